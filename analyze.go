@@ -0,0 +1,62 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/batch"
+	"github.com/go-music-theory/music-theory/render"
+	"github.com/go-music-theory/music-theory/song"
+)
+
+// analyzeSongs from files at some paths, or of "-", at each path read from a line of the input, e.g. piped from find,
+// writing a row of CSV of each chord, or of each bar, of each song as it's analyzed, after a header of the columns,
+// or if annotated, a lead sheet of each song, its numerals, cadences and modulations
+func analyzeSongs(r io.Reader, w io.Writer, paths []string, per string, annotate bool) error {
+	p, err := batch.PerOf(per)
+	if err != nil {
+		return err
+	}
+	out := batch.NewWriter(w, p).Write
+	if annotate {
+		out = func(_ string, s song.Song) error {
+			return render.To(w, render.LeadSheet, s)
+		}
+	}
+	for _, path := range paths {
+		if path != "-" {
+			if err = analyzeSong(out, path); err != nil {
+				return err
+			}
+			continue
+		}
+		lines := bufio.NewScanner(r)
+		for lines.Scan() {
+			if line := strings.TrimSpace(lines.Text()); len(line) > 0 {
+				if err = analyzeSong(out, line); err != nil {
+					return err
+				}
+			}
+		}
+		if err = lines.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// Private
+//
+
+// analyzeSong from a file at a path, writing its analysis
+func analyzeSong(out func(source string, s song.Song) error, path string) error {
+	s, err := readSongFile(path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return out(path, s)
+}
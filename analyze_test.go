@@ -0,0 +1,81 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/batch"
+)
+
+func TestAnalyzeSongs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "analyze")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := writeSongFile(t, dir)
+	var buf bytes.Buffer
+	assert.Nil(t, analyzeSongs(strings.NewReader(""), &buf, []string{path}, "bar", false))
+	assert.Equal(t, `source,title,key,section,bar,chords,changes,numerals,chromatic
+`+path+`,Autumn Leaves,G major,verse,1,Am7 D7,2,ii7 V7,0
+`+path+`,Autumn Leaves,G major,verse,2,Gmaj7,1,Imaj7,0
+`+path+`,Autumn Leaves,G major,Pre Chorus,3,Cmaj7,1,IVmaj7,0
+`, buf.String())
+}
+
+func TestAnalyzeSongs_Stdin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "analyze")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := writeSongFile(t, dir)
+	var buf bytes.Buffer
+	assert.Nil(t, analyzeSongs(strings.NewReader(path+"\n\n"+path+"\n"), &buf, []string{"-"}, "chord", false))
+	assert.Equal(t, 9, strings.Count(buf.String(), "\n"), "a header and 4 chords of each song")
+	assert.True(t, errors.Is(analyzeSongs(strings.NewReader(""), &buf, []string{path}, "beat", false), batch.ErrUnknownPer))
+	err = analyzeSongs(strings.NewReader(filepath.Join(dir, "missing.yaml")), &buf, []string{"-"}, "chord", false)
+	assert.Equal(t, filepath.Join(dir, "missing.yaml")+": open "+filepath.Join(dir, "missing.yaml")+": no such file or directory", err.Error())
+}
+
+func TestAnalyzeSongs_Annotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "analyze")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "chart.cho")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{title: Autumn Leaves}
+{key: E minor}
+{start_of_verse}
+The [Am7]falling [D7]leaves, [Gmaj7]drift by the [Cmaj7]window
+The [F#m7b5]autumn [B7]leaves of [Em]red and gold
+{end_of_verse}
+`), 0644))
+	var buf bytes.Buffer
+	assert.Nil(t, analyzeSongs(strings.NewReader(""), &buf, []string{path}, "chord", true))
+	assert.Equal(t, `# Autumn Leaves
+
+E minor, 4/4, 120 BPM
+
+## verse
+
+    | Am7    | D7   | Gmaj7   | Cmaj7  |
+    | iv7    | VII7 | IIImaj7 | VImaj7 |
+    | F#m7b5 | B7   | Em      | (authentic cadence in E minor)
+    | iiø7   | V7   | i       |
+
+`, buf.String())
+}
+
+func TestAnalyzeExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "analyze")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assertExitCode(t, 0, "", "analyze")
+	assertExitCode(t, 0, "", "analyze", "--per", "bar", writeSongFile(t, dir))
+	assertExitCode(t, 0, "", "analyze", "--annotate", writeSongFile(t, dir))
+	assertExitCode(t, 1, "Error occurred: unknown row \"beat\", expected chord or bar\n", "analyze", "--per", "beat", writeSongFile(t, dir))
+}
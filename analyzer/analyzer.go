@@ -0,0 +1,187 @@
+// An analyzer estimates the chord and key of notes as they're played, e.g. on a live MIDI instrument, fed each note on and note off as it comes,
+// keeping a rolling weight of each pitch class that decays over time, so the estimates follow the music without analyzing it all again.
+//
+// The chord is the template of a common chord nearest the pitch classes sounding, of the notes held and those released a moment ago, e.g. of an arpeggio,
+// and the key is the Krumhansl-Kessler key profile most correlated with the pitch classes played over the last several seconds.
+//
+// https://en.wikipedia.org/wiki/Key_(music)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package analyzer
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+// Half-lives of the weight of each pitch class by default, of the chord, which changes every beat or two, and of the key, which changes rarely
+const (
+	ChordHalfLife = 500 * time.Millisecond
+	KeyHalfLife   = 8 * time.Second
+)
+
+// MinChordScore of the nearest chord, of the pitch classes sounding, from 0 to 1, below which no chord is estimated, e.g. of a single note or a fifth
+const MinChordScore = 0.85
+
+// Event of a note on or note off, at a time, e.g. since the beginning of a performance
+type Event struct {
+	Time     time.Duration // never before the time of an event fed before it, else it's taken to be at the same time
+	Number   int           // MIDI note number, from 0 to 127
+	Velocity int           // of a note on, from 1 to 127, or 0 of a note off
+}
+
+// NoteOn of a note number at a time, with a velocity, e.g. NoteOn(time.Since(start), 60, 90)
+func NoteOn(t time.Duration, number int, velocity int) Event {
+	return Event{Time: t, Number: number, Velocity: velocity}
+}
+
+// NoteOff of a note number at a time
+func NoteOff(t time.Duration, number int) Event {
+	return Event{Time: t, Number: number}
+}
+
+// Estimate of the chord and key at a time, each with a score of how well it fits, from 0 to 1
+type Estimate struct {
+	Time       time.Duration
+	Chord      chord.Chord // of the Root Nil if no chord is near the pitch classes sounding
+	Bass       note.Class  // of the lowest note held, or of the last held, or Nil before any
+	ChordScore float64
+	Key        key.Key // of the Mode Nil before any note
+	KeyScore   float64
+}
+
+// Analyzer of the notes it's fed, safe for use by only one goroutine at a time
+type Analyzer struct {
+	held    [128]int    // velocity of each note number held, or 0, sustaining its pitch class at least at its level
+	chord   [12]float64 // weights of the pitch classes of the chord, decaying by its half-life
+	key     [12]float64 // weights of the pitch classes of the key, decaying by its half-life
+	time    time.Duration
+	bass    note.Class
+	options options
+}
+
+// New analyzer of no notes yet, with any options
+func New(opts ...Option) *Analyzer {
+	return &Analyzer{options: optionsOf(opts)}
+}
+
+// Feed a note on or note off, returning the estimate just after it
+func (a *Analyzer) Feed(e Event) Estimate {
+	a.decay(e.Time)
+	if e.Number < 0 || e.Number > 127 {
+		return a.estimate()
+	}
+	if e.Velocity > 0 {
+		a.held[e.Number] = min(e.Velocity, 127)
+		a.chord[e.Number%12] += levelOf(a.held[e.Number])
+		a.key[e.Number%12] += levelOf(a.held[e.Number])
+	} else if a.held[e.Number] > 0 {
+		a.chord[e.Number%12] = math.Max(a.chord[e.Number%12], levelOf(a.held[e.Number]))
+		a.held[e.Number] = 0
+	}
+	return a.estimate()
+}
+
+// At a time, the estimate of the notes fed until then, decayed since the last, e.g. every beat of a clock between any notes
+func (a *Analyzer) At(t time.Duration) Estimate {
+	a.decay(t)
+	return a.estimate()
+}
+
+// Candidates of the chord and key of the notes fed until the last event or time estimated, each ranked with its confidence among them all,
+// of every chord that scores at least the MinChordScore, favoring a root in the bass, spelled in the best key, and of every major and minor key,
+// e.g. to show the alternatives to an estimate, or none before any note
+func (a *Analyzer) Candidates() (chords []candidate.Candidate[chord.Chord], keys []candidate.Candidate[key.Key]) {
+	sounding := a.sounding()
+	keys = keyCandidatesOf(a.key)
+	best, _ := candidate.Best(keys)
+	chords = chordCandidatesOf(sounding, a.bass, best)
+	if trace.Enabled() {
+		trace.Ranked("analyzer.chord", a.time.String(), chords, chord.Chord.Name)
+		trace.Ranked("analyzer.key", a.time.String(), keys, key.Key.Canonical)
+	}
+	return chords, keys
+}
+
+// Reset the analyzer, of no notes yet, e.g. between songs
+func (a *Analyzer) Reset() {
+	*a = Analyzer{options: a.options}
+}
+
+//
+// Private
+//
+
+// decay the weights of every pitch class until a time, by the half-lives of the options
+func (a *Analyzer) decay(t time.Duration) {
+	if t <= a.time {
+		return
+	}
+	elapsed := float64(t - a.time)
+	chordFactor := math.Exp2(-elapsed / float64(a.options.chordHalfLife))
+	keyFactor := math.Exp2(-elapsed / float64(a.options.keyHalfLife))
+	for pc := range a.chord {
+		a.chord[pc] *= chordFactor
+		a.key[pc] *= keyFactor
+	}
+	a.time = t
+}
+
+// estimate of the chord and key of the weights of the pitch classes now, and the bass
+func (a *Analyzer) estimate() Estimate {
+	sounding := a.sounding()
+	e := Estimate{Time: a.time, Bass: a.bass}
+	e.Key, e.KeyScore = keyOf(a.key)
+	e.Chord, e.ChordScore = chordOf(sounding, a.bass, e.Key)
+	if trace.Enabled() {
+		trace.Emit(trace.Event{Scope: "analyzer.chord", Input: a.time.String(), Rule: e.Chord.Name(), Score: e.ChordScore})
+		trace.Emit(trace.Event{Scope: "analyzer.key", Input: a.time.String(), Rule: e.Key.Canonical(), Score: e.KeyScore})
+	}
+	return e
+}
+
+// sounding weights of the pitch classes of the chord now, the weight of each note held sustained at least at the level of its velocity,
+// and the bass, the lowest note held
+func (a *Analyzer) sounding() [12]float64 {
+	sounding := a.chord
+	bass := note.Nil
+	for number, velocity := range a.held {
+		if velocity == 0 {
+			continue
+		}
+		if bass == note.Nil {
+			bass = note.Class(number%12 + 1)
+		}
+		sounding[number%12] = math.Max(sounding[number%12], levelOf(velocity))
+	}
+	if bass != note.Nil {
+		a.bass = bass
+	}
+	return sounding
+}
+
+// levelOf a velocity, from 0 to 1
+func levelOf(velocity int) float64 {
+	return float64(velocity) / 127
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
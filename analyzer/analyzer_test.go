@@ -0,0 +1,150 @@
+// An analyzer estimates the chord and key of notes as they're played, e.g. on a live MIDI instrument, fed each note on and note off as it comes,
+package analyzer
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+func TestAnalyzer_Feed(t *testing.T) {
+	a := New()
+	e := a.Feed(NoteOn(0, 60, 100))
+	assert.Equal(t, note.Nil, e.Chord.Root, "of a single note")
+	assert.Equal(t, note.C, e.Bass)
+	a.Feed(NoteOn(5*time.Millisecond, 64, 100))
+	e = a.Feed(NoteOn(10*time.Millisecond, 67, 100))
+	assert.Equal(t, "C", e.Chord.Name())
+	assert.InDelta(t, 1.0, e.ChordScore, 0.01)
+	assert.Equal(t, 10*time.Millisecond, e.Time)
+	assert.Equal(t, key.Major, e.Key.Mode)
+	assert.Equal(t, note.C, e.Key.Root)
+}
+
+func TestAnalyzer_Candidates(t *testing.T) {
+	a := New()
+	chords, keys := a.Candidates()
+	assert.Nil(t, chords)
+	assert.Nil(t, keys)
+	for _, number := range []int{57, 60, 64, 67} {
+		a.Feed(NoteOn(0, number, 100))
+	}
+	chords, keys = a.Candidates()
+	assert.Equal(t, "Am7", chords[0].Value.Name(), "rooted on the bass")
+	assert.Equal(t, "C6", chords[1].Value.Name())
+	assert.True(t, chords[0].Confidence > chords[1].Confidence)
+	for _, c := range chords {
+		assert.True(t, c.Score >= MinChordScore, c.Value.Name())
+	}
+	assert.Equal(t, 24, len(keys))
+	e := a.At(0)
+	assert.Equal(t, e.Key, keys[0].Value)
+	assert.Equal(t, e.KeyScore, keys[0].Score)
+}
+
+func TestAnalyzer_Feed_Release(t *testing.T) {
+	a := New()
+	for _, number := range []int{48, 52, 55} {
+		a.Feed(NoteOn(0, number, 90))
+	}
+	for _, number := range []int{48, 52, 55} {
+		a.Feed(NoteOff(100*time.Millisecond, number))
+	}
+	e := a.Feed(NoteOn(200*time.Millisecond, 58, 90))
+	assert.Equal(t, "C7", e.Chord.Name(), "of the notes released a moment ago, and the note held")
+	assert.Equal(t, note.As, e.Bass)
+	e = a.At(3 * time.Second)
+	assert.Equal(t, note.Nil, e.Chord.Root, "of only the note held, once the others decay")
+	e = a.Feed(NoteOff(4*time.Second, 58))
+	assert.Equal(t, note.As, e.Bass, "of the last held")
+	e = a.At(10 * time.Second)
+	assert.Equal(t, note.Nil, e.Chord.Root)
+	assert.NotEqual(t, key.Nil, e.Key.Mode, "held longer than the chord")
+}
+
+func TestAnalyzer_Feed_Progression(t *testing.T) {
+	a := New()
+	var e Estimate
+	at := time.Duration(0)
+	for _, numbers := range [][]int{{57, 60, 64}, {53, 57, 60}, {52, 56, 59, 62}, {57, 60, 64}} { // Am F E7 Am
+		for _, number := range numbers {
+			a.Feed(NoteOn(at, number, 90))
+		}
+		at += 2 * time.Second
+		for _, number := range numbers {
+			e = a.Feed(NoteOff(at, number))
+		}
+	}
+	assert.Equal(t, "A Minor", e.Key.Canonical())
+	assert.Equal(t, "Am", e.Chord.Name())
+	assert.True(t, e.KeyScore > 0.5)
+}
+
+func TestAnalyzer_Feed_Spelling(t *testing.T) {
+	a := New()
+	var e Estimate
+	at := time.Duration(0)
+	for _, numbers := range [][]int{{53, 57, 60}, {58, 62, 65}, {48, 52, 55, 58}, {53, 57, 60}, {58, 62, 65}} { // F Bb C7 F Bb
+		for _, number := range numbers {
+			a.Feed(NoteOn(at, number, 90))
+		}
+		at += time.Second
+		for _, number := range numbers {
+			e = a.Feed(NoteOff(at, number))
+		}
+	}
+	assert.Equal(t, "F Major", e.Key.Canonical())
+	assert.Equal(t, "Bb", e.Chord.Name(), "spelled in the key")
+	assert.Equal(t, note.F, e.Bass, "of the last note held, the highest of the chord released upward")
+}
+
+func TestAnalyzer_Feed_Invalid(t *testing.T) {
+	a := New()
+	a.Feed(NoteOn(time.Second, 60, 90))
+	e := a.Feed(NoteOn(0, 200, 90))
+	assert.Equal(t, time.Second, e.Time, "never before the last")
+	e = a.Feed(NoteOff(2*time.Second, 61))
+	assert.Equal(t, note.C, e.Bass, "of a note off never held")
+	a.Reset()
+	assert.Equal(t, Estimate{}, a.At(0))
+	assert.Equal(t, optionsOf(nil), a.options)
+}
+
+func TestAnalyzer_Feed_Bass(t *testing.T) {
+	a := New()
+	for _, number := range []int{48, 52, 55, 57} {
+		a.Feed(NoteOn(0, number, 90))
+	}
+	assert.Equal(t, "C6", a.At(0).Chord.Name(), "rooted on the bass")
+	a.Reset()
+	for _, number := range []int{45, 52, 55, 60} {
+		a.Feed(NoteOn(0, number, 90))
+	}
+	assert.Equal(t, "Am7", a.At(0).Chord.Name())
+}
+
+func TestAnalyzer_Feed_Traced(t *testing.T) {
+	a := New()
+	a.Feed(NoteOn(0, 60, 90))
+	var events []trace.Event
+	previous := trace.Set(trace.HookFunc(func(e trace.Event) { events = append(events, e) }))
+	defer trace.Set(previous)
+	e := a.Feed(NoteOn(time.Second, 64, 90))
+	assert.Equal(t, []trace.Event{
+		{Scope: "analyzer.chord", Input: "1s", Rule: e.Chord.Name(), Score: e.ChordScore},
+		{Scope: "analyzer.key", Input: "1s", Rule: e.Key.Canonical(), Score: e.KeyScore},
+	}, events)
+}
+
+func BenchmarkAnalyzer_Feed(b *testing.B) {
+	a := New()
+	for i := 0; i < b.N; i++ {
+		a.Feed(NoteOn(time.Duration(i)*time.Millisecond, 48+i%24, 90))
+		a.Feed(NoteOff(time.Duration(i)*time.Millisecond, 48+(i+12)%24))
+	}
+}
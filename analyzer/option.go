@@ -0,0 +1,44 @@
+// Analyzers decay the weight of each pitch class by half every half a second for the chord, and every 8 seconds for the key, or with an Option, e.g. New(WithChordHalfLife(time.Second))
+package analyzer
+
+import (
+	"time"
+)
+
+// Option of an analyzer
+type Option func(*options)
+
+// WithChordHalfLife of the weight of each pitch class of the chord, longer to follow the chord of a slow arpeggio, or shorter of quick changes, e.g. WithChordHalfLife(250*time.Millisecond), or the default ChordHalfLife if not above 0
+func WithChordHalfLife(halfLife time.Duration) Option {
+	return func(o *options) {
+		if halfLife > 0 {
+			o.chordHalfLife = halfLife
+		}
+	}
+}
+
+// WithKeyHalfLife of the weight of each pitch class of the key, longer to hold to a key through passing chords, or shorter to follow a modulation, e.g. WithKeyHalfLife(30*time.Second), or the default KeyHalfLife if not above 0
+func WithKeyHalfLife(halfLife time.Duration) Option {
+	return func(o *options) {
+		if halfLife > 0 {
+			o.keyHalfLife = halfLife
+		}
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	chordHalfLife time.Duration
+	keyHalfLife   time.Duration
+}
+
+func optionsOf(opts []Option) options {
+	o := &options{chordHalfLife: ChordHalfLife, keyHalfLife: KeyHalfLife}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
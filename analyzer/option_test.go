@@ -0,0 +1,24 @@
+// Analyzers decay the weight of each pitch class by half every half a second for the chord, and every 8 seconds for the key, or with an Option, e.g. New(WithChordHalfLife(time.Second))
+package analyzer
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestOptionsOf(t *testing.T) {
+	assert.Equal(t, options{chordHalfLife: ChordHalfLife, keyHalfLife: KeyHalfLife}, optionsOf(nil))
+	assert.Equal(t, options{chordHalfLife: time.Second, keyHalfLife: time.Minute}, optionsOf([]Option{WithChordHalfLife(time.Second), WithKeyHalfLife(time.Minute)}))
+	assert.Equal(t, options{chordHalfLife: ChordHalfLife, keyHalfLife: KeyHalfLife}, optionsOf([]Option{WithChordHalfLife(0), WithKeyHalfLife(-time.Second)}), "the defaults unless above 0")
+}
+
+func TestWithChordHalfLife(t *testing.T) {
+	slow := New(WithChordHalfLife(10 * time.Second))
+	slow.Feed(NoteOn(0, 60, 90))
+	slow.Feed(NoteOff(100*time.Millisecond, 60))
+	slow.Feed(NoteOn(time.Second, 64, 90))
+	slow.Feed(NoteOff(1100*time.Millisecond, 64))
+	assert.Equal(t, "C", slow.Feed(NoteOn(2*time.Second, 67, 90)).Chord.Name(), "of a slow arpeggio")
+}
@@ -0,0 +1,198 @@
+// The weights of the pitch classes are matched to a template of each common chord on each root, and correlated with the profile of each major and minor key
+package analyzer
+
+import (
+	"math"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+//
+// Private
+//
+
+// chordSymbols of the templates of chords, after their root, from the most to the least common of those with the same pitch classes, e.g. C6 before Am7/C
+var chordSymbols = []string{"", "m", "dim", "aug", "sus4", "7", "M7", "m7", "m7b5", "dim7", "6", "m6", "mM7"}
+
+// majorProfile and minorProfile of keys, the weight of each pitch class up from the tonic, by Krumhansl and Kessler
+var (
+	majorProfile = [12]float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88}
+	minorProfile = [12]float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}
+)
+
+// template of a chord, its pitch classes, and the chord, spelled in sharps
+type template struct {
+	pitchClasses [12]bool
+	size         int
+	root         note.Class
+	chord        chord.Chord
+}
+
+// templates of every chord symbol on every root, in order of root then symbol
+var templates = templatesOf(chordSymbols)
+
+// keys of every major then every minor key on each pitch class, as each is most commonly spelled, and their profiles
+var (
+	keys = [24]key.Key{}
+
+	keyProfiles = [24][12]float64{}
+)
+
+func init() {
+	names := []string{"C", "Db", "D", "Eb", "E", "F", "F#", "G", "Ab", "A", "Bb", "B"}
+	minorNames := []string{"C", "C#", "D", "Eb", "E", "F", "F#", "G", "G#", "A", "Bb", "B"}
+	for pc := 0; pc < 12; pc++ {
+		keys[pc] = key.Of(names[pc] + " major")
+		keys[12+pc] = key.Of(minorNames[pc] + " minor")
+		for i := 0; i < 12; i++ {
+			keyProfiles[pc][(pc+i)%12] = majorProfile[i]
+			keyProfiles[12+pc][(pc+i)%12] = minorProfile[i]
+		}
+	}
+}
+
+// templatesOf some chord symbols on every root
+func templatesOf(symbols []string) []template {
+	var ts []template
+	for pc := 0; pc < 12; pc++ {
+		root := note.Class(pc + 1)
+		for _, symbol := range symbols {
+			t := template{root: root, chord: chord.Of(root.String(note.Sharp) + symbol)}
+			for _, class := range t.chord.ToneSet().Classes() {
+				t.pitchClasses[class-1] = true
+				t.size++
+			}
+			ts = append(ts, t)
+		}
+	}
+	return ts
+}
+
+// chordOf weights of the pitch classes, the chord of the template nearest them by cosine similarity, favoring a root in the bass, spelled in a key,
+// and its score, or a chord of the Root Nil if none scores at least the MinChordScore
+func chordOf(weights [12]float64, bass note.Class, k key.Key) (chord.Chord, float64) {
+	norm := normOf(weights)
+	if norm == 0 {
+		return chord.Chord{}, 0
+	}
+	best, bestScore, bestRanked := -1, 0.0, 0.0
+	for n, t := range templates {
+		score := similarityOf(weights, norm, t)
+		if ranked := rankedOf(score, t, bass); ranked > bestRanked {
+			best, bestScore, bestRanked = n, score, ranked
+		}
+	}
+	if best < 0 || bestScore < MinChordScore {
+		return chord.Chord{}, bestScore
+	}
+	return spelledIn(templates[best].chord, k), math.Min(bestScore, 1)
+}
+
+// chordCandidatesOf weights of the pitch classes, the chord of every template scoring at least the MinChordScore, spelled in a key,
+// ranked by its cosine similarity, favoring a root in the bass, or none if there are no weights
+func chordCandidatesOf(weights [12]float64, bass note.Class, k key.Key) []candidate.Candidate[chord.Chord] {
+	norm := normOf(weights)
+	if norm == 0 {
+		return nil
+	}
+	var candidates []candidate.Candidate[chord.Chord]
+	for _, t := range templates {
+		if score := similarityOf(weights, norm, t); score >= MinChordScore {
+			candidates = append(candidates, candidate.Of(spelledIn(t.chord, k), rankedOf(score, t, bass)))
+		}
+	}
+	return candidate.Rank(candidates)
+}
+
+// keyOf weights of the pitch classes, the major or minor key whose profile is most correlated with them, and its correlation, from 0 up to 1,
+// or a key of the Mode Nil if there are no weights
+func keyOf(weights [12]float64) (key.Key, float64) {
+	if sumOf(weights) == 0 {
+		return key.Key{}, 0
+	}
+	best, bestScore := 0, math.Inf(-1)
+	for n, profile := range keyProfiles {
+		if score := correlationOf(weights, profile); score > bestScore {
+			best, bestScore = n, score
+		}
+	}
+	return keys[best], math.Max(bestScore, 0)
+}
+
+// keyCandidatesOf weights of the pitch classes, every major and minor key, ranked by the correlation of its profile with them, or none if there are no weights
+func keyCandidatesOf(weights [12]float64) []candidate.Candidate[key.Key] {
+	if sumOf(weights) == 0 {
+		return nil
+	}
+	candidates := make([]candidate.Candidate[key.Key], len(keyProfiles))
+	for n, profile := range keyProfiles {
+		candidates[n] = candidate.Of(keys[n], correlationOf(weights, profile))
+	}
+	return candidate.Rank(candidates)
+}
+
+// similarityOf weights of the pitch classes, of a norm, to a template, their cosine similarity, from 0 to 1
+func similarityOf(weights [12]float64, norm float64, t template) float64 {
+	var dot float64
+	for pc, in := range t.pitchClasses {
+		if in {
+			dot += weights[pc]
+		}
+	}
+	return dot / (norm * math.Sqrt(float64(t.size)))
+}
+
+// rankedOf the score of a template, favoring a root in the bass
+func rankedOf(score float64, t template, bass note.Class) float64 {
+	if t.root == bass {
+		return score + 0.01
+	}
+	return score
+}
+
+// spelledIn a key, a chord of a template, or a copy of it spelled in sharps of a key of the Mode Nil
+func spelledIn(c chord.Chord, k key.Key) chord.Chord {
+	if k.Mode == key.Nil {
+		return c.Copy()
+	}
+	return c.SpelledIn(k)
+}
+
+// normOf weights of the pitch classes, the square root of the sum of their squares
+func normOf(weights [12]float64) float64 {
+	var sumSquares float64
+	for _, w := range weights {
+		sumSquares += w * w
+	}
+	return math.Sqrt(sumSquares)
+}
+
+// sumOf weights of the pitch classes
+func sumOf(weights [12]float64) (sum float64) {
+	for _, w := range weights {
+		sum += w
+	}
+	return
+}
+
+// correlationOf two sets of weights, Pearson's, from -1 to 1, or 0 if either is constant
+func correlationOf(a, b [12]float64) float64 {
+	var meanA, meanB float64
+	for i := range a {
+		meanA += a[i] / 12
+		meanB += b[i] / 12
+	}
+	var cov, varA, varB float64
+	for i := range a {
+		cov += (a[i] - meanA) * (b[i] - meanB)
+		varA += (a[i] - meanA) * (a[i] - meanA)
+		varB += (b[i] - meanB) * (b[i] - meanB)
+	}
+	if varA == 0 || varB == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varA*varB)
+}
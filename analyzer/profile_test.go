@@ -0,0 +1,56 @@
+// The weights of the pitch classes are matched to a template of each common chord on each root, and correlated with the profile of each major and minor key
+package analyzer
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestTemplates(t *testing.T) {
+	assert.Equal(t, 12*len(chordSymbols), len(templates))
+	assert.Equal(t, "C#m7", templates[len(chordSymbols)+7].chord.Name())
+	assert.Equal(t, 4, templates[len(chordSymbols)+7].size)
+}
+
+func TestChordOf(t *testing.T) {
+	c, score := chordOf([12]float64{0: 1, 4: 1, 7: 1}, note.C, key.Key{})
+	assert.Equal(t, "C", c.Name())
+	assert.InDelta(t, 1.0, score, 0.001)
+	c, _ = chordOf([12]float64{0: 1, 4: 1, 7: 1, 11: 0.2}, note.C, key.Key{})
+	assert.Equal(t, "C", c.Name(), "of a faint seventh")
+	c, _ = chordOf([12]float64{0: 1, 4: 1, 7: 1, 11: 0.8}, note.C, key.Key{})
+	assert.Equal(t, "CM7", c.Name())
+	c, score = chordOf([12]float64{0: 1, 7: 1}, note.C, key.Key{})
+	assert.Equal(t, note.Nil, c.Root, "of a fifth")
+	assert.True(t, score < MinChordScore)
+	c, score = chordOf([12]float64{}, note.Nil, key.Key{})
+	assert.Equal(t, note.Nil, c.Root)
+	assert.Equal(t, 0.0, score)
+	c, _ = chordOf([12]float64{3: 1, 7: 1, 10: 1}, note.Ds, key.Of("Bb major"))
+	assert.Equal(t, "Eb", c.Name())
+	c.Tones[0].Class = note.A
+	c, _ = chordOf([12]float64{3: 1, 7: 1, 10: 1}, note.Ds, key.Key{})
+	assert.Equal(t, note.Ds, c.Tones[0].Class, "a chord of its own")
+}
+
+func TestKeyOf(t *testing.T) {
+	k, score := keyOf([12]float64{0: 2, 2: 1, 4: 1, 5: 1, 7: 1, 9: 1, 11: 1})
+	assert.Equal(t, key.Of("C major"), k)
+	assert.True(t, score > 0.8)
+	k, _ = keyOf([12]float64{9: 2, 11: 1, 0: 1, 2: 1, 4: 1.5, 5: 1, 8: 1})
+	assert.Equal(t, key.Of("A minor"), k)
+	k, _ = keyOf([12]float64{1: 2, 3: 1, 5: 1, 6: 1, 8: 1.5, 10: 1, 0: 1})
+	assert.Equal(t, "Db Major", k.Canonical())
+	k, score = keyOf([12]float64{})
+	assert.Equal(t, key.Nil, k.Mode)
+	assert.Equal(t, 0.0, score)
+}
+
+func TestCorrelationOf(t *testing.T) {
+	assert.InDelta(t, 1.0, correlationOf(majorProfile, majorProfile), 0.0001)
+	assert.Equal(t, 0.0, correlationOf([12]float64{}, majorProfile))
+}
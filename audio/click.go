@@ -0,0 +1,40 @@
+// Clicks are short bursts of a sine wave, decaying quickly from their gain to silence, e.g. of a metronome, mixed into samples at a time
+package audio
+
+import (
+	"math"
+	"time"
+)
+
+// ClickLength of every click, long enough to hear its pitch
+const ClickLength = 30 * time.Millisecond
+
+// Click of a sine wave at a frequency, in Hz, from a gain of its amplitude, from 0 to 1, decaying exponentially over the ClickLength
+func Click(frequency float64, gain float64) []float64 {
+	samples := make([]float64, SamplesOf(ClickLength))
+	for n := range samples {
+		at := float64(n) / SampleRate
+		samples[n] = gain * math.Exp(-at*200) * math.Sin(2*math.Pi*frequency*at)
+	}
+	return samples
+}
+
+// Mix a sound into some samples, added to them from a time, or the beginning if it's before, growing them as long as it needs
+func Mix(samples []float64, sound []float64, at time.Duration) []float64 {
+	from := SamplesOf(at)
+	if from < 0 {
+		from = 0
+	}
+	if end := from + len(sound); end > len(samples) {
+		samples = append(samples, make([]float64, end-len(samples))...)
+	}
+	for n, s := range sound {
+		samples[from+n] += s
+	}
+	return samples
+}
+
+// SamplesOf some time, at the SampleRate, e.g. 44100 of a second
+func SamplesOf(d time.Duration) int {
+	return int(math.Round(d.Seconds() * SampleRate))
+}
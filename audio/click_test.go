@@ -0,0 +1,37 @@
+// Clicks are short bursts of a sine wave, decaying quickly from their gain to silence, e.g. of a metronome, mixed into samples at a time
+package audio
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestClick(t *testing.T) {
+	c := Click(1000, 0.5)
+	assert.Equal(t, 1323, len(c))
+	assert.Equal(t, 0.0, c[0])
+	peak := 0.0
+	for _, s := range c {
+		peak = math.Max(peak, math.Abs(s))
+	}
+	assert.True(t, peak <= 0.5)
+	assert.True(t, math.Abs(c[len(c)-1]) < 0.01, "decayed to nearly silence")
+}
+
+func TestMix(t *testing.T) {
+	samples := Mix(nil, []float64{0.5, 0.5}, 0)
+	assert.Equal(t, []float64{0.5, 0.5}, samples)
+	samples = Mix(samples, []float64{0.25, 0.25}, time.Second/SampleRate)
+	assert.Equal(t, []float64{0.5, 0.75, 0.25}, samples)
+	samples = Mix(samples, []float64{0.25}, -time.Second)
+	assert.Equal(t, []float64{0.75, 0.75, 0.25}, samples, "from the beginning")
+}
+
+func TestSamplesOf(t *testing.T) {
+	assert.Equal(t, 44100, SamplesOf(time.Second))
+	assert.Equal(t, 1323, SamplesOf(ClickLength))
+	assert.Equal(t, 0, SamplesOf(0))
+}
@@ -0,0 +1,53 @@
+// Audio is sampled as a sequence of amplitudes, each from -1 to 1, at a rate of samples per second, and written as a WAV file of 16-bit PCM in mono,
+// to be played by any audio player or opened in a DAW.
+//
+// https://en.wikipedia.org/wiki/WAV
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// SampleRate of every file, in samples per second, that of a compact disc
+const SampleRate = 44100
+
+// BitsPerSample of every file, of 16-bit PCM
+const BitsPerSample = 16
+
+// WriteWAV of some samples, each an amplitude clipped from -1 to 1, in mono at the SampleRate
+func WriteWAV(w io.Writer, samples []float64) error {
+	const blockAlign = BitsPerSample / 8
+	data := make([]byte, len(samples)*blockAlign)
+	for n, s := range samples {
+		binary.LittleEndian.PutUint16(data[n*blockAlign:], uint16(int16(math.Round(math.Max(-1, math.Min(1, s))*math.MaxInt16))))
+	}
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(36+len(data)))
+	b.WriteString("WAVEfmt ")
+	binary.Write(&b, binary.LittleEndian, uint32(16)) // size of the format
+	binary.Write(&b, binary.LittleEndian, uint16(1))  // PCM
+	binary.Write(&b, binary.LittleEndian, uint16(1))  // channels
+	binary.Write(&b, binary.LittleEndian, uint32(SampleRate))
+	binary.Write(&b, binary.LittleEndian, uint32(SampleRate*blockAlign)) // bytes per second
+	binary.Write(&b, binary.LittleEndian, uint16(blockAlign))
+	binary.Write(&b, binary.LittleEndian, uint16(BitsPerSample))
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, uint32(len(data)))
+	b.Write(data)
+	_, err := w.Write(b.Bytes())
+	return err
+}
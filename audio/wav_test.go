@@ -0,0 +1,32 @@
+// Audio is sampled as a sequence of amplitudes, each from -1 to 1, at a rate of samples per second, and written as a WAV file of 16-bit PCM in mono,
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestWriteWAV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, WriteWAV(&buf, []float64{0, 1, -1, 2, 0.5}))
+	b := buf.Bytes()
+	assert.Equal(t, 44+10, len(b))
+	assert.Equal(t, "RIFF", string(b[0:4]))
+	assert.Equal(t, uint32(36+10), binary.LittleEndian.Uint32(b[4:]))
+	assert.Equal(t, "WAVEfmt ", string(b[8:16]))
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(b[20:]), "PCM")
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(b[22:]), "mono")
+	assert.Equal(t, uint32(SampleRate), binary.LittleEndian.Uint32(b[24:]))
+	assert.Equal(t, uint32(SampleRate*2), binary.LittleEndian.Uint32(b[28:]))
+	assert.Equal(t, uint16(BitsPerSample), binary.LittleEndian.Uint16(b[34:]))
+	assert.Equal(t, "data", string(b[36:40]))
+	assert.Equal(t, uint32(10), binary.LittleEndian.Uint32(b[40:]))
+	var samples []int16
+	for n := 44; n < len(b); n += 2 {
+		samples = append(samples, int16(binary.LittleEndian.Uint16(b[n:])))
+	}
+	assert.Equal(t, []int16{0, 32767, -32767, 32767, 16384}, samples, "clipped from -1 to 1")
+}
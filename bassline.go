@@ -0,0 +1,48 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"os"
+
+	"github.com/go-music-theory/music-theory/bassline"
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// generateBassline of some chord names, a chord every bar, in a style, one of the bassline.StyleNames, shaped by any dynamics, e.g. "p..f"
+func generateBassline(names []string, styleName string, dynamicsText string) (bassline.Line, error) {
+	style, err := bassline.StyleNamed(styleName)
+	if err != nil {
+		return bassline.Line{}, err
+	}
+	phrase, err := dynamics.ParsePhrase(dynamicsText)
+	if err != nil {
+		return bassline.Line{}, err
+	}
+	var p progression.Progression
+	for _, name := range names {
+		c, err := chord.Parse(name)
+		if err != nil {
+			return bassline.Line{}, err
+		}
+		p.Chords = append(p.Chords, c)
+	}
+	l := bassline.Generate(p, style)
+	l.Dynamics = phrase
+	return l, nil
+}
+
+// writeBasslineFile at a path, of a bass line, as MIDI
+func writeBasslineFile(path string, l bassline.Line) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = l.WriteMIDI(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,281 @@
+// A bass line is the lowest part of a piece, sounding the roots of its chords and leading from each chord to the next.
+//
+// A walking bass moves in quarter notes through the tones of each chord, approaching the root of the next by step,
+// a root-fifth bass alternates between the root and fifth of each chord in half notes, and a pedal sustains the tonic of the key under every chord.
+//
+// https://en.wikipedia.org/wiki/Bassline
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package bassline
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Range of the bass, MIDI note numbers from E1, the lowest open string of a bass guitar, to G3
+const (
+	Lowest  = 28
+	Highest = 55
+)
+
+// BeatsPerChord of every progression, i.e. a bar of 4/4
+const BeatsPerChord = 4
+
+// Dynamics of each note of a bass line, accenting the downbeat of each chord over the other beats of its bar
+const (
+	DownbeatDynamic = dynamics.F
+	BeatDynamic     = dynamics.MF
+)
+
+// ErrUnknownStyle when naming a style that isn't built in, e.g. "slap"
+var ErrUnknownStyle = errors.New("unknown style")
+
+// Style of a bass line
+type Style int
+
+// Styles of bass lines
+const (
+	Walking   Style = iota // quarter notes through the tones of each chord, approaching the next root by step
+	RootFifth              // half notes of the root and then the fifth of each chord
+	Pedal                  // a whole note of the tonic of the key under each chord
+)
+
+// StyleNames of the Styles, in order
+var StyleNames = []string{"walking", "root-fifth", "pedal"}
+
+// Line of a bass under a progression, in its key, a chord every bar of 4/4, its notes in order, each at its own dynamic, shaped by any of the whole phrase
+type Line struct {
+	Key      key.Key
+	Style    Style
+	Notes    []melody.Note
+	Dynamics dynamics.Phrase // of the whole line, e.g. a crescendo, or Nil to play each note at its own dynamic
+}
+
+// Generate a bass line of a progression in a style, each chord for BeatsPerChord from its root within the Range, nearest the root before it,
+// and the last chord leading back to the first, so the line can be looped
+func Generate(p progression.Progression, style Style) Line {
+	l := Line{Style: style}
+	if len(p.Chords) == 0 {
+		return l
+	}
+	l.Key = p.Key()
+	roots := rootsOf(p.Chords)
+	in := scaleOf(l.Key)
+	for n, c := range p.Chords {
+		beat := float64(n * BeatsPerChord)
+		root := roots[n]
+		switch style {
+		case Walking:
+			next := roots[(n+1)%len(roots)]
+			steps := walkOf(c, root)
+			steps = append(steps, approachOf(steps[len(steps)-1], next, in))
+			for m, step := range steps {
+				l.Notes = append(l.Notes, noteOf(step, beat+float64(m), 1))
+			}
+		case RootFifth:
+			l.Notes = append(l.Notes, noteOf(root, beat, 2), noteOf(fifthOf(c, root), beat+2, 2))
+		case Pedal:
+			l.Notes = append(l.Notes, noteOf(lowestOf(l.Key.Root), beat, BeatsPerChord))
+		}
+	}
+	for n := range l.Notes {
+		l.Notes[n].Articulation = style.Articulation()
+	}
+	return l
+}
+
+// StyleNamed one of the StyleNames, e.g. StyleNamed("walking")
+func StyleNamed(name string) (Style, error) {
+	for n, styleName := range StyleNames {
+		if strings.ToLower(strings.TrimSpace(name)) == styleName {
+			return Style(n), nil
+		}
+	}
+	return Walking, fmt.Errorf("%w %q, expected one of %s", ErrUnknownStyle, name, strings.Join(StyleNames, ", "))
+}
+
+// Articulation of every note of the Style, as it's idiomatically played: legato walking from each note into the next,
+// portato, both staccato and tenuto, for the bounce of a root-fifth bass, and tenuto sustaining a pedal
+func (of Style) Articulation() melody.Articulation {
+	switch of {
+	case Walking:
+		return melody.Legato
+	case RootFifth:
+		return melody.Staccato | melody.Tenuto
+	case Pedal:
+		return melody.Tenuto
+	}
+	return 0
+}
+
+// String of the Style, e.g. "root-fifth"
+func (of Style) String() string {
+	if of < 0 || int(of) >= len(StyleNames) {
+		return ""
+	}
+	return StyleNames[of]
+}
+
+// String of the Line, its notes with a bar between each chord, spelled with flats in a key with flats in its signature, else sharps, e.g. "C2 E2 G2 B1 | A1 C2 E2 F#2"
+func (l Line) String() string {
+	adj := note.Sharp
+	if l.Key.Fifths() < 0 {
+		adj = note.Flat
+	}
+	var bars []string
+	var names []string
+	for n, nt := range l.Notes {
+		names = append(names, fmt.Sprintf("%s%d", nt.Class.String(adj), nt.Octave))
+		if n == len(l.Notes)-1 || int(l.Notes[n+1].Beat)/BeatsPerChord != int(nt.Beat)/BeatsPerChord {
+			bars = append(bars, strings.Join(names, " "))
+			names = nil
+		}
+	}
+	return strings.Join(bars, " | ")
+}
+
+//
+// Private
+//
+
+// rootsOf chords, as MIDI note numbers, the first in the lowest octave of the Range, and each after it nearest the root before it,
+// low enough to have its fifth above it within the Range
+func rootsOf(chords []chord.Chord) []int {
+	roots := make([]int, len(chords))
+	for n, c := range chords {
+		root := lowestOf(c.Root)
+		if n > 0 {
+			for candidate := root + 12; candidate <= Highest-7; candidate += 12 {
+				if abs(candidate-roots[n-1]) < abs(root-roots[n-1]) {
+					root = candidate
+				}
+			}
+		}
+		roots[n] = root
+	}
+	return roots
+}
+
+// walkOf a chord from its root, the root and then the next two tones of the chord above it, or below it if they'd be beyond the Range
+func walkOf(c chord.Chord, root int) []int {
+	steps := []int{root}
+	up := tonesFrom(c, root, 1)
+	if len(up) >= 2 && up[1] <= Highest-1 {
+		return append(steps, up[:2]...)
+	}
+	down := tonesFrom(c, root, -1)
+	if len(down) >= 2 && down[1] >= Lowest+1 {
+		return append(steps, down[:2]...)
+	}
+	return append(steps, root, root)
+}
+
+// tonesFrom a MIDI note number, the tones of a chord after it in a direction, up if positive or down if negative, within two octaves
+func tonesFrom(c chord.Chord, from int, direction int) []int {
+	var steps []int
+	for step := from + direction; abs(step-from) <= 24; step += direction {
+		if c.Contains(classOf(step)) && (len(steps) == 0 || classOf(step) != classOf(from)) {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+// approachOf the next root from the note before it, by the step of the scale adjacent to the root on the side of the note before it,
+// or if the scale has no such step, or it's the note before, by a semitone
+func approachOf(from, next int, in scale.Scale) int {
+	direction := -1 // approach from below
+	if from > next {
+		direction = 1
+	}
+	for step := next + direction; abs(step-next) <= 2; step += direction {
+		if in.Contains(classOf(step)) {
+			if step != from && step >= Lowest && step <= Highest {
+				return step
+			}
+			break
+		}
+	}
+	if next+direction < Lowest || next+direction > Highest {
+		return next - direction
+	}
+	return next + direction
+}
+
+// fifthOf a chord below its root, or above it if that would be below the Range, or the root an octave up or down if the chord has no fifth
+func fifthOf(c chord.Chord, root int) int {
+	class, ok := c.ClassAt(chord.I5)
+	if !ok || class == note.Nil {
+		if root+12 <= Highest {
+			return root + 12
+		}
+		return root - 12
+	}
+	step := root - 1
+	for classOf(step) != class {
+		step--
+	}
+	if step < Lowest {
+		step += 12
+	}
+	return step
+}
+
+// scaleOf a key, its major or minor scale
+func scaleOf(k key.Key) scale.Scale {
+	mode := "major"
+	if k.Mode == key.Minor {
+		mode = "minor"
+	}
+	return scale.Of(k.Root.String(k.AdjSymbol) + " " + mode)
+}
+
+// lowestOf a pitch class within the Range, as a MIDI note number
+func lowestOf(class note.Class) int {
+	step := Lowest
+	for classOf(step) != class && step < Lowest+12 {
+		step++
+	}
+	return step
+}
+
+// noteOf a MIDI note number, from a beat for some beats, at the DownbeatDynamic on the first beat of a chord, else the BeatDynamic
+func noteOf(step int, beat, beats float64) melody.Note {
+	dynamic := BeatDynamic
+	if math.Mod(beat, BeatsPerChord) == 0 {
+		dynamic = DownbeatDynamic
+	}
+	return melody.Note{Class: classOf(step), Octave: note.Octave(step/12 - 1), Beat: beat, Beats: beats, Dynamic: dynamic}
+}
+
+// classOf a MIDI note number
+func classOf(step int) note.Class {
+	return note.Class(step%12 + 1)
+}
+
+// abs value of some semitones
+func abs(semitones int) int {
+	if semitones < 0 {
+		return -semitones
+	}
+	return semitones
+}
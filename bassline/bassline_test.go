@@ -0,0 +1,120 @@
+// A bass line is the lowest part of a piece, sounding the roots of its chords and leading from each chord to the next.
+package bassline
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestGenerate_Walking(t *testing.T) {
+	l := Generate(progression.Of("C", "Am", "F", "G"), Walking)
+	assert.Equal(t, key.Of("C"), l.Key)
+	assert.Equal(t, Walking, l.Style)
+	assert.Equal(t, 16, len(l.Notes))
+	assert.Equal(t, melody.Note{Class: note.C, Octave: 2, Beat: 0, Beats: 1, Dynamic: DownbeatDynamic, Articulation: melody.Legato}, l.Notes[0])
+	assert.Equal(t, melody.Note{Class: note.B, Octave: 1, Beat: 3, Beats: 1, Dynamic: BeatDynamic, Articulation: melody.Legato}, l.Notes[3])
+	assert.Equal(t, "C2 E2 G2 B1 | A1 C2 E2 G1 | F1 A1 C2 A1 | G1 B1 D2 C#2", l.String())
+}
+
+func TestGenerate_RootFifth(t *testing.T) {
+	l := Generate(progression.Of("C", "Am", "F", "G"), RootFifth)
+	assert.Equal(t, 8, len(l.Notes))
+	assert.Equal(t, melody.Note{Class: note.G, Octave: 1, Beat: 2, Beats: 2, Dynamic: BeatDynamic, Articulation: melody.Staccato | melody.Tenuto}, l.Notes[1])
+	assert.Equal(t, "C2 G1 | A1 E1 | F1 C2 | G1 D2", l.String())
+}
+
+func TestGenerate_Pedal(t *testing.T) {
+	l := Generate(progression.Of("Bb", "Eb", "F7", "Bb"), Pedal)
+	assert.Equal(t, []melody.Note{
+		{Class: note.As, Octave: 1, Beat: 0, Beats: 4, Dynamic: DownbeatDynamic, Articulation: melody.Tenuto},
+		{Class: note.As, Octave: 1, Beat: 4, Beats: 4, Dynamic: DownbeatDynamic, Articulation: melody.Tenuto},
+		{Class: note.As, Octave: 1, Beat: 8, Beats: 4, Dynamic: DownbeatDynamic, Articulation: melody.Tenuto},
+		{Class: note.As, Octave: 1, Beat: 12, Beats: 4, Dynamic: DownbeatDynamic, Articulation: melody.Tenuto},
+	}, l.Notes)
+	assert.Equal(t, "Bb1 | Bb1 | Bb1 | Bb1", l.String())
+}
+
+func TestGenerate_Range(t *testing.T) {
+	for _, names := range [][]string{
+		{"C", "Am", "F", "G"},
+		{"Dm7", "G7", "Cmaj7", "A7"},
+		{"Bb", "Eb", "F7", "Bb"},
+		{"Em", "Am", "B7", "Em"},
+		{"G", "G", "G", "G", "G", "G"},
+	} {
+		for _, style := range []Style{Walking, RootFifth, Pedal} {
+			for _, n := range Generate(progression.Of(names...), style).MIDINotes() {
+				assert.True(t, n.Number >= Lowest && n.Number <= Highest, "%v %s: %d", names, style, n.Number)
+			}
+		}
+	}
+}
+
+func TestGenerate_Empty(t *testing.T) {
+	l := Generate(progression.Of(), Walking)
+	assert.Equal(t, 0, len(l.Notes))
+	assert.Equal(t, "", l.String())
+}
+
+func TestStyleNamed(t *testing.T) {
+	s, err := StyleNamed("walking")
+	assert.Nil(t, err)
+	assert.Equal(t, Walking, s)
+	s, err = StyleNamed(" Root-Fifth ")
+	assert.Nil(t, err)
+	assert.Equal(t, RootFifth, s)
+	s, err = StyleNamed("pedal")
+	assert.Nil(t, err)
+	assert.Equal(t, Pedal, s)
+	_, err = StyleNamed("slap")
+	assert.Equal(t, "unknown style \"slap\", expected one of walking, root-fifth, pedal", err.Error())
+}
+
+func TestStyle_String(t *testing.T) {
+	assert.Equal(t, "walking", Walking.String())
+	assert.Equal(t, "root-fifth", RootFifth.String())
+	assert.Equal(t, "pedal", Pedal.String())
+	assert.Equal(t, "", Style(3).String())
+}
+
+func TestStyle_Articulation(t *testing.T) {
+	assert.Equal(t, melody.Legato, Walking.Articulation())
+	assert.Equal(t, melody.Staccato|melody.Tenuto, RootFifth.Articulation())
+	assert.Equal(t, melody.Tenuto, Pedal.Articulation())
+	assert.Equal(t, melody.Articulation(0), Style(3).Articulation())
+}
+
+//
+// Private
+//
+
+func TestRootsOf(t *testing.T) {
+	assert.Equal(t, []int{36, 33, 29, 31}, rootsOf(progression.Of("C", "Am", "F", "G").Chords))
+	assert.Equal(t, []int{28, 33, 35, 40, 45}, rootsOf(progression.Of("E", "A", "B", "E", "A").Chords))
+}
+
+func TestWalkOf(t *testing.T) {
+	assert.Equal(t, []int{36, 40, 43}, walkOf(chord.Of("C"), 36))
+	assert.Equal(t, []int{48, 43, 40}, walkOf(chord.Of("C"), 48)) // G3 and E3 below, since the E and G above would be beyond the range
+}
+
+func TestApproachOf(t *testing.T) {
+	c := scale.Of("C major")
+	assert.Equal(t, 35, approachOf(43, 33, c)) // B1 above A1, from G2
+	assert.Equal(t, 37, approachOf(38, 36, c)) // C#2 above C2, from D2, since D2 is the note before
+	assert.Equal(t, 29, approachOf(28, 31, c)) // F1 below G1, from E1
+}
+
+func TestFifthOf(t *testing.T) {
+	assert.Equal(t, 31, fifthOf(chord.Of("C"), 36))
+	assert.Equal(t, 38, fifthOf(chord.Of("G"), 31)) // above, since D1 is below the range
+	assert.Equal(t, 48, fifthOf(chord.Chord{Root: note.C, Tones: []chord.Tone{{Degree: chord.Degree{Interval: chord.I1}, Class: note.C}}}, 36))
+}
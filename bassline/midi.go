@@ -0,0 +1,25 @@
+// Bass lines are written as MIDI, each note shaped by its dynamic and articulation, and by the dynamics of the whole line, on the first channel
+package bassline
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// Notes of the Line as MIDI, e.g. to humanize before writing them
+func (l Line) MIDINotes() []midi.Note {
+	return l.Dynamics.Apply(l.Tune().MIDINotes())
+}
+
+// Tune of the Line, its notes in its key, in 4/4, e.g. to render it as sheet music in the bass clef
+func (l Line) Tune() melody.Tune {
+	return melody.Tune{Key: l.Key, Meter: meter.Common, Notes: l.Notes}
+}
+
+// WriteMIDI of the Line
+func (l Line) WriteMIDI(w io.Writer) error {
+	return midi.Write(w, l.MIDINotes())
+}
@@ -0,0 +1,58 @@
+// Bass lines are written as MIDI, each note shaped by its dynamic and articulation, and by the dynamics of the whole line, on the first channel
+package bassline
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestLine_MIDINotes(t *testing.T) {
+	assert.Equal(t, []midi.Note{
+		{Number: 36, Velocity: 104, Start: 0, Duration: 720},
+		{Number: 31, Velocity: 88, Start: 960, Duration: 720},
+		{Number: 41, Velocity: 104, Start: 1920, Duration: 720},
+		{Number: 36, Velocity: 88, Start: 2880, Duration: 720},
+	}, Generate(progression.Of("C", "F"), RootFifth).MIDINotes())
+}
+
+func TestLine_MIDINotes_Legato(t *testing.T) {
+	notes := Generate(progression.Of("C"), Walking).MIDINotes()
+	assert.Equal(t, midi.Quarter+melody.LegatoOverlap, notes[0].Duration)
+	assert.Equal(t, midi.Quarter, notes[3].Duration)
+}
+
+func TestLine_Tune(t *testing.T) {
+	l := Generate(progression.Of("C", "G"), Pedal)
+	tune := l.Tune()
+	assert.Equal(t, key.Of("C"), tune.Key)
+	assert.Equal(t, meter.Common, tune.Meter)
+	assert.Equal(t, l.Notes, tune.Notes)
+}
+
+func TestLine_MIDINotes_Dynamics(t *testing.T) {
+	l := Generate(progression.Of("C", "F"), RootFifth)
+	l.Dynamics = dynamics.Phrase{From: dynamics.P, To: dynamics.F}
+	var velocities []int
+	for _, n := range l.MIDINotes() {
+		velocities = append(velocities, n.Velocity)
+	}
+	assert.Equal(t, []int{64, 72, 104, 106}, velocities)
+}
+
+func TestLine_WriteMIDI(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, Generate(progression.Of("C", "Am", "F", "G"), Walking).WriteMIDI(&out))
+	notes, err := midi.Read(&out)
+	assert.Nil(t, err)
+	assert.Equal(t, 16, len(notes))
+	assert.Equal(t, 36, notes[0].Number)
+}
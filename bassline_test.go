@@ -0,0 +1,58 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/bassline"
+	"github.com/go-music-theory/music-theory/dynamics"
+)
+
+func TestGenerateBassline(t *testing.T) {
+	l, err := generateBassline([]string{"C", "Am", "F", "G"}, "root-fifth", "")
+	assert.Nil(t, err)
+	assert.Equal(t, bassline.RootFifth, l.Style)
+	assert.Equal(t, "C2 G1 | A1 E1 | F1 C2 | G1 D2", l.String())
+	_, err = generateBassline([]string{"C"}, "slap", "")
+	assert.NotNil(t, err)
+	_, err = generateBassline([]string{"Hb"}, "walking", "")
+	assert.NotNil(t, err)
+	l, err = generateBassline([]string{"C"}, "walking", "pp..ff")
+	assert.Nil(t, err)
+	assert.Equal(t, dynamics.Phrase{From: dynamics.PP, To: dynamics.FF}, l.Dynamics)
+	_, err = generateBassline([]string{"C"}, "walking", "fff")
+	assert.True(t, errors.Is(err, dynamics.ErrUnknownLevel))
+}
+
+func TestWriteBasslineFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bassline")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	l, err := generateBassline([]string{"C", "G"}, "walking", "")
+	assert.Nil(t, err)
+	path := filepath.Join(dir, "bass.mid")
+	assert.Nil(t, writeBasslineFile(path, l))
+	b, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "MThd", string(b[:4]))
+	assert.NotNil(t, writeBasslineFile(filepath.Join(dir, "missing", "bass.mid"), l))
+}
+
+func TestBasslineExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bassline")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assertExitCode(t, 0, "", "bassline")
+	assertExitCode(t, 0, "", "bassline", "C", "G")
+	assertExitCode(t, 0, "", "bassline", "--style", "pedal", "--midi", filepath.Join(dir, "bass.mid"), "C", "G")
+	assertExitCode(t, 1, "Error occurred: unknown style \"slap\", expected one of walking, root-fifth, pedal\n", "bassline", "--style", "slap", "C")
+	assertExitCode(t, 1, "Error occurred: unknown root \"Hb\" at position 0 of chord \"Hb\"\n", "bassline", "Hb")
+	assertExitCode(t, 0, "", "bassline", "--dynamics", "mp..ff", "--midi", filepath.Join(dir, "crescendo.mid"), "C", "G")
+	assertExitCode(t, 1, "Error occurred: unknown dynamic \"loud\", expected one of pp, p, mp, mf, f, ff\n", "bassline", "--dynamics", "loud", "C")
+}
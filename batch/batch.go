@@ -0,0 +1,239 @@
+// Batch analysis of many songs streams a row of CSV of each chord, or of each bar, of each song as it's analyzed,
+// its key, numeral, function and chromaticism, so the results of a batch job load into pandas or R without custom parsing,
+// e.g. pandas.read_csv("analysis.csv") or read.csv("analysis.csv").
+//
+// https://en.wikipedia.org/wiki/Comma-separated_values
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package batch
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/song"
+)
+
+// Per what each row of the analysis is, a chord or a bar
+type Per int
+
+const (
+	PerChord Per = iota
+	PerBar
+)
+
+// ErrUnknownPer when naming what each row is, other than "chord" or "bar"
+var ErrUnknownPer = errors.New("unknown row")
+
+// ChordColumns of the header of a row per chord
+var ChordColumns = []string{"source", "title", "key", "section", "bar", "beat", "beats", "chord", "root", "bass", "type", "numeral", "function", "chromatic"}
+
+// BarColumns of the header of a row per bar
+var BarColumns = []string{"source", "title", "key", "section", "bar", "chords", "changes", "numerals", "chromatic"}
+
+// PerOf a name, "chord" or "bar", what each row is, returning ErrUnknownPer of any other
+func PerOf(name string) (Per, error) {
+	switch strings.ToLower(name) {
+	case "chord":
+		return PerChord, nil
+	case "bar":
+		return PerBar, nil
+	}
+	return PerChord, fmt.Errorf("%w %q, expected chord or bar", ErrUnknownPer, name)
+}
+
+// Writer of the analysis of songs, streaming the rows of each as CSV, after a header of its columns
+type Writer struct {
+	out    *csv.Writer
+	per    Per
+	header bool
+}
+
+// NewWriter of the analysis of songs, a row per chord or per bar
+func NewWriter(w io.Writer, per Per) *Writer {
+	return &Writer{out: csv.NewWriter(w), per: per}
+}
+
+// Write the rows of the analysis of a song, from a source, e.g. the path of its file, in its key, or else the key of its chords,
+// and flush them, so a batch job streams each song as it's analyzed
+func (w *Writer) Write(source string, s song.Song) error {
+	if !w.header {
+		columns := ChordColumns
+		if w.per == PerBar {
+			columns = BarColumns
+		}
+		if err := w.out.Write(columns); err != nil {
+			return err
+		}
+		w.header = true
+	}
+	a := analysisOf(s)
+	var err error
+	if w.per == PerBar {
+		err = w.writeBars(source, s, a)
+	} else {
+		err = w.writeChords(source, s, a)
+	}
+	if err != nil {
+		return err
+	}
+	w.out.Flush()
+	return w.out.Error()
+}
+
+//
+// Private
+//
+
+// analysis of a song, its key, and each chord placed in its bars, in order
+type analysis struct {
+	key    key.Key
+	chords []placed
+}
+
+// placed chord of a song, in a section, at a bar counted from 1 of the first of the song, its beats until the next, and its numeral in the key
+type placed struct {
+	section string
+	bar     int
+	bc      song.BarChord
+	beats   float64
+	numeral string
+}
+
+// analysisOf a song, in its key, or else the key of its chords, each chord placed lasting until the next, or the end of the song
+func analysisOf(s song.Song) analysis {
+	beatsPerBar := float64(s.Meter.Beats)
+	if beatsPerBar <= 0 {
+		beatsPerBar = 4
+	}
+	var a analysis
+	var starts []float64
+	p := progression.Progression{}
+	bar := 0
+	for _, sec := range s.Sections {
+		for _, b := range sec.Bars {
+			for _, bc := range b.Chords {
+				a.chords = append(a.chords, placed{section: sec.Name, bar: bar + 1, bc: bc})
+				starts = append(starts, float64(bar)*beatsPerBar+bc.Beat-1)
+				p.Chords = append(p.Chords, bc.Chord)
+			}
+			bar++
+		}
+	}
+	a.key = s.Key
+	if a.key.Mode == key.Nil {
+		a.key = p.Key()
+	}
+	numerals := progression.Song{Key: a.key, Progression: p}.Numerals()
+	for n := range a.chords {
+		end := float64(bar) * beatsPerBar
+		if n+1 < len(starts) {
+			end = starts[n+1]
+		}
+		a.chords[n].beats = end - starts[n]
+		a.chords[n].numeral = numerals[n]
+	}
+	return a
+}
+
+// writeChords of a song, a row of each chord placed
+func (w *Writer) writeChords(source string, s song.Song, a analysis) error {
+	for _, pc := range a.chords {
+		c := pc.bc.Chord
+		bass := c.Bass
+		if bass == note.Nil {
+			bass = c.Root
+		}
+		row := []string{source, s.Title, keyName(a.key), pc.section, strconv.Itoa(pc.bar), formatFloat(pc.bc.Beat), formatFloat(pc.beats),
+			pc.bc.Name, c.Root.String(c.AdjSymbol), bass.String(c.AdjSymbol), c.Quality().String(), pc.numeral, functionOf(c, a.key),
+			strconv.Itoa(chromaticOf(c, a.key))}
+		if err := w.out.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBars of a song, a row of each bar, of the names of its chords, their numerals, and the count of their tones outside the key
+func (w *Writer) writeBars(source string, s song.Song, a analysis) error {
+	n := 0
+	bar := 0
+	for _, sec := range s.Sections {
+		for _, b := range sec.Bars {
+			bar++
+			var names, numerals []string
+			chromatic := 0
+			for range b.Chords {
+				pc := a.chords[n]
+				names = append(names, pc.bc.Name)
+				numerals = append(numerals, pc.numeral)
+				chromatic += chromaticOf(pc.bc.Chord, a.key)
+				n++
+			}
+			row := []string{source, s.Title, keyName(a.key), sec.Name, strconv.Itoa(bar), strings.Join(names, " "), strconv.Itoa(len(b.Chords)),
+				strings.Join(numerals, " "), strconv.Itoa(chromatic)}
+			if err := w.out.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keyName of a key, e.g. "C major", or empty of the Mode Nil
+func keyName(k key.Key) string {
+	if k.Mode == key.Nil {
+		return ""
+	}
+	return k.Root.String(k.AdjSymbol) + " " + strings.ToLower(k.Mode.String())
+}
+
+// functionOf a chord in a key, "tonic", "subdominant" or "dominant", or empty if it's none of them
+func functionOf(c chord.Chord, k key.Key) string {
+	switch {
+	case k.Mode == key.Nil:
+		return ""
+	case c.IsTonicFunction(k):
+		return "tonic"
+	case c.IsSubdominantFunction(k):
+		return "subdominant"
+	case c.IsDominantFunction(k):
+		return "dominant"
+	}
+	return ""
+}
+
+// chromaticOf a chord in a key, the count of its tones outside the scale of the key, or 0 of the Mode Nil
+func chromaticOf(c chord.Chord, k key.Key) (count int) {
+	if k.Mode == key.Nil {
+		return 0
+	}
+	s := k.Scale()
+	for _, class := range c.ToneSet().Classes() {
+		if !s.Contains(class) {
+			count++
+		}
+	}
+	return
+}
+
+// formatFloat of a beat, without trailing zeros, e.g. "2.5" or "3"
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,73 @@
+// Batch analysis of many songs streams a row of CSV of each chord, or of each bar, of each song as it's analyzed,
+package batch
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/song"
+)
+
+func TestPerOf(t *testing.T) {
+	per, err := PerOf("chord")
+	assert.Nil(t, err)
+	assert.Equal(t, PerChord, per)
+	per, err = PerOf("Bar")
+	assert.Nil(t, err)
+	assert.Equal(t, PerBar, per)
+	_, err = PerOf("beat")
+	assert.True(t, errors.Is(err, ErrUnknownPer))
+	assert.Equal(t, `unknown row "beat", expected chord or bar`, err.Error())
+}
+
+func TestWriter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, PerChord)
+	assert.Nil(t, w.Write("autumn-leaves.yaml", exampleSong()))
+	assert.Equal(t, `source,title,key,section,bar,beat,beats,chord,root,bass,type,numeral,function,chromatic
+autumn-leaves.yaml,Autumn Leaves,G major,verse,1,1,2,Am7,A,A,"minor triad, minor seventh",ii7,subdominant,0
+autumn-leaves.yaml,Autumn Leaves,G major,verse,1,3,2,D7,D,D,"major triad, minor seventh",V7,dominant,0
+autumn-leaves.yaml,Autumn Leaves,G major,verse,2,1,4,Gmaj7,G,G,"major triad, major seventh",Imaj7,tonic,0
+autumn-leaves.yaml,Autumn Leaves,G major,bridge,3,1,3,C/E,C,E,major triad,IV,subdominant,0
+autumn-leaves.yaml,Autumn Leaves,G major,bridge,3,4,5,Eb,Eb,Eb,major triad,bVI,,2
+`, buf.String())
+	buf.Reset()
+	assert.Nil(t, w.Write("again.yaml", exampleSong()))
+	assert.NotContains(t, buf.String(), "source,", "the header only once")
+}
+
+func TestWriter_Write_PerBar(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, NewWriter(&buf, PerBar).Write("autumn-leaves.yaml", exampleSong()))
+	assert.Equal(t, `source,title,key,section,bar,chords,changes,numerals,chromatic
+autumn-leaves.yaml,Autumn Leaves,G major,verse,1,Am7 D7,2,ii7 V7,0
+autumn-leaves.yaml,Autumn Leaves,G major,verse,2,Gmaj7,1,Imaj7,0
+autumn-leaves.yaml,Autumn Leaves,G major,bridge,3,C/E Eb,2,IV bVI,2
+autumn-leaves.yaml,Autumn Leaves,G major,bridge,4,,0,,0
+`, buf.String())
+}
+
+func TestWriter_Write_DetectsKey(t *testing.T) {
+	s := song.New("Untitled", key.Key{})
+	s.Meter, _ = meter.Parse("3/4")
+	s.Add("A", song.BarOf(3, "Am"), song.BarOf(3, "F", "E7"), song.BarOf(3, "Am"))
+	var buf bytes.Buffer
+	assert.Nil(t, NewWriter(&buf, PerChord).Write("", s))
+	assert.Contains(t, buf.String(), ",Untitled,A minor,A,2,2.5,1.5,E7,E,E,\"major triad, minor seventh\",V7,dominant,1\n")
+}
+
+//
+// Private
+//
+
+func exampleSong() song.Song {
+	s := song.New("Autumn Leaves", key.Of("G major"))
+	s.Add("verse", song.BarOf(4, "Am7", "D7"), song.BarOf(4, "Gmaj7"))
+	s.Add("bridge", song.BarOf(4, "C/E", ".", ".", "Eb"), song.Bar{})
+	return s
+}
@@ -0,0 +1,68 @@
+// Candidates are the ranked results of a detection, e.g. of the chord of some notes, the scale of some tones, the key of a progression or melody,
+// or the cadence that ends a progression, each with the score of its detector and a confidence normalized over all of them,
+// so a UI can present the alternatives to the best guess, e.g. "A minor 31%, C major 29%".
+//
+// https://en.wikipedia.org/wiki/Ranking
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package candidate
+
+import (
+	"math"
+	"sort"
+)
+
+// Candidate of a detection, its value, e.g. a chord or key, the score of its detector, on whatever scale the detector scores,
+// and its confidence, from 0 to 1, its share of the positive scores of all the candidates ranked with it
+type Candidate[T any] struct {
+	Value      T
+	Score      float64
+	Confidence float64
+}
+
+// Of a value and its score, a candidate not yet ranked, of no confidence
+func Of[T any](value T, score float64) Candidate[T] {
+	return Candidate[T]{Value: value, Score: score}
+}
+
+// Rank candidates in descending order of their score, and then of the order given, e.g. of a catalog from the most to the least common,
+// each with its confidence, its share of the sum of the positive scores, or 0 if its score isn't positive
+func Rank[T any](candidates []Candidate[T]) []Candidate[T] {
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+	var sum float64
+	for _, c := range candidates {
+		sum += math.Max(c.Score, 0)
+	}
+	for n := range candidates {
+		candidates[n].Confidence = 0
+		if sum > 0 {
+			candidates[n].Confidence = math.Max(candidates[n].Score, 0) / sum
+		}
+	}
+	return candidates
+}
+
+// Best of some ranked candidates, the value of the first, and whether there are any
+func Best[T any](candidates []Candidate[T]) (T, bool) {
+	if len(candidates) == 0 {
+		var none T
+		return none, false
+	}
+	return candidates[0].Value, true
+}
+
+// Top of some ranked candidates, up to a limit of them, or all of them if the limit is 0, e.g. to list the alternatives
+func Top[T any](candidates []Candidate[T], limit int) []Candidate[T] {
+	if limit > 0 && limit < len(candidates) {
+		return candidates[:limit]
+	}
+	return candidates
+}
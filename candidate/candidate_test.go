@@ -0,0 +1,51 @@
+// Candidates are the ranked results of a detection, e.g. of the chord of some notes, the scale of some tones, the key of a progression or melody,
+package candidate
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestRank(t *testing.T) {
+	ranked := Rank([]Candidate[string]{Of("C major", 2), Of("A minor", 6), Of("G major", 2), Of("E minor", -1)})
+	assert.Equal(t, []Candidate[string]{
+		{Value: "A minor", Score: 6, Confidence: 0.6},
+		{Value: "C major", Score: 2, Confidence: 0.2},
+		{Value: "G major", Score: 2, Confidence: 0.2}, // after C major, as it's given
+		{Value: "E minor", Score: -1, Confidence: 0},
+	}, ranked)
+}
+
+func TestRank_NonePositive(t *testing.T) {
+	ranked := Rank([]Candidate[int]{Of(1, 0), Of(2, -1)})
+	assert.Equal(t, []Candidate[int]{{Value: 1}, {Value: 2, Score: -1}}, ranked)
+	assert.Equal(t, 0, len(Rank[int](nil)))
+}
+
+func TestBest(t *testing.T) {
+	best, ok := Best(Rank([]Candidate[string]{Of("C", 0.5), Of("Am7", 1)}))
+	assert.True(t, ok)
+	assert.Equal(t, "Am7", best)
+	best, ok = Best[string](nil)
+	assert.False(t, ok)
+	assert.Equal(t, "", best)
+}
+
+func TestTop(t *testing.T) {
+	ranked := Rank([]Candidate[string]{Of("C", 3), Of("Am", 2), Of("F", 1)})
+	assert.Equal(t, []string{"C", "Am"}, valuesOf(Top(ranked, 2)))
+	assert.Equal(t, []string{"C", "Am", "F"}, valuesOf(Top(ranked, 0)))
+	assert.Equal(t, []string{"C", "Am", "F"}, valuesOf(Top(ranked, 5)))
+}
+
+//
+// Private
+//
+
+func valuesOf(candidates []Candidate[string]) (values []string) {
+	for _, c := range candidates {
+		values = append(values, c.Value)
+	}
+	return
+}
@@ -0,0 +1,48 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-music-theory/music-theory/fretboard"
+	"github.com/go-music-theory/music-theory/key"
+)
+
+// suggestCapos for a key named, playing some shapes named, one of the fretboard.ShapeSetNames or shape names separated by commas, of a difficulty up to the most, or any of 0,
+// writing a table of each capo from the best, the key of the shapes played, their mean difficulty, and each chord of the key with the shape that plays it
+func suggestCapos(w io.Writer, keyName string, shapesName string, mostDifficulty int) error {
+	k, err := key.Parse(keyName)
+	if err != nil {
+		return err
+	}
+	shapes, err := fretboard.ShapesNamed(shapesName)
+	if err != nil {
+		return err
+	}
+	if mostDifficulty > 0 {
+		shapes = fretboard.Easier(shapes, mostDifficulty)
+	}
+	suggestions := fretboard.CapoSuggestions(k, shapes)
+	if len(suggestions) == 0 {
+		_, err = fmt.Fprintf(w, "No capo for %s with the shapes %s\n", keyName, shapesName)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CAPO\tSHAPES IN\tDIFFICULTY\tCHORDS")
+	for _, s := range suggestions {
+		var chords []string
+		for _, c := range s.Chords {
+			chords = append(chords, fmt.Sprintf("%s (%s)", c.Chord.Name(), c.Shape.Name))
+		}
+		capo := "none"
+		if s.Capo > 0 {
+			capo = strconv.Itoa(s.Capo)
+		}
+		fmt.Fprintf(tw, "%s\t%s %s\t%.1f\t%s\n", capo, s.Played.Root.String(s.Played.AdjSymbol), strings.ToLower(s.Played.Mode.String()), s.Difficulty(), strings.Join(chords, "  "))
+	}
+	return tw.Flush()
+}
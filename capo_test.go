@@ -0,0 +1,47 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestSuggestCapos(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, suggestCapos(&out, "Eb", "open", 0))
+	assert.Equal(t, "CAPO  SHAPES IN  DIFFICULTY  CHORDS\n"+
+		"1     D major    4.5         Eb (D)  Fm (Em)  Ab (G)  Bb (A)\n"+
+		"6     A major    5.3         Eb (A)  Ab (D)  Bb (E)\n"+
+		"3     C major    6.0         Eb (C)  Fm (Dm)  Gm (Em)  Bb (G)  Cm (Am)\n", out.String())
+	out.Reset()
+	assert.Nil(t, suggestCapos(&out, "G", "G,C,D", 0))
+	assert.Equal(t, "CAPO  SHAPES IN  DIFFICULTY  CHORDS\n"+
+		"5     D major    6.0         G (D)  C (G)\n"+
+		"7     C major    6.5         G (C)  D (G)\n"+
+		"none  G major    6.7         G (G)  C (C)  D (D)\n", out.String())
+	out.Reset()
+	assert.Nil(t, suggestCapos(&out, "F minor", "caged", 0))
+	assert.Equal(t, "No capo for F minor with the shapes caged\n", out.String())
+	assert.NotNil(t, suggestCapos(&out, "H major", "open", 0))
+	assert.NotNil(t, suggestCapos(&out, "Eb", "F", 0))
+}
+
+func TestSuggestCapos_MaxDifficulty(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, suggestCapos(&out, "Eb", "open", 6))
+	assert.Equal(t, "CAPO  SHAPES IN  DIFFICULTY  CHORDS\n"+
+		"6     A major    4.5         Eb (A)  Bb (E)\n", out.String())
+	out.Reset()
+	assert.Nil(t, suggestCapos(&out, "Eb", "G,C,D", 6))
+	assert.Equal(t, "No capo for Eb with the shapes G,C,D\n", out.String())
+}
+
+func TestCapoExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "capo")
+	assertExitCode(t, 0, "", "capo", "Eb")
+	assertExitCode(t, 0, "", "capo", "--shapes", "caged", "Bb")
+	assertExitCode(t, 0, "", "capo", "--max-difficulty", "5", "G")
+	assertExitCode(t, 1, "Error occurred: unknown shape \"F\", expected one of open, caged, or some of C, A, G, E, D, Am, Em, Dm\n", "capo", "--shapes", "F", "Eb")
+}
@@ -0,0 +1,30 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/satb"
+)
+
+// realizeChorale of some chord names in four parts, in a key, or if the key name is empty, the key of the progression
+func realizeChorale(names []string, keyName string) (satb.Chorale, error) {
+	var p progression.Progression
+	for _, name := range names {
+		c, err := chord.Parse(name)
+		if err != nil {
+			return satb.Chorale{}, err
+		}
+		p.Chords = append(p.Chords, c)
+	}
+	var k key.Key
+	if len(keyName) > 0 {
+		var err error
+		k, err = key.Parse(keyName)
+		if err != nil {
+			return satb.Chorale{}, err
+		}
+	}
+	return satb.Realize(p, k), nil
+}
@@ -0,0 +1,33 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+)
+
+func TestRealizeChorale(t *testing.T) {
+	c, err := realizeChorale([]string{"C", "F", "G7", "C"}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, key.Of("C"), c.Key)
+	assert.Equal(t, 4, len(c.Voicings))
+	c, err = realizeChorale([]string{"Am", "E", "Am"}, "C")
+	assert.Nil(t, err)
+	assert.Equal(t, key.Of("C"), c.Key)
+	_, err = realizeChorale([]string{"Hb"}, "")
+	assert.NotNil(t, err)
+	_, err = realizeChorale([]string{"C"}, "H major")
+	assert.NotNil(t, err)
+}
+
+func TestChoraleExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "satb")
+	assertExitCode(t, 0, "", "satb", "C", "F", "G7", "C")
+	assertExitCode(t, 0, "", "satb", "--key", "Em", "--format", "lilypond", "Em", "Am", "B7", "Em")
+	assertExitCode(t, 0, "", "satb", "--format", "musicxml", "Dm7", "G7", "C")
+	assertExitCode(t, 1, "Error occurred: unknown root \"Hb\" at position 0 of chord \"Hb\"\n", "satb", "Hb")
+	assertExitCode(t, 1, "Error occurred: unsupported value satb.Chorale in format \"svg\"\n", "satb", "--format", "svg", "C")
+}
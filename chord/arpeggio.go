@@ -0,0 +1,158 @@
+// An arpeggio is a chord whose tones are played one after another, in some pattern, rather than simultaneously.
+//
+// https://en.wikipedia.org/wiki/Arpeggio
+package chord
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ArpeggioOctave of the root of every arpeggio, i.e. middle C is the lowest C
+const ArpeggioOctave = note.Octave(4)
+
+// ErrUnknownPattern when naming a pattern that isn't built in, e.g. "sideways"
+var ErrUnknownPattern = errors.New("unknown pattern")
+
+// Arpeggio of a chord, its tones as concrete notes in the order of a pattern
+type Arpeggio struct {
+	Chord Chord
+	Notes []*note.Note
+}
+
+// Pattern orders the notes of an arpeggio, which are given in ascending order, without modifying them
+type Pattern func(notes []*note.Note) []*note.Note
+
+// Names of the built-in patterns, for PatternNamed
+var PatternNames = []string{"up", "down", "updown", "converge", "random"}
+
+// Arpeggiate a chord over some octaves, at least one, ascending from the root in the ArpeggioOctave, in the order of a pattern, e.g. Arpeggiate(Of("Cmaj7"), UpDown, 2)
+func Arpeggiate(c Chord, pattern Pattern, octaves int) Arpeggio {
+	if pattern == nil {
+		pattern = Up
+	}
+	return Arpeggio{Chord: c, Notes: pattern(ascendingNotesOf(c, octaves))}
+}
+
+// PatternNamed one of the PatternNames, with a seed for the random pattern, e.g. PatternNamed("updown", 0)
+func PatternNamed(name string, seed int64) (Pattern, error) {
+	switch name {
+	case "up":
+		return Up, nil
+	case "down":
+		return Down, nil
+	case "updown", "up-down":
+		return UpDown, nil
+	case "converge":
+		return Converge, nil
+	case "random":
+		return Random(seed), nil
+	}
+	return nil, fmt.Errorf("%w %q", ErrUnknownPattern, name)
+}
+
+// Up from the lowest note to the highest, e.g. C E G C'
+func Up(notes []*note.Note) []*note.Note {
+	return append([]*note.Note{}, notes...)
+}
+
+// Down from the highest note to the lowest, e.g. C' G E C
+func Down(notes []*note.Note) []*note.Note {
+	down := make([]*note.Note, len(notes))
+	for n, nt := range notes {
+		down[len(notes)-1-n] = nt
+	}
+	return down
+}
+
+// UpDown from the lowest note to the highest and back, without repeating either, so it can be looped, e.g. C E G C' G E
+func UpDown(notes []*note.Note) []*note.Note {
+	updown := Up(notes)
+	for n := len(notes) - 2; n > 0; n-- {
+		updown = append(updown, notes[n])
+	}
+	return updown
+}
+
+// Converge from the outside in, alternating between the lowest and highest notes remaining, e.g. C C' E G
+func Converge(notes []*note.Note) []*note.Note {
+	var converge []*note.Note
+	for low, high := 0, len(notes)-1; low <= high; low, high = low+1, high-1 {
+		converge = append(converge, notes[low])
+		if high > low {
+			converge = append(converge, notes[high])
+		}
+	}
+	return converge
+}
+
+// Random order of the notes, shuffled by a seed, so the same seed always gives the same order
+func Random(seed int64) Pattern {
+	return func(notes []*note.Note) []*note.Note {
+		return shuffledOf(notes, rand.New(rand.NewSource(seed)))
+	}
+}
+
+// RandomFrom a source of random numbers, shuffling the notes of each arpeggio by the next of them, so each arpeggio may differ from the last,
+// the same arpeggios of a source of the same numbers; the source is not safe for concurrent use unless it's built to be
+func RandomFrom(src rand.Source) Pattern {
+	r := rand.New(src)
+	return func(notes []*note.Note) []*note.Note {
+		return shuffledOf(notes, r)
+	}
+}
+
+//
+// Private
+//
+
+// shuffledOf notes, a copy of them shuffled by random numbers
+func shuffledOf(notes []*note.Note, r *rand.Rand) []*note.Note {
+	shuffled := Up(notes)
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// ascendingNotesOf a chord over some octaves, each tone in the lowest octave above the tone before it, beginning from the root, then repeated an octave higher for each additional octave
+func ascendingNotesOf(c Chord, octaves int) []*note.Note {
+	if octaves < 1 {
+		octaves = 1
+	}
+	rootStep := int(c.Root) + int(ArpeggioOctave)*12
+	prev := rootStep - 1
+	var voicing []int
+	for _, t := range c.OrderedTones() {
+		if t.Class == note.Nil {
+			continue
+		}
+		step := int(t.Class) + int(ArpeggioOctave)*12
+		for step <= prev {
+			step += 12
+		}
+		voicing = append(voicing, step)
+		prev = step
+	}
+	seen := make(map[int]bool)
+	var steps []int
+	for o := 0; o < octaves; o++ {
+		for _, step := range voicing {
+			if !seen[step+o*12] {
+				seen[step+o*12] = true
+				steps = append(steps, step+o*12)
+			}
+		}
+	}
+	sort.Ints(steps)
+	notes := make([]*note.Note, len(steps))
+	for n, step := range steps {
+		placed := noteOfStep(step)
+		notes[n] = &placed
+	}
+	return notes
+}
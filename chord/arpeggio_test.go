@@ -0,0 +1,105 @@
+// An arpeggio is a chord whose tones are played one after another, in some pattern, rather than simultaneously.
+package chord
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/internal/golden"
+)
+
+func TestArpeggiate(t *testing.T) {
+	assertArpeggio(t, "C4 E4 G4 B4", "Cmaj7", Up, 1)
+	assertArpeggio(t, "C4 E4 G4 B4 C5 E5 G5 B5", "Cmaj7", Up, 2)
+	assertArpeggio(t, "C4 E4 G4 B4", "Cmaj7", nil, 1)
+	assertArpeggio(t, "C4 E4 G4", "C", Up, 0)
+	assertArpeggio(t, "A4 C5 E5", "Am", Up, 1)
+}
+
+func TestArpeggiate_Extended(t *testing.T) {
+	assertArpeggio(t, "C4 E4 G4 A#4 D5", "C9", Up, 1)
+	assertArpeggio(t, "C4 E4 G4 A#4 C5 D5 E5 G5 A#5 D6", "C9", Up, 2)
+}
+
+func TestArpeggiate_Patterns(t *testing.T) {
+	assertArpeggio(t, "B4 G4 E4 C4", "Cmaj7", Down, 1)
+	assertArpeggio(t, "C4 E4 G4 B4 G4 E4", "Cmaj7", UpDown, 1)
+	assertArpeggio(t, "C4 B4 E4 G4", "Cmaj7", Converge, 1)
+	assertArpeggio(t, "C4 G4 E4", "C", Converge, 1)
+}
+
+func TestArpeggiate_Random(t *testing.T) {
+	a := Arpeggiate(Of("Cmaj7"), Random(7), 2)
+	b := Arpeggiate(Of("Cmaj7"), Random(7), 2)
+	assert.Equal(t, a, b)
+	assert.ElementsMatch(t, Arpeggiate(Of("Cmaj7"), Up, 2).Notes, a.Notes)
+	assert.NotEqual(t, Arpeggiate(Of("Cmaj7"), Up, 2).Notes, a.Notes)
+}
+
+func TestArpeggiate_Random_Golden(t *testing.T) {
+	var out strings.Builder
+	for seed := int64(0); seed < 8; seed++ {
+		fmt.Fprintf(&out, "%d %s\n", seed, arpeggioString(Arpeggiate(Of("Cmaj9"), Random(seed), 2)))
+	}
+	random := RandomFrom(rand.NewSource(42))
+	for n := 0; n < 4; n++ {
+		fmt.Fprintf(&out, "from 42 %s\n", arpeggioString(Arpeggiate(Of("Cmaj9"), random, 2)))
+	}
+	golden.Assert(t, "random", out.String())
+}
+
+func TestArpeggiate_RandomFrom(t *testing.T) {
+	random := RandomFrom(rand.NewSource(7))
+	a := Arpeggiate(Of("Cmaj7"), random, 2)
+	assert.Equal(t, Arpeggiate(Of("Cmaj7"), Random(7), 2), a, "the first of the same seed")
+	assert.ElementsMatch(t, a.Notes, Arpeggiate(Of("Cmaj7"), random, 2).Notes)
+}
+
+func TestArpeggiate_Empty(t *testing.T) {
+	assert.Equal(t, 0, len(Arpeggiate(Chord{}, UpDown, 2).Notes))
+	assert.Equal(t, 0, len(Arpeggiate(Chord{}, Converge, 2).Notes))
+}
+
+func TestPatternNamed(t *testing.T) {
+	for _, name := range PatternNames {
+		p, err := PatternNamed(name, 1)
+		assert.Nil(t, err)
+		assert.NotNil(t, p)
+	}
+	p, err := PatternNamed("up-down", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "C4 E4 G4 E4", arpeggioString(Arpeggiate(Of("C"), p, 1)))
+	_, err = PatternNamed("sideways", 0)
+	assert.True(t, errors.Is(err, ErrUnknownPattern))
+	assert.Equal(t, "unknown pattern \"sideways\"", err.Error())
+}
+
+func TestArpeggio_ToYAML(t *testing.T) {
+	assert.Equal(t, "chord:\n  root: C\n  tones:\n    1: C\n    3: E\n    5: G\nnotes:\n- C4\n- E4\n- G4\n- E4\n", Arpeggiate(Of("C"), UpDown, 1).ToYAML())
+}
+
+func TestArpeggio_ToJSON(t *testing.T) {
+	assert.Equal(t, `{"chord":{"root":"C","tones":{"1":"C","3":"Eb","5":"G"}},"notes":["G4","Eb4","C4"]}`, Arpeggiate(Of("Cm"), Down, 1).ToJSON())
+}
+
+//
+// Private
+//
+
+func assertArpeggio(t *testing.T, expect string, name string, pattern Pattern, octaves int) {
+	assert.Equal(t, expect, arpeggioString(Arpeggiate(Of(name), pattern, octaves)))
+}
+
+func arpeggioString(a Arpeggio) string {
+	var names []string
+	for _, n := range a.Notes {
+		names = append(names, n.Class.String(a.Chord.AdjSymbol)+strconv.Itoa(int(n.Octave)))
+	}
+	return strings.Join(names, " ")
+}
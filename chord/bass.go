@@ -0,0 +1,57 @@
+// The bass of a chord is its root, unless it's a slash chord over another note, e.g. C/E, or an inversion with another of its tones lowest, e.g. the first inversion of C
+package chord
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Over another note in the bass, a slash chord, e.g. C/E of Of("C").Over(note.E), or in root position of its own root or Nil
+func (this Chord) Over(bass note.Class) Chord {
+	c := this.Copy()
+	if bass == c.Root {
+		bass = note.Nil
+	}
+	c.Bass = bass
+	return c
+}
+
+// Inversion of the chord, with the nth of its tones in ascending order from the root in the bass, e.g. C/E of the first inversion of C, or root position of 0
+func (this Chord) Inversion(n int) Chord {
+	var classes []note.Class
+	for _, t := range this.OrderedTones() {
+		if t.Class != note.Nil {
+			classes = append(classes, t.Class)
+		}
+	}
+	if len(classes) == 0 {
+		return this.Over(note.Nil)
+	}
+	return this.Over(classes[(n%len(classes)+len(classes))%len(classes)])
+}
+
+// Lowest note of the chord, its bass if it has one, else its root, e.g. E of C/E
+func (this Chord) Lowest() note.Class {
+	if this.Bass != note.Nil {
+		return this.Bass
+	}
+	return this.Root
+}
+
+//
+// Private
+//
+
+// bassAndRemaining of a name, e.g. E and "Cm7" of "Cm7/E", or Nil and the whole name if it doesn't end with a slash and a note, e.g. "C6/9"
+func bassAndRemaining(name string) (note.Class, string) {
+	slash := strings.LastIndex(name, "/")
+	if slash < 0 {
+		return note.Nil, name
+	}
+	bass, remaining := note.RootAndRemaining(strings.TrimSpace(name[slash+1:]))
+	if bass == note.Nil || len(remaining) > 0 {
+		return note.Nil, name
+	}
+	return bass, strings.TrimSpace(name[:slash])
+}
@@ -0,0 +1,62 @@
+// The bass of a chord is its root, unless it's a slash chord over another note, e.g. C/E, or an inversion with another of its tones lowest, e.g. the first inversion of C
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestOf_SlashChord(t *testing.T) {
+	c := Of("Cm7/Bb")
+	assert.Equal(t, note.C, c.Root)
+	assert.Equal(t, note.As, c.Bass)
+	assert.Equal(t, Of("Cm7").Tones, c.Tones)
+	assert.Equal(t, note.E, Of("C / E").Bass)
+	assert.Equal(t, note.Nil, Of("C/C").Bass)
+	assert.Equal(t, note.Nil, Of("C6/9").Bass)
+	assert.Equal(t, note.D, classAt(Of("C6/9"), I9))
+}
+
+func TestParse_SlashChord(t *testing.T) {
+	c, err := Parse("Dm7/C", WithStrict())
+	assert.Nil(t, err)
+	assert.Equal(t, note.C, c.Bass)
+	_, err = Parse("C/H")
+	assert.NotNil(t, err)
+}
+
+func TestChord_Over(t *testing.T) {
+	assert.Equal(t, "F/G", Of("F").Over(note.G).Name())
+	assert.Equal(t, note.Nil, Of("F/G").Over(note.F).Bass)
+	assert.Equal(t, note.Nil, Of("F").Bass)
+}
+
+func TestChord_Inversion(t *testing.T) {
+	assert.Equal(t, "Am7/C", Of("Am7").Inversion(1).Name())
+	assert.Equal(t, "Am7/G", Of("Am7").Inversion(3).Name())
+	assert.Equal(t, "Am7/G", Of("Am7").Inversion(-1).Name())
+	assert.Equal(t, "Am7", Of("Am7/E").Inversion(0).Name())
+	assert.Equal(t, "C/G", Of("C").Inversion(5).Name())
+}
+
+func TestChord_Lowest(t *testing.T) {
+	assert.Equal(t, note.E, Of("C/E").Lowest())
+	assert.Equal(t, note.C, Of("C").Lowest())
+}
+
+func TestChord_Bass_Transpose(t *testing.T) {
+	c := Of("C/E").Transpose(2)
+	assert.Equal(t, note.D, c.Root)
+	assert.Equal(t, note.Fs, c.Bass)
+	assert.Equal(t, note.Nil, Of("C").Transpose(2).Bass)
+}
+
+func TestChord_Bass_Equal(t *testing.T) {
+	assert.True(t, Of("C/E").Equal(Of("C major/E")))
+	assert.False(t, Of("C/E").Equal(Of("C")))
+	assert.True(t, Of("C/C").Equal(Of("C")))
+	assert.Equal(t, "C: 1=C 3=E 5=G /E", Of("C/E").Canonical())
+}
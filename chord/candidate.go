@@ -0,0 +1,39 @@
+// Chords are detected of some tones as ranked candidates, each found by a search, with its confidence among all those found
+package chord
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+// Candidates of a search of the catalog, each chord found ranked by its score, with its confidence among all those found, e.g. C9 before FM13 of C E G Bb D
+func Candidates(opts SearchOptions) []candidate.Candidate[Found] {
+	found := Search(opts)
+	candidates := make([]candidate.Candidate[Found], len(found))
+	for n, f := range found {
+		candidates[n] = candidate.Of(f, f.Score)
+	}
+	ranked := candidate.Rank(candidates)
+	if trace.Enabled() {
+		trace.Ranked("chord.candidates", toneNamesOf(opts.Tones, opts.AdjSymbol), ranked, func(f Found) string { return f.Chord.Name() })
+	}
+	return ranked
+}
+
+//
+// Private
+//
+
+// toneNamesOf some tones, spelled with an accidental, in ascending order from C, e.g. "C E G"
+func toneNamesOf(tones toneset.Set, adjSymbol note.AdjSymbol) string {
+	classes := tones.Classes()
+	names := make([]string, len(classes))
+	for n, class := range classes {
+		names[n] = class.String(adjSymbol)
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,26 @@
+// Chords are detected of some tones as ranked candidates, each found by a search, with its confidence among all those found
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestCandidates(t *testing.T) {
+	candidates := Candidates(SearchOptions{Tones: toneset.Of(note.C, note.E, note.G, note.As, note.D), AdjSymbol: note.Flat})
+	assert.Equal(t, 3, len(candidates))
+	assert.Equal(t, "C9", candidates[0].Value.Chord.Name())
+	assert.Equal(t, 1.0, candidates[0].Score)
+	assert.InDelta(t, 0.41, candidates[0].Confidence, 0.01)
+	assert.Equal(t, "FM13", candidates[1].Value.Chord.Name())
+	assert.Equal(t, []note.Class{note.F, note.A}, candidates[1].Value.Extra)
+	assert.InDelta(t, 1, candidates[0].Confidence+candidates[1].Confidence+candidates[2].Confidence, 1e-9)
+}
+
+func TestCandidates_None(t *testing.T) {
+	assert.Equal(t, 0, len(Candidates(SearchOptions{})))
+}
@@ -2,6 +2,9 @@
 //
 // https://en.wikipedia.org/wiki/Chord_(music)
 //
+// A Chord is a value: no method changes the chord it's called on, and every chord returned has tones of its own, not shared with another.
+// Chords may be parsed, and forms registered, from any number of goroutines at once, e.g. by the handlers of a server.
+//
 // Credit
 //
 // Charney Kaye
@@ -14,40 +17,73 @@
 package chord
 
 import (
-	"gopkg.in/music-theory.v0/note"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/symbol"
 )
 
+// toneCapacity of the tones of a parsed chord, enough for most chords, so they needn't grow while its forms are applied
+const toneCapacity = 8
+
 // Chord in a particular key
 type Chord struct {
 	Root      note.Class
 	AdjSymbol note.AdjSymbol
-	Tones     map[Interval]note.Class
+	Tones     []Tone     // in ascending order of interval from the root, e.g. 1 3 5 7 9 of C9
+	Bass      note.Class // in the bass of a slash chord or inversion, e.g. E of C/E, or Nil if it's the root
 }
 
-// Of a particular key, e.g. Of("C minor 7")
-func Of(name string) Chord {
+// Of a particular key, e.g. Of("C minor 7"), with its symbols in ASCII or Unicode, e.g. Of("B♭⁷"), or with options, e.g. Of("Fis m7", WithLocale(locale.German))
+func Of(name string, options ...Option) Chord {
+	o := optionsOf(options)
 	c := Chord{}
-	c.parse(name)
+	c.parse(o.locale.Translate(symbol.Normalize(name)))
+	if o.spellingKey != nil {
+		c = c.SpelledIn(o.spellingKey)
+	}
 	return c
 }
 
-// Notes to obtain the notes from the Chord
-func (this *Chord) Notes() (notes []*note.Note) {
-	forAllIn(this.Tones, func(class note.Class) {
-		notes = append(notes, note.OfClass(class))
-	})
-	return
+// Parse a chord name, e.g. Parse("C minor 7"), returning a *ParseError if its root or any of its forms is unknown
+func Parse(name string, options ...Option) (Chord, error) {
+	o := optionsOf(options)
+	c := Of(name, options...)
+	normalized := symbol.Normalize(name)
+	translated := o.locale.Translate(normalized)
+	shift := len(normalized) - len(translated) // of each position after a note name translated from the locale
+	_, translated = bassAndRemaining(translated)
+	root, remaining := note.RootAndRemaining(translated)
+	if root == note.Nil {
+		return c, &ParseError{Name: name, Position: 0, Text: name, Err: ErrUnknownRoot}
+	}
+	if o.strict {
+		if i, text, err := strictErrorIn(remaining); i >= 0 {
+			at := shift + strings.LastIndex(translated, remaining) + i
+			position, text := symbol.Unnormalized(name, at, at+len(text))
+			return c, &ParseError{Name: name, Position: position, Text: text, Err: err}
+		}
+	} else if i, text := unknownFormIn(remaining); i >= 0 {
+		at := shift + strings.LastIndex(translated, remaining) + i
+		position, text := symbol.Unnormalized(name, at, at+len(text))
+		return c, &ParseError{Name: name, Position: position, Text: text, Err: ErrUnknownForm}
+	}
+	return c, nil
 }
 
 // Transpose a chord +/- semitones
 func (this Chord) Transpose(semitones int) Chord {
 	transposedChord := Chord{
 		AdjSymbol: this.AdjSymbol,
-		Tones:     make(map[Interval]note.Class),
+		Tones:     make([]Tone, len(this.Tones)),
 	}
 	transposedChord.Root, _ = this.Root.Step(semitones)
-	for interval, class := range this.Tones {
-		transposedChord.Tones[interval], _ = class.Step(semitones)
+	if this.Bass != note.Nil {
+		transposedChord.Bass, _ = this.Bass.Step(semitones)
+	}
+	for n, t := range this.Tones {
+		t.Class, _ = t.Class.Step(semitones)
+		transposedChord.Tones[n] = t
 	}
 	return transposedChord
 }
@@ -57,14 +93,21 @@ func (this Chord) Transpose(semitones int) Chord {
 //
 
 func (this *Chord) parse(name string) {
-	this.Tones = make(map[Interval]note.Class)
+	input := name
+	this.Tones = make([]Tone, 0, toneCapacity)
 
 	// determine whether the name is "sharps" or "flats"
 	this.AdjSymbol = note.AdjSymbolOf(name)
 
+	// parse any bass of a slash chord, and keep the chord before it
+	this.Bass, name = bassAndRemaining(name)
+
 	// parse the root, and keep the remaining string
 	this.Root, name = note.RootAndRemaining(name)
 
 	// parse the chord Form
-	this.parseForms(name)
+	this.parseForms(name, input)
+	if this.Bass == this.Root {
+		this.Bass = note.Nil
+	}
 }
@@ -10,7 +10,8 @@ import (
 	"gopkg.in/stretchr/testify.v1/assert"
 
 	"gopkg.in/music-theory.v0/key"
-	"gopkg.in/music-theory.v0/note"
+
+	"github.com/go-music-theory/music-theory/note"
 )
 
 func TestChordExpectations(t *testing.T) {
@@ -25,54 +26,161 @@ func TestChordExpectations(t *testing.T) {
 	for name, expect := range testExpectations.Chords {
 		actual := Of(name)
 		assert.Equal(t, expect.Root, actual.Root.String(actual.AdjSymbol), fmt.Sprintf("name:%v expect.Root:%v actual.Root:%v", name, expect.Root, actual.Root.String(actual.AdjSymbol)))
+		tones := classesOf(actual)
 		for i, c := range expect.Tones {
-			assert.Equal(t, c, actual.Tones[i].String(actual.AdjSymbol), fmt.Sprintf("name:%v expect.Tones[%v]:%v actual.Tones[%v]:%v", name, i, c, i, actual.Tones[i].String(actual.AdjSymbol)))
+			assert.Equal(t, c, tones[i].String(actual.AdjSymbol), fmt.Sprintf("name:%v expect.Tones[%v]:%v actual.Tones[%v]:%v", name, i, c, i, tones[i].String(actual.AdjSymbol)))
 		}
-		for i, c := range actual.Tones {
+		for i, c := range tones {
 			assert.Equal(t, expect.Tones[i], c.String(actual.AdjSymbol), fmt.Sprintf("name:%v actual.Tones[%v]:%v expect.Tones[%v]:%v", name, i, c.String(actual.AdjSymbol), i, expect.Tones[i]))
 		}
 	}
 }
 
-func TestNotes(t *testing.T) {
-	c := Of("Cm nondominant -5 +6 +7 +9")
-	assert.Equal(t, []*note.Note{
-		&note.Note{Class: note.Ds},
-		&note.Note{Class: note.A},
-		&note.Note{Class: note.As},
-		&note.Note{Class: note.D},
-	}, c.Notes())
+func TestParseExpectations(t *testing.T) {
+	testExpectations := testExpectationManifest{}
+	file, err := ioutil.ReadFile("testdata/expectations.yaml")
+	assert.Nil(t, err)
+
+	err = yaml.Unmarshal(file, &testExpectations)
+	assert.Nil(t, err)
+
+	for name := range testExpectations.Chords {
+		actual, err := Parse(name)
+		assert.Nil(t, err, fmt.Sprintf("name:%v", name))
+		assert.Equal(t, Of(name), actual, fmt.Sprintf("name:%v", name))
+	}
+}
+
+func TestParse_UnknownRoot(t *testing.T) {
+	_, err := Parse("garbage")
+	assert.Equal(t, &ParseError{Name: "garbage", Position: 0, Text: "garbage", Err: ErrUnknownRoot}, err)
+	_, err = Parse("")
+	assert.Equal(t, &ParseError{Name: "", Position: 0, Text: "", Err: ErrUnknownRoot}, err)
+}
+
+func TestParse_UnknownForm(t *testing.T) {
+	_, err := Parse("C jams")
+	assert.Equal(t, &ParseError{Name: "C jams", Position: 2, Text: "jams", Err: ErrUnknownForm}, err)
+	_, err = Parse("C#m7  with jams")
+	assert.Equal(t, &ParseError{Name: "C#m7  with jams", Position: 6, Text: "with", Err: ErrUnknownForm}, err)
+	_, err = Parse("Cm nondominant -5 +6 +7 +9")
+	assert.Nil(t, err)
+	_, err = Parse("B♭m⁷ jams")
+	assert.Equal(t, &ParseError{Name: "B♭m⁷ jams", Position: 9, Text: "jams", Err: ErrUnknownForm}, err)
+	_, err = Parse("E♭⁷ jams", WithStrict())
+	assert.Equal(t, &ParseError{Name: "E♭⁷ jams", Position: 8, Text: "jams", Err: ErrUnknownForm}, err)
+}
+
+func TestParse_PartlyUnknownForm(t *testing.T) {
+	for name, expect := range map[string]*ParseError{
+		"Cxyz7":  {Name: "Cxyz7", Position: 1, Text: "xyz", Err: ErrUnknownForm},
+		"Co7":    {Name: "Co7", Position: 1, Text: "o", Err: ErrUnknownForm},
+		"Cø7":    {Name: "Cø7", Position: 1, Text: "ø", Err: ErrUnknownForm},
+		"CΔ7":    {Name: "CΔ7", Position: 1, Text: "Δ", Err: ErrUnknownForm},
+		"C°7":    {Name: "C°7", Position: 1, Text: "°", Err: ErrUnknownForm},
+		"C7sus4": {Name: "C7sus4", Position: 2, Text: "sus4", Err: ErrUnknownForm},
+	} {
+		_, err := Parse(name)
+		assert.Equal(t, expect, err, name)
+	}
+	for _, name := range []string{"C minor 7th", "C dominant 9th", "CMb5b7", "Csus2 add9", "Cm nondominant -5 679", "C7b9", "C13#11", "C7b13"} {
+		_, err := Parse(name)
+		assert.Nil(t, err, name)
+	}
+}
+
+func TestOf_Unicode(t *testing.T) {
+	for name, ascii := range map[string]string{
+		"B♭⁷":    "Bb7",
+		"C♯m⁷":   "C#m7",
+		"Cm⁷♭⁵":  "Cm7b5",
+		"F＃m":    "F#m",
+		"Cm7–5":  "Cm7-5",
+		"C⁶⁹":    "C69",
+		"A♭maj⁷": "Abmaj7",
+	} {
+		assert.Equal(t, Of(ascii), Of(name), name)
+	}
+}
+
+func TestOf_DoubleAccidentals(t *testing.T) {
+	c, err := Parse("C𝄪")
+	assert.Nil(t, err)
+	assert.Equal(t, note.D, c.Root)
+	assert.Equal(t, map[Interval]note.Class{I1: note.D, I3: note.Fs, I5: note.A}, classesOf(c))
+	c, err = Parse("D𝄫m")
+	assert.Nil(t, err)
+	assert.Equal(t, note.C, c.Root)
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I3: note.Ds, I5: note.G}, classesOf(c))
+	assert.Equal(t, "D7", Of("C##7").Name())
 }
 
 func TestOf_Invalid(t *testing.T) {
 	k := key.Of("P-funk")
-	assert.Equal(t, note.Nil, k.Root)
+	assert.Equal(t, note.Nil, note.Class(k.Root))
+}
+
+func TestOf_ToneInterval(t *testing.T) {
+	c := Of("Cm7-5")
+	assert.Equal(t, []string{"P1", "m3", "m7"}, namesOf(c))
+}
+
+func TestTranspose_ToneInterval(t *testing.T) {
+	c := Of("C9")
+	assert.Equal(t, namesOf(c), namesOf(c.Transpose(5)))
 }
 
 func TestTranspose(t *testing.T) {
 	actualChord := Chord{
 		Root:      note.C,
 		AdjSymbol: note.Flat,
-		Tones: map[Interval]note.Class{
-			I3: note.Ds,
-			I6: note.A,
-			I7: note.As,
-			I9: note.D,
+		Tones: []Tone{
+			{Degree: Flat3, Class: note.Ds},
+			{Degree: Degree{I6, Natural}, Class: note.A},
+			{Degree: Flat7, Class: note.As},
+			{Degree: Natural9, Class: note.D},
 		},
 	}
 	expectChord := Chord{
 		Root:      note.Ds,
 		AdjSymbol: note.Flat,
-		Tones: map[Interval]note.Class{
-			I3: note.Fs,
-			I6: note.C,
-			I7: note.Cs,
-			I9: note.F,
+		Tones: []Tone{
+			{Degree: Flat3, Class: note.Fs},
+			{Degree: Degree{I6, Natural}, Class: note.C},
+			{Degree: Flat7, Class: note.Cs},
+			{Degree: Natural9, Class: note.F},
 		},
 	}
 	assert.Equal(t, expectChord, actualChord.Transpose(3))
 }
 
+func TestOf_AllocationBudget(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+	for _, name := range benchmarkNames {
+		assert.True(t, testing.AllocsPerRun(100, func() { Of(name) }) <= 1, fmt.Sprintf("name:%v", name))
+	}
+}
+
+func BenchmarkChordOf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Of(benchmarkNames[i%len(benchmarkNames)])
+	}
+}
+
+func BenchmarkChordParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse(benchmarkNames[i%len(benchmarkNames)])
+	}
+}
+
+func BenchmarkChordParse_Strict(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse(benchmarkNames[i%len(benchmarkNames)], WithStrict())
+	}
+}
+
 //
 // Private
 //
@@ -85,3 +193,29 @@ type testKey struct {
 type testExpectationManifest struct {
 	Chords map[string]testKey
 }
+
+// classesOf the tones of a chord, by interval, to compare regardless of their order
+func classesOf(c Chord) map[Interval]note.Class {
+	classes := make(map[Interval]note.Class, len(c.Tones))
+	for _, t := range c.Tones {
+		classes[t.Degree.Interval] = t.Class
+	}
+	return classes
+}
+
+// classAt an interval of a chord, or Nil if it has no tone there
+func classAt(c Chord, i Interval) note.Class {
+	class, _ := c.ClassAt(i)
+	return class
+}
+
+// namesOf the intervals of the tones of a chord, in order
+func namesOf(c Chord) (names []string) {
+	for _, t := range c.Tones {
+		names = append(names, t.Name)
+	}
+	return
+}
+
+// benchmarkNames of chords, as found in a lead sheet
+var benchmarkNames = []string{"C", "Cm7", "G7", "Bbmaj7", "F#m7b5", "Ebdim7", "Aaug", "Dsus4", "C13", "Abm9", "E7#9", "Gadd9"}
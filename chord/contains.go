@@ -0,0 +1,23 @@
+// Membership of pitch classes in a chord, regardless of their spelling
+package chord
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+// ToneSet of the pitch classes in the chord
+func (this Chord) ToneSet() toneset.Set {
+	s := toneset.Of()
+	for _, t := range this.Tones {
+		if t.Class != note.Nil {
+			s[t.Class] = true
+		}
+	}
+	return s
+}
+
+// Contains the pitch class, e.g. the chord "C minor" contains both D# and Eb
+func (this Chord) Contains(class note.Class) bool {
+	return this.ToneSet().Contains(class)
+}
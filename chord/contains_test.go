@@ -0,0 +1,27 @@
+// Membership of pitch classes in a chord, regardless of their spelling
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestChord_ToneSet(t *testing.T) {
+	assert.Equal(t, toneset.Of(note.C, note.Ds, note.G, note.As), Of("C minor 7").ToneSet())
+	assert.Equal(t, toneset.Of(), Of("").ToneSet())
+}
+
+func TestChord_Contains(t *testing.T) {
+	c := Of("C minor")
+	eb, _ := note.ClassNamed("Eb")
+	dSharp, _ := note.ClassNamed("D#")
+	assert.True(t, c.Contains(eb))
+	assert.True(t, c.Contains(dSharp))
+	assert.True(t, c.Contains(note.G))
+	assert.False(t, c.Contains(note.E))
+	assert.False(t, c.Contains(note.Nil))
+}
@@ -0,0 +1,123 @@
+// The degree of a chord tone counts up the major scale from the root, with any alteration, e.g. the b3 and b7 of a minor seventh chord, or the #9 of an altered dominant
+package chord
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/interval"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Degree of a chord tone, its interval from the root, e.g. I5, altered from the major or perfect interval of that number, e.g. Flat of b5
+type Degree struct {
+	Interval   Interval
+	Alteration Alteration
+}
+
+// Alteration of a degree, in semitones from the major or perfect interval of its number, e.g. Flat of b5
+type Alteration int
+
+const (
+	DoubleFlat  Alteration = -2
+	Flat        Alteration = -1
+	Natural     Alteration = 0
+	Sharp       Alteration = 1
+	DoubleSharp Alteration = 2
+)
+
+// Degrees commonly altered or added to a chord, e.g. Flat5 of a half-diminished chord, or Sharp9 of an altered dominant
+var (
+	Flat3       = Degree{I3, Flat}
+	Flat5       = Degree{I5, Flat}
+	Sharp5      = Degree{I5, Sharp}
+	DoubleFlat7 = Degree{I7, DoubleFlat}
+	Flat7       = Degree{I7, Flat}
+	Natural7    = Degree{I7, Natural}
+	Flat9       = Degree{I9, Flat}
+	Natural9    = Degree{I9, Natural}
+	Sharp9      = Degree{I9, Sharp}
+	Natural11   = Degree{I11, Natural}
+	Sharp11     = Degree{I11, Sharp}
+	Flat13      = Degree{I13, Flat}
+	Natural13   = Degree{I13, Natural}
+)
+
+// ErrUnknownDegree when parsing a degree that isn't a number after any flats or sharps, e.g. "x9"
+var ErrUnknownDegree = errors.New("unknown degree")
+
+// ParseDegree of a chord tone, e.g. "b5" or "#9" or "11", with its accidentals in ASCII or unicode, e.g. "♭13"
+func ParseDegree(text string) (Degree, error) {
+	m := rgxDegree.FindStringSubmatch(strings.TrimSpace(symbol.Normalize(text)))
+	if m == nil {
+		return Degree{}, fmt.Errorf("%w %q, expected a number after any flats or sharps, e.g. b5 or #9", ErrUnknownDegree, text)
+	}
+	number, _ := strconv.Atoi(m[2])
+	d := Degree{Interval: Interval(number)}
+	for _, r := range m[1] {
+		switch r {
+		case 'b':
+			d.Alteration--
+		case '#':
+			d.Alteration++
+		}
+	}
+	return d, nil
+}
+
+// String of the degree, its number after any flats or sharps, e.g. "b5" or "#9" or "11" or "bb7"
+func (d Degree) String() string {
+	accidentals := ""
+	if d.Alteration < 0 {
+		accidentals = strings.Repeat("b", -int(d.Alteration))
+	} else if d.Alteration > 0 {
+		accidentals = strings.Repeat("#", int(d.Alteration))
+	}
+	return accidentals + strconv.Itoa(int(d.Interval))
+}
+
+// Semitones of the degree up from the root, e.g. 6 of b5, or 15 of #9
+func (d Degree) Semitones() int {
+	number := int(d.Interval)
+	if number < 1 {
+		return int(d.Alteration)
+	}
+	return interval.MajorSemitones[(number-1)%7] + 12*((number-1)/7) + int(d.Alteration)
+}
+
+// Degree of the tone of an interval, the key it's stored by, and whether the chord has it, e.g. Flat5 of the fifth of Cm7b5
+func (this Chord) Degree(i Interval) (Degree, bool) {
+	if n, ok := this.indexOf(i); ok {
+		return this.Tones[n].Degree, true
+	}
+	return Degree{}, false
+}
+
+// Degrees of the tones of the chord, in ascending order of interval, e.g. 1 b3 b5 b7 of Cm7b5
+func (this Chord) Degrees() []Degree {
+	degrees := make([]Degree, 0, len(this.Tones))
+	for _, t := range this.Tones {
+		degrees = append(degrees, t.Degree)
+	}
+	return degrees
+}
+
+//
+// Private
+//
+
+var rgxDegree = regexp.MustCompile(`^([b#]*)([1-9][0-9]?)$`)
+
+// degreeOf a tone by its interval and semitones from the root, altered by the fewest semitones from the major or perfect interval of its number,
+// e.g. b5 of a fifth 6 semitones up, or #9 of a ninth 3 semitones up
+func degreeOf(i Interval, semitones int) Degree {
+	number := int(i)
+	if number < 1 {
+		return Degree{Interval: i}
+	}
+	diff := ((semitones-interval.MajorSemitones[(number-1)%7])%12+18)%12 - 6
+	return Degree{Interval: i, Alteration: Alteration(diff)}
+}
@@ -0,0 +1,78 @@
+// The degree of a chord tone counts up the major scale from the root, with any alteration, e.g. the b3 and b7 of a minor seventh chord, or the #9 of an altered dominant
+package chord
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestChord_Degrees(t *testing.T) {
+	assert.Equal(t, []Degree{{I1, Natural}, Flat3, Flat5, Flat7}, Of("Cm7b5").Degrees())
+	assert.Equal(t, []Degree{{I1, Natural}, {I3, Natural}, {I5, Natural}, Flat7, Sharp9}, Of("C7#9").Degrees())
+	assert.Equal(t, []Degree{{I1, Natural}, Flat3, Flat5, DoubleFlat7}, Of("Cdim7").Degrees())
+	assert.Equal(t, []Degree{{I1, Natural}, {I3, Natural}, {I5, Natural}, Natural7}, Of("Cmaj7").Degrees())
+	assert.Equal(t, []Degree{}, Chord{}.Degrees())
+}
+
+func TestChord_Degree(t *testing.T) {
+	d, ok := Of("Cm7b5").Degree(I5)
+	assert.True(t, ok)
+	assert.Equal(t, Flat5, d)
+	d, ok = Of("C7").Degree(I5)
+	assert.True(t, ok)
+	assert.Equal(t, Degree{I5, Natural}, d)
+	assert.NotEqual(t, Flat5, d, "b5 isn't 5")
+	_, ok = Of("C7").Degree(I9)
+	assert.False(t, ok)
+}
+
+func TestDegree_String(t *testing.T) {
+	assert.Equal(t, "b5", Flat5.String())
+	assert.Equal(t, "#9", Sharp9.String())
+	assert.Equal(t, "11", Natural11.String())
+	assert.Equal(t, "bb7", DoubleFlat7.String())
+	assert.Equal(t, "##4", Degree{I4, DoubleSharp}.String())
+}
+
+func TestDegree_Semitones(t *testing.T) {
+	assert.Equal(t, 0, Degree{I1, Natural}.Semitones())
+	assert.Equal(t, 6, Flat5.Semitones())
+	assert.Equal(t, 9, DoubleFlat7.Semitones())
+	assert.Equal(t, 15, Sharp9.Semitones())
+	assert.Equal(t, 17, Natural11.Semitones())
+	assert.Equal(t, 20, Flat13.Semitones())
+}
+
+func TestParseDegree(t *testing.T) {
+	for _, d := range []Degree{Flat3, Flat5, Sharp5, DoubleFlat7, Flat7, Natural7, Flat9, Natural9, Sharp9, Natural11, Sharp11, Flat13, Natural13} {
+		parsed, err := ParseDegree(d.String())
+		assert.Nil(t, err)
+		assert.Equal(t, d, parsed)
+	}
+	d, err := ParseDegree(" ♭13 ")
+	assert.Nil(t, err)
+	assert.Equal(t, Flat13, d)
+	d, err = ParseDegree("♯11")
+	assert.Nil(t, err)
+	assert.Equal(t, Sharp11, d)
+	d, err = ParseDegree("𝄫⁷")
+	assert.Nil(t, err)
+	assert.Equal(t, DoubleFlat7, d)
+	_, err = ParseDegree("x9")
+	assert.True(t, errors.Is(err, ErrUnknownDegree))
+	assert.Equal(t, `unknown degree "x9", expected a number after any flats or sharps, e.g. b5 or #9`, err.Error())
+	_, err = ParseDegree("b")
+	assert.True(t, errors.Is(err, ErrUnknownDegree))
+}
+
+func TestDegree_OfEveryTone(t *testing.T) {
+	for _, name := range benchmarkNames {
+		c := Of(name)
+		for _, d := range c.Degrees() {
+			class, _ := c.Root.Step(d.Semitones())
+			assert.Equal(t, classAt(c, d.Interval), class, name+" "+d.String())
+		}
+	}
+}
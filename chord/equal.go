@@ -0,0 +1,48 @@
+// Chords are equal if they have the same root, spelling and tones, or equivalent if they have the same pitch classes, regardless of their spelling,
+// and have a canonical form and a stable hash, e.g. to use as a map key or to deduplicate chords.
+package chord
+
+import (
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// Equal to another chord, with the same root, spelling, bass, and tone of each degree
+func (this Chord) Equal(other Chord) bool {
+	if this.Root != other.Root || this.AdjSymbol != other.AdjSymbol || this.Lowest() != other.Lowest() || len(this.Tones) != len(other.Tones) {
+		return false
+	}
+	for n, t := range this.Tones {
+		if other.Tones[n].Degree != t.Degree || other.Tones[n].Class != t.Class {
+			return false
+		}
+	}
+	return true
+}
+
+// EquivalentTo another chord, with the same pitch classes, regardless of their spelling or root, e.g. C6 and Am7
+func (this Chord) EquivalentTo(other Chord) bool {
+	return this.ToneSet().Equal(other.ToneSet())
+}
+
+// Canonical form of the chord, its root, then each of its tones by interval, in order, spelled with its sharps or flats, then any bass after a slash,
+// the same for any two chords that are Equal, e.g. "C: 1=C 3=E 5=G 7=Bb" or "C: 1=C 3=E 5=G /E"
+func (this Chord) Canonical() string {
+	var b strings.Builder
+	b.WriteString(this.Root.String(this.AdjSymbol) + ":")
+	for _, t := range this.Tones {
+		b.WriteString(" " + strconv.Itoa(int(t.Degree.Interval)) + "=" + t.Class.String(this.AdjSymbol))
+	}
+	if bass := this.slashBass(); len(bass) > 0 {
+		b.WriteString(" " + bass)
+	}
+	return b.String()
+}
+
+// Hash of the Canonical form of the chord, stable from one run to the next, e.g. to use as a map key
+func (this Chord) Hash() uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(this.Canonical()))
+	return h.Sum64()
+}
@@ -0,0 +1,38 @@
+// Chords are equal if they have the same root, spelling and tones, or equivalent if they have the same pitch classes, regardless of their spelling,
+// and have a canonical form and a stable hash, e.g. to use as a map key or to deduplicate chords.
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestChord_Equal(t *testing.T) {
+	assert.True(t, Of("C7").Equal(Of("C dominant 7")))
+	assert.False(t, Of("C7").Equal(Of("Cmaj7")))
+	assert.False(t, Of("A#").Equal(Of("Bb")), "spelled differently")
+	assert.False(t, Of("C").Equal(Of("C7")))
+}
+
+func TestChord_EquivalentTo(t *testing.T) {
+	assert.True(t, Of("A#").EquivalentTo(Of("Bb")))
+	assert.True(t, Of("C6").EquivalentTo(Of("Am7")))
+	assert.False(t, Of("C").EquivalentTo(Of("Cm")))
+}
+
+func TestChord_Canonical(t *testing.T) {
+	assert.Equal(t, "C: 1=C 3=E 5=G 7=Bb", Of("C7").SpelledIn(signature(-1)).Canonical())
+	assert.Equal(t, "A#: 1=A# 3=D 5=F", Of("A#").Canonical())
+	assert.Equal(t, Of("Cmaj7").Canonical(), Of("C major 7").Canonical())
+}
+
+func TestChord_Hash(t *testing.T) {
+	assert.Equal(t, Of("Cmaj7").Hash(), Of("C major 7").Hash())
+	assert.NotEqual(t, Of("Cmaj7").Hash(), Of("C7").Hash())
+	seen := make(map[uint64]Chord)
+	for _, name := range []string{"C", "Cm", "C major", "Cmin", "C7"} {
+		seen[Of(name).Hash()] = Of(name)
+	}
+	assert.Equal(t, 3, len(seen))
+}
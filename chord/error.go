@@ -0,0 +1,36 @@
+// Chord names that cannot be parsed are described by a ParseError, e.g. for an unknown root or form
+package chord
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnknownRoot when a chord name doesn't begin with a note, e.g. "H7"
+	ErrUnknownRoot = errors.New("unknown root")
+
+	// ErrUnknownForm when some word of a chord name, or part of one, isn't matched by any form, e.g. the "jams" of "C jams" or the "b" of "C7b9"
+	ErrUnknownForm = errors.New("unknown form")
+
+	// ErrAmbiguous when parsing strictly, and a form matches from the middle of a word, e.g. the "dominant 7" of "C nondominant 7"
+	ErrAmbiguous = errors.New("ambiguous forms")
+)
+
+// ParseError of a chord name, at the position of the text that could not be parsed
+type ParseError struct {
+	Name     string // chord name that was parsed
+	Position int    // of the text that could not be parsed, in bytes from the beginning of the name
+	Text     string // that could not be parsed
+	Err      error  // ErrUnknownRoot, ErrUnknownForm or ErrAmbiguous
+}
+
+// Error message, e.g. `unknown form "jams" at position 2 of chord "C jams"`
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v %q at position %d of chord %q", e.Err, e.Text, e.Position, e.Name)
+}
+
+// Unwrap to the underlying error, e.g. for errors.Is(err, ErrUnknownForm)
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,16 @@
+// Chord names that cannot be parsed are described by a ParseError, e.g. for an unknown root or form
+package chord
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParseError(t *testing.T) {
+	err := &ParseError{Name: "C jams", Position: 2, Text: "jams", Err: ErrUnknownForm}
+	assert.Equal(t, `unknown form "jams" at position 2 of chord "C jams"`, err.Error())
+	assert.True(t, errors.Is(err, ErrUnknownForm))
+	assert.False(t, errors.Is(err, ErrUnknownRoot))
+}
@@ -0,0 +1,95 @@
+// Chords can be explained by the forms that matched parts of their name, and the tones each form added or omitted, e.g. to debug why a chord came out the way it did
+package chord
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Explanation of a chord name, by each of the forms that matched it, in the order they were applied
+type Explanation struct {
+	Name  string
+	Chord Chord
+	Forms []FormMatch
+}
+
+// FormMatch is a form that matched part of a chord name, and the tones it added or omitted
+type FormMatch struct {
+	Form     string                  // name of the form
+	Text     string                  // part of the chord name that was matched, or empty if the form always applies
+	Position int                     // of the matched text, in bytes from the beginning of the chord name
+	Add      map[Interval]note.Class // tones added, by interval from the root
+	Omit     []Interval              // intervals omitted, after all forms have added their tones
+}
+
+// Explain a chord name, e.g. Explain("Cm679-5")
+func Explain(name string) Explanation {
+	e := Explanation{Name: name, Chord: Of(name)}
+	normalized := symbol.Normalize(name)
+	root, remaining := note.RootAndRemaining(normalized)
+	offset := len(normalized) - len(remaining)
+	if len(remaining) > 0 {
+		offset = strings.LastIndex(normalized, remaining)
+	}
+	for _, f := range knownForms() {
+		if !f.MatchString(remaining) {
+			continue
+		}
+		m := FormMatch{Form: f.Name, Add: make(map[Interval]note.Class), Omit: f.omit}
+		if f.pos != nil {
+			loc := f.pos.FindStringIndex(remaining)
+			m.Position, m.Text = symbol.Unnormalized(name, offset+loc[0], offset+loc[1])
+		}
+		for i, semitones := range f.add {
+			m.Add[i], _ = root.Step(semitones)
+		}
+		e.Forms = append(e.Forms, m)
+	}
+	return e
+}
+
+// ToYAML of the Explanation, e.g. for the command-line utility
+func (e Explanation) ToYAML() string {
+	spec := specExplanationFrom(e)
+	out, _ := yaml.Marshal(spec)
+	return string(out[:])
+}
+
+//
+// Private
+//
+
+func specExplanationFrom(e Explanation) specExplanation {
+	s := specExplanation{Chord: specFrom(e.Chord)}
+	for _, m := range e.Forms {
+		sm := specFormMatch{Form: m.Form, Match: m.Text, Position: m.Position}
+		if len(m.Add) > 0 {
+			sm.Add = make(map[int]string)
+			for i, class := range m.Add {
+				sm.Add[int(i)] = class.String(e.Chord.AdjSymbol)
+			}
+		}
+		for _, i := range m.Omit {
+			sm.Omit = append(sm.Omit, int(i))
+		}
+		s.Forms = append(s.Forms, sm)
+	}
+	return s
+}
+
+type specExplanation struct {
+	Forms []specFormMatch
+	Chord specChord
+}
+
+type specFormMatch struct {
+	Form     string
+	Match    string         `yaml:",omitempty"`
+	Position int            `yaml:",omitempty"`
+	Add      map[int]string `yaml:",omitempty"`
+	Omit     []int          `yaml:",omitempty"`
+}
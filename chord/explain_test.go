@@ -0,0 +1,42 @@
+// Chords can be explained by the forms that matched parts of their name, and the tones each form added or omitted, e.g. to debug why a chord came out the way it did
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestExplain(t *testing.T) {
+	e := Explain("Cm679-5")
+	assert.Equal(t, "Cm679-5", e.Name)
+	assert.Equal(t, Of("Cm679-5"), e.Chord)
+	var names []string
+	for _, m := range e.Forms {
+		names = append(names, m.Form)
+	}
+	assert.Equal(t, []string{"Basic", "Minor Triad", "Omit Fifth", "Add Sixth", "Add Seventh", "Add Ninth"}, names)
+	assert.Equal(t, FormMatch{Form: "Basic", Add: map[Interval]note.Class{I1: note.C, I3: note.E, I5: note.G}}, e.Forms[0])
+	assert.Equal(t, FormMatch{Form: "Omit Fifth", Text: "-5", Position: 5, Add: map[Interval]note.Class{}, Omit: []Interval{I5}}, e.Forms[2])
+	assert.Equal(t, FormMatch{Form: "Add Sixth", Text: "6", Position: 2, Add: map[Interval]note.Class{I6: note.A}}, e.Forms[3])
+}
+
+func TestExplain_Spaces(t *testing.T) {
+	e := Explain("G minor 7")
+	assert.Equal(t, "Minor Triad", e.Forms[1].Form)
+	assert.Equal(t, "minor ", e.Forms[1].Text)
+	assert.Equal(t, 2, e.Forms[1].Position)
+}
+
+func TestExplain_Unicode(t *testing.T) {
+	e := Explain("B♭m⁷–⁵")
+	assert.Equal(t, Of("Bbm7-5"), e.Chord)
+	assert.Equal(t, FormMatch{Form: "Omit Fifth", Text: "–⁵", Position: 8, Add: map[Interval]note.Class{}, Omit: []Interval{I5}}, e.Forms[2])
+}
+
+func TestExplain_ToYAML(t *testing.T) {
+	out := Explain("C-5").ToYAML()
+	assert.Equal(t, "forms:\n- form: Basic\n  add:\n    1: C\n    3: E\n    5: G\n- form: Omit Fifth\n  match: \"-5\"\n  position: 1\n  omit:\n  - 5\nchord:\n  root: C\n  tones:\n    1: C\n    3: E\n", out)
+}
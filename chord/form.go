@@ -3,15 +3,19 @@ package chord
 
 import (
 	//"log"
-	"regexp"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/match"
+	"github.com/go-music-theory/music-theory/trace"
 )
 
 // Form is identified by positive/negative regular expressions, and then adds/removes pitch classes by interval from the root of the chord.
 type Form struct {
-	Name string
-	pos  *regexp.Regexp
-	add  FormAdd
-	omit FormOmit
+	Name  string
+	pos   *match.Matcher
+	add   FormAdd
+	omit  FormOmit
+	whole *match.Matcher // longest-matching copy of pos, for strict parsing
 }
 
 // FormAdd maps an interval-from-chord-root to a +/1 semitone adjustment
@@ -37,7 +41,7 @@ var (
 	majorExp = "(M|maj|major)"
 	minorExp = "([^a-z]|^)(m|min|minor)"
 
-	flatExp  = "(f|flat|b|♭)"
+	flatExp  = "(f|flat|b)"
 	sharpExp = "(#|s|sharp)"
 	halfExp  = "half"
 
@@ -77,7 +81,7 @@ var forms = []Form{
 
 	Form{
 		Name: "Major Triad",
-		pos:  exp("^" + majorExp + "([^a-z]|$)"),
+		pos:  exp("^" + majorExp + "([^a-z]|b[0-9]|$)"),
 		add: FormAdd{
 			I3: 4, // major 3rd
 			I5: 7, // perfect 5th
@@ -113,7 +117,7 @@ var forms = []Form{
 
 	Form{
 		Name: "Suspended Triad",
-		pos:  exp("^" + suspendedExp),
+		pos:  exp("^" + suspendedExp + "(" + nExp + "4)?"),
 		add: FormAdd{
 			I4: 5, // 4th
 			I5: 7, // perfect 5th
@@ -123,8 +127,30 @@ var forms = []Form{
 		},
 	},
 
+	Form{
+		Name: "Suspended Second",
+		pos:  exp("^" + suspendedExp + nExp + "2"),
+		add: FormAdd{
+			I2: 2, // 2nd
+		},
+		omit: FormOmit{
+			I4, // no 4th, of the suspended triad
+		},
+	},
+
 	// Fifth
 
+	Form{
+		Name: "Power Chord",
+		pos:  exp("^5([^0-9]|$)"),
+		add: FormAdd{
+			I5: 7, // perfect 5th
+		},
+		omit: FormOmit{
+			I3, // no 3rd
+		},
+	},
+
 	Form{
 		Name: "Omit Fifth",
 		pos:  exp(omitExp + nExp + "5"),
@@ -175,6 +201,14 @@ var forms = []Form{
 		},
 	},
 
+	Form{
+		Name: "Flat Seventh",
+		pos:  exp(flatExp + nExp + "7"),
+		add: FormAdd{
+			I7: 10, // minor 7th
+		},
+	},
+
 	Form{
 		Name: "Dominant Seventh",
 		pos:  exp(dominantExp + nExp + "7"),
@@ -210,7 +244,7 @@ var forms = []Form{
 
 	Form{
 		Name: "Half Diminished Seventh",
-		pos:  exp(halfExp + nExp + diminishedExp + nExp + "7"),
+		pos:  exp("(" + halfExp + nExp + diminishedExp + "(" + nExp + "7)?|" + minorExp + nExp + "7" + nExp + flatExp + nExp + "5)"),
 		add: FormAdd{
 			I3: 3,  // minor 3rd
 			I5: 6,  // diminished 5th
@@ -413,6 +447,32 @@ var forms = []Form{
 		},
 	},
 
+	// Altered, after the stacked ninths, elevenths and thirteenths they alter
+
+	Form{
+		Name: "Flat Ninth",
+		pos:  exp(flatExp + nExp + "9"),
+		add: FormAdd{
+			I9: 13, // flat 9th
+		},
+	},
+
+	Form{
+		Name: "Sharp Eleventh",
+		pos:  exp(sharpExp + nExp + "11"),
+		add: FormAdd{
+			I11: 18, // sharp 11th
+		},
+	},
+
+	Form{
+		Name: "Flat Thirteenth",
+		pos:  exp(flatExp + nExp + "13"),
+		add: FormAdd{
+			I13: 20, // flat 13th
+		},
+	},
+
 	// Lydian
 
 	/*
@@ -561,9 +621,30 @@ var forms = []Form{
 
 }
 
-func exp(s string) *regexp.Regexp {
-	r, _ := regexp.Compile(s)
-	return r
+// Regular expressions of each word in a chord name, which must be matched at least in part by some form, unless it's filler, and of each word to match wholly when parsing strictly, including any +, e.g. of C+
+var (
+	rgxWord       = exp("[^\\s.,+()]+")
+	rgxStrictWord = exp("[^\\s.,()]+")
+	rgxFiller     = exp("^(add|added)$")
+	rgxOrdinal    = exp("[0-9](st|nd|rd|th)\\b")
+)
+
+// exp compiled once, to a matcher with a prefilter of the runes any match must contain
+func exp(s string) *match.Matcher {
+	return match.MustCompile(s)
+}
+
+// longest-matching copy of a regular expression, e.g. to match all of "nondominant" instead of only "non"
+func longest(r *match.Matcher) *match.Matcher {
+	return r.Longest()
+}
+
+func init() {
+	for i := range forms {
+		if forms[i].pos != nil {
+			forms[i].whole = longest(forms[i].pos)
+		}
+	}
 }
 
 func (this *Form) matchPosNegString(s string) bool {
@@ -581,26 +662,121 @@ func (this *Form) matchPosNegString(s string) bool {
 	}
 }
 
+// unknownFormIn a name, the position and text of the first part of a word not matched by any form, e.g. "xyz" of "xyz7",
+// or -1 if every part of every word is matched, but for filler and the suffix of an ordinal, e.g. "th" of "7th"
+func unknownFormIn(name string) (int, string) {
+	matched := make([]bool, len(name))
+	for _, f := range knownForms() {
+		if f.pos == nil {
+			continue
+		}
+		for _, loc := range append(f.pos.FindAllStringIndex(name, -1), f.whole.FindAllStringIndex(name, -1)...) {
+			for i := loc[0]; i < loc[1]; i++ {
+				matched[i] = true
+			}
+		}
+	}
+	for _, loc := range rgxOrdinal.FindAllStringIndex(name, -1) {
+		for i := loc[0] + 1; i < loc[1]; i++ {
+			matched[i] = true
+		}
+	}
+	for _, loc := range rgxWord.FindAllStringIndex(name, -1) {
+		for i := loc[0]; i < loc[1]; i++ {
+			if !matched[i] {
+				j := i
+				for j < loc[1] && !matched[j] {
+					j++
+				}
+				if !rgxFiller.MatchString(name[i:j]) {
+					return i, name[i:j]
+				}
+				i = j
+			}
+		}
+	}
+	return -1, ""
+}
+
+// strictErrorIn a name, the position and text of the first part of a word not wholly matched by some form,
+// or else of the first form matching from the middle of a word outside of any other match, or -1 if there is neither.
+func strictErrorIn(name string) (int, string, error) {
+	var locs [][]int
+	for _, f := range knownForms() {
+		if f.whole != nil {
+			locs = append(locs, f.whole.FindAllStringIndex(name, -1)...)
+		}
+	}
+	matched := make([]bool, len(name))
+	for _, loc := range locs {
+		for i := loc[0]; i < loc[1]; i++ {
+			matched[i] = true
+		}
+	}
+	for _, loc := range rgxStrictWord.FindAllStringIndex(name, -1) {
+		for i := loc[0]; i < loc[1]; i++ {
+			if !matched[i] {
+				j := i
+				for j < loc[1] && !matched[j] {
+					j++
+				}
+				if !rgxFiller.MatchString(name[i:j]) {
+					return i, name[i:j], ErrUnknownForm
+				}
+				i = j
+			}
+		}
+	}
+	sort.Slice(locs, func(i, j int) bool { return locs[i][0] < locs[j][0] })
+	for _, loc := range locs {
+		if isMidWord(name, loc[0]) && !isNestedIn(loc, locs) {
+			return loc[0], name[loc[0]:loc[1]], ErrAmbiguous
+		}
+	}
+	return -1, "", nil
+}
+
+// isNestedIn any other, larger location
+func isNestedIn(loc []int, locs [][]int) bool {
+	for _, other := range locs {
+		if other[0] <= loc[0] && loc[1] <= other[1] && other[1]-other[0] > loc[1]-loc[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// isMidWord if the byte at a position in a name is a letter following another letter of the same case, e.g. the "d" of "nondominant" but not the "M" of "mM7"
+func isMidWord(name string, i int) bool {
+	if i == 0 || i >= len(name) {
+		return false
+	}
+	prev, this := name[i-1], name[i]
+	return ('a' <= prev && prev <= 'z' && 'a' <= this && this <= 'z') ||
+		('A' <= prev && prev <= 'Z' && 'A' <= this && this <= 'Z')
+}
+
 // Build the chord by processing all Forms against the given name.
-func (this *Chord) parseForms(name string) {
-	var toDelete []Interval
-	for _, f := range forms {
+func (this *Chord) parseForms(name string, input string) {
+	var omitted [16]Interval // backing the intervals to delete, so that most parses don't allocate them
+	toDelete := omitted[:0]
+	for _, f := range knownForms() {
 		if f.MatchString(name) {
-			toDelete = append(toDelete, this.applyForm(f)...)
+			if trace.Enabled() {
+				trace.Emit(trace.Event{Scope: "chord.parse", Input: input, Rule: f.Name})
+			}
+			this.applyForm(f)
+			toDelete = append(toDelete, f.omit...)
 		}
 	}
 	for _, t := range toDelete {
-		delete(this.Tones, t)
+		this.omitTone(t)
 	}
 	return
 }
 
-func (this *Chord) applyForm(f Form) (toDelete []Interval) {
+func (this *Chord) applyForm(f Form) {
 	for i, c := range f.add {
-		this.Tones[i], _ = this.Root.Step(c)
+		this.setTone(i, c)
 	}
-	for _, t := range f.omit {
-		toDelete = append(toDelete, t)
-	}
-	return
 }
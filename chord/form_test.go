@@ -4,8 +4,10 @@ package chord
 import (
 	"testing"
 
-	"gopkg.in/music-theory.v0/note"
 	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/trace"
 )
 
 func TestListAllForms(t *testing.T) {
@@ -23,7 +25,19 @@ func TestChordParseForms(t *testing.T) {
 		I6: note.A,
 		I7: note.As,
 		I9: note.D,
-	}, c.Tones)
+	}, classesOf(c))
+}
+
+func TestChordParseForms_Traced(t *testing.T) {
+	var rules []string
+	previous := trace.Set(trace.HookFunc(func(e trace.Event) {
+		assert.Equal(t, "chord.parse", e.Scope)
+		assert.Equal(t, "Cmaj7", e.Input)
+		rules = append(rules, e.Rule)
+	}))
+	defer trace.Set(previous)
+	Of("Cmaj7")
+	assert.Equal(t, []string{"Basic", "Major Triad", "Add Seventh", "Major Seventh"}, rules)
 }
 
 //
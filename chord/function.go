@@ -1,6 +1,67 @@
 // Chords have different Functions, such as Diatonic, Altered or Other.
 package chord
 
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Tonal center to find the function of a chord in, e.g. a key.Key, by its tonic
+type Tonal interface {
+	Tonic() note.Class
+}
+
+// IsTonicFunction in a key, the tonic, but for a dominant seventh chord on it, or the iii or vi minor chord of a major key, or III major chord of a minor key, that share its tones,
+// e.g. C, Em or Am in C major
+func (this Chord) IsTonicFunction(k Tonal) bool {
+	q := this.Quality()
+	switch this.degreeIn(k) {
+	case 0:
+		return !q.IsDominant()
+	case 4, 9:
+		return q.Triad == MinorTriad
+	case 3:
+		return q.Triad == MajorTriad && !q.IsDominant()
+	}
+	return false
+}
+
+// IsSubdominantFunction in a key, the IV or iv chord or the supertonic ii that leads to the dominant, but for a dominant seventh chord on the supertonic,
+// e.g. F, Fm, Dm7 or Dm7b5 in C major
+func (this Chord) IsSubdominantFunction(k Tonal) bool {
+	switch this.degreeIn(k) {
+	case 5:
+		return this.Quality().Triad != NoTriad
+	case 2:
+		return !this.Quality().IsDominant() && this.Quality().Triad != NoTriad
+	}
+	return false
+}
+
+// IsDominantFunction in a key, the V chord, major or suspended, or the diminished leading-tone chord vii°, that resolve to the tonic,
+// e.g. G, G7, G7sus4 or Bdim7 in C major
+func (this Chord) IsDominantFunction(k Tonal) bool {
+	switch this.degreeIn(k) {
+	case 7:
+		t := this.Quality().Triad
+		return t == MajorTriad || t == SuspendedTriad
+	case 11:
+		return this.Quality().Triad == DiminishedTriad
+	}
+	return false
+}
+
+//
+// Private
+//
+
+// degreeIn a key, the semitones from its tonic up to the root of the chord, or -1 if either is missing, e.g. of a chord that didn't parse
+func (this Chord) degreeIn(k Tonal) int {
+	if this.Root == note.Nil || k.Tonic() == note.Nil {
+		return -1
+	}
+	return (k.Tonic().Diff(this.Root) + 12) % 12
+}
+
 //type Function int
 //
 //const (
@@ -3,8 +3,57 @@ package chord
 
 import (
 	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
 )
 
-func TestFunction(t *testing.T) {
-	// TODO
+func TestChord_IsTonicFunction(t *testing.T) {
+	for _, name := range []string{"C", "Cmaj7", "Em", "Am7", "C6"} {
+		assert.True(t, Of(name).IsTonicFunction(tonic(note.C)), name)
+	}
+	for _, name := range []string{"C7", "E", "F", "G7", "Am7b5"} {
+		assert.False(t, Of(name).IsTonicFunction(tonic(note.C)), name)
+	}
+	assert.True(t, Of("C").IsTonicFunction(tonic(note.A)), "the III of A minor")
+}
+
+func TestChord_IsSubdominantFunction(t *testing.T) {
+	for _, name := range []string{"F", "Fm", "Fmaj7", "Dm7", "Dm7b5"} {
+		assert.True(t, Of(name).IsSubdominantFunction(tonic(note.C)), name)
+	}
+	for _, name := range []string{"D7", "G", "C"} {
+		assert.False(t, Of(name).IsSubdominantFunction(tonic(note.C)), name)
+	}
+}
+
+func TestChord_IsDominantFunction(t *testing.T) {
+	for _, name := range []string{"G", "G7", "G9", "Bdim", "Bdim7", "Bm7b5"} {
+		assert.True(t, Of(name).IsDominantFunction(tonic(note.C)), name)
+	}
+	for _, name := range []string{"Gm", "Bm", "C7", "Db7"} {
+		assert.False(t, Of(name).IsDominantFunction(tonic(note.C)), name)
+	}
+	assert.True(t, Of("E7").IsDominantFunction(tonic(note.A)))
+}
+
+func TestChord_Function_NilRoot(t *testing.T) {
+	for _, c := range []Chord{{}, Of("garbage")} {
+		assert.False(t, c.IsTonicFunction(tonic(note.C)))
+		assert.False(t, c.IsSubdominantFunction(tonic(note.C)))
+		assert.False(t, c.IsDominantFunction(tonic(note.C)))
+	}
+	assert.False(t, Of("G7").IsDominantFunction(tonic(note.Nil)))
+}
+
+//
+// Private
+//
+
+// tonic of a key, by its pitch class alone
+type tonic note.Class
+
+func (t tonic) Tonic() note.Class {
+	return note.Class(t)
 }
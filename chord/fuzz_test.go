@@ -0,0 +1,79 @@
+//go:build go1.18
+// +build go1.18
+
+// Chords are parsed from any name without panicking, e.g. from the arguments of the command line or the query of a request
+package chord
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-music-theory/music-theory/locale"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func FuzzParse(f *testing.F) {
+	for _, name := range benchmarkNames {
+		f.Add(name)
+	}
+	f.Add("C jams")
+	f.Add("B♭maj7♯11")
+	f.Add("H7")
+	f.Add("Fis m7")
+	f.Add("Sol7")
+	f.Fuzz(func(t *testing.T, name string) {
+		for _, options := range [][]Option{nil, {WithStrict()}} {
+			c, err := Parse(name, options...)
+			assertParsed(t, name, c, err)
+		}
+		for _, l := range []locale.Locale{locale.German, locale.Solfege} {
+			c, err := Parse(name, WithLocale(l))
+			var parseError *ParseError
+			if errors.As(err, &parseError) && (parseError.Position < 0 || parseError.Position > len(name)) {
+				t.Errorf("name:%q locale:%v position %d out of range", name, l, parseError.Position)
+			}
+			assertTones(t, name, c)
+		}
+	})
+}
+
+//
+// Private
+//
+
+// assertParsed chord has a root and valid tones, or else an error at a position of the name, of the text found there
+func assertParsed(t *testing.T, name string, c Chord, err error) {
+	assertTones(t, name, c)
+	if err == nil {
+		if c.Root == note.Nil {
+			t.Errorf("name:%q parsed without a root", name)
+		}
+		return
+	}
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("name:%q error %v is not a *ParseError", name, err)
+	}
+	if parseError.Position < 0 || parseError.Position > len(name) || !strings.HasPrefix(name[parseError.Position:], parseError.Text) {
+		t.Errorf("name:%q error %v is not of the text at its position", name, err)
+	}
+}
+
+// assertTones of a chord with a root are all note classes, in ascending order of interval; a chord without one, of an unknown root, has none to step its tones from
+func assertTones(t *testing.T, name string, c Chord) {
+	if c.Root == note.Nil {
+		return
+	}
+	for n, tone := range c.Tones {
+		if tone.Class < note.C || tone.Class > note.B {
+			t.Errorf("name:%q tone %v is %v", name, tone.Degree, tone.Class)
+		}
+		if n > 0 && c.Tones[n-1].Degree.Interval >= tone.Degree.Interval {
+			t.Errorf("name:%q tone %v is out of order", name, tone.Degree)
+		}
+	}
+	if c.Root < note.C || c.Root > note.B {
+		t.Errorf("name:%q root is %d", name, int(c.Root))
+	}
+}
@@ -0,0 +1,296 @@
+// Chord forms are catalogued by the other names they're known by, a description, and their typical usages, e.g. FormInfo("m7b5") of the Half Diminished Seventh
+package chord
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Info about a chord form, its name, the aliases it's also known by, e.g. "m7b5" or "half diminished" of the Half Diminished Seventh, a description of it, and its typical usages
+type Info struct {
+	Name        string   `yaml:"name" json:"name"`
+	Aliases     []string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Usages      []string `yaml:"usages,omitempty" json:"usages,omitempty"`
+}
+
+// FormInfo of a known form, by its name or any of its aliases, regardless of case, spaces or dashes, e.g. "Half Diminished Seventh", "half-diminished" or "m7b5",
+// but an alias of its exact case first, e.g. "M7" of the Major Seventh and "m7" of the Minor Seventh; a registered form is known only by its name.
+// Every alias after a root strictly parses to a chord of its form, e.g. "Cm7b5" of the Half Diminished Seventh.
+func FormInfo(name string) (Info, error) {
+	name = symbol.Normalize(strings.TrimSpace(name))
+	known := knownForms()
+	for _, f := range known {
+		i := infoOf(f)
+		for _, alias := range i.Aliases {
+			if alias == name {
+				return i, nil
+			}
+		}
+	}
+	folded := foldInfoName(name)
+	for _, f := range known {
+		i := infoOf(f)
+		if foldInfoName(i.Name) == folded {
+			return i, nil
+		}
+		for _, alias := range i.Aliases {
+			if foldInfoName(alias) == folded {
+				return i, nil
+			}
+		}
+	}
+	return Info{}, fmt.Errorf("%w %q, expected the name or alias of a known form", ErrUnknownForm, name)
+}
+
+// ToYAML of the info about a form
+func (i Info) ToYAML() string {
+	out, _ := yaml.Marshal(i)
+	return string(out[:])
+}
+
+//
+// Private
+//
+
+// infoOf a form, as catalogued, or else only its name, e.g. of a registered form
+func infoOf(f Form) Info {
+	i, ok := infos[f.Name]
+	if !ok {
+		return Info{Name: f.Name}
+	}
+	i.Name = f.Name
+	return i
+}
+
+// foldInfoName to compare it regardless of case, spaces or dashes, e.g. "halfdiminished" of "Half-Diminished"
+func foldInfoName(name string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(name))
+}
+
+// infos of the built-in forms, by name
+var infos = map[string]Info{
+	"Basic": {
+		Description: "The root, major third and perfect fifth that every chord begins from, before its other forms add or omit tones",
+		Usages:      []string{"a chord named by its root alone, e.g. C"},
+	},
+	"Nondominant": {
+		Aliases:     []string{"non", "nondom"},
+		Description: "Omits the root of the chord",
+		Usages:      []string{"rootless voicings, leaving the root to a bass player"},
+	},
+	"Major Triad": {
+		Aliases:     []string{"major", "maj", "M"},
+		Description: "A major third and perfect fifth above the root, bright and stable",
+		Usages:      []string{"the tonic, subdominant and dominant of a major key", "most folk, pop and rock songs"},
+	},
+	"Minor Triad": {
+		Aliases:     []string{"minor", "min", "m"},
+		Description: "A minor third and perfect fifth above the root, darker than the major triad",
+		Usages:      []string{"the tonic of a minor key", "the ii, iii and vi of a major key"},
+	},
+	"Augmented Triad": {
+		Aliases:     []string{"augmented", "aug"},
+		Description: "A major third and augmented fifth above the root, two stacked major thirds dividing the octave evenly",
+		Usages:      []string{"a passing chord between a major triad and its sixth", "the dominant of a minor key, with its fifth raised"},
+	},
+	"Diminished Triad": {
+		Aliases:     []string{"diminished", "dim"},
+		Description: "A minor third and diminished fifth above the root, two stacked minor thirds, tense and unstable",
+		Usages:      []string{"the vii° of a major key, leading to the tonic", "the ii° of a minor key"},
+	},
+	"Suspended Triad": {
+		Aliases:     []string{"suspended", "sus", "sus4"},
+		Description: "A perfect fourth in place of the third, suspended until it resolves down to the third",
+		Usages:      []string{"delaying the third of a dominant chord, e.g. G7sus4 to G7", "open, ambiguous harmony in rock and modal jazz"},
+	},
+	"Suspended Second": {
+		Aliases:     []string{"sus2"},
+		Description: "A major second in place of the third, or of the fourth of the suspended triad",
+		Usages:      []string{"the open, ringing chords of folk and rock guitar, e.g. Dsus2", "a tonic neither major nor minor"},
+	},
+	"Power Chord": {
+		Aliases:     []string{"5"},
+		Description: "Only the root and perfect fifth, with no third to make it major or minor",
+		Usages:      []string{"distorted electric guitar of rock, punk and metal, e.g. E5"},
+	},
+	"Omit Fifth": {
+		Aliases:     []string{"omit5"},
+		Description: "Omits the fifth of the chord",
+		Usages:      []string{"shell voicings of only the root, third and seventh, e.g. of jazz guitar"},
+	},
+	"Flat Fifth": {
+		Aliases:     []string{"flat5"},
+		Description: "Lowers the fifth of the chord by a semitone, to a diminished fifth",
+		Usages:      []string{"the half-diminished m7b5", "an altered dominant, e.g. C7b5"},
+	},
+	"Add Sixth": {
+		Aliases:     []string{"6", "add6"},
+		Description: "Adds a major sixth above the root",
+		Usages:      []string{"a sweeter tonic than the major seventh, e.g. C6 in swing and bossa nova", "the minor sixth chord, e.g. Am6"},
+	},
+	"Augmented Sixth": {
+		Aliases:     []string{"aug6"},
+		Description: "Adds an augmented sixth above the root, the enharmonic minor seventh",
+		Usages:      []string{"the Italian, French and German sixth chords of classical harmony, resolving outward to the dominant"},
+	},
+	"Omit Sixth": {
+		Aliases:     []string{"omit6"},
+		Description: "Omits the sixth of the chord",
+		Usages:      []string{"thinning a voicing of an extended chord"},
+	},
+	"Add Seventh": {
+		Aliases:     []string{"7", "add7"},
+		Description: "Adds a minor seventh above the root, making a dominant seventh of a major triad, or a minor seventh of a minor triad",
+		Usages:      []string{"the dominant of a key, e.g. G7 of C", "the twelve-bar blues"},
+	},
+	"Flat Seventh": {
+		Aliases:     []string{"flat7"},
+		Description: "Adds a minor seventh above the root, spelled as the major seventh lowered, e.g. of an alteration",
+		Usages:      []string{"a dominant seventh named by its alterations, e.g. CMb5b7"},
+	},
+	"Dominant Seventh": {
+		Aliases:     []string{"dom7"},
+		Description: "A major triad with a minor seventh, its tritone between the third and seventh pulling to the tonic",
+		Usages:      []string{"the V7 resolving to the I of a key", "secondary dominants, e.g. A7 to Dm in C", "every chord of the blues"},
+	},
+	"Major Seventh": {
+		Aliases:     []string{"maj7", "M7"},
+		Description: "A major triad with a major seventh, lush and stable",
+		Usages:      []string{"the tonic of jazz and bossa nova, e.g. Cmaj7", "the IV of a major key, e.g. Fmaj7 in C"},
+	},
+	"Minor Seventh": {
+		Aliases:     []string{"min7", "m7"},
+		Description: "A minor triad with a minor seventh, mellow and stable",
+		Usages:      []string{"the ii of a ii-V-I, e.g. Dm7 in C", "the tonic of dorian modal jazz"},
+	},
+	"Diminished Seventh": {
+		Aliases:     []string{"dim7"},
+		Description: "A diminished triad with a diminished seventh, stacked minor thirds dividing the octave into four, so each of its tones can be its root",
+		Usages:      []string{"the vii°7 of a minor key, leading to the tonic", "a passing chord between two chords a whole tone apart", "modulating to distant keys"},
+	},
+	"Half Diminished Seventh": {
+		Aliases:     []string{"half diminished", "m7b5"},
+		Description: "A diminished triad with a minor seventh, a minor seventh with its fifth lowered",
+		Usages:      []string{"the ii of a minor ii-V-i, e.g. Bm7b5 E7 Am", "the vii of a major key"},
+	},
+	"Diminished Major Seventh": {
+		Aliases:     []string{"dim maj7"},
+		Description: "A diminished triad with a major seventh",
+		Usages:      []string{"a rare color of film scores and contemporary jazz"},
+	},
+	"Augmented Major Seventh": {
+		Aliases:     []string{"aug maj7"},
+		Description: "An augmented triad with a major seventh",
+		Usages:      []string{"the III+ of melodic and harmonic minor, e.g. Ebmaj7#5 in C minor", "a brighter substitute for the major seventh of a tonic"},
+	},
+	"Augmented Minor Seventh": {
+		Aliases:     []string{"aug min7"},
+		Description: "An augmented triad with a minor seventh, a dominant seventh with its fifth raised",
+		Usages:      []string{"an altered dominant, its raised fifth leading to the third of the tonic"},
+	},
+	"Harmonic Seventh": {
+		Aliases:     []string{"harm7"},
+		Description: "A major triad with the seventh harmonic of its root, a minor seventh tuned flatter than in equal temperament",
+		Usages:      []string{"barbershop and a cappella singing", "the blues, sung in just intonation"},
+	},
+	"Omit Seventh": {
+		Aliases:     []string{"omit7"},
+		Description: "Omits the seventh of the chord",
+		Usages:      []string{"an extended chord named by its ninth or more, voiced without its seventh"},
+	},
+	"Add Ninth": {
+		Aliases:     []string{"9", "add9"},
+		Description: "Adds a major ninth above the root, without a seventh unless another form adds one",
+		Usages:      []string{"a richer major or minor triad, e.g. Cadd9 in pop and worship music"},
+	},
+	"Dominant Ninth": {
+		Aliases:     []string{"dom9"},
+		Description: "A dominant seventh with a major ninth",
+		Usages:      []string{"the dominant of funk and soul, e.g. E9", "a fuller V of a key"},
+	},
+	"Major Ninth": {
+		Aliases:     []string{"maj9", "M9"},
+		Description: "A major seventh with a major ninth",
+		Usages:      []string{"the tonic of jazz ballads and neo-soul"},
+	},
+	"Minor Ninth": {
+		Aliases:     []string{"min9", "m9"},
+		Description: "A minor seventh with a major ninth",
+		Usages:      []string{"the ii of a ii-V-I", "the tonic of dorian and minor grooves"},
+	},
+	"Sharp Ninth": {
+		Aliases:     []string{"sharp9"},
+		Description: "Raises the ninth above the root by a semitone, an augmented ninth clashing against the major third",
+		Usages:      []string{"the dominant of blues-rock, e.g. the E7#9 of Purple Haze", "an altered dominant of jazz"},
+	},
+	"Flat Ninth": {
+		Aliases:     []string{"flat9"},
+		Description: "Lowers the ninth above the root by a semitone, a minor ninth a semitone over the root",
+		Usages:      []string{"the dominant of a minor key, e.g. the E7b9 of A minor", "an altered dominant of jazz"},
+	},
+	"Omit Ninth": {
+		Aliases:     []string{"omit9"},
+		Description: "Omits the ninth of the chord",
+		Usages:      []string{"an eleventh or thirteenth chord voiced without its ninth"},
+	},
+	"Add Eleventh": {
+		Aliases:     []string{"11", "add11"},
+		Description: "Adds a perfect eleventh above the root, without a ninth or seventh unless another form adds them",
+		Usages:      []string{"a suspended color over a major or minor triad, e.g. Cadd11"},
+	},
+	"Dominant Eleventh": {
+		Aliases:     []string{"dom11"},
+		Description: "A dominant ninth with a perfect eleventh, omitting the third it would clash with",
+		Usages:      []string{"a suspended dominant of gospel and soul, e.g. G11 to C"},
+	},
+	"Major Eleventh": {
+		Aliases:     []string{"maj11", "M11"},
+		Description: "A major ninth with a perfect eleventh",
+		Usages:      []string{"an ambiguous, suspended tonic, usually voiced without its third"},
+	},
+	"Minor Eleventh": {
+		Aliases:     []string{"min11", "m11"},
+		Description: "A minor ninth with a perfect eleventh, open and consonant",
+		Usages:      []string{"the tonic of modal jazz, e.g. the Dm11 of So What", "quartal voicings"},
+	},
+	"Sharp Eleventh": {
+		Aliases:     []string{"sharp11"},
+		Description: "Raises the eleventh above the root by a semitone, the raised fourth of the lydian mode, clear of the major third",
+		Usages:      []string{"a lydian major seventh, e.g. Cmaj7#11", "a lydian dominant, e.g. the D7#11 of the Simpsons theme"},
+	},
+	"Omit Eleventh": {
+		Aliases:     []string{"omit11"},
+		Description: "Omits the eleventh of the chord",
+		Usages:      []string{"a thirteenth chord voiced without its eleventh, to keep the third"},
+	},
+	"Add Thirteenth": {
+		Aliases:     []string{"13", "add13"},
+		Description: "Adds a major thirteenth above the root, the sixth an octave higher",
+		Usages:      []string{"a dominant or major chord with the color of its sixth"},
+	},
+	"Dominant Thirteenth": {
+		Aliases:     []string{"dom13"},
+		Description: "A dominant eleventh with a major thirteenth, omitting its third",
+		Usages:      []string{"the dominant of big band and jazz, e.g. G13 to Cmaj7"},
+	},
+	"Major Thirteenth": {
+		Aliases:     []string{"maj13", "M13"},
+		Description: "A major eleventh with a major thirteenth, every tone of the major scale stacked in thirds",
+		Usages:      []string{"a lush tonic of jazz, usually voiced without its eleventh"},
+	},
+	"Minor Thirteenth": {
+		Aliases:     []string{"min13", "m13"},
+		Description: "A minor eleventh with a major thirteenth, every tone of the dorian mode stacked in thirds",
+		Usages:      []string{"the tonic of dorian modal jazz and funk"},
+	},
+	"Flat Thirteenth": {
+		Aliases:     []string{"flat13"},
+		Description: "Lowers the thirteenth above the root by a semitone, the minor sixth an octave up",
+		Usages:      []string{"an altered dominant resolving to a minor tonic, e.g. the G7b13 of C minor"},
+	},
+}
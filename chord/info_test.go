@@ -0,0 +1,97 @@
+// Chord forms are catalogued by the other names they're known by, a description, and their typical usages, e.g. FormInfo("m7b5") of the Half Diminished Seventh
+package chord
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestFormInfo(t *testing.T) {
+	for name, form := range map[string]string{
+		"Half Diminished Seventh": "Half Diminished Seventh",
+		"half-diminished":         "Half Diminished Seventh",
+		"Half-Diminished":         "Half Diminished Seventh",
+		"m7b5":                    "Half Diminished Seventh",
+		"m7♭5":                    "Half Diminished Seventh",
+		"half diminished":         "Half Diminished Seventh",
+		" minor seventh ":         "Minor Seventh",
+		"m7":                      "Minor Seventh",
+		"M7":                      "Major Seventh",
+		"MAJ7":                    "Major Seventh",
+		"dim7":                    "Diminished Seventh",
+		"dim":                     "Diminished Triad",
+		"sus4":                    "Suspended Triad",
+		"sharp9":                  "Sharp Ninth",
+		"Basic":                   "Basic",
+	} {
+		i, err := FormInfo(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, form, i.Name, name)
+	}
+}
+
+func TestFormInfo_HalfDiminished(t *testing.T) {
+	i, err := FormInfo("m7b5")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"half diminished", "m7b5"}, i.Aliases)
+	assert.Equal(t, "A diminished triad with a minor seventh, a minor seventh with its fifth lowered", i.Description)
+	assert.Contains(t, i.Usages, "the ii of a minor ii-V-i, e.g. Bm7b5 E7 Am")
+}
+
+func TestFormInfo_Unknown(t *testing.T) {
+	_, err := FormInfo("jams")
+	assert.True(t, errors.Is(err, ErrUnknownForm))
+	assert.Equal(t, `unknown form "jams", expected the name or alias of a known form`, err.Error())
+}
+
+func TestFormInfo_Registered(t *testing.T) {
+	defer restoreForms()()
+	assert.Nil(t, RegisterForm("Power", "^5$", FormAdd{I1: 0, I5: 7}, FormOmit{I3}))
+	i, err := FormInfo("power")
+	assert.Nil(t, err)
+	assert.Equal(t, Info{Name: "Power"}, i)
+}
+
+func TestFormInfo_EveryForm(t *testing.T) {
+	aliases := map[string]string{}
+	for _, f := range forms {
+		i, err := FormInfo(f.Name)
+		assert.Nil(t, err, f.Name)
+		assert.Equal(t, f.Name, i.Name)
+		assert.NotEmpty(t, i.Description, f.Name)
+		assert.NotEmpty(t, i.Usages, f.Name)
+		for _, alias := range i.Aliases {
+			assert.NotEqual(t, foldInfoName(f.Name), foldInfoName(alias), f.Name)
+			other, ok := aliases[alias]
+			assert.False(t, ok, "%s is an alias of both %s and %s", alias, other, f.Name)
+			aliases[alias] = f.Name
+			_, err := Parse("C"+alias, WithStrict())
+			assert.Nil(t, err, "C%s of %s", alias, f.Name)
+			assert.Contains(t, formsMatching("C"+alias), f.Name, "C%s of %s", alias, f.Name)
+		}
+	}
+	assert.Len(t, infos, len(ChordFormList))
+}
+
+//
+// Private
+//
+
+// formsMatching a chord name, as explained
+func formsMatching(name string) (names []string) {
+	for _, m := range Explain(name).Forms {
+		names = append(names, m.Form)
+	}
+	return
+}
+
+func TestInfo_ToYAML(t *testing.T) {
+	i, err := FormInfo("Omit Fifth")
+	assert.Nil(t, err)
+	assert.Equal(t, "name: Omit Fifth\n"+
+		"aliases:\n- omit5\n"+
+		"description: Omits the fifth of the chord\n"+
+		"usages:\n- shell voicings of only the root, third and seventh, e.g. of jazz guitar\n", i.ToYAML())
+}
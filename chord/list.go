@@ -13,8 +13,16 @@ func (l List) ToYAML() string {
 	return string(out[:])
 }
 
+// ChordFormList of the names of all known forms, as of startup and any registered since; read FormNames instead while forms may be registered
 var ChordFormList List
 
+// FormNames of all known forms, the built-in forms and any registered since, safe to read while forms are registered
+func FormNames() List {
+	registry.RLock()
+	defer registry.RUnlock()
+	return ChordFormList
+}
+
 //
 // Private
 //
@@ -0,0 +1,256 @@
+// The name of a chord is a canonical symbol regenerated from its tones, e.g. "Cm7b5", which parses back to the same chord
+package chord
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Name of the chord, a canonical symbol of its root and degrees, e.g. "Cm7b5" of Of("C half diminished 7"),
+// that parses back to a chord with the same tones, spelling it out in words where the compact symbol wouldn't, e.g. "C 7 add 9 add 11 add 13",
+// and after a slash any bass other than the root, e.g. "Cm7/Bb", or empty of a chord whose tones no symbol parses back to
+func (this Chord) Name() string {
+	if this.Root == note.Nil {
+		return ""
+	}
+	s := this.symbol()
+	if s == "" {
+		return ""
+	}
+	return s + this.slashBass()
+}
+
+//
+// Private
+//
+
+// symbol of the chord, its root and degrees, the compact symbol if it parses back to the same tones, else spelled out in words if that does,
+// else empty, never a symbol of other tones
+func (this Chord) symbol() string {
+	d := degreeMapOf(this)
+	root := this.Root.String(this.AdjSymbol)
+	symbols := [2]string{root + compactSymbolOf(d), strings.TrimSpace(root + " " + spelledSymbolOf(d))}
+	for _, s := range symbols {
+		if Of(s).sameTonesAs(this) {
+			return s
+		}
+	}
+	return ""
+}
+
+// slashBass of the chord, a slash and its bass, e.g. "/E" of C/E, or empty if the root is in the bass
+func (this Chord) slashBass() string {
+	if this.Bass == note.Nil || this.Bass == this.Root {
+		return ""
+	}
+	return "/" + this.Bass.String(this.AdjSymbol)
+}
+
+// degreeMap of the alteration of each interval of a chord, e.g. I5: Flat of Cm7b5
+type degreeMap map[Interval]Alteration
+
+func degreeMapOf(c Chord) degreeMap {
+	d := make(degreeMap, len(c.Tones))
+	for _, degree := range c.Degrees() {
+		d[degree.Interval] = degree.Alteration
+	}
+	return d
+}
+
+// is whether the chord has an interval with an alteration, e.g. is(I5, Flat) of Cm7b5
+func (d degreeMap) is(i Interval, a Alteration) bool {
+	alteration, ok := d[i]
+	return ok && alteration == a
+}
+
+// compactSymbolOf degrees, as on a lead sheet, e.g. "m7b5" or "maj9" or "7#9" or "add9"
+func compactSymbolOf(d degreeMap) string {
+	var b strings.Builder
+	left := make(degreeMap, len(d))
+	for i, a := range d {
+		left[i] = a
+	}
+	done := func(intervals ...Interval) {
+		for _, i := range intervals {
+			delete(left, i)
+		}
+	}
+	minor, major := d.is(I3, Flat), d.is(I3, Natural)
+	switch {
+	case minor && d.is(I5, Flat) && d.is(I7, DoubleFlat):
+		b.WriteString("dim7")
+		done(I1, I3, I5, I7)
+	case minor && d.is(I5, Flat) && d.is(I7, Flat):
+		b.WriteString("m7b5")
+		done(I1, I3, I5, I7)
+	case minor && d.is(I5, Flat) && d.is(I7, Natural):
+		b.WriteString("dimM7")
+		done(I1, I3, I5, I7)
+	case minor && d.is(I5, Flat):
+		b.WriteString("dim")
+		done(I1, I3, I5)
+	case major && d.is(I5, Sharp):
+		b.WriteString("aug")
+		done(I1, I3, I5)
+		if d.is(I7, Flat) {
+			b.WriteString("7")
+			done(I7)
+		} else if d.is(I7, Natural) {
+			b.WriteString("M7")
+			done(I7)
+		}
+	default:
+		if minor {
+			b.WriteString("m")
+		}
+		done(I1, I3)
+		if d.is(I7, Flat) || d.is(I7, Natural) {
+			if d.is(I7, Natural) {
+				b.WriteString("M")
+			}
+			b.WriteString(stackedNumberOf(d, left))
+		} else if d.is(I6, Natural) {
+			b.WriteString("6")
+			done(I6)
+			if d.is(I9, Natural) {
+				b.WriteString("9")
+				done(I9)
+			}
+		}
+		if suspended(d) {
+			b.WriteString("sus4")
+			done(I4)
+		} else if suspendedSecond(d) {
+			b.WriteString("sus2")
+			done(I2)
+		} else if power(d) && b.Len() == 0 {
+			b.WriteString("5")
+		}
+		if _, ok := d[I5]; ok && !d.is(I5, Natural) {
+			if b.Len() == 0 {
+				b.WriteString("(" + Degree{I5, d[I5]}.String() + ")")
+			} else {
+				b.WriteString(Degree{I5, d[I5]}.String())
+			}
+		}
+		done(I5)
+	}
+	for _, i := range []Interval{I6, I9, I11, I13} {
+		if a, ok := left[i]; ok {
+			if i == I6 && a == Sharp {
+				if !strings.HasSuffix(b.String(), "aug") {
+					b.WriteString("aug")
+				}
+				b.WriteString("6")
+				continue
+			}
+			if a == Natural {
+				b.WriteString("add")
+			}
+			b.WriteString(Degree{i, a}.String())
+		}
+	}
+	if _, ok := d[I5]; !ok {
+		b.WriteString("omit5")
+	}
+	if _, ok := d[I1]; !ok {
+		b.WriteString(" nondominant")
+	}
+	return b.String()
+}
+
+// stackedNumberOf the highest of the natural 9, 11 and 13 stacked on the seventh of a chord, e.g. "9" of C9, marking each done
+func stackedNumberOf(d degreeMap, left degreeMap) string {
+	number := "7"
+	delete(left, I7)
+	for _, i := range []Interval{I9, I11, I13} {
+		if !d.is(i, Natural) {
+			break
+		}
+		number = Degree{Interval: i}.String()
+		delete(left, i)
+	}
+	return number
+}
+
+// suspended whether the chord has a fourth in place of its third, e.g. Csus4
+func suspended(d degreeMap) bool {
+	_, third := d[I3]
+	return !third && d.is(I4, Natural)
+}
+
+// suspendedSecond whether the chord has a second in place of its third, e.g. Csus2
+func suspendedSecond(d degreeMap) bool {
+	_, third := d[I3]
+	_, fourth := d[I4]
+	return !third && !fourth && d.is(I2, Natural)
+}
+
+// power whether the chord has only a perfect fifth over its root, no third, second or fourth, e.g. C5
+func power(d degreeMap) bool {
+	for _, i := range []Interval{I2, I3, I4} {
+		if _, ok := d[i]; ok {
+			return false
+		}
+	}
+	return d.is(I5, Natural)
+}
+
+// spelledSymbolOf degrees, each in turn in words, e.g. "m nondominant -5 6 7 add 9" or "7 add 9 add 11 add 13"
+func spelledSymbolOf(d degreeMap) string {
+	var words []string
+	dim := d.is(I3, Flat) && d.is(I5, Flat) && !d.is(I7, Flat)
+	switch {
+	case dim:
+		words = append(words, "dim")
+	case d.is(I3, Flat):
+		words = append(words, "m")
+	case d.is(I3, Natural) && d.is(I5, Sharp):
+		words = append(words, "aug")
+	case suspended(d):
+		words = append(words, "sus4")
+	case suspendedSecond(d):
+		words = append(words, "sus2")
+	}
+	if _, ok := d[I1]; !ok {
+		words = append(words, "nondominant")
+	}
+	if _, ok := d[I5]; !ok {
+		words = append(words, "-5")
+	} else if d.is(I5, Flat) && !dim {
+		words = append(words, "b5")
+	}
+	if d.is(I6, Natural) {
+		words = append(words, "6")
+	} else if d.is(I6, Sharp) {
+		words = append(words, "aug 6")
+	}
+	switch {
+	case d.is(I7, Flat):
+		words = append(words, "7")
+	case d.is(I7, Natural):
+		words = append(words, "M7")
+	case d.is(I7, DoubleFlat) && d.is(I5, Flat):
+		words = append(words, "7")
+	}
+	for _, i := range []Interval{I9, I11, I13} {
+		if a, ok := d[i]; ok {
+			words = append(words, "add "+Degree{i, a}.String())
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// sameTonesAs another chord, its root and the class of the tone of each degree
+func (this Chord) sameTonesAs(other Chord) bool {
+	if this.Root != other.Root || len(this.Tones) != len(other.Tones) {
+		return false
+	}
+	for n, t := range this.Tones {
+		if other.Tones[n].Degree != t.Degree || other.Tones[n].Class != t.Class {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+// The name of a chord is a canonical symbol regenerated from its tones, e.g. "Cm7b5", which parses back to the same chord
+package chord
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestChord_Name(t *testing.T) {
+	for name, expect := range map[string]string{
+		"C major":             "C",
+		"C minor 7th":         "Cm7",
+		"C half diminished 7": "Cm7b5",
+		"C diminished 7":      "Cdim7",
+		"C aug major 7":       "CaugM7",
+		"C dominant 7 flat 5": "C7b5",
+		"C sus":               "Csus4",
+		"Cm(maj7)":            "CmM7",
+		"Ebmaj9":              "EbM9",
+		"B♭m11":               "Bbm11",
+		"C add 9":             "Cadd9",
+		"C7#9":                "C7#9",
+		"C69":                 "C69",
+		"CM13-11":             "CM9add13",
+		"Cm13 -7 -9 -11":      "C m add 13",
+		"C nondominant 7":     "C7 nondominant",
+		"C5":                  "C5",
+		"Csus2":               "Csus2",
+		"C aug 6":             "Caug6",
+		"Em7b9":               "Em7b9",
+		"Cmaj7 sharp 11":      "CM7#11",
+	} {
+		assert.Equal(t, expect, Of(name).Name(), fmt.Sprintf("name:%v", name))
+	}
+	assert.Equal(t, "", Of("H7").Name(), "no name without a root")
+	assert.Equal(t, "", Of("C7 dim7").Name(), "no name of a major triad with a diminished seventh, which no symbol parses back to")
+}
+
+func TestChord_Name_RoundTrip(t *testing.T) {
+	testExpectations := testExpectationManifest{}
+	file, err := ioutil.ReadFile("testdata/expectations.yaml")
+	assert.Nil(t, err)
+
+	err = yaml.Unmarshal(file, &testExpectations)
+	assert.Nil(t, err)
+
+	for name := range testExpectations.Chords {
+		c := Of(name)
+		again := Of(c.Name())
+		assert.True(t, again.EquivalentTo(c), fmt.Sprintf("name:%v symbol:%v", name, c.Name()))
+		assert.Equal(t, classesOf(c), classesOf(again), fmt.Sprintf("name:%v symbol:%v", name, c.Name()))
+		assert.Equal(t, c.Name(), again.Name(), fmt.Sprintf("name:%v symbol:%v is canonical", name, c.Name()))
+	}
+}
+
+func TestChord_Name_RoundTrip_EveryForm(t *testing.T) {
+	names := []string{"C"}
+	for _, f := range forms {
+		for _, alias := range infoOf(f).Aliases {
+			names = append(names, "C"+alias)
+		}
+	}
+	for _, name := range names {
+		c := Of(name)
+		assert.NotEmpty(t, c.Name(), name)
+		assert.True(t, Of(c.Name()).sameTonesAs(c), fmt.Sprintf("name:%v symbol:%v", name, c.Name()))
+	}
+}
+
+func BenchmarkChord_Name(b *testing.B) {
+	chords := make([]Chord, len(benchmarkNames))
+	for i, name := range benchmarkNames {
+		chords[i] = Of(name)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = chords[i%len(chords)].Name()
+	}
+}
@@ -0,0 +1,109 @@
+// The notes of a chord are realized as concrete pitches, stacked up from its root within two octaves, avoiding a minor ninth between any two of its tones but the root,
+// e.g. to play a chord or write it as MIDI without assigning each tone an octave by hand
+package chord
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// NotesSpan of the tones of a chord realized as notes, in semitones above its root
+const NotesSpan = 24
+
+// Notes of the chord, realized as concrete pitches in ascending order from its root in an octave, e.g. Bb4 D5 F5 Ab5 C6 of Of("Bb9").Notes(4),
+// each tone in order of its interval in the lowest octave above the tones before it, unless that's beyond the NotesSpan or a minor ninth from another tone but the root,
+// then in the lowest octave above the root that isn't, under any bass of a slash chord or inversion in the nearest octave below the root,
+// but for a natural 11th over a major 3rd, a minor ninth above it in any octave that fits, e.g. the F omitted of Cmaj13, or no notes of a chord of the Root Nil
+func (this Chord) Notes(rootOctave note.Octave) []note.Note {
+	if this.Root == note.Nil {
+		return nil
+	}
+	rootStep := stepOf(this.Root, rootOctave)
+	var steps, voiced []int
+	if this.Bass != note.Nil && this.Bass != this.Root {
+		step := stepOf(this.Bass, rootOctave)
+		for step >= rootStep {
+			step -= 12
+		}
+		steps = append(steps, step)
+	}
+	highest := rootStep - 1
+	major, _ := this.semitonesTo(I3)
+	for _, t := range this.OrderedTones() {
+		if t.Class == note.Nil || t.Degree == Natural11 && major == 4 {
+			continue
+		}
+		step := placedStep(stepOf(t.Class, rootOctave), highest, rootStep, voiced)
+		if t.Class != this.Root {
+			voiced = append(voiced, step)
+		}
+		if step > highest {
+			highest = step
+		}
+		steps = append(steps, step)
+	}
+	sort.Ints(steps)
+	notes := make([]note.Note, len(steps))
+	for n, step := range steps {
+		notes[n] = noteOfStep(step)
+	}
+	return notes
+}
+
+//
+// Private
+//
+
+// minorNinth in semitones, of two tones that clash
+const minorNinth = 13
+
+// stepOf a pitch class in an octave, in semitones, e.g. 49 of C4
+func stepOf(class note.Class, octave note.Octave) int {
+	return int(class) + int(octave)*12
+}
+
+// noteOfStep in semitones, its pitch class and octave, flooring the octave of a step below C0, e.g. B-1 of 0, or C4 of 49
+func noteOfStep(step int) note.Note {
+	octave := (step - 1) / 12
+	if step < 1 {
+		octave = (step - 12) / 12
+	}
+	return note.Note{Class: note.Class(step - octave*12), Octave: note.Octave(octave)}
+}
+
+// placedStep of a tone, of its step in any octave, in the lowest octave above the highest tone placed, if that's within the NotesSpan of the root
+// and not a minor ninth from any tone voiced, or else in the lowest above the root that's neither, or else in the lowest above the highest tone placed
+func placedStep(step int, highest int, rootStep int, voiced []int) int {
+	for step > rootStep {
+		step -= 12
+	}
+	for step < rootStep {
+		step += 12
+	}
+	above := step
+	for above <= highest {
+		above += 12
+	}
+	for s := above; s <= rootStep+NotesSpan; s += 12 {
+		if !clashes(s, voiced) {
+			return s
+		}
+	}
+	for s := step; s < above; s += 12 {
+		if !clashes(s, voiced) {
+			return s
+		}
+	}
+	return above
+}
+
+// clashes whether a step is a minor ninth from any tone voiced
+func clashes(step int, voiced []int) bool {
+	for _, v := range voiced {
+		if step-v == minorNinth || v-step == minorNinth {
+			return true
+		}
+	}
+	return false
+}
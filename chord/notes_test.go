@@ -0,0 +1,69 @@
+// The notes of a chord are realized as concrete pitches, stacked up from its root within two octaves, avoiding a minor ninth between any two of its tones but the root,
+package chord
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestNotes(t *testing.T) {
+	assert.Equal(t, []note.Note{
+		{Class: note.C, Octave: 4},
+		{Class: note.E, Octave: 4},
+		{Class: note.G, Octave: 4},
+	}, Of("C").Notes(4))
+	assert.Equal(t, "C4 E4 G4 B4", notesString(Of("Cmaj7"), 4))
+	assert.Equal(t, "Bb4 D5 F5 Ab5 C6", notesString(Of("Bb9"), 4))
+	assert.Equal(t, "A2 C3 E3 G3", notesString(Of("Am7"), 2))
+	assert.Equal(t, "Eb4 A4 Bb4 D5", notesString(Of("Cm nondominant -5 +6 +7 +9"), 4), "of no root")
+	assert.Nil(t, Chord{}.Notes(4))
+}
+
+func TestNotes_Bass(t *testing.T) {
+	assert.Equal(t, "E3 C4 E4 G4", notesString(Of("C/E"), 4))
+	assert.Equal(t, "B3 G4 B4 D5 F5", notesString(Of("G7/B"), 4))
+	assert.Equal(t, "G3 C4 E4 G4", notesString(Of("C").Inversion(2), 4))
+}
+
+func TestNotes_LowOctaves(t *testing.T) {
+	assert.Equal(t, "C0 E0 G0", notesString(Of("C"), 0))
+	assert.Equal(t, "E-1 C0 E0 G0", notesString(Of("C/E"), 0), "the bass below C0")
+	assert.Equal(t, "B-1 G0 B0 D1 F1", notesString(Of("G7/B"), 0))
+	assert.Equal(t, "C-1 E-1 G-1 B-1", notesString(Of("Cmaj7"), -1))
+	assert.Equal(t, "G-2 C-1 E-1 G-1", notesString(Of("C").Inversion(2), -1))
+	assert.Equal(t, "A-1 C0 E0 G0", notesString(Of("Am7"), -1))
+}
+
+func TestNotes_MinorNinth(t *testing.T) {
+	assert.Equal(t, "C4 E4 G4 B4 D5 A5", notesString(Of("Cmaj13"), 4), "no 11th a minor ninth above the 3rd")
+	assert.Equal(t, "C4 E4 G4 B4", notesString(Of("Cmaj7 +11"), 4))
+	assert.Equal(t, "C4 Eb4 G4 Bb4 D5 F5", notesString(Of("Cm11"), 4), "the 11th over a minor 3rd")
+	assert.Equal(t, "C4 E4 G4 B4 D5 F#5", notesString(Of("Cmaj7 +9 +#11"), 4), "a sharp 11th over a major 3rd")
+	flatNine := Chord{Root: note.C, AdjSymbol: note.Flat, Tones: []Tone{{Degree: Degree{I1, Natural}, Class: note.C}, {Degree: Degree{I3, Natural}, Class: note.E}, {Degree: Degree{I5, Natural}, Class: note.G}, {Degree: Flat7, Class: note.As}, {Degree: Flat9, Class: note.Cs}}}
+	assert.Equal(t, "C4 E4 G4 Bb4 Db5", notesString(flatNine, 4), "a minor ninth above the root")
+}
+
+func TestNotes_Span(t *testing.T) {
+	for _, name := range []string{"C13", "Cmaj13", "Cm11", "C69", "Bbm7b5", "F#7 +9 +11 +13"} {
+		notes := Of(name).Notes(4)
+		lowest, highest := notes[0], notes[len(notes)-1]
+		assert.True(t, stepOf(highest.Class, highest.Octave)-stepOf(lowest.Class, lowest.Octave) <= NotesSpan, name)
+	}
+}
+
+//
+// Private
+//
+
+func notesString(c Chord, octave note.Octave) string {
+	var names []string
+	for _, n := range c.Notes(octave) {
+		names = append(names, n.Class.String(c.AdjSymbol)+strconv.Itoa(int(n.Octave)))
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,51 @@
+// Chord names are parsed leniently by default, or with an Option, e.g. strictly with Parse("Cm7", WithStrict()), or in a locale with Of("Fis m7", WithLocale(locale.German))
+package chord
+
+import (
+	"github.com/go-music-theory/music-theory/locale"
+)
+
+// Option for parsing a chord name
+type Option func(*options)
+
+// WithStrict parsing, which rejects a chord name unless every part of it is matched by some form, with no form matching from the middle of a word outside of any other match
+func WithStrict() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// WithLocale of the note name at the beginning of a chord name, e.g. WithLocale(locale.German) to parse "H7" as B7
+func WithLocale(l locale.Locale) Option {
+	return func(o *options) {
+		o.locale = l
+	}
+}
+
+// WithSpellingKey to spell the chord in, with the sharps or flats of its key signature, e.g. WithSpellingKey(key.Of("F")) to spell C7 with a Bb
+func WithSpellingKey(k Key) Option {
+	return func(o *options) {
+		o.spellingKey = k
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	strict      bool
+	locale      locale.Locale
+	spellingKey Key
+}
+
+func optionsOf(opts []Option) options {
+	if len(opts) == 0 {
+		return options{} // without allocating, as the options applied below would
+	}
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
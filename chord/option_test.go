@@ -0,0 +1,80 @@
+// Chord names are parsed leniently by default, or with an Option, e.g. strictly with Parse("Cm7", WithStrict()), or in a locale with Of("Fis m7", WithLocale(locale.German))
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/locale"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestWithStrict(t *testing.T) {
+	assert.Equal(t, options{strict: true}, optionsOf([]Option{WithStrict()}))
+	assert.Equal(t, options{}, optionsOf(nil))
+}
+
+func TestWithLocale(t *testing.T) {
+	assert.Equal(t, options{locale: locale.German}, optionsOf([]Option{WithLocale(locale.German)}))
+	assert.Equal(t, Of("B7"), Of("H7", WithLocale(locale.German)))
+	assert.Equal(t, Of("F# m7"), Of("Fis m7", WithLocale(locale.German)))
+	assert.Equal(t, Of("Bb maj7"), Of("Sib maj7", WithLocale(locale.Solfege)))
+	_, err := Parse("Fis minor 7th", WithLocale(locale.German), WithStrict())
+	assert.Equal(t, &ParseError{Name: "Fis minor 7th", Position: 11, Text: "th", Err: ErrUnknownForm}, err)
+	_, err = Parse("H7", WithStrict())
+	assert.Equal(t, &ParseError{Name: "H7", Position: 0, Text: "H7", Err: ErrUnknownRoot}, err)
+}
+
+func TestWithSpellingKey(t *testing.T) {
+	c := Of("C7", WithSpellingKey(signature(-1)))
+	assert.Equal(t, "Bb", classAt(c, I7).String(c.AdjSymbol))
+	c, err := Parse("A#", WithSpellingKey(signature(-2)))
+	assert.Nil(t, err)
+	assert.Equal(t, "Bb", c.Root.String(c.AdjSymbol))
+}
+
+func TestParse_Strict(t *testing.T) {
+	for _, name := range []string{"C", "Cm7", "CmM7", "C dominant 7 flat 5", "Cm nondominant -5 679", "Cadd9", "C add 9", "CM13-9-11", "B♭m11"} {
+		_, err := Parse(name, WithStrict())
+		assert.Nil(t, err, name)
+	}
+}
+
+func TestParse_StrictTones(t *testing.T) {
+	for name, expect := range map[string]map[Interval]note.Class{
+		"Csus4":  {I1: note.C, I4: note.F, I5: note.G},
+		"Csus 4": {I1: note.C, I4: note.F, I5: note.G},
+		"Csus2":  {I1: note.C, I2: note.D, I5: note.G},
+		"C5":     {I1: note.C, I5: note.G},
+	} {
+		c, err := Parse(name, WithStrict())
+		assert.Nil(t, err, name)
+		assert.Equal(t, expect, classesOf(c), name)
+	}
+}
+
+func TestParse_StrictPlus(t *testing.T) {
+	for name, expect := range map[string]*ParseError{
+		"C+":  {Name: "C+", Position: 1, Text: "+", Err: ErrUnknownForm},
+		"C+6": {Name: "C+6", Position: 1, Text: "+", Err: ErrUnknownForm},
+		"C +": {Name: "C +", Position: 2, Text: "+", Err: ErrUnknownForm},
+	} {
+		_, err := Parse(name, WithStrict())
+		assert.Equal(t, expect, err, name)
+	}
+}
+
+func TestParse_StrictUnknownForm(t *testing.T) {
+	_, err := Parse("C minor 7th", WithStrict())
+	assert.Equal(t, &ParseError{Name: "C minor 7th", Position: 9, Text: "th", Err: ErrUnknownForm}, err)
+	_, err = Parse("C minor 7th")
+	assert.Nil(t, err)
+}
+
+func TestParse_StrictAmbiguous(t *testing.T) {
+	_, err := Parse("C nondominant 7", WithStrict())
+	assert.Equal(t, &ParseError{Name: "C nondominant 7", Position: 5, Text: "dominant 7", Err: ErrAmbiguous}, err)
+	_, err = Parse("C nondominant 7")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,160 @@
+// The quality of a chord classifies it by the intervals of its tones from the root, its triad, seventh, extensions, alterations and suspensions, e.g. a major triad with a minor seventh and a sharp 11th.
+package chord
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Quality of a chord, classified by the semitones from its root to each of its tones
+type Quality struct {
+	Triad       TriadQuality
+	Seventh     SeventhQuality
+	Extensions  []string // tones added, unaltered, from the root, e.g. "6" or "9" or "13"
+	Alterations []string // tones raised or lowered from the fifth or an extension, e.g. "b5" or "#9" or "b13"
+	Suspensions []string // tones sounding in place of a third, e.g. "sus4"
+}
+
+// TriadQuality of a chord, by its third and fifth, e.g. MajorTriad
+type TriadQuality int
+
+const (
+	NoTriad TriadQuality = iota // neither a third nor a suspension of it, e.g. a power chord
+	MajorTriad
+	MinorTriad
+	DiminishedTriad
+	AugmentedTriad
+	SuspendedTriad // a second or fourth in place of the third
+)
+
+// String of the triad quality, e.g. "major"
+func (of TriadQuality) String() string {
+	switch of {
+	case MajorTriad:
+		return "major"
+	case MinorTriad:
+		return "minor"
+	case DiminishedTriad:
+		return "diminished"
+	case AugmentedTriad:
+		return "augmented"
+	case SuspendedTriad:
+		return "suspended"
+	}
+	return "no"
+}
+
+// SeventhQuality of a chord, by its seventh, e.g. MinorSeventh for a dominant seventh chord
+type SeventhQuality int
+
+const (
+	NoSeventh SeventhQuality = iota
+	MinorSeventh
+	MajorSeventh
+	DiminishedSeventh
+)
+
+// String of the seventh quality, e.g. "minor seventh"
+func (of SeventhQuality) String() string {
+	switch of {
+	case MinorSeventh:
+		return "minor seventh"
+	case MajorSeventh:
+		return "major seventh"
+	case DiminishedSeventh:
+		return "diminished seventh"
+	}
+	return "no seventh"
+}
+
+// Quality of the chord, e.g. of C E G Bb F#, a major triad with a minor seventh and an alteration, #11, or of "C7#9", an alteration, #9.
+// The names of chords don't parse the alterations #11, b9 or b13 yet, so classify a chord of them built by its tones
+func (this Chord) Quality() Quality {
+	var q Quality
+	third, hasThird := this.semitonesTo(I3)
+	fifth, hasFifth := this.semitonesTo(I5)
+	if !hasFifth {
+		fifth = 7
+	}
+	switch {
+	case !hasThird:
+		for _, i := range []Interval{I2, I4} {
+			if semitones, ok := this.semitonesTo(i); ok {
+				q.Triad = SuspendedTriad
+				q.Suspensions = append(q.Suspensions, "sus"+degreeOf(i, semitones).String())
+			}
+		}
+	case third == 3 && fifth == 6:
+		q.Triad = DiminishedTriad
+	case third == 4 && fifth == 8:
+		q.Triad = AugmentedTriad
+	case third == 3:
+		q.Triad = MinorTriad
+	default:
+		q.Triad = MajorTriad
+	}
+	if fifth != 7 && q.Triad != DiminishedTriad && q.Triad != AugmentedTriad {
+		q.Alterations = append(q.Alterations, degreeOf(I5, fifth).String())
+	}
+	switch seventh, _ := this.semitonesTo(I7); seventh {
+	case 10:
+		q.Seventh = MinorSeventh
+	case 11:
+		q.Seventh = MajorSeventh
+	case 9:
+		q.Seventh = DiminishedSeventh
+	}
+	for _, i := range []Interval{I2, I4, I6, I9, I11, I13} {
+		semitones, ok := this.semitonesTo(i)
+		if !ok || (q.Triad == SuspendedTriad && (i == I2 || i == I4)) {
+			continue
+		}
+		if name := degreeOf(i, semitones).String(); strings.ContainsAny(name, "b#") {
+			q.Alterations = append(q.Alterations, name)
+		} else {
+			q.Extensions = append(q.Extensions, name)
+		}
+	}
+	return q
+}
+
+// String of the quality, its triad, seventh, and any extensions, alterations and suspensions, e.g. "major triad, minor seventh, 9, #11"
+func (q Quality) String() string {
+	parts := []string{q.Triad.String() + " triad"}
+	if q.Seventh != NoSeventh {
+		parts = append(parts, q.Seventh.String())
+	}
+	parts = append(parts, q.Extensions...)
+	parts = append(parts, q.Alterations...)
+	parts = append(parts, q.Suspensions...)
+	return strings.Join(parts, ", ")
+}
+
+// IsDominant seventh chord, a major triad with a minor seventh, e.g. "G7" or "G9"
+func (q Quality) IsDominant() bool {
+	return q.Triad == MajorTriad && q.Seventh == MinorSeventh
+}
+
+// IsHalfDiminished seventh chord, a diminished triad with a minor seventh, e.g. "Bm7b5"
+func (q Quality) IsHalfDiminished() bool {
+	return q.Triad == DiminishedTriad && q.Seventh == MinorSeventh
+}
+
+// IsAltered chord, with any fifth or extension raised or lowered, e.g. "G7#9"
+func (q Quality) IsAltered() bool {
+	return len(q.Alterations) > 0
+}
+
+//
+// Private
+//
+
+// semitonesTo the tone of an interval, up from the root, within the octave, and whether the chord has it
+func (this Chord) semitonesTo(i Interval) (int, bool) {
+	class, ok := this.ClassAt(i)
+	if !ok || this.Root == note.Nil || class == note.Nil {
+		return 0, false
+	}
+	return (this.Root.Diff(class) + 12) % 12, true
+}
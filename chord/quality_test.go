@@ -0,0 +1,70 @@
+// The quality of a chord classifies it by the intervals of its tones from the root, its triad, seventh, extensions, alterations and suspensions, e.g. a major triad with a minor seventh and a sharp 11th.
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestQuality_Triads(t *testing.T) {
+	assert.Equal(t, MajorTriad, Of("C").Quality().Triad)
+	assert.Equal(t, MinorTriad, Of("Cm").Quality().Triad)
+	assert.Equal(t, DiminishedTriad, Of("Cdim").Quality().Triad)
+	assert.Equal(t, AugmentedTriad, Of("Caug").Quality().Triad)
+	assert.Equal(t, Quality{Triad: SuspendedTriad, Suspensions: []string{"sus4"}}, Of("Csus4").Quality())
+	assert.Equal(t, Quality{}, Chord{Root: note.C, Tones: []Tone{{Degree: Degree{I1, Natural}, Class: note.C}, {Degree: Degree{I5, Natural}, Class: note.G}}}.Quality())
+}
+
+func TestQuality_Sevenths(t *testing.T) {
+	assert.Equal(t, Quality{Triad: MajorTriad, Seventh: MinorSeventh}, Of("C7").Quality())
+	assert.Equal(t, MajorSeventh, Of("Cmaj7").Quality().Seventh)
+	assert.Equal(t, MinorSeventh, Of("Cm7").Quality().Seventh)
+	assert.Equal(t, DiminishedSeventh, Of("Cdim7").Quality().Seventh)
+	assert.Equal(t, NoSeventh, Of("C6").Quality().Seventh)
+	assert.True(t, Of("G7").Quality().IsDominant())
+	assert.True(t, Of("G9").Quality().IsDominant())
+	thirteenth := Chord{Root: note.G, Tones: []Tone{{Degree: Degree{I1, Natural}, Class: note.G}, {Degree: Degree{I3, Natural}, Class: note.B}, {Degree: Degree{I5, Natural}, Class: note.D}, {Degree: Flat7, Class: note.F}, {Degree: Natural9, Class: note.A}, {Degree: Natural13, Class: note.E}}}
+	assert.True(t, thirteenth.Quality().IsDominant())
+	assert.Equal(t, []string{"9", "13"}, thirteenth.Quality().Extensions)
+	assert.False(t, Of("Gm7").Quality().IsDominant())
+	assert.True(t, Of("Bm7b5").Quality().IsHalfDiminished())
+	assert.False(t, Of("Bdim7").Quality().IsHalfDiminished())
+}
+
+func TestQuality_ExtensionsAndAlterations(t *testing.T) {
+	assert.Equal(t, Quality{Triad: MajorTriad, Extensions: []string{"6", "9"}}, Of("C69").Quality())
+	lydian := Chord{Root: note.C, Tones: []Tone{{Degree: Degree{I1, Natural}, Class: note.C}, {Degree: Degree{I3, Natural}, Class: note.E}, {Degree: Degree{I5, Natural}, Class: note.G}, {Degree: Natural7, Class: note.B}, {Degree: Natural9, Class: note.D}, {Degree: Sharp11, Class: note.Fs}}}
+	assert.Equal(t, Quality{Triad: MajorTriad, Seventh: MajorSeventh, Extensions: []string{"9"}, Alterations: []string{"#11"}}, lydian.Quality())
+	sharpEleven := Chord{Root: note.C, Tones: []Tone{{Degree: Degree{I1, Natural}, Class: note.C}, {Degree: Degree{I3, Natural}, Class: note.E}, {Degree: Degree{I5, Natural}, Class: note.G}, {Degree: Flat7, Class: note.As}, {Degree: Sharp11, Class: note.Fs}}}
+	assert.Equal(t, Quality{Triad: MajorTriad, Seventh: MinorSeventh, Alterations: []string{"#11"}}, sharpEleven.Quality())
+	assert.Equal(t, Quality{Triad: MajorTriad, Seventh: MinorSeventh, Alterations: []string{"#9"}}, Of("C7#9").Quality())
+	assert.Equal(t, Quality{Triad: MajorTriad, Seventh: MinorSeventh, Alterations: []string{"b5"}}, Of("C7b5").Quality())
+	assert.True(t, Of("C7#9").Quality().IsAltered())
+	assert.False(t, Of("C9").Quality().IsAltered())
+	c := Chord{Root: note.G, Tones: []Tone{{Degree: Degree{I1, Natural}, Class: note.G}, {Degree: Degree{I3, Natural}, Class: note.B}, {Degree: Degree{I5, Natural}, Class: note.D}, {Degree: Flat7, Class: note.F}, {Degree: Flat9, Class: note.Gs}, {Degree: Flat13, Class: note.Ds}}}
+	assert.Equal(t, []string{"b9", "b13"}, c.Quality().Alterations)
+}
+
+func TestQuality_String(t *testing.T) {
+	assert.Equal(t, "major triad", Of("C").Quality().String())
+	assert.Equal(t, "major triad, minor seventh, #9", Of("C7#9").Quality().String())
+	assert.Equal(t, "minor triad, minor seventh, 9, 11", Of("Cm11").Quality().String())
+	assert.Equal(t, "suspended triad, sus4", Of("Csus4").Quality().String())
+	assert.Equal(t, "no triad", Quality{}.String())
+}
+
+func TestQuality_NilRoot(t *testing.T) {
+	assert.Equal(t, Quality{}, Of("garbage").Quality())
+	assert.Equal(t, Quality{}, Chord{}.Quality())
+}
+
+func TestExtensionNameOf(t *testing.T) {
+	assert.Equal(t, "9", degreeOf(I9, 2).String())
+	assert.Equal(t, "b9", degreeOf(I9, 1).String())
+	assert.Equal(t, "#11", degreeOf(I11, 6).String())
+	assert.Equal(t, "b13", degreeOf(I13, 8).String())
+	assert.Equal(t, "b5", degreeOf(I5, 6).String())
+}
@@ -0,0 +1,125 @@
+// Chord forms can be registered by library users, or loaded from a YAML file, to extend the built-in forms without forking.
+package chord
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/match"
+)
+
+// ErrDuplicateForm when registering a form with the same name as a known form
+var ErrDuplicateForm = errors.New("duplicate form")
+
+// RegisterForm named name, matching the regular expression pattern in a chord name, which adds tones by semitones
+// from the root and omits intervals, e.g. RegisterForm("Mu", "^mu$", FormAdd{I2: 2}, nil).
+// Registered forms are applied after all the built-in forms, in the order they are registered.
+// It's safe to register a form while chords are parsed in other goroutines, each parsed by the forms known when it began.
+func RegisterForm(name string, pattern string, add FormAdd, omit FormOmit) error {
+	f, err := formOf(name, pattern, add, omit)
+	if err != nil {
+		return err
+	}
+	return registerForms(f)
+}
+
+// LoadForms from YAML and register each of them, e.g.
+//
+//     - name: Mu
+//       match: ^mu$
+//       add:
+//         2: 2
+//
+// All of them are registered together, or none if any is invalid or a duplicate, and no chord is parsed by only some of them.
+func LoadForms(r io.Reader) error {
+	in, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var specs []specForm
+	err = yaml.UnmarshalStrict(in, &specs)
+	if err != nil {
+		return err
+	}
+	var loaded []Form
+	for _, s := range specs {
+		f, err := formOf(s.Name, s.Match, s.Add, s.Omit)
+		if err != nil {
+			return err
+		}
+		loaded = append(loaded, f)
+	}
+	return registerForms(loaded...)
+}
+
+// LoadFormsFile at a path, e.g. ~/.config/music-theory/chords.yaml, and register each of them
+func LoadFormsFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = LoadForms(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+//
+// Private
+//
+
+// registry guards the known forms, which registering replaces with a longer copy, never changing a slice of them that parsing might be reading
+var registry sync.RWMutex
+
+// knownForms, the built-in forms and any registered since, as they are now
+func knownForms() []Form {
+	registry.RLock()
+	defer registry.RUnlock()
+	return forms
+}
+
+// formOf a name, compiling the regular expression pattern it matches in a chord name, to add tones and omit intervals
+func formOf(name string, pattern string, add FormAdd, omit FormOmit) (Form, error) {
+	pos, err := match.Compile(pattern)
+	if err != nil {
+		return Form{}, fmt.Errorf("form %q: %w", name, err)
+	}
+	return Form{Name: name, pos: pos, add: add, omit: omit, whole: longest(pos)}, nil
+}
+
+// registerForms after the known forms, all at once under the lock, or none of them if any has the name of a known form or of another of them
+func registerForms(fs ...Form) error {
+	registry.Lock()
+	defer registry.Unlock()
+	names := make(map[string]bool, len(forms)+len(fs))
+	for _, f := range forms {
+		names[f.Name] = true
+	}
+	for _, f := range fs {
+		if names[f.Name] {
+			return fmt.Errorf("%w %q", ErrDuplicateForm, f.Name)
+		}
+		names[f.Name] = true
+	}
+	list := ChordFormList[:len(ChordFormList):len(ChordFormList)]
+	for _, f := range fs {
+		list = append(list, f.Name)
+	}
+	forms, ChordFormList = append(forms[:len(forms):len(forms)], fs...), list
+	return nil
+}
+
+type specForm struct {
+	Name  string
+	Match string
+	Add   FormAdd
+	Omit  FormOmit
+}
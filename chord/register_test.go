@@ -0,0 +1,128 @@
+// Chord forms can be registered by library users, or loaded from a YAML file, to extend the built-in forms without forking.
+package chord
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestRegisterForm(t *testing.T) {
+	defer restoreForms()()
+
+	err := RegisterForm("Power", "^5$", FormAdd{I1: 0, I5: 7}, FormOmit{I3})
+	assert.Nil(t, err)
+
+	c := Of("C5")
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I5: note.G}, classesOf(c))
+	assert.Equal(t, "Power", ChordFormList[len(ChordFormList)-1])
+
+	_, err = Parse("C5", WithStrict())
+	assert.Nil(t, err)
+}
+
+func TestRegisterForm_WhileParsing(t *testing.T) {
+	defer restoreForms()()
+
+	var wg sync.WaitGroup
+	for n := 0; n < 8; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				assert.Equal(t, note.Ds, classAt(Of("Cm7"), I3))
+				_, err := Parse("Cm7", WithStrict())
+				assert.Nil(t, err)
+				assert.NotEmpty(t, FormNames())
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		assert.Nil(t, RegisterForm(fmt.Sprintf("Concurrent %d", i), fmt.Sprintf("^concurrent%d$", i), FormAdd{I1: 0}, FormOmit{}))
+	}
+	wg.Wait()
+	assert.Equal(t, "Concurrent 19", FormNames()[len(FormNames())-1])
+}
+
+func TestRegisterForm_Duplicate(t *testing.T) {
+	defer restoreForms()()
+
+	err := RegisterForm("Minor Triad", "^q", FormAdd{}, FormOmit{})
+	assert.True(t, errors.Is(err, ErrDuplicateForm))
+	assert.Equal(t, `duplicate form "Minor Triad"`, err.Error())
+}
+
+func TestRegisterForm_InvalidPattern(t *testing.T) {
+	defer restoreForms()()
+
+	err := RegisterForm("Broken", "(", FormAdd{}, FormOmit{})
+	assert.NotNil(t, err)
+	assert.Equal(t, len(forms), len(ChordFormList))
+	assert.NotEqual(t, "Broken", ChordFormList[len(ChordFormList)-1])
+}
+
+func TestLoadForms(t *testing.T) {
+	defer restoreForms()()
+
+	err := LoadForms(strings.NewReader("- name: Power\n  match: ^5$\n  add:\n    1: 0\n    5: 7\n  omit: [3]\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[Interval]note.Class{I1: note.G, I5: note.D}, classesOf(Of("G5")))
+}
+
+func TestLoadForms_Invalid(t *testing.T) {
+	defer restoreForms()()
+
+	assert.NotNil(t, LoadForms(strings.NewReader("- name: Power\n  pattern: ^5$\n")))
+	assert.NotNil(t, LoadForms(strings.NewReader("not a list")))
+}
+
+func TestLoadForms_AllOrNone(t *testing.T) {
+	defer restoreForms()()
+	known := len(forms)
+
+	err := LoadForms(strings.NewReader("- name: Power\n  match: ^5$\n  add: {1: 0, 5: 7}\n  omit: [3]\n- name: Broken\n  match: (\n"))
+	assert.NotNil(t, err)
+	err = LoadForms(strings.NewReader("- name: Power\n  match: ^5$\n  add: {1: 0, 5: 7}\n  omit: [3]\n- name: Power\n  match: ^pow$\n"))
+	assert.True(t, errors.Is(err, ErrDuplicateForm))
+	err = LoadForms(strings.NewReader("- name: Power\n  match: ^5$\n  add: {1: 0, 5: 7}\n  omit: [3]\n- name: Minor Triad\n  match: ^q$\n"))
+	assert.True(t, errors.Is(err, ErrDuplicateForm))
+	assert.Equal(t, known, len(forms), "of none of them registered")
+	assert.Equal(t, len(forms), len(ChordFormList))
+}
+
+func TestLoadFormsFile(t *testing.T) {
+	defer restoreForms()()
+
+	dir, err := ioutil.TempDir("", "chord")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "chords.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("- name: Power\n  match: ^5$\n  add: {1: 0, 5: 7}\n  omit: [3]\n"), 0644))
+
+	assert.Nil(t, LoadFormsFile(path))
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I5: note.G}, classesOf(Of("C5")))
+
+	assert.True(t, os.IsNotExist(LoadFormsFile(filepath.Join(dir, "missing.yaml"))))
+}
+
+//
+// Private
+//
+
+// restoreForms returns a func to restore the known forms and their list, e.g. deferred after registering forms in a test
+func restoreForms() func() {
+	oldForms := append([]Form(nil), forms...)
+	oldList := append(List(nil), ChordFormList...)
+	return func() {
+		forms, ChordFormList = oldForms, oldList
+	}
+}
@@ -0,0 +1,111 @@
+// Chord names imported from a chart, e.g. scanned by OCR or typed in a hurry, are repaired of common typos before they're parsed, e.g. "Cmi7" to "Cm7", returning each correction applied
+package chord
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Correction of a chord name, some text replaced for a reason, e.g. "mi" with "m" for minor
+type Correction struct {
+	Text        string
+	Replacement string
+	Reason      string
+}
+
+// String of the correction, e.g. `"mi" to "m" (minor)`
+func (c Correction) String() string {
+	return fmt.Sprintf("%q to %q (%s)", c.Text, c.Replacement, c.Reason)
+}
+
+// Repair a chord name of common typos and OCR artifacts, e.g. "Cmi7" or "C-7" to "Cm7", though not the omitted fifth of "C-5", "Co7" to "Cdim7", "Cmaj 7" to "Cmaj7" or " cm7 " to "Cm7",
+// returning the repaired name and each correction applied, in order, or none if it needed none
+func Repair(name string) (string, []Correction) {
+	var corrections []Correction
+	for _, r := range nameRepairs {
+		name = r.apply(name, &corrections)
+	}
+	root, remaining := note.RootAndRemaining(name)
+	if root == note.Nil {
+		return name, corrections
+	}
+	prefix := strings.TrimSpace(name[:len(name)-len(remaining)])
+	remaining = name[len(prefix):]
+	for _, r := range formRepairs {
+		remaining = r.apply(remaining, &corrections)
+	}
+	return prefix + remaining, corrections
+}
+
+// ParseRepaired a chord name, tolerantly, repairing it before it's parsed, e.g. ParseRepaired("C-7") of Cm7, returning each correction applied,
+// and a *ParseError of the repaired name if even that can't be parsed
+func ParseRepaired(name string, options ...Option) (Chord, []Correction, error) {
+	repaired, corrections := Repair(name)
+	c, err := Parse(repaired, options...)
+	return c, corrections, err
+}
+
+//
+// Private
+//
+
+// repair of some text of a chord name, matched as the second of three groups of a regular expression, between the text before and after it, replaced for a reason
+type repair struct {
+	reason  string
+	rgx     *regexp.Regexp
+	replace func(text string) string
+}
+
+// nameRepairs applied to the whole of a chord name, before its root is found
+var nameRepairs = []repair{
+	{"stray space", regexp.MustCompile(`^()(\s+)()`), with("")},
+	{"stray space", regexp.MustCompile(`()(\s+)()$`), with("")},
+	{"stray space", regexp.MustCompile(`()(\s\s+|[\t\n\r\f\v]\s*)()`), with(" ")},
+	{"symbol", regexp.MustCompile(`()([^\x00-\x7F])()`), symbol.Normalize},
+	{"lowercase root", regexp.MustCompile(`^()([a-g])([#b]?(?:[^a-z]|$|maj|min|mi|dim|aug|sus|add|m))`), strings.ToUpper},
+	{"lowercase bass", regexp.MustCompile(`(/\s*)([a-g])([#b]?$)`), strings.ToUpper},
+}
+
+// formRepairs applied to the rest of a chord name after its root, from the space before it, if any
+var formRepairs = []repair{
+	{"minor", regexp.MustCompile(`^()(-)(7|9|11|13|$)`), with("m")},
+	{"minor", regexp.MustCompile(`^(\s?)(mi|mn|rn)([^a-z]|$)`), with("m")},
+	{"diminished", regexp.MustCompile(`^(\s?)(o|°|º)([^a-z]|$)`), with("dim")},
+	{"half-diminished", regexp.MustCompile(`^(\s?)(ø7?|Ø7?)()`), with("m7b5")},
+	{"major seventh", regexp.MustCompile(`^(\s?)(Δ7?|\^7?)([^0-9]|$)`), with("maj7")},
+	{"major", regexp.MustCompile(`^(\s?)(Δ|\^)([0-9])`), with("maj")},
+	{"augmented", regexp.MustCompile(`^(\s?)(\+)()`), with("aug")},
+	{"stray space", regexp.MustCompile(`^()(\s)([0-9#b])`), with("")},
+	{"stray space", regexp.MustCompile(`^(\s?(?:maj|min|m|M|dim|aug|sus|add))(\s)([0-9])`), with("")},
+}
+
+// with a replacement, whatever the text replaced
+func with(replacement string) func(string) string {
+	return func(string) string { return replacement }
+}
+
+// apply the repair to every match in a name, adding a correction of each to those so far
+func (r repair) apply(name string, corrections *[]Correction) string {
+	var b strings.Builder
+	end, changed := 0, false
+	for _, loc := range r.rgx.FindAllStringSubmatchIndex(name, -1) {
+		text := name[loc[4]:loc[5]]
+		replacement := r.replace(text)
+		if replacement == text {
+			continue
+		}
+		b.WriteString(name[end:loc[4]])
+		b.WriteString(replacement)
+		end, changed = loc[5], true
+		*corrections = append(*corrections, Correction{Text: text, Replacement: replacement, Reason: r.reason})
+	}
+	if !changed {
+		return name
+	}
+	b.WriteString(name[end:])
+	return b.String()
+}
@@ -0,0 +1,82 @@
+// Chord names imported from a chart, e.g. scanned by OCR or typed in a hurry, are repaired of common typos before they're parsed, e.g. "Cmi7" to "Cm7", returning each correction applied
+package chord
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestRepair(t *testing.T) {
+	for name, repaired := range map[string]string{
+		"Cmin7":     "Cmin7",
+		"Cmi7":      "Cm7",
+		"C-7":       "Cm7",
+		"C–7":       "Cm7",
+		"Crn7":      "Cm7",
+		"C-7b5":     "Cm7b5",
+		"C-":        "Cm",
+		"C-9":       "Cm9",
+		"C-11":      "Cm11",
+		"C-13":      "Cm13",
+		"C-5":       "C-5",
+		"C -7":      "C -7",
+		"Cmaj 7":    "Cmaj7",
+		"C maj 7":   "C maj7",
+		"C 7":       "C7",
+		" cm7 ":     "Cm7",
+		"C  m7":     "C m7",
+		"C\tm7":     "C m7",
+		"bb7":       "Bb7",
+		"f#m7":      "F#m7",
+		"C/e":       "C/E",
+		"Co7":       "Cdim7",
+		"C°":        "Cdim",
+		"Cø7":       "Cm7b5",
+		"CΔ":        "Cmaj7",
+		"CΔ9":       "Cmaj9",
+		"C^7":       "Cmaj7",
+		"C+":        "Caug",
+		"C minor 7": "C minor 7",
+		"C7b9":      "C7b9",
+		"H7":        "H7",
+		"":          "",
+	} {
+		actual, _ := Repair(name)
+		assert.Equal(t, repaired, actual, name)
+	}
+}
+
+func TestRepair_Corrections(t *testing.T) {
+	repaired, corrections := Repair(" c–7 ")
+	assert.Equal(t, "Cm7", repaired)
+	assert.Equal(t, []Correction{
+		{Text: " ", Replacement: "", Reason: "stray space"},
+		{Text: " ", Replacement: "", Reason: "stray space"},
+		{Text: "–", Replacement: "-", Reason: "symbol"},
+		{Text: "c", Replacement: "C", Reason: "lowercase root"},
+		{Text: "-", Replacement: "m", Reason: "minor"},
+	}, corrections)
+	_, corrections = Repair("Cm7")
+	assert.Nil(t, corrections)
+}
+
+func TestCorrection_String(t *testing.T) {
+	assert.Equal(t, `"mi" to "m" (minor)`, Correction{Text: "mi", Replacement: "m", Reason: "minor"}.String())
+}
+
+func TestParseRepaired(t *testing.T) {
+	c, corrections, err := ParseRepaired("C-7")
+	assert.Nil(t, err)
+	assert.True(t, c.Equal(Of("Cm7")))
+	assert.Equal(t, []Correction{{Text: "-", Replacement: "m", Reason: "minor"}}, corrections)
+	c, _, err = ParseRepaired("Co7", WithStrict())
+	assert.Nil(t, err)
+	assert.True(t, c.Equal(Of("Cdim7")))
+	c, _, err = ParseRepaired("Cø")
+	assert.Nil(t, err)
+	assert.Equal(t, "Cm7b5", c.Name())
+	_, _, err = ParseRepaired("C jams")
+	assert.True(t, errors.Is(err, ErrUnknownForm))
+}
@@ -0,0 +1,91 @@
+// Chords can be searched by the tones they contain, over a catalog of the common chords on every root, each scored by how closely its tones match
+package chord
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+// Catalog of the chords searched on every root, by their symbol after the root, from the most to the least common of those with the same tones;
+// add to it at startup, before anything is searched
+var Catalog = List{
+	"", "m", "dim", "aug", "sus4",
+	"6", "m6", "7", "M7", "m7", "m7b5", "dim7", "mM7", "aug7", "7b5",
+	"add9", "69", "m69", "9", "M9", "m9", "7#9",
+	"11", "m11", "13", "M13", "m13",
+}
+
+// SearchOptions of a search of the catalog, by the tones to search for, spelled with an accidental
+type SearchOptions struct {
+	Tones     toneset.Set
+	AdjSymbol note.AdjSymbol
+	Exact     bool // only chords of exactly the tones, not more
+	Partial   bool // also chords of only some of the tones
+}
+
+// Found chord of a search, scored by the similarity of its tones to those searched for, from 0 to 1 of exactly them,
+// and the tones searched for that it's missing, and the extra tones it has, each in ascending order from C
+type Found struct {
+	Chord   Chord
+	Score   float64
+	Missing []note.Class
+	Extra   []note.Class
+}
+
+// Search the catalog of chords on every root for those containing the tones, or exactly or only some of them, as the options say,
+// in descending order of their score, and then of the catalog, e.g. C9 before FM13 of C E G Bb D
+func Search(opts SearchOptions) []Found {
+	var found []Found
+	if len(opts.Tones) == 0 {
+		return found
+	}
+	for root := note.C; root <= note.B; root++ {
+		var sets []toneset.Set
+		for _, symbol := range Catalog {
+			c := Of(root.String(opts.AdjSymbol) + symbol)
+			tones := c.ToneSet()
+			if searchedAlready(sets, tones) {
+				continue
+			}
+			sets = append(sets, tones)
+			f, ok := foundOf(opts, tones)
+			if ok {
+				f.Chord = c
+				found = append(found, f)
+			}
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].Score > found[j].Score })
+	return found
+}
+
+//
+// Private
+//
+
+// foundOf the tones of a candidate, scored against the tones searched for, or false if the options exclude it
+func foundOf(opts SearchOptions, tones toneset.Set) (Found, bool) {
+	missing := opts.Tones.Difference(tones)
+	extra := tones.Difference(opts.Tones)
+	switch {
+	case opts.Exact && (len(missing) > 0 || len(extra) > 0):
+		return Found{}, false
+	case !opts.Partial && len(missing) > 0:
+		return Found{}, false
+	case len(missing) == len(opts.Tones):
+		return Found{}, false
+	}
+	return Found{Score: opts.Tones.Similarity(tones), Missing: missing.Classes(), Extra: extra.Classes()}, true
+}
+
+// searchedAlready a set of tones, the same as one of some sets, e.g. of another symbol on the same root
+func searchedAlready(sets []toneset.Set, tones toneset.Set) bool {
+	for _, s := range sets {
+		if s.Equal(tones) {
+			return true
+		}
+	}
+	return false
+}
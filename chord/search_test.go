@@ -0,0 +1,52 @@
+// Chords can be searched by the tones they contain, over a catalog of the common chords on every root, each scored by how closely its tones match
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestSearch(t *testing.T) {
+	found := Search(SearchOptions{Tones: toneset.Of(note.C, note.E, note.G, note.As, note.D), AdjSymbol: note.Flat})
+	assert.Equal(t, []string{"C9", "FM13", "Gm13"}, foundNames(found))
+	assert.Equal(t, 1.0, found[0].Score)
+	assert.Empty(t, found[0].Missing)
+	assert.Empty(t, found[0].Extra)
+	assert.Equal(t, []note.Class{note.F, note.A}, found[1].Extra)
+}
+
+func TestSearch_Exact(t *testing.T) {
+	found := Search(SearchOptions{Tones: toneset.Of(note.C, note.E, note.G, note.A), Exact: true})
+	assert.Equal(t, []string{"C6", "Am7"}, foundNames(found))
+	assert.Equal(t, 0, len(Search(SearchOptions{Tones: toneset.Of(note.C, note.Cs, note.D), Exact: true})))
+}
+
+func TestSearch_Partial(t *testing.T) {
+	found := Search(SearchOptions{Tones: toneset.Of(note.C, note.E, note.G, note.As, note.D), AdjSymbol: note.Flat, Partial: true})
+	assert.Equal(t, []string{"C9", "C7", "Cadd9", "Em7b5", "Gm6"}, foundNames(found[:5]))
+	assert.Equal(t, 0.8, found[1].Score)
+	assert.Equal(t, []note.Class{note.D}, found[1].Missing)
+	for _, f := range found {
+		assert.True(t, len(f.Missing) < 5, f.Chord.Name())
+	}
+}
+
+func TestSearch_None(t *testing.T) {
+	assert.Equal(t, 0, len(Search(SearchOptions{})))
+}
+
+//
+// Private
+//
+
+func foundNames(found []Found) []string {
+	var names []string
+	for _, f := range found {
+		names = append(names, f.Chord.Name())
+	}
+	return names
+}
@@ -0,0 +1,59 @@
+// Chords can be found by their shape, a stack of intervals from the root, e.g. 4 3 3 of a dominant seventh chord, as transcribed before it's named
+package chord
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+// Shaped chord of a stack of intervals, by its symbol in the catalog after the root, e.g. "7" of 4 3 3, or "" of a major triad,
+// and the semitones above the root of the tone of it missing from the stack, if any, e.g. 7 of the fifth of 4 6
+type Shaped struct {
+	Symbol  string
+	Missing []int
+}
+
+// FindByIntervals of a stack, the semitones from the root to each tone and from each tone to the next, e.g. FindByIntervals([]int{4, 3, 3}),
+// the symbols of the chords in the catalog of exactly those tones, and then of those with one more tone, missing from the stack
+func FindByIntervals(stack []int) []Shaped {
+	if len(stack) == 0 {
+		return nil
+	}
+	tones := toneset.Of(note.C)
+	semitones := 0
+	for _, interval := range stack {
+		semitones += interval
+		class, _ := note.C.Step(semitones)
+		tones[class] = true
+	}
+	var exact, fuzzy []Shaped
+	var sets []toneset.Set
+	for _, symbol := range Catalog {
+		c := Of("C" + symbol)
+		chordTones := c.ToneSet()
+		if searchedAlready(sets, chordTones) || !chordTones.ContainsAll(tones) {
+			continue
+		}
+		sets = append(sets, chordTones)
+		switch missing := chordTones.Difference(tones); len(missing) {
+		case 0:
+			exact = append(exact, Shaped{Symbol: symbol})
+		case 1:
+			fuzzy = append(fuzzy, Shaped{Symbol: symbol, Missing: semitonesAboveC(missing)})
+		}
+	}
+	return append(exact, fuzzy...)
+}
+
+//
+// Private
+//
+
+// semitonesAboveC of each pitch class of a set, in ascending order
+func semitonesAboveC(s toneset.Set) []int {
+	var semitones []int
+	for _, class := range s.Classes() {
+		semitones = append(semitones, int(class-note.C))
+	}
+	return semitones
+}
@@ -0,0 +1,28 @@
+// Chords can be found by their shape, a stack of intervals from the root, e.g. 4 3 3 of a dominant seventh chord, as transcribed before it's named
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestFindByIntervals(t *testing.T) {
+	assert.Equal(t, []Shaped{{Symbol: "7"}, {Symbol: "9", Missing: []int{2}}, {Symbol: "7#9", Missing: []int{3}}}, FindByIntervals([]int{4, 3, 3}))
+	assert.Equal(t, []Shaped{{Symbol: "dim"}, {Symbol: "m7b5", Missing: []int{10}}, {Symbol: "dim7", Missing: []int{9}}}, FindByIntervals([]int{3, 3}))
+	assert.Equal(t, []Shaped{{Symbol: "M9"}}, FindByIntervals([]int{4, 3, 4, 3}))
+}
+
+func TestFindByIntervals_Missing(t *testing.T) {
+	assert.Equal(t, []Shaped{{Symbol: "7", Missing: []int{7}}, {Symbol: "aug7", Missing: []int{8}}, {Symbol: "7b5", Missing: []int{6}}}, FindByIntervals([]int{4, 6}))
+	assert.Equal(t, []Shaped{{Symbol: "", Missing: []int{4}}, {Symbol: "m", Missing: []int{3}}, {Symbol: "sus4", Missing: []int{5}}}, FindByIntervals([]int{7}))
+}
+
+func TestFindByIntervals_Compound(t *testing.T) {
+	assert.Equal(t, FindByIntervals([]int{4, 3}), FindByIntervals([]int{16, -9}))
+}
+
+func TestFindByIntervals_None(t *testing.T) {
+	assert.Nil(t, FindByIntervals(nil))
+	assert.Nil(t, FindByIntervals([]int{1, 1, 1}))
+}
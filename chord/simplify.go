@@ -0,0 +1,125 @@
+// Chords are simplified to an easier symbol of the same function, for a beginner's chart, dropping the tensions, sevenths or added tones they can do without,
+// e.g. C7 of C9#11, or Am of Am7, by a level of how far to go, and in a key, restoring any third left out with the third of its key signature, e.g. Dm of Dsus4 in C major
+package chord
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// SimplifyLevel of a chord, how far it's simplified, from none to its triad
+type SimplifyLevel int
+
+const (
+	SimplifyNone     SimplifyLevel = iota // as it is
+	SimplifyTensions                      // without its 9th, 11th and 13th, altered or added, e.g. C7 of C9#11, or C of Cadd9
+	SimplifySevenths                      // to its triad and seventh, also without an added 6th or an altered fifth of a major or minor triad, e.g. C7 of C7b5, or C of C6
+	SimplifyTriads                        // to its triad, without a seventh, or any slash bass, and with a suspension resolved to a third, e.g. Am of Am7, or G of G7sus4/C
+)
+
+// SimplifyLevelNames of each level, for SimplifyLevelOf, from the least simplified
+var SimplifyLevelNames = []string{"none", "tensions", "sevenths", "triads"}
+
+// ErrUnknownSimplifyLevel when naming a level that isn't one of the SimplifyLevelNames, e.g. "beginner"
+var ErrUnknownSimplifyLevel = errors.New("unknown simplify level")
+
+// SimplifyLevelOf one of the SimplifyLevelNames, e.g. SimplifyLevelOf("triads"), or of an empty name, SimplifyNone
+func SimplifyLevelOf(name string) (SimplifyLevel, error) {
+	if len(name) == 0 {
+		return SimplifyNone, nil
+	}
+	for n, levelName := range SimplifyLevelNames {
+		if strings.EqualFold(name, levelName) {
+			return SimplifyLevel(n), nil
+		}
+	}
+	return SimplifyNone, fmt.Errorf("%w %q, expected one of %s", ErrUnknownSimplifyLevel, name, strings.Join(SimplifyLevelNames, ", "))
+}
+
+// String of the level, e.g. "sevenths"
+func (of SimplifyLevel) String() string {
+	if of >= 0 && int(of) < len(SimplifyLevelNames) {
+		return SimplifyLevelNames[of]
+	}
+	return ""
+}
+
+// Simplify the chord to a level, e.g. C7 of C9#11 simplified to SimplifyTensions, or Am of Am7 simplified to SimplifyTriads,
+// restoring a major third to a chord without one or a suspension, e.g. of a 13th that left it out, and at SimplifyTriads in place of a suspension,
+// and a perfect fifth left out at SimplifySevenths, keeping a slash bass only if it's still a tone of the chord, but for SimplifyNone, the chord as it is.
+// The chord returned has its own tones, not shared with this one.
+func (this Chord) Simplify(level SimplifyLevel) Chord {
+	return this.simplify(level, nil)
+}
+
+// SimplifyIn a key, the chord simplified to a level as by Simplify, but spelled in the key, and restoring the third of the key signature, major or minor, where it's left out,
+// e.g. Dm of Dsus4 or D5 in C major, simplified to SimplifyTriads, but D in G major
+func (this Chord) SimplifyIn(k Key, level SimplifyLevel) Chord {
+	if level == SimplifyNone {
+		return this.SpelledIn(k)
+	}
+	return this.simplify(level, k).SpelledIn(k)
+}
+
+//
+// Private
+//
+
+// simplify the chord to a level, restoring any third left out with the third of the key signature, or major if there's no key
+func (this Chord) simplify(level SimplifyLevel, k Key) Chord {
+	c := this.Copy()
+	if level == SimplifyNone || c.Root == note.Nil {
+		return c
+	}
+	q := c.Quality()
+	drop := []Interval{I9, I11, I13}
+	if level >= SimplifySevenths {
+		drop = append(drop, I6)
+	}
+	if level >= SimplifyTriads {
+		drop = append(drop, I7)
+	}
+	if q.Triad != SuspendedTriad || level >= SimplifyTriads {
+		drop = append(drop, I2, I4)
+	}
+	for _, i := range drop {
+		c.omitTone(i)
+	}
+	if _, ok := c.ClassAt(I5); level >= SimplifySevenths && (!ok || q.Triad == MajorTriad || q.Triad == MinorTriad) {
+		c.setTone(I5, 7)
+	}
+	if _, ok := c.ClassAt(I3); !ok && (q.Triad != SuspendedTriad || level >= SimplifyTriads) {
+		c.setTone(I3, thirdIn(k, c.Root))
+	}
+	if level >= SimplifyTriads || !c.Contains(c.Bass) {
+		c.Bass = note.Nil
+	}
+	return c
+}
+
+// thirdIn a key of a root, the semitones up to its minor third if only that is in the key signature, or else to its major third, as of no key
+func thirdIn(k Key, root note.Class) int {
+	if k == nil {
+		return 4
+	}
+	minor, _ := root.Step(3)
+	major, _ := root.Step(4)
+	if inSignature(k, minor) && !inSignature(k, major) {
+		return 3
+	}
+	return 4
+}
+
+// inSignature of a key, whether a pitch class is one of the seven of its key signature, i.e. of the major scale of as many fifths from C major
+func inSignature(k Key, class note.Class) bool {
+	tonic, _ := note.C.Step(7 * k.Fifths())
+	semitones := (tonic.Diff(class) + 12) % 12
+	switch semitones {
+	case 0, 2, 4, 5, 7, 9, 11:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+// Chords are simplified to an easier symbol of the same function, for a beginner's chart, dropping the tensions, sevenths or added tones they can do without,
+// e.g. C7 of C9#11, or Am of Am7, by a level of how far to go, and in a key, restoring any third left out with the third of its key signature, e.g. Dm of Dsus4 in C major
+package chord
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestChord_Simplify(t *testing.T) {
+	for name, expect := range map[string][4]string{
+		"C9#11":   {"C9#11", "C7", "C7", "C"},
+		"C7b9b13": {"C7b9b13", "C7", "C7", "C"},
+		"Cmaj9":   {"CM9", "CM7", "CM7", "C"},
+		"Cm11":    {"Cm11", "Cm7", "Cm7", "Cm"},
+		"C69":     {"C69", "C6", "C", "C"},
+		"Cadd9":   {"Cadd9", "C", "C", "C"},
+		"C7b5":    {"C7b5", "C7b5", "C7", "C"},
+		"Comit5":  {"Comit5", "Comit5", "C", "C"},
+		"Csus4":   {"Csus4", "Csus4", "Csus4", "C"},
+		"Cm7b5":   {"Cm7b5", "Cm7b5", "Cm7b5", "Cdim"},
+		"Cdim7":   {"Cdim7", "Cdim7", "Cdim7", "Cdim"},
+		"Caug7":   {"Caug7", "Caug7", "Caug7", "Caug"},
+		"Am7":     {"Am7", "Am7", "Am7", "Am"},
+		"C9/E":    {"C9/E", "C7/E", "C7/E", "C"},
+		"Cm9/D":   {"Cm9/D", "Cm7", "Cm7", "Cm"},
+	} {
+		c := Of(name)
+		for level := SimplifyNone; level <= SimplifyTriads; level++ {
+			assert.Equal(t, expect[level], c.Simplify(level).Name(), name+" of "+level.String())
+		}
+	}
+}
+
+func TestChord_Simplify_Copied(t *testing.T) {
+	c := Of("Cmaj9")
+	simplified := c.Simplify(SimplifyTriads)
+	simplified.Tones[0].Class = note.B
+	simplified.setTone(I7, 11)
+	assert.Equal(t, Of("Cmaj9"), c)
+	assert.Equal(t, "", Chord{}.Simplify(SimplifyTriads).Name())
+}
+
+func TestChord_SimplifyIn(t *testing.T) {
+	assert.Equal(t, "Dm", Of("Dsus4").SimplifyIn(signature(0), SimplifyTriads).Name())
+	assert.Equal(t, "D", Of("Dsus4").SimplifyIn(signature(1), SimplifyTriads).Name())
+	assert.Equal(t, "Dsus4", Of("Dsus4").SimplifyIn(signature(0), SimplifySevenths).Name())
+	assert.Equal(t, "Bb7", Of("A#13").SimplifyIn(signature(-1), SimplifyTensions).Name())
+	assert.Equal(t, "Em7", Of("E13").SimplifyIn(signature(0), SimplifyTensions).Name(), "of the minor third left out of the chord")
+	assert.Equal(t, "F#m7", Of("F#m7").SimplifyIn(signature(2), SimplifyNone).Name())
+}
+
+func TestSimplifyLevelOf(t *testing.T) {
+	for n, name := range SimplifyLevelNames {
+		level, err := SimplifyLevelOf(name)
+		assert.Nil(t, err)
+		assert.Equal(t, SimplifyLevel(n), level)
+		assert.Equal(t, name, level.String())
+	}
+	level, err := SimplifyLevelOf("Triads")
+	assert.Nil(t, err)
+	assert.Equal(t, SimplifyTriads, level)
+	level, err = SimplifyLevelOf("")
+	assert.Nil(t, err)
+	assert.Equal(t, SimplifyNone, level)
+	_, err = SimplifyLevelOf("beginner")
+	assert.True(t, errors.Is(err, ErrUnknownSimplifyLevel))
+	assert.Equal(t, `unknown simplify level "beginner", expected one of none, tensions, sevenths, triads`, err.Error())
+	assert.Equal(t, "", SimplifyLevel(9).String())
+}
@@ -2,15 +2,61 @@
 package chord
 
 import (
+	"encoding/json"
+	"strconv"
+
 	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/schema"
 )
 
+// ToYAML of the Chord, e.g. for the command-line utility
 func (c Chord) ToYAML() string {
 	spec := specFrom(c)
 	out, _ := yaml.Marshal(spec)
 	return string(out[:])
 }
 
+// ToJSON of the Chord, e.g. for a web app
+func (c Chord) ToJSON() string {
+	spec := specFrom(c)
+	out, _ := json.Marshal(spec)
+	return string(out[:])
+}
+
+// ToYAMLSchema of the Chord in a version of the schema, e.g. schema.V2 with its bass, intervals, degrees and inversions, else as ToYAML
+func (c Chord) ToYAMLSchema(v schema.Version) string {
+	if v != schema.V2 {
+		return c.ToYAML()
+	}
+	out, _ := yaml.Marshal(specV2From(c))
+	return string(out[:])
+}
+
+// ToJSONSchema of the Chord in a version of the schema, e.g. schema.V2 with its bass, intervals, degrees and inversions, else as ToJSON
+func (c Chord) ToJSONSchema(v schema.Version) string {
+	if v != schema.V2 {
+		return c.ToJSON()
+	}
+	out, _ := json.Marshal(specV2From(c))
+	return string(out[:])
+}
+
+// ToYAML of the Arpeggio, e.g. for the command-line utility
+func (a Arpeggio) ToYAML() string {
+	spec := specArpeggioFrom(a)
+	out, _ := yaml.Marshal(spec)
+	return string(out[:])
+}
+
+// ToJSON of the Arpeggio, e.g. for a web app
+func (a Arpeggio) ToJSON() string {
+	spec := specArpeggioFrom(a)
+	out, _ := json.Marshal(spec)
+	return string(out[:])
+}
+
 //
 // Private
 //
@@ -18,14 +64,77 @@ func (c Chord) ToYAML() string {
 func specFrom(c Chord) specChord {
 	s := specChord{}
 	s.Root = c.Root.String(c.AdjSymbol)
-	s.Tones = make(map[int]string)
-	for i, t := range c.Tones {
-		s.Tones[int(i)] = t.String(c.AdjSymbol)
+	tones := c.OrderedTones()
+	s.Tones = degreesOf(tones, func(t Tone) string { return t.Class.String(c.AdjSymbol) })
+	return s
+}
+
+// specV2From a chord, its v1 spec, the bass of a slash chord or inversion, if any, the intervals of its tones, each of its degrees in ascending order,
+// with its note and interval from the root, e.g. b5 of Gb, and its inversions, each from the next tone in the bass, beginning with root position
+func specV2From(c Chord) specChordV2 {
+	v1 := specFrom(c)
+	s := specChordV2{Schema: string(schema.V2), Root: v1.Root, Tones: v1.Tones, Intervals: schema.Degrees{}, Degrees: []specDegree{}, Inversions: []specInversion{}}
+	if c.Bass != note.Nil && c.Bass != c.Root {
+		s.Bass = c.Bass.String(c.AdjSymbol)
+	}
+	if named := c.namedTones(); len(named) > 0 {
+		s.Intervals = degreesOf(named, func(t Tone) string { return t.Name })
+	}
+	var tones []Tone
+	for _, t := range c.OrderedTones() {
+		if t.Class != note.Nil {
+			tones = append(tones, t)
+			s.Degrees = append(s.Degrees, specDegree{Degree: t.Degree.String(), Note: t.Class.String(c.AdjSymbol), Interval: t.Name})
+		}
+	}
+	for n := range tones {
+		var inv specInversion
+		for m := range tones {
+			inv.Notes = append(inv.Notes, tones[(n+m)%len(tones)].Class.String(c.AdjSymbol))
+		}
+		inv.Bass = inv.Notes[0]
+		s.Inversions = append(s.Inversions, inv)
 	}
 	return s
 }
 
+// specArpeggioFrom an arpeggio, with each note named in scientific pitch notation, e.g. C4
+func specArpeggioFrom(a Arpeggio) specArpeggio {
+	s := specArpeggio{Chord: specFrom(a.Chord)}
+	for _, n := range a.Notes {
+		s.Notes = append(s.Notes, n.Class.String(a.Chord.AdjSymbol)+strconv.Itoa(int(n.Octave)))
+	}
+	return s
+}
+
+type specArpeggio struct {
+	Chord specChord `json:"chord"`
+	Notes []string  `json:"notes"`
+}
+
+// specChord of version 1 of the schema, frozen to the original fields of a chord, its root and tones
 type specChord struct {
-	Root  string
-	Tones map[int]string
+	Root  string         `json:"root"`
+	Tones schema.Degrees `json:"tones"`
+}
+
+type specChordV2 struct {
+	Schema     string          `json:"schema"`
+	Root       string          `json:"root"`
+	Bass       string          `json:"bass,omitempty" yaml:",omitempty"`
+	Tones      schema.Degrees  `json:"tones"`
+	Intervals  schema.Degrees  `json:"intervals"`
+	Degrees    []specDegree    `json:"degrees"`
+	Inversions []specInversion `json:"inversions"`
+}
+
+type specDegree struct {
+	Degree   string `json:"degree"`
+	Note     string `json:"note"`
+	Interval string `json:"interval,omitempty" yaml:",omitempty"`
+}
+
+type specInversion struct {
+	Bass  string   `json:"bass"`
+	Notes []string `json:"notes" yaml:",flow"`
 }
@@ -2,9 +2,13 @@
 package chord
 
 import (
+	"strings"
 	"testing"
 
 	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/internal/golden"
+	"github.com/go-music-theory/music-theory/schema"
 )
 
 func TestToYAML(t *testing.T) {
@@ -12,3 +16,94 @@ func TestToYAML(t *testing.T) {
 	out := c.ToYAML()
 	assert.Equal(t, "root: C\ntones:\n  1: C\n  3: Eb\n  6: A\n  7: Bb\n  9: D\n", out)
 }
+
+func TestToJSON(t *testing.T) {
+	c := Of("Cm769-5")
+	out := c.ToJSON()
+	assert.Equal(t, `{"root":"C","tones":{"1":"C","3":"Eb","6":"A","7":"Bb","9":"D"}}`, out)
+}
+
+func TestToYAML_SlashChord(t *testing.T) {
+	assert.Equal(t, "root: C\ntones:\n  1: C\n  3: E\n  5: G\n", Of("C/E").ToYAML(), "v1 without the bass")
+	assert.Equal(t, `{"root":"A","tones":{"1":"A","3":"C","5":"E"}}`, Of("Am").Inversion(1).ToJSON())
+	assert.Contains(t, Of("C/E").ToYAMLSchema(schema.V2), "root: C\nbass: E\n")
+	assert.Contains(t, Of("Am").Inversion(1).ToJSONSchema(schema.V2), `"root":"A","bass":"C"`)
+	assert.Contains(t, Of("C").ToYAMLSchema(schema.V2), "root: C\ntones:\n", "no bass in root position")
+	assert.Contains(t, Of("C").ToJSONSchema(schema.V2), `"root":"C","tones"`)
+}
+
+func TestToJSONSchema_Degrees(t *testing.T) {
+	assert.Contains(t, Of("C7b5").ToJSONSchema(schema.V2), `{"degree":"b5","note":"Gb","interval":"d5"}`)
+	assert.Contains(t, Of("C7#9").ToJSONSchema(schema.V2), `{"degree":"#9","note":"D#","interval":"#9"}`)
+}
+
+func TestToJSON_V1(t *testing.T) {
+	var out strings.Builder
+	for _, name := range []string{"C", "Cm769-5", "C/E", "Am7/C", "Bb9", "Cdim7"} {
+		c := Of(name)
+		out.WriteString(c.ToJSON() + "\n" + c.ToYAML())
+	}
+	golden.Assert(t, "v1", out.String())
+}
+
+func TestToJSON_AscendingIntervals(t *testing.T) {
+	c := Of("C13")
+	assert.Equal(t, `{"root":"C","tones":{"1":"C","5":"G","7":"Bb","9":"D","11":"F","13":"A"}}`, c.SpelledIn(signature(-1)).ToJSON())
+	assert.Contains(t, c.ToJSONSchema(schema.V2), `"intervals":{"1":"P1","5":"P5","7":"m7","9":"9","11":"11","13":"13"}`)
+	for n := 0; n < 20; n++ {
+		assert.Equal(t, c.ToJSON(), Of("C13").ToJSON())
+		assert.Equal(t, c.ToYAML(), Of("C13").ToYAML())
+		assert.Equal(t, c.ToJSONSchema(schema.V2), Of("C13").ToJSONSchema(schema.V2))
+	}
+}
+
+func TestToYAMLSchema(t *testing.T) {
+	c := Of("Cm7")
+	assert.Equal(t, c.ToYAML(), c.ToYAMLSchema(schema.V1))
+	assert.Equal(t, `schema: v2
+root: C
+tones:
+  1: C
+  3: Eb
+  5: G
+  7: Bb
+intervals:
+  1: P1
+  3: m3
+  5: P5
+  7: m7
+degrees:
+- degree: "1"
+  note: C
+  interval: P1
+- degree: b3
+  note: Eb
+  interval: m3
+- degree: "5"
+  note: G
+  interval: P5
+- degree: b7
+  note: Bb
+  interval: m7
+inversions:
+- bass: C
+  notes: [C, Eb, G, Bb]
+- bass: Eb
+  notes: [Eb, G, Bb, C]
+- bass: G
+  notes: [G, Bb, C, Eb]
+- bass: Bb
+  notes: [Bb, C, Eb, G]
+`, c.ToYAMLSchema(schema.V2))
+}
+
+func TestToJSONSchema(t *testing.T) {
+	c := Of("Cm")
+	assert.Equal(t, c.ToJSON(), c.ToJSONSchema(schema.V1))
+	assert.Equal(t, `{"schema":"v2","root":"C","tones":{"1":"C","3":"Eb","5":"G"},"intervals":{"1":"P1","3":"m3","5":"P5"},"degrees":[{"degree":"1","note":"C","interval":"P1"},{"degree":"b3","note":"Eb","interval":"m3"},{"degree":"5","note":"G","interval":"P5"}],"inversions":[{"bass":"C","notes":["C","Eb","G"]},{"bass":"Eb","notes":["Eb","G","C"]},{"bass":"G","notes":["G","C","Eb"]}]}`, c.ToJSONSchema(schema.V2))
+}
+
+func TestToJSONSchema_NoTones(t *testing.T) {
+	c := Chord{}
+	assert.Equal(t, `{"schema":"v2","root":"-","tones":{},"intervals":{},"degrees":[],"inversions":[]}`, c.ToJSONSchema(schema.V2))
+}
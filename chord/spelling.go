@@ -0,0 +1,31 @@
+// Chords are respelled in a key, with sharps or flats like its key signature, e.g. Bb instead of A# in F major
+package chord
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Key to spell a chord in, e.g. a key.Key, by the fifths of its key signature from C major, the number of sharps if positive, or flats if negative
+type Key interface {
+	Fifths() int
+}
+
+// SpelledIn a key, the same chord with its tones spelled with the sharps or flats of the key signature, without changing its pitch classes,
+// or as it was, if the key signature has neither, e.g. in C major. The chord returned has its own tones, not shared with this one.
+func (this Chord) SpelledIn(k Key) Chord {
+	switch fifths := k.Fifths(); {
+	case fifths > 0:
+		this.AdjSymbol = note.Sharp
+	case fifths < 0:
+		this.AdjSymbol = note.Flat
+	}
+	return this.Copy()
+}
+
+// Copy of the chord, with its own copy of its tones, so that changing one doesn't change the other, e.g. of a chord kept in a cache
+func (this Chord) Copy() Chord {
+	if this.Tones != nil {
+		this.Tones = append(make([]Tone, 0, len(this.Tones)), this.Tones...)
+	}
+	return this
+}
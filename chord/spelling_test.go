@@ -0,0 +1,59 @@
+// Chords are respelled in a key, with sharps or flats like its key signature, e.g. Bb instead of A# in F major
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestChord_SpelledIn(t *testing.T) {
+	c := Of("C7")
+	assert.Equal(t, "A#", classAt(c, I7).String(c.AdjSymbol))
+	spelled := c.SpelledIn(signature(-1))
+	assert.Equal(t, note.Flat, spelled.AdjSymbol)
+	assert.Equal(t, c.Tones, spelled.Tones)
+	assert.Equal(t, "Bb", classAt(spelled, I7).String(spelled.AdjSymbol))
+}
+
+func TestChord_SpelledIn_Copied(t *testing.T) {
+	c := Of("C7")
+	spelled := c.SpelledIn(signature(-1))
+	spelled.Tones[3].Class = note.B
+	spelled.setTone(I9, 14)
+	assert.Equal(t, Of("C7"), c)
+}
+
+func TestChord_Copy(t *testing.T) {
+	c := Of("C7")
+	copied := c.Copy()
+	assert.Equal(t, c, copied)
+	copied.Tones[0].Class = note.D
+	copied.omitTone(I3)
+	assert.Equal(t, Of("C7"), c)
+}
+
+func TestChord_SpelledIn_Sharps(t *testing.T) {
+	c := Of("Gbm").SpelledIn(signature(2))
+	assert.Equal(t, "F#", c.Root.String(c.AdjSymbol))
+	assert.Equal(t, "A", classAt(c, I3).String(c.AdjSymbol))
+	assert.Equal(t, "C#", classAt(c, I5).String(c.AdjSymbol))
+}
+
+func TestChord_SpelledIn_NoSignature(t *testing.T) {
+	assert.Equal(t, note.Flat, Of("Bb").SpelledIn(signature(0)).AdjSymbol)
+	assert.Equal(t, note.Sharp, Of("C#").SpelledIn(signature(0)).AdjSymbol)
+}
+
+//
+// Private
+//
+
+// signature of a key, by its fifths, e.g. -1 for F major
+type signature int
+
+func (s signature) Fifths() int {
+	return int(s)
+}
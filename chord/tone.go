@@ -0,0 +1,89 @@
+// Tones of a chord are keyed by their degree, e.g. b5 apart from 5, and kept in ascending order of interval from the root, e.g. 1 3 5 7 9, the same in every listing and serialization
+package chord
+
+import (
+	"github.com/go-music-theory/music-theory/interval"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/schema"
+)
+
+// Tone of a chord, keyed by its degree, its interval from the root with any alteration, e.g. b3 or 5, its pitch class there, and the name of that interval, e.g. m3 or P5
+type Tone struct {
+	Degree Degree
+	Class  note.Class
+	Name   string
+}
+
+// OrderedTones of the chord, a copy of its Tones, in ascending order of interval, e.g. C E G Bb D of C9
+func (this Chord) OrderedTones() []Tone {
+	return append(make([]Tone, 0, len(this.Tones)), this.Tones...)
+}
+
+// ToneOf a degree of the chord, its tone of that interval and alteration, and whether the chord has it, e.g. the Gb and true of Flat5 of Cm7b5, but false of its natural 5
+func (this Chord) ToneOf(d Degree) (Tone, bool) {
+	if n, ok := this.indexOf(d.Interval); ok && this.Tones[n].Degree == d {
+		return this.Tones[n], true
+	}
+	return Tone{}, false
+}
+
+// ClassAt an interval of the chord, the pitch class of its tone there, and whether the chord has one, e.g. E and true of I3 of C
+func (this Chord) ClassAt(i Interval) (note.Class, bool) {
+	if n, ok := this.indexOf(i); ok {
+		return this.Tones[n].Class, true
+	}
+	return note.Nil, false
+}
+
+//
+// Private
+//
+
+// indexOf the tone of an interval among the tones of the chord, or else of where it belongs in order, and whether the chord has it
+func (this Chord) indexOf(i Interval) (int, bool) {
+	for n, t := range this.Tones {
+		if t.Degree.Interval >= i {
+			return n, t.Degree.Interval == i
+		}
+	}
+	return len(this.Tones), false
+}
+
+// setTone of the chord at an interval, some semitones up from its root, in its place in order, replacing any tone at that interval
+func (this *Chord) setTone(i Interval, semitones int) {
+	t := Tone{Degree: degreeOf(i, semitones), Name: interval.NameOf(int(i), semitones)}
+	t.Class, _ = this.Root.Step(semitones)
+	n, ok := this.indexOf(i)
+	if ok {
+		this.Tones[n] = t
+		return
+	}
+	this.Tones = append(this.Tones, Tone{})
+	copy(this.Tones[n+1:], this.Tones[n:])
+	this.Tones[n] = t
+}
+
+// omitTone of the chord at an interval, if it has one
+func (this *Chord) omitTone(i Interval) {
+	if n, ok := this.indexOf(i); ok {
+		this.Tones = append(this.Tones[:n], this.Tones[n+1:]...)
+	}
+}
+
+// namedTones of the chord, those with an interval name, in ascending order of interval
+func (this Chord) namedTones() (tones []Tone) {
+	for _, t := range this.Tones {
+		if len(t.Name) > 0 {
+			tones = append(tones, t)
+		}
+	}
+	return
+}
+
+// degreesOf the tones of a chord, each named by the given function, in ascending order of interval
+func degreesOf(tones []Tone, nameOf func(t Tone) string) (degrees schema.Degrees) {
+	for _, t := range tones {
+		degrees = append(degrees, schema.Degree{Number: int(t.Degree.Interval), Name: nameOf(t)})
+	}
+	return
+}
@@ -0,0 +1,68 @@
+// Tones of a chord are kept in ascending order of interval from the root, e.g. 1 3 5 7 9, the same in every listing and serialization
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestChord_OrderedTones(t *testing.T) {
+	assert.Equal(t, []Tone{
+		{Degree: Degree{I1, Natural}, Class: note.C, Name: "P1"},
+		{Degree: Degree{I3, Natural}, Class: note.E, Name: "M3"},
+		{Degree: Degree{I5, Natural}, Class: note.G, Name: "P5"},
+		{Degree: Flat7, Class: note.As, Name: "m7"},
+		{Degree: Natural9, Class: note.D, Name: "9"},
+	}, Of("C9").OrderedTones())
+	var intervals []Interval
+	for _, tone := range Of("C13").OrderedTones() {
+		intervals = append(intervals, tone.Degree.Interval)
+	}
+	assert.Equal(t, []Interval{I1, I5, I7, I9, I11, I13}, intervals)
+	assert.Equal(t, []Tone{}, Chord{}.OrderedTones())
+}
+
+func TestChord_OrderedTones_Copy(t *testing.T) {
+	c := Of("C")
+	tones := c.OrderedTones()
+	tones[0].Class = note.D
+	assert.Equal(t, note.C, c.Tones[0].Class)
+}
+
+func TestChord_Tones_Ascending(t *testing.T) {
+	c := Of("C")
+	c.setTone(I7, 10)
+	c.setTone(I2, 2)
+	c.setTone(I3, 3)
+	c.omitTone(I5)
+	assert.Equal(t, []Tone{
+		{Degree: Degree{I1, Natural}, Class: note.C, Name: "P1"},
+		{Degree: Degree{I2, Natural}, Class: note.D, Name: "M2"},
+		{Degree: Flat3, Class: note.Ds, Name: "m3"},
+		{Degree: Flat7, Class: note.As, Name: "m7"},
+	}, c.Tones)
+	assert.Equal(t, `{"root":"C","tones":{"1":"C","2":"D","3":"Eb","7":"Bb"}}`, Chord{Root: note.C, AdjSymbol: note.Flat, Tones: c.Tones}.ToJSON())
+}
+
+func TestChord_ClassAt(t *testing.T) {
+	class, ok := Of("C").ClassAt(I3)
+	assert.Equal(t, note.E, class)
+	assert.True(t, ok)
+	class, ok = Of("C").ClassAt(I7)
+	assert.Equal(t, note.Nil, class)
+	assert.False(t, ok)
+}
+
+func TestChord_ToneOf(t *testing.T) {
+	c := Of("Cm7b5")
+	tone, ok := c.ToneOf(Flat5)
+	assert.True(t, ok)
+	assert.Equal(t, Tone{Degree: Flat5, Class: note.Fs, Name: "d5"}, tone)
+	_, ok = c.ToneOf(Degree{I5, Natural})
+	assert.False(t, ok, "the b5 isn't a 5")
+	_, ok = c.ToneOf(Natural9)
+	assert.False(t, ok)
+}
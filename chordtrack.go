@@ -0,0 +1,48 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/chordtrack"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// channelOf a track, from 0 to 15 of the channel given from 1 to 16, as a DAW numbers them
+func channelOf(channel int) (int, error) {
+	if channel < 1 || channel > 16 {
+		return 0, fmt.Errorf("%w: %d, expected 1 to 16", midi.ErrChannelRange, channel)
+	}
+	return channel - 1, nil
+}
+
+// writeChordTracks of some chord names, generated with any options, listing each track by its channel, from 1 to 16, its program and its number of notes,
+// and if there's a path, writing them all to a MIDI file at it
+func writeChordTracks(w io.Writer, names []string, path string, opts ...chordtrack.Option) error {
+	var p progression.Progression
+	for _, name := range names {
+		c, err := chord.Parse(name)
+		if err != nil {
+			return err
+		}
+		p.Chords = append(p.Chords, c)
+	}
+	tracks := chordtrack.Generate(p, opts...)
+	for _, t := range tracks {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, t := range tracks {
+		fmt.Fprintf(w, "%s on channel %d, program %d: %d notes\n", t.Name, t.Channel+1, t.Program, len(t.Notes))
+	}
+	if len(path) == 0 {
+		return nil
+	}
+	return writeTuneFile(path, func(f io.Writer) error {
+		return chordtrack.Write(f, p, opts...)
+	})
+}
@@ -0,0 +1,112 @@
+// A chord track plays the chords of a progression on an instrument, voiced from the root of each, held, strummed or arpeggiated, over a bass track of the lowest note of each chord.
+//
+// General MIDI numbers its instruments by program, e.g. 1 of the Acoustic Grand Piano or 33 of the Acoustic Bass, and plays each on its own channel,
+// so the tracks of a Standard MIDI File of format 1 are heard together, each by its own instrument, when it's opened in a DAW or played by a synthesizer.
+//
+// https://en.wikipedia.org/wiki/General_MIDI
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package chordtrack
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/humanize"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// Names of the tracks
+const (
+	ChordsName = "chords"
+	BassName   = "bass"
+)
+
+// Octaves of the notes of each chord, its root in the chord.ArpeggioOctave, with the bass of a slash chord below the root
+// in the SlashOctave, or in the BassOctave on a bass track of its own
+const (
+	SlashOctave = note.Octave(3)
+	BassOctave  = note.Octave(2)
+)
+
+// Generate the tracks of a progression, each chord for some beats, a bar of 4/4 unless WithBeatsPerChord, on the first channel,
+// played by the Acoustic Grand Piano unless WithProgram, then a bass track only if WithBass, or with any other Option, e.g. Generate(p, WithStrum(30*time.Millisecond))
+func Generate(p progression.Progression, opts ...Option) []midi.Track {
+	o := optionsOf(opts)
+	length := int(o.beats * midi.Quarter)
+	chords := midi.Track{Name: ChordsName, Channel: o.channel, Program: o.program}
+	var bass midi.Track
+	for n, c := range p.Chords {
+		if c.Root == note.Nil {
+			continue
+		}
+		start := n * length
+		if o.bass != nil {
+			bass.Notes = append(bass.Notes, midi.Note{Number: midi.NumberOf(c.Lowest(), BassOctave), Velocity: midi.DefaultVelocity, Channel: o.bass.Channel, Start: start, Duration: length})
+		} else if c.Bass != note.Nil {
+			chords.Notes = append(chords.Notes, midi.Note{Number: midi.NumberOf(c.Bass, SlashOctave), Velocity: midi.DefaultVelocity, Channel: o.channel, Start: start, Duration: length})
+		}
+		chords.Notes = append(chords.Notes, o.notesOf(c, start, length)...)
+	}
+	chords.Notes = o.humanized(chords.Notes)
+	if o.bass == nil {
+		return []midi.Track{chords}
+	}
+	bass.Name, bass.Channel, bass.Program = BassName, o.bass.Channel, o.bass.Program
+	bass.Notes = o.humanized(bass.Notes)
+	return []midi.Track{chords, bass}
+}
+
+// Write a Standard MIDI File of the tracks of a progression, generated with any Option, at the default midi.Tempo unless WithTempo,
+// or an error if its channel or program, or that of its bass, doesn't exist
+func Write(w io.Writer, p progression.Progression, opts ...Option) error {
+	return midi.WriteTracks(w, optionsOf(opts).tempo, Generate(p, opts...)...)
+}
+
+//
+// Private
+//
+
+// notesOf a chord from a start for a length of ticks, its Notes realized up from the root, held together, or strummed up, or in eighth notes of an arpeggio
+func (o options) notesOf(c chord.Chord, start int, length int) (notes []midi.Note) {
+	if o.arpeggio != nil {
+		tones := chord.Arpeggiate(c, o.arpeggio, 1).Notes
+		for tick, i := 0, 0; tick < length; tick, i = tick+midi.Eighth, i+1 {
+			tone := tones[i%len(tones)]
+			notes = append(notes, midi.Note{Number: midi.NumberOf(tone.Class, tone.Octave), Velocity: midi.DefaultVelocity, Channel: o.channel, Start: start + tick, Duration: min(midi.Eighth, length-tick)})
+		}
+		return
+	}
+	strum := midi.TicksOf(o.strum)
+	for i, tone := range c.Over(note.Nil).Notes(chord.ArpeggioOctave) {
+		number := midi.NumberOf(tone.Class, tone.Octave)
+		delay := min(i*strum, length-1)
+		notes = append(notes, midi.Note{Number: number, Velocity: midi.DefaultVelocity, Channel: o.channel, Start: start + delay, Duration: length - delay})
+	}
+	return
+}
+
+// humanized notes, if humanized at all
+func (o options) humanized(notes []midi.Note) []midi.Note {
+	if o.humanize <= 0 && o.jitter <= 0 {
+		return notes
+	}
+	return humanize.Apply(notes, o.humanize, o.jitter, o.seed)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
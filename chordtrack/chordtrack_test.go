@@ -0,0 +1,106 @@
+// A chord track plays the chords of a progression on an instrument, voiced from the root of each, held, strummed or arpeggiated, over a bass track of the lowest note of each chord.
+package chordtrack
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestGenerate(t *testing.T) {
+	tracks := Generate(progression.Of("C", "Am7"))
+	assert.Equal(t, 1, len(tracks))
+	assert.Equal(t, ChordsName, tracks[0].Name)
+	assert.Equal(t, 0, tracks[0].Channel)
+	assert.Equal(t, midi.AcousticGrandPiano, tracks[0].Program)
+	assert.Equal(t, []int{60, 64, 67, 69, 72, 76, 79}, numbersOf(tracks[0].Notes))
+	assert.Equal(t, midi.Note{Number: 60, Velocity: midi.DefaultVelocity, Start: 0, Duration: midi.Whole}, tracks[0].Notes[0])
+	assert.Equal(t, midi.Note{Number: 69, Velocity: midi.DefaultVelocity, Start: midi.Whole, Duration: midi.Whole}, tracks[0].Notes[3])
+}
+
+func TestGenerate_Slash(t *testing.T) {
+	tracks := Generate(progression.Of("C/E", "G"), WithBeatsPerChord(2), WithChannel(3), WithProgram(midi.ElectricPiano))
+	assert.Equal(t, 1, len(tracks))
+	assert.Equal(t, 3, tracks[0].Channel)
+	assert.Equal(t, midi.ElectricPiano, tracks[0].Program)
+	assert.Equal(t, []int{52, 60, 64, 67, 67, 71, 74}, numbersOf(tracks[0].Notes), "E3 under C")
+	assert.Equal(t, midi.Note{Number: 67, Velocity: midi.DefaultVelocity, Channel: 3, Start: midi.Half, Duration: midi.Half}, tracks[0].Notes[4])
+}
+
+func TestGenerate_Bass(t *testing.T) {
+	tracks := Generate(progression.Of("C/E", "G"), WithBass(1, midi.AcousticBass))
+	assert.Equal(t, 2, len(tracks))
+	assert.Equal(t, []int{60, 64, 67, 67, 71, 74}, numbersOf(tracks[0].Notes), "the slash bass left out")
+	assert.Equal(t, BassName, tracks[1].Name)
+	assert.Equal(t, 1, tracks[1].Channel)
+	assert.Equal(t, midi.AcousticBass, tracks[1].Program)
+	assert.Equal(t, []midi.Note{
+		{Number: 40, Velocity: midi.DefaultVelocity, Channel: 1, Start: 0, Duration: midi.Whole},
+		{Number: 43, Velocity: midi.DefaultVelocity, Channel: 1, Start: midi.Whole, Duration: midi.Whole},
+	}, tracks[1].Notes)
+}
+
+func TestGenerate_Strum(t *testing.T) {
+	notes := Generate(progression.Of("C"), WithStrum(50*time.Millisecond))[0].Notes
+	assert.Equal(t, []midi.Note{
+		{Number: 60, Velocity: midi.DefaultVelocity, Start: 0, Duration: midi.Whole},
+		{Number: 64, Velocity: midi.DefaultVelocity, Start: 48, Duration: midi.Whole - 48},
+		{Number: 67, Velocity: midi.DefaultVelocity, Start: 96, Duration: midi.Whole - 96},
+	}, notes)
+	notes = Generate(progression.Of("C"), WithBeatsPerChord(0.25), WithStrum(time.Second))[0].Notes
+	assert.Equal(t, midi.Note{Number: 67, Velocity: midi.DefaultVelocity, Start: 119, Duration: 1}, notes[2], "never beyond the chord")
+}
+
+func TestGenerate_Arpeggio(t *testing.T) {
+	notes := Generate(progression.Of("C", "F"), WithBeatsPerChord(3), WithArpeggio(chord.Down))[0].Notes
+	assert.Equal(t, []int{67, 64, 60, 67, 64, 60, 72, 69, 65, 72, 69, 65}, numbersOf(notes))
+	assert.Equal(t, midi.Note{Number: 72, Velocity: midi.DefaultVelocity, Start: 3 * midi.Quarter, Duration: midi.Eighth}, notes[6])
+	notes = Generate(progression.Of("C"), WithBeatsPerChord(0.75), WithArpeggio(chord.Up))[0].Notes
+	assert.Equal(t, midi.Note{Number: 64, Velocity: midi.DefaultVelocity, Start: midi.Eighth, Duration: midi.Quarter - midi.Eighth*3/2}, notes[1], "the last cut short")
+}
+
+func TestGenerate_Humanize(t *testing.T) {
+	plain := Generate(progression.Of("C", "G"), WithBass(1, midi.AcousticBass))
+	humanized := Generate(progression.Of("C", "G"), WithBass(1, midi.AcousticBass), WithHumanize(20*time.Millisecond, 10, 7))
+	assert.NotEqual(t, plain[0].Notes, humanized[0].Notes)
+	assert.NotEqual(t, plain[1].Notes, humanized[1].Notes)
+	assert.Equal(t, humanized, Generate(progression.Of("C", "G"), WithBass(1, midi.AcousticBass), WithHumanize(20*time.Millisecond, 10, 7)), "the same seed")
+	assert.Equal(t, numbersOf(plain[0].Notes), numbersOf(humanized[0].Notes))
+}
+
+func TestGenerate_Empty(t *testing.T) {
+	assert.Nil(t, Generate(progression.Progression{})[0].Notes)
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, Write(&buf, progression.Of("Am", "C/G"), WithTempo(90), WithBass(1, midi.ElectricBass)))
+	assert.Contains(t, buf.String(), "\xFF\x51\x03\x0A\x2C\x2B", "666667 microseconds per quarter note")
+	assert.Contains(t, buf.String(), ChordsName+"\x00\xC0\x00")
+	assert.Contains(t, buf.String(), BassName+"\x00\xC1\x21")
+	notes, err := midi.Read(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(notes))
+	err = Write(&buf, progression.Of("C"), WithChannel(16))
+	assert.True(t, errors.Is(err, midi.ErrChannelRange))
+	err = Write(&buf, progression.Of("C"), WithBass(0, 200))
+	assert.True(t, errors.Is(err, midi.ErrProgramRange))
+}
+
+//
+// Private
+//
+
+func numbersOf(notes []midi.Note) (numbers []int) {
+	for _, n := range notes {
+		numbers = append(numbers, n.Number)
+	}
+	return
+}
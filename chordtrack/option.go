@@ -0,0 +1,96 @@
+// Chord tracks are generated of chords held for a bar of 4/4 on the first channel by a piano, or with an Option, e.g. strummed by a guitar over a bass with Generate(p, WithProgram(midi.AcousticGuitar), WithStrum(20*time.Millisecond), WithBass(1, midi.AcousticBass))
+package chordtrack
+
+import (
+	"time"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// Option for generating a chord track
+type Option func(*options)
+
+// WithProgram of General MIDI to play the chords by, from 1 to 128, e.g. WithProgram(midi.ElectricPiano), or 0 to leave it to the synthesizer
+func WithProgram(program int) Option {
+	return func(o *options) {
+		o.program = program
+	}
+}
+
+// WithChannel of the chords, from 0 to 15, e.g. WithChannel(2)
+func WithChannel(channel int) Option {
+	return func(o *options) {
+		o.channel = channel
+	}
+}
+
+// WithBeatsPerChord of quarter notes each chord is held for, e.g. WithBeatsPerChord(2) of two chords every bar of 4/4, or 4 if not above 0
+func WithBeatsPerChord(beats float64) Option {
+	return func(o *options) {
+		if beats > 0 {
+			o.beats = beats
+		}
+	}
+}
+
+// WithTempo of the chords, in beats per minute, e.g. WithTempo(90), or 0 of the default midi.Tempo
+func WithTempo(bpm float64) Option {
+	return func(o *options) {
+		o.tempo = bpm
+	}
+}
+
+// WithStrum of the chords, each tone beginning some time after the one below it, as a guitar is strummed down, e.g. WithStrum(20*time.Millisecond)
+func WithStrum(delay time.Duration) Option {
+	return func(o *options) {
+		o.strum = delay
+	}
+}
+
+// WithArpeggio of each chord in eighth notes, its tones in the order of a pattern, e.g. WithArpeggio(chord.UpDown), instead of held or strummed together
+func WithArpeggio(pattern chord.Pattern) Option {
+	return func(o *options) {
+		o.arpeggio = pattern
+	}
+}
+
+// WithHumanize of every note, moving it by up to some time either way, and its velocity by up to some jitter, shuffled by a seed, as humanize.Apply does, e.g. WithHumanize(10*time.Millisecond, 8, 0)
+func WithHumanize(amount time.Duration, velocityJitter int, seed int64) Option {
+	return func(o *options) {
+		o.humanize, o.jitter, o.seed = amount, velocityJitter, seed
+	}
+}
+
+// WithBass of a track of its own, on a channel, from 0 to 15, played by a program, e.g. WithBass(1, midi.AcousticBass), of the lowest note of each chord,
+// i.e. the bass of a slash chord, e.g. E of C/E, then left out of the chords, or else its root
+func WithBass(channel int, program int) Option {
+	return func(o *options) {
+		o.bass = &midi.Track{Channel: channel, Program: program}
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	program  int
+	channel  int
+	beats    float64
+	tempo    float64
+	strum    time.Duration
+	arpeggio chord.Pattern
+	humanize time.Duration
+	jitter   int
+	seed     int64
+	bass     *midi.Track // of only the channel and program, if any
+}
+
+func optionsOf(opts []Option) options {
+	o := &options{program: midi.AcousticGrandPiano, beats: 4}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
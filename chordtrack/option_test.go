@@ -0,0 +1,20 @@
+// Chord tracks are generated of chords held for a bar of 4/4 on the first channel by a piano, or with an Option, e.g. strummed by a guitar over a bass with Generate(p, WithProgram(midi.AcousticGuitar), WithStrum(20*time.Millisecond), WithBass(1, midi.AcousticBass))
+package chordtrack
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestOptionsOf(t *testing.T) {
+	assert.Equal(t, options{program: midi.AcousticGrandPiano, beats: 4}, optionsOf(nil))
+	assert.Equal(t, options{program: midi.Organ, channel: 2, beats: 2, tempo: 90, strum: 20 * time.Millisecond, humanize: 10 * time.Millisecond, jitter: 8, seed: 3, bass: &midi.Track{Channel: 1, Program: midi.AcousticBass}},
+		optionsOf([]Option{WithProgram(midi.Organ), WithChannel(2), WithBeatsPerChord(2), WithTempo(90), WithStrum(20 * time.Millisecond), WithHumanize(10*time.Millisecond, 8, 3), WithBass(1, midi.AcousticBass)}))
+	assert.Equal(t, 4.0, optionsOf([]Option{WithBeatsPerChord(0)}).beats, "4 unless above 0")
+	assert.NotNil(t, optionsOf([]Option{WithArpeggio(chord.UpDown)}).arpeggio)
+}
@@ -0,0 +1,61 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chordtrack"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestChannelOf(t *testing.T) {
+	channel, err := channelOf(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, channel)
+	channel, err = channelOf(16)
+	assert.Nil(t, err)
+	assert.Equal(t, 15, channel)
+	_, err = channelOf(0)
+	assert.True(t, errors.Is(err, midi.ErrChannelRange))
+}
+
+func TestWriteChordTracks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chord-track")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	var buf bytes.Buffer
+	assert.Nil(t, writeChordTracks(&buf, []string{"C/E", "G"}, "", chordtrack.WithBass(1, midi.AcousticBass)))
+	assert.Equal(t, "chords on channel 1, program 1: 6 notes\nbass on channel 2, program 33: 2 notes\n", buf.String())
+	path := filepath.Join(dir, "chords.mid")
+	buf.Reset()
+	assert.Nil(t, writeChordTracks(&buf, []string{"Am", "F"}, path, chordtrack.WithStrum(20*time.Millisecond)))
+	b, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "MThd", string(b[:4]))
+	assert.NotNil(t, writeChordTracks(&buf, []string{"Hb"}, ""))
+	assert.True(t, errors.Is(writeChordTracks(&buf, []string{"C"}, "", chordtrack.WithProgram(129)), midi.ErrProgramRange))
+	assert.NotNil(t, writeChordTracks(&buf, []string{"C"}, filepath.Join(dir, "missing", "chords.mid")))
+}
+
+func TestChordTrackExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chord-track")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assertExitCode(t, 0, "", "chord-track")
+	assertExitCode(t, 0, "", "chord-track", "C", "G")
+	assertExitCode(t, 0, "", "chord-track", "--program", "25", "--strum", "20ms", "--bass", "--midi", filepath.Join(dir, "strum.mid"), "C/E", "F", "G7", "C")
+	assertExitCode(t, 0, "", "chord-track", "--arpeggio", "random", "--seed", "3", "--beats", "2", "--humanize", "10ms", "--jitter", "8", "--midi", filepath.Join(dir, "arpeggio.mid"), "Am", "F")
+	assertExitCode(t, 1, "Error occurred: unknown pattern \"sideways\"\n", "chord-track", "--arpeggio", "sideways", "C")
+	assertExitCode(t, 1, "Error occurred: channel out of range: 17, expected 1 to 16\n", "chord-track", "--channel", "17", "C")
+	assertExitCode(t, 1, "Error occurred: channel out of range: 0, expected 1 to 16\n", "chord-track", "--bass", "--bass-channel", "0", "C")
+	assertExitCode(t, 1, "Error occurred: program out of range: 129 of track \"chords\", expected 1 to 128, or 0 for none\n", "chord-track", "--program", "129", "C")
+	assertExitCode(t, 1, "Error occurred: unknown root \"Hb\" at position 0 of chord \"Hb\"\n", "chord-track", "Hb")
+}
@@ -0,0 +1,40 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/rhythm"
+)
+
+// writeClickTrack of a meter named, e.g. "7/8", with its beats grouped by the accents, e.g. "2+2+3", or as the meter implies if empty, at some beats per minute, for some bars,
+// writing a bar of its accents and how long it lasts, and the click track to a MIDI file and a WAV file at each path, if any
+func writeClickTrack(w io.Writer, meterName string, accents string, bpm float64, bars int, midiPath string, wavPath string) error {
+	m, err := meter.Parse(meterName)
+	if err != nil {
+		return err
+	}
+	if len(accents) > 0 {
+		if m, err = m.Grouped(accents); err != nil {
+			return err
+		}
+	}
+	t, err := rhythm.Metronome(m, bpm, bars)
+	if err != nil {
+		return err
+	}
+	if len(midiPath) > 0 {
+		if err = writeTuneFile(midiPath, t.WriteMIDI); err != nil {
+			return err
+		}
+	}
+	if len(wavPath) > 0 {
+		if err = writeTuneFile(wavPath, t.WriteWAV); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "%s  %s\n%d bars at %v bpm, %s\n", m, t, bars, bpm, t.Duration())
+	return err
+}
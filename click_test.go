@@ -0,0 +1,54 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/rhythm"
+)
+
+func TestWriteClickTrack(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, writeClickTrack(&out, "7/8", "", 140, 16, "", ""))
+	assert.Equal(t, "7/8  Xx|Xx|Xxx\n16 bars at 140 bpm, 48s\n", out.String())
+	out.Reset()
+	assert.Nil(t, writeClickTrack(&out, "7/8", "3+2+2", 140, 1, "", ""))
+	assert.Equal(t, "3+2+2/8  Xxx|Xx|Xx\n1 bars at 140 bpm, 3s\n", out.String())
+	assert.True(t, errors.Is(writeClickTrack(&out, "7/8", "2+2", 140, 1, "", ""), meter.ErrInvalidMeter))
+	assert.True(t, errors.Is(writeClickTrack(&out, "waltz", "", 140, 1, "", ""), meter.ErrInvalidMeter))
+	assert.True(t, errors.Is(writeClickTrack(&out, "4/4", "", 120, 0, "", ""), rhythm.ErrBarsRange))
+}
+
+func TestWriteClickTrack_Files(t *testing.T) {
+	dir, err := ioutil.TempDir("", "click")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	midiPath, wavPath := filepath.Join(dir, "click.mid"), filepath.Join(dir, "click.wav")
+	var out bytes.Buffer
+	assert.Nil(t, writeClickTrack(&out, "5/4", "3+2", 100, 2, midiPath, wavPath))
+	f, err := os.Open(midiPath)
+	assert.Nil(t, err)
+	defer f.Close()
+	notes, err := midi.Read(f)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(notes))
+	wav, err := ioutil.ReadFile(wavPath)
+	assert.Nil(t, err)
+	assert.Equal(t, "RIFF", string(wav[:4]))
+	assert.NotNil(t, writeClickTrack(&out, "5/4", "", 100, 2, "", filepath.Join(dir, "missing", "click.wav")))
+}
+
+func TestClickExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "click")
+	assertExitCode(t, 0, "", "click", "--bpm", "140", "--bars", "2", "--accent", "2+2+3", "7/8")
+	assertExitCode(t, 1, "Error occurred: invalid meter groups \"2+2\", expected them to add up to 7 beats\n", "click", "--accent", "2+2", "7/8")
+}
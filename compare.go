@@ -0,0 +1,97 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// compareScales named, writing the tones they share and those only one has, and then a table of the pivot chords diatonic to both, by the degree of each in either scale
+func compareScales(w io.Writer, nameA, nameB string) error {
+	a, err := scale.Parse(nameA)
+	if err != nil {
+		return err
+	}
+	b, err := scale.Parse(nameB)
+	if err != nil {
+		return err
+	}
+	c := scale.Compare(a, b)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "shared\t%s\n", classNames(c.Shared, a.AdjSymbol))
+	fmt.Fprintf(tw, "only in %s\t%s\n", nameA, classNames(c.OnlyA, a.AdjSymbol))
+	fmt.Fprintf(tw, "only in %s\t%s\n", nameB, classNames(c.OnlyB, b.AdjSymbol))
+	if err = tw.Flush(); err != nil {
+		return err
+	}
+	if len(c.Pivots) == 0 {
+		_, err = fmt.Fprintln(w, "\nNo pivot chords")
+		return err
+	}
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "PIVOT\tDEGREE IN %s\tDEGREE IN %s\n", nameA, nameB)
+	for _, p := range c.Pivots {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", p.Chord.Name(), p.DegreeA, p.DegreeB)
+	}
+	return tw.Flush()
+}
+
+// compareProgressions of chord names separated by spaces, e.g. "Dm7 G7 C", writing their similarity, with the second transposed to be most similar if asked,
+// and then their alignment, a column of each pair of chords, and - of a gap
+func compareProgressions(w io.Writer, namesA, namesB string, transpose bool) error {
+	a, err := progressionNamed(namesA)
+	if err != nil {
+		return err
+	}
+	b, err := progressionNamed(namesB)
+	if err != nil {
+		return err
+	}
+	if transpose {
+		similarity, semitones := progression.TransposedSimilarity(a, b)
+		for i, c := range b.Chords {
+			b.Chords[i] = c.Transpose(-semitones)
+		}
+		fmt.Fprintf(w, "similarity %.0f%%, of the second transposed down %d semitones\n\n", similarity*100, semitones)
+	}
+	alignment := progression.Align(a, b)
+	if !transpose {
+		fmt.Fprintf(w, "similarity %.0f%%\n\n", alignment.Similarity*100)
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	var rowA, rowB []string
+	for _, p := range alignment.Pairs {
+		rowA = append(rowA, alignedName(a, p.A))
+		rowB = append(rowB, alignedName(b, p.B))
+	}
+	fmt.Fprintf(tw, "%s\n%s\n", strings.Join(rowA, "\t"), strings.Join(rowB, "\t"))
+	return tw.Flush()
+}
+
+// progressionNamed by chord names separated by spaces, e.g. "Dm7 G7 C", each parsed
+func progressionNamed(names string) (progression.Progression, error) {
+	p := progression.Progression{}
+	for _, name := range strings.Fields(names) {
+		c, err := chord.Parse(name)
+		if err != nil {
+			return p, err
+		}
+		p.Chords = append(p.Chords, c)
+	}
+	return p, nil
+}
+
+// alignedName of the chord at an index of a progression, or - of a gap
+func alignedName(p progression.Progression, index int) string {
+	if index == progression.Gap {
+		return "-"
+	}
+	return p.Chords[index].Name()
+}
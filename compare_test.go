@@ -0,0 +1,55 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestCompareScales(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, compareScales(&out, "C major", "E minor"))
+	assert.Equal(t, "shared           C D E G A B\n"+
+		"only in C major  F\n"+
+		"only in E minor  Gb\n"+
+		"\n"+
+		"PIVOT  DEGREE IN C major  DEGREE IN E minor\n"+
+		"C      1                  6\n"+
+		"CM7    1                  6\n"+
+		"Em     3                  1\n"+
+		"Em7    3                  1\n"+
+		"G      5                  3\n"+
+		"Am     6                  4\n"+
+		"Am7    6                  4\n", out.String())
+	out.Reset()
+	assert.Nil(t, compareScales(&out, "C major", "F# major"))
+	assert.Contains(t, out.String(), "\nNo pivot chords\n")
+	assert.NotNil(t, compareScales(&out, "Hb", "C"))
+	assert.NotNil(t, compareScales(&out, "C", "Hb"))
+}
+
+func TestCompareScalesExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "compare-scales")
+	assertExitCode(t, 0, "", "compare-scales", "C major")
+	assertExitCode(t, 0, "", "compare-scales", "C major", "A harmonic minor")
+	assertExitCode(t, 1, "Error occurred: unknown root \"Hb\" at position 0 of scale \"Hb\"\n", "compare-scales", "C", "Hb")
+}
+
+func TestCompareProgressions(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, compareProgressions(&out, "C Am F G", "C F G", false))
+	assert.Equal(t, "similarity 75%\n\nC  Am  F  G\nC  -   F  G\n", out.String())
+	out.Reset()
+	assert.Nil(t, compareProgressions(&out, "Dm7 G7 C", "Em7 A7 D", true))
+	assert.Equal(t, "similarity 100%, of the second transposed down 2 semitones\n\nDm7  G7  C\nDm7  G7  C\n", out.String())
+	assert.NotNil(t, compareProgressions(&out, "Hb", "C", false))
+	assert.NotNil(t, compareProgressions(&out, "C", "Hb", false))
+}
+
+func TestCompareProgressionsExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "compare-progressions")
+	assertExitCode(t, 0, "", "compare-progressions", "--transpose", "Dm7 G7 C", "Em7 A7 D")
+	assertExitCode(t, 1, "Error occurred: unknown root \"Hb\" at position 0 of chord \"Hb\"\n", "compare-progressions", "C", "Hb")
+}
@@ -0,0 +1,42 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/urfave/cli.v1"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// loadConfig of custom chord forms and scale modes from the config directory, if any, before running any command
+func loadConfig(c *cli.Context) error {
+	dir := configDir()
+	if dir == "" {
+		return nil
+	}
+	err := chord.LoadFormsFile(filepath.Join(dir, "chords.yaml"))
+	if err != nil && !os.IsNotExist(err) {
+		return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+	}
+	err = scale.LoadModesFile(filepath.Join(dir, "scales.yaml"))
+	if err != nil && !os.IsNotExist(err) {
+		return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+	}
+	return nil
+}
+
+// configDir is $XDG_CONFIG_HOME/music-theory, else ~/.config/music-theory, or empty if there is no home directory
+func configDir() string {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "music-theory")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "music-theory")
+}
@@ -0,0 +1,61 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestConfigDir(t *testing.T) {
+	oldXDG := os.Getenv("XDG_CONFIG_HOME")
+	defer os.Setenv("XDG_CONFIG_HOME", oldXDG)
+
+	os.Setenv("XDG_CONFIG_HOME", "/tmp/xdg")
+	assert.Equal(t, "/tmp/xdg/music-theory", configDir())
+
+	os.Setenv("XDG_CONFIG_HOME", "")
+	home, _ := os.UserHomeDir()
+	assert.Equal(t, filepath.Join(home, ".config", "music-theory"), configDir())
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	oldXDG := os.Getenv("XDG_CONFIG_HOME")
+	defer os.Setenv("XDG_CONFIG_HOME", oldXDG)
+
+	dir, err := ioutil.TempDir("", "music-theory")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "music-theory"), 0755))
+	path := filepath.Join(dir, "music-theory", "chords.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("- name: Broken\n  match: (\n"), 0644))
+
+	assertExitCode(t, 1, "Error occurred: "+path+": form \"Broken\": error parsing regexp: missing closing ): `(`\n", "chord", "C")
+}
+
+func TestLoadConfig_InvalidScales(t *testing.T) {
+	oldXDG := os.Getenv("XDG_CONFIG_HOME")
+	defer os.Setenv("XDG_CONFIG_HOME", oldXDG)
+
+	dir, err := ioutil.TempDir("", "music-theory")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "music-theory"), 0755))
+	path := filepath.Join(dir, "music-theory", "scales.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("- name: Empty\n  intervals: []\n"), 0644))
+
+	assertExitCode(t, 1, "Error occurred: "+path+": invalid intervals [] of mode \"Empty\"\n", "scale", "C")
+}
+
+func TestLoadConfig_Missing(t *testing.T) {
+	oldXDG := os.Getenv("XDG_CONFIG_HOME")
+	defer os.Setenv("XDG_CONFIG_HOME", oldXDG)
+
+	os.Setenv("XDG_CONFIG_HOME", "/nonexistent")
+	assertExitCode(t, 0, "", "chord", "C")
+}
@@ -0,0 +1,56 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/urfave/cli.v1"
+
+	"github.com/go-music-theory/music-theory/diagram"
+	"github.com/go-music-theory/music-theory/fretboard"
+)
+
+// diagramFlag to draw a diagram of a chord or scale instead of rendering it
+var diagramFlag = cli.StringFlag{Name: "diagram", Usage: "Draw a diagram instead, one of " + strings.Join(diagram.KindNames, ", ") + ", as SVG, or PNG if --out is named .png"}
+
+// instrumentFlag whose fretboard a fretboard diagram is drawn of
+var instrumentFlag = cli.StringFlag{Name: "instrument", Value: "guitar", Usage: "Draw a fretboard diagram of this instrument, one of " + strings.Join(fretboard.Names(), ", ")}
+
+// outFlag to write the diagram to a file instead
+var outFlag = cli.StringFlag{Name: "out", Usage: "Write the diagram to a file at this path, as PNG if it's named .png, else SVG"}
+
+// diagramPNGScale of every pixel of a diagram written as PNG, so it's sharp on a screen of high density
+const diagramPNGScale = 2
+
+// writeDiagram of a kind of some tones, of the fretboard of an instrument by name, with any other options, e.g. of accidentals,
+// as SVG to the writer, or else to a file at a path, as PNG if it's named .png, else SVG
+func writeDiagram(w io.Writer, kind string, instrument string, tones diagram.Tones, path string, options ...diagram.Option) error {
+	f, err := fretboard.Named(instrument)
+	if err != nil {
+		return err
+	}
+	d, err := diagram.Draw(diagram.Kind(kind), tones, append([]diagram.Option{diagram.WithFretboard(f)}, options...)...)
+	if err != nil {
+		return err
+	}
+	if len(path) == 0 {
+		return d.WriteSVG(w)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if strings.ToLower(filepath.Ext(path)) == ".png" {
+		err = d.WritePNG(file, diagramPNGScale)
+	} else {
+		err = d.WriteSVG(file)
+	}
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
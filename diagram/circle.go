@@ -0,0 +1,55 @@
+// A circle diagram highlights the tones on the circle of fifths, C at the top and each fifth above it clockwise, joining those of a chord in the order of its tones
+package diagram
+
+import (
+	"math"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+//
+// Private
+//
+
+// Dimensions of the circle
+const (
+	circleSize   = 240
+	circleRadius = 96
+	circleDotR   = 16
+)
+
+// drawCircle of fifths, the tones highlighted, and of a chord, a line from each tone to the next and from the last back to the first
+func drawCircle(t Tones) Diagram {
+	d := Diagram{Width: circleSize, Height: circleSize}
+	center := circleSize / 2
+	d.circle(center, center, circleRadius, "", "#cccccc")
+	var classes []note.Class
+	for _, class := range t.Classes {
+		if class != note.Nil {
+			classes = append(classes, class)
+		}
+	}
+	for n := 0; t.Together && len(classes) > 2 && n < len(classes); n++ {
+		x, y := circlePositionOf(classes[n])
+		x2, y2 := circlePositionOf(classes[(n+1)%len(classes)])
+		d.line(x, y, x2, y2, 2, ToneFill)
+	}
+	for fifth := 0; fifth < 12; fifth++ {
+		class, _ := note.C.Step(fifth * 7)
+		x, y := circlePositionOf(class)
+		fill, label := fillOf(t, class), "#ffffff"
+		if fill == "" {
+			fill, label = "#ffffff", "#000000"
+		}
+		d.circle(x, y, circleDotR, fill, "#000000")
+		d.text(x, y+4, 12, label, class.String(t.AdjSymbol))
+	}
+	return d
+}
+
+// circlePositionOf a pitch class on the circle of fifths, the center of its dot, e.g. at the top of C or the right of A
+func circlePositionOf(class note.Class) (int, int) {
+	fifths := (int(class-note.C)*7%12 + 12) % 12
+	angle := float64(fifths) * math.Pi / 6
+	return circleSize/2 + int(math.Round(circleRadius*math.Sin(angle))), circleSize/2 - int(math.Round(circleRadius*math.Cos(angle)))
+}
@@ -0,0 +1,37 @@
+// A circle diagram highlights the tones on the circle of fifths, C at the top and each fifth above it clockwise, joining those of a chord in the order of its tones
+package diagram
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestDrawCircle(t *testing.T) {
+	d := drawCircle(OfChord(chord.Of("G7")))
+	assert.Equal(t, 240, d.Width)
+	assert.Equal(t, 240, d.Height)
+	assert.Equal(t, 4, countShapes(d, lineShape, ""))
+	assert.Equal(t, 1, countShapes(d, circleShape, RootFill))
+	assert.Equal(t, 3, countShapes(d, circleShape, ToneFill))
+	assert.Equal(t, []string{"C", "G", "D", "A", "E", "B", "F#", "C#", "G#", "D#", "A#", "F"}, textsOf(d))
+}
+
+func TestDrawCircle_Scale(t *testing.T) {
+	d := drawCircle(OfScale(scale.Of("C major")))
+	assert.Equal(t, 0, countShapes(d, lineShape, ""))
+	assert.Equal(t, 6, countShapes(d, circleShape, ToneFill))
+}
+
+func TestCirclePositionOf(t *testing.T) {
+	x, y := circlePositionOf(note.C)
+	assert.Equal(t, []int{120, 24}, []int{x, y})
+	x, y = circlePositionOf(note.A)
+	assert.Equal(t, []int{216, 120}, []int{x, y})
+	x, y = circlePositionOf(note.Fs)
+	assert.Equal(t, []int{120, 216}, []int{x, y})
+}
@@ -0,0 +1,144 @@
+// A diagram pictures the tones of a chord or scale: on a piano keyboard, a staff, the fretboard of a guitar or another fretted instrument, or the circle of fifths,
+// to be written as SVG, or rasterized as PNG.
+//
+// https://en.wikipedia.org/wiki/Chord_chart
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package diagram
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Kind of diagram
+type Kind string
+
+// Kinds of diagram
+const (
+	Keyboard  Kind = "keyboard"  // the tones pressed on a piano keyboard
+	Staff     Kind = "staff"     // the tones on a treble staff, stacked of a chord, or one after another of a scale
+	Fretboard Kind = "fretboard" // every position of the tones on the first 12 frets of a guitar in standard tuning, or of another instrument WithFretboard
+	Circle    Kind = "circle"    // the tones highlighted on the circle of fifths
+)
+
+// KindNames of all the kinds of diagram
+var KindNames = []string{string(Keyboard), string(Staff), string(Fretboard), string(Circle)}
+
+// ErrUnknownKind when drawing a diagram of a kind that isn't known, e.g. "banjo"
+var ErrUnknownKind = errors.New("unknown diagram")
+
+// Colors of a diagram
+const (
+	RootFill = "#d9534f" // of the root
+	ToneFill = "#5b9bd5" // of every other tone
+)
+
+// Tones of a chord or scale to picture, from the root, spelled with an accidental
+type Tones struct {
+	Root      note.Class
+	AdjSymbol note.AdjSymbol
+	Classes   []note.Class // in ascending order of interval from the root, e.g. C Eb G Bb of Cm7
+	Together  bool         // sounding together, as a chord, rather than one after another, as a scale
+}
+
+// Diagram of some width and height, in pixels, of the shapes drawn on it
+type Diagram struct {
+	Width  int
+	Height int
+	shapes []shape
+}
+
+// OfChord its tones, sounding together
+func OfChord(c chord.Chord) Tones {
+	t := Tones{Root: c.Root, AdjSymbol: c.AdjSymbol, Together: true}
+	for _, tone := range c.OrderedTones() {
+		t.Classes = append(t.Classes, tone.Class)
+	}
+	return t
+}
+
+// OfScale its tones, one after another
+func OfScale(s scale.Scale) Tones {
+	t := Tones{Root: s.Root, AdjSymbol: s.AdjSymbol}
+	for _, tone := range s.OrderedTones() {
+		t.Classes = append(t.Classes, tone.Class)
+	}
+	return t
+}
+
+// Draw a diagram of a kind, of some tones, with any options, e.g. Draw(Keyboard, OfChord(chord.Of("Cm7")))
+func Draw(kind Kind, t Tones, opts ...Option) (Diagram, error) {
+	o := optionsOf(opts)
+	var d Diagram
+	switch kind {
+	case Keyboard:
+		d = drawKeyboard(t)
+	case Staff:
+		d = drawStaff(t)
+	case Fretboard:
+		d = drawFretboard(t, o.fretboard)
+	case Circle:
+		d = drawCircle(t)
+	default:
+		return Diagram{}, fmt.Errorf("%w %q, expected one of keyboard, staff, fretboard or circle", ErrUnknownKind, kind)
+	}
+	d.labelAccidentals(o.accidentals)
+	return d, nil
+}
+
+//
+// Private
+//
+
+// rootOctave of the first pitch of the tones
+const rootOctave = note.Octave(4)
+
+// pitch of a tone, its class in an octave
+type pitch struct {
+	class  note.Class
+	octave note.Octave
+}
+
+// pitchesOf the tones, each in the lowest octave above the tone before it, beginning from the root in the root octave
+func pitchesOf(t Tones) []pitch {
+	var pitches []pitch
+	prev := int(t.Root) + int(rootOctave)*12 - 1
+	for _, class := range t.Classes {
+		if class == note.Nil {
+			continue
+		}
+		step := int(class) + int(rootOctave)*12
+		for step <= prev {
+			step += 12
+		}
+		pitches = append(pitches, pitch{class, note.Octave((step - 1) / 12)})
+		prev = step
+	}
+	return pitches
+}
+
+// fillOf a pitch class of the tones, the RootFill of the root, else the ToneFill of another tone, or a fill of none
+func fillOf(t Tones, class note.Class) string {
+	if class == t.Root {
+		return RootFill
+	}
+	for _, c := range t.Classes {
+		if c == class {
+			return ToneFill
+		}
+	}
+	return ""
+}
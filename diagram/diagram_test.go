@@ -0,0 +1,79 @@
+// A diagram pictures the tones of a chord or scale: on a piano keyboard, a staff, the fretboard of a guitar or another fretted instrument, or the circle of fifths,
+// to be written as SVG, or rasterized as PNG.
+package diagram
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/fretboard"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+func TestOfChord(t *testing.T) {
+	tones := OfChord(chord.Of("Cm7"))
+	assert.Equal(t, note.C, tones.Root)
+	assert.Equal(t, note.Flat, tones.AdjSymbol)
+	assert.Equal(t, []note.Class{note.C, note.Ds, note.G, note.As}, tones.Classes)
+	assert.True(t, tones.Together)
+}
+
+func TestOfScale(t *testing.T) {
+	tones := OfScale(scale.Of("A minor"))
+	assert.Equal(t, note.A, tones.Root)
+	assert.Equal(t, []note.Class{note.A, note.B, note.C, note.D, note.E, note.F, note.G}, tones.Classes)
+	assert.False(t, tones.Together)
+}
+
+func TestDraw(t *testing.T) {
+	for _, name := range KindNames {
+		d, err := Draw(Kind(name), OfChord(chord.Of("G7")))
+		assert.Nil(t, err)
+		assert.True(t, d.Width > 0 && d.Height > 0, name)
+		assert.NotEmpty(t, d.shapes, name)
+	}
+	_, err := Draw("banjo", OfChord(chord.Of("G7")))
+	assert.True(t, errors.Is(err, ErrUnknownKind))
+}
+
+func TestPitchesOf(t *testing.T) {
+	assert.Equal(t, []pitch{{note.A, 4}, {note.C, 5}, {note.E, 5}}, pitchesOf(OfChord(chord.Of("Am"))))
+	assert.Equal(t, []pitch{{note.C, 4}, {note.E, 4}, {note.G, 4}, {note.D, 5}}, pitchesOf(OfChord(chord.Of("Cadd9"))))
+}
+
+func TestFillOf(t *testing.T) {
+	tones := OfChord(chord.Of("C"))
+	assert.Equal(t, RootFill, fillOf(tones, note.C))
+	assert.Equal(t, ToneFill, fillOf(tones, note.E))
+	assert.Equal(t, "", fillOf(tones, note.D))
+}
+
+func TestDraw_WithFretboard(t *testing.T) {
+	guitar, err := Draw(Fretboard, OfChord(chord.Of("G7")))
+	assert.Nil(t, err)
+	mandolin, err := Draw(Fretboard, OfChord(chord.Of("G7")), WithFretboard(fretboard.Mandolin))
+	assert.Nil(t, err)
+	assert.Equal(t, 16+5*24+16+20, guitar.Height)
+	assert.Equal(t, 16+3*24+16+20, mandolin.Height)
+}
+
+func TestDraw_WithAccidentals(t *testing.T) {
+	for _, name := range KindNames {
+		ascii, err := Draw(Kind(name), OfChord(chord.Of("Bb7")))
+		assert.Nil(t, err)
+		unicode, err := Draw(Kind(name), OfChord(chord.Of("Bb7")), WithAccidentals(symbol.Unicode))
+		assert.Nil(t, err)
+		assert.Equal(t, len(ascii.shapes), len(unicode.shapes), name)
+		var labeled bool
+		for n, s := range unicode.shapes {
+			assert.NotContains(t, s.text, "b", name)
+			labeled = labeled || s.text != ascii.shapes[n].text
+		}
+		assert.True(t, labeled, name)
+	}
+}
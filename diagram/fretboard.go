@@ -0,0 +1,71 @@
+// A fretboard diagram marks every position of the tones on the first 12 frets of a guitar in standard tuning, or of another fretted instrument, with the last string of its tuning at the top
+package diagram
+
+import (
+	"strconv"
+
+	"github.com/go-music-theory/music-theory/fretboard"
+)
+
+//
+// Private
+//
+
+// Dimensions of the fretboard, the open strings left of the nut
+const (
+	fretFrets    = 12
+	fretWidth    = 40
+	fretString   = 24
+	fretNut      = 36
+	fretMargin   = 16
+	fretDotR     = 9
+	fretNumbersY = 20
+)
+
+// fretMarkers of the inlaid frets, numbered below the fretboard
+var fretMarkers = []int{3, 5, 7, 9, 12}
+
+// drawFretboard of the tones, each position of them on every string of an instrument, from the open string to the 12th fret, or its last if it has fewer
+func drawFretboard(t Tones, f fretboard.Fretboard) Diagram {
+	frets := fretFrets
+	if f.Frets < frets {
+		frets = f.Frets
+	}
+	bottom := fretMargin + (len(f.Tuning)-1)*fretString
+	d := Diagram{Width: fretNut + frets*fretWidth + fretMargin, Height: bottom + fretMargin + fretNumbersY}
+	for fret := 0; fret <= frets; fret++ {
+		width := 1
+		if fret == 0 {
+			width = 4
+		}
+		x := fretNut + fret*fretWidth
+		d.line(x, fretMargin, x, bottom, width, "#000000")
+	}
+	for _, fret := range fretMarkers {
+		if fret <= frets {
+			d.text(fretNut+fret*fretWidth-fretWidth/2, bottom+fretNumbersY, 11, "#000000", strconv.Itoa(fret))
+		}
+	}
+	for s := range f.Tuning {
+		str := len(f.Tuning) - 1 - s
+		y := fretMargin + s*fretString
+		d.line(fretNut, y, d.Width-fretMargin, y, 1, "#000000")
+		for fret := 0; fret <= frets; fret++ {
+			n, ok := f.At(str, fret)
+			if !ok {
+				continue
+			}
+			fill := fillOf(t, n.Class)
+			if fill == "" {
+				continue
+			}
+			x := fretNut + fret*fretWidth - fretWidth/2
+			if fret == 0 {
+				x = fretNut / 2
+			}
+			d.circle(x, y, fretDotR, fill, "#000000")
+			d.text(x, y+3, 9, "#ffffff", n.Class.String(t.AdjSymbol))
+		}
+	}
+	return d
+}
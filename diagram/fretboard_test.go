@@ -0,0 +1,43 @@
+// A fretboard diagram marks every position of the tones on the first 12 frets of a guitar in standard tuning, or of another fretted instrument, with the last string of its tuning at the top
+package diagram
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/fretboard"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestDrawFretboard(t *testing.T) {
+	d := drawFretboard(Tones{Root: note.E, Classes: []note.Class{note.E, note.B}, Together: true}, fretboard.Guitar(0))
+	assert.Equal(t, 36+12*40+16, d.Width)
+	assert.Equal(t, 16+5*24+16+20, d.Height)
+	assert.Equal(t, 13+6, countShapes(d, lineShape, ""))
+	// E and B of a power chord, 3 each of the E and B strings, at the open string, the 12th fret and between, and 2 of each other string
+	assert.Equal(t, 15, countShapes(d, circleShape, ""))
+	assert.Equal(t, 8, countShapes(d, circleShape, RootFill))
+}
+
+func TestDrawFretboard_Markers(t *testing.T) {
+	texts := textsOf(drawFretboard(OfChord(chord.Of("C")), fretboard.Guitar(0)))
+	assert.Equal(t, []string{"3", "5", "7", "9", "12"}, texts[:5])
+}
+
+func TestDrawFretboard_Ukulele(t *testing.T) {
+	d := drawFretboard(Tones{Root: note.A, Classes: []note.Class{note.A}}, fretboard.Ukulele)
+	assert.Equal(t, 16+3*24+16+20, d.Height)
+	assert.Equal(t, 13+4, countShapes(d, lineShape, ""))
+	// A of the open A string at the top and its 12th fret, the 2nd fret of the G string at the bottom, the 9th of C and the 5th of E
+	assert.Equal(t, 5, countShapes(d, circleShape, RootFill))
+}
+
+func TestDrawFretboard_FewerFrets(t *testing.T) {
+	short := fretboard.Fretboard{Name: "short", Tuning: fretboard.Standard, Frets: 5}
+	d := drawFretboard(OfChord(chord.Of("C")), short)
+	assert.Equal(t, 36+5*40+16, d.Width)
+	assert.Equal(t, 6+6, countShapes(d, lineShape, ""))
+	assert.Equal(t, []string{"3", "5"}, textsOf(d)[:2])
+}
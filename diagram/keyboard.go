@@ -0,0 +1,65 @@
+// A keyboard diagram presses the keys of the tones, each in the lowest octave above the one before it, labeled by its note name
+package diagram
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+//
+// Private
+//
+
+// Dimensions of each key
+const (
+	whiteWidth  = 24
+	whiteHeight = 96
+	blackWidth  = 14
+	blackHeight = 60
+)
+
+// whiteIndex of each natural pitch class, left to right within an octave
+var whiteIndex = map[note.Class]int{note.C: 0, note.D: 1, note.E: 2, note.F: 3, note.G: 4, note.A: 5, note.B: 6}
+
+// blackAfter each accidental pitch class, the natural pitch class of the white key to its left
+var blackAfter = map[note.Class]note.Class{note.Cs: note.C, note.Ds: note.D, note.Fs: note.F, note.Gs: note.G, note.As: note.A}
+
+// drawKeyboard of the octaves from the lowest to the highest of the pitches of the tones
+func drawKeyboard(t Tones) Diagram {
+	pitches := pitchesOf(t)
+	low, high := rootOctave, rootOctave
+	pressed := make(map[pitch]bool)
+	for _, p := range pitches {
+		if p.octave < low {
+			low = p.octave
+		}
+		if p.octave > high {
+			high = p.octave
+		}
+		pressed[p] = true
+	}
+	octaves := int(high-low) + 1
+	d := Diagram{Width: octaves * 7 * whiteWidth, Height: whiteHeight}
+	key := func(p pitch, x, width, height int, fill string) {
+		if pressed[p] {
+			fill = fillOf(t, p.class)
+		}
+		d.rect(x, 0, width, height, fill, "#000000")
+		if pressed[p] {
+			d.text(x+width/2, height-6, 9, "#ffffff", p.class.String(t.AdjSymbol))
+		}
+	}
+	for o := 0; o < octaves; o++ {
+		octave := low + note.Octave(o)
+		for class := note.C; class <= note.B; class++ {
+			if i, ok := whiteIndex[class]; ok {
+				key(pitch{class, octave}, (o*7+i)*whiteWidth, whiteWidth, whiteHeight, "#ffffff")
+			}
+		}
+		for class := note.C; class <= note.B; class++ {
+			if left, ok := blackAfter[class]; ok {
+				key(pitch{class, octave}, (o*7+whiteIndex[left]+1)*whiteWidth-blackWidth/2, blackWidth, blackHeight, "#000000")
+			}
+		}
+	}
+	return d
+}
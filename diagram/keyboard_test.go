@@ -0,0 +1,51 @@
+// A keyboard diagram presses the keys of the tones, each in the lowest octave above the one before it, labeled by its note name
+package diagram
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestDrawKeyboard(t *testing.T) {
+	d := drawKeyboard(OfChord(chord.Of("Cm")))
+	assert.Equal(t, 168, d.Width)
+	assert.Equal(t, 96, d.Height)
+	assert.Equal(t, 12, countShapes(d, rectShape, ""))
+	assert.Equal(t, 1, countShapes(d, rectShape, RootFill))
+	assert.Equal(t, 2, countShapes(d, rectShape, ToneFill))
+	assert.Equal(t, []string{"C", "G", "Eb"}, textsOf(d)) // the white keys, then the black
+}
+
+func TestDrawKeyboard_Octaves(t *testing.T) {
+	d := drawKeyboard(OfScale(scale.Of("A minor")))
+	assert.Equal(t, 2*168, d.Width)
+	assert.Equal(t, []string{"A", "B", "C", "D", "E", "F", "G"}, textsOf(d))
+}
+
+//
+// Private
+//
+
+// countShapes of a kind, and of a fill unless it's empty
+func countShapes(d Diagram, kind shapeKind, fill string) (count int) {
+	for _, s := range d.shapes {
+		if s.kind == kind && (fill == "" || s.fill == fill) {
+			count++
+		}
+	}
+	return
+}
+
+// textsOf a diagram, in the order they're drawn
+func textsOf(d Diagram) (texts []string) {
+	for _, s := range d.shapes {
+		if s.kind == textShape {
+			texts = append(texts, s.text)
+		}
+	}
+	return
+}
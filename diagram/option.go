@@ -0,0 +1,42 @@
+// Diagrams are drawn of a guitar in standard tuning by default, or with an Option, e.g. of a ukulele with Draw(Fretboard, t, WithFretboard(fretboard.Ukulele)),
+// and label their notes with accidentals in ASCII, or e.g. in Unicode with WithAccidentals(symbol.Unicode)
+package diagram
+
+import (
+	"github.com/go-music-theory/music-theory/fretboard"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Option for drawing a diagram
+type Option func(*options)
+
+// WithFretboard of an instrument whose strings a fretboard diagram is drawn of, e.g. WithFretboard(fretboard.Mandolin)
+func WithFretboard(f fretboard.Fretboard) Option {
+	return func(o *options) {
+		o.fretboard = f
+	}
+}
+
+// WithAccidentals of the labels of notes, in ASCII or Unicode, e.g. WithAccidentals(symbol.Unicode) to label Bb as B♭
+func WithAccidentals(a symbol.Accidentals) Option {
+	return func(o *options) {
+		o.accidentals = a
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	fretboard   fretboard.Fretboard
+	accidentals symbol.Accidentals
+}
+
+func optionsOf(opts []Option) options {
+	o := &options{fretboard: fretboard.Guitar(0), accidentals: symbol.ASCII}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
@@ -0,0 +1,168 @@
+// A diagram is rasterized as PNG on a white background, e.g. to share as an image, with its text in a small pixel font of the note names and numbers
+package diagram
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strconv"
+)
+
+// WritePNG of the diagram, at a scale of pixels per pixel of its width and height, at least 1
+func (d Diagram) WritePNG(w io.Writer, scale int) error {
+	if scale < 1 {
+		scale = 1
+	}
+	img := image.NewRGBA(image.Rect(0, 0, d.Width*scale, d.Height*scale))
+	fillRect(img, 0, 0, d.Width*scale, d.Height*scale, color.RGBA{0xff, 0xff, 0xff, 0xff})
+	for _, s := range d.shapes {
+		fill, hasFill := colorOf(s.fill)
+		stroke, hasStroke := colorOf(s.stroke)
+		switch s.kind {
+		case rectShape:
+			if hasFill {
+				fillRect(img, s.x*scale, s.y*scale, s.width*scale, s.height*scale, fill)
+			}
+			if hasStroke {
+				x, y, width, height := s.x*scale, s.y*scale, s.width*scale, s.height*scale
+				fillRect(img, x, y, width, scale, stroke)
+				fillRect(img, x, y+height-scale, width, scale, stroke)
+				fillRect(img, x, y, scale, height, stroke)
+				fillRect(img, x+width-scale, y, scale, height, stroke)
+			}
+		case circleShape:
+			if hasFill {
+				fillRing(img, s.x*scale, s.y*scale, s.width*scale, s.width*scale, fill)
+			}
+			if hasStroke {
+				fillRing(img, s.x*scale, s.y*scale, s.width*scale, scale, stroke)
+			}
+		case lineShape:
+			if hasStroke {
+				drawLine(img, s.x*scale, s.y*scale, s.x2*scale, s.y2*scale, s.width*scale, stroke)
+			}
+		case textShape:
+			if hasFill {
+				drawText(img, s.x*scale, s.y*scale, s.height*scale, fill, s.text)
+			}
+		}
+	}
+	return png.Encode(w, img)
+}
+
+//
+// Private
+//
+
+// colorOf a hex color, e.g. #d9534f, and whether it is one, i.e. not empty
+func colorOf(hex string) (color.RGBA, bool) {
+	if len(hex) != 7 || hex[0] != '#' {
+		return color.RGBA{}, false
+	}
+	value, err := strconv.ParseUint(hex[1:], 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+	return color.RGBA{uint8(value >> 16), uint8(value >> 8), uint8(value), 0xff}, true
+}
+
+// fillRect from x, y of a width and height
+func fillRect(img *image.RGBA, x, y, width, height int, c color.RGBA) {
+	for py := y; py < y+height; py++ {
+		for px := x; px < x+width; px++ {
+			img.SetRGBA(px, py, c)
+		}
+	}
+}
+
+// fillRing centered on x, y, of the pixels within a thickness inside a radius, or the whole circle of a thickness of the radius
+func fillRing(img *image.RGBA, x, y, radius, thickness int, c color.RGBA) {
+	outer, inner := radius*radius, (radius-thickness)*(radius-thickness)
+	if thickness >= radius {
+		inner = -1
+	}
+	for py := y - radius; py <= y+radius; py++ {
+		for px := x - radius; px <= x+radius; px++ {
+			d := (px-x)*(px-x) + (py-y)*(py-y)
+			if d <= outer && d > inner {
+				img.SetRGBA(px, py, c)
+			}
+		}
+	}
+}
+
+// drawLine from x, y to x2, y2 of a width, by a square of the width at every pixel along it
+func drawLine(img *image.RGBA, x, y, x2, y2, width int, c color.RGBA) {
+	steps := abs(x2 - x)
+	if abs(y2-y) > steps {
+		steps = abs(y2 - y)
+	}
+	if width < 1 {
+		width = 1
+	}
+	for n := 0; n <= steps; n++ {
+		px, py := x, y
+		if steps > 0 {
+			px, py = x+(x2-x)*n/steps, y+(y2-y)*n/steps
+		}
+		fillRect(img, px-width/2, py-width/2, width, width, c)
+	}
+}
+
+// drawText of a size, centered on x, with its baseline at y, in the pixel font, skipping any character it hasn't got
+func drawText(img *image.RGBA, x, y, size int, c color.RGBA, text string) {
+	dot := size / 7
+	if dot < 1 {
+		dot = 1
+	}
+	runes := []rune(text)
+	left := x - (len(runes)*4-1)*dot/2
+	top := y - 5*dot
+	for n, r := range runes {
+		glyph, ok := pixelFont[r]
+		if !ok {
+			continue
+		}
+		for row, line := range glyph {
+			for col, px := range line {
+				if px == '#' {
+					fillRect(img, left+(n*4+col)*dot, top+row*dot, dot, dot, c)
+				}
+			}
+		}
+	}
+}
+
+// abs value of an integer
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// pixelFont of 3 by 5 glyphs of the note names, their accidentals, and the numbers
+var pixelFont = map[rune][5]string{
+	'A': {".#.", "#.#", "###", "#.#", "#.#"},
+	'B': {"##.", "#.#", "##.", "#.#", "##."},
+	'C': {".##", "#..", "#..", "#..", ".##"},
+	'D': {"##.", "#.#", "#.#", "#.#", "##."},
+	'E': {"###", "#..", "##.", "#..", "###"},
+	'F': {"###", "#..", "##.", "#..", "#.."},
+	'G': {".##", "#..", "#.#", "#.#", ".##"},
+	'#': {"#.#", "###", "#.#", "###", "#.#"},
+	'b': {"#..", "#..", "##.", "#.#", "##."},
+	'♯': {"#.#", "###", "#.#", "###", "#.#"},
+	'♭': {"#..", "#..", "##.", "#.#", "##."},
+	'0': {"###", "#.#", "#.#", "#.#", "###"},
+	'1': {".#.", "##.", ".#.", ".#.", "###"},
+	'2': {"##.", "..#", ".#.", "#..", "###"},
+	'3': {"##.", "..#", ".#.", "..#", "##."},
+	'4': {"#.#", "#.#", "###", "..#", "..#"},
+	'5': {"###", "#..", "##.", "..#", "##."},
+	'6': {".##", "#..", "###", "#.#", "###"},
+	'7': {"###", "..#", ".#.", ".#.", ".#."},
+	'8': {"###", "#.#", "###", "#.#", "###"},
+	'9': {"###", "#.#", "###", "..#", "##."},
+}
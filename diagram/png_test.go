@@ -0,0 +1,73 @@
+// A diagram is rasterized as PNG on a white background, e.g. to share as an image, with its text in a small pixel font of the note names and numbers
+package diagram
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+)
+
+func TestDiagram_WritePNG(t *testing.T) {
+	d := Diagram{Width: 40, Height: 20}
+	d.rect(0, 0, 10, 10, "#0000ff", "#000000")
+	d.circle(30, 10, 5, RootFill, "")
+	d.line(0, 15, 20, 15, 1, "#00ff00")
+	d.text(30, 19, 7, "#000000", "C")
+	img := writePNG(t, d, 2)
+	assert.Equal(t, image.Rect(0, 0, 80, 40), img.Bounds())
+	assert.Equal(t, rgba(0, 0, 0), rgbaAt(img, 0, 0))
+	assert.Equal(t, rgba(0, 0, 0xff), rgbaAt(img, 10, 10))
+	assert.Equal(t, rgba(0xd9, 0x53, 0x4f), rgbaAt(img, 60, 20))
+	assert.Equal(t, rgba(0, 0xff, 0), rgbaAt(img, 20, 30))
+	assert.Equal(t, rgba(0xff, 0xff, 0xff), rgbaAt(img, 30, 20))
+	assert.Equal(t, rgba(0xff, 0xff, 0xff), rgbaAt(img, 79, 39))
+}
+
+func TestDiagram_WritePNG_Scale(t *testing.T) {
+	d, err := Draw(Keyboard, OfChord(chord.Of("C")))
+	assert.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, d.Width, d.Height), writePNG(t, d, 0).Bounds())
+}
+
+func TestColorOf(t *testing.T) {
+	c, ok := colorOf("#d9534f")
+	assert.True(t, ok)
+	assert.Equal(t, rgba(0xd9, 0x53, 0x4f), c)
+	_, ok = colorOf("")
+	assert.False(t, ok)
+	_, ok = colorOf("#zzzzzz")
+	assert.False(t, ok)
+}
+
+func TestPixelFont(t *testing.T) {
+	for _, r := range "ABCDEFG#b0123456789" {
+		_, ok := pixelFont[r]
+		assert.True(t, ok, string(r))
+	}
+}
+
+//
+// Private
+//
+
+func writePNG(t *testing.T, d Diagram, scale int) image.Image {
+	var out bytes.Buffer
+	assert.Nil(t, d.WritePNG(&out, scale))
+	img, err := png.Decode(&out)
+	assert.Nil(t, err)
+	return img
+}
+
+func rgba(r, g, b uint8) color.RGBA {
+	return color.RGBA{r, g, b, 0xff}
+}
+
+func rgbaAt(img image.Image, x, y int) color.RGBA {
+	return color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+}
@@ -0,0 +1,70 @@
+// A diagram is drawn as shapes, rectangles, circles, lines and text, in order from the back to the front, so it's written the same as SVG or PNG
+package diagram
+
+import (
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+//
+// Private
+//
+
+// shapeKind of a shape
+type shapeKind int
+
+const (
+	rectShape shapeKind = iota
+	circleShape
+	lineShape
+	textShape
+)
+
+// shape of a diagram: a rectangle from x, y of a width and height, a circle centered on x, y of a radius (the width),
+// a line from x, y to x2, y2 of a width, or text of a size centered on x with its baseline at y, each filled or stroked in a color, or none if empty
+type shape struct {
+	kind   shapeKind
+	x, y   int
+	x2, y2 int
+	width  int
+	height int
+	fill   string
+	stroke string
+	text   string
+}
+
+// rect from x, y of a width and height, filled and outlined
+func (d *Diagram) rect(x, y, width, height int, fill, stroke string) {
+	d.shapes = append(d.shapes, shape{kind: rectShape, x: x, y: y, width: width, height: height, fill: fill, stroke: stroke})
+}
+
+// circle centered on x, y of a radius, filled and outlined
+func (d *Diagram) circle(x, y, radius int, fill, stroke string) {
+	d.shapes = append(d.shapes, shape{kind: circleShape, x: x, y: y, width: radius, fill: fill, stroke: stroke})
+}
+
+// line from x, y to x2, y2 of a width and color
+func (d *Diagram) line(x, y, x2, y2, width int, stroke string) {
+	d.shapes = append(d.shapes, shape{kind: lineShape, x: x, y: y, x2: x2, y2: y2, width: width, stroke: stroke})
+}
+
+// text of a size and color, centered on x, with its baseline at y
+func (d *Diagram) text(x, y, size int, fill, text string) {
+	d.shapes = append(d.shapes, shape{kind: textShape, x: x, y: y, height: size, fill: fill, text: text})
+}
+
+// labelAccidentals of the text of the diagram, in ASCII or Unicode, e.g. B♭ of Bb, or ♭ of the b before a notehead of a staff
+func (d *Diagram) labelAccidentals(a symbol.Accidentals) {
+	for n, s := range d.shapes {
+		if s.kind != textShape {
+			continue
+		}
+		switch {
+		case a == symbol.Unicode && s.text == "#":
+			d.shapes[n].text = "♯"
+		case a == symbol.Unicode && s.text == "b":
+			d.shapes[n].text = "♭"
+		default:
+			d.shapes[n].text = a.Of(s.text)
+		}
+	}
+}
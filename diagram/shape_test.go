@@ -0,0 +1,22 @@
+// A diagram is drawn as shapes, rectangles, circles, lines and text, in order from the back to the front, so it's written the same as SVG or PNG
+package diagram
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestDiagram_Shapes(t *testing.T) {
+	var d Diagram
+	d.rect(1, 2, 3, 4, "#ffffff", "#000000")
+	d.circle(5, 6, 7, RootFill, "")
+	d.line(1, 2, 3, 4, 2, ToneFill)
+	d.text(8, 9, 10, "#000000", "C#")
+	assert.Equal(t, []shape{
+		{kind: rectShape, x: 1, y: 2, width: 3, height: 4, fill: "#ffffff", stroke: "#000000"},
+		{kind: circleShape, x: 5, y: 6, width: 7, fill: RootFill},
+		{kind: lineShape, x: 1, y: 2, x2: 3, y2: 4, width: 2, stroke: ToneFill},
+		{kind: textShape, x: 8, y: 9, height: 10, fill: "#000000", text: "C#"},
+	}, d.shapes)
+}
@@ -0,0 +1,98 @@
+// A staff diagram writes the tones on a treble staff, stacked of a chord or one after another of a scale, with ledger lines and accidentals as they need
+package diagram
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+//
+// Private
+//
+
+// Dimensions of the staff, a column for each note after the clef, and a step of half the gap between lines
+const (
+	staffClefWidth = 40
+	staffColumn    = 36
+	staffStep      = 5
+	staffNoteheadR = 5
+)
+
+// Steps of the letters of the lines of the treble staff, counted from C0, from the bottom line, E4, to the top line, F5
+const (
+	staffBottom = 2 + 7*4
+	staffTop    = 3 + 7*5
+)
+
+// drawStaff of the pitches of the tones, in a column together of a chord, or a column each of a scale
+func drawStaff(t Tones) Diagram {
+	pitches := pitchesOf(t)
+	low, high := staffBottom, staffTop
+	for _, p := range pitches {
+		step := staffStepOf(p, t.AdjSymbol)
+		if step < low {
+			low = step
+		}
+		if step > high {
+			high = step
+		}
+	}
+	low, high = low-3, high+3
+	columns := len(pitches)
+	if t.Together && columns > 0 {
+		columns = 1
+	}
+	d := Diagram{Width: staffClefWidth + columns*staffColumn + 10, Height: (high - low) * staffStep}
+	yOf := func(step int) int { return (high - step) * staffStep }
+	for step := staffBottom; step <= staffTop; step += 2 {
+		d.line(4, yOf(step), d.Width-4, yOf(step), 1, "#000000")
+	}
+	d.text(staffClefWidth/2, yOf(staffBottom)+8, 44, "#000000", "𝄞")
+	prev, shifted := 0, false
+	for n, p := range pitches {
+		column := n
+		if t.Together {
+			column = 0
+		}
+		x := staffClefWidth + column*staffColumn + staffColumn/2
+		step := staffStepOf(p, t.AdjSymbol)
+		if t.Together && n > 0 && step-prev == 1 && !shifted {
+			x, shifted = x+2*staffNoteheadR+1, true
+		} else {
+			shifted = false
+		}
+		prev = step
+		for ledger := staffBottom - 2; ledger >= step; ledger -= 2 {
+			d.line(x-9, yOf(ledger), x+9, yOf(ledger), 1, "#000000")
+		}
+		for ledger := staffTop + 2; ledger <= step; ledger += 2 {
+			d.line(x-9, yOf(ledger), x+9, yOf(ledger), 1, "#000000")
+		}
+		d.circle(x, yOf(step), staffNoteheadR, fillOf(t, p.class), "#000000")
+		switch _, alter := letterOf(p.class, t.AdjSymbol); alter {
+		case 1:
+			d.text(x-13, yOf(step)+4, 12, "#000000", "#")
+		case -1:
+			d.text(x-13, yOf(step)+4, 12, "#000000", "b")
+		}
+	}
+	return d
+}
+
+// staffStepOf a pitch, the step of its letter counted from C0, e.g. 30 of E4 or Eb4
+func staffStepOf(p pitch, adjSymbol note.AdjSymbol) int {
+	letter, _ := letterOf(p.class, adjSymbol)
+	return letter + 7*int(p.octave)
+}
+
+// letterOf a pitch class, the index of its letter from C, and its alteration in semitones, e.g. 2, -1 of Eb, spelled with an accidental
+func letterOf(class note.Class, adjSymbol note.AdjSymbol) (int, int) {
+	if i, ok := whiteIndex[class]; ok {
+		return i, 0
+	}
+	if adjSymbol == note.Flat {
+		up, _ := class.Step(1)
+		return whiteIndex[up], -1
+	}
+	down, _ := class.Step(-1)
+	return whiteIndex[down], 1
+}
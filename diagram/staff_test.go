@@ -0,0 +1,70 @@
+// A staff diagram writes the tones on a treble staff, stacked of a chord or one after another of a scale, with ledger lines and accidentals as they need
+package diagram
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestDrawStaff_Chord(t *testing.T) {
+	d := drawStaff(OfChord(chord.Of("Cm7")))
+	assert.Equal(t, 40+36+10, d.Width)
+	assert.Equal(t, 4, countShapes(d, circleShape, ""))
+	assert.Equal(t, 5+1, countShapes(d, lineShape, "")) // with a ledger line of middle C
+	assert.Equal(t, []string{"𝄞", "b", "b"}, textsOf(d))
+	var xs []int
+	for _, s := range d.shapes {
+		if s.kind == circleShape {
+			xs = append(xs, s.x)
+		}
+	}
+	assert.Equal(t, []int{58, 58, 58, 58}, xs)
+}
+
+func TestDrawStaff_Seconds(t *testing.T) {
+	var xs []int
+	for _, s := range drawStaff(OfChord(chord.Of("Cadd9"))).shapes {
+		if s.kind == circleShape {
+			xs = append(xs, s.x)
+		}
+	}
+	assert.Equal(t, []int{58, 58, 58, 58}, xs) // D5 is a fifth above G4
+	xs = nil
+	for _, s := range drawStaff(Tones{Root: note.C, Classes: []note.Class{note.C, note.D, note.E}, Together: true}).shapes {
+		if s.kind == circleShape {
+			xs = append(xs, s.x)
+		}
+	}
+	assert.Equal(t, []int{58, 69, 58}, xs)
+}
+
+func TestDrawStaff_Scale(t *testing.T) {
+	d := drawStaff(OfScale(scale.Of("D major")))
+	assert.Equal(t, 40+7*36+10, d.Width)
+	assert.Equal(t, 7, countShapes(d, circleShape, ""))
+	assert.Equal(t, []string{"𝄞", "#", "#"}, textsOf(d))
+}
+
+func TestStaffStepOf(t *testing.T) {
+	assert.Equal(t, staffBottom, staffStepOf(pitch{note.E, 4}, note.Sharp))
+	assert.Equal(t, staffBottom, staffStepOf(pitch{note.Ds, 4}, note.Flat))
+	assert.Equal(t, staffBottom-1, staffStepOf(pitch{note.Ds, 4}, note.Sharp))
+	assert.Equal(t, staffTop, staffStepOf(pitch{note.F, 5}, note.Sharp))
+}
+
+func TestLetterOf(t *testing.T) {
+	letter, alter := letterOf(note.As, note.Flat)
+	assert.Equal(t, 6, letter)
+	assert.Equal(t, -1, alter)
+	letter, alter = letterOf(note.As, note.Sharp)
+	assert.Equal(t, 5, letter)
+	assert.Equal(t, 1, alter)
+	letter, alter = letterOf(note.G, note.Flat)
+	assert.Equal(t, 4, letter)
+	assert.Equal(t, 0, alter)
+}
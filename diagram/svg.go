@@ -0,0 +1,44 @@
+// A diagram is written as SVG, e.g. to embed in a web page or open in a browser
+package diagram
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteSVG of the diagram
+func (d Diagram) WriteSVG(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", d.Width, d.Height, d.Width, d.Height)
+	for _, s := range d.shapes {
+		switch s.kind {
+		case rectShape:
+			fmt.Fprintf(&b, "  <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\" stroke=\"%s\"/>\n", s.x, s.y, s.width, s.height, svgColorOf(s.fill), svgColorOf(s.stroke))
+		case circleShape:
+			fmt.Fprintf(&b, "  <circle cx=\"%d\" cy=\"%d\" r=\"%d\" fill=\"%s\" stroke=\"%s\"/>\n", s.x, s.y, s.width, svgColorOf(s.fill), svgColorOf(s.stroke))
+		case lineShape:
+			fmt.Fprintf(&b, "  <line x1=\"%d\" y1=\"%d\" x2=\"%d\" y2=\"%d\" stroke=\"%s\" stroke-width=\"%d\"/>\n", s.x, s.y, s.x2, s.y2, svgColorOf(s.stroke), s.width)
+		case textShape:
+			fmt.Fprintf(&b, "  <text x=\"%d\" y=\"%d\" font-family=\"sans-serif\" font-size=\"%d\" text-anchor=\"middle\" fill=\"%s\">", s.x, s.y, s.height, svgColorOf(s.fill))
+			xml.EscapeText(&b, []byte(s.text))
+			b.WriteString("</text>\n")
+		}
+	}
+	b.WriteString("</svg>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+//
+// Private
+//
+
+// svgColorOf a color, or none if it's empty
+func svgColorOf(color string) string {
+	if color == "" {
+		return "none"
+	}
+	return color
+}
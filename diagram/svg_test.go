@@ -0,0 +1,28 @@
+// A diagram is written as SVG, e.g. to embed in a web page or open in a browser
+package diagram
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestDiagram_WriteSVG(t *testing.T) {
+	d := Diagram{Width: 20, Height: 10}
+	d.rect(0, 0, 20, 10, "#ffffff", "")
+	d.circle(5, 5, 4, RootFill, "#000000")
+	d.line(0, 5, 20, 5, 1, "#000000")
+	d.text(10, 8, 9, "#000000", "A<B")
+	var out bytes.Buffer
+	assert.Nil(t, d.WriteSVG(&out))
+	assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" width="20" height="10" viewBox="0 0 20 10">
+  <rect x="0" y="0" width="20" height="10" fill="#ffffff" stroke="none"/>
+  <circle cx="5" cy="5" r="4" fill="#d9534f" stroke="#000000"/>
+  <line x1="0" y1="5" x2="20" y2="5" stroke="#000000" stroke-width="1"/>
+  <text x="10" y="8" font-family="sans-serif" font-size="9" text-anchor="middle" fill="#000000">A&lt;B</text>
+</svg>
+`, out.String())
+	assert.Nil(t, xml.Unmarshal(out.Bytes(), new(interface{})))
+}
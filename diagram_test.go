@@ -0,0 +1,55 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/diagram"
+	"github.com/go-music-theory/music-theory/fretboard"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestWriteDiagram(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, writeDiagram(&out, "keyboard", "guitar", diagram.OfChord(chord.Of("Cm7")), ""))
+	assert.True(t, strings.HasPrefix(out.String(), "<svg "))
+	err := writeDiagram(&out, "banjo", "guitar", diagram.OfChord(chord.Of("Cm7")), "")
+	assert.True(t, errors.Is(err, diagram.ErrUnknownKind))
+}
+
+func TestWriteDiagram_Instrument(t *testing.T) {
+	var guitar, ukulele bytes.Buffer
+	assert.Nil(t, writeDiagram(&guitar, "fretboard", "guitar", diagram.OfChord(chord.Of("C")), ""))
+	assert.Nil(t, writeDiagram(&ukulele, "fretboard", " Ukulele", diagram.OfChord(chord.Of("C")), ""))
+	assert.NotEqual(t, guitar.String(), ukulele.String())
+	err := writeDiagram(&ukulele, "fretboard", "sitar", diagram.OfChord(chord.Of("C")), "")
+	assert.True(t, errors.Is(err, fretboard.ErrUnknownInstrument))
+}
+
+func TestWriteDiagram_Files(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diagram")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	svg, img := filepath.Join(dir, "cm7.svg"), filepath.Join(dir, "dorian.PNG")
+	assert.Nil(t, writeDiagram(nil, "staff", "guitar", diagram.OfChord(chord.Of("Cm7")), svg))
+	data, err := ioutil.ReadFile(svg)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "<svg "))
+	assert.Nil(t, writeDiagram(nil, "fretboard", "guitar", diagram.OfScale(scale.Of("D dorian")), img))
+	f, err := os.Open(img)
+	assert.Nil(t, err)
+	defer f.Close()
+	_, err = png.Decode(f)
+	assert.Nil(t, err)
+	assert.NotNil(t, writeDiagram(nil, "staff", "guitar", diagram.OfChord(chord.Of("C")), filepath.Join(dir, "missing", "c.svg")))
+}
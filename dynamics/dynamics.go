@@ -0,0 +1,114 @@
+// Dynamics are the loudness of music, marked from pianissimo (pp) to fortissimo (ff), each played at a MIDI velocity and sounded at a gain,
+// for a whole phrase, or growing from one to another in a crescendo or a diminuendo, so generated music doesn't sound flat.
+//
+// https://en.wikipedia.org/wiki/Dynamics_(music)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package dynamics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// ErrUnknownLevel when naming a dynamic that isn't marked from pp to ff, e.g. "fff"
+var ErrUnknownLevel = errors.New("unknown dynamic")
+
+// Level of dynamics, from pianissimo to fortissimo
+type Level int
+
+// Levels of dynamics, or Nil of none marked, played at mezzo-forte
+const (
+	Nil Level = iota
+	PP        // pianissimo, very soft
+	P         // piano, soft
+	MP        // mezzo-piano, moderately soft
+	MF        // mezzo-forte, moderately loud
+	F         // forte, loud
+	FF        // fortissimo, very loud
+)
+
+// LevelNames of the Levels, as marked in a score, from PP in order
+var LevelNames = []string{"pp", "p", "mp", "mf", "f", "ff"}
+
+// velocities of the Levels in MIDI, evenly spaced from PP to FF, so that MF is the midi.DefaultVelocity
+var velocities = []int{33, 49, 64, midi.DefaultVelocity, 96, 112}
+
+// LevelNamed one of the LevelNames, e.g. F of "f", or Nil of empty
+func LevelNamed(name string) (Level, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) == 0 {
+		return Nil, nil
+	}
+	for n, levelName := range LevelNames {
+		if name == levelName {
+			return Level(n + 1), nil
+		}
+	}
+	return Nil, fmt.Errorf("%w %q, expected one of %s", ErrUnknownLevel, name, strings.Join(LevelNames, ", "))
+}
+
+// Of a MIDI velocity, the nearest Level, e.g. F of 100, or Nil of 0
+func Of(velocity int) Level {
+	if velocity <= 0 {
+		return Nil
+	}
+	nearest := PP
+	for n, v := range velocities {
+		if abs(velocity-v) < abs(velocity-nearest.Velocity()) {
+			nearest = Level(n + 1)
+		}
+	}
+	return nearest
+}
+
+// String of the Level, as marked in a score, e.g. "mf", or empty of Nil
+func (l Level) String() string {
+	if l < PP || l > FF {
+		return ""
+	}
+	return LevelNames[l-1]
+}
+
+// Velocity of the Level in MIDI, from 33 of PP to 112 of FF, or the midi.DefaultVelocity of Nil
+func (l Level) Velocity() int {
+	if l < PP || l > FF {
+		return midi.DefaultVelocity
+	}
+	return velocities[l-1]
+}
+
+// Gain of the Level, the amplitude it sounds at from 0 to 1, e.g. of Sonic Pi or SuperCollider, by GainOf its Velocity
+func (l Level) Gain() float64 {
+	return GainOf(l.Velocity())
+}
+
+// GainOf a MIDI velocity, the amplitude it sounds at from 0 to 1, by the square of the velocity, as most synthesizers curve it, e.g. 0.4 of 80
+func GainOf(velocity int) float64 {
+	v := math.Max(0, math.Min(127, float64(velocity))) / 127
+	return v * v
+}
+
+//
+// Private
+//
+
+// abs of a number
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,56 @@
+// Dynamics are the loudness of music, marked from pianissimo (pp) to fortissimo (ff), each played at a MIDI velocity and sounded at a gain,
+// for a whole phrase, or growing from one to another in a crescendo or a diminuendo, so generated music doesn't sound flat.
+package dynamics
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestLevelNamed(t *testing.T) {
+	l, err := LevelNamed(" MP")
+	assert.Nil(t, err)
+	assert.Equal(t, MP, l)
+	l, err = LevelNamed("")
+	assert.Nil(t, err)
+	assert.Equal(t, Nil, l)
+	_, err = LevelNamed("fff")
+	assert.True(t, errors.Is(err, ErrUnknownLevel))
+	assert.Equal(t, `unknown dynamic "fff", expected one of pp, p, mp, mf, f, ff`, err.Error())
+}
+
+func TestOf(t *testing.T) {
+	assert.Equal(t, F, Of(100))
+	assert.Equal(t, PP, Of(1))
+	assert.Equal(t, FF, Of(127))
+	assert.Equal(t, MF, Of(midi.DefaultVelocity))
+	assert.Equal(t, Nil, Of(0))
+}
+
+func TestLevel_String(t *testing.T) {
+	assert.Equal(t, "pp", PP.String())
+	assert.Equal(t, "ff", FF.String())
+	assert.Equal(t, "", Nil.String())
+}
+
+func TestLevel_Velocity(t *testing.T) {
+	assert.Equal(t, 33, PP.Velocity())
+	assert.Equal(t, midi.DefaultVelocity, MF.Velocity())
+	assert.Equal(t, 112, FF.Velocity())
+	assert.Equal(t, midi.DefaultVelocity, Nil.Velocity())
+	for l := PP; l < FF; l++ {
+		assert.True(t, l.Velocity() < (l+1).Velocity())
+	}
+}
+
+func TestLevel_Gain(t *testing.T) {
+	assert.InDelta(t, 0.397, MF.Gain(), 0.001)
+	assert.InDelta(t, 0.068, PP.Gain(), 0.001)
+	assert.Equal(t, 1.0, GainOf(127))
+	assert.Equal(t, 1.0, GainOf(200))
+	assert.Equal(t, 0.0, GainOf(0))
+}
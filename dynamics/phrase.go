@@ -0,0 +1,112 @@
+// A phrase is played at one dynamic, e.g. "mf", or grows from one to another, a crescendo, e.g. "p..f", or a diminuendo, e.g. "ff..pp"
+package dynamics
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// Phrase of dynamics, from one Level at its beginning to another at its end, the same for a whole phrase at one Level, or Nil for none
+type Phrase struct {
+	From Level
+	To   Level
+}
+
+// At one Level for a whole phrase, e.g. At(P)
+func At(l Level) Phrase {
+	return Phrase{From: l, To: l}
+}
+
+// ParsePhrase of a level, e.g. "mf", or of two levels from the beginning to the end, e.g. "p..f", or none of empty
+func ParsePhrase(text string) (Phrase, error) {
+	from, to := text, text
+	if i := strings.Index(text, ".."); i >= 0 {
+		from, to = text[:i], text[i+2:]
+	}
+	var p Phrase
+	var err error
+	if p.From, err = LevelNamed(from); err != nil {
+		return Phrase{}, err
+	}
+	if p.To, err = LevelNamed(to); err != nil {
+		return Phrase{}, err
+	}
+	if (p.From == Nil) != (p.To == Nil) {
+		return Phrase{}, fmt.Errorf("%w %q, expected one of %s, or two apart by ..", ErrUnknownLevel, text, strings.Join(LevelNames, ", "))
+	}
+	return p, nil
+}
+
+// IsNil whether the phrase has no dynamics, so its notes are played as they are
+func (p Phrase) IsNil() bool {
+	return p.From == Nil && p.To == Nil
+}
+
+// String of the phrase, e.g. "mf" or "p..f", or empty of none
+func (p Phrase) String() string {
+	if p.From == p.To {
+		return p.From.String()
+	}
+	return p.From.String() + ".." + p.To.String()
+}
+
+// VelocityAt a position in the phrase, from 0 at its beginning to 1 at its end, between the velocities of its levels
+func (p Phrase) VelocityAt(position float64) int {
+	from, to := p.From.Velocity(), p.To.Velocity()
+	position = clamp(position, 0, 1)
+	return from + int(math.Round(float64(to-from)*position))
+}
+
+// GainAt a position in the phrase, from 0 at its beginning to 1 at its end, by GainOf the VelocityAt it
+func (p Phrase) GainAt(position float64) float64 {
+	return GainOf(p.VelocityAt(position))
+}
+
+// Apply the phrase to notes, without modifying them, scaling the velocity of each by the phrase where it begins, from the first note to begin to the last,
+// so that any accent of a note, louder or softer than the midi.DefaultVelocity, is kept, e.g. a crescendo of a bass line with its downbeats accented
+func (p Phrase) Apply(notes []midi.Note) []midi.Note {
+	applied := make([]midi.Note, len(notes))
+	copy(applied, notes)
+	if p.IsNil() || len(notes) == 0 {
+		return applied
+	}
+	first, last := notes[0].Start, notes[0].Start
+	for _, n := range notes {
+		if n.Start < first {
+			first = n.Start
+		}
+		if n.Start > last {
+			last = n.Start
+		}
+	}
+	for i, n := range applied {
+		position := 0.0
+		if last > first {
+			position = float64(n.Start-first) / float64(last-first)
+		}
+		velocity := n.Velocity
+		if velocity == 0 {
+			velocity = midi.DefaultVelocity
+		}
+		applied[i].Velocity = int(clamp(math.Round(float64(velocity*p.VelocityAt(position))/midi.DefaultVelocity), 1, 127))
+	}
+	return applied
+}
+
+//
+// Private
+//
+
+// clamp a value from a minimum to a maximum
+func clamp(value, min, max float64) float64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
@@ -0,0 +1,59 @@
+// A phrase is played at one dynamic, e.g. "mf", or grows from one to another, a crescendo, e.g. "p..f", or a diminuendo, e.g. "ff..pp"
+package dynamics
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestParsePhrase(t *testing.T) {
+	p, err := ParsePhrase("mf")
+	assert.Nil(t, err)
+	assert.Equal(t, At(MF), p)
+	p, err = ParsePhrase("p..f")
+	assert.Nil(t, err)
+	assert.Equal(t, Phrase{From: P, To: F}, p)
+	p, err = ParsePhrase("")
+	assert.Nil(t, err)
+	assert.True(t, p.IsNil())
+	_, err = ParsePhrase("p..loud")
+	assert.True(t, errors.Is(err, ErrUnknownLevel))
+	_, err = ParsePhrase("p..")
+	assert.True(t, errors.Is(err, ErrUnknownLevel))
+	assert.Equal(t, `unknown dynamic "p..", expected one of pp, p, mp, mf, f, ff, or two apart by ..`, err.Error())
+}
+
+func TestPhrase_String(t *testing.T) {
+	assert.Equal(t, "mf", At(MF).String())
+	assert.Equal(t, "ff..pp", Phrase{From: FF, To: PP}.String())
+	assert.Equal(t, "", Phrase{}.String())
+}
+
+func TestPhrase_VelocityAt(t *testing.T) {
+	p := Phrase{From: P, To: F}
+	assert.Equal(t, 49, p.VelocityAt(0))
+	assert.Equal(t, 73, p.VelocityAt(0.5))
+	assert.Equal(t, 96, p.VelocityAt(1))
+	assert.Equal(t, 96, p.VelocityAt(2))
+	assert.Equal(t, GainOf(73), p.GainAt(0.5))
+	assert.Equal(t, 49, Phrase{From: F, To: P}.VelocityAt(1))
+}
+
+func TestPhrase_Apply(t *testing.T) {
+	notes := []midi.Note{
+		{Number: 60, Start: 0},
+		{Number: 64, Start: 480, Velocity: 112},
+		{Number: 67, Start: 960},
+	}
+	applied := Phrase{From: PP, To: FF}.Apply(notes)
+	assert.Equal(t, []int{33, 102, 112}, []int{applied[0].Velocity, applied[1].Velocity, applied[2].Velocity})
+	assert.Equal(t, 0, notes[0].Velocity, "without modifying the notes")
+	applied = At(FF).Apply(notes)
+	assert.Equal(t, 127, applied[1].Velocity, "louder than the loudest")
+	assert.Equal(t, notes, Phrase{}.Apply(notes))
+	assert.Equal(t, []midi.Note{}, At(P).Apply(nil))
+}
@@ -0,0 +1,33 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/etude"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/render"
+)
+
+// etudeFormats to write an etude in, for printing
+var etudeFormats = []string{render.ABC, render.MusicXML, render.LilyPond}
+
+// writeEtude in a key named, at a level of difficulty, of some bars, randomized by a seed, in a format, one of the etudeFormats
+func writeEtude(w io.Writer, keyName string, level int, bars int, seed int64, format string) error {
+	if err := atLeastOne("bars", bars); err != nil {
+		return err
+	}
+	k, err := key.Parse(keyName)
+	if err != nil {
+		return err
+	}
+	l, err := etude.LevelOf(level)
+	if err != nil {
+		return err
+	}
+	tune := etude.Generate(k, l, seed, etude.WithBars(bars))
+	tune.Title = fmt.Sprintf("Etude in %s %s, level %d", k.Root.String(k.AdjSymbol), strings.ToLower(k.Mode.String()), l)
+	return render.To(w, format, tune)
+}
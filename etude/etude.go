@@ -0,0 +1,147 @@
+// An etude for sight-reading is a random melody in a key, graded by a level of difficulty, each harder level wider in range, with bigger leaps and more complex rhythms,
+// stepwise more often than not, beginning on the tonic and ending on it by step, held for the whole last bar.
+//
+// https://en.wikipedia.org/wiki/Sight-reading
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package etude
+
+import (
+	"math/rand"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Generate an etude in a key, at a level of difficulty, randomized by a seed, so the same seed always generates the same etude, with any options,
+// e.g. Generate(key.Of("G"), 2, 42), of 8 bars of 4/4, from the tonic in the 4th octave
+func Generate(k key.Key, l Level, seed int64, opts ...Option) melody.Tune {
+	return GenerateFrom(k, l, rand.NewSource(seed), opts...)
+}
+
+// GenerateFrom a source of random numbers an etude in a key, at a level of difficulty, with any options, the same etude of a source of the same numbers,
+// e.g. of a source shared by the rest of a composition, GenerateFrom(key.Of("G"), 2, src)
+func GenerateFrom(k key.Key, l Level, src rand.Source, opts ...Option) melody.Tune {
+	o := optionsOf(opts)
+	t := melody.Tune{Key: k, Meter: meter.Common}
+	steps := stepsOf(k, o.octave)
+	if len(steps) == 0 || o.bars < 1 {
+		return t
+	}
+	r := rand.New(src)
+	bar := float64(t.Meter.Beats)
+	var lengths []float64
+	for b := 0; b < o.bars-1; b++ {
+		lengths = append(lengths, rhythmOf(r, l.Rhythms(), bar)...)
+	}
+	lengths = append(lengths, bar)
+	beat := 0.0
+	for n, degree := range degreesOf(r, l, len(lengths)) {
+		octaves := degree / len(steps)
+		if degree < 0 && degree%len(steps) != 0 {
+			octaves--
+		}
+		step := steps[degree-octaves*len(steps)] + 12*octaves
+		class, octave := note.C.Step(step)
+		t.Notes = append(t.Notes, melody.Note{Class: class, Octave: note.Octave(octave - 1), Beat: beat, Beats: lengths[n]})
+		beat += lengths[n]
+	}
+	return t
+}
+
+//
+// Private
+//
+
+// stepsOf each tone of the scale of a key, in semitones from C-1, ascending from its tonic in an octave, e.g. 67 69 71 72 74 76 78 of G major from the 4th
+func stepsOf(k key.Key, octave note.Octave) []int {
+	tonic := (int(octave)+1)*12 + int(k.Root) - 1
+	var steps []int
+	for _, tone := range k.Scale().OrderedTones() {
+		if tone.Class == note.Nil {
+			continue
+		}
+		steps = append(steps, tonic+((int(tone.Class)-int(k.Root))%12+12)%12)
+	}
+	return steps
+}
+
+// rhythmOf a bar of some beats, random rhythms of a level, each fitting in the beats left, filling the bar
+func rhythmOf(r *rand.Rand, rhythms [][]float64, beats float64) []float64 {
+	var lengths []float64
+	for left := beats; left > 0; {
+		var fit [][]float64
+		for _, rhythm := range rhythms {
+			if sum(rhythm) <= left {
+				fit = append(fit, rhythm)
+			}
+		}
+		if len(fit) == 0 {
+			return append(lengths, left)
+		}
+		rhythm := fit[r.Intn(len(fit))]
+		lengths = append(lengths, rhythm...)
+		left -= sum(rhythm)
+	}
+	return lengths
+}
+
+// degreesOf some notes of an etude at a level, each a degree of the scale from 0 of the tonic, random within the range of the level,
+// moving from the one before it by a step more often than a leap of up to the widest of the level, always within reach of the tonic to end on,
+// from the tonic, to the 2nd degree or the 7th below, the leading tone, then the tonic
+func degreesOf(r *rand.Rand, l Level, count int) []int {
+	lowest, highest := l.Range()
+	leap := l.Leap()
+	degrees := make([]int, count)
+	for n := 1; n < count-1; n++ {
+		var steps, leaps []int
+		for d := lowest; d <= highest; d++ {
+			interval := abs(d - degrees[n-1])
+			left := count - 2 - n
+			switch {
+			case interval == 0 || interval > leap:
+			case left == 0 && abs(d) != 1:
+			case abs(d) > 1+leap*left:
+			case interval == 1:
+				steps = append(steps, d)
+			default:
+				leaps = append(leaps, d)
+			}
+		}
+		switch {
+		case len(steps) > 0 && (len(leaps) == 0 || r.Intn(2) == 0):
+			degrees[n] = steps[r.Intn(len(steps))]
+		case len(leaps) > 0:
+			degrees[n] = leaps[r.Intn(len(leaps))]
+		default:
+			degrees[n] = degrees[n-1]
+		}
+	}
+	return degrees
+}
+
+// sum of some lengths, in beats
+func sum(lengths []float64) (total float64) {
+	for _, length := range lengths {
+		total += length
+	}
+	return
+}
+
+// abs of a number of degrees
+func abs(degrees int) int {
+	if degrees < 0 {
+		return -degrees
+	}
+	return degrees
+}
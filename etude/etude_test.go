@@ -0,0 +1,122 @@
+// An etude for sight-reading is a random melody in a key, graded by a level of difficulty, each harder level wider in range, with bigger leaps and more complex rhythms,
+// stepwise more often than not, beginning on the tonic and ending on it by step, held for the whole last bar.
+package etude
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/internal/golden"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestGenerate(t *testing.T) {
+	tune := Generate(key.Of("G"), 1, 42)
+	assert.Equal(t, key.Of("G"), tune.Key)
+	assert.Equal(t, meter.Common, tune.Meter)
+	assert.Equal(t, tune, Generate(key.Of("G"), 1, 42), "the same of the same seed")
+	assert.NotEqual(t, tune, Generate(key.Of("G"), 1, 43))
+	first, last := tune.Notes[0], tune.Notes[len(tune.Notes)-1]
+	assert.Equal(t, melody.Note{Class: note.G, Octave: 4, Beat: 0, Beats: first.Beats}, first)
+	assert.Equal(t, melody.Note{Class: note.G, Octave: 4, Beat: 28, Beats: 4}, last, "the tonic held for the whole last bar")
+}
+
+func TestGenerate_Golden(t *testing.T) {
+	var out strings.Builder
+	for l := Level(1); l <= MaxLevel; l++ {
+		for _, k := range []key.Key{key.Of("G"), key.Of("Eb minor")} {
+			fmt.Fprintf(&out, "%d %s %s\n", l, k.Canonical(), tuneString(Generate(k, l, 42)))
+		}
+	}
+	golden.Assert(t, "generate", out.String())
+}
+
+func TestGenerateFrom(t *testing.T) {
+	assert.Equal(t, Generate(key.Of("F"), 3, 9, WithBars(4)), GenerateFrom(key.Of("F"), 3, rand.NewSource(9), WithBars(4)))
+	src := rand.NewSource(9)
+	first := GenerateFrom(key.Of("F"), 3, src, WithBars(4))
+	assert.NotEqual(t, first, GenerateFrom(key.Of("F"), 3, src, WithBars(4)), "of the next random numbers of the source")
+}
+
+func TestGenerate_Levels(t *testing.T) {
+	k := key.Of("D minor")
+	steps := stepsOf(k, 4)
+	for l := Level(1); l <= MaxLevel; l++ {
+		lowest, highest := l.Range()
+		for seed := int64(0); seed < 50; seed++ {
+			tune := Generate(k, l, seed)
+			var degrees []int
+			for _, nt := range tune.Notes {
+				degree, ok := degreeOf(steps, nt.Step())
+				assert.True(t, ok, "in the key")
+				assert.True(t, degree >= lowest && degree <= highest, "level %d seed %d degree %d in range", l, seed, degree)
+				degrees = append(degrees, degree)
+			}
+			for n := 1; n < len(degrees); n++ {
+				assert.True(t, abs(degrees[n]-degrees[n-1]) <= l.Leap(), "level %d seed %d leap %d", l, seed, degrees[n]-degrees[n-1])
+			}
+			assert.Equal(t, 1, abs(degrees[len(degrees)-2]), "ending on the tonic by step, level %d seed %d", l, seed)
+			assertBars(t, tune, l)
+		}
+	}
+}
+
+func TestGenerate_Options(t *testing.T) {
+	tune := Generate(key.Of("C"), 3, 7, WithBars(2), WithOctave(3))
+	assert.Equal(t, melody.Note{Class: note.C, Octave: 3, Beat: 4, Beats: 4}, tune.Notes[len(tune.Notes)-1])
+	assert.Equal(t, []melody.Note{{Class: note.C, Octave: 4, Beat: 0, Beats: 4}}, Generate(key.Of("C"), 3, 7, WithBars(1)).Notes)
+	assert.Nil(t, Generate(key.Of("C"), 3, 7, WithBars(0)).Notes)
+}
+
+func TestStepsOf(t *testing.T) {
+	assert.Equal(t, []int{67, 69, 71, 72, 74, 76, 78}, stepsOf(key.Of("G"), 4))
+	assert.Equal(t, []int{57, 59, 60, 62, 64, 65, 67}, stepsOf(key.Of("A minor"), 3))
+}
+
+//
+// Private
+//
+
+// degreeOf a step in the steps of a scale from its tonic, in any octave
+func degreeOf(steps []int, step int) (int, bool) {
+	for octave := -2; octave <= 2; octave++ {
+		for n, s := range steps {
+			if s+12*octave == step {
+				return n + octave*len(steps), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// assertBars of a tune each filled by the rhythms of a level, every note within a bar
+func assertBars(t *testing.T, tune melody.Tune, l Level) {
+	lengths := map[float64]bool{}
+	for _, rhythm := range l.Rhythms() {
+		for _, length := range rhythm {
+			lengths[length] = true
+		}
+	}
+	for _, nt := range tune.Notes[:len(tune.Notes)-1] {
+		assert.True(t, lengths[nt.Beats], "a length %v of level %d", nt.Beats, l)
+		assert.Equal(t, int(nt.Beat/4), int((nt.Beat+nt.Beats-0.001)/4), "within a bar")
+	}
+	last := tune.Notes[len(tune.Notes)-1]
+	assert.Equal(t, 32.0, last.Beat+last.Beats)
+}
+
+// tuneString of the notes of a tune, each of its pitch, spelled in its key, and its beats, e.g. "G4:1 A4:0.5"
+func tuneString(tune melody.Tune) string {
+	names := make([]string, len(tune.Notes))
+	for n, nt := range tune.Notes {
+		names[n] = fmt.Sprintf("%s%d:%g", nt.Class.String(tune.Key.AdjSymbol), nt.Octave, nt.Beats)
+	}
+	return strings.Join(names, " ")
+}
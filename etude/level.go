@@ -0,0 +1,73 @@
+// Each level of an etude is harder than the one before, e.g. at level 1 in quarter, half and whole notes within the first 5 degrees, moving by steps and thirds,
+// up to level 5 in sixteenth notes and dotted rhythms, from the subdominant below the tonic to the dominant an octave above, leaping as much as an octave
+package etude
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxLevel of an etude, the hardest, counting from 1 of the easiest
+const MaxLevel = 5
+
+// ErrUnknownLevel when grading an etude at a level that isn't from 1 to the MaxLevel
+var ErrUnknownLevel = errors.New("unknown level")
+
+// Level of difficulty of an etude, from 1 of the easiest to the MaxLevel
+type Level int
+
+// LevelOf a number, from 1 to the MaxLevel, e.g. LevelOf(2)
+func LevelOf(n int) (Level, error) {
+	if n < 1 || n > MaxLevel {
+		return 1, fmt.Errorf("%w %d, expected from 1 to %d", ErrUnknownLevel, n, MaxLevel)
+	}
+	return Level(n), nil
+}
+
+// Range of the degrees of the scale an etude at the level is written within, counted from 0 of the tonic, below it if negative, e.g. 0 to 4 at level 1
+func (l Level) Range() (lowest int, highest int) {
+	spec := specOf(l)
+	return spec.lowest, spec.highest
+}
+
+// Leap of the level, the widest from one note to the next, in degrees of the scale, e.g. 2 of a third at level 1, or 7 of an octave at level 5
+func (l Level) Leap() int {
+	return specOf(l).leap
+}
+
+// Rhythms of the level, each of the lengths of one or more notes, in beats, filling whole beats, e.g. a half note [2], or an eighth note pair [0.5, 0.5]
+func (l Level) Rhythms() [][]float64 {
+	return specOf(l).rhythms
+}
+
+//
+// Private
+//
+
+// levelSpec of the range, widest leap and rhythms of a level
+type levelSpec struct {
+	lowest  int
+	highest int
+	leap    int
+	rhythms [][]float64
+}
+
+// levelSpecs from level 1 to the MaxLevel, each with the rhythms of the level before it, and more
+var levelSpecs = []levelSpec{
+	{0, 4, 2, [][]float64{{4}, {2}, {1}}},
+	{0, 7, 2, [][]float64{{4}, {2}, {1}, {3}}},
+	{-3, 7, 4, [][]float64{{4}, {2}, {1}, {3}, {0.5, 0.5}}},
+	{-3, 9, 5, [][]float64{{4}, {2}, {1}, {3}, {0.5, 0.5}, {1.5, 0.5}}},
+	{-4, 11, 7, [][]float64{{4}, {2}, {1}, {3}, {0.5, 0.5}, {1.5, 0.5}, {0.25, 0.25, 0.25, 0.25}, {0.75, 0.25}}},
+}
+
+// specOf a level, or of the nearest level from 1 to the MaxLevel
+func specOf(l Level) levelSpec {
+	switch {
+	case l < 1:
+		return levelSpecs[0]
+	case l > MaxLevel:
+		return levelSpecs[MaxLevel-1]
+	}
+	return levelSpecs[l-1]
+}
@@ -0,0 +1,44 @@
+// Each level of an etude is harder than the one before, e.g. at level 1 in quarter, half and whole notes within the first 5 degrees, moving by steps and thirds,
+// up to level 5 in sixteenth notes and dotted rhythms, from the subdominant below the tonic to the dominant an octave above, leaping as much as an octave
+package etude
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestLevelOf(t *testing.T) {
+	l, err := LevelOf(2)
+	assert.Nil(t, err)
+	assert.Equal(t, Level(2), l)
+	_, err = LevelOf(0)
+	assert.True(t, errors.Is(err, ErrUnknownLevel))
+	_, err = LevelOf(6)
+	assert.Equal(t, "unknown level 6, expected from 1 to 5", err.Error())
+}
+
+func TestLevel_Range(t *testing.T) {
+	lowest, highest := Level(1).Range()
+	assert.Equal(t, []int{0, 4}, []int{lowest, highest})
+	lowest, highest = Level(5).Range()
+	assert.Equal(t, []int{-4, 11}, []int{lowest, highest})
+}
+
+func TestLevel_Leap(t *testing.T) {
+	assert.Equal(t, 2, Level(1).Leap())
+	assert.Equal(t, 7, Level(MaxLevel).Leap())
+	assert.Equal(t, 7, Level(9).Leap(), "of the hardest above it")
+	assert.Equal(t, 2, Level(0).Leap(), "of the easiest below it")
+}
+
+func TestLevel_Rhythms(t *testing.T) {
+	assert.Equal(t, [][]float64{{4}, {2}, {1}}, Level(1).Rhythms())
+	for l := Level(2); l <= MaxLevel; l++ {
+		assert.Equal(t, (l - 1).Rhythms(), l.Rhythms()[:len((l-1).Rhythms())], "the rhythms of level %d before it", l-1)
+		for _, rhythm := range l.Rhythms() {
+			assert.Equal(t, float64(int(sum(rhythm))), sum(rhythm), "filling whole beats")
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Etudes are generated of 8 bars from the 4th octave, or with an Option, e.g. of 16 bars below middle C with Generate(k, 2, seed, WithBars(16), WithOctave(3))
+package etude
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Option for generating an etude
+type Option func(*options)
+
+// WithBars of 4/4 in an etude, e.g. WithBars(16)
+func WithBars(bars int) Option {
+	return func(o *options) {
+		o.bars = bars
+	}
+}
+
+// WithOctave of the tonic of an etude, e.g. WithOctave(3) to read in the bass clef
+func WithOctave(octave note.Octave) Option {
+	return func(o *options) {
+		o.octave = octave
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	bars   int
+	octave note.Octave
+}
+
+func optionsOf(opts []Option) options {
+	o := &options{bars: 8, octave: 4}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
@@ -0,0 +1,15 @@
+// Etudes are generated of 8 bars from the 4th octave, or with an Option, e.g. of 16 bars below middle C with Generate(k, 2, seed, WithBars(16), WithOctave(3))
+package etude
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestOptionsOf(t *testing.T) {
+	assert.Equal(t, options{bars: 8, octave: 4}, optionsOf(nil))
+	assert.Equal(t, options{bars: 16, octave: 3}, optionsOf([]Option{WithBars(16), WithOctave(note.Octave(3))}))
+}
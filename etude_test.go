@@ -0,0 +1,34 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/etude"
+)
+
+func TestWriteEtude(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, writeEtude(&out, "G", 2, 4, 42, "abc"))
+	assert.Equal(t, "X:1\nT:Etude in G major, level 2\nM:4/4\nL:1/8\nK:G\nG4 A2 c2 | A2 B2 c2 A2 | A8 | G8 |]\n", out.String())
+	out.Reset()
+	assert.Nil(t, writeEtude(&out, "D minor", 3, 8, 1, "musicxml"))
+	assert.True(t, strings.Contains(out.String(), "<score-partwise"))
+	err := writeEtude(&out, "G", 6, 8, 42, "abc")
+	assert.True(t, errors.Is(err, etude.ErrUnknownLevel))
+	assert.NotNil(t, writeEtude(&out, "H", 1, 8, 42, "abc"))
+}
+
+func TestEtudeExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "etude")
+	assertExitCode(t, 0, "", "etude", "--level", "5", "--bars", "16", "Eb")
+	assertExitCode(t, 0, "", "etude", "-l", "3", "--seed", "7", "-f", "lilypond", "A minor")
+	assertExitCode(t, 1, "Error occurred: unknown level 0, expected from 1 to 5\n", "etude", "--level", "0", "G")
+	assertExitCode(t, 1, "Error occurred: out of range bars 0, expected at least 1\n", "etude", "--bars", "0", "G")
+	assertExitCode(t, 1, "Error occurred: out of range bars -3, expected at least 1\n", "etude", "--bars", "-3", "G")
+}
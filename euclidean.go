@@ -0,0 +1,50 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/groove"
+	"github.com/go-music-theory/music-theory/rhythm"
+)
+
+// writeEuclidean rhythm of some pulses over some steps, both arguments, e.g. 3 8, rotated, played by an instrument named, one of the groove.InstrumentNames,
+// writing it as a grid with a bar between each beat of some steps, and as MIDI of some repetitions to a file at a path, if any
+func writeEuclidean(w io.Writer, args []string, rotation int, instrumentName string, stepsPerBeat int, bars int, midiPath string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected pulses and steps, e.g. 3 8, not %q", strings.Join(args, " "))
+	}
+	pulses, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("expected a number of pulses, e.g. 3, not %q", args[0])
+	}
+	steps, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("expected a number of steps, e.g. 8, not %q", args[1])
+	}
+	if err = atLeastOne("steps per beat", stepsPerBeat); err != nil {
+		return err
+	}
+	if err = atLeastOne("bars", bars); err != nil {
+		return err
+	}
+	s, err := rhythm.Euclidean(pulses, steps, rotation)
+	if err != nil {
+		return err
+	}
+	i, err := groove.InstrumentNamed(instrumentName)
+	if err != nil {
+		return err
+	}
+	p := s.Pattern(i, stepsPerBeat)
+	if len(midiPath) > 0 {
+		if err = writeTuneFile(midiPath, func(w io.Writer) error { return p.WriteMIDI(w, bars) }); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, p.String())
+	return err
+}
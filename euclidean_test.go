@@ -0,0 +1,54 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/groove"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/rhythm"
+)
+
+func TestWriteEuclidean(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, writeEuclidean(&out, []string{"3", "8"}, 0, "rim", 4, 4, ""))
+	assert.Equal(t, "rim  x..x|..x.\n", out.String())
+	out.Reset()
+	assert.Nil(t, writeEuclidean(&out, []string{"5", "16"}, 2, "closed hat", 4, 4, ""))
+	assert.Equal(t, "closed hat  .x..|x..x|..x.|..x.\n", out.String())
+	assert.NotNil(t, writeEuclidean(&out, []string{"3"}, 0, "rim", 4, 4, ""))
+	assert.NotNil(t, writeEuclidean(&out, []string{"three", "8"}, 0, "rim", 4, 4, ""))
+	assert.True(t, errors.Is(writeEuclidean(&out, []string{"9", "8"}, 0, "rim", 4, 4, ""), rhythm.ErrPulsesRange))
+	assert.True(t, errors.Is(writeEuclidean(&out, []string{"3", "8"}, 0, "cowbell", 4, 4, ""), groove.ErrUnknownInstrument))
+}
+
+func TestWriteEuclidean_MIDI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "euclidean")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tresillo.mid")
+	var out bytes.Buffer
+	assert.Nil(t, writeEuclidean(&out, []string{"3", "8"}, 0, "kick", 4, 2, path))
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+	notes, err := midi.Read(f)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, len(notes))
+	assert.NotNil(t, writeEuclidean(&out, []string{"3", "8"}, 0, "kick", 4, 2, filepath.Join(dir, "missing", "tresillo.mid")))
+}
+
+func TestEuclideanExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "euclidean")
+	assertExitCode(t, 0, "", "euclidean", "--rotation", "2", "--instrument", "kick", "5", "16")
+	assertExitCode(t, 1, "Error occurred: out of range pulses 9, expected 0 to 8\n", "euclidean", "9", "8")
+	assertExitCode(t, 1, "Error occurred: out of range steps per beat 0, expected at least 1\n", "euclidean", "--steps-per-beat", "0", "3", "8")
+	assertExitCode(t, 1, "Error occurred: out of range bars 0, expected at least 1\n", "euclidean", "--bars", "0", "3", "8")
+}
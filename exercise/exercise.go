@@ -0,0 +1,157 @@
+// An exercise practices a scale in a pattern, e.g. up and down it in thirds, or in broken arpeggios of its triads, in eighth notes, with a finger suggested for each note.
+//
+// https://en.wikipedia.org/wiki/Exercise_(music)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package exercise
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// NoteBeats of every note of an exercise, an eighth note of 4/4, but the last, held to the end of its bar
+const NoteBeats = 0.5
+
+// ErrUnknownPattern when naming a pattern that isn't built in, e.g. "sixths"
+var ErrUnknownPattern = errors.New("unknown pattern")
+
+// Pattern of an exercise
+type Pattern int
+
+// Patterns of exercises, each up the scale to the top of its octaves, then back down to the root
+const (
+	Straight  Pattern = iota // each tone of the scale, one after another
+	Thirds                   // each tone and then the tone a third above it, e.g. C E, D F, E G, or below it on the way down
+	Fourths                  // each tone and then the tone a fourth above it, e.g. C F, D G, E A, or below it on the way down
+	Arpeggios                // the triad of each tone broken up and back, e.g. C E G E, D F A F, or down and back on the way down
+)
+
+// PatternNames of the Patterns, in order
+var PatternNames = []string{"straight", "thirds", "fourths", "arpeggios"}
+
+// PatternNamed one of the PatternNames, e.g. PatternNamed("thirds")
+func PatternNamed(name string) (Pattern, error) {
+	for n, patternName := range PatternNames {
+		if strings.ToLower(strings.TrimSpace(name)) == patternName {
+			return Pattern(n), nil
+		}
+	}
+	return Straight, fmt.Errorf("%w %q, expected one of %s", ErrUnknownPattern, name, strings.Join(PatternNames, ", "))
+}
+
+// String of the Pattern, e.g. "thirds"
+func (of Pattern) String() string {
+	if of < 0 || int(of) >= len(PatternNames) {
+		return ""
+	}
+	return PatternNames[of]
+}
+
+// Generate an exercise of a scale in a pattern, with any options, e.g. Generate(scale.Of("G major"), Thirds, WithTempo(80)),
+// from the root in the 4th octave up to the top of 1 octave, then back down to end on the root, as a tune in 4/4 in the key of the scale,
+// each note with the finger of the right hand suggested to play it, crossing the thumb under to the next tone
+func Generate(s scale.Scale, p Pattern, opts ...Option) melody.Tune {
+	o := optionsOf(opts)
+	t := melody.Tune{Key: keyOf(s), Meter: meter.Common, Tempo: o.tempo}
+	steps := stepsOf(s, o.octave)
+	if len(steps) == 0 {
+		return t
+	}
+	top := len(steps) * o.octaves
+	degrees := degreesOf(p, top)
+	for n, degree := range degrees {
+		step := steps[degree%len(steps)] + 12*(degree/len(steps))
+		class, octaves := note.C.Step(step)
+		t.Notes = append(t.Notes, melody.Note{
+			Class:  class,
+			Octave: note.Octave(octaves - 1),
+			Beat:   float64(n) * NoteBeats,
+			Beats:  NoteBeats,
+			Finger: fingerOf(p, n, degrees, len(steps), top),
+		})
+	}
+	last := &t.Notes[len(t.Notes)-1]
+	bar := float64(t.Meter.Beats)
+	last.Beats = bar*float64(int(last.Beat/bar)+1) - last.Beat
+	return t
+}
+
+//
+// Private
+//
+
+// keyOf a scale, from its root, minor if it has a minor third and no major third, else major
+func keyOf(s scale.Scale) key.Key {
+	k := key.Key{Root: s.Root, AdjSymbol: s.AdjSymbol, Mode: key.Major}
+	for _, tone := range s.OrderedTones() {
+		switch tone.Name {
+		case "M3":
+			return k
+		case "m3":
+			k.Mode = key.Minor
+		}
+	}
+	return k
+}
+
+// stepsOf each tone of a scale, in semitones from C-1, ascending from its root in an octave, e.g. 60 62 64 65 67 69 71 of C major from the 4th
+func stepsOf(s scale.Scale, octave note.Octave) []int {
+	root := (int(octave)+1)*12 + int(s.Root) - 1
+	var steps []int
+	for _, tone := range s.OrderedTones() {
+		if tone.Class == note.Nil {
+			continue
+		}
+		steps = append(steps, root+((int(tone.Class)-int(s.Root))%12+12)%12)
+	}
+	return steps
+}
+
+// degreesOf a pattern, each of an exercise, counted from 0 of the root up to the top, then back down, ending on the root
+func degreesOf(p Pattern, top int) []int {
+	var degrees []int
+	switch p {
+	case Straight:
+		for d := 0; d <= top; d++ {
+			degrees = append(degrees, d)
+		}
+		for d := top - 1; d >= 0; d-- {
+			degrees = append(degrees, d)
+		}
+	case Thirds, Fourths:
+		interval := int(p) + 1
+		for d := 0; d+interval <= top; d++ {
+			degrees = append(degrees, d, d+interval)
+		}
+		for d := top; d-interval >= 0; d-- {
+			degrees = append(degrees, d, d-interval)
+		}
+	case Arpeggios:
+		for d := 0; d+4 <= top; d++ {
+			degrees = append(degrees, d, d+2, d+4, d+2)
+		}
+		for d := top; d-4 >= 0; d-- {
+			degrees = append(degrees, d, d-2, d-4, d-2)
+		}
+	}
+	if len(degrees) == 0 || degrees[len(degrees)-1] != 0 {
+		degrees = append(degrees, 0)
+	}
+	return degrees
+}
@@ -0,0 +1,116 @@
+// An exercise practices a scale in a pattern, e.g. up and down it in thirds, or in broken arpeggios of its triads, in eighth notes, with a finger suggested for each note.
+package exercise
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestPatternNamed(t *testing.T) {
+	p, err := PatternNamed(" Thirds ")
+	assert.Nil(t, err)
+	assert.Equal(t, Thirds, p)
+	assert.Equal(t, "arpeggios", Arpeggios.String())
+	assert.Equal(t, "", Pattern(9).String())
+	_, err = PatternNamed("sixths")
+	assert.True(t, errors.Is(err, ErrUnknownPattern))
+	assert.Contains(t, err.Error(), "straight, thirds, fourths, arpeggios")
+}
+
+func TestGenerate_Straight(t *testing.T) {
+	tune := Generate(scale.Of("G major"), Straight)
+	assert.Equal(t, key.Key{Root: note.G, AdjSymbol: note.Sharp, Mode: key.Major}, tune.Key)
+	assert.Equal(t, meter.Common, tune.Meter)
+	assert.Equal(t, []string{"G4", "A4", "B4", "C5", "D5", "E5", "F#5", "G5", "F#5", "E5", "D5", "C5", "B4", "A4", "G4"}, namesOf(tune.Notes))
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3, 4, 5, 4, 3, 2, 1, 3, 2, 1}, fingersOf(tune.Notes))
+	assert.Equal(t, 0.5, tune.Notes[1].Beat)
+	assert.Equal(t, NoteBeats, tune.Notes[1].Beats)
+	last := tune.Notes[len(tune.Notes)-1]
+	assert.Equal(t, 7.0, last.Beat)
+	assert.Equal(t, 1.0, last.Beats, "held to the end of the bar")
+}
+
+func TestGenerate_Thirds(t *testing.T) {
+	tune := Generate(scale.Of("C major"), Thirds)
+	assert.Equal(t, []string{"C4", "E4", "D4", "F4", "E4", "G4", "F4", "A4", "G4", "B4", "A4", "C5",
+		"C5", "A4", "B4", "G4", "A4", "F4", "G4", "E4", "F4", "D4", "E4", "C4"}, namesOf(tune.Notes))
+	assert.Equal(t, []int{1, 3, 2, 1, 3, 2, 1, 3, 2, 4, 3, 5}, fingersOf(tune.Notes)[:12])
+	assert.Equal(t, 1, tune.Notes[len(tune.Notes)-1].Finger)
+}
+
+func TestGenerate_Fourths(t *testing.T) {
+	tune := Generate(scale.Of("C major"), Fourths)
+	assert.Equal(t, []string{"C4", "F4", "D4", "G4", "E4", "A4", "F4", "B4", "G4", "C5",
+		"C5", "G4", "B4", "F4", "A4", "E4", "G4", "D4", "F4", "C4"}, namesOf(tune.Notes))
+	last := tune.Notes[len(tune.Notes)-1]
+	assert.Equal(t, 9.5, last.Beat)
+	assert.Equal(t, 2.5, last.Beats)
+}
+
+func TestGenerate_Arpeggios(t *testing.T) {
+	tune := Generate(scale.Of("C major"), Arpeggios)
+	assert.Equal(t, []string{"C4", "E4", "G4", "E4", "D4", "F4", "A4", "F4", "E4", "G4", "B4", "G4", "F4", "A4", "C5", "A4",
+		"C5", "A4", "F4", "A4", "B4", "G4", "E4", "G4", "A4", "F4", "D4", "F4", "G4", "E4", "C4", "E4", "C4"}, namesOf(tune.Notes))
+	assert.Equal(t, []int{1, 3, 5, 3, 1, 3, 5, 3}, fingersOf(tune.Notes)[:8])
+	assert.Equal(t, []int{5, 3, 1, 3, 1}, fingersOf(tune.Notes)[28:])
+}
+
+func TestGenerate_Minor(t *testing.T) {
+	tune := Generate(scale.Of("A minor"), Straight, WithOctave(3))
+	assert.Equal(t, key.Minor, tune.Key.Mode)
+	assert.Equal(t, []string{"A3", "B3", "C4", "D4", "E4", "F4", "G4", "A4"}, namesOf(tune.Notes)[:8])
+}
+
+func TestGenerate_Octaves(t *testing.T) {
+	tune := Generate(scale.Of("C major"), Straight, WithOctaves(2), WithTempo(80))
+	assert.Equal(t, 80.0, tune.Tempo)
+	assert.Equal(t, 29, len(tune.Notes))
+	assert.Equal(t, "C6", namesOf(tune.Notes)[14])
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3, 4, 1, 2, 3, 1, 2, 3, 4, 5}, fingersOf(tune.Notes)[:15])
+}
+
+func TestGenerate_Empty(t *testing.T) {
+	tune := Generate(scale.Scale{}, Thirds)
+	assert.Equal(t, 0, len(tune.Notes))
+}
+
+func TestDegreesOf(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 2, 1, 0}, degreesOf(Straight, 2))
+	assert.Equal(t, []int{0, 2, 2, 0}, degreesOf(Thirds, 2))
+	assert.Equal(t, []int{0}, degreesOf(Fourths, 2), "too few tones for a fourth")
+	assert.Equal(t, []int{0, 2, 4, 2, 4, 2, 0, 2, 0}, degreesOf(Arpeggios, 4))
+}
+
+func TestStepsOf(t *testing.T) {
+	assert.Equal(t, []int{60, 62, 64, 65, 67, 69, 71}, stepsOf(scale.Of("C major"), 4))
+	assert.Equal(t, []int{55, 57, 59, 60, 62, 64, 66}, stepsOf(scale.Of("G major"), 3))
+}
+
+//
+// Private
+//
+
+func namesOf(notes []melody.Note) []string {
+	var names []string
+	for _, n := range notes {
+		names = append(names, n.Class.String(note.Sharp)+strconv.Itoa(int(n.Octave)))
+	}
+	return names
+}
+
+func fingersOf(notes []melody.Note) []int {
+	var fingers []int
+	for _, n := range notes {
+		fingers = append(fingers, n.Finger)
+	}
+	return fingers
+}
@@ -0,0 +1,47 @@
+// Fingerings are suggested for the right hand on a piano, from 1 of the thumb to 5 of the little finger, the thumb crossing under after the 3rd finger, then the 4th,
+// e.g. 1 2 3 1 2 3 4 5 up a major scale, or 1 3 5 3 of each broken triad up, and 5 3 1 3 down
+package exercise
+
+//
+// Private
+//
+
+// arpeggioFingers of each note of a broken triad, up and back, the thumb, middle and little fingers spanning a fifth, or of one down and back
+var (
+	arpeggioFingers     = []int{1, 3, 5, 3}
+	arpeggioFingersDown = []int{5, 3, 1, 3}
+)
+
+// fingerOf a note of an exercise in a pattern, of its degrees, in a scale of some tones, the little finger on the top,
+// else the finger of its tone, or of its place in a broken triad, up or down, ending with the thumb on the root
+func fingerOf(p Pattern, n int, degrees []int, tones int, top int) int {
+	switch {
+	case n == len(degrees)-1 && degrees[n] == 0:
+		return 1
+	case p == Arpeggios:
+		first := n - n%len(arpeggioFingers)
+		if degrees[first+1] < degrees[first] {
+			return arpeggioFingersDown[n%len(arpeggioFingersDown)]
+		}
+		return arpeggioFingers[n%len(arpeggioFingers)]
+	case degrees[n] == top && top > 0:
+		return 5
+	}
+	return scaleFingers(tones)[degrees[n]%tones]
+}
+
+// scaleFingers of each tone of a scale of some tones, in groups from the thumb, of 3 fingers and then 4, or all of 4 if they fit, e.g. 1 2 3 1 2 3 4 of 7 tones
+func scaleFingers(tones int) []int {
+	group := 3
+	if tones%4 == 0 {
+		group = 4
+	}
+	var fingers []int
+	for len(fingers) < tones {
+		for finger := 1; finger <= group && len(fingers) < tones; finger++ {
+			fingers = append(fingers, finger)
+		}
+		group = 4
+	}
+	return fingers
+}
@@ -0,0 +1,26 @@
+// Fingerings are suggested for the right hand on a piano, from 1 of the thumb to 5 of the little finger, the thumb crossing under after the 3rd finger, then the 4th,
+// e.g. 1 2 3 1 2 3 4 5 up a major scale, or 1 3 5 3 of each broken triad up, and 5 3 1 3 down
+package exercise
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestScaleFingers(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3, 4}, scaleFingers(7))
+	assert.Equal(t, []int{1, 2, 3, 1, 2}, scaleFingers(5))
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3}, scaleFingers(6))
+	assert.Equal(t, []int{1, 2, 3, 4, 1, 2, 3, 4}, scaleFingers(8))
+}
+
+func TestFingerOf(t *testing.T) {
+	degrees := degreesOf(Straight, 7)
+	assert.Equal(t, 1, fingerOf(Straight, 0, degrees, 7, 7))
+	assert.Equal(t, 5, fingerOf(Straight, 7, degrees, 7, 7), "the little finger on the top")
+	assert.Equal(t, 1, fingerOf(Straight, len(degrees)-1, degrees, 7, 7), "the thumb on the root at the end")
+	arpeggios := degreesOf(Arpeggios, 7)
+	assert.Equal(t, 5, fingerOf(Arpeggios, 2, arpeggios, 7, 7))
+	assert.Equal(t, 5, fingerOf(Arpeggios, 16, arpeggios, 7, 7), "down from the top")
+}
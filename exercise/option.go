@@ -0,0 +1,50 @@
+// Exercises are generated of 1 octave from the 4th, at the default tempo, or with an Option, e.g. of 2 octaves slowly with Generate(s, Thirds, WithOctaves(2), WithTempo(60))
+package exercise
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Option for generating an exercise
+type Option func(*options)
+
+// WithOctaves of the scale that an exercise goes up and back down, 1 if fewer
+func WithOctaves(octaves int) Option {
+	return func(o *options) {
+		if octaves > 0 {
+			o.octaves = octaves
+		}
+	}
+}
+
+// WithOctave from which an exercise begins on the root of its scale, e.g. WithOctave(3) to practice below middle C
+func WithOctave(octave note.Octave) Option {
+	return func(o *options) {
+		o.octave = octave
+	}
+}
+
+// WithTempo of an exercise, in beats per minute, e.g. WithTempo(80), or 0 of the default midi.Tempo
+func WithTempo(bpm float64) Option {
+	return func(o *options) {
+		o.tempo = bpm
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	octaves int
+	octave  note.Octave
+	tempo   float64
+}
+
+func optionsOf(opts []Option) options {
+	o := &options{octaves: 1, octave: 4}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
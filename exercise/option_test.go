@@ -0,0 +1,16 @@
+// Exercises are generated of 1 octave from the 4th, at the default tempo, or with an Option, e.g. of 2 octaves slowly with Generate(s, Thirds, WithOctaves(2), WithTempo(60))
+package exercise
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestOptionsOf(t *testing.T) {
+	assert.Equal(t, options{octaves: 1, octave: 4}, optionsOf(nil))
+	assert.Equal(t, options{octaves: 2, octave: 3, tempo: 60}, optionsOf([]Option{WithOctaves(2), WithOctave(note.Octave(3)), WithTempo(60)}))
+	assert.Equal(t, options{octaves: 1, octave: 4}, optionsOf([]Option{WithOctaves(0)}), "at least 1 octave")
+}
@@ -0,0 +1,67 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/song"
+)
+
+// readSongFile at a path, as MusicXML if it's named .musicxml or .xml, or compressed MusicXML if .mxl, a ChordPro chart if .cho, .chordpro, .chopro or .crd, or else as YAML
+func readSongFile(path string) (song.Song, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return song.Song{}, err
+	}
+	defer f.Close()
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".musicxml", ".xml":
+		return song.ReadMusicXML(f)
+	case ".mxl":
+		info, err := f.Stat()
+		if err != nil {
+			return song.Song{}, err
+		}
+		return song.ReadCompressedMusicXML(f, info.Size())
+	case ".cho", ".chordpro", ".chopro", ".crd":
+		return song.ReadChordPro(f)
+	}
+	return song.Load(f)
+}
+
+// exportSong from a file at a path, writing CSV of its chords, or its YAML, or if there's a directory of clips, a MIDI clip of each section to it,
+// named by its number and section, e.g. 1-verse.mid, and the path of each clip as it's written
+func exportSong(w io.Writer, path string, asYAML bool, clipsDir string) error {
+	s, err := readSongFile(path)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(clipsDir) == 0 && asYAML:
+		_, err = io.WriteString(w, s.ToYAML())
+		return err
+	case len(clipsDir) == 0:
+		return s.WriteCSV(w)
+	}
+	for n, clip := range s.Clips() {
+		clipPath := filepath.Join(clipsDir, fmt.Sprintf("%d-%s.mid", n+1, clipFileName(clip.Name)))
+		if err = writeTuneFile(clipPath, clip.WriteMIDI); err != nil {
+			return err
+		}
+		fmt.Fprintln(w, clipPath)
+	}
+	return nil
+}
+
+//
+// Private
+//
+
+// clipFileName of a section, lowercase with a dash for each space or separator, e.g. "pre-chorus" of "Pre Chorus"
+func clipFileName(section string) string {
+	return strings.NewReplacer(" ", "-", "/", "-", "\\", "-").Replace(strings.ToLower(strings.TrimSpace(section)))
+}
@@ -0,0 +1,106 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestExportSong(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := writeSongFile(t, dir)
+	var buf bytes.Buffer
+	assert.Nil(t, exportSong(&buf, path, false, ""))
+	assert.Equal(t, `bar,beat,chord,notes
+1,1,Am7,A C E G
+1,3,D7,D F# A C
+2,1,Gmaj7,G B D F#
+3,1,Cmaj7,C E G B
+`, buf.String())
+}
+
+func TestExportSong_Clips(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := writeSongFile(t, dir)
+	var buf bytes.Buffer
+	assert.Nil(t, exportSong(&buf, path, false, dir))
+	assert.Equal(t, filepath.Join(dir, "1-verse.mid")+"\n"+filepath.Join(dir, "2-pre-chorus.mid")+"\n", buf.String())
+	f, err := os.Open(filepath.Join(dir, "2-pre-chorus.mid"))
+	assert.Nil(t, err)
+	defer f.Close()
+	notes, err := midi.Read(f)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(notes))
+	assert.NotNil(t, exportSong(&buf, path, false, filepath.Join(dir, "missing")))
+	assert.NotNil(t, exportSong(&buf, filepath.Join(dir, "missing.yaml"), false, ""))
+}
+
+func TestExportSong_MusicXML(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "blues.musicxml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<score-partwise version="3.1"><work><work-title>Blues</work-title></work><part id="P1">
+<measure number="1"><attributes><divisions>1</divisions><key><fifths>-3</fifths></key><time><beats>4</beats><beat-type>4</beat-type></time></attributes>
+<harmony><root><root-step>B</root-step><root-alter>-1</root-alter></root><kind>dominant</kind></harmony>
+<note><pitch><step>B</step><alter>-1</alter><octave>4</octave></pitch><duration>4</duration></note></measure>
+<measure number="2"><harmony><root><root-step>E</root-step><root-alter>-1</root-alter></root><kind>dominant</kind></harmony>
+<note><rest/><duration>4</duration></note></measure>
+</part></score-partwise>
+`), 0644))
+	var buf bytes.Buffer
+	assert.Nil(t, exportSong(&buf, path, false, ""))
+	assert.Equal(t, "bar,beat,chord,notes\n1,1,Bb7,Bb D F Ab\n2,1,Eb7,Eb G Bb Db\n", buf.String())
+	buf.Reset()
+	assert.Nil(t, exportSong(&buf, path, true, ""))
+	assert.Equal(t, `title: Blues
+key: Eb major
+tempo: 120
+meter: 4/4
+sections:
+- name: score
+  bars: [Bb7, Eb7]
+`, buf.String())
+	assert.NotNil(t, exportSong(&buf, filepath.Join(dir, "blues.mxl"), false, ""))
+}
+
+func TestExportExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assertExitCode(t, 0, "", "export", writeSongFile(t, dir))
+	assertExitCode(t, 0, "", "export", "--clips", dir, writeSongFile(t, dir))
+	assertExitCode(t, 0, "", "export", "--yaml", writeSongFile(t, dir))
+	assertExitCode(t, 0, "", "export")
+	assertExitCode(t, 1, "Error occurred: open "+filepath.Join(dir, "missing.yaml")+": no such file or directory\n", "export", filepath.Join(dir, "missing.yaml"))
+}
+
+//
+// Private
+//
+
+func writeSongFile(t *testing.T, dir string) string {
+	path := filepath.Join(dir, "autumn-leaves.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`title: Autumn Leaves
+key: G major
+tempo: 132
+sections:
+- name: verse
+  bars: [Am7 D7, Gmaj7]
+- name: Pre Chorus
+  bars: [Cmaj7]
+`), 0644))
+	return path
+}
@@ -0,0 +1,258 @@
+// Fitting chords to a melody selects one of the candidate chords for each bar, satisfying constraints of the melody notes that are its chord tones,
+// the smooth motion of the bass from each chord to the next, and the cadence that ends them, by a beam search of the best solutions,
+// e.g. to compare the progressions that could accompany a melody.
+//
+// https://en.wikipedia.org/wiki/Beam_search
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package fit
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// DefaultBeatsPerBar of constraints that don't say, i.e. of 4/4
+const DefaultBeatsPerBar = 4.0
+
+// DefaultTopK solutions of constraints that don't say, at most, ranked from the best
+const DefaultTopK = 5
+
+// DefaultBeamWidth of constraints that don't say, the number of the best partial solutions kept after each bar
+const DefaultBeamWidth = 32
+
+// Constraints of fitting chords to a melody
+type Constraints struct {
+	BeatsPerBar   float64                   // of the melody, e.g. 3 of 3/4, or if 0, DefaultBeatsPerBar
+	MinMembership float64                   // of each bar, the share of the beats of its melody notes that are tones of its chord, from 0 to 1, e.g. 0.5
+	MaxBassLeap   int                       // from the bass of each chord to the next, in semitones up or down, from 1 to 6 of a tritone, or if 0, any
+	Cadences      []progression.CadenceKind // any of which the final two chords must be in the Key, or if none, or of a single bar, any chords
+	Key           key.Key                   // of the cadences
+	TopK          int                       // solutions, at most, or if 0, DefaultTopK
+	BeamWidth     int                       // partial solutions kept after each bar, or if 0, DefaultBeamWidth
+}
+
+// Solution of fitting chords to a melody, the chord of each bar, in order, and the score of them all, higher being better
+type Solution struct {
+	Chords []chord.Chord
+	Score  float64
+}
+
+// Chords fit to a melody, one of the candidate chords of each bar, ranking the top solutions that satisfy the constraints from the best,
+// each scored by the share of the melody notes of each bar that are its chord tones, less the motion of the bass, more so of a leap,
+// plus how well the final two chords fit a cadence of the constraints, or no solutions, if none satisfy them.
+// The beam keeps only the partial solutions that can still satisfy the constraints to the end, e.g. reach a cadence,
+// so it finds a solution if there is any, though a wider beam may find a better one
+func Chords(notes []melody.Note, candidates [][]chord.Chord, constraints Constraints) []Solution {
+	c := constraints.withDefaults()
+	if len(candidates) == 0 {
+		return nil
+	}
+	memberships := make([][]float64, len(candidates))
+	for bar, options := range candidates {
+		memberships[bar] = make([]float64, len(options))
+		for o, option := range options {
+			memberships[bar][o] = membershipOf(notes, float64(bar)*c.BeatsPerBar, c.BeatsPerBar, option)
+		}
+	}
+	viable, cadences := c.viableOf(candidates, memberships)
+	beam := []path{{}}
+	for bar, options := range candidates {
+		var next []path
+		for _, p := range beam {
+			for o, option := range options {
+				if !viable[bar][o] {
+					continue
+				}
+				score := p.score + memberships[bar][o]
+				if len(p.chords) > 0 {
+					from := p.chords[len(p.chords)-1]
+					if !c.leapFits(from, option) {
+						continue
+					}
+					score -= bassWeight * float64(bassLeapOf(from, option)) / tritone
+				}
+				if bar == len(candidates)-1 && c.cadenced(len(candidates)) {
+					cadence, ok := cadences[[2]int{p.last, o}]
+					if !ok {
+						continue
+					}
+					score += cadence
+				}
+				next = append(next, path{chords: append(append([]chord.Chord{}, p.chords...), option), last: o, score: score})
+			}
+		}
+		sort.SliceStable(next, func(i, j int) bool { return next[i].score > next[j].score })
+		if len(next) > c.BeamWidth {
+			next = next[:c.BeamWidth]
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		beam = next
+	}
+	var solutions []Solution
+	for _, p := range beam {
+		if len(solutions) == c.TopK {
+			break
+		}
+		solutions = append(solutions, Solution{Chords: p.chords, Score: p.score})
+	}
+	return solutions
+}
+
+// String of the chords of the solution, separated by bars, e.g. "C | F | G7 | C"
+func (s Solution) String() string {
+	var names []string
+	for _, c := range s.Chords {
+		names = append(names, c.Name())
+	}
+	return strings.Join(names, " | ")
+}
+
+// Progression of the chords of the solution, e.g. to find its key or cadence
+func (s Solution) Progression() progression.Progression {
+	return progression.Progression{Chords: s.Chords}
+}
+
+//
+// Private
+//
+
+// Scores of the heuristics, relative to a bar of melody notes that are all chord tones, which scores 1
+const (
+	bassWeight = 0.5 // of the bass moving a tritone, the farthest it can, less for each semitone closer
+	tritone    = 6.0 // semitones
+)
+
+// path of the beam search, the chord chosen for each bar so far, the index of the last among the candidates of its bar, and their score
+type path struct {
+	chords []chord.Chord
+	last   int
+	score  float64
+}
+
+// withDefaults of the constraints, for each that doesn't say
+func (c Constraints) withDefaults() Constraints {
+	if c.BeatsPerBar <= 0 {
+		c.BeatsPerBar = DefaultBeatsPerBar
+	}
+	if c.TopK <= 0 {
+		c.TopK = DefaultTopK
+	}
+	if c.BeamWidth <= 0 {
+		c.BeamWidth = DefaultBeamWidth
+	}
+	return c
+}
+
+// membershipOf a chord, of the melody notes sounding in some beats from a beat, the share of their beats in that span that are its tones, or 1 of none
+func membershipOf(notes []melody.Note, from, beats float64, c chord.Chord) float64 {
+	var sounding, member float64
+	for _, n := range notes {
+		overlap := math.Min(n.Beat+n.Beats, from+beats) - math.Max(n.Beat, from)
+		if overlap <= 0 || n.Class == note.Nil {
+			continue
+		}
+		sounding += overlap
+		if c.Contains(n.Class) {
+			member += overlap
+		}
+	}
+	if sounding == 0 {
+		return 1
+	}
+	return member / sounding
+}
+
+// bassLeapOf one chord to the next, the semitones up or down between their lowest tones, from 0 of the same to 6 of a tritone
+func bassLeapOf(from, to chord.Chord) int {
+	leap := from.Lowest().Diff(to.Lowest())
+	if leap < 0 {
+		return -leap
+	}
+	return leap
+}
+
+// cadenced whether the constraints require some bars to end in a cadence, of any cadences, and at least two bars, a cadence being of two chords
+func (c Constraints) cadenced(bars int) bool {
+	return len(c.Cadences) > 0 && bars > 1
+}
+
+// leapFits the constraints, whether the bass moves from one chord to the next by no more than the largest leap, if any
+func (c Constraints) leapFits(from, to chord.Chord) bool {
+	return c.MaxBassLeap <= 0 || bassLeapOf(from, to) <= c.MaxBassLeap
+}
+
+// viableOf the candidate chords of each bar, whether each satisfies the constraints and leads to one of the next bar that does, and so on to the end,
+// searching back from the final two bars, whose every pair that fits a cadence of the constraints is scored by it, of the index of each in its bar
+func (c Constraints) viableOf(candidates [][]chord.Chord, memberships [][]float64) ([][]bool, map[[2]int]float64) {
+	last := len(candidates) - 1
+	viable := make([][]bool, len(candidates))
+	for bar, options := range candidates {
+		viable[bar] = make([]bool, len(options))
+		for o, option := range options {
+			viable[bar][o] = option.Root != note.Nil && memberships[bar][o] >= c.MinMembership
+		}
+	}
+	cadences := map[[2]int]float64{}
+	before := last - 1
+	if c.cadenced(len(candidates)) {
+		begins, ends := make([]bool, len(candidates[last-1])), make([]bool, len(candidates[last]))
+		for from, a := range candidates[last-1] {
+			for to, b := range candidates[last] {
+				if !viable[last-1][from] || !viable[last][to] || !c.leapFits(a, b) {
+					continue
+				}
+				if score, ok := cadenceOf(a, b, c); ok {
+					cadences[[2]int{from, to}] = score
+					begins[from], ends[to] = true, true
+				}
+			}
+		}
+		viable[last-1], viable[last] = begins, ends
+		before--
+	}
+	for bar := before; bar >= 0; bar-- {
+		for from, a := range candidates[bar] {
+			if !viable[bar][from] {
+				continue
+			}
+			viable[bar][from] = false
+			for to, b := range candidates[bar+1] {
+				if viable[bar+1][to] && c.leapFits(a, b) {
+					viable[bar][from] = true
+					break
+				}
+			}
+		}
+	}
+	return viable, cadences
+}
+
+// cadenceOf the final two chords of a solution, how well they fit the best of the cadences of its constraints in the key, and whether they fit any
+func cadenceOf(from, to chord.Chord, c Constraints) (float64, bool) {
+	for _, fit := range (progression.Progression{Chords: []chord.Chord{from, to}}).CadencesIn(c.Key) {
+		for _, kind := range c.Cadences {
+			if fit.Value.Kind == kind {
+				return fit.Score, true
+			}
+		}
+	}
+	return 0, false
+}
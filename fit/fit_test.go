@@ -0,0 +1,110 @@
+// Fitting chords to a melody selects one of the candidate chords for each bar, satisfying constraints of the melody notes that are its chord tones,
+package fit
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestChords(t *testing.T) {
+	notes := melody.NotesOf("C4", "E4", "G4", "E4", "F4", "A4", "C5", "A4", "G4", "B4", "D5", "B4", "C4", "E4", "G4", "C5")
+	options := chordsOf("C", "F", "G", "Am")
+	solutions := Chords(notes, [][]chord.Chord{options, options, options, options}, Constraints{})
+	assert.Equal(t, DefaultTopK, len(solutions))
+	assert.Equal(t, "C | F | G | C", solutions[0].String())
+	assert.InDelta(t, 4-0.5*(5+2+5)/6.0, solutions[0].Score, 0.0001)
+	for n := 1; n < len(solutions); n++ {
+		assert.True(t, solutions[n-1].Score >= solutions[n].Score)
+	}
+}
+
+func TestChords_MinMembership(t *testing.T) {
+	notes := melody.NotesOf("C4", "D4", "E4", "F4")
+	assert.Equal(t, 2, len(Chords(notes, [][]chord.Chord{chordsOf("C", "Dm", "G")}, Constraints{MinMembership: 0.5})))
+	assert.Equal(t, "C", Chords(notes, [][]chord.Chord{chordsOf("C", "Dm", "G")}, Constraints{MinMembership: 0.5})[0].String())
+	assert.Nil(t, Chords(notes, [][]chord.Chord{chordsOf("C", "Dm", "G")}, Constraints{MinMembership: 0.75}))
+}
+
+func TestChords_MaxBassLeap(t *testing.T) {
+	notes := melody.NotesOf("C4", "E4", "G4", "E4", "C4", "F4", "A4", "F4")
+	candidates := [][]chord.Chord{chordsOf("C"), chordsOf("F", "F/C")}
+	assert.Equal(t, []string{"C | F/C", "C | F"}, solutionNamesOf(Chords(notes, candidates, Constraints{})))
+	solutions := Chords(notes, candidates, Constraints{MaxBassLeap: 2})
+	assert.Equal(t, 1, len(solutions))
+	assert.Equal(t, "C | F/C", solutions[0].String())
+}
+
+func TestChords_Cadences(t *testing.T) {
+	notes := melody.NotesOf("G4", "B4", "D5", "B4", "C5", "C5", "C5", "C5")
+	candidates := [][]chord.Chord{chordsOf("G", "G7"), chordsOf("C", "Am", "F")}
+	c := key.Of("C major")
+	assert.Equal(t, "G | C", Chords(notes, candidates, Constraints{Cadences: []progression.CadenceKind{progression.Authentic}, Key: c})[0].String())
+	solutions := Chords(notes, candidates, Constraints{Cadences: []progression.CadenceKind{progression.Deceptive}, Key: c})
+	assert.Equal(t, []string{"G | Am", "G7 | Am", "G | F", "G7 | F"}, solutionNamesOf(solutions))
+	assert.Nil(t, Chords(notes, candidates, Constraints{Cadences: []progression.CadenceKind{progression.Plagal}, Key: c}))
+}
+
+func TestChords_Cadences_OneBar(t *testing.T) {
+	notes := melody.NotesOf("C4", "E4", "G4", "E4")
+	solutions := Chords(notes, [][]chord.Chord{chordsOf("C", "Am", "F")}, Constraints{Cadences: []progression.CadenceKind{progression.Authentic}, Key: key.Of("C major")})
+	assert.Equal(t, []string{"C", "Am", "F"}, solutionNamesOf(solutions))
+}
+
+func TestChords_Cadences_Reachable(t *testing.T) {
+	notes := melody.NotesOf("C4", "E4", "G4", "E4", "C5", "C5", "C5", "C5")
+	candidates := [][]chord.Chord{chordsOf("C", "G"), chordsOf("C")}
+	solutions := Chords(notes, candidates, Constraints{Cadences: []progression.CadenceKind{progression.Authentic}, Key: key.Of("C major"), BeamWidth: 1})
+	assert.Equal(t, []string{"G | C"}, solutionNamesOf(solutions))
+	solutions = Chords(notes, [][]chord.Chord{chordsOf("C", "F"), chordsOf("G")}, Constraints{MaxBassLeap: 2, BeamWidth: 1})
+	assert.Equal(t, []string{"F | G"}, solutionNamesOf(solutions))
+}
+
+func TestChords_TopK(t *testing.T) {
+	options := chordsOf("C", "F", "G", "Am")
+	solutions := Chords(melody.NotesOf("C4", "E4", "G4", "C5"), [][]chord.Chord{options, options}, Constraints{TopK: 2, BeamWidth: 3})
+	assert.Equal(t, 2, len(solutions))
+	assert.Equal(t, 3, len(Chords(nil, [][]chord.Chord{options, options}, Constraints{TopK: 10, BeamWidth: 3})))
+}
+
+func TestChords_None(t *testing.T) {
+	assert.Nil(t, Chords(melody.NotesOf("C4"), nil, Constraints{}))
+	assert.Nil(t, Chords(melody.NotesOf("C4"), [][]chord.Chord{{}}, Constraints{}))
+	assert.Nil(t, Chords(melody.NotesOf("C4"), [][]chord.Chord{{chord.Chord{}}}, Constraints{}))
+}
+
+func TestChords_BeatsPerBar(t *testing.T) {
+	notes := melody.NotesOf("C4", "E4", "G4", "F4", "A4", "C5")
+	solutions := Chords(notes, [][]chord.Chord{chordsOf("C", "F"), chordsOf("C", "F")}, Constraints{BeatsPerBar: 3})
+	assert.Equal(t, "C | F", solutions[0].String())
+}
+
+func TestSolution_Progression(t *testing.T) {
+	s := Solution{Chords: chordsOf("Dm7", "G7", "C")}
+	assert.Equal(t, progression.Of("Dm7", "G7", "C"), s.Progression())
+	assert.Equal(t, "Dm7 | G7 | C", s.String())
+}
+
+//
+// Private
+//
+
+func chordsOf(names ...string) []chord.Chord {
+	var chords []chord.Chord
+	for _, name := range names {
+		chords = append(chords, chord.Of(name))
+	}
+	return chords
+}
+
+func solutionNamesOf(solutions []Solution) (names []string) {
+	for _, s := range solutions {
+		names = append(names, s.String())
+	}
+	return
+}
@@ -0,0 +1,36 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// freqsOf a scale, or a chord, named, across a range of octaves, e.g. "3..5", at a tuning of A4, e.g. "432" or "baroque"
+func freqsOf(name string, isChord bool, octaves string, tuning string) (pitch.Table, error) {
+	from, to, err := pitch.ParseRange(octaves)
+	if err != nil {
+		return pitch.Table{}, err
+	}
+	opts := pitch.RangeOptions{From: from, To: to}
+	if opts.Tuning, err = pitch.TuningOf(tuning); err != nil {
+		return pitch.Table{}, err
+	}
+	var tones pitch.Tones
+	if isChord {
+		c, err := chord.Parse(name)
+		if err != nil {
+			return pitch.Table{}, err
+		}
+		tones, opts.AdjSymbol = c, note.AdjSymbol(c.AdjSymbol) // numbered alike by both note packages
+	} else {
+		s, err := scale.Parse(name)
+		if err != nil {
+			return pitch.Table{}, err
+		}
+		tones, opts.AdjSymbol = s, note.AdjSymbol(s.AdjSymbol)
+	}
+	return pitch.TableFor(tones, opts), nil
+}
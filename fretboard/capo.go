@@ -0,0 +1,91 @@
+// A capo lets a guitarist play in a key of awkward barre chords with the shapes of an easier key, e.g. in Eb with a capo at the 1st fret, playing the shapes of D
+package fretboard
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// MaxCapo fret suggested, above which the frets are too narrow to play most shapes comfortably
+const MaxCapo = 7
+
+// Suggestion of a capo for a key, at a fret, or 0 of none, playing the shapes of another key, and the shape of each diatonic chord of the key that can be played
+type Suggestion struct {
+	Capo   int
+	Played key.Key  // whose shapes are played, sounding in the key suggested for, e.g. D major of Eb major with a capo at the 1st fret
+	Chords []Shaped // of the key, in order of its degrees, each played by a shape
+}
+
+// Shaped chord of a key, on a degree of its scale, from 1 of the tonic, sounding as a shape is played with a capo
+type Shaped struct {
+	Chord  chord.Chord
+	Degree int
+	Shape  Shape
+}
+
+// CapoSuggestions for a key, of each fret of a capo, up to the MaxCapo, where the tonic chord of the key can be played by one of the preferred shapes, on a guitar in standard tuning,
+// from the best, of the least difficulty, then playing the most of the primary chords of the key, I, IV and V, or i, iv and v, then the most of its diatonic chords, then the lowest capo,
+// e.g. at the 1st fret for Eb, playing the shapes of D, Em, G and A
+func CapoSuggestions(k key.Key, preferred []Shape) []Suggestion {
+	diatonic := k.DiatonicChords()
+	var suggestions []Suggestion
+	for capo := 0; capo <= MaxCapo; capo++ {
+		s := Suggestion{Capo: capo, Played: keyBelow(k, capo)}
+		for n, c := range diatonic {
+			if shape, ok := shapeOf(c, preferred, capo); ok {
+				s.Chords = append(s.Chords, Shaped{Chord: c, Degree: n + 1, Shape: shape})
+			}
+		}
+		if len(s.Chords) > 0 && s.Chords[0].Degree == 1 {
+			suggestions = append(suggestions, s)
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if da, db := a.Difficulty(), b.Difficulty(); da != db {
+			return da < db
+		}
+		if pa, pb := a.primaries(), b.primaries(); pa != pb {
+			return pa > pb
+		}
+		return len(a.Chords) > len(b.Chords)
+	})
+	return suggestions
+}
+
+//
+// Private
+//
+
+// primaries of the key played by a suggestion, of its chords on the 1st, 4th and 5th degrees
+func (s Suggestion) primaries() (count int) {
+	for _, c := range s.Chords {
+		if c.Degree == 1 || c.Degree == 4 || c.Degree == 5 {
+			count++
+		}
+	}
+	return
+}
+
+// shapeOf a chord, the first of some shapes that sounds it, with a capo at a fret, by the same root and pitch classes
+func shapeOf(c chord.Chord, shapes []Shape, capo int) (Shape, bool) {
+	for _, s := range shapes {
+		if sounding := s.Sounding(Guitar(capo)); sounding.Root == c.Root && sounding.EquivalentTo(c) {
+			return s, true
+		}
+	}
+	return Shape{}, false
+}
+
+// keyBelow a key by some semitones, of the same mode, spelled in sharps unless it has fewer flats, e.g. D major of Eb major a semitone down
+func keyBelow(k key.Key, semitones int) key.Key {
+	root, _ := k.Root.Step(-semitones)
+	below := key.Key{Root: root, AdjSymbol: note.Sharp, Mode: k.Mode}
+	if below.Fifths() > 6 {
+		below.AdjSymbol = note.Flat
+	}
+	return below
+}
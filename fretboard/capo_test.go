@@ -0,0 +1,84 @@
+// A capo lets a guitarist play in a key of awkward barre chords with the shapes of an easier key, e.g. in Eb with a capo at the 1st fret, playing the shapes of D
+package fretboard
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestCapoSuggestions(t *testing.T) {
+	suggestions := CapoSuggestions(key.Of("Eb"), OpenShapes)
+	assert.Equal(t, []int{1, 6, 3}, capos(suggestions))
+	best := suggestions[0]
+	assert.Equal(t, key.Key{Root: note.D, AdjSymbol: note.Sharp, Mode: key.Major}, best.Played)
+	assert.Equal(t, []string{"Eb=D", "Fm=Em", "Ab=G", "Bb=A"}, shapedOf(best))
+	assert.Equal(t, []int{1, 2, 4, 5}, degreesOf(best))
+	assert.Equal(t, []string{"Eb=C", "Fm=Dm", "Gm=Em", "Bb=G", "Cm=Am"}, shapedOf(suggestions[2]))
+}
+
+func TestCapoSuggestions_Open(t *testing.T) {
+	suggestions := CapoSuggestions(key.Of("G"), OpenShapes)
+	assert.Equal(t, []int{5, 3, 0, 7}, capos(suggestions))
+	assert.Equal(t, []string{"G=D", "Am=Em", "C=G", "D=A"}, shapedOf(suggestions[0]))
+	assert.Equal(t, []string{"G=E", "C=A"}, shapedOf(suggestions[1]), "as easy, but of fewer primary chords")
+	assert.Equal(t, []string{"G=G", "Am=Am", "C=C", "D=D", "Em=Em"}, shapedOf(suggestions[2]))
+}
+
+func TestCapoSuggestions_Minor(t *testing.T) {
+	suggestions := CapoSuggestions(key.Of("Bb minor"), OpenShapes)
+	assert.Equal(t, []int{6, 1}, capos(suggestions))
+	assert.Equal(t, key.Key{Root: note.E, AdjSymbol: note.Sharp, Mode: key.Minor}, suggestions[0].Played)
+	assert.Equal(t, []string{"Bbm=Em", "Db=G", "Ebm=Am", "Gb=C", "Ab=D"}, shapedOf(suggestions[0]))
+	assert.Equal(t, key.Key{Root: note.A, AdjSymbol: note.Sharp, Mode: key.Minor}, suggestions[1].Played)
+	assert.Equal(t, []string{"Bbm=Am", "Db=C", "Ebm=Dm", "Fm=Em", "Ab=G"}, shapedOf(suggestions[1]))
+}
+
+func TestCapoSuggestions_Preferred(t *testing.T) {
+	shapes, err := ShapesNamed("caged")
+	assert.Nil(t, err)
+	suggestions := CapoSuggestions(key.Of("F"), shapes)
+	assert.Equal(t, []int{1, 3, 5}, capos(suggestions))
+	assert.Equal(t, []string{"F=E", "Bb=A"}, shapedOf(suggestions[0]))
+	assert.Equal(t, []string{"F=D", "Bb=G", "C=A"}, shapedOf(suggestions[1]))
+	assert.Equal(t, 0, len(CapoSuggestions(key.Of("F"), nil)))
+	assert.Equal(t, 0, len(CapoSuggestions(key.Of("F minor"), shapes)), "without a shape of the minor tonic")
+}
+
+func TestKeyBelow(t *testing.T) {
+	assert.Equal(t, key.Key{Root: note.D, AdjSymbol: note.Sharp, Mode: key.Major}, keyBelow(key.Of("Eb"), 1))
+	assert.Equal(t, key.Key{Root: note.Cs, AdjSymbol: note.Flat, Mode: key.Major}, keyBelow(key.Of("D"), 1))
+	assert.Equal(t, key.Key{Root: note.Fs, AdjSymbol: note.Sharp, Mode: key.Minor}, keyBelow(key.Of("A minor"), 3))
+	assert.Equal(t, key.Of("C").Root, keyBelow(key.Of("C"), 0).Root)
+}
+
+//
+// Private
+//
+
+func capos(suggestions []Suggestion) []int {
+	var frets []int
+	for _, s := range suggestions {
+		frets = append(frets, s.Capo)
+	}
+	return frets
+}
+
+func shapedOf(s Suggestion) []string {
+	var shaped []string
+	for _, c := range s.Chords {
+		shaped = append(shaped, c.Chord.Name()+"="+c.Shape.Name)
+	}
+	return shaped
+}
+
+func degreesOf(s Suggestion) []int {
+	var degrees []int
+	for _, c := range s.Chords {
+		degrees = append(degrees, c.Degree)
+	}
+	return degrees
+}
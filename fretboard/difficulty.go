@@ -0,0 +1,89 @@
+// The difficulty of a shape is how hard it is for the fretting hand, more of each string it stops, each fret it spans, a barre, and each string it mutes,
+// e.g. 2 of Em 022000, 9 of Dm xx0231 or 14 of the barre F 133211, and of a suggestion, the mean of its shapes on the same scale
+package fretboard
+
+// Weights of what makes a shape harder to play, adding up to its difficulty
+const (
+	FingerDifficulty = 1 // of each string stopped by a finger
+	SpanDifficulty   = 2 // of each fret spanned from the lowest stopped to the highest
+	BarreDifficulty  = 4 // of a barre, one finger stopping several strings at the same fret, needed of more strings than the 4 fingers can stop alone
+	MutedDifficulty  = 1 // of each muted string, damped so it doesn't sound
+)
+
+// Difficulty of the shape for the fretting hand, adding up the weights of what makes it harder to play, from 0 of only open strings,
+// up to 10 of the 6 strings stopped or muted without a span, and another SpanDifficulty of each fret spanned, e.g. 8 of C x32010, or 14 of F 133211
+func (s Shape) Difficulty() int {
+	stopped, muted, lowest, highest := 0, 0, 0, 0
+	for _, fret := range s.Frets {
+		switch {
+		case fret == Muted:
+			muted++
+		case fret > 0:
+			if stopped == 0 || fret < lowest {
+				lowest = fret
+			}
+			if fret > highest {
+				highest = fret
+			}
+			stopped++
+		}
+	}
+	difficulty := stopped*FingerDifficulty + muted*MutedDifficulty
+	if stopped > 0 {
+		difficulty += (highest - lowest) * SpanDifficulty
+	}
+	if stopped > 4 && s.barreAt(lowest) {
+		difficulty += BarreDifficulty
+	}
+	return difficulty
+}
+
+// Difficulty of a suggestion, the mean of the shapes of its chords, on the same scale as the difficulty of a shape, to compare suggestions of more or fewer chords,
+// e.g. 4.5 of D, Em, G and A, or 0 of a suggestion without any chords
+func (s Suggestion) Difficulty() float64 {
+	if len(s.Chords) == 0 {
+		return 0
+	}
+	total := 0
+	for _, c := range s.Chords {
+		total += c.Shape.Difficulty()
+	}
+	return float64(total) / float64(len(s.Chords))
+}
+
+// Easier of some shapes, in order, those of a difficulty up to the most, e.g. Em 022000 and A x02220 of the OpenShapes up to 4
+func Easier(shapes []Shape, most int) []Shape {
+	var easier []Shape
+	for _, s := range shapes {
+		if s.Difficulty() <= most {
+			easier = append(easier, s)
+		}
+	}
+	return easier
+}
+
+//
+// Private
+//
+
+// barreAt a fret, whether one finger can stop several strings of the shape there, of two or more strings at the fret, and only stopped strings between them
+func (s Shape) barreAt(fret int) bool {
+	first, last := -1, -1
+	for str, f := range s.Frets {
+		if f == fret {
+			if first < 0 {
+				first = str
+			}
+			last = str
+		}
+	}
+	if first < 0 || first == last {
+		return false
+	}
+	for _, f := range s.Frets[first:last] {
+		if f < fret {
+			return false
+		}
+	}
+	return true
+}
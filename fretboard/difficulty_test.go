@@ -0,0 +1,41 @@
+// The difficulty of a shape is how hard it is for the fretting hand, more of each string it stops, each fret it spans, a barre, and each string it mutes,
+// e.g. 2 of Em 022000, 9 of Dm xx0231 or 14 of the barre F 133211, and of a suggestion, the mean of its shapes on the same scale
+package fretboard
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+)
+
+func TestShape_Difficulty(t *testing.T) {
+	difficulties := map[string]int{}
+	for _, s := range OpenShapes {
+		difficulties[s.Name] = s.Difficulty()
+	}
+	assert.Equal(t, map[string]int{"C": 8, "A": 4, "G": 5, "E": 5, "D": 7, "Am": 6, "Em": 2, "Dm": 9}, difficulties)
+}
+
+func TestShape_Difficulty_Barre(t *testing.T) {
+	assert.Equal(t, 6+2*2+4, Shape{Name: "F", Frets: []int{1, 3, 3, 2, 1, 1}}.Difficulty())
+	assert.Equal(t, 5+2*2+1+4, Shape{Name: "C", Frets: []int{Muted, 3, 5, 5, 5, 3}}.Difficulty())
+	assert.Equal(t, 5+2*2, Shape{Frets: []int{1, 0, 3, 2, 1, 1}}.Difficulty(), "of an open string under the lowest fret, not a barre")
+	assert.Equal(t, 5+2*1, Shape{Frets: []int{2, 3, 3, 3, 3, 0}}.Difficulty(), "of only one string at the lowest fret")
+	assert.Equal(t, 4+2*2, Shape{Frets: []int{0, 0, 3, 2, 1, 1}}.Difficulty(), "of the 4 fingers alone")
+	assert.Equal(t, 0, Shape{Name: "Em7", Frets: []int{0, 0, 0, 0, 0, 0}}.Difficulty())
+	assert.Equal(t, 2, Shape{Frets: []int{Muted, Muted, 0, 0, 0, 0}}.Difficulty())
+}
+
+func TestSuggestion_Difficulty(t *testing.T) {
+	best := CapoSuggestions(key.Of("Eb"), OpenShapes)[0]
+	assert.Equal(t, float64(7+2+5+4)/4, best.Difficulty(), "of D, Em, G and A")
+	assert.Equal(t, 0.0, Suggestion{}.Difficulty())
+}
+
+func TestEasier(t *testing.T) {
+	assert.Equal(t, []string{"A", "Em"}, namesOf(Easier(OpenShapes, 4)))
+	assert.Equal(t, []string{"A", "G", "E", "Em"}, namesOf(Easier(OpenShapes, 5)))
+	assert.Equal(t, 0, len(Easier(OpenShapes, 1)))
+}
@@ -0,0 +1,62 @@
+// A fretboard of a guitar, or another fretted instrument, e.g. a ukulele, has strings in a tuning, each sounding higher by a semitone at each fret it's stopped at,
+// and a capo clamped across every string at a fret raises them all together, so the same shapes of open chords sound in another key.
+//
+// https://en.wikipedia.org/wiki/Capo
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package fretboard
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// GuitarFrets that a capo can be clamped at, or a string stopped at, counted from 0 of the open string at the nut
+const GuitarFrets = 19
+
+// Muted string, not played, in the frets of a shape
+const Muted = -1
+
+// Tuning of the open strings of a fretted instrument, from the string nearest the thumb, the lowest unless the tuning is re-entrant, e.g. the high G of a ukulele
+type Tuning []note.Note
+
+// Standard tuning of a guitar, E2 A2 D3 G3 B3 E4
+var Standard = Tuning{
+	{Class: note.E, Octave: 2},
+	{Class: note.A, Octave: 2},
+	{Class: note.D, Octave: 3},
+	{Class: note.G, Octave: 3},
+	{Class: note.B, Octave: 3},
+	{Class: note.E, Octave: 4},
+}
+
+// Fretboard of an instrument, by its name, of strings in a tuning, with some frets, and a capo clamped at one of them, or 0 of none
+type Fretboard struct {
+	Name   string
+	Tuning Tuning
+	Frets  int
+	Capo   int
+}
+
+// Guitar in standard tuning, with a capo clamped at a fret, or 0 of none
+func Guitar(capo int) Fretboard {
+	return Fretboard{Name: "guitar", Tuning: Standard, Frets: GuitarFrets, Capo: capo}
+}
+
+// At a fret of a string, counted from 0 of the first string of the tuning, and from 0 of the string open at the capo, or the nut of none,
+// the note it sounds, or false if the string is muted or there's no such string or fret
+func (f Fretboard) At(str, fret int) (note.Note, bool) {
+	if str < 0 || str >= len(f.Tuning) || fret < 0 || f.Capo < 0 || f.Capo+fret > f.Frets {
+		return note.Note{}, false
+	}
+	open := f.Tuning[str]
+	class, octaves := open.Class.Step(f.Capo + fret)
+	return note.Note{Class: class, Octave: open.Octave + octaves}, true
+}
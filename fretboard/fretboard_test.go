@@ -0,0 +1,43 @@
+// A fretboard of a guitar, or another fretted instrument, e.g. a ukulele, has strings in a tuning, each sounding higher by a semitone at each fret it's stopped at,
+// and a capo clamped across every string at a fret raises them all together, so the same shapes of open chords sound in another key.
+package fretboard
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestGuitar(t *testing.T) {
+	f := Guitar(2)
+	assert.Equal(t, "guitar", f.Name)
+	assert.Equal(t, Standard, f.Tuning)
+	assert.Equal(t, GuitarFrets, f.Frets)
+	assert.Equal(t, 2, f.Capo)
+}
+
+func TestFretboard_At(t *testing.T) {
+	n, ok := Guitar(0).At(0, 0)
+	assert.True(t, ok)
+	assert.Equal(t, note.Note{Class: note.E, Octave: 2}, n)
+	n, ok = Guitar(0).At(1, 3)
+	assert.True(t, ok)
+	assert.Equal(t, note.Note{Class: note.C, Octave: 3}, n)
+	n, ok = Guitar(3).At(5, 0)
+	assert.True(t, ok)
+	assert.Equal(t, note.Note{Class: note.G, Octave: 4}, n, "open at the capo")
+	n, ok = Guitar(0).At(5, 12)
+	assert.True(t, ok)
+	assert.Equal(t, note.Note{Class: note.E, Octave: 5}, n)
+}
+
+func TestFretboard_At_Invalid(t *testing.T) {
+	for _, at := range [][2]int{{0, Muted}, {-1, 0}, {6, 0}, {0, GuitarFrets + 1}} {
+		_, ok := Guitar(0).At(at[0], at[1])
+		assert.False(t, ok, at)
+	}
+	_, ok := Guitar(5).At(0, GuitarFrets-4)
+	assert.False(t, ok, "beyond the last fret above the capo")
+}
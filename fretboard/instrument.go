@@ -0,0 +1,71 @@
+// Fretted instruments other than the guitar have their own tunings and numbers of strings and frets, e.g. a ukulele of 4 strings re-entrant in GCEA
+package fretboard
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ErrUnknownInstrument when naming a fretted instrument that isn't built in, e.g. "sitar"
+var ErrUnknownInstrument = errors.New("unknown instrument")
+
+// Fretboards of the instruments built in other than the guitar
+var (
+	Ukulele = Fretboard{Name: "ukulele", Frets: 15, Tuning: Tuning{
+		{Class: note.G, Octave: 4},
+		{Class: note.C, Octave: 4},
+		{Class: note.E, Octave: 4},
+		{Class: note.A, Octave: 4},
+	}}
+	Bass = Fretboard{Name: "bass", Frets: 20, Tuning: Tuning{
+		{Class: note.E, Octave: 1},
+		{Class: note.A, Octave: 1},
+		{Class: note.D, Octave: 2},
+		{Class: note.G, Octave: 2},
+	}}
+	FiveStringBass = Fretboard{Name: "bass-5", Frets: 24, Tuning: Tuning{
+		{Class: note.B, Octave: 0},
+		{Class: note.E, Octave: 1},
+		{Class: note.A, Octave: 1},
+		{Class: note.D, Octave: 2},
+		{Class: note.G, Octave: 2},
+	}}
+	Banjo = Fretboard{Name: "banjo", Frets: 22, Tuning: Tuning{
+		{Class: note.G, Octave: 4},
+		{Class: note.D, Octave: 3},
+		{Class: note.G, Octave: 3},
+		{Class: note.B, Octave: 3},
+		{Class: note.D, Octave: 4},
+	}}
+	Mandolin = Fretboard{Name: "mandolin", Frets: 17, Tuning: Tuning{
+		{Class: note.G, Octave: 3},
+		{Class: note.D, Octave: 4},
+		{Class: note.A, Octave: 4},
+		{Class: note.E, Octave: 5},
+	}}
+)
+
+// Instruments built in, the guitar first, without a capo
+var Instruments = []Fretboard{Guitar(0), Ukulele, Bass, FiveStringBass, Banjo, Mandolin}
+
+// Names of the Instruments built in, in order
+func Names() []string {
+	names := make([]string, len(Instruments))
+	for n, f := range Instruments {
+		names[n] = f.Name
+	}
+	return names
+}
+
+// Named one of the Instruments built in, e.g. Ukulele of "ukulele"
+func Named(name string) (Fretboard, error) {
+	for _, f := range Instruments {
+		if strings.ToLower(strings.TrimSpace(name)) == f.Name {
+			return f, nil
+		}
+	}
+	return Fretboard{}, fmt.Errorf("%w %q, expected one of %s", ErrUnknownInstrument, name, strings.Join(Names(), ", "))
+}
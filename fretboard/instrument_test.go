@@ -0,0 +1,55 @@
+// Fretted instruments other than the guitar have their own tunings and numbers of strings and frets, e.g. a ukulele of 4 strings re-entrant in GCEA
+package fretboard
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, []string{"guitar", "ukulele", "bass", "bass-5", "banjo", "mandolin"}, Names())
+}
+
+func TestNamed(t *testing.T) {
+	f, err := Named(" Ukulele ")
+	assert.Nil(t, err)
+	assert.Equal(t, Ukulele, f)
+	f, err = Named("guitar")
+	assert.Nil(t, err)
+	assert.Equal(t, Guitar(0), f)
+	_, err = Named("sitar")
+	assert.True(t, errors.Is(err, ErrUnknownInstrument))
+	assert.Contains(t, err.Error(), "guitar, ukulele, bass, bass-5, banjo, mandolin")
+}
+
+func TestInstruments_Strings(t *testing.T) {
+	for name, strings := range map[string]int{"guitar": 6, "ukulele": 4, "bass": 4, "bass-5": 5, "banjo": 5, "mandolin": 4} {
+		f, err := Named(name)
+		assert.Nil(t, err)
+		assert.Equal(t, strings, len(f.Tuning), name)
+	}
+}
+
+func TestUkulele_At(t *testing.T) {
+	n, ok := Ukulele.At(0, 0)
+	assert.True(t, ok)
+	assert.Equal(t, note.Note{Class: note.G, Octave: 4}, n, "re-entrant, above the C string")
+	n, ok = Ukulele.At(3, 3)
+	assert.True(t, ok)
+	assert.Equal(t, note.Note{Class: note.C, Octave: 5}, n)
+	_, ok = Ukulele.At(3, 16)
+	assert.False(t, ok, "beyond the last fret")
+}
+
+func TestFiveStringBass_At(t *testing.T) {
+	n, ok := FiveStringBass.At(0, 1)
+	assert.True(t, ok)
+	assert.Equal(t, note.Note{Class: note.C, Octave: 1}, n)
+	n, ok = FiveStringBass.At(4, 24)
+	assert.True(t, ok)
+	assert.Equal(t, note.Note{Class: note.G, Octave: 4}, n)
+}
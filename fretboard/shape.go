@@ -0,0 +1,107 @@
+// A shape of a chord is the fret each string is stopped at, or open or muted, e.g. x32010 of C, playing the same kind of chord wherever a capo moves it
+package fretboard
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ErrUnknownShape when naming a shape, or a set of shapes, that isn't known, e.g. "F"
+var ErrUnknownShape = errors.New("unknown shape")
+
+// Shape of a chord, by the name of the chord it plays without a capo, e.g. "C", and the fret of each string from the lowest, relative to the capo, or Muted
+type Shape struct {
+	Name  string
+	Frets []int
+}
+
+// OpenShapes of major and minor chords, played on open strings in the first frets of a guitar in standard tuning
+var OpenShapes = []Shape{
+	{Name: "C", Frets: []int{Muted, 3, 2, 0, 1, 0}},
+	{Name: "A", Frets: []int{Muted, 0, 2, 2, 2, 0}},
+	{Name: "G", Frets: []int{3, 2, 0, 0, 0, 3}},
+	{Name: "E", Frets: []int{0, 2, 2, 1, 0, 0}},
+	{Name: "D", Frets: []int{Muted, Muted, 0, 2, 3, 2}},
+	{Name: "Am", Frets: []int{Muted, 0, 2, 2, 1, 0}},
+	{Name: "Em", Frets: []int{0, 2, 2, 0, 0, 0}},
+	{Name: "Dm", Frets: []int{Muted, Muted, 0, 2, 3, 1}},
+}
+
+// ShapeSetNames of the sets of shapes, "open" of all the OpenShapes, or "caged" of only the major ones, C, A, G, E and D
+var ShapeSetNames = []string{"open", "caged"}
+
+// ShapesNamed a set of shapes, one of the ShapeSetNames, e.g. "open", or some of the OpenShapes by name, separated by commas, e.g. "G,C,D,Em"
+func ShapesNamed(text string) ([]Shape, error) {
+	switch strings.ToLower(strings.TrimSpace(text)) {
+	case "open":
+		return OpenShapes, nil
+	case "caged":
+		return OpenShapes[:5], nil
+	}
+	var shapes []Shape
+	for _, name := range strings.Split(text, ",") {
+		s, ok := shapeNamed(strings.TrimSpace(name))
+		if !ok {
+			var names []string
+			for _, o := range OpenShapes {
+				names = append(names, o.Name)
+			}
+			return nil, fmt.Errorf("%w %q, expected one of %s, or some of %s", ErrUnknownShape, strings.TrimSpace(name), strings.Join(ShapeSetNames, ", "), strings.Join(names, ", "))
+		}
+		shapes = append(shapes, s)
+	}
+	return shapes, nil
+}
+
+// Chord of the shape, played without a capo, e.g. C of x32010
+func (s Shape) Chord() chord.Chord {
+	return chord.Of(s.Name)
+}
+
+// Sounding chord of the shape on a fretboard, raised by its capo, e.g. D of the shape of C with a capo at the 2nd fret
+func (s Shape) Sounding(f Fretboard) chord.Chord {
+	return s.Chord().Transpose(f.Capo)
+}
+
+// Notes of the shape on a fretboard, from the lowest string, of each string it plays
+func (s Shape) Notes(f Fretboard) []note.Note {
+	var notes []note.Note
+	for str, fret := range s.Frets {
+		if n, ok := f.At(str, fret); ok {
+			notes = append(notes, n)
+		}
+	}
+	return notes
+}
+
+// String of the shape, the fret of each string from the lowest, or x if it's muted, e.g. "x32010"
+func (s Shape) String() string {
+	var b strings.Builder
+	for _, fret := range s.Frets {
+		if fret == Muted {
+			b.WriteString("x")
+		} else {
+			b.WriteString(strconv.Itoa(fret))
+		}
+	}
+	return b.String()
+}
+
+//
+// Private
+//
+
+// shapeNamed one of the OpenShapes, case sensitive, e.g. "Em"
+func shapeNamed(name string) (Shape, bool) {
+	for _, s := range OpenShapes {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return Shape{}, false
+}
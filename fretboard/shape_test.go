@@ -0,0 +1,76 @@
+// A shape of a chord is the fret each string is stopped at, or open or muted, e.g. x32010 of C, playing the same kind of chord wherever a capo moves it
+package fretboard
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestOpenShapes(t *testing.T) {
+	for _, s := range OpenShapes {
+		var classes []note.Class
+		for _, n := range s.Notes(Guitar(0)) {
+			classes = append(classes, n.Class)
+		}
+		assert.True(t, s.Chord().ToneSet().Equal(toneset.Of(classes...)), s.Name)
+		assert.Equal(t, len(Standard), len(s.Frets), s.Name)
+	}
+}
+
+func TestShapesNamed(t *testing.T) {
+	shapes, err := ShapesNamed("open")
+	assert.Nil(t, err)
+	assert.Equal(t, OpenShapes, shapes)
+	shapes, err = ShapesNamed("CAGED")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C", "A", "G", "E", "D"}, namesOf(shapes))
+	shapes, err = ShapesNamed("G, C,D,Em")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"G", "C", "D", "Em"}, namesOf(shapes))
+	_, err = ShapesNamed("G,F")
+	assert.True(t, errors.Is(err, ErrUnknownShape))
+	assert.Equal(t, `unknown shape "F", expected one of open, caged, or some of C, A, G, E, D, Am, Em, Dm`, err.Error())
+}
+
+func TestShape_Sounding(t *testing.T) {
+	c, _ := shapeNamed("C")
+	assert.Equal(t, "D", c.Sounding(Guitar(2)).Name())
+	em, _ := shapeNamed("Em")
+	assert.Equal(t, "Gm", em.Sounding(Guitar(3)).Name())
+	assert.Equal(t, "C", c.Sounding(Guitar(0)).Name())
+}
+
+func TestShape_Notes(t *testing.T) {
+	d, _ := shapeNamed("D")
+	assert.Equal(t, []note.Note{
+		{Class: note.D, Octave: 3},
+		{Class: note.A, Octave: 3},
+		{Class: note.D, Octave: 4},
+		{Class: note.Fs, Octave: 4},
+	}, d.Notes(Guitar(0)))
+	assert.Equal(t, note.Note{Class: note.E, Octave: 3}, d.Notes(Guitar(2))[0])
+}
+
+func TestShape_String(t *testing.T) {
+	c, _ := shapeNamed("C")
+	assert.Equal(t, "x32010", c.String())
+	assert.Equal(t, "xx0232", OpenShapes[4].String())
+	assert.Equal(t, "", Shape{}.String())
+}
+
+//
+// Private
+//
+
+func namesOf(shapes []Shape) []string {
+	var names []string
+	for _, s := range shapes {
+		names = append(names, s.Name)
+	}
+	return names
+}
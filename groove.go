@@ -0,0 +1,22 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"os"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// writeGrooveFile at a path, of the notes of a pattern, as MIDI
+func writeGrooveFile(path string, notes []midi.Note) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = midi.Write(f, notes)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,176 @@
+// A groove is a drum pattern on a grid of steps, e.g. sixteen per bar, with some swing, that repeats to keep time under a progression.
+//
+// Common patterns are built in, e.g. a rock backbeat, four-on-the-floor, a bossa nova or a shuffle, and are written as MIDI on the drum channel.
+//
+// https://en.wikipedia.org/wiki/Drum_beat
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package groove
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Swing of a pattern, in percent of each pair of steps taken by the first, from Straight to a dotted rhythm
+const (
+	Straight = 50
+	Triplet  = 66 // e.g. a shuffle
+	MaxSwing = 75 // i.e. dotted eighths and sixteenths
+)
+
+// Steps of a track, each a Hit, an Accent or a Rest
+const (
+	Hit    = 'x'
+	Accent = 'X'
+	Rest   = '.'
+)
+
+var (
+	// ErrUnknownPattern when naming a pattern that isn't built in, e.g. "polka"
+	ErrUnknownPattern = errors.New("unknown pattern")
+
+	// ErrSwingRange when swinging a pattern outside of Straight to MaxSwing
+	ErrSwingRange = errors.New("out of range swing")
+
+	// ErrUnknownInstrument when naming an instrument that isn't in the drum kit, e.g. "cowbell"
+	ErrUnknownInstrument = errors.New("unknown instrument")
+)
+
+// Instrument of a drum kit, by its General MIDI percussion note number
+type Instrument struct {
+	Name string
+	Note int
+}
+
+// Instruments of a General MIDI drum kit
+var (
+	Kick      = Instrument{"kick", 36}
+	Rim       = Instrument{"rim", 37}
+	Snare     = Instrument{"snare", 38}
+	Clap      = Instrument{"clap", 39}
+	ClosedHat = Instrument{"closed hat", 42}
+	OpenHat   = Instrument{"open hat", 46}
+	Ride      = Instrument{"ride", 51}
+)
+
+// Instruments of the drum kit, from the lowest General MIDI note number
+var Instruments = []Instrument{Kick, Rim, Snare, Clap, ClosedHat, OpenHat, Ride}
+
+// InstrumentNames of the drum kit, in order, each with a dash in place of a space, e.g. "closed-hat"
+func InstrumentNames() []string {
+	names := make([]string, len(Instruments))
+	for n, i := range Instruments {
+		names[n] = strings.ReplaceAll(i.Name, " ", "-")
+	}
+	return names
+}
+
+// InstrumentNamed one of the Instruments, with a dash or a space in its name, e.g. ClosedHat of "closed-hat"
+func InstrumentNamed(name string) (Instrument, error) {
+	for _, i := range Instruments {
+		if strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "-", " ") == i.Name {
+			return i, nil
+		}
+	}
+	return Instrument{}, fmt.Errorf("%w %q, expected one of %s", ErrUnknownInstrument, name, strings.Join(InstrumentNames(), ", "))
+}
+
+// Track of a pattern, an instrument played on some steps, e.g. "x...x...x...x..." for the kick of four-on-the-floor
+type Track struct {
+	Instrument Instrument
+	Steps      string
+}
+
+// Pattern of tracks, on a grid of some steps per bar and per beat, e.g. 16 and 4 for sixteenth notes in 4/4, with some swing
+type Pattern struct {
+	Name         string
+	Steps        int
+	StepsPerBeat int
+	Swing        int // percent, from Straight to MaxSwing
+	Tracks       []Track
+}
+
+// Patterns built in, by name
+var Patterns = map[string]Pattern{
+	"backbeat": {Name: "backbeat", Steps: 16, StepsPerBeat: 4, Swing: Straight, Tracks: []Track{
+		{ClosedHat, "x.x.x.x.x.x.x.x."},
+		{Snare, "....X.......X..."},
+		{Kick, "x.......x.x....."},
+	}},
+	"four-on-the-floor": {Name: "four-on-the-floor", Steps: 16, StepsPerBeat: 4, Swing: Straight, Tracks: []Track{
+		{OpenHat, "..x...x...x...x."},
+		{Clap, "....X.......X..."},
+		{Kick, "X...X...X...X..."},
+	}},
+	"bossa": {Name: "bossa", Steps: 16, StepsPerBeat: 4, Swing: Straight, Tracks: []Track{
+		{ClosedHat, "x.x.x.x.x.x.x.x."},
+		{Rim, "x..x..x...x..x.."},
+		{Kick, "x..xx..xx..xx..x"},
+	}},
+	"shuffle": {Name: "shuffle", Steps: 8, StepsPerBeat: 2, Swing: Triplet, Tracks: []Track{
+		{Ride, "Xx.xXx.x"},
+		{Snare, "..X...X."},
+		{Kick, "x...x..."},
+	}},
+}
+
+// Names of the built-in patterns, in order
+func Names() []string {
+	var names []string
+	for name := range Patterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Named pattern, one of the Names, e.g. Named("bossa")
+func Named(name string) (Pattern, error) {
+	p, ok := Patterns[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return Pattern{}, fmt.Errorf("%w %q", ErrUnknownPattern, name)
+	}
+	return p, nil
+}
+
+// Swung to some percent of swing, from Straight to MaxSwing, without modifying the pattern, e.g. Swung(Triplet)
+func (p Pattern) Swung(percent int) (Pattern, error) {
+	if percent < Straight || percent > MaxSwing {
+		return p, fmt.Errorf("%w %d%%, expected %d%% to %d%%", ErrSwingRange, percent, Straight, MaxSwing)
+	}
+	p.Swing = percent
+	return p, nil
+}
+
+// String of the pattern as a grid, a line for each track, with a bar between each beat, e.g. "kick  x...|x...|x...|x..."
+func (p Pattern) String() string {
+	width := 0
+	for _, t := range p.Tracks {
+		if len(t.Instrument.Name) > width {
+			width = len(t.Instrument.Name)
+		}
+	}
+	var b strings.Builder
+	for _, t := range p.Tracks {
+		fmt.Fprintf(&b, "%-*s  ", width, t.Instrument.Name)
+		for n := 0; n < len(t.Steps); n++ {
+			if n > 0 && p.StepsPerBeat > 0 && n%p.StepsPerBeat == 0 {
+				b.WriteByte('|')
+			}
+			b.WriteByte(t.Steps[n])
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,77 @@
+// A groove is a drum pattern on a grid of steps, e.g. sixteen per bar, with some swing, that repeats to keep time under a progression.
+package groove
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, []string{"backbeat", "bossa", "four-on-the-floor", "shuffle"}, Names())
+}
+
+func TestNamed(t *testing.T) {
+	p, err := Named("Bossa")
+	assert.Nil(t, err)
+	assert.Equal(t, "bossa", p.Name)
+	assert.Equal(t, 16, p.Steps)
+	assert.Equal(t, Straight, p.Swing)
+}
+
+func TestNamed_Unknown(t *testing.T) {
+	_, err := Named("polka")
+	assert.True(t, errors.Is(err, ErrUnknownPattern))
+	assert.Equal(t, `unknown pattern "polka"`, err.Error())
+}
+
+func TestInstrumentNames(t *testing.T) {
+	assert.Equal(t, []string{"kick", "rim", "snare", "clap", "closed-hat", "open-hat", "ride"}, InstrumentNames())
+}
+
+func TestInstrumentNamed(t *testing.T) {
+	i, err := InstrumentNamed("Closed-Hat")
+	assert.Nil(t, err)
+	assert.Equal(t, ClosedHat, i)
+	i, err = InstrumentNamed(" open hat ")
+	assert.Nil(t, err)
+	assert.Equal(t, OpenHat, i)
+	_, err = InstrumentNamed("cowbell")
+	assert.True(t, errors.Is(err, ErrUnknownInstrument))
+	assert.Equal(t, `unknown instrument "cowbell", expected one of kick, rim, snare, clap, closed-hat, open-hat, ride`, err.Error())
+}
+
+func TestPatterns_Steps(t *testing.T) {
+	for name, p := range Patterns {
+		assert.Equal(t, name, p.Name)
+		assert.Equal(t, 0, p.Steps%p.StepsPerBeat, name)
+		for _, tr := range p.Tracks {
+			assert.Equal(t, p.Steps, len(tr.Steps), name+" "+tr.Instrument.Name)
+		}
+	}
+}
+
+func TestPattern_Swung(t *testing.T) {
+	p := Patterns["backbeat"]
+	swung, err := p.Swung(60)
+	assert.Nil(t, err)
+	assert.Equal(t, 60, swung.Swing)
+	assert.Equal(t, Straight, p.Swing)
+	_, err = p.Swung(80)
+	assert.True(t, errors.Is(err, ErrSwingRange))
+	assert.Equal(t, "out of range swing 80%, expected 50% to 75%", err.Error())
+	_, err = p.Swung(49)
+	assert.True(t, errors.Is(err, ErrSwingRange))
+}
+
+func TestPattern_String(t *testing.T) {
+	assert.Equal(t, ""+
+		"open hat  ..x.|..x.|..x.|..x.\n"+
+		"clap      ....|X...|....|X...\n"+
+		"kick      X...|X...|X...|X...\n", Patterns["four-on-the-floor"].String())
+	assert.Equal(t, ""+
+		"ride   Xx|.x|Xx|.x\n"+
+		"snare  ..|X.|..|X.\n"+
+		"kick   x.|..|x.|..\n", Patterns["shuffle"].String())
+}
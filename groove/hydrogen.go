@@ -0,0 +1,88 @@
+// Grooves are written as the XML of a pattern of the Hydrogen drum machine, on the instruments of its default GMRockKit, to import into a song of Hydrogen
+package groove
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// HydrogenResolution of a pattern of Hydrogen, in ticks per quarter note
+const HydrogenResolution = 48
+
+// HydrogenKit whose instruments a pattern of Hydrogen is played on, the default drum kit of Hydrogen
+const HydrogenKit = "GMRockKit"
+
+// WriteHydrogen of the pattern, a bar of it, as the XML of a pattern of Hydrogen, a .h2pattern file, each step swung as it is written as MIDI
+func (p Pattern) WriteHydrogen(w io.Writer) error {
+	h := hydrogenPattern{Xmlns: "http://www.hydrogen-music.org/drumkit_pattern", Kit: HydrogenKit, Name: p.Name, Category: "music-theory"}
+	if p.StepsPerBeat > 0 {
+		h.Size = p.Steps * HydrogenResolution / p.StepsPerBeat
+	}
+	for _, n := range p.Notes(1) {
+		instrument, ok := hydrogenInstruments[n.Number]
+		if !ok {
+			return fmt.Errorf("%w with the note %d, expected one of the instruments of %s", ErrUnknownInstrument, n.Number, HydrogenKit)
+		}
+		h.Notes = append(h.Notes, hydrogenNote{
+			Position:   int(math.Round(float64(n.Start*HydrogenResolution) / midi.Quarter)),
+			Velocity:   fmt.Sprintf("%.2f", float64(n.Velocity)/127),
+			PanL:       "0.5",
+			PanR:       "0.5",
+			Key:        "C0",
+			Length:     -1,
+			Instrument: instrument,
+		})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", " ")
+	if err := e.Encode(h); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+//
+// Private
+//
+
+// hydrogenInstruments of GMRockKit, numbered from 0, by the General MIDI note of each Instrument
+var hydrogenInstruments = map[int]int{
+	Kick.Note:      0,  // Kick
+	Rim.Note:       1,  // Stick
+	Snare.Note:     2,  // Snare Jazz
+	Clap.Note:      3,  // Hand Clap
+	ClosedHat.Note: 6,  // Closed HH
+	OpenHat.Note:   10, // Open HH
+	Ride.Note:      12, // Ride Jazz
+}
+
+type hydrogenPattern struct {
+	XMLName  xml.Name       `xml:"drumkit_pattern"`
+	Xmlns    string         `xml:"xmlns,attr"`
+	Kit      string         `xml:"drumkit_name"`
+	Name     string         `xml:"pattern>pattern_name"`
+	Info     string         `xml:"pattern>info"`
+	Category string         `xml:"pattern>category"`
+	Size     int            `xml:"pattern>size"`
+	Notes    []hydrogenNote `xml:"pattern>noteList>note"`
+}
+
+type hydrogenNote struct {
+	Position   int    `xml:"position"`
+	LeadLag    int    `xml:"leadlag"`
+	Velocity   string `xml:"velocity"`
+	PanL       string `xml:"pan_L"`
+	PanR       string `xml:"pan_R"`
+	Pitch      int    `xml:"pitch"`
+	Key        string `xml:"key"`
+	Length     int    `xml:"length"`
+	Instrument int    `xml:"instrument"`
+}
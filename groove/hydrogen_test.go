@@ -0,0 +1,45 @@
+// Grooves are written as the XML of a pattern of the Hydrogen drum machine, on the instruments of its default GMRockKit, to import into a song of Hydrogen
+package groove
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestPattern_WriteHydrogen(t *testing.T) {
+	p := Pattern{Name: "test", Steps: 4, StepsPerBeat: 2, Swing: Triplet, Tracks: []Track{{Kick, "X..."}, {ClosedHat, ".x.."}}}
+	var buf bytes.Buffer
+	assert.Nil(t, p.WriteHydrogen(&buf))
+	assert.Contains(t, buf.String(), `<?xml version="1.0" encoding="UTF-8"?>`)
+	assert.Contains(t, buf.String(), `<drumkit_pattern xmlns="http://www.hydrogen-music.org/drumkit_pattern">`)
+
+	var h hydrogenPattern
+	assert.Nil(t, xml.Unmarshal(buf.Bytes(), &h))
+	assert.Equal(t, "GMRockKit", h.Kit)
+	assert.Equal(t, "test", h.Name)
+	assert.Equal(t, 96, h.Size)
+	assert.Equal(t, []hydrogenNote{
+		{Position: 0, Velocity: "0.88", PanL: "0.5", PanR: "0.5", Key: "C0", Length: -1, Instrument: 0},
+		{Position: 32, Velocity: "0.63", PanL: "0.5", PanR: "0.5", Key: "C0", Length: -1, Instrument: 6},
+	}, h.Notes)
+}
+
+func TestPattern_WriteHydrogen_Patterns(t *testing.T) {
+	for _, name := range Names() {
+		p, _ := Named(name)
+		var buf bytes.Buffer
+		assert.Nil(t, p.WriteHydrogen(&buf), name)
+		assert.Contains(t, buf.String(), "<size>192</size>", name)
+	}
+}
+
+func TestPattern_WriteHydrogen_UnknownInstrument(t *testing.T) {
+	p := Pattern{Steps: 4, StepsPerBeat: 4, Tracks: []Track{{Instrument{"cowbell", 56}, "x..."}}}
+	err := p.WriteHydrogen(&bytes.Buffer{})
+	assert.True(t, errors.Is(err, ErrUnknownInstrument))
+	assert.Equal(t, "unknown instrument with the note 56, expected one of the instruments of GMRockKit", err.Error())
+}
@@ -0,0 +1,45 @@
+// Grooves are written as MIDI on the General MIDI drum channel, swinging every second step of each pair
+package groove
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/humanize"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// DrumChannel of General MIDI, i.e. channel 10 counted from 1
+const DrumChannel = 9
+
+// AccentVelocity of an accented step, louder than the midi.DefaultVelocity of a hit
+const AccentVelocity = 112
+
+// Notes of the pattern repeated for some bars, each step lasting until the next, with every second step of a pair delayed by the swing
+func (p Pattern) Notes(bars int) []midi.Note {
+	if p.StepsPerBeat < 1 {
+		return nil
+	}
+	step := midi.Quarter / p.StepsPerBeat
+	var notes []midi.Note
+	for bar := 0; bar < bars; bar++ {
+		for _, t := range p.Tracks {
+			for n := 0; n < len(t.Steps) && n < p.Steps; n++ {
+				velocity := midi.DefaultVelocity
+				switch t.Steps[n] {
+				case Hit:
+				case Accent:
+					velocity = AccentVelocity
+				default:
+					continue
+				}
+				notes = append(notes, midi.Note{Number: t.Instrument.Note, Velocity: velocity, Channel: DrumChannel, Start: (bar*p.Steps + n) * step, Duration: step})
+			}
+		}
+	}
+	return humanize.Swing(notes, step, p.Swing)
+}
+
+// WriteMIDI of the pattern repeated for some bars
+func (p Pattern) WriteMIDI(w io.Writer, bars int) error {
+	return midi.Write(w, p.Notes(bars))
+}
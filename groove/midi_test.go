@@ -0,0 +1,45 @@
+// Grooves are written as MIDI on the General MIDI drum channel, swinging every second step of each pair
+package groove
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestPattern_Notes(t *testing.T) {
+	p := Pattern{Steps: 4, StepsPerBeat: 2, Swing: Straight, Tracks: []Track{{Kick, "X.x."}, {ClosedHat, "xxxx"}}}
+	assert.Equal(t, []midi.Note{
+		{Number: 36, Velocity: AccentVelocity, Channel: DrumChannel, Start: 0, Duration: 240},
+		{Number: 36, Velocity: midi.DefaultVelocity, Channel: DrumChannel, Start: 480, Duration: 240},
+		{Number: 42, Velocity: midi.DefaultVelocity, Channel: DrumChannel, Start: 0, Duration: 240},
+		{Number: 42, Velocity: midi.DefaultVelocity, Channel: DrumChannel, Start: 240, Duration: 240},
+		{Number: 42, Velocity: midi.DefaultVelocity, Channel: DrumChannel, Start: 480, Duration: 240},
+		{Number: 42, Velocity: midi.DefaultVelocity, Channel: DrumChannel, Start: 720, Duration: 240},
+	}, p.Notes(1))
+}
+
+func TestPattern_Notes_Swing(t *testing.T) {
+	p := Pattern{Steps: 4, StepsPerBeat: 2, Swing: MaxSwing, Tracks: []Track{{ClosedHat, "xxxx"}}}
+	var starts, durations []int
+	for _, n := range p.Notes(2) {
+		starts = append(starts, n.Start)
+		durations = append(durations, n.Duration)
+	}
+	assert.Equal(t, []int{0, 360, 480, 840, 960, 1320, 1440, 1800}, starts)
+	assert.Equal(t, []int{360, 120, 360, 120, 360, 120, 360, 120}, durations)
+}
+
+func TestPattern_Notes_NoGrid(t *testing.T) {
+	assert.Nil(t, Pattern{Tracks: []Track{{Kick, "x"}}}.Notes(1))
+}
+
+func TestPattern_WriteMIDI(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, Patterns["bossa"].WriteMIDI(&out, 2))
+	assert.Equal(t, "MThd", out.String()[:4])
+	assert.Equal(t, byte(0x99), out.Bytes()[23]) // note on, drum channel
+}
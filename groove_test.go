@@ -0,0 +1,71 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/groove"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestWriteGrooveFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "groove")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "bossa.mid")
+	assert.Nil(t, writeGrooveFile(path, groove.Patterns["bossa"].Notes(2)))
+	b, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "MThd", string(b[:4]))
+	assert.NotNil(t, writeGrooveFile(filepath.Join(dir, "missing", "bossa.mid"), groove.Patterns["bossa"].Notes(2)))
+}
+
+func TestGrooveExitCode_Humanize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "groove")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "bossa.mid")
+	assertExitCode(t, 0, "", "groove", "--humanize", "10ms", "--jitter", "8", "--seed", "3", "--midi", path, "bossa")
+	b, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "MThd", string(b[:4]))
+}
+
+func TestGrooveExitCode_Dynamics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "groove")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "backbeat.mid")
+	assertExitCode(t, 0, "", "groove", "--dynamics", "ff..p", "--midi", path, "backbeat")
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+	notes, err := midi.Read(f)
+	assert.Nil(t, err)
+	assert.True(t, notes[0].Velocity > notes[len(notes)-1].Velocity)
+	assertExitCode(t, 1, "Error occurred: unknown dynamic \"ppp\", expected one of pp, p, mp, mf, f, ff\n", "groove", "--dynamics", "ppp", "--midi", path, "backbeat")
+}
+
+func TestGrooveExitCode_Hydrogen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "groove")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "bossa.h2pattern")
+	assertExitCode(t, 0, "", "groove", "--hydrogen", path, "bossa")
+	b, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), "<pattern_name>bossa</pattern_name>")
+	assertExitCode(t, 1, "Error occurred: open "+filepath.Join(dir, "missing", "bossa.h2pattern")+": no such file or directory\n", "groove", "--hydrogen", filepath.Join(dir, "missing", "bossa.h2pattern"), "bossa")
+}
+
+func TestGrooveExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "groove", "backbeat")
+	assertExitCode(t, 0, "", "groove", "--swing", "60", "shuffle")
+	assertExitCode(t, 1, "Error occurred: unknown pattern \"polka\"\n", "groove", "polka")
+	assertExitCode(t, 1, "Error occurred: out of range swing 90%, expected 50% to 75%\n", "groove", "--swing", "90", "bossa")
+}
@@ -0,0 +1,32 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/guide"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// soloingGuide over some chord names, each argument one or several separated by spaces, e.g. "Dm7 G7 Cmaj7", in a key, or if the key name is empty, the key of the progression, each chord for some beats
+func soloingGuide(args []string, keyName string, beats float64) (guide.Guide, error) {
+	var p progression.Progression
+	for _, name := range strings.Fields(strings.Join(args, " ")) {
+		c, err := chord.Parse(name)
+		if err != nil {
+			return guide.Guide{}, err
+		}
+		p.Chords = append(p.Chords, c)
+	}
+	var k key.Key
+	if len(keyName) > 0 {
+		var err error
+		k, err = key.Parse(keyName)
+		if err != nil {
+			return guide.Guide{}, err
+		}
+	}
+	return guide.Of(p, k, guide.WithBeats(beats)), nil
+}
@@ -0,0 +1,120 @@
+// A soloing guide over a chord progression targets the tones of each chord as it sounds, its third and seventh above all, the guide tones that tell one chord from the next,
+// approaching each from a semitone below or the next tone above of a scale that fits the chord, e.g. the dorian of a minor seventh chord on the second degree of a major key.
+//
+// https://en.wikipedia.org/wiki/Chord-scale_system
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package guide
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// MaxTargets of each chord, its guide tones
+const MaxTargets = 2
+
+// Guide to soloing over a progression in a key, each chord in turn on a timeline of beats
+type Guide struct {
+	Key   key.Key
+	Steps []Step
+}
+
+// Step of a guide, a chord sounding from a beat, counted from 0 of the first, for some beats, the tones to target, and the scale choices, from the best, e.g. "D dorian" of Dm7 in C major
+type Step struct {
+	Chord   chord.Chord
+	Beat    float64
+	Beats   float64
+	Targets []Target
+	Scales  []string
+}
+
+// Target tone of a chord, by its degree, e.g. b3, approached from the semitone below, or from the next tone above of the first scale choice, or the semitone above of none
+type Target struct {
+	Class  note.Class
+	Degree chord.Degree
+	Below  note.Class
+	Above  note.Class
+}
+
+// Of a progression in a key, or if the key is Nil, the key of the progression, a guide to soloing over each chord, for a bar of 4 beats, or with an Option, e.g. WithBeats(2)
+func Of(p progression.Progression, k key.Key, opts ...Option) Guide {
+	o := optionsOf(opts)
+	if k.Mode == key.Nil && len(p.Chords) > 0 {
+		k = p.Key()
+	}
+	g := Guide{Key: k}
+	for n, c := range p.Chords {
+		s := Step{Chord: c, Beat: float64(n) * o.beats, Beats: o.beats, Scales: ScalesOf(c, k)}
+		var choice scale.Scale
+		if len(s.Scales) > 0 {
+			choice = scale.Of(s.Scales[0])
+		}
+		for _, t := range targetsOf(c) {
+			s.Targets = append(s.Targets, Target{Class: t.Class, Degree: t.Degree, Below: below(t.Class), Above: above(t.Class, choice)})
+		}
+		g.Steps = append(g.Steps, s)
+	}
+	return g
+}
+
+// AdjSymbol of the notes of a guide, that of its key, or of the first chord if it has no key
+func (g Guide) AdjSymbol() note.AdjSymbol {
+	if g.Key.Root == note.Nil && len(g.Steps) > 0 {
+		return g.Steps[0].Chord.AdjSymbol
+	}
+	return g.Key.AdjSymbol
+}
+
+//
+// Private
+//
+
+// targetIntervals of a chord, in order of preference, its third and seventh, else what sounds in their place, a sixth or a suspension, else its fifth and root
+var targetIntervals = []chord.Interval{chord.I3, chord.I7, chord.I6, chord.I4, chord.I2, chord.I5, chord.I1}
+
+// targetsOf a chord, up to the MaxTargets of its tones in order of the targetIntervals, in ascending order of interval, e.g. F and C of Dm7
+func targetsOf(c chord.Chord) []chord.Tone {
+	tones := map[chord.Interval]chord.Tone{}
+	for _, t := range c.OrderedTones() {
+		tones[t.Degree.Interval] = t
+	}
+	var targets []chord.Tone
+	for _, i := range targetIntervals {
+		if t, ok := tones[i]; ok && len(targets) < MaxTargets {
+			targets = append(targets, t)
+		}
+	}
+	sort.Slice(targets, func(a, b int) bool { return targets[a].Degree.Interval < targets[b].Degree.Interval })
+	return targets
+}
+
+// below a target, the semitone approaching it chromatically
+func below(class note.Class) note.Class {
+	b, _ := class.Step(-1)
+	return b
+}
+
+// above a target, the next tone up of a scale, approaching it diatonically, or the semitone above if the scale has no other tone within a whole step
+func above(class note.Class, s scale.Scale) note.Class {
+	for semitones := 1; semitones <= 2; semitones++ {
+		if a, _ := class.Step(semitones); s.Contains(a) {
+			return a
+		}
+	}
+	a, _ := class.Step(1)
+	return a
+}
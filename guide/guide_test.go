@@ -0,0 +1,73 @@
+// A soloing guide over a chord progression targets the tones of each chord as it sounds, its third and seventh above all, the guide tones that tell one chord from the next,
+package guide
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestOf(t *testing.T) {
+	g := Of(progression.Of("Dm7", "G7", "Cmaj7"), key.Key{})
+	assert.Equal(t, key.Of("C"), g.Key)
+	assert.Equal(t, 3, len(g.Steps))
+	assert.Equal(t, 0.0, g.Steps[0].Beat)
+	assert.Equal(t, 4.0, g.Steps[1].Beat)
+	assert.Equal(t, 8.0, g.Steps[2].Beat)
+	assert.Equal(t, 4.0, g.Steps[2].Beats)
+	assert.Equal(t, []Target{
+		{Class: note.F, Degree: chord.Flat3, Below: note.E, Above: note.G},
+		{Class: note.C, Degree: chord.Flat7, Below: note.B, Above: note.D},
+	}, g.Steps[0].Targets)
+	assert.Equal(t, []Target{
+		{Class: note.B, Degree: chord.Degree{Interval: chord.I3}, Below: note.As, Above: note.C},
+		{Class: note.F, Degree: chord.Flat7, Below: note.E, Above: note.G},
+	}, g.Steps[1].Targets)
+	assert.Equal(t, []string{"G mixolydian"}, g.Steps[1].Scales)
+}
+
+func TestOf_Key(t *testing.T) {
+	g := Of(progression.Of("Am", "E7", "Am"), key.Of("C"), WithBeats(2))
+	assert.Equal(t, key.Of("C"), g.Key)
+	assert.Equal(t, 4.0, g.Steps[2].Beat)
+	assert.Equal(t, "A minor", g.Steps[0].Scales[0], "the mode of the key first")
+	assert.Equal(t, 0, len(Of(progression.Progression{}, key.Key{}).Steps))
+}
+
+func TestOf_Targets(t *testing.T) {
+	for name, degrees := range map[string][]chord.Degree{
+		"C":     {{Interval: chord.I3}, {Interval: chord.I5}},
+		"C6":    {{Interval: chord.I3}, {Interval: chord.I6}},
+		"Csus4": {{Interval: chord.I4}, {Interval: chord.I5}},
+		"Cm6":   {chord.Flat3, {Interval: chord.I6}},
+		"C9":    {{Interval: chord.I3}, chord.Flat7},
+	} {
+		var actual []chord.Degree
+		for _, tg := range Of(progression.Of(name), key.Of("C")).Steps[0].Targets {
+			actual = append(actual, tg.Degree)
+		}
+		assert.Equal(t, degrees, actual, name)
+	}
+}
+
+func TestGuide_AdjSymbol(t *testing.T) {
+	assert.Equal(t, note.Flat, Of(progression.Of("Bb7"), key.Of("F")).AdjSymbol())
+	assert.Equal(t, note.Sharp, Of(progression.Of("E7"), key.Of("A")).AdjSymbol())
+}
+
+func TestAbove(t *testing.T) {
+	assert.Equal(t, note.F, above(note.E, scale.Of("C major")))
+	assert.Equal(t, note.D, above(note.C, scale.Of("C major")))
+	assert.Equal(t, note.Cs, above(note.C, scale.Scale{}), "a semitone above of no scale")
+}
+
+func TestBelow(t *testing.T) {
+	assert.Equal(t, note.B, below(note.C))
+	assert.Equal(t, note.E, below(note.F))
+}
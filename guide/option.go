@@ -0,0 +1,30 @@
+// Guides give each chord a bar of 4 beats, or with an Option, e.g. 2 beats each with Of(p, k, WithBeats(2))
+package guide
+
+// Option for a guide
+type Option func(*options)
+
+// WithBeats that each chord of a guide sounds for, unless they're not more than 0
+func WithBeats(beats float64) Option {
+	return func(o *options) {
+		if beats > 0 {
+			o.beats = beats
+		}
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	beats float64
+}
+
+func optionsOf(opts []Option) options {
+	o := &options{beats: 4}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
@@ -0,0 +1,14 @@
+// Guides give each chord a bar of 4 beats, or with an Option, e.g. 2 beats each with Of(p, k, WithBeats(2))
+package guide
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestOptionsOf(t *testing.T) {
+	assert.Equal(t, options{beats: 4}, optionsOf(nil))
+	assert.Equal(t, options{beats: 2}, optionsOf([]Option{WithBeats(2)}))
+	assert.Equal(t, options{beats: 4}, optionsOf([]Option{WithBeats(0)}), "more than 0 beats")
+}
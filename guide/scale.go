@@ -0,0 +1,42 @@
+// Scale choices of a chord are the scales on its root containing every one of its tones, the mode of the key first, if it's one of them, e.g. D dorian of Dm7 in C major
+package guide
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Modes of the scales chosen on the root of a chord, by their name after the root, from the most to the least common of those containing the same chord
+var Modes = scale.List{
+	"major", "lydian", "mixolydian",
+	"dorian", "minor", "phrygian", "locrian",
+	"harmonic minor", "melodic minor ascend",
+	"diminished", "augmented",
+}
+
+// ScalesOf a chord in a key, the name of each of the Modes on its root containing every tone of the chord, in order, but the one of the same tones as the key first, e.g. "G mixolydian" of G7 in C major
+func ScalesOf(c chord.Chord, k key.Key) []string {
+	if c.Root == note.Nil {
+		return nil
+	}
+	adj := k.AdjSymbol
+	if k.Mode == key.Nil {
+		adj = c.AdjSymbol
+	}
+	diatonic := k.Scale().ToneSet()
+	var names []string
+	for _, mode := range Modes {
+		name := c.Root.String(adj) + " " + mode
+		s := scale.Of(name)
+		switch {
+		case !s.ContainsChord(c):
+		case k.Mode != key.Nil && s.ToneSet().Equal(diatonic):
+			names = append([]string{name}, names...)
+		default:
+			names = append(names, name)
+		}
+	}
+	return names
+}
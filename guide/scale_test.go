@@ -0,0 +1,21 @@
+// Scale choices of a chord are the scales on its root containing every one of its tones, the mode of the key first, if it's one of them, e.g. D dorian of Dm7 in C major
+package guide
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+)
+
+func TestScalesOf(t *testing.T) {
+	assert.Equal(t, []string{"D dorian", "D minor", "D phrygian"}, ScalesOf(chord.Of("Dm7"), key.Of("C")))
+	assert.Equal(t, []string{"D minor", "D dorian", "D phrygian"}, ScalesOf(chord.Of("Dm7"), key.Of("F")), "the mode of the key first")
+	assert.Equal(t, []string{"G mixolydian"}, ScalesOf(chord.Of("G7"), key.Of("C")))
+	assert.Equal(t, []string{"B locrian"}, ScalesOf(chord.Of("Bm7b5"), key.Of("C")))
+	assert.Equal(t, []string{"F lydian", "F major", "F augmented"}, ScalesOf(chord.Of("Fmaj7"), key.Of("C")))
+	assert.Equal(t, []string{"B diminished"}, ScalesOf(chord.Of("Bdim7"), key.Key{}))
+	assert.Equal(t, 0, len(ScalesOf(chord.Chord{}, key.Of("C"))))
+}
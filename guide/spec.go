@@ -0,0 +1,67 @@
+// Guides are expressed with their Key, and each step on the timeline, its beat and beats, chord, target tones with their approaches, and scale choices
+package guide
+
+import (
+	"encoding/json"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ToYAML of the Guide, e.g. for the command-line utility
+func (g Guide) ToYAML() string {
+	spec := specFrom(g)
+	out, _ := yaml.Marshal(spec)
+	return string(out[:])
+}
+
+// ToJSON of the Guide, e.g. for an app to practice improvising
+func (g Guide) ToJSON() string {
+	spec := specFrom(g)
+	out, _ := json.Marshal(spec)
+	return string(out[:])
+}
+
+//
+// Private
+//
+
+func specFrom(g Guide) specGuide {
+	s := specGuide{}
+	s.Key.Root = g.Key.Root.String(g.Key.AdjSymbol)
+	s.Key.Mode = g.Key.Mode.String()
+	adj := g.AdjSymbol()
+	s.Steps = make([]specStep, 0, len(g.Steps))
+	for _, st := range g.Steps {
+		ss := specStep{Beat: st.Beat, Beats: st.Beats, Chord: st.Chord.Name(), Targets: make([]specTarget, 0, len(st.Targets)), Scales: st.Scales}
+		for _, t := range st.Targets {
+			ss.Targets = append(ss.Targets, specTarget{Tone: t.Class.String(adj), Degree: t.Degree.String(), Below: t.Below.String(adj), Above: t.Above.String(adj)})
+		}
+		s.Steps = append(s.Steps, ss)
+	}
+	return s
+}
+
+type specGuide struct {
+	Key   specKey    `json:"key"`
+	Steps []specStep `json:"steps"`
+}
+
+type specKey struct {
+	Root string `json:"root"`
+	Mode string `json:"mode"`
+}
+
+type specStep struct {
+	Beat    float64      `json:"beat"`
+	Beats   float64      `json:"beats"`
+	Chord   string       `json:"chord"`
+	Targets []specTarget `json:"targets"`
+	Scales  []string     `json:"scales"`
+}
+
+type specTarget struct {
+	Tone   string `json:"tone"`
+	Degree string `json:"degree"`
+	Below  string `json:"below"`
+	Above  string `json:"above"`
+}
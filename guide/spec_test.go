@@ -0,0 +1,27 @@
+// Guides are expressed with their Key, and each step on the timeline, its beat and beats, chord, target tones with their approaches, and scale choices
+package guide
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestGuide_ToYAML(t *testing.T) {
+	g := Of(progression.Of("G7"), key.Of("C"), WithBeats(2))
+	assert.Equal(t, "key:\n  root: C\n  mode: Major\nsteps:\n"+
+		"- beat: 0\n  beats: 2\n  chord: G7\n  targets:\n"+
+		"  - tone: B\n    degree: \"3\"\n    below: A#\n    above: C\n"+
+		"  - tone: F\n    degree: b7\n    below: E\n    above: G\n"+
+		"  scales:\n  - G mixolydian\n", g.ToYAML())
+}
+
+func TestGuide_ToJSON(t *testing.T) {
+	g := Of(progression.Of("Dm7", "G7"), key.Of("C"))
+	assert.Equal(t, `{"key":{"root":"C","mode":"Major"},"steps":[`+
+		`{"beat":0,"beats":4,"chord":"Dm7","targets":[{"tone":"F","degree":"b3","below":"E","above":"G"},{"tone":"C","degree":"b7","below":"B","above":"D"}],"scales":["D dorian","D minor","D phrygian"]},`+
+		`{"beat":4,"beats":4,"chord":"G7","targets":[{"tone":"B","degree":"3","below":"A#","above":"C"},{"tone":"F","degree":"b7","below":"E","above":"G"}],"scales":["G mixolydian"]}]}`, g.ToJSON())
+}
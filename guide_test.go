@@ -0,0 +1,34 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+)
+
+func TestSoloingGuide(t *testing.T) {
+	g, err := soloingGuide([]string{"Dm7 G7", "Cmaj7"}, "", 4)
+	assert.Nil(t, err)
+	assert.Equal(t, key.Of("C"), g.Key)
+	assert.Equal(t, 3, len(g.Steps))
+	assert.Equal(t, 8.0, g.Steps[2].Beat)
+	g, err = soloingGuide([]string{"Am", "E7", "Am"}, "C", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, key.Of("C"), g.Key)
+	assert.Equal(t, 4.0, g.Steps[2].Beat)
+	_, err = soloingGuide([]string{"Hb"}, "", 4)
+	assert.NotNil(t, err)
+	_, err = soloingGuide([]string{"C"}, "H major", 4)
+	assert.NotNil(t, err)
+}
+
+func TestGuideExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "guide")
+	assertExitCode(t, 0, "", "guide", "Dm7 G7 Cmaj7")
+	assertExitCode(t, 0, "", "guide", "--key", "Am", "--beats", "2", "--format", "json", "Bm7b5", "E7", "Am")
+	assertExitCode(t, 1, "Error occurred: unknown root \"Hb\" at position 0 of chord \"Hb\"\n", "guide", "Hb")
+	assertExitCode(t, 1, "Error occurred: unsupported value guide.Guide in format \"svg\"\n", "guide", "--format", "svg", "C")
+}
@@ -0,0 +1,65 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/harmonize"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+)
+
+// readTuneFile at a path, as MIDI if it's named .mid or .midi, the melody of a score if it's MusicXML, or else as ABC notation
+func readTuneFile(path string) (melody.Tune, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".musicxml", ".xml", ".mxl":
+		s, err := readSongFile(path)
+		if err != nil {
+			return melody.Tune{}, err
+		}
+		return s.Tune(), nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return melody.Tune{}, err
+	}
+	defer f.Close()
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".mid", ".midi":
+		return melody.ReadMIDI(f)
+	}
+	return melody.ReadABC(f)
+}
+
+// harmonizeMelody of a tune, in a key named, or else the key of the tune, or else the key guessed from its notes,
+// in a style named, with each chord spanning some beats, or if 0, a bar of the tune, writing some of the alternatives, ranked from the best
+func harmonizeMelody(w io.Writer, tune melody.Tune, keyName string, styleName string, beats float64, count int) error {
+	k := tune.Key
+	if len(keyName) > 0 {
+		var err error
+		if k, err = key.Parse(keyName); err != nil {
+			return err
+		}
+	} else if k.Mode == key.Nil {
+		k = harmonize.KeyOf(tune.Notes)
+	}
+	if beats <= 0 {
+		beats = float64(tune.Meter.Beats)
+	}
+	style, err := harmonize.StyleNamed(styleName, beats)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Key: %s %s\n", k.Root.String(k.AdjSymbol), strings.ToLower(k.Mode.String()))
+	for n, h := range harmonize.Melody(tune.Notes, k, style) {
+		if n == count {
+			break
+		}
+		fmt.Fprintf(w, "%d. %s\n   %s\n", n+1, h.String(), h.Numerals())
+	}
+	return nil
+}
@@ -0,0 +1,364 @@
+// Harmonization is the proposal of chords to accompany a melody, here the diatonic chords of its key,
+// chosen for how many of the notes they cover, and how well each leads to the next by its function in the key.
+//
+// https://en.wikipedia.org/wiki/Harmonization
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package harmonize
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/roman"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Alternatives of a melody, at most, ranked from the best
+const Alternatives = 5
+
+// DefaultBeats of each chord of a style that doesn't say, i.e. a chord per bar of 4/4
+const DefaultBeats = 4.0
+
+// ErrUnknownStyle when naming a style that isn't built in, e.g. "bebop"
+var ErrUnknownStyle = errors.New("unknown style")
+
+// Style of a harmonization, the diatonic chords it chooses from, and how many beats each chord spans
+type Style struct {
+	Degrees  []int   // of the key, from 1 for the tonic, or if none, every degree
+	Sevenths bool    // chooses seventh chords, instead of triads
+	Beats    float64 // spanned by each chord, e.g. 4 for a chord per bar of 4/4, or if 0, DefaultBeats
+}
+
+// Styles built in, by name
+var Styles = map[string]Style{
+	"primary":  {Degrees: []int{1, 4, 5}},          // only the primary triads, I, IV and V
+	"triads":   {Degrees: []int{1, 2, 3, 4, 5, 6}}, // all but the diminished vii°, rarely heard but as a dominant seventh without its root
+	"sevenths": {Sevenths: true},
+}
+
+// Harmonization of a melody, the chord for each span of its beats, in order, and the score of them all, higher being better
+type Harmonization struct {
+	Chords []Choice
+	Score  float64
+}
+
+// Choice of a chord for some beats of a melody
+type Choice struct {
+	Beat    float64 // from 0 at the beginning
+	Degree  int     // of the key, from 1 for the tonic
+	Numeral string  // roman, as roman.Analyze writes it in the key, e.g. "V", "ii7" or "vii°"
+	Name    string  // e.g. "G7"
+	Chord   chord.Chord
+}
+
+// StyleNamed one of the Styles, with some beats spanned by each chord, or if 0, DefaultBeats, e.g. StyleNamed("sevenths", 2)
+func StyleNamed(name string, beats float64) (Style, error) {
+	s, ok := Styles[name]
+	if !ok {
+		return Style{}, fmt.Errorf("%w %q, expected one of %s", ErrUnknownStyle, name, strings.Join(StyleNames(), ", "))
+	}
+	s.Beats = beats
+	return s, nil
+}
+
+// StyleNames of the Styles built in, in alphabetical order
+func StyleNames() []string {
+	var names []string
+	for name := range Styles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Melody harmonized in a key, in a style, ranking the Alternatives from the best, each a chord for every span of the beats of the style,
+// scored by the notes of each span that are its chord tones, more so on the downbeat, less any non-chord tones, forgiving a passing or neighbor tone,
+// suspension or appoggiatura, plus the motion between chords by their functions, e.g. from the dominant V to the tonic I, and beginning and ending on the tonic,
+// less a bias against the weaker chords, iii and vii
+func Melody(notes []melody.Note, k key.Key, style Style) []Harmonization {
+	beats := style.Beats
+	if beats <= 0 {
+		beats = DefaultBeats
+	}
+	candidates := candidatesOf(k, style)
+	spans := spansOf(notes, beats)
+	if len(spans) == 0 || len(candidates) == 0 {
+		return nil
+	}
+	beam := []path{{}}
+	for s := range spans {
+		var next []path
+		for _, p := range beam {
+			for c := range candidates {
+				score := p.score + coverageOf(notes, spans[s], candidates[c].Chord, float64(s)*beats) + biases[candidates[c].Degree]
+				if len(p.chords) > 0 {
+					score += motionOf(candidates[p.chords[len(p.chords)-1]], candidates[c])
+				} else if candidates[c].Degree == 1 {
+					score += beginBonus
+				}
+				if s == len(spans)-1 && candidates[c].Degree == 1 {
+					score += endBonus
+				}
+				next = append(next, path{chords: append(append([]int{}, p.chords...), c), score: score})
+			}
+		}
+		sort.SliceStable(next, func(i, j int) bool { return next[i].score > next[j].score })
+		if len(next) > beamWidth {
+			next = next[:beamWidth]
+		}
+		beam = next
+	}
+	var harmonizations []Harmonization
+	for _, p := range beam {
+		if len(harmonizations) == Alternatives {
+			break
+		}
+		h := Harmonization{Score: p.score}
+		for s, c := range p.chords {
+			choice := candidates[c]
+			choice.Beat = float64(s) * beats
+			h.Chords = append(h.Chords, choice)
+		}
+		harmonizations = append(harmonizations, h)
+	}
+	return harmonizations
+}
+
+// String of the chords of the harmonization, separated by bars, e.g. "C | F | G7 | C"
+func (h Harmonization) String() string {
+	var names []string
+	for _, c := range h.Chords {
+		names = append(names, c.Name)
+	}
+	return strings.Join(names, " | ")
+}
+
+// Numerals of the chords of the harmonization, separated by bars, e.g. "I | IV | V | I"
+func (h Harmonization) Numerals() string {
+	var numerals []string
+	for _, c := range h.Chords {
+		numerals = append(numerals, c.Numeral)
+	}
+	return strings.Join(numerals, " | ")
+}
+
+// Harmony of the chords of the harmonization, from the beat of each, e.g. to analyze the melody with them
+func (h Harmonization) Harmony() []melody.Harmony {
+	var harmony []melody.Harmony
+	for _, c := range h.Chords {
+		harmony = append(harmony, melody.Harmony{Chord: c.Chord, Beat: c.Beat})
+	}
+	return harmony
+}
+
+//
+// Private
+//
+
+// Scores of the heuristics, relative to a chord tone on a beat of a span, which scores 1
+const (
+	beamWidth      = 32
+	downbeatWeight = 2.0  // of a chord tone or non-chord tone beginning the span
+	forgivenWeight = 0.25 // of a passing or neighbor tone, suspension or appoggiatura
+	beginBonus     = 1.0  // of beginning on the tonic
+	endBonus       = 4.0  // of ending on the tonic
+	repeatPenalty  = 0.25 // of a chord repeated from the span before
+)
+
+// function of a diatonic chord in the key, by its degree
+type function int
+
+const (
+	tonic       function = iota // I, iii or vi
+	subdominant                 // ii or IV
+	dominant                    // V or vii°
+)
+
+// motions from the function of one chord to the next
+var motions = map[function]map[function]float64{
+	tonic:       {tonic: 0, subdominant: 1, dominant: 0.5},
+	subdominant: {tonic: 0.25, subdominant: 0, dominant: 1},
+	dominant:    {tonic: 1.5, subdominant: -0.5, dominant: 0},
+}
+
+// biases of each degree of the key, against the weaker chords, iii and vii, which are heard less often than the others
+var biases = map[int]float64{3: -0.5, 7: -1}
+
+// path of the beam search, the index of the candidate chosen for each span so far, and their score
+type path struct {
+	chords []int
+	score  float64
+}
+
+// span of the notes of a melody, the indexes of those sounding in some beats, from the first to the last
+type span struct {
+	first, last int
+}
+
+// candidatesOf chords of a style in a key, the diatonic triad or seventh chord on each of its degrees, spelled in the key
+func candidatesOf(k key.Key, style Style) []Choice {
+	adjSymbol := note.Sharp
+	if k.Fifths() < 0 {
+		adjSymbol = note.Flat
+	}
+	mode := " major"
+	if k.Mode == key.Minor {
+		mode = " minor"
+	}
+	s := scale.Of(k.Root.String(adjSymbol) + mode)
+	degrees := style.Degrees
+	if len(degrees) == 0 {
+		degrees = []int{1, 2, 3, 4, 5, 6, 7}
+	}
+	var choices []Choice
+	for _, d := range degrees {
+		root := s.Tones[scale.Interval(d)]
+		third := root.Diff(s.Tones[scale.Interval((d+1)%7+1)])
+		fifth := root.Diff(s.Tones[scale.Interval((d+3)%7+1)])
+		seventh := root.Diff(s.Tones[scale.Interval((d+5)%7+1)])
+		if root == note.Nil {
+			continue
+		}
+		suffix := triadSuffixOf(third, fifth)
+		if style.Sevenths {
+			suffix = seventhSuffixOf(third, fifth, seventh)
+		}
+		name := root.String(adjSymbol) + suffix
+		c := chord.Of(name).SpelledIn(k)
+		r, _ := roman.Analyze(c, k)
+		choices = append(choices, Choice{Degree: d, Numeral: r.String(), Name: name, Chord: c})
+	}
+	return choices
+}
+
+// spansOf a melody, the notes beginning in each span of some beats, for every span from the beginning to the end of the last note
+func spansOf(notes []melody.Note, beats float64) []span {
+	end := 0.0
+	for _, n := range notes {
+		if n.Beat+n.Beats > end {
+			end = n.Beat + n.Beats
+		}
+	}
+	count := int(end / beats)
+	if float64(count)*beats < end {
+		count++
+	}
+	spans := make([]span, count)
+	for s := range spans {
+		spans[s] = span{-1, -1}
+	}
+	for i, n := range notes {
+		s := int(n.Beat / beats)
+		if s < 0 || s >= count {
+			continue
+		}
+		if spans[s].first < 0 {
+			spans[s].first = i
+		}
+		spans[s].last = i
+	}
+	return spans
+}
+
+// coverageOf the notes of a span by a chord beginning at a beat, the beats of each chord tone less those of each non-chord tone,
+// weighted on the downbeat, and forgiving a non-chord tone by its role, which depends on the notes just outside the span too
+func coverageOf(notes []melody.Note, s span, c chord.Chord, beat float64) float64 {
+	if s.first < 0 {
+		return 0
+	}
+	from, to := s.first, s.last+1
+	if from > 0 {
+		from--
+	}
+	if to < len(notes) {
+		to++
+	}
+	score := 0.0
+	for i, a := range melody.Analyze(notes[from:to], []melody.Harmony{{Chord: c, Beat: beat}}) {
+		if i+from < s.first || i+from > s.last {
+			continue
+		}
+		weight := a.Note.Beats
+		if a.Note.Beat == beat {
+			weight *= downbeatWeight
+		}
+		switch a.Role {
+		case melody.ChordTone:
+			score += weight
+		case melody.Passing, melody.Neighbor, melody.Suspension, melody.Appoggiatura:
+			score -= weight * forgivenWeight
+		default:
+			score -= weight
+		}
+	}
+	return score
+}
+
+// motionOf the harmony from one chord to the next, by their functions, less a penalty for repeating the same chord
+func motionOf(from, to Choice) float64 {
+	score := motions[functionOf(from.Degree)][functionOf(to.Degree)]
+	if from.Degree == to.Degree {
+		score -= repeatPenalty
+	}
+	return score
+}
+
+// functionOf a degree of the key
+func functionOf(degree int) function {
+	switch degree {
+	case 2, 4:
+		return subdominant
+	case 5, 7:
+		return dominant
+	}
+	return tonic
+}
+
+// triadSuffixOf a chord name, by the semitones up from its root to its third and fifth, e.g. "m" for a minor triad
+func triadSuffixOf(third, fifth int) string {
+	third, fifth = (third+12)%12, (fifth+12)%12
+	switch {
+	case third == 3 && fifth == 6:
+		return "dim"
+	case third == 4 && fifth == 8:
+		return "aug"
+	case third == 3:
+		return "m"
+	}
+	return ""
+}
+
+// seventhSuffixOf a chord name, by the semitones up from its root to its third, fifth and seventh, e.g. "m7b5" for a half-diminished seventh chord
+func seventhSuffixOf(third, fifth, seventh int) string {
+	third, fifth, seventh = (third+12)%12, (fifth+12)%12, (seventh+12)%12
+	switch {
+	case third == 3 && fifth == 6 && seventh == 9:
+		return "dim7"
+	case third == 3 && fifth == 6:
+		return "m7b5"
+	case third == 4 && fifth == 8:
+		return "maj7#5"
+	case third == 3 && seventh == 11:
+		return "mMaj7"
+	case third == 3:
+		return "m7"
+	case seventh == 11:
+		return "maj7"
+	}
+	return "7"
+}
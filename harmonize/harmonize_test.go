@@ -0,0 +1,95 @@
+// Harmonization is the proposal of chords to accompany a melody, here the diatonic chords of its key,
+package harmonize
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestMelody(t *testing.T) {
+	hs := Melody(twinkle(t), key.Of("C"), Styles["primary"])
+	assert.Equal(t, Alternatives, len(hs))
+	assert.Equal(t, "C | F | F | G | C | C | G | C | C | F | F | C", hs[0].String())
+	assert.Equal(t, "I | IV | IV | V | I | I | V | I | I | IV | IV | I", hs[0].Numerals())
+	for n := 1; n < len(hs); n++ {
+		assert.True(t, hs[n-1].Score >= hs[n].Score)
+		assert.NotEqual(t, hs[n-1].String(), hs[n].String())
+	}
+}
+
+func TestMelody_Beats(t *testing.T) {
+	s, err := StyleNamed("triads", 2)
+	assert.Nil(t, err)
+	h := Melody(twinkle(t), key.Of("C"), s)[0]
+	assert.Equal(t, 24, len(h.Chords))
+	assert.Equal(t, 2.0, h.Chords[1].Beat)
+	assert.Equal(t, "C", h.Chords[0].Name)
+	assert.Equal(t, "C", h.Chords[23].Name)
+	assert.Equal(t, 1, h.Chords[23].Degree)
+}
+
+func TestMelody_Sevenths(t *testing.T) {
+	h := Melody(melody.NotesOf("D4", "F4", "A4", "C5", "B4", "D5", "G4", "F4", "E4", "G4", "C5", "G4"), key.Of("C"), Styles["sevenths"])[0]
+	assert.Equal(t, "Dm7 | G7 | Cmaj7", h.String())
+	assert.Equal(t, "ii7 | V7 | Imaj7", h.Numerals())
+	assert.Equal(t, 3, len(h.Harmony()))
+	assert.Equal(t, note.G, h.Harmony()[1].Chord.Root)
+	assert.Equal(t, 4.0, h.Harmony()[1].Beat)
+}
+
+func TestMelody_Minor(t *testing.T) {
+	h := Melody(melody.NotesOf("A4", "C5", "E5", "C5", "D5", "F5", "A5", "F5", "E5", "B4", "E5", "B4", "A4", "E4", "C5", "A4"), key.Of("A minor"), Styles["triads"])[0]
+	assert.Equal(t, "Am | Dm | Em | Am", h.String())
+	assert.Equal(t, "i | iv | v | i", h.Numerals())
+}
+
+func TestMelody_Flats(t *testing.T) {
+	h := Melody(melody.NotesOf("F4", "A4", "C5", "A4", "A#4", "D5", "F5", "D5", "C5", "E5", "G5", "E5", "F5", "C5", "A4", "F4"), key.Of("F"), Styles["primary"])[0]
+	assert.Equal(t, "F | Bb | C | F", h.String())
+}
+
+func TestMelody_Empty(t *testing.T) {
+	assert.Nil(t, Melody(nil, key.Of("C"), Styles["triads"]))
+	h := Melody([]melody.Note{{Class: note.C, Octave: 4, Beat: 4, Beats: 4}}, key.Of("C"), Styles["primary"])[0]
+	assert.Equal(t, 2, len(h.Chords), "a chord for the silence before the first note")
+	assert.Equal(t, "C", h.Chords[1].Name)
+}
+
+func TestStyleNamed(t *testing.T) {
+	s, err := StyleNamed("sevenths", 3)
+	assert.Nil(t, err)
+	assert.True(t, s.Sevenths)
+	assert.Equal(t, 3.0, s.Beats)
+	_, err = StyleNamed("bebop", 0)
+	assert.True(t, errors.Is(err, ErrUnknownStyle))
+	assert.Equal(t, `unknown style "bebop", expected one of primary, sevenths, triads`, err.Error())
+	assert.Equal(t, 4.0, Styles["sevenths"].Beats+DefaultBeats)
+}
+
+func TestSeventhSuffixOf(t *testing.T) {
+	assert.Equal(t, "maj7", seventhSuffixOf(4, 7, 11))
+	assert.Equal(t, "7", seventhSuffixOf(4, 7, 10))
+	assert.Equal(t, "m7", seventhSuffixOf(3, 7, 10))
+	assert.Equal(t, "m7b5", seventhSuffixOf(3, 6, 10))
+	assert.Equal(t, "dim7", seventhSuffixOf(3, 6, 9))
+	assert.Equal(t, "mMaj7", seventhSuffixOf(3, 7, 11))
+	assert.Equal(t, "maj7#5", seventhSuffixOf(4, 8, 11))
+}
+
+//
+// Private
+//
+
+// twinkle, twinkle, little star
+func twinkle(t *testing.T) []melody.Note {
+	tune, err := melody.ReadABC(strings.NewReader("L:1/4\nK:C\nCCGG|AAG2|FFEE|DDC2|GGFF|EED2|GGFF|EED2|CCGG|AAG2|FFEE|DDC2|]\n"))
+	assert.Nil(t, err)
+	return tune.Notes
+}
@@ -0,0 +1,81 @@
+// The key of a melody that doesn't say is guessed from the notes of it that are in the scale of each key, and how it begins and ends
+package harmonize
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+// KeyOf a melody, the major or minor key whose scale sounds most of its beats, favoring its tonic, especially as the first and last note,
+// or a major key over its relative minor if they score the same, or else C major for no notes
+func KeyOf(notes []melody.Note) key.Key {
+	best, _ := candidate.Best(KeysOf(notes))
+	return best
+}
+
+// KeysOf a melody, every major and minor key, ranked by how many of its beats the scale of each sounds, favoring its tonic, especially as the first and last note,
+// each with its confidence among them all, or all of no confidence for no notes, C major first
+func KeysOf(notes []melody.Note) []candidate.Candidate[key.Key] {
+	candidates := make([]candidate.Candidate[key.Key], len(keyNames))
+	for n, name := range keyNames {
+		k := key.Of(name)
+		candidates[n] = candidate.Of(k, keyScoreOf(notes, k))
+	}
+	ranked := candidate.Rank(candidates)
+	if trace.Enabled() {
+		trace.Ranked("harmonize.key", classNamesOf(notes), ranked, key.Key.Canonical)
+	}
+	return ranked
+}
+
+//
+// Private
+//
+
+// keyNames of every major key then every minor key, as it's most commonly spelled
+var keyNames = []string{
+	"C major", "Db major", "D major", "Eb major", "E major", "F major", "F# major", "G major", "Ab major", "A major", "Bb major", "B major",
+	"C minor", "C# minor", "D minor", "Eb minor", "E minor", "F minor", "F# minor", "G minor", "G# minor", "A minor", "Bb minor", "B minor",
+}
+
+// keyScoreOf a melody in a key, the beats of its notes in the scale of the key less those not, plus half the beats of the tonic, and one for each end on it
+func keyScoreOf(notes []melody.Note, k key.Key) float64 {
+	mode := " major"
+	if k.Mode == key.Minor {
+		mode = " minor"
+	}
+	s := scale.Of(k.Root.String(k.AdjSymbol) + mode)
+	score := 0.0
+	for _, n := range notes {
+		switch {
+		case n.Class == k.Root:
+			score += 1.5 * n.Beats
+		case s.Contains(n.Class):
+			score += n.Beats
+		default:
+			score -= n.Beats
+		}
+	}
+	if len(notes) > 0 && notes[0].Class == k.Root {
+		score++
+	}
+	if len(notes) > 0 && notes[len(notes)-1].Class == k.Root {
+		score++
+	}
+	return score
+}
+
+// classNamesOf the notes of a melody, spelled with sharps, e.g. "E D C"
+func classNamesOf(notes []melody.Note) string {
+	names := make([]string, len(notes))
+	for n, m := range notes {
+		names[n] = m.Class.String(note.Sharp)
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,42 @@
+// The key of a melody that doesn't say is guessed from the notes of it that are in the scale of each key, and how it begins and ends
+package harmonize
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestKeyOf(t *testing.T) {
+	assertKey(t, note.C, key.Major, twinkle(t))
+	assertKey(t, note.G, key.Major, melody.NotesOf("G4", "B4", "D5", "F#5", "G5", "D5", "B4", "G4"))
+	assertKey(t, note.A, key.Minor, melody.NotesOf("A4", "C5", "E5", "D5", "C5", "B4", "A4"))
+	assertKey(t, note.F, key.Major, melody.NotesOf("F4", "A4", "C5", "Bb4", "A4", "G4", "F4"))
+	assertKey(t, note.C, key.Major, nil)
+}
+
+func TestKeysOf(t *testing.T) {
+	keys := KeysOf(melody.NotesOf("A4", "C5", "E5", "D5", "C5", "B4", "A4"))
+	assert.Equal(t, 24, len(keys))
+	assert.Equal(t, key.Of("A minor"), keys[0].Value)
+	assert.Equal(t, key.Of("C major"), keys[1].Value) // its relative major, without a tonic to begin or end on
+	assert.True(t, keys[0].Confidence > keys[1].Confidence)
+	assert.Equal(t, 0.0, keys[23].Confidence) // of more beats out of its scale than in it
+	keys = KeysOf(nil)
+	assert.Equal(t, key.Of("C major"), keys[0].Value)
+	assert.Equal(t, 0.0, keys[0].Confidence)
+}
+
+//
+// Private
+//
+
+func assertKey(t *testing.T, root note.Class, mode key.Mode, notes []melody.Note) {
+	k := KeyOf(notes)
+	assert.Equal(t, root, k.Root)
+	assert.Equal(t, mode, k.Mode)
+}
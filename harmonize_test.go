@@ -0,0 +1,91 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestReadTuneFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "harmonize")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	paths := writeTuneFiles(t, dir)
+	abc, err := readTuneFile(paths[0])
+	assert.Nil(t, err)
+	assert.Equal(t, "Twinkle", abc.Title)
+	assert.Equal(t, 14, len(abc.Notes))
+	mid, err := readTuneFile(paths[1])
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(mid.Notes))
+	xml := filepath.Join(dir, "twinkle.musicxml")
+	assert.Nil(t, ioutil.WriteFile(xml, []byte(`<score-partwise><work><work-title>Twinkle</work-title></work><part id="P1"><measure number="1">
+<attributes><divisions>1</divisions><key><fifths>0</fifths></key><time><beats>4</beats><beat-type>4</beat-type></time></attributes>
+<note><pitch><step>C</step><octave>4</octave></pitch><duration>1</duration></note><note><pitch><step>C</step><octave>4</octave></pitch><duration>1</duration></note>
+<note><pitch><step>G</step><octave>4</octave></pitch><duration>1</duration></note><note><pitch><step>G</step><octave>4</octave></pitch><duration>1</duration></note>
+</measure></part></score-partwise>`), 0644))
+	score, err := readTuneFile(xml)
+	assert.Nil(t, err)
+	assert.Equal(t, "Twinkle", score.Title)
+	assert.Equal(t, "C", score.Key.Root.String(score.Key.AdjSymbol))
+	assert.Equal(t, 4, len(score.Notes))
+	_, err = readTuneFile(filepath.Join(dir, "missing.musicxml"))
+	assert.NotNil(t, err)
+	_, err = readTuneFile(filepath.Join(dir, "missing.abc"))
+	assert.NotNil(t, err)
+}
+
+func TestHarmonizeMelody(t *testing.T) {
+	tune, err := melody.ReadABC(strings.NewReader("M:4/4\nL:1/4\nK:C\nCCGG|AAG2|FFEE|DDC2|]\n"))
+	assert.Nil(t, err)
+	var out bytes.Buffer
+	assert.Nil(t, harmonizeMelody(&out, tune, "", "triads", 0, 2))
+	assert.Equal(t, "Key: C major\n1. C | Dm | F | C\n   I | ii | IV | I\n2. C | F | Dm | C\n   I | IV | ii | I\n", out.String())
+	out.Reset()
+	tune.Key.Mode = 0
+	assert.Nil(t, harmonizeMelody(&out, tune, "", "primary", 2, 1))
+	assert.Equal(t, "Key: C major\n1. C | C | F | C | F | C | G | C\n   I | I | IV | I | IV | I | V | I\n", out.String())
+	assert.NotNil(t, harmonizeMelody(&out, tune, "H major", "triads", 0, 1))
+	assert.NotNil(t, harmonizeMelody(&out, tune, "G", "bebop", 0, 1))
+}
+
+func TestHarmonizeMelodyExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "harmonize")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	paths := writeTuneFiles(t, dir)
+	assertExitCode(t, 0, "", "harmonize-melody")
+	assertExitCode(t, 0, "", "harmonize-melody", paths[0])
+	assertExitCode(t, 0, "", "harmonize-melody", "--style", "sevenths", "--beats", "2", "--key", "C", paths[1])
+	assertExitCode(t, 1, "Error occurred: unknown style \"bebop\", expected one of primary, sevenths, triads\n", "harmonize-melody", "--style", "bebop", paths[0])
+	assertExitCode(t, 1, "Error occurred: invalid MIDI file, expected a header beginning MThd\n", "harmonize-melody", paths[2])
+}
+
+//
+// Private
+//
+
+// writeTuneFiles in a directory, of a melody as ABC, as MIDI, and as a .mid file that isn't MIDI
+func writeTuneFiles(t *testing.T, dir string) []string {
+	paths := []string{filepath.Join(dir, "twinkle.abc"), filepath.Join(dir, "twinkle.mid"), filepath.Join(dir, "broken.mid")}
+	assert.Nil(t, ioutil.WriteFile(paths[0], []byte("X:1\nT:Twinkle\nM:4/4\nL:1/4\nK:C\nCCGG|AAG2|FFEE|DDC2|]\n"), 0644))
+	var mid bytes.Buffer
+	assert.Nil(t, midi.Write(&mid, []midi.Note{
+		{Number: 60, Start: 0, Duration: midi.Half},
+		{Number: 65, Start: midi.Half, Duration: midi.Half},
+		{Number: 67, Start: midi.Whole, Duration: midi.Half},
+		{Number: 60, Start: midi.Whole + midi.Half, Duration: midi.Half},
+	}))
+	assert.Nil(t, ioutil.WriteFile(paths[1], mid.Bytes(), 0644))
+	assert.Nil(t, ioutil.WriteFile(paths[2], []byte("X:1\n"), 0644))
+	return paths
+}
@@ -0,0 +1,65 @@
+// Humanize notes that were generated straight on a grid, nudging each earlier or later and softer or louder at random, so they don't sound robotic.
+//
+// https://en.wikipedia.org/wiki/Swing_(jazz_performance_style)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package humanize
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// Apply humanization to notes, without modifying them, moving the start of each by up to some amount of time either way, never before the beginning,
+// and its velocity by up to some jitter either way, from 1 to 127, shuffled by a seed, so the same seed always gives the same notes, e.g. Apply(notes, 10*time.Millisecond, 8, 0)
+func Apply(notes []midi.Note, amount time.Duration, velocityJitter int, seed int64) []midi.Note {
+	return ApplyFrom(notes, amount, velocityJitter, rand.NewSource(seed))
+}
+
+// ApplyFrom a source of random numbers humanization to notes, the same as Apply, the same notes of a source of the same numbers
+func ApplyFrom(notes []midi.Note, amount time.Duration, velocityJitter int, src rand.Source) []midi.Note {
+	r := rand.New(src)
+	ticks := midi.TicksOf(amount)
+	humanized := make([]midi.Note, len(notes))
+	for i, n := range notes {
+		if ticks > 0 {
+			n.Start += r.Intn(2*ticks+1) - ticks
+			if n.Start < 0 {
+				n.Start = 0
+			}
+		}
+		if velocityJitter > 0 {
+			if n.Velocity == 0 {
+				n.Velocity = midi.DefaultVelocity
+			}
+			n.Velocity = clamp(n.Velocity+r.Intn(2*velocityJitter+1)-velocityJitter, 1, 127)
+		}
+		humanized[i] = n
+	}
+	return humanized
+}
+
+//
+// Private
+//
+
+// clamp a value from a minimum to a maximum
+func clamp(value, min, max int) int {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
@@ -0,0 +1,82 @@
+// Humanize notes that were generated straight on a grid, nudging each earlier or later and softer or louder at random, so they don't sound robotic.
+package humanize
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/internal/golden"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestApply(t *testing.T) {
+	notes := straightNotes(16)
+	humanized := Apply(notes, 20*time.Millisecond, 10, 1)
+	assert.Equal(t, len(notes), len(humanized))
+	moved := 0
+	for i, n := range humanized {
+		assert.True(t, n.Start >= 0)
+		assert.True(t, n.Start >= notes[i].Start-midi.TicksOf(20*time.Millisecond))
+		assert.True(t, n.Start <= notes[i].Start+midi.TicksOf(20*time.Millisecond))
+		assert.True(t, n.Velocity >= midi.DefaultVelocity-10 && n.Velocity <= midi.DefaultVelocity+10)
+		assert.Equal(t, notes[i].Number, n.Number)
+		assert.Equal(t, notes[i].Duration, n.Duration)
+		if n.Start != notes[i].Start {
+			moved++
+		}
+	}
+	assert.True(t, moved > 0)
+	assert.Equal(t, 240, notes[1].Start, "the notes given are not modified")
+}
+
+func TestApply_Seed(t *testing.T) {
+	notes := straightNotes(8)
+	assert.Equal(t, Apply(notes, 10*time.Millisecond, 5, 7), Apply(notes, 10*time.Millisecond, 5, 7))
+	assert.NotEqual(t, Apply(notes, 10*time.Millisecond, 5, 7), Apply(notes, 10*time.Millisecond, 5, 8))
+}
+
+func TestApply_Golden(t *testing.T) {
+	var out strings.Builder
+	for _, n := range Apply(straightNotes(16), 20*time.Millisecond, 10, 42) {
+		fmt.Fprintf(&out, "%d %d %d %d\n", n.Number, n.Velocity, n.Start, n.Duration)
+	}
+	golden.Assert(t, "apply", out.String())
+}
+
+func TestApplyFrom(t *testing.T) {
+	notes := straightNotes(8)
+	assert.Equal(t, Apply(notes, 10*time.Millisecond, 5, 7), ApplyFrom(notes, 10*time.Millisecond, 5, rand.NewSource(7)))
+}
+
+func TestApply_None(t *testing.T) {
+	notes := straightNotes(4)
+	assert.Equal(t, notes, Apply(notes, 0, 0, 1))
+}
+
+func TestApply_Clamped(t *testing.T) {
+	notes := []midi.Note{{Number: 60, Velocity: 126, Start: 0, Duration: midi.Quarter}, {Number: 60, Velocity: 1, Start: 0, Duration: midi.Quarter}}
+	for seed := int64(0); seed < 20; seed++ {
+		for _, n := range Apply(notes, time.Second, 100, seed) {
+			assert.True(t, n.Start >= 0)
+			assert.True(t, n.Velocity >= 1 && n.Velocity <= 127)
+		}
+	}
+}
+
+//
+// Private
+//
+
+// straightNotes of middle C, some eighth notes one after another
+func straightNotes(count int) []midi.Note {
+	notes := make([]midi.Note, count)
+	for i := range notes {
+		notes[i] = midi.Note{Number: 60, Start: i * midi.Eighth, Duration: midi.Eighth}
+	}
+	return notes
+}
@@ -0,0 +1,36 @@
+// Swing delays the second step of each pair, lengthening the first and shortening the second, e.g. a triplet feel of two eighth notes
+package humanize
+
+import (
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// Swing notes on a grid of steps, without modifying them, stretching time in each pair of steps so that the second begins at some percent of the pair,
+// e.g. 50 is straight, 66 a triplet feel, moving both the start and the end of each note, so a note from one step to the next is swung along with it,
+// or else the notes unchanged for a step of no ticks or a percent outside 0 to 100, e.g. Swing(notes, midi.Eighth, 66)
+func Swing(notes []midi.Note, step int, percent int) []midi.Note {
+	swung := append([]midi.Note{}, notes...)
+	if step < 1 || percent <= 0 || percent >= 100 {
+		return swung
+	}
+	swing := 2 * step * percent / 100
+	for i, n := range swung {
+		end := swingTick(n.Start+n.Duration, step, swing)
+		swung[i].Start = swingTick(n.Start, step, swing)
+		swung[i].Duration = end - swung[i].Start
+	}
+	return swung
+}
+
+//
+// Private
+//
+
+// swingTick of a pair of steps, from its start to the swing at the first step, and from there to the end of the pair
+func swingTick(tick, step, swing int) int {
+	pair, offset := tick/(2*step)*2*step, tick%(2*step)
+	if offset <= step {
+		return pair + offset*swing/step
+	}
+	return pair + swing + (offset-step)*(2*step-swing)/step
+}
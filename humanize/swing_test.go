@@ -0,0 +1,33 @@
+// Swing delays the second step of each pair, lengthening the first and shortening the second, e.g. a triplet feel of two eighth notes
+package humanize
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestSwing(t *testing.T) {
+	swung := Swing(straightNotes(4), midi.Eighth, 75)
+	var starts, durations []int
+	for _, n := range swung {
+		starts = append(starts, n.Start)
+		durations = append(durations, n.Duration)
+	}
+	assert.Equal(t, []int{0, 360, 480, 840}, starts)
+	assert.Equal(t, []int{360, 120, 360, 120}, durations)
+}
+
+func TestSwing_Held(t *testing.T) {
+	notes := []midi.Note{{Number: 60, Start: 0, Duration: midi.Quarter}, {Number: 62, Start: 120, Duration: 120}}
+	assert.Equal(t, []midi.Note{{Number: 60, Start: 0, Duration: midi.Quarter}, {Number: 62, Start: 158, Duration: 158}}, Swing(notes, midi.Eighth, 66))
+}
+
+func TestSwing_Straight(t *testing.T) {
+	notes := straightNotes(4)
+	assert.Equal(t, notes, Swing(notes, midi.Eighth, 50))
+	assert.Equal(t, notes, Swing(notes, 0, 66))
+	assert.Equal(t, notes, Swing(notes, midi.Eighth, 100))
+}
@@ -0,0 +1,117 @@
+// A transposing instrument sounds its notes at another pitch than they're written, e.g. a written C of a Bb trumpet sounds the Bb a major second below,
+// so an arranger writes each part at the concert pitch it should sound, transposed up or down by its instrument, within its range.
+//
+// https://en.wikipedia.org/wiki/Transposing_instrument
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package instrument
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ErrUnknownInstrument when naming an instrument that isn't built in, e.g. "kazoo"
+var ErrUnknownInstrument = errors.New("unknown instrument")
+
+// Instrument by its name, the semitones it sounds from where it's written, and its range at concert pitch
+type Instrument struct {
+	Name          string
+	Transposition int       // semitones each note sounds from where it's written, e.g. -2 of a Bb trumpet, a major second lower, or 0 of concert pitch
+	Lowest        note.Note // sounding, at concert pitch
+	Highest       note.Note // sounding, at concert pitch
+}
+
+// Instruments built in, each sounding at concert pitch, or transposing, e.g. of a Bb trumpet or a guitar, written an octave higher than it sounds
+var (
+	Flute    = Instrument{Name: "flute", Transposition: 0, Lowest: at(note.C, 4), Highest: at(note.C, 7)}
+	Clarinet = Instrument{Name: "clarinet", Transposition: -2, Lowest: at(note.D, 3), Highest: at(note.As, 6)}
+	AltoSax  = Instrument{Name: "alto-sax", Transposition: -9, Lowest: at(note.Cs, 3), Highest: at(note.A, 5)}
+	TenorSax = Instrument{Name: "tenor-sax", Transposition: -14, Lowest: at(note.Gs, 2), Highest: at(note.E, 5)}
+	Horn     = Instrument{Name: "horn", Transposition: -7, Lowest: at(note.B, 1), Highest: at(note.F, 5)}
+	Trumpet  = Instrument{Name: "trumpet", Transposition: -2, Lowest: at(note.E, 3), Highest: at(note.As, 5)}
+	Guitar   = Instrument{Name: "guitar", Transposition: -12, Lowest: at(note.E, 2), Highest: at(note.B, 5)}
+)
+
+// Instruments built in, in the order of a score, from the woodwinds to the brass, then the guitar
+var Instruments = []Instrument{Flute, Clarinet, AltoSax, TenorSax, Horn, Trumpet, Guitar}
+
+// Names of the Instruments built in, in order
+func Names() []string {
+	names := make([]string, len(Instruments))
+	for n, i := range Instruments {
+		names[n] = i.Name
+	}
+	return names
+}
+
+// Named one of the Instruments built in, e.g. Trumpet of "trumpet"
+func Named(name string) (Instrument, error) {
+	for _, i := range Instruments {
+		if strings.ToLower(strings.TrimSpace(name)) == i.Name {
+			return i, nil
+		}
+	}
+	return Instrument{}, fmt.Errorf("%w %q, expected one of %s", ErrUnknownInstrument, name, strings.Join(Names(), ", "))
+}
+
+// Written note of a part for an instrument, of the concert note it should sound, e.g. D5 for a Bb trumpet to sound C5
+func Written(concert note.Note, i Instrument) note.Note {
+	return transposed(concert, -i.Transposition)
+}
+
+// Sounding note at concert pitch, of a note written for an instrument, e.g. C5 of D5 written for a Bb trumpet
+func Sounding(written note.Note, i Instrument) note.Note {
+	return transposed(written, i.Transposition)
+}
+
+// Key of the instrument, the concert pitch class sounded by its written C, e.g. Bb of a Bb trumpet, or C of concert pitch, or of an octave transposition
+func (i Instrument) Key() note.Class {
+	class, _ := note.C.Step(i.Transposition)
+	return class
+}
+
+// InRange whether the instrument can sound a note at concert pitch, from its lowest to its highest
+func (i Instrument) InRange(concert note.Note) bool {
+	return stepOf(concert) >= stepOf(i.Lowest) && stepOf(concert) <= stepOf(i.Highest)
+}
+
+// String of the instrument, its name, and the key it's in if it isn't C, e.g. "trumpet in Bb"
+func (i Instrument) String() string {
+	if k := i.Key(); k != note.C {
+		return i.Name + " in " + k.String(note.Flat)
+	}
+	return i.Name
+}
+
+//
+// Private
+//
+
+// at an octave, a note of a pitch class
+func at(class note.Class, octave note.Octave) note.Note {
+	return note.Note{Class: class, Octave: octave}
+}
+
+// transposed note, by some semitones, up if positive or down if negative, keeping anything else about it, e.g. its position
+func transposed(n note.Note, semitones int) note.Note {
+	class, octaves := n.Class.Step(semitones)
+	n.Class, n.Octave = class, n.Octave+octaves
+	return n
+}
+
+// stepOf a note, in semitones from C-1, e.g. 60 of middle C
+func stepOf(n note.Note) int {
+	return int(n.Octave+1)*12 + int(n.Class) - 1
+}
@@ -0,0 +1,75 @@
+// A transposing instrument sounds its notes at another pitch than they're written, e.g. a written C of a Bb trumpet sounds the Bb a major second below,
+// so an arranger writes each part at the concert pitch it should sound, transposed up or down by its instrument, within its range.
+package instrument
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestNamed(t *testing.T) {
+	i, err := Named("Trumpet")
+	assert.Nil(t, err)
+	assert.Equal(t, Trumpet, i)
+	i, err = Named(" alto-sax ")
+	assert.Nil(t, err)
+	assert.Equal(t, AltoSax, i)
+	_, err = Named("kazoo")
+	assert.True(t, errors.Is(err, ErrUnknownInstrument))
+	assert.Equal(t, `unknown instrument "kazoo", expected one of flute, clarinet, alto-sax, tenor-sax, horn, trumpet, guitar`, err.Error())
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, []string{"flute", "clarinet", "alto-sax", "tenor-sax", "horn", "trumpet", "guitar"}, Names())
+}
+
+func TestWritten(t *testing.T) {
+	assert.Equal(t, at(note.D, 5), Written(at(note.C, 5), Trumpet))
+	assert.Equal(t, at(note.C, 5), Written(at(note.Ds, 4), AltoSax))
+	assert.Equal(t, at(note.D, 5), Written(at(note.C, 4), TenorSax), "a major ninth higher")
+	assert.Equal(t, at(note.G, 4), Written(at(note.C, 4), Horn))
+	assert.Equal(t, at(note.E, 3), Written(at(note.E, 2), Guitar), "an octave higher")
+	assert.Equal(t, at(note.A, 4), Written(at(note.A, 4), Flute))
+	assert.Equal(t, note.Note{Class: note.D, Octave: 5, Position: 2}, Written(note.Note{Class: note.C, Octave: 5, Position: 2}, Clarinet))
+}
+
+func TestSounding(t *testing.T) {
+	assert.Equal(t, at(note.C, 5), Sounding(at(note.D, 5), Trumpet))
+	assert.Equal(t, at(note.Ds, 4), Sounding(at(note.C, 5), AltoSax))
+	assert.Equal(t, at(note.F, 3), Sounding(at(note.C, 4), Horn))
+	assert.Equal(t, at(note.B, 1), Sounding(at(note.B, 2), Guitar))
+	for _, i := range Instruments {
+		assert.Equal(t, at(note.Gs, 3), Sounding(Written(at(note.Gs, 3), i), i), i.Name)
+	}
+}
+
+func TestInstrument_Key(t *testing.T) {
+	assert.Equal(t, note.As, Trumpet.Key())
+	assert.Equal(t, note.As, Clarinet.Key())
+	assert.Equal(t, note.Ds, AltoSax.Key())
+	assert.Equal(t, note.As, TenorSax.Key())
+	assert.Equal(t, note.F, Horn.Key())
+	assert.Equal(t, note.C, Guitar.Key())
+	assert.Equal(t, note.C, Flute.Key())
+}
+
+func TestInstrument_InRange(t *testing.T) {
+	assert.True(t, Trumpet.InRange(at(note.E, 3)))
+	assert.True(t, Trumpet.InRange(at(note.As, 5)))
+	assert.False(t, Trumpet.InRange(at(note.Ds, 3)))
+	assert.False(t, Trumpet.InRange(at(note.B, 5)))
+	assert.True(t, Guitar.InRange(at(note.E, 2)))
+	assert.False(t, Flute.InRange(at(note.B, 3)))
+}
+
+func TestInstrument_String(t *testing.T) {
+	assert.Equal(t, "trumpet in Bb", Trumpet.String())
+	assert.Equal(t, "alto-sax in Eb", AltoSax.String())
+	assert.Equal(t, "horn in F", Horn.String())
+	assert.Equal(t, "guitar", Guitar.String())
+	assert.Equal(t, "flute", Flute.String())
+}
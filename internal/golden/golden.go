@@ -0,0 +1,19 @@
+// Golden files of the expected output of a test, e.g. of a seeded random generator, kept in the testdata directory of each package as its name with the .golden extension.
+package golden
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+// Assert the actual output of a test equals the golden file of a name, in the testdata directory of the package under test, e.g. testdata/sample.golden of "sample",
+// so a change to the random numbers of a seed, or to a frozen schema, can't go unnoticed
+func Assert(t *testing.T, name string, actual string) {
+	t.Helper()
+	expect, err := ioutil.ReadFile(filepath.Join("testdata", name+".golden"))
+	assert.Nil(t, err)
+	assert.Equal(t, string(expect), actual, name)
+}
@@ -0,0 +1,10 @@
+// Golden files of the expected output of a test, e.g. of a seeded random generator, kept in the testdata directory of each package as its name with the .golden extension.
+package golden
+
+import (
+	"testing"
+)
+
+func TestAssert(t *testing.T) {
+	Assert(t, "example", "C E G\n")
+}
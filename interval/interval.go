@@ -0,0 +1,50 @@
+// An interval is the distance between two notes, by its semitones from the lower to the higher, heard ascending from the lower or descending from the higher,
+// and remembered by the opening of a well-known song that begins with it, e.g. the perfect 4th ascending of Here Comes the Bride, to train the ear.
+//
+// https://en.wikipedia.org/wiki/Interval_(music)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Direction of an interval, ascending from its lower note to the higher, or descending from the higher to the lower
+type Direction string
+
+// Directions of an interval
+const (
+	Ascending  Direction = "ascending"
+	Descending Direction = "descending"
+)
+
+// DirectionNames of both directions of an interval, e.g. for the usage of a flag
+var DirectionNames = []string{string(Ascending), string(Descending)}
+
+// ErrUnknownDirection when parsing a direction that isn't ascending or descending, e.g. "sideways"
+var ErrUnknownDirection = errors.New("unknown direction")
+
+// ParseDirection of a name, e.g. "descending", or "up" or "down", or of an empty name, ascending
+func ParseDirection(name string) (Direction, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", string(Ascending), "up":
+		return Ascending, nil
+	case string(Descending), "down":
+		return Descending, nil
+	}
+	return "", fmt.Errorf("%w %q, expected one of %s", ErrUnknownDirection, name, strings.Join(DirectionNames, ", "))
+}
+
+// Names of the simple intervals, by their semitones from 0 of a unison to 12 of an octave, e.g. Names[5] of "P4"
+var Names = []string{"P1", "m2", "M2", "m3", "M3", "P4", "TT", "P5", "m6", "M6", "m7", "M7", "P8"}
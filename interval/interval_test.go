@@ -0,0 +1,33 @@
+// An interval is the distance between two notes, by its semitones from the lower to the higher, heard ascending from the lower or descending from the higher,
+// and remembered by the opening of a well-known song that begins with it, e.g. the perfect 4th ascending of Here Comes the Bride, to train the ear.
+package interval
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParseDirection(t *testing.T) {
+	for name, direction := range map[string]Direction{
+		"":            Ascending,
+		"ascending":   Ascending,
+		"up":          Ascending,
+		" Descending": Descending,
+		"down":        Descending,
+	} {
+		d, err := ParseDirection(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, direction, d, name)
+	}
+	_, err := ParseDirection("sideways")
+	assert.True(t, errors.Is(err, ErrUnknownDirection))
+	assert.Equal(t, `unknown direction "sideways", expected one of ascending, descending`, err.Error())
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, 13, len(Names))
+	assert.Equal(t, "P4", Names[5])
+	assert.Equal(t, "P8", Names[12])
+}
@@ -0,0 +1,60 @@
+// The name of an interval from the root of a chord or scale is by its number and semitones, e.g. m3 of the 3rd at 3 semitones, or #11 of the 11th at 18
+package interval
+
+import (
+	"strconv"
+)
+
+// MajorSemitones from the root of each degree of the major scale, from 0 of the 1st to 11 of the 7th
+var MajorSemitones = []int{0, 2, 4, 5, 7, 9, 11}
+
+// NameOf an interval by its number and semitones from the root: within the octave, by quality and number, e.g. m3 or P5 or M7,
+// with a quality of P (perfect), M (major), m (minor), A (augmented) or d (diminished), else as an extension, e.g. 9 or #11 or b13.
+// An interval whose semitones don't fit its number, e.g. the 4th tone of a symmetric scale, is named by its semitones alone, as one of the Names.
+func NameOf(number int, semitones int) string {
+	if number >= 0 && number < len(names) && semitones >= 0 && semitones < len(names[number]) {
+		return names[number][semitones]
+	}
+	return computeNameOf(number, semitones)
+}
+
+//
+// Private
+//
+
+// names of each interval by its semitones from the root, computed once, so that naming the tones of a parse doesn't allocate
+var names = computeNames()
+
+func computeNames() (all [17][25]string) {
+	for number := range all {
+		for semitones := range all[number] {
+			all[number][semitones] = computeNameOf(number, semitones)
+		}
+	}
+	return
+}
+
+func computeNameOf(number int, semitones int) string {
+	if number < 1 {
+		return Names[(semitones%12+12)%12]
+	}
+	degree := (number - 1) % 7
+	diff := semitones - MajorSemitones[degree] - 12*((number-1)/7)
+	qualities := majorQualities
+	if number > 8 {
+		qualities = extensionQualities
+	} else if degree == 0 || degree == 3 || degree == 4 {
+		qualities = perfectQualities
+	}
+	if quality, ok := qualities[diff]; ok {
+		return quality + strconv.Itoa(number)
+	}
+	return Names[(semitones%12+12)%12]
+}
+
+// qualities of an interval by its semitones from the major or perfect interval of the same number
+var (
+	majorQualities     = map[int]string{-2: "d", -1: "m", 0: "M", 1: "A"}
+	perfectQualities   = map[int]string{-1: "d", 0: "P", 1: "A"}
+	extensionQualities = map[int]string{-1: "b", 0: "", 1: "#"}
+)
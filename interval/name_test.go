@@ -0,0 +1,40 @@
+// The name of an interval from the root of a chord or scale is by its number and semitones, e.g. m3 of the 3rd at 3 semitones, or #11 of the 11th at 18
+package interval
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestNameOf(t *testing.T) {
+	assert.Equal(t, "P1", NameOf(1, 0))
+	assert.Equal(t, "m2", NameOf(2, 1))
+	assert.Equal(t, "A2", NameOf(2, 3))
+	assert.Equal(t, "m3", NameOf(3, 3))
+	assert.Equal(t, "M3", NameOf(3, 4))
+	assert.Equal(t, "P4", NameOf(4, 5))
+	assert.Equal(t, "A4", NameOf(4, 6))
+	assert.Equal(t, "d5", NameOf(5, 6))
+	assert.Equal(t, "A5", NameOf(5, 8))
+	assert.Equal(t, "m6", NameOf(6, 8))
+	assert.Equal(t, "M6", NameOf(6, 9))
+	assert.Equal(t, "d7", NameOf(7, 9))
+	assert.Equal(t, "m7", NameOf(7, 10))
+	assert.Equal(t, "M7", NameOf(7, 11))
+	assert.Equal(t, "d8", NameOf(8, 11))
+	assert.Equal(t, "b9", NameOf(9, 13))
+	assert.Equal(t, "9", NameOf(9, 14))
+	assert.Equal(t, "#9", NameOf(9, 15))
+	assert.Equal(t, "#11", NameOf(11, 18))
+	assert.Equal(t, "b13", NameOf(13, 20))
+	assert.Equal(t, "P5", NameOf(4, 7), "of semitones that don't fit the number")
+	assert.Equal(t, "M7", NameOf(6, 11))
+	assert.Equal(t, "TT", NameOf(0, 6))
+}
+
+func TestNameOf_BeyondTheTable(t *testing.T) {
+	assert.Equal(t, "M3", NameOf(3, -8))
+	assert.Equal(t, "P5", NameOf(20, 31))
+	assert.Equal(t, "m3", NameOf(-1, 3))
+}
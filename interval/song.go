@@ -0,0 +1,62 @@
+// Songs are the ear anchors of the intervals, each opening with an interval ascending or descending, e.g. Here Comes the Bride of a perfect 4th ascending
+package interval
+
+import (
+	"fmt"
+)
+
+// Song whose opening begins with an interval, by its title and who wrote or first recorded it, or else "traditional"
+type Song struct {
+	Title string `yaml:"title" json:"title"`
+	By    string `yaml:"by" json:"by"`
+}
+
+// String of the song, e.g. "Here Comes the Bride (Richard Wagner)"
+func (s Song) String() string {
+	return fmt.Sprintf("%s (%s)", s.Title, s.By)
+}
+
+// Songs whose openings begin with an interval of some semitones, from 1 of a minor 2nd to 12 of an octave, in a direction,
+// e.g. Songs(5, Ascending) of Here Comes the Bride and Amazing Grace, or none of a unison, a compound interval or an unknown direction
+func Songs(semitones int, d Direction) []Song {
+	if semitones < 1 || semitones >= len(songs[d]) {
+		return nil
+	}
+	return songs[d][semitones]
+}
+
+//
+// Private
+//
+
+// songs of each interval, by its direction and semitones, the best known first
+var songs = map[Direction][][]Song{
+	Ascending: {
+		1:  {{"Jaws", "John Williams"}, {"White Christmas", "Irving Berlin"}},
+		2:  {{"Frère Jacques", "traditional"}, {"Do-Re-Mi", "Rodgers and Hammerstein"}},
+		3:  {{"Greensleeves", "traditional"}, {"Smoke on the Water", "Deep Purple"}},
+		4:  {{"When the Saints Go Marching In", "traditional"}, {"Kumbaya", "traditional"}},
+		5:  {{"Here Comes the Bride", "Richard Wagner"}, {"Amazing Grace", "traditional"}},
+		6:  {{"The Simpsons", "Danny Elfman"}, {"Maria", "Leonard Bernstein"}},
+		7:  {{"Twinkle, Twinkle, Little Star", "traditional"}, {"Star Wars", "John Williams"}},
+		8:  {{"The Entertainer", "Scott Joplin"}, {"Manhã de Carnaval", "Luiz Bonfá"}},
+		9:  {{"My Bonnie Lies over the Ocean", "traditional"}, {"NBC chimes", "NBC"}},
+		10: {{"Somewhere", "Leonard Bernstein"}, {"Star Trek", "Alexander Courage"}},
+		11: {{"Take On Me", "a-ha"}, {"Don't Know Why", "Norah Jones"}},
+		12: {{"Somewhere Over the Rainbow", "Harold Arlen"}, {"The Christmas Song", "Mel Tormé"}},
+	},
+	Descending: {
+		1:  {{"Für Elise", "Ludwig van Beethoven"}, {"Joy to the World", "Lowell Mason"}},
+		2:  {{"Mary Had a Little Lamb", "traditional"}, {"Three Blind Mice", "traditional"}},
+		3:  {{"Hey Jude", "The Beatles"}, {"The Star-Spangled Banner", "John Stafford Smith"}},
+		4:  {{"Swing Low, Sweet Chariot", "traditional"}, {"Symphony No. 5", "Ludwig van Beethoven"}},
+		5:  {{"Eine kleine Nachtmusik", "Wolfgang Amadeus Mozart"}, {"Born Free", "John Barry"}},
+		6:  {{"Black Sabbath", "Black Sabbath"}},
+		7:  {{"The Flintstones", "Hoyt Curtin"}},
+		8:  {{"Where Do I Begin (Love Story)", "Francis Lai"}},
+		9:  {{"Nobody Knows the Trouble I've Seen", "traditional"}},
+		10: {{"Watermelon Man", "Herbie Hancock"}},
+		11: {{"I Love You", "Cole Porter"}},
+		12: {{"Willow Weep for Me", "Ann Ronell"}},
+	},
+}
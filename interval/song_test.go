@@ -0,0 +1,34 @@
+// Songs are the ear anchors of the intervals, each opening with an interval ascending or descending, e.g. Here Comes the Bride of a perfect 4th ascending
+package interval
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestSongs(t *testing.T) {
+	assert.Equal(t, []Song{{"Here Comes the Bride", "Richard Wagner"}, {"Amazing Grace", "traditional"}}, Songs(5, Ascending))
+	assert.Equal(t, []Song{{"Eine kleine Nachtmusik", "Wolfgang Amadeus Mozart"}, {"Born Free", "John Barry"}}, Songs(5, Descending))
+	assert.Equal(t, "Jaws", Songs(1, Ascending)[0].Title)
+	assert.Equal(t, "Willow Weep for Me", Songs(12, Descending)[0].Title)
+}
+
+func TestSongs_EveryInterval(t *testing.T) {
+	for _, d := range []Direction{Ascending, Descending} {
+		for semitones := 1; semitones <= 12; semitones++ {
+			assert.NotEmpty(t, Songs(semitones, d), "%s %s", Names[semitones], d)
+		}
+	}
+}
+
+func TestSongs_None(t *testing.T) {
+	assert.Nil(t, Songs(0, Ascending))
+	assert.Nil(t, Songs(13, Ascending))
+	assert.Nil(t, Songs(-1, Descending))
+	assert.Nil(t, Songs(5, "sideways"))
+}
+
+func TestSong_String(t *testing.T) {
+	assert.Equal(t, "Here Comes the Bride (Richard Wagner)", Song{"Here Comes the Bride", "Richard Wagner"}.String())
+}
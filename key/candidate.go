@@ -0,0 +1,86 @@
+// A key is inferred from its signature and tonic, e.g. of a MusicXML or MIDI key event, as the major or minor key of the signature, ranked by the mode of the signature on that tonic, and offered in that mode, e.g. A Mixolydian.
+package key
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Modal key of a signature on a tonic, the major or minor key of the signature, and the church mode of the signature on the tonic, e.g. A Mixolydian of D major
+type Modal struct {
+	Key
+	Tonic      note.Class
+	ChurchMode string
+}
+
+// String of the church mode on its tonic, and the key of its signature, to tell apart the major and minor keys of the same signature, e.g. "A Mixolydian of D major" or "A Mixolydian of B minor"
+func (m Modal) String() string {
+	return m.Tonic.String(m.AdjSymbol) + " " + m.ChurchMode + " of " + m.Root.String(m.AdjSymbol) + " " + strings.ToLower(m.Mode.String())
+}
+
+// FromSignature of a key, its sharps if positive or flats if negative, e.g. 2 for D major or B minor, and its tonic, the candidate keys, ranked with their confidence, each in the mode on the tonic:
+// only the key on the tonic, if the mode of the signature on the tonic is Ionian or Aeolian, e.g. B minor of 2 sharps with a tonic of B, of B Aeolian,
+// or else both keys of the signature, first the one whose third, major or minor, the mode on the tonic shares, e.g. D major 67% and B minor 33% with a tonic of A, each of A Mixolydian.
+// There are no candidates of a tonic outside the signature, or of more than 7 sharps or flats.
+func FromSignature(sharpsOrFlats int, tonic note.Class) []candidate.Candidate[Modal] {
+	if sharpsOrFlats < -7 || sharpsOrFlats > 7 || tonic == note.Nil {
+		return nil
+	}
+	adj := note.Sharp
+	if sharpsOrFlats < 0 {
+		adj = note.Flat
+	}
+	root, _ := note.C.Step(sharpsOrFlats * 7)
+	relative, _ := root.Step(-3)
+	mode, ok := churchModeOf(root.Diff(tonic))
+	if !ok {
+		return nil
+	}
+	major := Modal{Key{Root: root, AdjSymbol: adj, Mode: Major}, tonic, mode}
+	minor := Modal{Key{Root: relative, AdjSymbol: adj, Mode: Minor}, tonic, mode}
+	var candidates []candidate.Candidate[Modal]
+	switch mode {
+	case ionian:
+		candidates = append(candidates, candidate.Of(major, tonicScore))
+	case aeolian:
+		candidates = append(candidates, candidate.Of(minor, tonicScore))
+	case "Lydian", "Mixolydian":
+		candidates = append(candidates, candidate.Of(major, thirdScore), candidate.Of(minor, otherScore))
+	default:
+		candidates = append(candidates, candidate.Of(minor, thirdScore), candidate.Of(major, otherScore))
+	}
+	return candidate.Rank(candidates)
+}
+
+// ChurchModeOn a tonic, the mode of the key signature starting on it, e.g. "Dorian" of D in C major, and whether the tonic is in the signature
+func (k Key) ChurchModeOn(tonic note.Class) (string, bool) {
+	if k.Mode == Nil || tonic == note.Nil {
+		return "", false
+	}
+	root, _ := note.C.Step(k.Fifths() * 7)
+	return churchModeOf(root.Diff(tonic))
+}
+
+//
+// Private
+//
+
+const (
+	ionian  = "Ionian"
+	aeolian = "Aeolian"
+)
+
+// Scores of the candidate keys of a signature, the key on the tonic, or else the key whose third the mode on the tonic shares, over the other
+const (
+	tonicScore = 1.0
+	thirdScore = 2.0
+	otherScore = 1.0
+)
+
+// churchModeOf a tonic, by its semitones up from the tonic of the major scale of its signature, e.g. Dorian of 2, and whether it's in that scale
+func churchModeOf(semitones int) (string, bool) {
+	mode, ok := map[int]string{0: ionian, 2: "Dorian", 4: "Phrygian", 5: "Lydian", 7: "Mixolydian", 9: aeolian, 11: "Locrian"}[(semitones%12+12)%12]
+	return mode, ok
+}
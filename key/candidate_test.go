@@ -0,0 +1,80 @@
+// A key is inferred from its signature and tonic, e.g. of a MusicXML or MIDI key event, as the major or minor key of the signature, ranked by the mode of the signature on that tonic.
+package key
+
+import (
+	"fmt"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestFromSignature(t *testing.T) {
+	assert.Equal(t, []string{"B Minor 100%"}, candidateNamesOf(FromSignature(2, note.B)))
+	assert.Equal(t, []string{"D Major 100%"}, candidateNamesOf(FromSignature(2, note.D)))
+	assert.Equal(t, []string{"D Major 67%", "B Minor 33%"}, candidateNamesOf(FromSignature(2, note.A)), "of A Mixolydian")
+	assert.Equal(t, []string{"B Minor 67%", "D Major 33%"}, candidateNamesOf(FromSignature(2, note.E)), "of E Dorian")
+	assert.Equal(t, []string{"C Minor 100%"}, candidateNamesOf(FromSignature(-3, note.C)))
+	assert.Equal(t, []string{"Bb Minor 67%", "Db Major 33%"}, candidateNamesOf(FromSignature(-5, note.F)), "of F Phrygian")
+	assert.Equal(t, []string{"C Major 100%"}, candidateNamesOf(FromSignature(0, note.C)))
+	assert.Equal(t, []string{"A Minor 67%", "C Major 33%"}, candidateNamesOf(FromSignature(0, note.B)), "of B Locrian")
+	assert.Equal(t, []string{"C# Major 67%", "A# Minor 33%"}, candidateNamesOf(FromSignature(7, note.Fs)), "of F# Lydian")
+	assert.Nil(t, FromSignature(2, note.F), "F isn't in the signature of two sharps")
+	assert.Nil(t, FromSignature(8, note.C))
+	assert.Nil(t, FromSignature(0, note.Nil))
+}
+
+func TestFromSignature_Modal(t *testing.T) {
+	candidates := FromSignature(2, note.A)
+	assert.Len(t, candidates, 2)
+	assert.Equal(t, "A Mixolydian of D major", candidates[0].Value.String())
+	assert.Equal(t, "A Mixolydian of B minor", candidates[1].Value.String())
+	for _, c := range candidates {
+		assert.Equal(t, note.A, c.Value.Tonic)
+		assert.Equal(t, "Mixolydian", c.Value.ChurchMode)
+	}
+	m, _ := candidate.Best(FromSignature(2, note.B))
+	assert.Equal(t, "B Aeolian of B minor", m.String())
+	m, _ = candidate.Best(FromSignature(-5, note.Ds))
+	assert.Equal(t, "Eb Dorian of Bb minor", m.String())
+}
+
+func TestFromSignature_Key(t *testing.T) {
+	k, ok := candidate.Best(FromSignature(2, note.B))
+	assert.True(t, ok)
+	assert.True(t, k.EquivalentTo(Of("B minor")))
+	assert.Equal(t, 2, k.Fifths())
+	k, _ = candidate.Best(FromSignature(-3, note.Ds))
+	assert.Equal(t, "Eb Major", k.Canonical())
+}
+
+func TestKey_ChurchModeOn(t *testing.T) {
+	assertChurchModeOn(t, "Dorian", Of("C major"), note.D)
+	assertChurchModeOn(t, "Mixolydian", Of("D major"), note.A)
+	assertChurchModeOn(t, "Aeolian", Of("B minor"), note.B)
+	assertChurchModeOn(t, "Ionian", Of("B minor"), note.D)
+	assertChurchModeOn(t, "Phrygian", Of("Bb minor"), note.F)
+	_, ok := Of("D major").ChurchModeOn(note.F)
+	assert.False(t, ok, "F isn't in D major")
+	_, ok = Key{}.ChurchModeOn(note.C)
+	assert.False(t, ok)
+}
+
+//
+// Private
+//
+
+func assertChurchModeOn(t *testing.T, expect string, k Key, tonic note.Class) {
+	mode, ok := k.ChurchModeOn(tonic)
+	assert.True(t, ok)
+	assert.Equal(t, expect, mode)
+}
+
+func candidateNamesOf(candidates []candidate.Candidate[Modal]) (names []string) {
+	for _, c := range candidates {
+		names = append(names, fmt.Sprintf("%s %.0f%%", c.Value.Canonical(), c.Confidence*100))
+	}
+	return
+}
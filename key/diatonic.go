@@ -0,0 +1,90 @@
+// The diatonic chords of a key are the triads and seventh chords built on each degree of its scale, from only the notes of the key.
+package key
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/numeral"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// DiatonicChords of the key, the triad on each degree of its major or natural minor scale, from the tonic, each spelled in the key,
+// e.g. C, Dm, Em, F, G, Am and Bdim in C major
+func (k Key) DiatonicChords() []chord.Chord {
+	s := k.Scale()
+	var chords []chord.Chord
+	for d := 1; d <= 7; d++ {
+		root := s.Tones[scale.Interval(d)]
+		third := s.Tones[scale.Interval((d+1)%7+1)]
+		fifth := s.Tones[scale.Interval((d+3)%7+1)]
+		if root == note.Nil {
+			continue
+		}
+		name := root.String(k.AdjSymbol) + triadSuffixOf(root.Diff(third), root.Diff(fifth))
+		chords = append(chords, chord.Of(name).SpelledIn(k))
+	}
+	return chords
+}
+
+// Diatonic chord of a key, the triad or seventh chord on a degree of its scale
+type Diatonic struct {
+	Chord   chord.Chord
+	Name    string // e.g. "Dm" or "Dm7"
+	Numeral string // roman, in upper case for a major third, e.g. "ii" or "ii7"
+	Degree  int    // of the scale, from 1 of the tonic
+}
+
+// String of the diatonic chord, its name and numeral, e.g. "Dm7 (ii7)"
+func (d Diatonic) String() string {
+	return d.Name + " (" + d.Numeral + ")"
+}
+
+// Chords of the key, the diatonic triad then the seventh chord on each degree of its major or natural minor scale, each spelled in the key,
+// e.g. C (I), Cmaj7 (Imaj7), Dm (ii), Dm7 (ii7), and so on up to Bdim (vii°) and Bm7b5 (viiø7) in C major
+func (k Key) Chords() []Diatonic {
+	s := k.Scale()
+	var chords []Diatonic
+	for d := 1; d <= 7; d++ {
+		root := s.Tones[scale.Interval(d)]
+		if root == note.Nil {
+			continue
+		}
+		third, fifth, seventh := root.Diff(s.Tones[scale.Interval((d+1)%7+1)]), root.Diff(s.Tones[scale.Interval((d+3)%7+1)]), root.Diff(s.Tones[scale.Interval((d+5)%7+1)])
+		quality, _ := numeral.QualityOf(third, fifth)
+		for _, c := range []Diatonic{
+			{Name: root.String(k.AdjSymbol) + triadSuffixOf(third, fifth), Numeral: numeral.Of(0, d, quality, numeral.NoSeventh, 0), Degree: d},
+			{Name: root.String(k.AdjSymbol) + seventhSuffixOf(third, fifth, seventh), Numeral: numeral.Of(0, d, quality, (seventh+12)%12, 0), Degree: d},
+		} {
+			c.Chord = chord.Of(c.Name).SpelledIn(k)
+			chords = append(chords, c)
+		}
+	}
+	return chords
+}
+
+// Scale of the key, major or natural minor, e.g. Db Eb F Gb Ab Bb C of Db major
+func (k Key) Scale() scale.Scale {
+	mode := " major"
+	if k.Mode == Minor {
+		mode = " minor"
+	}
+	return scale.Of(k.Root.String(k.AdjSymbol) + mode)
+}
+
+//
+// Private
+//
+
+// triadSuffixOf a chord name, by the semitones up from its root to its third and fifth, e.g. "m" for a minor triad
+func triadSuffixOf(third, fifth int) string {
+	third, fifth = (third+12)%12, (fifth+12)%12
+	switch {
+	case third == 3 && fifth == 6:
+		return "dim"
+	case third == 4 && fifth == 8:
+		return "aug"
+	case third == 3:
+		return "m"
+	}
+	return ""
+}
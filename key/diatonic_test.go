@@ -0,0 +1,111 @@
+// The diatonic chords of a key are the triads and seventh chords built on each degree of its scale, from only the notes of the key.
+package key
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestDiatonicChords(t *testing.T) {
+	assert.Equal(t, "C E G | D F A | E G B | F A C | G B D | A C E | B D F", diatonicString(Of("C")))
+	assert.Equal(t, "F A C | G Bb D | A C E | Bb D F | C E G | D F A | E G Bb", diatonicString(Of("F")))
+	assert.Equal(t, "D F# A | E G B | F# A C# | G B D | A C# E | B D F# | C# E G", diatonicString(Of("D")))
+	assert.Equal(t, "G Bb D | A C Eb | Bb D F | C Eb G | D F A | Eb G Bb | F A C", diatonicString(Of("G minor")))
+}
+
+func TestDiatonicChords_Qualities(t *testing.T) {
+	var roots []string
+	for _, c := range Of("Eb").DiatonicChords() {
+		roots = append(roots, c.Root.String(c.AdjSymbol)+qualityOf(c))
+	}
+	assert.Equal(t, []string{"Eb", "Fm", "Gm", "Ab", "Bb", "Cm", "Ddim"}, roots)
+}
+
+func TestDiatonicChords_SpelledIn(t *testing.T) {
+	for _, c := range Of("A").DiatonicChords() {
+		assert.Equal(t, note.Sharp, c.AdjSymbol)
+	}
+	for _, c := range Of("C minor").DiatonicChords() {
+		assert.Equal(t, note.Flat, c.AdjSymbol)
+	}
+}
+
+func TestChords(t *testing.T) {
+	var names []string
+	for _, d := range Of("C").Chords() {
+		names = append(names, d.String())
+	}
+	assert.Equal(t, []string{"C (I)", "Cmaj7 (Imaj7)", "Dm (ii)", "Dm7 (ii7)", "Em (iii)", "Em7 (iii7)", "F (IV)", "Fmaj7 (IVmaj7)",
+		"G (V)", "G7 (V7)", "Am (vi)", "Am7 (vi7)", "Bdim (vii°)", "Bm7b5 (viiø7)"}, names)
+	chords := Of("G minor").Chords()
+	assert.Equal(t, 14, len(chords))
+	assert.Equal(t, Diatonic{Chord: chord.Of("Dm7").SpelledIn(Of("G minor")), Name: "Dm7", Numeral: "v7", Degree: 5}, chords[9], "minor v7, of the natural minor")
+	assert.Equal(t, "F A C Eb", toneString(chords[13].Chord))
+	for n, d := range Of("Db").Chords() {
+		assert.Equal(t, n/2+1, d.Degree)
+		assert.Equal(t, note.Flat, d.Chord.AdjSymbol)
+	}
+}
+
+func TestScale(t *testing.T) {
+	assert.Equal(t, scale.Of("Db major"), Of("Db").Scale())
+	assert.Equal(t, scale.Of("A minor"), Of("A minor").Scale())
+}
+
+func TestTriadSuffixOf(t *testing.T) {
+	assert.Equal(t, "", triadSuffixOf(4, 7))
+	assert.Equal(t, "m", triadSuffixOf(3, 7))
+	assert.Equal(t, "m", triadSuffixOf(3, -5))
+	assert.Equal(t, "dim", triadSuffixOf(3, 6))
+	assert.Equal(t, "dim", triadSuffixOf(3, -6))
+	assert.Equal(t, "aug", triadSuffixOf(4, -4))
+}
+
+//
+// Private
+//
+
+func diatonicString(k Key) string {
+	var chords []string
+	for _, c := range k.DiatonicChords() {
+		var names []string
+		for _, i := range []chord.Interval{chord.I1, chord.I3, chord.I5} {
+			class, _ := c.ClassAt(i)
+			names = append(names, class.String(c.AdjSymbol))
+		}
+		chords = append(chords, strings.Join(names, " "))
+	}
+	return strings.Join(chords, " | ")
+}
+
+func toneString(c chord.Chord) string {
+	var names []string
+	for _, t := range c.OrderedTones() {
+		names = append(names, t.Class.String(c.AdjSymbol))
+	}
+	return strings.Join(names, " ")
+}
+
+func qualityOf(c chord.Chord) string {
+	var names string
+	for _, t := range c.Tones {
+		if t.Degree.Interval == chord.I3 || t.Degree.Interval == chord.I5 {
+			names += t.Name
+		}
+	}
+	switch names {
+	case "m3P5":
+		return "m"
+	case "m3d5":
+		return "dim"
+	case "M3A5":
+		return "aug"
+	}
+	return ""
+}
@@ -0,0 +1,29 @@
+// Keys are equal if they have the same root, spelling and mode, or equivalent if they have the same tonic and mode, regardless of spelling, e.g. C# major and Db major,
+// and have a canonical form and a stable hash, e.g. to use as a map key or to deduplicate keys.
+package key
+
+import (
+	"hash/fnv"
+)
+
+// Equal to another key, with the same root, spelling and mode
+func (k Key) Equal(other Key) bool {
+	return k == other
+}
+
+// EquivalentTo another key, with the same tonic and mode, regardless of its spelling, e.g. C# major and Db major
+func (k Key) EquivalentTo(other Key) bool {
+	return k.Root == other.Root && k.Mode == other.Mode
+}
+
+// Canonical form of the key, its root, spelled with its sharps or flats, and its mode, the same for any two keys that are Equal, e.g. "Bb Major"
+func (k Key) Canonical() string {
+	return k.Root.String(k.AdjSymbol) + " " + k.Mode.String()
+}
+
+// Hash of the Canonical form of the key, stable from one run to the next, e.g. to use as a map key
+func (k Key) Hash() uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(k.Canonical()))
+	return h.Sum64()
+}
@@ -0,0 +1,32 @@
+// Keys are equal if they have the same root, spelling and mode, or equivalent if they have the same tonic and mode, regardless of spelling, e.g. C# major and Db major,
+// and have a canonical form and a stable hash, e.g. to use as a map key or to deduplicate keys.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestKey_Equal(t *testing.T) {
+	assert.True(t, Of("Bb minor").Equal(Of("Bbm")))
+	assert.False(t, Of("C#").Equal(Of("Db")))
+	assert.False(t, Of("C").Equal(Of("A minor")))
+}
+
+func TestKey_EquivalentTo(t *testing.T) {
+	assert.True(t, Of("C#").EquivalentTo(Of("Db")))
+	assert.True(t, Of("A# minor").EquivalentTo(Of("Bb minor")))
+	assert.False(t, Of("C").EquivalentTo(Of("C minor")))
+}
+
+func TestKey_Canonical(t *testing.T) {
+	assert.Equal(t, "C# Major", Of("C#").Canonical())
+	assert.Equal(t, "Bb Minor", Of("Bb minor").Canonical())
+}
+
+func TestKey_Hash(t *testing.T) {
+	assert.Equal(t, uint64(6449434070360806435), Of("C").Hash()) // stable from one run to the next
+	assert.Equal(t, Of("Bbm").Hash(), Of("Bb minor").Hash())
+	assert.NotEqual(t, Of("C#").Hash(), Of("Db").Hash())
+}
@@ -0,0 +1,33 @@
+// Key names that cannot be parsed are described by a ParseError, e.g. for an unknown root or mode
+package key
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnknownRoot when a key name doesn't begin with a note, e.g. "H minor"
+	ErrUnknownRoot = errors.New("unknown root")
+
+	// ErrUnknownMode when a key name has more than a major or minor mode after its root, e.g. the "jams" of "C jams"
+	ErrUnknownMode = errors.New("unknown mode")
+)
+
+// ParseError of a key name, at the position of the text that could not be parsed
+type ParseError struct {
+	Name     string // key name that was parsed
+	Position int    // of the text that could not be parsed, in bytes from the beginning of the name
+	Text     string // that could not be parsed
+	Err      error  // ErrUnknownRoot or ErrUnknownMode
+}
+
+// Error message, e.g. `unknown mode "jams" at position 2 of key "C jams"`
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v %q at position %d of key %q", e.Err, e.Text, e.Position, e.Name)
+}
+
+// Unwrap to the underlying error, e.g. for errors.Is(err, ErrUnknownMode)
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
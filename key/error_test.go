@@ -0,0 +1,16 @@
+// Key names that cannot be parsed are described by a ParseError, e.g. for an unknown root or mode
+package key
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParseError(t *testing.T) {
+	err := &ParseError{Name: "C jams", Position: 2, Text: "jams", Err: ErrUnknownMode}
+	assert.Equal(t, `unknown mode "jams" at position 2 of key "C jams"`, err.Error())
+	assert.True(t, errors.Is(err, ErrUnknownMode))
+	assert.False(t, errors.Is(err, ErrUnknownRoot))
+}
@@ -0,0 +1,48 @@
+//go:build go1.18
+// +build go1.18
+
+// Keys are parsed from any name without panicking, e.g. from the arguments of the command line or the query of a request
+package key
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-music-theory/music-theory/locale"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func FuzzParse(f *testing.F) {
+	for _, name := range benchmarkNames {
+		f.Add(name)
+	}
+	f.Add("C jams")
+	f.Add("B♭ minor")
+	f.Add("Es")
+	f.Add("Fis moll")
+	f.Add("Sol minor")
+	f.Fuzz(func(t *testing.T, name string) {
+		for _, l := range []locale.Locale{locale.English, locale.German, locale.Solfege} {
+			k, err := Parse(name, WithLocale(l))
+			if k.Mode < Nil || k.Mode > Minor {
+				t.Errorf("name:%q locale:%v mode is %d", name, l, int(k.Mode))
+			}
+			if err == nil {
+				if k.Root < note.C || k.Root > note.B {
+					t.Errorf("name:%q locale:%v root is %d", name, l, int(k.Root))
+				}
+				continue
+			}
+			var parseError *ParseError
+			if !errors.As(err, &parseError) {
+				t.Fatalf("name:%q locale:%v error %v is not a *ParseError", name, l, err)
+			}
+			if parseError.Position < 0 || parseError.Position > len(name) {
+				t.Errorf("name:%q locale:%v error %v is out of range", name, l, err)
+			} else if l == locale.English && !strings.HasPrefix(name[parseError.Position:], parseError.Text) {
+				t.Errorf("name:%q error %v is not of the text at its position", name, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,154 @@
+// Modal interchange borrows chords from the parallel modes of a key, those on the same tonic, e.g. Fm, the iv of C Aeolian, borrowed by C major.
+package key
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/numeral"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Borrowed chord of a key, the triad or seventh chord on a degree of one of its parallel modes, with a tone outside the key
+type Borrowed struct {
+	Chord   chord.Chord
+	Name    string // e.g. "Ab" or "Dbmaj7"
+	Numeral string // roman, from the key's own degree, flat or sharp, in upper case for a major third, e.g. "bVI" or "bIImaj7"
+	Mode    string // the parallel mode borrowed from, e.g. "Aeolian"
+}
+
+// String of the borrowed chord, its name and numeral, and the mode it's borrowed from, e.g. "Fm (iv, from Aeolian)"
+func (b Borrowed) String() string {
+	return b.Name + " (" + b.Numeral + ", from " + b.Mode + ")"
+}
+
+// ModalInterchange of the key, each chord borrowed from its parallel modes, the triad then the seventh chord on each degree,
+// from the parallel minor or major first, then the other modes in order, each chord only once, from the first mode it's in,
+// e.g. Cm, Ddim, Eb, Fm, Gm, Ab and Bb, and their seventh chords, from the Aeolian of C major, then F7 from its Dorian, then Db and Dbmaj7 from its Phrygian, and so on,
+// each named from the letter of its degree, e.g. Cb, the bVI of Eb major, or nil of a key without a root or mode
+func (k Key) ModalInterchange() []Borrowed {
+	if k.Root == note.Nil || k.Mode == Nil {
+		return nil
+	}
+	tonic := k.Root.String(k.AdjSymbol)
+	diatonic := make(map[note.Class]bool)
+	own := k.Scale()
+	for _, c := range own.Tones {
+		diatonic[c] = true
+	}
+	parallel := parallelModes[1:]
+	if k.Mode == Minor {
+		parallel = append([]string{parallelModes[0]}, parallelModes[2:]...)
+	}
+	seen := make(map[string]bool)
+	var borrowed []Borrowed
+	for _, mode := range parallel {
+		fifths := fifthsOf(k, mode)
+		adj := note.Sharp
+		if fifths < 0 {
+			adj = note.Flat
+		}
+		s := scale.Of(k.Root.String(adj) + " " + strings.ToLower(mode))
+		for d := 1; d <= 7; d++ {
+			root := s.Tones[scale.Interval(d)]
+			tones := []note.Class{root, s.Tones[scale.Interval((d+1)%7+1)], s.Tones[scale.Interval((d+3)%7+1)], s.Tones[scale.Interval((d+5)%7+1)]}
+			third, fifth, seventh := root.Diff(tones[1]), root.Diff(tones[2]), root.Diff(tones[3])
+			accidental := accidentalOf(own.Tones[scale.Interval(d)].Diff(root))
+			quality, _ := numeral.QualityOf(third, fifth)
+			name := spelledOn(tonic, d, root)
+			triad := Borrowed{Name: name + triadSuffixOf(third, fifth), Numeral: numeral.Of(accidental, d, quality, numeral.NoSeventh, 0), Mode: mode}
+			seventhChord := Borrowed{Name: name + seventhSuffixOf(third, fifth, seventh), Numeral: numeral.Of(accidental, d, quality, (seventh+12)%12, 0), Mode: mode}
+			for _, b := range []struct {
+				Borrowed
+				tones []note.Class
+			}{{triad, tones[:3]}, {seventhChord, tones}} {
+				if seen[b.Name] || allIn(b.tones, diatonic) {
+					continue
+				}
+				seen[b.Name] = true
+				b.Chord = chord.Of(b.Name).SpelledIn(signature(fifths))
+				borrowed = append(borrowed, b.Borrowed)
+			}
+		}
+	}
+	return borrowed
+}
+
+//
+// Private
+//
+
+// parallelModes of a key, the major and minor first, each a church mode by name, to parse as a scale
+var parallelModes = []string{"Ionian", "Aeolian", "Dorian", "Phrygian", "Lydian", "Mixolydian", "Locrian"}
+
+// fifthsOf the key signature of a parallel mode of a key, e.g. -3 for C Aeolian, or -7 for Eb Phrygian, to spell its chords in flats
+func fifthsOf(k Key, mode string) int {
+	major := k
+	major.Mode = Major
+	return major.Fifths() + map[string]int{"Lydian": 1, "Mixolydian": -1, "Dorian": -2, "Aeolian": -3, "Phrygian": -4, "Locrian": -5}[mode]
+}
+
+// signature of a parallel mode, by its fifths from C major, to spell a chord borrowed from it
+type signature int
+
+// Fifths of the signature, the number of sharps if positive, or flats if negative
+func (s signature) Fifths() int {
+	return int(s)
+}
+
+// spelledOn a tonic, the name of a pitch class on a degree up from it, by the letter of that degree and as many sharps or flats as it takes,
+// e.g. "Cb" of B on the 6th degree up from "Eb", or "Bbb" of A on the 5th degree up from "Eb"
+func spelledOn(tonic string, degree int, class note.Class) string {
+	letter := letters[(strings.IndexByte(letters, tonic[0])+degree-1)%len(letters)]
+	natural, _ := note.ClassNamed(string(letter))
+	alter := (natural.Diff(class)+18)%12 - 6
+	if alter < 0 {
+		return string(letter) + strings.Repeat("b", -alter)
+	}
+	return string(letter) + strings.Repeat("#", alter)
+}
+
+// letters of the names of notes, in order up from C
+const letters = "CDEFGAB"
+
+// accidentalOf a numeral, by the semitones from the key's own degree to the borrowed one, e.g. -1 of a flat degree
+func accidentalOf(diff int) int {
+	switch (diff + 12) % 12 {
+	case 11:
+		return -1
+	case 1:
+		return 1
+	}
+	return 0
+}
+
+// seventhSuffixOf a chord name, by the semitones up from its root to its third, fifth and seventh, e.g. "m7b5" for a half-diminished seventh chord
+func seventhSuffixOf(third, fifth, seventh int) string {
+	third, fifth, seventh = (third+12)%12, (fifth+12)%12, (seventh+12)%12
+	switch {
+	case third == 3 && fifth == 6 && seventh == 9:
+		return "dim7"
+	case third == 3 && fifth == 6:
+		return "m7b5"
+	case third == 4 && fifth == 8:
+		return "maj7#5"
+	case third == 3 && seventh == 11:
+		return "mMaj7"
+	case third == 3:
+		return "m7"
+	case seventh == 11:
+		return "maj7"
+	}
+	return "7"
+}
+
+// allIn the set, every one of the classes
+func allIn(classes []note.Class, set map[note.Class]bool) bool {
+	for _, c := range classes {
+		if !set[c] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+// Modal interchange borrows chords from the parallel modes of a key, those on the same tonic, e.g. Fm, the iv of C Aeolian, borrowed by C major.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestModalInterchange(t *testing.T) {
+	borrowed := Of("C").ModalInterchange()
+	assert.Equal(t, []string{"Cm", "Cm7", "Ddim", "Dm7b5", "Eb", "Ebmaj7", "Fm", "Fm7", "Gm", "Gm7", "Ab", "Abmaj7", "Bb", "Bb7"}, namesFrom(borrowed, "Aeolian"))
+	assert.Equal(t, []string{"F7", "Adim", "Am7b5", "Bbmaj7"}, namesFrom(borrowed, "Dorian"))
+	assert.Equal(t, []string{"Db", "Dbmaj7", "Eb7", "Gdim", "Gm7b5", "Bbm", "Bbm7"}, namesFrom(borrowed, "Phrygian"))
+	assert.Equal(t, []string{"C7", "Edim", "Em7b5"}, namesFrom(borrowed, "Mixolydian"))
+	assert.Equal(t, 0, len(namesFrom(borrowed, "Ionian")))
+	assert.Equal(t, Borrowed{Chord: chord.Of("Dbmaj7"), Name: "Dbmaj7", Numeral: "bIImaj7", Mode: "Phrygian"}, borrowed[19])
+	assert.Equal(t, "Fm (iv, from Aeolian)", borrowed[6].String())
+}
+
+func TestModalInterchange_Minor(t *testing.T) {
+	borrowed := Of("A minor").ModalInterchange()
+	assert.Equal(t, []string{"A", "Amaj7", "Bm", "Bm7", "C#m", "C#m7", "D", "Dmaj7", "E", "E7", "F#m", "F#m7", "G#dim", "G#m7b5"}, namesFrom(borrowed, "Ionian"))
+	assert.Equal(t, "E7 (V7, from Ionian)", borrowed[9].String())
+	assert.Equal(t, "G#m7b5 (#viiø7, from Ionian)", borrowed[13].String())
+	assert.Equal(t, 0, len(namesFrom(borrowed, "Aeolian")))
+}
+
+func TestModalInterchange_SpelledIn(t *testing.T) {
+	for _, b := range Of("Eb").ModalInterchange() {
+		assert.Equal(t, note.Flat, b.Chord.AdjSymbol, b.Name) // even Eb Lydian has two flats
+	}
+	for _, b := range Of("E").ModalInterchange() {
+		if b.Mode == "Lydian" || b.Mode == "Mixolydian" {
+			assert.Equal(t, note.Sharp, b.Chord.AdjSymbol, b.Name)
+		}
+	}
+}
+
+func TestModalInterchange_Letters(t *testing.T) {
+	borrowed := Of("Eb").ModalInterchange()
+	assert.Equal(t, []string{"Ebm", "Ebm7", "Fdim", "Fm7b5", "Gb", "Gbmaj7", "Abm", "Abm7", "Bbm", "Bbm7", "Cb", "Cbmaj7", "Db", "Db7"}, namesFrom(borrowed, "Aeolian"))
+	assert.Equal(t, []string{"Fb", "Fbmaj7", "Gb7", "Bbdim", "Bbm7b5", "Dbm", "Dbm7"}, namesFrom(borrowed, "Phrygian"))
+	assert.Equal(t, []string{"Ebdim", "Ebm7b5", "Gbm", "Gbm7", "Bbb", "Bbbmaj7", "Cb7"}, namesFrom(borrowed, "Locrian"))
+	assert.Equal(t, "Bbb (bV, from Locrian)", borrowed[len(borrowed)-3].String())
+	assert.Equal(t, note.A, borrowed[len(borrowed)-3].Chord.Root)
+	assert.Equal(t, []string{"D#", "D#7", "F##dim", "F##m7b5", "G#maj7", "B#m", "B#m7"}, namesFrom(Of("C#").ModalInterchange(), "Lydian"))
+}
+
+func TestModalInterchange_Nil(t *testing.T) {
+	assert.Nil(t, Key{}.ModalInterchange())
+	assert.Nil(t, Key{Root: note.C}.ModalInterchange())
+	assert.Nil(t, Key{Mode: Major}.ModalInterchange())
+}
+
+func TestSpelledOn(t *testing.T) {
+	assert.Equal(t, "Cb", spelledOn("Eb", 6, note.B))
+	assert.Equal(t, "Bbb", spelledOn("Eb", 5, note.A))
+	assert.Equal(t, "F##", spelledOn("C#", 4, note.G))
+	assert.Equal(t, "Ab", spelledOn("C", 6, note.Gs))
+}
+
+func TestAccidentalOf(t *testing.T) {
+	assert.Equal(t, -1, accidentalOf(-1))
+	assert.Equal(t, -1, accidentalOf(11))
+	assert.Equal(t, 1, accidentalOf(1))
+	assert.Equal(t, 0, accidentalOf(0))
+}
+
+//
+// Private
+//
+
+func namesFrom(borrowed []Borrowed, mode string) []string {
+	var names []string
+	for _, b := range borrowed {
+		if b.Mode == mode {
+			names = append(names, b.Name)
+		}
+	}
+	return names
+}
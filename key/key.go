@@ -14,16 +14,40 @@
 package key
 
 import (
-	"gopkg.in/music-theory.v0/note"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/symbol"
+	"github.com/go-music-theory/music-theory/trace"
 )
 
-// Of a particular key, e.g. Of("C minor 7")
-func Of(name string) Key {
+// Of a particular key, e.g. Of("C minor 7"), with its symbols in ASCII or Unicode, e.g. Of("B♭ minor"), or with options, e.g. Of("Es", WithLocale(locale.German))
+func Of(name string, options ...Option) Key {
+	o := optionsOf(options)
 	k := Key{}
-	k.parse(name)
+	k.parse(o.locale.Translate(symbol.Normalize(name)))
 	return k
 }
 
+// Parse a key name, e.g. Parse("C minor"), returning a *ParseError if its root or mode is unknown
+func Parse(name string, options ...Option) (Key, error) {
+	o := optionsOf(options)
+	k := Of(name, options...)
+	normalized := symbol.Normalize(name)
+	translated := o.locale.Translate(normalized)
+	shift := len(normalized) - len(translated) // of each position after a note name translated from the locale
+	root, remaining := note.RootAndRemaining(translated)
+	if root == note.Nil {
+		return k, &ParseError{Name: name, Position: 0, Text: name, Err: ErrUnknownRoot}
+	}
+	if i, text := unknownModeIn(remaining); i >= 0 {
+		at := shift + strings.LastIndex(translated, remaining) + i
+		position, text := symbol.Unnormalized(name, at, at+len(text))
+		return k, &ParseError{Name: name, Position: position, Text: text, Err: ErrUnknownMode}
+	}
+	return k, nil
+}
+
 // Key is a model of a musical key signature
 type Key struct {
 	Root      note.Class
@@ -31,17 +55,37 @@ type Key struct {
 	Mode      Mode
 }
 
+// Tonic of the key, its root, e.g. to find the function of a chord in it
+func (k Key) Tonic() note.Class {
+	return k.Root
+}
+
 //
 // Private
 //
 
+// adjSymbolOf a key named, the accidental of its root, e.g. Sharp of "F# minor", or else of a natural root, as counted in the whole name, e.g. Flat of "F major"
+func adjSymbolOf(name string) note.AdjSymbol {
+	if root := strings.TrimSpace(name); len(root) > 1 {
+		if adj := note.AdjSymbolBegin(root[1:]); adj != note.No {
+			return adj
+		}
+	}
+	return note.AdjSymbolOf(name)
+}
+
 func (this *Key) parse(name string) {
-	// determine whether the name is "sharps" or "flats"
-	this.AdjSymbol = note.AdjSymbolOf(name)
+	input := name
+
+	// determine whether the name is "sharps" or "flats", by the accidental of its root, if any
+	this.AdjSymbol = adjSymbolOf(name)
 
 	// parse the root, and keep the remaining string
 	this.Root, name = note.RootAndRemaining(name)
 
 	// parse the key mode
 	this.parseMode(name)
+	if trace.Enabled() {
+		trace.Emit(trace.Event{Scope: "key.parse", Input: input, Rule: this.Mode.String()})
+	}
 }
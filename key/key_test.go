@@ -9,7 +9,9 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"fmt"
-	"gopkg.in/music-theory.v0/note"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/trace"
 )
 
 func TestKeys(t *testing.T) {
@@ -24,16 +26,99 @@ func TestKeys(t *testing.T) {
 	for name, expect := range testExpectations.Keys {
 		actual := Of(name)
 		expectMode := modeOf(expect.Mode)
-		assert.Equal(t, note.ClassNamed(expect.Root), actual.Root, fmt.Sprintf("name:%v expect.Root=%v actual.Root=%v", name, expect.Root, actual.Root))
+		root, _ := note.ClassNamed(expect.Root)
+		assert.Equal(t, root, actual.Root, fmt.Sprintf("name:%v expect.Root=%v actual.Root=%v", name, expect.Root, actual.Root))
 		assert.Equal(t, expectMode, actual.Mode, fmt.Sprintf("name:%v expect.Mode=%v actual.Mode==%v", name, expectMode, actual.Mode))
 	}
 }
 
+func TestParseExpectations(t *testing.T) {
+	testExpectations := testExpectationManifest{}
+	file, err := ioutil.ReadFile("testdata/expectations.yaml")
+	assert.Nil(t, err)
+
+	err = yaml.Unmarshal(file, &testExpectations)
+	assert.Nil(t, err)
+
+	for name, expect := range testExpectations.Keys {
+		if _, ok := note.ClassNamed(expect.Root); !ok {
+			continue
+		}
+		actual, err := Parse(name)
+		assert.Nil(t, err, fmt.Sprintf("name:%v", name))
+		assert.Equal(t, Of(name), actual, fmt.Sprintf("name:%v", name))
+	}
+}
+
+func TestParse_UnknownRoot(t *testing.T) {
+	_, err := Parse("P-funk")
+	assert.Equal(t, &ParseError{Name: "P-funk", Position: 0, Text: "P-funk", Err: ErrUnknownRoot}, err)
+}
+
+func TestParse_UnknownMode(t *testing.T) {
+	_, err := Parse("C jams")
+	assert.Equal(t, &ParseError{Name: "C jams", Position: 2, Text: "jams", Err: ErrUnknownMode}, err)
+	_, err = Parse("C minor 7")
+	assert.Equal(t, &ParseError{Name: "C minor 7", Position: 8, Text: "7", Err: ErrUnknownMode}, err)
+	_, err = Parse("Cmaj. ")
+	assert.Nil(t, err)
+	_, err = Parse("C Major")
+	assert.Nil(t, err)
+	_, err = Parse("G♭ jams")
+	assert.Equal(t, &ParseError{Name: "G♭ jams", Position: 5, Text: "jams", Err: ErrUnknownMode}, err)
+}
+
+func TestOf_Unicode(t *testing.T) {
+	assert.Equal(t, Of("Bb minor"), Of("B♭ minor"))
+	assert.Equal(t, Of("F# minor"), Of("F♯ minor"))
+	assert.Equal(t, Of("C"), Of("C♮"))
+}
+
+func TestParse_DoubleAccidentals(t *testing.T) {
+	k, err := Parse("F𝄪 major")
+	assert.Nil(t, err)
+	assert.Equal(t, note.G, k.Root)
+	assert.Equal(t, Major, k.Mode)
+	k, err = Parse("B𝄫 minor")
+	assert.Nil(t, err)
+	assert.Equal(t, note.A, k.Root)
+	assert.Equal(t, Minor, k.Mode)
+}
+
 func TestOf_Invalid(t *testing.T) {
 	k := Of("P-funk")
 	assert.Equal(t, note.Nil, k.Root)
 }
 
+func TestOf_Traced(t *testing.T) {
+	var events []trace.Event
+	previous := trace.Set(trace.HookFunc(func(e trace.Event) { events = append(events, e) }))
+	defer trace.Set(previous)
+	Of("Eb minor")
+	assert.Equal(t, []trace.Event{{Scope: "key.parse", Input: "Eb minor", Rule: "Minor"}}, events)
+}
+
+func TestOf_AllocationBudget(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+	for _, name := range benchmarkNames {
+		assert.True(t, testing.AllocsPerRun(100, func() { Of(name) }) <= 0, fmt.Sprintf("name:%v", name))
+	}
+}
+
+func BenchmarkKeyOf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Of(benchmarkNames[i%len(benchmarkNames)])
+	}
+}
+
+func BenchmarkKeyParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse(benchmarkNames[i%len(benchmarkNames)])
+	}
+}
+
 //
 // Private
 //
@@ -46,3 +131,6 @@ type testKey struct {
 type testExpectationManifest struct {
 	Keys map[string]testKey
 }
+
+// benchmarkNames of keys, major and minor
+var benchmarkNames = []string{"C", "A minor", "Bb", "F# minor", "Eb major", "G minor"}
@@ -3,6 +3,7 @@ package key
 
 import (
 	"regexp"
+	"strings"
 )
 
 // Mode is the mode of a key, e.g. Major or Minor
@@ -34,8 +35,16 @@ func (of Mode) String() string {
 var (
 	rgxMajor, _ = regexp.Compile("^(M|maj|major)")
 	rgxMinor, _ = regexp.Compile("^(m\\b|min|minor|Minor)")
+	rgxMode     = longest("^(M|maj|major|Major|m\\b|min|minor|Minor)\\.?")
 )
 
+// longest-matching regular expression, e.g. to match all of "minor" instead of only "min"
+func longest(s string) *regexp.Regexp {
+	r, _ := regexp.Compile(s)
+	r.Longest()
+	return r
+}
+
 func (k *Key) parseMode(name string) {
 	// parse the chord Mode
 	k.Mode = modeOf(name)
@@ -51,3 +60,13 @@ func modeOf(name string) Mode {
 		return Major
 	}
 }
+
+// unknownModeIn a name, the position and text following its mode, or -1 if there's nothing but the mode
+func unknownModeIn(name string) (int, string) {
+	rest := name[len(rgxMode.FindString(name)):]
+	text := strings.TrimSpace(rest)
+	if len(text) == 0 {
+		return -1, ""
+	}
+	return len(name) - len(rest) + strings.Index(rest, text), text
+}
@@ -0,0 +1,6 @@
+// +build !race
+
+// The race detector allocates of its own, so allocation budgets aren't held to under it
+package key
+
+const raceEnabled = false
@@ -0,0 +1,35 @@
+// Key names are parsed in English by default, or in another locale with an Option, e.g. Of("Es", WithLocale(locale.German))
+package key
+
+import (
+	"github.com/go-music-theory/music-theory/locale"
+)
+
+// Option for parsing a key name
+type Option func(*options)
+
+// WithLocale of the note name at the beginning of a key name, e.g. WithLocale(locale.German) to parse "H minor" as B minor
+func WithLocale(l locale.Locale) Option {
+	return func(o *options) {
+		o.locale = l
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	locale locale.Locale
+}
+
+func optionsOf(opts []Option) options {
+	if len(opts) == 0 {
+		return options{} // without allocating, as the options applied below would
+	}
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
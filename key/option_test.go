@@ -0,0 +1,19 @@
+// Key names are parsed in English by default, or in another locale with an Option, e.g. Of("Es", WithLocale(locale.German))
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/locale"
+)
+
+func TestWithLocale(t *testing.T) {
+	assert.Equal(t, options{locale: locale.German}, optionsOf([]Option{WithLocale(locale.German)}))
+	assert.Equal(t, Of("Eb"), Of("Es", WithLocale(locale.German)))
+	assert.Equal(t, Of("B minor"), Of("H minor", WithLocale(locale.German)))
+	assert.Equal(t, Of("G"), Of("Sol", WithLocale(locale.Solfege)))
+	_, err := Parse("Fis jams", WithLocale(locale.German))
+	assert.Equal(t, &ParseError{Name: "Fis jams", Position: 4, Text: "jams", Err: ErrUnknownMode}, err)
+}
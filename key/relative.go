@@ -1,8 +1,8 @@
-// The relative minor of a major key has the same key signature and starts down a minor third (or equivalently up a major sixth); for example, the relative minor of G major is E minor. Similarly the relative major of a minor key starts up a minor third (or down a major sixth); for example, the relative major of F minor is A♭ major.
+// The relative minor of a major key has the same key signature and starts down a minor third (or equivalently up a major sixth); for example, the relative minor of G major is E minor. Similarly the relative major of a minor key starts up a minor third (or down a major sixth); for example, the relative major of F minor is A♭ major. The parallel key has the same tonic in the other mode, and the closely related keys have signatures that differ by at most one accidental.
 package key
 
 import (
-	"gopkg.in/music-theory.v0/note"
+	"github.com/go-music-theory/music-theory/note"
 )
 
 func (k Key) RelativeMinor() (rk Key) {
@@ -24,3 +24,74 @@ func (k Key) RelativeMajor() (rk Key) {
 	}
 	return
 }
+
+// Relative key, with the same key signature, the relative minor of a major key or the relative major of a minor key, e.g. A minor of C major
+func (k Key) Relative() Key {
+	switch k.Mode {
+	case Major:
+		return spelledBySignature(k.RelativeMinor(), k.AdjSymbol)
+	case Minor:
+		return spelledBySignature(k.RelativeMajor(), k.AdjSymbol)
+	}
+	return k
+}
+
+// Parallel key, with the same tonic in the other mode, the parallel minor of a major key or the parallel major of a minor key, e.g. C minor of C major
+func (k Key) Parallel() Key {
+	pk := k
+	switch k.Mode {
+	case Major:
+		pk.Mode = Minor
+	case Minor:
+		pk.Mode = Major
+	default:
+		return k
+	}
+	return spelledBySignature(pk, k.AdjSymbol)
+}
+
+// CloselyRelated keys, those whose signatures differ from the key's by at most one accidental: its relative, then its dominant and subdominant keys
+// and their relatives, e.g. A minor, G major, E minor, F major and D minor of C major
+func (k Key) CloselyRelated() []Key {
+	if k.Mode != Major && k.Mode != Minor {
+		return nil
+	}
+	dominant, subdominant := k, k
+	dominant.Root, _ = k.Root.Step(7)
+	subdominant.Root, _ = k.Root.Step(5)
+	dominant, subdominant = spelledBySignature(dominant, k.AdjSymbol), spelledBySignature(subdominant, k.AdjSymbol)
+	return []Key{k.Relative(), dominant, dominant.Relative(), subdominant, subdominant.Relative()}
+}
+
+// Distance to another key in fifths around the circle of fifths, the shortest way, sharpward if positive and flatward if negative,
+// from -5 up to 6, e.g. 1 from C major to G major or E minor, -3 from C major to C minor, or 6 from C major to F# major either way
+func (k Key) Distance(other Key) int {
+	return ((circlePositionOf(other)-circlePositionOf(k))%12+17)%12 - 5
+}
+
+//
+// Private
+//
+
+// circlePositionOf a key, the fifths of its signature from C major, from 0 up to 11 around the circle, regardless of its spelling
+func circlePositionOf(k Key) int {
+	major := k
+	if k.Mode == Minor {
+		major = k.RelativeMajor()
+	}
+	return (int(major.Root-note.C)*7%12 + 12) % 12
+}
+
+// spelledBySignature a key, in sharps or flats, whichever of its signatures has fewer accidentals, e.g. Db major rather than C# major,
+// or else as it's spelled by preference, e.g. F# major or Gb major
+func spelledBySignature(k Key, preference note.AdjSymbol) Key {
+	switch fifths := circlePositionOf(k); {
+	case fifths < 6:
+		k.AdjSymbol = note.Sharp
+	case fifths > 6:
+		k.AdjSymbol = note.Flat
+	default:
+		k.AdjSymbol = preference
+	}
+	return k
+}
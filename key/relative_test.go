@@ -1,10 +1,12 @@
-// The relative minor of a major key has the same key signature and starts down a minor third (or equivalently up a major sixth); for example, the relative minor of G major is E minor. Similarly the relative major of a minor key starts up a minor third (or down a major sixth); for example, the relative major of F minor is A♭ major.
+// The relative minor of a major key has the same key signature and starts down a minor third (or equivalently up a major sixth); for example, the relative minor of G major is E minor. Similarly the relative major of a minor key starts up a minor third (or down a major sixth); for example, the relative major of F minor is A♭ major. The parallel key has the same tonic in the other mode, and the closely related keys have signatures that differ by at most one accidental.
 package key
 
 import (
 	"testing"
 
 	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
 )
 
 func TestRelativeMajor(t *testing.T) {
@@ -18,3 +20,55 @@ func TestRelativeMinor(t *testing.T) {
 	expectRk := Of("A minor")
 	assert.Equal(t, expectRk, k.RelativeMinor())
 }
+
+func TestRelative(t *testing.T) {
+	assert.Equal(t, "A Minor", Of("C major").Relative().Canonical())
+	assert.Equal(t, "C Major", Of("A minor").Relative().Canonical())
+	assert.Equal(t, "C# Minor", Of("E major").Relative().Canonical())
+	assert.Equal(t, "Ab Major", Of("F minor").Relative().Canonical())
+	assert.Equal(t, Key{Root: note.C}, Key{Root: note.C}.Relative(), "no relative without a mode")
+}
+
+func TestParallel(t *testing.T) {
+	assert.Equal(t, "C Minor", Of("C major").Parallel().Canonical())
+	assert.Equal(t, "A Major", Of("A minor").Parallel().Canonical())
+	assert.Equal(t, "Eb Minor", Of("Eb major").Parallel().Canonical())
+	assert.Equal(t, "Db Major", Of("C# minor").Parallel().Canonical(), "with fewer accidentals than C# major")
+	assert.Equal(t, "Gb Major", Key{Root: note.Fs, AdjSymbol: note.Flat, Mode: Minor}.Parallel().Canonical(), "as spelled, with as many accidentals either way")
+	assert.Equal(t, "F# Major", Key{Root: note.Fs, AdjSymbol: note.Sharp, Mode: Minor}.Parallel().Canonical())
+}
+
+func TestCloselyRelated(t *testing.T) {
+	assert.Equal(t, []string{"A Minor", "G Major", "E Minor", "F Major", "D Minor"}, canonicalsOf(Of("C major").CloselyRelated()))
+	assert.Equal(t, []string{"Bb Major", "D Minor", "F Major", "C Minor", "Eb Major"}, canonicalsOf(Of("G minor").CloselyRelated()))
+	assert.Equal(t, []string{"F# Minor", "E Major", "C# Minor", "D Major", "B Minor"}, canonicalsOf(Of("A major").CloselyRelated()))
+	for _, rk := range Of("Eb major").CloselyRelated() {
+		assert.True(t, Of("Eb major").Distance(rk) >= -1 && Of("Eb major").Distance(rk) <= 1, rk.Canonical())
+	}
+	assert.Nil(t, Key{Root: note.C}.CloselyRelated())
+}
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, Of("C major").Distance(Of("A minor")))
+	assert.Equal(t, 1, Of("C major").Distance(Of("G major")))
+	assert.Equal(t, 1, Of("C major").Distance(Of("E minor")))
+	assert.Equal(t, -1, Of("C major").Distance(Of("F major")))
+	assert.Equal(t, -3, Of("C major").Distance(Of("C minor")))
+	assert.Equal(t, 3, Of("C minor").Distance(Of("C major")))
+	assert.Equal(t, 2, Of("Bb major").Distance(Of("C major")))
+	assert.Equal(t, 6, Of("C major").Distance(Of("F# major")))
+	assert.Equal(t, 6, Of("F# major").Distance(Of("C major")), "either way")
+	assert.Equal(t, -5, Of("C major").Distance(Of("Db major")))
+	assert.Equal(t, 0, Of("C# major").Distance(Of("Db major")), "regardless of spelling")
+}
+
+//
+// Private
+//
+
+func canonicalsOf(keys []Key) (names []string) {
+	for _, k := range keys {
+		names = append(names, k.Canonical())
+	}
+	return
+}
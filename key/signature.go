@@ -0,0 +1,50 @@
+// A key signature is the set of sharps or flats of a key, counted in fifths on the circle of fifths from C major, e.g. one sharp for G major or E minor.
+package key
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Fifths of the key signature from C major, the number of sharps if positive, or flats if negative, e.g. 1 for G major or -3 for C minor
+func (k Key) Fifths() int {
+	major := k
+	if k.Mode == Minor {
+		major = k.RelativeMajor()
+	}
+	fifths := (int(major.Root-note.C)*7%12 + 12) % 12
+	if k.AdjSymbol == note.Flat && fifths >= 5 {
+		fifths -= 12
+	} else if k.AdjSymbol != note.Flat && fifths > 7 {
+		fifths -= 12
+	}
+	return fifths
+}
+
+// Accidentals of the key signature, in the order they're written, e.g. F# C# of D major, or Bb Eb Ab of C minor
+func (k Key) Accidentals() []string {
+	fifths := k.Fifths()
+	if fifths > 0 {
+		return signatureOrder(sharpsOrder, fifths)
+	}
+	return signatureOrder(flatsOrder, -fifths)
+}
+
+//
+// Private
+//
+
+var (
+	// sharpsOrder of the sharps of a key signature, each a fifth above the last
+	sharpsOrder = []string{"F#", "C#", "G#", "D#", "A#", "E#", "B#"}
+
+	// flatsOrder of the flats of a key signature, each a fifth below the last
+	flatsOrder = []string{"Bb", "Eb", "Ab", "Db", "Gb", "Cb", "Fb"}
+)
+
+// signatureOrder of the first few accidentals, e.g. F# C# of two sharps, to copy, of at most all seven
+func signatureOrder(order []string, count int) []string {
+	if count > len(order) {
+		count = len(order)
+	}
+	return append([]string{}, order[:count]...)
+}
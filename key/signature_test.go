@@ -0,0 +1,46 @@
+// A key signature is the set of sharps or flats of a key, counted in fifths on the circle of fifths from C major, e.g. one sharp for G major or E minor.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestFifths(t *testing.T) {
+	assert.Equal(t, 0, Of("C").Fifths())
+	assert.Equal(t, 0, Of("A minor").Fifths())
+	assert.Equal(t, 1, Of("G").Fifths())
+	assert.Equal(t, 5, Of("B").Fifths())
+	assert.Equal(t, -6, Of("Gb").Fifths())
+	assert.Equal(t, -5, Of("Db").Fifths())
+	assert.Equal(t, 7, Of("C#").Fifths())
+	assert.Equal(t, -1, Of("F").Fifths())
+	assert.Equal(t, -1, Of("D minor").Fifths())
+	assert.Equal(t, -3, Of("C minor").Fifths())
+	assert.Equal(t, 3, Of("A").Fifths())
+	assert.Equal(t, 6, Of("F#").Fifths())
+	assert.Equal(t, 6, Of("F# major").Fifths())
+	assert.Equal(t, 3, Of("F# minor").Fifths())
+	assert.Equal(t, -6, Of("Gb major").Fifths())
+}
+
+func TestAccidentals(t *testing.T) {
+	assert.Equal(t, []string{}, Of("C").Accidentals())
+	assert.Equal(t, []string{}, Of("A minor").Accidentals())
+	assert.Equal(t, []string{"F#", "C#"}, Of("D").Accidentals())
+	assert.Equal(t, []string{"Bb", "Eb", "Ab"}, Of("C minor").Accidentals())
+	assert.Equal(t, []string{"Bb", "Eb", "Ab", "Db", "Gb"}, Of("Db").Accidentals())
+	assert.Equal(t, []string{"F#", "C#", "G#", "D#", "A#", "E#", "B#"}, Of("C#").Accidentals())
+	assert.Equal(t, []string{"F#", "C#", "G#", "D#", "A#", "E#"}, Of("F#").Accidentals())
+	assert.Equal(t, []string{"F#", "C#", "G#"}, Of("F# minor").Accidentals())
+}
+
+func TestOf_FSharp(t *testing.T) {
+	assert.Equal(t, "F# Major", Of("F#").Canonical())
+	assert.Equal(t, "F# Minor", Of("F# minor").Canonical())
+	assert.Equal(t, "F# Major", Of("F# minor").Parallel().Canonical())
+	assert.Equal(t, "A Major", Of("F# minor").Relative().Canonical())
+	assert.Equal(t, "F Major", Of("F").Canonical())
+	assert.Equal(t, -1, Of("F").Fifths())
+}
@@ -2,15 +2,46 @@
 package key
 
 import (
+	"encoding/json"
+
 	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/schema"
 )
 
+// ToYAML of the Key, e.g. for the command-line utility
 func (k Key) ToYAML() string {
 	spec := specFrom(k)
 	out, _ := yaml.Marshal(spec)
 	return string(out[:])
 }
 
+// ToJSON of the Key, e.g. for a web app
+func (k Key) ToJSON() string {
+	spec := specFrom(k)
+	out, _ := json.Marshal(spec)
+	return string(out[:])
+}
+
+// ToYAMLSchema of the Key in a version of the schema, e.g. schema.V2 with its signature and the chord on each degree, else as ToYAML
+func (k Key) ToYAMLSchema(v schema.Version) string {
+	if v != schema.V2 {
+		return k.ToYAML()
+	}
+	out, _ := yaml.Marshal(specV2From(k))
+	return string(out[:])
+}
+
+// ToJSONSchema of the Key in a version of the schema, e.g. schema.V2 with its signature and the chord on each degree, else as ToJSON
+func (k Key) ToJSONSchema(v schema.Version) string {
+	if v != schema.V2 {
+		return k.ToJSON()
+	}
+	out, _ := json.Marshal(specV2From(k))
+	return string(out[:])
+}
+
 //
 // Private
 //
@@ -32,12 +63,54 @@ func specFrom(k Key) specKey {
 }
 
 type specKey struct {
-	Root     string
-	Mode     string
-	Relative specRelativeKey
+	Root     string          `json:"root"`
+	Mode     string          `json:"mode"`
+	Relative specRelativeKey `json:"relative"`
 }
 
 type specRelativeKey struct {
-	Root string
-	Mode string
+	Root string `json:"root"`
+	Mode string `json:"mode"`
+}
+
+// specV2From a key, its v1 spec, its parallel key, the fifths of its signature, its closely related keys by their distance in fifths,
+// and the name of the diatonic chord on each degree
+func specV2From(k Key) specKeyV2 {
+	v1 := specFrom(k)
+	s := specKeyV2{Schema: string(schema.V2), Root: v1.Root, Mode: v1.Mode, Relative: v1.Relative, Fifths: k.Fifths(), Related: []specRelatedKey{}, Degrees: []specDegree{}}
+	if pk := k.Parallel(); pk.Mode != k.Mode {
+		s.Parallel = &specRelativeKey{Root: pk.Root.String(pk.AdjSymbol), Mode: pk.Mode.String()}
+	}
+	for _, rk := range k.CloselyRelated() {
+		s.Related = append(s.Related, specRelatedKey{Root: rk.Root.String(rk.AdjSymbol), Mode: rk.Mode.String(), Distance: k.Distance(rk)})
+	}
+	for n, c := range k.DiatonicChords() {
+		third, _ := c.ClassAt(chord.I3)
+		fifth, _ := c.ClassAt(chord.I5)
+		name := c.Root.String(c.AdjSymbol) + triadSuffixOf(c.Root.Diff(third), c.Root.Diff(fifth))
+		s.Degrees = append(s.Degrees, specDegree{Degree: n + 1, Chord: name})
+	}
+	return s
+}
+
+type specKeyV2 struct {
+	Schema   string           `json:"schema"`
+	Root     string           `json:"root"`
+	Mode     string           `json:"mode"`
+	Relative specRelativeKey  `json:"relative"`
+	Parallel *specRelativeKey `json:"parallel,omitempty" yaml:",omitempty"`
+	Fifths   int              `json:"fifths"`
+	Related  []specRelatedKey `json:"related"`
+	Degrees  []specDegree     `json:"degrees"`
+}
+
+type specRelatedKey struct {
+	Root     string `json:"root"`
+	Mode     string `json:"mode"`
+	Distance int    `json:"distance"` // in fifths, e.g. 1 of the dominant key
+}
+
+type specDegree struct {
+	Degree int    `json:"degree"`
+	Chord  string `json:"chord"`
 }
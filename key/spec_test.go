@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/schema"
 )
 
 func TestToYAML(t *testing.T) {
@@ -12,6 +14,65 @@ func TestToYAML(t *testing.T) {
 	testKeySpecYAML(t, "A minor", "root: A\nmode: Minor\nrelative:\n  root: C\n  mode: Major\n")
 }
 
+func TestToJSON(t *testing.T) {
+	c := Of("C major")
+	out := c.ToJSON()
+	assert.Equal(t, `{"root":"C","mode":"Major","relative":{"root":"A","mode":"Minor"}}`, out)
+}
+
+func TestToYAMLSchema(t *testing.T) {
+	k := Of("Eb major")
+	assert.Equal(t, k.ToYAML(), k.ToYAMLSchema(schema.V1))
+	assert.Equal(t, `schema: v2
+root: Eb
+mode: Major
+relative:
+  root: C
+  mode: Minor
+parallel:
+  root: Eb
+  mode: Minor
+fifths: -3
+related:
+- root: C
+  mode: Minor
+  distance: 0
+- root: Bb
+  mode: Major
+  distance: 1
+- root: G
+  mode: Minor
+  distance: 1
+- root: Ab
+  mode: Major
+  distance: -1
+- root: F
+  mode: Minor
+  distance: -1
+degrees:
+- degree: 1
+  chord: Eb
+- degree: 2
+  chord: Fm
+- degree: 3
+  chord: Gm
+- degree: 4
+  chord: Ab
+- degree: 5
+  chord: Bb
+- degree: 6
+  chord: Cm
+- degree: 7
+  chord: Ddim
+`, k.ToYAMLSchema(schema.V2))
+}
+
+func TestToJSONSchema(t *testing.T) {
+	k := Of("D major")
+	assert.Equal(t, k.ToJSON(), k.ToJSONSchema(schema.V1))
+	assert.Equal(t, `{"schema":"v2","root":"D","mode":"Major","relative":{"root":"B","mode":"Minor"},"parallel":{"root":"D","mode":"Minor"},"fifths":2,"related":[{"root":"B","mode":"Minor","distance":0},{"root":"A","mode":"Major","distance":1},{"root":"F#","mode":"Minor","distance":1},{"root":"G","mode":"Major","distance":-1},{"root":"E","mode":"Minor","distance":-1}],"degrees":[{"degree":1,"chord":"D"},{"degree":2,"chord":"Em"},{"degree":3,"chord":"F#m"},{"degree":4,"chord":"G"},{"degree":5,"chord":"A"},{"degree":6,"chord":"Bm"},{"degree":7,"chord":"C#dim"}]}`, k.ToJSONSchema(schema.V2))
+}
+
 //
 // Private
 //
@@ -0,0 +1,17 @@
+// A key is transposed up or down some semitones to another key of the same mode, spelled by the key signature of fewer accidentals, e.g. Bb major two semitones up from Ab major
+package key
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Transposed some semitones, up if positive or down if negative, in the same mode, spelled in sharps or flats, whichever of its signatures has fewer accidentals,
+// or else as the key is spelled, e.g. F# major six semitones up from C major, or as it is without a root
+func (k Key) Transposed(semitones int) Key {
+	if k.Root == note.Nil {
+		return k
+	}
+	tk := k
+	tk.Root, _ = k.Root.Step(semitones)
+	return spelledBySignature(tk, k.AdjSymbol)
+}
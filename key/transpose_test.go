@@ -0,0 +1,22 @@
+// A key is transposed up or down some semitones to another key of the same mode, spelled by the key signature of fewer accidentals, e.g. Bb major two semitones up from Ab major
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestKey_Transposed(t *testing.T) {
+	assert.Equal(t, "D Major", Of("C major").Transposed(2).Canonical())
+	assert.Equal(t, "Bb Major", Of("Ab major").Transposed(2).Canonical())
+	assert.Equal(t, "Eb Minor", Of("E minor").Transposed(-1).Canonical())
+	assert.Equal(t, "Db Major", Of("C major").Transposed(13).Canonical(), "with fewer accidentals than C# major")
+	assert.Equal(t, "F# Major", Of("C major").Transposed(6).Canonical(), "as spelled, with as many accidentals either way")
+	assert.Equal(t, "Gb Major", Of("Db major").Transposed(5).Canonical())
+	assert.Equal(t, "A Minor", Of("A minor").Transposed(0).Canonical())
+	assert.Equal(t, Key{}, Key{}.Transposed(2), "no root")
+	assert.Equal(t, Key{Root: note.D, AdjSymbol: note.Sharp}, Key{Root: note.C}.Transposed(2), "without a mode")
+}
@@ -0,0 +1,31 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-music-theory/music-theory/listen"
+	"github.com/go-music-theory/music-theory/osc"
+)
+
+// listenTo a MIDI input port, writing the notes held, their chord and the key estimated so far, each time they change, until the port is closed,
+// and sending them as OSC messages to a host and port, if any, e.g. localhost:57120
+func listenTo(w io.Writer, portName string, oscAddress string) error {
+	var opts []listen.Option
+	if len(oscAddress) > 0 {
+		client, err := osc.Dial(oscAddress)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		opts = append(opts, listen.WithOSC(client))
+	}
+	port, err := listen.Open(portName)
+	if err != nil {
+		return err
+	}
+	defer port.Close()
+	fmt.Fprintf(w, "Listening to %s\n", portName)
+	return listen.New(opts...).Listen(port, w)
+}
@@ -0,0 +1,108 @@
+// The chord of the notes held is named by the first of the common chords that has exactly their pitch classes, rooted on the lowest of them if it can be
+package listen
+
+import (
+	"fmt"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+// ChordOf some MIDI note numbers, the common chord of exactly their pitch classes, spelled with an accidental,
+// rooted on the lowest note if it can be, else on another in ascending order, e.g. Am7 of A C E G but C6 of C E G A, or a chord of the Root Nil if none is
+func ChordOf(numbers []int, adjSymbol note.AdjSymbol) chord.Chord {
+	if len(numbers) == 0 {
+		return chord.Chord{}
+	}
+	held, bass := heldOf(numbers)
+	for _, root := range rootsOf(held, bass) {
+		for _, symbol := range chordSymbols {
+			c := chord.Of(root.String(adjSymbol) + symbol)
+			if c.ToneSet().Equal(held) {
+				return c
+			}
+		}
+	}
+	return chord.Chord{}
+}
+
+// ChordsOf some MIDI note numbers, every common chord sharing at least three quarters of the pitch classes of it and them, spelled with an accidental,
+// ranked by the similarity of its pitch classes to theirs, with its confidence among them all, and then rooted on the lowest note, else on another in ascending order,
+// e.g. Am7 before Am9 and Am of A C E G, or none of no notes, so the first of them is the ChordOf the notes, if it's of exactly their pitch classes
+func ChordsOf(numbers []int, adjSymbol note.AdjSymbol) []candidate.Candidate[chord.Chord] {
+	if len(numbers) == 0 {
+		return nil
+	}
+	held, bass := heldOf(numbers)
+	roots := rootsOf(held, bass)
+	for root := note.C; root <= note.B; root++ {
+		if !held[root] {
+			roots = append(roots, root)
+		}
+	}
+	var candidates []candidate.Candidate[chord.Chord]
+	var sets []toneset.Set
+	for _, root := range roots {
+		for _, symbol := range chordSymbols {
+			c := chord.Of(root.String(adjSymbol) + symbol)
+			tones := c.ToneSet()
+			if similarity := tones.Similarity(held); similarity >= 0.75 && !containsSet(sets, tones) {
+				sets = append(sets, tones)
+				candidates = append(candidates, candidate.Of(c, similarity))
+			}
+		}
+	}
+	ranked := candidate.Rank(candidates)
+	if trace.Enabled() {
+		trace.Ranked("listen.chord", fmt.Sprint(numbers), ranked, chord.Chord.Name)
+	}
+	return ranked
+}
+
+//
+// Private
+//
+
+// chordSymbols of the common chords, after their root, from the most to the least common of those with the same pitch classes, e.g. C6 before Am7/C
+var chordSymbols = []string{
+	"", "m", "dim", "aug", "sus4",
+	"7", "M7", "m7", "m7b5", "dim7", "6", "m6", "mM7", "aug7",
+	"add9", "9", "M9", "m9", "69",
+}
+
+// heldOf some MIDI note numbers, their pitch classes, and the pitch class of the lowest of them
+func heldOf(numbers []int) (toneset.Set, note.Class) {
+	held := toneset.Of()
+	lowest := numbers[0]
+	for _, number := range numbers {
+		held[classOf(number)] = true
+		if number < lowest {
+			lowest = number
+		}
+	}
+	return held, classOf(lowest)
+}
+
+// rootsOf the pitch classes held, the bass first, then the others in ascending order from C
+func rootsOf(held toneset.Set, bass note.Class) []note.Class {
+	roots := []note.Class{bass}
+	for _, class := range held.Classes() {
+		if class != bass {
+			roots = append(roots, class)
+		}
+	}
+	return roots
+}
+
+// containsSet of some sets of pitch classes, whether one of them is the same as a set, e.g. of another symbol on another root
+func containsSet(sets []toneset.Set, tones toneset.Set) bool {
+	for _, s := range sets {
+		if s.Equal(tones) {
+			return true
+		}
+	}
+	return false
+}
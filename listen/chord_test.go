@@ -0,0 +1,53 @@
+// The chord of the notes held is named by the first of the common chords that has exactly their pitch classes, rooted on the lowest of them if it can be
+package listen
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestChordOf(t *testing.T) {
+	assertChordOf(t, "C", note.Sharp, 60, 64, 67)
+	assertChordOf(t, "C", note.Sharp, 67, 64, 48)
+	assertChordOf(t, "Am7", note.Sharp, 57, 60, 64, 67)
+	assertChordOf(t, "C6", note.Sharp, 48, 57, 64, 67)
+	assertChordOf(t, "G7", note.Sharp, 59, 65, 67, 74) // rooted on G, not the B in the bass
+	assertChordOf(t, "Bbm7b5", note.Flat, 58, 61, 64, 68)
+	assertChordOf(t, "C#", note.Sharp, 61, 65, 68)
+	assertChordOf(t, "Db", note.Flat, 61, 65, 68)
+	assertChordOf(t, "CM9", note.Sharp, 48, 52, 55, 59, 62)
+	assertChordOf(t, "", note.Sharp, 60)
+	assertChordOf(t, "", note.Sharp, 60, 61, 62)
+	assertChordOf(t, "", note.Sharp)
+}
+
+func TestChordsOf(t *testing.T) {
+	chords := ChordsOf([]int{57, 60, 64, 67}, note.Sharp)
+	assert.Equal(t, []string{"Am7", "Am9", "C69", "FM9", "Am", "C"}, chordNamesOf(chords))
+	assert.Equal(t, ChordOf([]int{57, 60, 64, 67}, note.Sharp), chords[0].Value)
+	assert.Equal(t, 1.0, chords[0].Score)
+	assert.InDelta(t, 0.2, chords[0].Confidence, 0.01)
+	assert.Equal(t, []string{"C", "C7", "CM7", "C6", "Cadd9"}, chordNamesOf(ChordsOf([]int{48, 64, 67}, note.Sharp))[:5]) // C6 rooted on the bass, not Am7
+	assert.Nil(t, ChordsOf([]int{60}, note.Sharp))
+	assert.Nil(t, ChordsOf(nil, note.Sharp))
+}
+
+//
+// Private
+//
+
+func assertChordOf(t *testing.T, name string, adjSymbol note.AdjSymbol, numbers ...int) {
+	assert.Equal(t, name, ChordOf(numbers, adjSymbol).Name())
+}
+
+func chordNamesOf(chords []candidate.Candidate[chord.Chord]) (names []string) {
+	for _, c := range chords {
+		names = append(names, c.Value.Name())
+	}
+	return
+}
@@ -0,0 +1,205 @@
+// Live analysis of the notes held on a MIDI instrument, naming the chord they sound and estimating the key of the chords played so far.
+//
+// MIDI messages are read from a port, a raw MIDI device, e.g. /dev/snd/midiC1D0 on Linux, or with the rtmidi build tag,
+// a port of the RtMidi library by its number.
+//
+// https://en.wikipedia.org/wiki/MIDI
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package listen
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/osc"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// History of the chords played, at most, by which the key is estimated, so that it follows a change of key
+const History = 16
+
+// ErrUnknownPort when opening a MIDI input port that doesn't exist
+var ErrUnknownPort = errors.New("unknown MIDI port")
+
+// Listener to the notes of a MIDI instrument, buffering those held, and remembering the chords they've sounded
+type Listener struct {
+	held     map[int]int // count of note ons without a note off of each number, of any channel
+	history  progression.Progression
+	analysis Analysis
+	options  options
+}
+
+// Option of a listener, e.g. WithOSC
+type Option func(*options)
+
+// Analysis of the notes held at a moment, the chord they sound, if any, and the key estimated by the chords played until then
+type Analysis struct {
+	Held  []int       // MIDI note numbers, in ascending order
+	Chord chord.Chord // of the Root Nil if the notes held aren't a known chord
+	Bass  note.Class  // of the lowest note held
+	Key   key.Key     // of the Mode Nil before any chord is played
+}
+
+// New listener, holding no notes, with any options
+func New(opts ...Option) *Listener {
+	l := &Listener{held: make(map[int]int)}
+	for _, opt := range opts {
+		opt(&l.options)
+	}
+	return l
+}
+
+// NoteOn of a note number, or a note off if its velocity is 0, and whether it changed the notes held
+func (l *Listener) NoteOn(number, velocity int) bool {
+	if velocity == 0 {
+		return l.NoteOff(number)
+	}
+	l.held[number]++
+	if l.held[number] > 1 {
+		return false
+	}
+	l.analyze()
+	return true
+}
+
+// NoteOff of a note number, and whether it changed the notes held
+func (l *Listener) NoteOff(number int) bool {
+	if l.held[number] == 0 {
+		return false
+	}
+	l.held[number]--
+	if l.held[number] > 0 {
+		return false
+	}
+	delete(l.held, number)
+	l.analyze()
+	return true
+}
+
+// Held notes, their MIDI note numbers in ascending order
+func (l *Listener) Held() []int {
+	numbers := make([]int, 0, len(l.held))
+	for number := range l.held {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	return numbers
+}
+
+// Analysis of the notes held now, in the key of the chords played so far
+func (l *Listener) Analysis() Analysis {
+	return l.analysis
+}
+
+// Listen to the MIDI messages read until the end, or an error, writing the analysis of the notes held each time they change,
+// and sending its messages to any OSC client
+func (l *Listener) Listen(r io.Reader, w io.Writer) error {
+	return readMessages(r, func(m message) error {
+		var changed bool
+		switch m.status & 0xF0 {
+		case 0x90:
+			changed = l.NoteOn(int(m.data[0]), int(m.data[1]))
+		case 0x80:
+			changed = l.NoteOff(int(m.data[0]))
+		}
+		if !changed {
+			return nil
+		}
+		if _, err := fmt.Fprintln(w, l.analysis.String()); err != nil {
+			return err
+		}
+		if l.options.osc != nil {
+			return l.options.osc.Send(l.analysis.Messages()...)
+		}
+		return nil
+	})
+}
+
+// String of the analysis, the notes held, the chord they sound over any other bass, and the key, e.g. "E3 C4 G4  C/E  C major",
+// or "-" of a chord that isn't known, or of no notes held
+func (a Analysis) String() string {
+	notes := make([]string, len(a.Held))
+	for n, number := range a.Held {
+		notes[n] = fmt.Sprintf("%s%d", classOf(number).String(adjSymbolOf(a.Key)), octaveOf(number))
+	}
+	name := "-"
+	if a.Chord.Root != note.Nil {
+		name = a.Chord.Name()
+		if a.Bass != a.Chord.Root {
+			name += "/" + a.Bass.String(adjSymbolOf(a.Key))
+		}
+	}
+	if len(notes) == 0 {
+		notes = []string{"-"}
+	}
+	k := "-"
+	if a.Key.Mode != key.Nil {
+		k = a.Key.Root.String(a.Key.AdjSymbol) + " " + strings.ToLower(a.Key.Mode.String())
+	}
+	return fmt.Sprintf("%-24s %-12s %s", strings.Join(notes, " "), name, k)
+}
+
+//
+// Private
+//
+
+// options of a listener
+type options struct {
+	osc *osc.Client
+}
+
+// analyze the notes held, spelling their chord in the key so far, then adding it to those played, unless it's the same as the last
+func (l *Listener) analyze() {
+	k := l.analysis.Key
+	l.analysis = Analysis{Held: l.Held(), Key: k}
+	if len(l.analysis.Held) == 0 {
+		return
+	}
+	l.analysis.Bass = classOf(l.analysis.Held[0])
+	l.analysis.Chord = ChordOf(l.analysis.Held, adjSymbolOf(k))
+	if l.analysis.Chord.Root == note.Nil {
+		return
+	}
+	chords := l.history.Chords
+	if len(chords) > 0 && chords[len(chords)-1].EquivalentTo(l.analysis.Chord) {
+		return
+	}
+	l.history.Chords = append(chords, l.analysis.Chord)
+	if len(l.history.Chords) > History {
+		l.history.Chords = l.history.Chords[len(l.history.Chords)-History:]
+	}
+	l.analysis.Key = l.history.Key()
+}
+
+// classOf a MIDI note number, e.g. C of 60
+func classOf(number int) note.Class {
+	return note.Class(number%12 + 1)
+}
+
+// octaveOf a MIDI note number, e.g. 4 of 60
+func octaveOf(number int) note.Octave {
+	return note.Octave(number/12 - 1)
+}
+
+// adjSymbolOf a key, or sharps before there is one
+func adjSymbolOf(k key.Key) note.AdjSymbol {
+	if k.Mode == key.Nil {
+		return note.Sharp
+	}
+	return k.AdjSymbol
+}
@@ -0,0 +1,113 @@
+// Live analysis of the notes held on a MIDI instrument, naming the chord they sound and estimating the key of the chords played so far.
+package listen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestListener_NoteOn(t *testing.T) {
+	l := New()
+	assert.True(t, l.NoteOn(64, 90))
+	assert.True(t, l.NoteOn(60, 90))
+	assert.False(t, l.NoteOn(60, 90)) // the same note again, e.g. of another channel
+	assert.Equal(t, []int{60, 64}, l.Held())
+	assert.False(t, l.NoteOn(60, 0))
+	assert.True(t, l.NoteOn(60, 0))
+	assert.Equal(t, []int{64}, l.Held())
+}
+
+func TestListener_NoteOff(t *testing.T) {
+	l := New()
+	assert.False(t, l.NoteOff(60))
+	l.NoteOn(60, 90)
+	assert.True(t, l.NoteOff(60))
+	assert.Equal(t, []int{}, l.Held())
+}
+
+func TestListener_Analysis(t *testing.T) {
+	l := New()
+	l.NoteOn(52, 90)
+	assert.Equal(t, key.Nil, l.Analysis().Key.Mode)
+	l.NoteOn(60, 90)
+	l.NoteOn(67, 90)
+	a := l.Analysis()
+	assert.Equal(t, []int{52, 60, 67}, a.Held)
+	assert.Equal(t, note.C, a.Chord.Root)
+	assert.Equal(t, note.E, a.Bass)
+	assert.Equal(t, note.C, a.Key.Root)
+	assert.Equal(t, key.Major, a.Key.Mode)
+	assert.Equal(t, "E3 C4 G4                 C/E          C major", a.String())
+}
+
+func TestListener_Analysis_History(t *testing.T) {
+	l := New()
+	play := func(numbers ...int) { play(l, numbers...) }
+	play(60, 64, 67)
+	play(53, 57, 60)
+	play(55, 59, 62, 65)
+	play(60, 64, 67)
+	assert.Equal(t, "C major", keyNameOf(l.Analysis().Key))
+	for n := 0; n < History; n++ {
+		play(62, 66, 69)
+		play(55, 59, 62)
+		play(57, 61, 64, 67)
+	}
+	play(62, 66, 69)
+	assert.Equal(t, "D major", keyNameOf(l.Analysis().Key))
+}
+
+func TestAnalysis_String(t *testing.T) {
+	assert.Equal(t, "-                        -            -", Analysis{}.String())
+	l := New()
+	l.NoteOn(60, 90)
+	l.NoteOn(61, 90)
+	assert.Equal(t, "C4 C#4                   -            -", l.Analysis().String())
+}
+
+func TestListener_Listen(t *testing.T) {
+	var out bytes.Buffer
+	in := []byte{
+		0x90, 57, 90, 60, 90, 64, 90, // A minor, by running status
+		0xF8,         // a clock, between messages
+		0x80, 57, 64, // note off
+		0x90, 64, 0, // note on of velocity 0
+		0xB0, 64, 127, // a control change, which changes nothing
+	}
+	assert.Nil(t, New().Listen(bytes.NewReader(in), &out))
+	assert.Equal(t, ""+
+		"A3                       -            -\n"+
+		"A3 C4                    -            -\n"+
+		"A3 C4 E4                 Am           A minor\n"+
+		"C4 E4                    -            A minor\n"+
+		"C4                       -            A minor\n", out.String())
+}
+
+//
+// Private
+//
+
+// hold the notes of a chord
+func hold(l *Listener, numbers ...int) {
+	for _, number := range numbers {
+		l.NoteOn(number, 90)
+	}
+}
+
+// play the notes of a chord, then let them go
+func play(l *Listener, numbers ...int) {
+	hold(l, numbers...)
+	for _, number := range numbers {
+		l.NoteOff(number)
+	}
+}
+
+func keyNameOf(k key.Key) string {
+	return k.Root.String(k.AdjSymbol) + " " + strings.ToLower(k.Mode.String())
+}
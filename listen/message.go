@@ -0,0 +1,67 @@
+// MIDI messages are read from a live stream of bytes, with running status, and system messages skipped wherever they come
+package listen
+
+import (
+	"bufio"
+	"io"
+)
+
+//
+// Private
+//
+
+// message of a MIDI channel, its status byte and up to two data bytes
+type message struct {
+	status byte
+	data   [2]byte
+}
+
+// readMessages until the end of a stream of MIDI bytes, or an error, handling each channel message,
+// skipping any data byte without a status, system exclusive messages, and system common and real-time messages, which don't cancel running status
+func readMessages(r io.Reader, handle func(message) error) error {
+	in := bufio.NewReader(r)
+	var m message
+	var n int
+	sysex := false
+	for {
+		b, err := in.ReadByte()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		switch {
+		case b >= 0xF8: // real-time, e.g. a clock, between any bytes
+			continue
+		case b == 0xF0:
+			sysex, m.status = true, 0
+			continue
+		case b >= 0xF1: // system common, or the end of system exclusive
+			sysex, m.status = false, 0
+			continue
+		case b&0x80 != 0:
+			sysex, m, n = false, message{status: b}, 0
+			continue
+		case sysex || m.status == 0:
+			continue
+		}
+		m.data[n] = b
+		n++
+		if n < dataLengthOf(m.status) {
+			continue
+		}
+		n = 0
+		if err := handle(m); err != nil {
+			return err
+		}
+	}
+}
+
+// dataLengthOf a channel message by its status, 1 of a program change or channel pressure, else 2
+func dataLengthOf(status byte) int {
+	switch status & 0xF0 {
+	case 0xC0, 0xD0:
+		return 1
+	}
+	return 2
+}
@@ -0,0 +1,39 @@
+// MIDI messages are read from a live stream of bytes, with running status, and system messages skipped wherever they come
+package listen
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestReadMessages(t *testing.T) {
+	in := []byte{
+		64, 0x90, 60, 0xFE, 100, // a data byte without a status, then active sensing within a message
+		62, 101, // running status
+		0xF0, 0x7E, 0x7F, 0xF7, // system exclusive
+		64, 102, // without its running status, cancelled
+		0xC1, 5, 6, // program change of one data byte
+		0xF2, 1, 2, // song position, which cancels running status
+		0x81, 60, 0,
+	}
+	var messages []message
+	assert.Nil(t, readMessages(bytes.NewReader(in), func(m message) error {
+		messages = append(messages, m)
+		return nil
+	}))
+	assert.Equal(t, []message{
+		{0x90, [2]byte{60, 100}},
+		{0x90, [2]byte{62, 101}},
+		{0xC1, [2]byte{5, 0}},
+		{0xC1, [2]byte{6, 0}},
+		{0x81, [2]byte{60, 0}},
+	}, messages)
+}
+
+func TestReadMessages_Error(t *testing.T) {
+	fail := errors.New("fail")
+	assert.Equal(t, fail, readMessages(bytes.NewReader([]byte{0x90, 60, 100}), func(m message) error { return fail }))
+}
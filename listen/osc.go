@@ -0,0 +1,65 @@
+// The analysis of the notes held is sent as OSC messages, e.g. to the patches of Max/MSP, SuperCollider or TouchDesigner, each time they change
+package listen
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/osc"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Addresses of the OSC messages of an analysis
+const (
+	AddressNotes = "/music-theory/notes" // the MIDI note numbers held, in ascending order
+	AddressChord = "/music-theory/chord" // the name of their chord, its root and its bass, or none if it isn't known
+	AddressKey   = "/music-theory/key"   // the root and mode of the key, e.g. "C" "major", or none before a chord is played
+	AddressScale = "/music-theory/scale" // the name of the scale suggested over the chord, then its tones, e.g. "D Dorian" "D" "E" "F" "G" "A" "B" "C"
+)
+
+// WithOSC client, sending the messages of the analysis each time the notes held change
+func WithOSC(client *osc.Client) Option {
+	return func(o *options) {
+		o.osc = client
+	}
+}
+
+// Scale suggested to play over the chord of the analysis, its name and tones, the church mode of the key on the root of the chord, spelled in the key,
+// e.g. "D Dorian" of Dm7 in C major, and whether there is one, of a chord in the scale of the key
+func (a Analysis) Scale() (string, scale.Scale, bool) {
+	if a.Chord.Root == note.Nil || a.Key.Mode == key.Nil || !a.Key.Scale().ContainsChord(a.Chord) {
+		return "", scale.Scale{}, false
+	}
+	mode, ok := a.Key.ChurchModeOn(a.Chord.Root)
+	if !ok {
+		return "", scale.Scale{}, false
+	}
+	root := a.Chord.Root.String(a.Key.AdjSymbol)
+	return root + " " + mode, scale.Of(root + " " + strings.ToLower(mode)).SpelledIn(a.Key), true
+}
+
+// Messages of the analysis, to each of the Addresses, with no arguments of what there isn't, e.g. a chord that isn't known
+func (a Analysis) Messages() []osc.Message {
+	adj := adjSymbolOf(a.Key)
+	notes := osc.Message{Address: AddressNotes}
+	for _, number := range a.Held {
+		notes.Arguments = append(notes.Arguments, number)
+	}
+	c := osc.Message{Address: AddressChord}
+	if a.Chord.Root != note.Nil {
+		c.Arguments = []interface{}{a.Chord.Name(), a.Chord.Root.String(adj), a.Bass.String(adj)}
+	}
+	k := osc.Message{Address: AddressKey}
+	if a.Key.Mode != key.Nil {
+		k.Arguments = []interface{}{a.Key.Root.String(a.Key.AdjSymbol), strings.ToLower(a.Key.Mode.String())}
+	}
+	s := osc.Message{Address: AddressScale}
+	if name, spelled, ok := a.Scale(); ok {
+		s.Arguments = []interface{}{name}
+		for _, t := range spelled.OrderedTones() {
+			s.Arguments = append(s.Arguments, t.Class.String(spelled.AdjSymbol))
+		}
+	}
+	return []osc.Message{notes, c, k, s}
+}
@@ -0,0 +1,71 @@
+// The analysis of the notes held is sent as OSC messages, e.g. to the patches of Max/MSP, SuperCollider or TouchDesigner, each time they change
+package listen
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/osc"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestAnalysis_Scale(t *testing.T) {
+	l := New()
+	play(l, 60, 64, 67)
+	hold(l, 62, 65, 69, 72)
+	name, s, ok := l.Analysis().Scale()
+	assert.True(t, ok)
+	assert.Equal(t, "D Dorian", name)
+	assert.True(t, scale.Of("D dorian").EquivalentTo(s))
+	play(l, 62, 65, 69, 72)
+	hold(l, 61, 65, 68) // not in C major
+	_, _, ok = l.Analysis().Scale()
+	assert.False(t, ok)
+	_, _, ok = Analysis{}.Scale()
+	assert.False(t, ok)
+}
+
+func TestAnalysis_Messages(t *testing.T) {
+	l := New()
+	play(l, 60, 64, 67)
+	hold(l, 50, 65, 69, 72)
+	assert.Equal(t, []osc.Message{
+		{Address: AddressNotes, Arguments: []interface{}{50, 65, 69, 72}},
+		{Address: AddressChord, Arguments: []interface{}{"Dm7", "D", "D"}},
+		{Address: AddressKey, Arguments: []interface{}{"C", "major"}},
+		{Address: AddressScale, Arguments: []interface{}{"D Dorian", "D", "E", "F", "G", "A", "B", "C"}},
+	}, l.Analysis().Messages())
+	assert.Equal(t, []osc.Message{
+		{Address: AddressNotes},
+		{Address: AddressChord},
+		{Address: AddressKey},
+		{Address: AddressScale},
+	}, Analysis{}.Messages())
+}
+
+func TestWithOSC(t *testing.T) {
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer server.Close()
+	c, err := osc.Dial(server.LocalAddr().String())
+	assert.Nil(t, err)
+	defer c.Close()
+	var out bytes.Buffer
+	assert.Nil(t, New(WithOSC(c)).Listen(bytes.NewReader([]byte{0x90, 60, 90}), &out))
+	assert.Nil(t, server.SetReadDeadline(time.Now().Add(time.Second)))
+	buf := make([]byte, 64)
+	n, _, err := server.ReadFrom(buf)
+	assert.Nil(t, err)
+	packet, _ := osc.Message{Address: AddressNotes, Arguments: []interface{}{60}}.MarshalBinary()
+	assert.Equal(t, packet, buf[:n])
+	for _, address := range []string{AddressChord, AddressKey, AddressScale} {
+		n, _, err = server.ReadFrom(buf)
+		assert.Nil(t, err)
+		packet, _ = osc.Message{Address: address}.MarshalBinary()
+		assert.Equal(t, packet, buf[:n])
+	}
+}
@@ -0,0 +1,23 @@
+// +build !rtmidi
+
+// A MIDI port is opened as a raw MIDI device, e.g. /dev/snd/midiC1D0 of ALSA on Linux, whose bytes are the messages of the instrument
+package listen
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Open a MIDI input port, the path of a raw MIDI device, e.g. /dev/snd/midiC1D0, or "-" for the standard input,
+// to read its messages until it's closed, or the end of a file of them
+func Open(port string) (io.ReadCloser, error) {
+	if port == "-" {
+		return os.Stdin, nil
+	}
+	f, err := os.Open(port)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q, expected a raw MIDI device, e.g. /dev/snd/midiC1D0, or the number of a port if built with -tags rtmidi: %v", ErrUnknownPort, port, err)
+	}
+	return f, nil
+}
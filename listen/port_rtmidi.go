@@ -0,0 +1,88 @@
+// +build rtmidi
+
+// A MIDI port is opened by its number with the RtMidi library, e.g. of ALSA, JACK, CoreMIDI or Windows MM, by building with -tags rtmidi
+package listen
+
+/*
+#cgo pkg-config: rtmidi
+#include <stdlib.h>
+#include <stdbool.h>
+#include "rtmidi_c.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+	"unsafe"
+)
+
+// Open a MIDI input port, the number of an input port of RtMidi, e.g. "0" of the first,
+// to read its messages until it's closed
+func Open(port string) (io.ReadCloser, error) {
+	number, err := strconv.Atoi(port)
+	if err != nil || number < 0 {
+		return nil, fmt.Errorf("%w %q, expected the number of an input port, e.g. 0", ErrUnknownPort, port)
+	}
+	device := C.rtmidi_in_create_default()
+	if !bool(device.ok) {
+		err := errors.New(C.GoString(device.msg))
+		C.rtmidi_in_free(device)
+		return nil, err
+	}
+	if count := int(C.rtmidi_get_port_count(device)); number >= count {
+		C.rtmidi_in_free(device)
+		return nil, fmt.Errorf("%w %q, expected the number of one of %d input ports", ErrUnknownPort, port, count)
+	}
+	name := C.CString("music-theory")
+	defer C.free(unsafe.Pointer(name))
+	C.rtmidi_open_port(device, C.uint(number), name)
+	if !bool(device.ok) {
+		err := errors.New(C.GoString(device.msg))
+		C.rtmidi_in_free(device)
+		return nil, err
+	}
+	C.rtmidi_in_ignore_types(device, true, true, true)
+	return &rtmidiPort{device: device}, nil
+}
+
+//
+// Private
+//
+
+// pollInterval between asking RtMidi for a message, while none has come
+const pollInterval = time.Millisecond
+
+// rtmidiPort of input, reading each message in turn as it comes, not to be closed while it's read
+type rtmidiPort struct {
+	device  C.RtMidiInPtr
+	pending []byte
+}
+
+func (p *rtmidiPort) Read(b []byte) (int, error) {
+	var message [1024]C.uchar
+	for len(p.pending) == 0 {
+		size := C.size_t(len(message))
+		C.rtmidi_in_get_message(p.device, &message[0], &size)
+		if !bool(p.device.ok) {
+			return 0, errors.New(C.GoString(p.device.msg))
+		}
+		if size == 0 {
+			time.Sleep(pollInterval)
+			continue
+		}
+		p.pending = C.GoBytes(unsafe.Pointer(&message[0]), C.int(size))
+	}
+	n := copy(b, p.pending)
+	p.pending = p.pending[n:]
+	return n, nil
+}
+
+func (p *rtmidiPort) Close() error {
+	C.rtmidi_close_port(p.device)
+	C.rtmidi_in_free(p.device)
+	return nil
+}
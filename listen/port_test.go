@@ -0,0 +1,41 @@
+//go:build !rtmidi
+// +build !rtmidi
+
+// A MIDI port is opened as a raw MIDI device, e.g. /dev/snd/midiC1D0 of ALSA on Linux, whose bytes are the messages of the instrument
+package listen
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listen")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "midi")
+	assert.Nil(t, ioutil.WriteFile(path, []byte{0x90, 60, 100, 64, 100, 67, 100}, 0644))
+	port, err := Open(path)
+	assert.Nil(t, err)
+	defer port.Close()
+	var out bytes.Buffer
+	assert.Nil(t, New().Listen(port, &out))
+	assert.Contains(t, out.String(), "C4 E4 G4                 C            C major\n")
+}
+
+func TestOpen_Stdin(t *testing.T) {
+	port, err := Open("-")
+	assert.Nil(t, err)
+	assert.Equal(t, os.Stdin, port)
+}
+
+func TestOpen_Unknown(t *testing.T) {
+	_, err := Open("/dev/snd/nonexistent")
+	assert.True(t, errors.Is(err, ErrUnknownPort))
+}
@@ -0,0 +1,51 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/listen"
+)
+
+func TestListenTo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listen")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "midi")
+	assert.Nil(t, ioutil.WriteFile(path, []byte{0x90, 55, 90, 59, 90, 62, 90, 65, 90}, 0644))
+	var out bytes.Buffer
+	assert.Nil(t, listenTo(&out, path, ""))
+	assert.Equal(t, "Listening to "+path+"\n"+
+		"G3                       -            -\n"+
+		"G3 B3                    -            -\n"+
+		"G3 B3 D4                 G            G major\n"+
+		"G3 B3 D4 F4              G7           G major\n", out.String())
+	assert.NotNil(t, listenTo(&out, filepath.Join(dir, "missing"), ""))
+}
+
+func TestListenTo_OSC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listen")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "midi")
+	assert.Nil(t, ioutil.WriteFile(path, []byte{0x90, 60, 90}, 0644))
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer server.Close()
+	var out bytes.Buffer
+	assert.Nil(t, listenTo(&out, path, server.LocalAddr().String()))
+	assert.Nil(t, server.SetReadDeadline(time.Now().Add(time.Second)))
+	buf := make([]byte, 64)
+	n, _, err := server.ReadFrom(buf)
+	assert.Nil(t, err)
+	assert.Contains(t, string(buf[:n]), listen.AddressNotes)
+	assert.NotNil(t, listenTo(&out, path, "localhost:notaport"))
+}
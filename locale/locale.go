@@ -0,0 +1,111 @@
+// A locale names the notes in the convention of a language, e.g. H for B and B for Bb in German, or Do, Re and Mi in the fixed-do solfège of Romance languages.
+//
+// Names in a locale are translated to the English letter names understood by the chord, scale and key parsers, e.g. chord.Of("Fis m7", chord.WithLocale(locale.German)).
+//
+// https://en.wikipedia.org/wiki/Musical_note#12-tone_chromatic_scale
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownLocale of a name that isn't one of the LocaleNames
+var ErrUnknownLocale = errors.New("unknown locale")
+
+// Locale of the names of notes
+type Locale int
+
+const (
+	English Locale = iota // letter names A through G, with # or b, e.g. "Bb", the default
+	German                // H for B, B for Bb, and -is or -es for sharp or flat, e.g. "Fis" or "Es"
+	Solfege               // fixed-do syllables, Do through Si, with # or b, e.g. "Sib"
+)
+
+// LocaleNames of each locale, in order
+var LocaleNames = []string{"english", "german", "solfege"}
+
+// Named locale, one of the LocaleNames, e.g. Named("german")
+func Named(name string) (Locale, error) {
+	for i, n := range LocaleNames {
+		if n == strings.ToLower(name) {
+			return Locale(i), nil
+		}
+	}
+	return English, fmt.Errorf("%w %q, expected one of %s", ErrUnknownLocale, name, strings.Join(LocaleNames, ", "))
+}
+
+// String of the locale, e.g. "german"
+func (of Locale) String() string {
+	if of < 0 || int(of) >= len(LocaleNames) {
+		return "unknown"
+	}
+	return LocaleNames[of]
+}
+
+// Translate the note name at the beginning of a name in the locale, to its English letter name, keeping the rest of the name,
+// e.g. "Fis m7" to "F# m7" in German, or "Sib maj7" to "Bb maj7" in Solfege, or the name as it is, if it doesn't begin with a note name of the locale
+func (of Locale) Translate(name string) string {
+	switch of {
+	case German:
+		return germanToEnglish(name)
+	case Solfege:
+		return solfegeToEnglish(name)
+	}
+	return name
+}
+
+//
+// Private
+//
+
+// solfegeLetters of each syllable of fixed-do solfège, longest first, so Sol isn't mistaken for a shorter syllable
+var solfegeLetters = []struct{ syllable, letter string }{
+	{"Sol", "G"}, {"Do", "C"}, {"Re", "D"}, {"Mi", "E"}, {"Fa", "F"}, {"La", "A"}, {"Si", "B"},
+}
+
+// solfegeToEnglish name, replacing its leading syllable by a letter, keeping any sharp or flat after it
+func solfegeToEnglish(name string) string {
+	for _, s := range solfegeLetters {
+		if strings.HasPrefix(name, s.syllable) {
+			return s.letter + name[len(s.syllable):]
+		}
+	}
+	return name
+}
+
+// germanToEnglish name, replacing its leading note name, e.g. "H" or "B" or "Fis" or "Es", by a letter and any sharp or flat
+func germanToEnglish(name string) string {
+	if len(name) == 0 || !strings.ContainsAny(name[:1], "ABCDEFGH") {
+		return name
+	}
+	letter, rest := name[:1], name[1:]
+	switch letter {
+	case "H":
+		letter = "B"
+	case "B":
+		if !strings.HasPrefix(rest, "es") && !strings.HasPrefix(rest, "is") {
+			return "Bb" + rest
+		}
+	}
+	switch {
+	case strings.HasPrefix(rest, "is"):
+		return letter + "#" + rest[2:]
+	case strings.HasPrefix(rest, "es"):
+		return letter + "b" + rest[2:]
+	case (letter == "A" || letter == "E") && strings.HasPrefix(rest, "s") && !strings.HasPrefix(rest, "su"):
+		return letter + "b" + rest[1:] // As or Es, but not the sus of Asus4
+	}
+	return letter + rest
+}
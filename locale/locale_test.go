@@ -0,0 +1,63 @@
+// A locale names the notes in the convention of a language, e.g. H for B and B for Bb in German, or Do, Re and Mi in the fixed-do solfège of Romance languages.
+package locale
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestTranslate_German(t *testing.T) {
+	for name, expect := range map[string]string{
+		"H":      "B",
+		"H7":     "B7",
+		"B":      "Bb",
+		"Bm":     "Bbm",
+		"Fis m7": "F# m7",
+		"Cis":    "C#",
+		"Des":    "Db",
+		"Es":     "Eb",
+		"As dur": "Ab dur",
+		"Asus4":  "Asus4",
+		"Esus":   "Esus",
+		"His":    "B#",
+		"C":      "C",
+		"x":      "x",
+		"":       "",
+	} {
+		assert.Equal(t, expect, German.Translate(name), name)
+	}
+}
+
+func TestTranslate_Solfege(t *testing.T) {
+	for name, expect := range map[string]string{
+		"Do":       "C",
+		"Sol7":     "G7",
+		"Sib maj7": "Bb maj7",
+		"Fa# m":    "F# m",
+		"La minor": "A minor",
+		"C":        "C",
+	} {
+		assert.Equal(t, expect, Solfege.Translate(name), name)
+	}
+}
+
+func TestTranslate_English(t *testing.T) {
+	assert.Equal(t, "Bb", English.Translate("Bb"))
+	assert.Equal(t, "H", English.Translate("H"))
+}
+
+func TestNamed(t *testing.T) {
+	l, err := Named("German")
+	assert.Nil(t, err)
+	assert.Equal(t, German, l)
+	_, err = Named("klingon")
+	assert.True(t, errors.Is(err, ErrUnknownLocale))
+	assert.Equal(t, "unknown locale \"klingon\", expected one of english, german, solfege", err.Error())
+}
+
+func TestLocale_String(t *testing.T) {
+	assert.Equal(t, "solfege", Solfege.String())
+	assert.Equal(t, "unknown", Locale(9).String())
+}
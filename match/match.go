@@ -0,0 +1,183 @@
+// A matcher is a regular expression compiled once, with a prefilter of the runes that any match must contain,
+// so that a name which can't match, e.g. "Cm7" for a form matching "sus", is rejected without running the expression.
+//
+// Parsing a chord or scale name tries every one of its forms or modes, most of which don't match, so the prefilter
+// spares most of the work of parsing, e.g. in batch analysis of millions of chord symbols.
+//
+// https://en.wikipedia.org/wiki/Regular_expression
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package match
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// Matcher of a regular expression, safe for concurrent use
+type Matcher struct {
+	rgx      *regexp.Regexp
+	required string // runes of which any match contains at least one, or empty if any name might match
+}
+
+// Compile a regular expression, e.g. Compile("^(M|maj|major)"), with the prefilter of the runes any match must contain
+func Compile(pattern string) (*Matcher, error) {
+	rgx, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &Matcher{rgx: rgx, required: requiredOf(pattern)}, nil
+}
+
+// MustCompile a regular expression, panicking if it's invalid, e.g. for the built-in forms of a package
+func MustCompile(pattern string) *Matcher {
+	m, err := Compile(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Longest copy of the matcher, preferring the leftmost-longest match, e.g. to match all of "minor" instead of only "min"
+func (m *Matcher) Longest() *Matcher {
+	rgx := regexp.MustCompile(m.rgx.String())
+	rgx.Longest()
+	return &Matcher{rgx: rgx, required: m.required}
+}
+
+// MatchString reports whether the string contains any match
+func (m *Matcher) MatchString(s string) bool {
+	return m.mightMatch(s) && m.rgx.MatchString(s)
+}
+
+// FindString of the leftmost match in the string, or empty if there is none
+func (m *Matcher) FindString(s string) string {
+	if !m.mightMatch(s) {
+		return ""
+	}
+	return m.rgx.FindString(s)
+}
+
+// FindStringIndex of the leftmost match in the string, its beginning and end, or nil if there is none
+func (m *Matcher) FindStringIndex(s string) []int {
+	if !m.mightMatch(s) {
+		return nil
+	}
+	return m.rgx.FindStringIndex(s)
+}
+
+// FindAllStringIndex of up to n successive matches in the string, or all of them if n < 0, or nil if there are none
+func (m *Matcher) FindAllStringIndex(s string, n int) [][]int {
+	if !m.mightMatch(s) {
+		return nil
+	}
+	return m.rgx.FindAllStringIndex(s, n)
+}
+
+// String of the regular expression the matcher was compiled from
+func (m *Matcher) String() string {
+	return m.rgx.String()
+}
+
+//
+// Private
+//
+
+// maxClassRunes of a character class to require one of, beyond which the class is taken to match anything, e.g. [^a-z]
+const maxClassRunes = 16
+
+// mightMatch the string, unless it contains none of the runes any match must contain
+func (m *Matcher) mightMatch(s string) bool {
+	return len(m.required) == 0 || strings.ContainsAny(s, m.required)
+}
+
+// requiredOf a pattern, the runes of which any match contains at least one, or empty if any string might match
+func requiredOf(pattern string) string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	runes, ok := requiredRunes(re.Simplify())
+	if !ok {
+		return ""
+	}
+	var b strings.Builder
+	for _, r := range runes {
+		if !strings.ContainsRune(b.String(), r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// requiredRunes of an expression, of which any match contains at least one, or false if it might match without any, e.g. the empty string
+func requiredRunes(re *syntax.Regexp) ([]rune, bool) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if len(re.Rune) == 0 {
+			return nil, false
+		}
+		return foldedOf(re.Rune[0], re.Flags&syntax.FoldCase != 0), true
+	case syntax.OpCharClass:
+		var runes []rune
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			if re.Rune[i+1]-re.Rune[i] >= maxClassRunes {
+				return nil, false
+			}
+			for r := re.Rune[i]; r <= re.Rune[i+1]; r++ {
+				runes = append(runes, r)
+			}
+			if len(runes) > maxClassRunes {
+				return nil, false
+			}
+		}
+		return runes, len(runes) > 0
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredRunes(re.Sub[0])
+	case syntax.OpRepeat:
+		if re.Min < 1 {
+			return nil, false
+		}
+		return requiredRunes(re.Sub[0])
+	case syntax.OpConcat:
+		var best []rune
+		for _, sub := range re.Sub {
+			if runes, ok := requiredRunes(sub); ok && (best == nil || len(runes) < len(best)) {
+				best = runes
+			}
+		}
+		return best, best != nil
+	case syntax.OpAlternate:
+		var union []rune
+		for _, sub := range re.Sub {
+			runes, ok := requiredRunes(sub)
+			if !ok {
+				return nil, false
+			}
+			union = append(union, runes...)
+		}
+		return union, true
+	}
+	return nil, false
+}
+
+// foldedOf a rune, with its other cases, if the expression folds case
+func foldedOf(r rune, fold bool) []rune {
+	runes := []rune{r}
+	if fold {
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			runes = append(runes, f)
+		}
+	}
+	return runes
+}
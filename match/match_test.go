@@ -0,0 +1,88 @@
+// A matcher is a regular expression compiled once, with a prefilter of the runes that any match must contain,
+// so that a name which can't match, e.g. "Cm7" for a form matching "sus", is rejected without running the expression.
+package match
+
+import (
+	"regexp"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestCompile(t *testing.T) {
+	m, err := Compile("^(M|maj|major)")
+	assert.Nil(t, err)
+	assert.True(t, m.MatchString("maj7"))
+	assert.False(t, m.MatchString("sus4"))
+	assert.Equal(t, "^(M|maj|major)", m.String())
+	_, err = Compile("(")
+	assert.NotNil(t, err)
+}
+
+func TestMustCompile(t *testing.T) {
+	assert.NotNil(t, MustCompile("sus"))
+	assert.Panics(t, func() { MustCompile("(") })
+}
+
+func TestMatcher_Find(t *testing.T) {
+	m := MustCompile("(dim|dimin|diminished)")
+	assert.Equal(t, "dim", m.FindString("C diminished"))
+	assert.Equal(t, "diminished", m.Longest().FindString("C diminished"))
+	assert.Equal(t, []int{2, 5}, m.FindStringIndex("C dim 7"))
+	assert.Equal(t, [][]int{{0, 3}, {4, 7}}, m.FindAllStringIndex("dim dim", -1))
+	assert.Equal(t, "", m.FindString("Cm7"))
+	assert.Nil(t, m.FindStringIndex("Cm7"))
+	assert.Nil(t, m.FindAllStringIndex("Cm7", -1))
+}
+
+func TestRequiredOf(t *testing.T) {
+	assert.Equal(t, "Mm", sorted(requiredOf("^(M|maj|major)")))
+	assert.Equal(t, "m", requiredOf("([^a-z]|^)(m|min|minor)"))
+	assert.Equal(t, "#bfs♭", sorted(requiredOf("(f|flat|b|♭|#|s|sharp)")))
+	assert.Equal(t, "Ssſ", sorted(requiredOf("(?i)sus")), "in any case, even the long s")
+	assert.Equal(t, "", requiredOf("(^|dom|dominant)"), "might match the empty string")
+	assert.Equal(t, "", requiredOf("[^a-z]"), "might match almost anything")
+	assert.Equal(t, "", requiredOf("a*"))
+	assert.Equal(t, "7", requiredOf("[^a-z]*7"))
+	assert.Equal(t, "679", sorted(requiredOf("[679]+")))
+	assert.Equal(t, "", requiredOf("("))
+}
+
+func TestMatcher_SameAsRegexp(t *testing.T) {
+	for _, pattern := range []string{"^(M|maj|major)([^a-z]|$)", "([^a-z]|^)(m|min|minor)", "(?i)hungarian[. ]*gypsy", "(13|thirteen)", "(add|added)[. ]*(9|nine)", "b[. ]*5|♭5", "x{2,3}"} {
+		m, rgx := MustCompile(pattern), regexp.MustCompile(pattern)
+		for _, s := range []string{"", "C", "maj7", "M", "m", "min", "Hungarian Gypsy", "13", "thirteen", "add 9", "added nine", "b5", "♭5", "xx", "x", "Cm7b5"} {
+			assert.Equal(t, rgx.MatchString(s), m.MatchString(s), pattern+" of "+s)
+		}
+	}
+}
+
+func BenchmarkMatcher_MatchString(b *testing.B) {
+	m := MustCompile("^(sus|susp|suspend|suspended)")
+	for i := 0; i < b.N; i++ {
+		m.MatchString("m7b5")
+	}
+}
+
+func BenchmarkRegexp_MatchString(b *testing.B) {
+	rgx := regexp.MustCompile("^(sus|susp|suspend|suspended)")
+	for i := 0; i < b.N; i++ {
+		rgx.MatchString("m7b5")
+	}
+}
+
+//
+// Private
+//
+
+func sorted(s string) string {
+	runes := []rune(s)
+	for i := range runes {
+		for j := i + 1; j < len(runes); j++ {
+			if runes[j] < runes[i] {
+				runes[i], runes[j] = runes[j], runes[i]
+			}
+		}
+	}
+	return string(runes)
+}
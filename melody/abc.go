@@ -0,0 +1,462 @@
+// ABC notation is a shorthand for writing music as plain text, with a header of fields, e.g. the key, and a body of notes, e.g. G2 AB | c4
+package melody
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ErrSyntax when reading a melody that can't be parsed, naming the line of it
+var ErrSyntax = errors.New("invalid syntax")
+
+// Tune of a melody, its notes in the beats of its meter, with a title, key and tempo if known
+type Tune struct {
+	Title string
+	Key   key.Key
+	Meter meter.Meter
+	Tempo float64 // in beats of the meter per minute, or 0 of the default midi.Tempo
+	Notes []Note
+}
+
+// ReadABC of a single tune, the title, meter, unit note length and key of its header, then the notes of its body, e.g.
+//
+//     X:1
+//     T:Example
+//     M:3/4
+//     L:1/8
+//     K:G
+//     D2 | G2 B2 d2 | c2 A2 F2 | G6 |]
+//
+// in beats of its meter, defaulting to 4/4 with an eighth note unit, taking the highest note of a chord, articulating notes marked staccato, e.g. .c,
+// accented, e.g. !>!c or Lc, or tenuto, e.g. !tenuto!c, and legato within a slur, e.g. (cde), and skipping chord symbols, other decorations and grace notes
+func ReadABC(r io.Reader) (Tune, error) {
+	t := Tune{Meter: meter.Common}
+	p := abcParser{tune: &t, unit: 1.0 / 8, signature: map[string]int{}, bar: map[string]int{}, tuplet: 1}
+	unitSet := false
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(stripComment(scanner.Text()))
+		if len(text) == 0 {
+			continue
+		}
+		f := rgxABCField.FindStringSubmatch(text)
+		if f == nil {
+			if err := p.parse(text); err != nil {
+				return Tune{}, fmt.Errorf("%w at line %d: %v", ErrSyntax, line, err)
+			}
+			continue
+		}
+		value := strings.TrimSpace(f[2])
+		switch f[1] {
+		case "T":
+			if len(t.Title) == 0 {
+				t.Title = value
+			}
+		case "M":
+			m, err := abcMeterOf(value)
+			if err != nil {
+				return Tune{}, fmt.Errorf("%w at line %d: %v", ErrSyntax, line, err)
+			}
+			t.Meter = m
+			if !unitSet && float64(m.Beats)/float64(m.Unit) < 0.75 {
+				p.unit = 1.0 / 16
+			}
+		case "L":
+			unit, err := abcFractionOf(value)
+			if err != nil || unit <= 0 {
+				return Tune{}, fmt.Errorf("%w at line %d: unit note length %q, expected e.g. 1/8", ErrSyntax, line, value)
+			}
+			p.unit, unitSet = unit, true
+		case "K":
+			k, err := abcKeyOf(value)
+			if err != nil {
+				return Tune{}, fmt.Errorf("%w at line %d: %v", ErrSyntax, line, err)
+			}
+			t.Key, p.signature = k, abcSignatureOf(k)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Tune{}, err
+	}
+	return t, nil
+}
+
+//
+// Private
+//
+
+var (
+	rgxABCField, _  = regexp.Compile(`^([A-Za-z]):(.*)$`)
+	rgxABCNote, _   = regexp.Compile(`^(\^\^|\^|__|_|=)?([A-Ga-g])([',]*)([0-9]*(?:/+[0-9]*)?)`)
+	rgxABCRest, _   = regexp.Compile(`^([zxZX])([0-9]*(?:/+[0-9]*)?)`)
+	rgxABCTuplet, _ = regexp.Compile(`^\(([2-9])`)
+	rgxABCLength, _ = regexp.Compile(`^[0-9]*(?:/+[0-9]*)?`)
+	rgxABCMode, _   = regexp.Compile(`^(?i)(maj|min|m$)`)
+)
+
+// abcLetters of the notes, in order of the sharps of a key signature, and their steps up from C
+var (
+	abcSharps = []string{"F", "C", "G", "D", "A", "E", "B"}
+	abcSteps  = map[string]int{"C": 0, "D": 2, "E": 4, "F": 5, "G": 7, "A": 9, "B": 11}
+)
+
+// abcDecorations that articulate a note, by their names between ! or +, e.g. !staccato!
+var abcDecorations = map[string]Articulation{
+	"staccato": Staccato,
+	"accent":   Accent,
+	">":        Accent,
+	"emphasis": Accent,
+	"tenuto":   Tenuto,
+}
+
+// abcParser of the body of a tune, remembering the key signature, the accidentals of the current bar, and any tie, broken rhythm, tuplet, articulation or slur pending
+type abcParser struct {
+	tune         *Tune
+	beat         float64
+	unit         float64 // of a whole note
+	signature    map[string]int
+	bar          map[string]int
+	tied         bool
+	broken       float64      // length of the next note, after a broken rhythm, e.g. 0.5 after >
+	tuplet       float64      // length of each note of a tuplet, e.g. 2/3 of a triplet
+	tupletN      int          // notes remaining in the tuplet
+	articulation Articulation // of the next note, e.g. Staccato after .
+	slurs        int          // open, each note within them legato until the last
+}
+
+// parse a line of the body of a tune
+func (p *abcParser) parse(text string) error {
+	for len(text) > 0 {
+		var err error
+		if text, err = p.next(text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// next element of the body of a tune, returning the text after it
+func (p *abcParser) next(text string) (string, error) {
+	if m := rgxABCNote.FindStringSubmatch(text); m != nil {
+		p.add(p.pitchOf(m[1], m[2], m[3]), m[4])
+		return text[len(m[0]):], nil
+	}
+	if m := rgxABCRest.FindStringSubmatch(text); m != nil {
+		length := m[2]
+		if m[1] == "Z" || m[1] == "X" {
+			bars, err := strconv.Atoi(m[2])
+			if err != nil {
+				bars = 1
+			}
+			p.beat += float64(bars * p.tune.Meter.Beats)
+		} else {
+			p.add(nil, length)
+		}
+		return text[len(m[0]):], nil
+	}
+	if m := rgxABCTuplet.FindStringSubmatch(text); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		in := 2.0
+		if n == 2 || n == 4 || n == 8 {
+			in = 3
+		}
+		p.tuplet, p.tupletN = in/float64(n), n
+		return text[len(m[0]):], nil
+	}
+	switch text[0] {
+	case '"', '!', '+':
+		end := strings.IndexByte(text[1:], text[0])
+		if end < 0 {
+			return "", fmt.Errorf("unclosed %c", text[0])
+		}
+		if text[0] != '"' {
+			p.articulation |= abcDecorations[text[1:end+1]]
+		}
+		return text[end+2:], nil
+	case '{':
+		end := strings.IndexByte(text, '}')
+		if end < 0 {
+			return "", errors.New("unclosed grace notes")
+		}
+		return text[end+1:], nil
+	case '[':
+		return p.chord(text)
+	case '|', ':', ']':
+		p.bar = map[string]int{}
+		return text[1:], nil
+	case '-':
+		p.tied = true
+		return text[1:], nil
+	case '>', '<':
+		p.brokenRhythm(text[0])
+		return text[1:], nil
+	case '.':
+		p.articulation |= Staccato
+		return text[1:], nil
+	case 'L':
+		p.articulation |= Accent
+		return text[1:], nil
+	case '(':
+		p.slurs++
+		return text[1:], nil
+	case ')':
+		p.endSlur()
+		return text[1:], nil
+	case ' ', '\t', '`', '\\', '~', 'H', 'M', 'O', 'P', 'S', 'T', 'u', 'v', ',', '\'', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return text[1:], nil
+	}
+	return "", fmt.Errorf("unexpected %q", text[0])
+}
+
+// chord of notes in brackets, e.g. [CEG]2, adding the highest, or else an inline field, e.g. [K:D] changing the key signature from there, or a bar line, e.g. [|
+func (p *abcParser) chord(text string) (string, error) {
+	end := strings.IndexByte(text, ']')
+	if end < 0 {
+		return "", errors.New("unclosed chord")
+	}
+	inner := text[1:end]
+	if len(inner) > 1 && inner[1] == ':' {
+		if inner[0] == 'K' {
+			k, err := abcKeyOf(inner[2:])
+			if err != nil {
+				return "", err
+			}
+			p.signature = abcSignatureOf(k)
+		}
+		return text[end+1:], nil
+	}
+	if len(inner) == 0 || inner[0] == '|' {
+		p.bar = map[string]int{}
+		return text[1:], nil
+	}
+	var top *note.Note
+	length := ""
+	for len(inner) > 0 {
+		m := rgxABCNote.FindStringSubmatch(inner)
+		if m == nil {
+			inner = inner[1:]
+			continue
+		}
+		pitch := p.pitchOf(m[1], m[2], m[3])
+		if top == nil || stepOf(pitch) > stepOf(top) {
+			top = pitch
+		}
+		if len(length) == 0 {
+			length = m[4]
+		}
+		inner = inner[len(m[0]):]
+	}
+	rest := text[end+1:]
+	after := rgxABCLength.FindString(rest)
+	if top != nil {
+		p.addLength(top, abcLengthOf(length)*abcLengthOf(after))
+	}
+	return rest[len(after):], nil
+}
+
+// add a note, or a rest if nil, of a length in units, e.g. "3/2"
+func (p *abcParser) add(pitch *note.Note, length string) {
+	p.addLength(pitch, abcLengthOf(length))
+}
+
+// addLength of a note, or a rest if nil, in units, to the end of the tune, tied to the note before if it's the same, else articulated by any pending
+func (p *abcParser) addLength(pitch *note.Note, units float64) {
+	if p.broken != 0 {
+		units *= p.broken
+		p.broken = 0
+	}
+	if p.tupletN > 0 {
+		units *= p.tuplet
+		p.tupletN--
+	}
+	beats := units * p.unit * float64(p.tune.Meter.Unit)
+	notes := p.tune.Notes
+	tied, articulation := p.tied, p.articulation
+	p.tied, p.articulation = false, 0
+	if p.slurs > 0 {
+		articulation |= Legato
+	}
+	if pitch == nil {
+		p.beat += beats
+		return
+	}
+	if tied && len(notes) > 0 {
+		last := &notes[len(notes)-1]
+		if last.Class == pitch.Class && last.Octave == pitch.Octave && last.Beat+last.Beats == p.beat {
+			last.Beats += beats
+			p.beat += beats
+			return
+		}
+	}
+	p.tune.Notes = append(notes, Note{Class: pitch.Class, Octave: pitch.Octave, Beat: p.beat, Beats: beats, Articulation: articulation})
+	p.beat += beats
+}
+
+// endSlur of the notes within it, the last of them not legato, since it isn't slurred into the note after, unless within another slur
+func (p *abcParser) endSlur() {
+	if p.slurs == 0 {
+		return
+	}
+	p.slurs--
+	if p.slurs == 0 && len(p.tune.Notes) > 0 {
+		last := &p.tune.Notes[len(p.tune.Notes)-1]
+		last.Articulation &^= Legato
+	}
+}
+
+// brokenRhythm after a note, e.g. > dotting it and halving the next, or < the other way around
+func (p *abcParser) brokenRhythm(symbol byte) {
+	notes := p.tune.Notes
+	if len(notes) == 0 {
+		return
+	}
+	last := &notes[len(notes)-1]
+	if last.Beat+last.Beats != p.beat {
+		return
+	}
+	change := last.Beats / 2
+	if symbol == '<' {
+		change = -change
+	}
+	last.Beats += change
+	p.beat += change
+	p.broken = 1 - change/(last.Beats-change)
+}
+
+// pitchOf a note, by its accidental, letter and octave marks, remembering any accidental for the rest of the bar, or else by the key signature
+func (p *abcParser) pitchOf(accidental, letter, marks string) *note.Note {
+	octave := 4
+	if letter == strings.ToLower(letter) {
+		octave = 5
+	}
+	octave += strings.Count(marks, "'") - strings.Count(marks, ",")
+	upper := strings.ToUpper(letter)
+	at := upper + strconv.Itoa(octave)
+	alter, ok := p.bar[at]
+	switch accidental {
+	case "^^":
+		alter, ok = 2, true
+	case "^":
+		alter, ok = 1, true
+	case "__":
+		alter, ok = -2, true
+	case "_":
+		alter, ok = -1, true
+	case "=":
+		alter, ok = 0, true
+	default:
+		if !ok {
+			alter = p.signature[upper]
+		}
+	}
+	if len(accidental) > 0 {
+		p.bar[at] = alter
+	}
+	step := (octave+1)*12 + abcSteps[upper] + alter
+	return &note.Note{Class: note.Class(step%12 + 1), Octave: note.Octave(step/12 - 1)}
+}
+
+// stepOf a note, in semitones from C-1
+func stepOf(nt *note.Note) int {
+	return int(nt.Octave+1)*12 + int(nt.Class) - 1
+}
+
+// abcLengthOf a note, in units, e.g. 2 for "2", 0.5 for "/" or "/2", or 1.5 for "3/2"
+func abcLengthOf(text string) float64 {
+	if len(text) == 0 {
+		return 1
+	}
+	slashes := strings.Count(text, "/")
+	num, den := text, ""
+	if slashes > 0 {
+		i := strings.IndexByte(text, '/')
+		num, den = text[:i], strings.TrimLeft(text[i:], "/")
+	}
+	n := 1.0
+	if v, err := strconv.Atoi(num); err == nil {
+		n = float64(v)
+	}
+	d := 1.0
+	switch {
+	case slashes == 0:
+	case len(den) > 0:
+		if v, err := strconv.Atoi(den); err == nil && v > 0 {
+			d = float64(v)
+		}
+	default:
+		d = float64(int(1) << uint(slashes))
+	}
+	return n / d
+}
+
+// abcFractionOf a field, e.g. 0.125 for "1/8"
+func abcFractionOf(text string) (float64, error) {
+	parts := strings.Split(strings.TrimSpace(text), "/")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("fraction %q", text)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, err
+	}
+	d, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil || d == 0 {
+		return 0, fmt.Errorf("fraction %q", text)
+	}
+	return float64(n) / float64(d), nil
+}
+
+// abcMeterOf a field, e.g. "6/8", or C for common time and C| for cut time
+func abcMeterOf(text string) (meter.Meter, error) {
+	switch text {
+	case "C":
+		return meter.Common, nil
+	case "C|":
+		return meter.New(2, 2)
+	case "none", "":
+		return meter.Common, nil
+	}
+	return meter.Parse(text)
+}
+
+// abcKeyOf a field, e.g. "G", "Em" or "Bb major", ignoring anything after a space that isn't a mode, e.g. a clef
+func abcKeyOf(text string) (key.Key, error) {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 || text == "none" {
+		return key.Of("C"), nil
+	}
+	if fields := strings.Fields(text); len(fields) > 1 && !rgxABCMode.MatchString(fields[1]) {
+		text = fields[0]
+	}
+	return key.Parse(text)
+}
+
+// abcSignatureOf a key, the alteration of each letter by its sharps or flats
+func abcSignatureOf(k key.Key) map[string]int {
+	signature := make(map[string]int)
+	fifths := k.Fifths()
+	for n := 0; n < fifths && n < 7; n++ {
+		signature[abcSharps[n]] = 1
+	}
+	for n := 0; n < -fifths && n < 7; n++ {
+		signature[abcSharps[6-n]] = -1
+	}
+	return signature
+}
+
+// stripComment from a line, everything after a %
+func stripComment(line string) string {
+	if i := strings.IndexByte(line, '%'); i >= 0 {
+		return line[:i]
+	}
+	return line
+}
@@ -0,0 +1,115 @@
+// ABC notation is a shorthand for writing music as plain text, with a header of fields, e.g. the key, and a body of notes, e.g. G2 AB | c4
+package melody
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestReadABC(t *testing.T) {
+	tune, err := ReadABC(strings.NewReader(`X:1
+T:Example
+T:Subtitle
+M:3/4
+L:1/8
+K:G
+% a comment
+D2 | G2 B2 d2 | "C"c2 A2 F2 | G6 |]
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, "Example", tune.Title)
+	assert.Equal(t, note.G, tune.Key.Root)
+	assert.Equal(t, key.Major, tune.Key.Mode)
+	assert.Equal(t, 3, tune.Meter.Beats)
+	assert.Equal(t, []Note{
+		{Class: note.D, Octave: 4, Beat: 0, Beats: 1},
+		{Class: note.G, Octave: 4, Beat: 1, Beats: 1},
+		{Class: note.B, Octave: 4, Beat: 2, Beats: 1},
+		{Class: note.D, Octave: 5, Beat: 3, Beats: 1},
+		{Class: note.C, Octave: 5, Beat: 4, Beats: 1},
+		{Class: note.A, Octave: 4, Beat: 5, Beats: 1},
+		{Class: note.Fs, Octave: 4, Beat: 6, Beats: 1},
+		{Class: note.G, Octave: 4, Beat: 7, Beats: 3},
+	}, tune.Notes)
+}
+
+func TestReadABC_Defaults(t *testing.T) {
+	tune, err := ReadABC(strings.NewReader("C D E F | G4 z4 |\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, meter.Common, tune.Meter)
+	assert.Equal(t, key.Nil, tune.Key.Mode)
+	assert.Equal(t, []float64{0, 0.5, 1, 1.5, 2}, beatsOfNotes(tune.Notes))
+	assert.Equal(t, 2.0, tune.Notes[4].Beats)
+}
+
+func TestReadABC_Accidentals(t *testing.T) {
+	tune, err := ReadABC(strings.NewReader("L:1/4\nK:F\nB ^F F =B | B _E ^^C __D | c' C, B,, =c\n"))
+	assert.Nil(t, err)
+	var names []string
+	for _, n := range tune.Notes {
+		names = append(names, fmt.Sprintf("%s%d", n.Class.String(note.Sharp), n.Octave))
+	}
+	assert.Equal(t, []string{"A#4", "F#4", "F#4", "B4", "A#4", "D#4", "D4", "C4", "C6", "C3", "A#2", "C5"}, names)
+}
+
+func TestReadABC_Rhythm(t *testing.T) {
+	tune, err := ReadABC(strings.NewReader("M:2/4\nL:1/8\nK:C\nA>B c<d | (3efg a/b/ c'2- | c'2 [CEG]2 | Z2 | C// |\n"))
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{0, 0.75, 1, 1.25, 2, 2 + 1.0/3, 2 + 2.0/3, 3, 3.25, 3.5, 5.5, 10.5}, beatsOfNotes(tune.Notes), 1e-9)
+	assert.InDelta(t, 2.0, tune.Notes[9].Beats, 1e-9, "tied across the bar")
+	assert.Equal(t, note.G, tune.Notes[10].Class, "the highest note of a chord")
+	assert.InDelta(t, 0.125, tune.Notes[11].Beats, 1e-9)
+}
+
+func TestReadABC_Fields(t *testing.T) {
+	tune, err := ReadABC(strings.NewReader("M:6/8\nK:Em clef=treble\nB,3 [K:D] F3 | {g}A2 !trill!B C|\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, key.Minor, tune.Key.Mode)
+	assert.Equal(t, 6, tune.Meter.Beats)
+	assert.Equal(t, note.Fs, tune.Notes[1].Class, "by the inline key of D major")
+	tune, err = ReadABC(strings.NewReader("M:2/4\nC D\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0.25, tune.Notes[1].Beat, "a sixteenth note unit below 3/4")
+	tune, err = ReadABC(strings.NewReader("M:C|\nL:1/4\nC D\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, tune.Meter.Unit)
+}
+
+func TestReadABC_Articulations(t *testing.T) {
+	tune, err := ReadABC(strings.NewReader("L:1/4\nK:C\n.C !>!D LE !tenuto!F | +staccato+!accent!G (3ABc (Bcd) !trill!e |\n"))
+	assert.Nil(t, err)
+	var articulations []Articulation
+	for _, n := range tune.Notes {
+		articulations = append(articulations, n.Articulation)
+	}
+	assert.Equal(t, []Articulation{Staccato, Accent, Accent, Tenuto, Staccato | Accent, 0, 0, 0, Legato, Legato, 0, 0}, articulations)
+}
+
+func TestReadABC_Errors(t *testing.T) {
+	for _, text := range []string{"M:4/3\n", "L:eighth\n", "K:H\n", "C D \"E\n", "C D {E\n", "C D [E\n", "C D # E\n"} {
+		_, err := ReadABC(strings.NewReader(text))
+		assert.True(t, errors.Is(err, ErrSyntax), text)
+	}
+	_, err := ReadABC(strings.NewReader("K:C\nC D # E\n"))
+	assert.Equal(t, `invalid syntax at line 2: unexpected '#'`, err.Error())
+}
+
+//
+// Private
+//
+
+func beatsOfNotes(notes []Note) []float64 {
+	var beats []float64
+	for _, n := range notes {
+		beats = append(beats, n.Beat)
+	}
+	return beats
+}
@@ -0,0 +1,36 @@
+// Notes are articulated by how each is attacked and released, short or held, slurred into the next, or stressed, marked e.g. staccato, legato, accent or tenuto
+package melody
+
+import (
+	"strings"
+)
+
+// Articulation of a note, any of the Articulations together, e.g. Staccato|Accent, or 0 of none
+type Articulation int
+
+// Articulations of a note
+const (
+	Staccato Articulation = 1 << iota // short, detached from the next note
+	Legato                            // slurred into the next note, without a break
+	Accent                            // attacked louder than the notes around it
+	Tenuto                            // held for its full length, slightly stressed
+)
+
+// ArticulationNames of the Articulations, in order
+var ArticulationNames = []string{"staccato", "legato", "accent", "tenuto"}
+
+// Has whether the articulation has all of another, e.g. Staccato|Accent has Accent
+func (a Articulation) Has(other Articulation) bool {
+	return a&other == other
+}
+
+// String of the articulation, the names of each it has, in order, e.g. "staccato accent", or empty of none
+func (a Articulation) String() string {
+	var names []string
+	for n, name := range ArticulationNames {
+		if a.Has(1 << n) {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,23 @@
+// Notes are articulated by how each is attacked and released, short or held, slurred into the next, or stressed, marked e.g. staccato, legato, accent or tenuto
+package melody
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestArticulation_Has(t *testing.T) {
+	assert.True(t, (Staccato | Accent).Has(Accent))
+	assert.True(t, (Staccato | Accent).Has(Staccato|Accent))
+	assert.False(t, Staccato.Has(Staccato|Tenuto))
+	assert.False(t, Legato.Has(Accent))
+	assert.True(t, Articulation(0).Has(0))
+}
+
+func TestArticulation_String(t *testing.T) {
+	assert.Equal(t, "staccato", Staccato.String())
+	assert.Equal(t, "staccato accent", (Accent | Staccato).String())
+	assert.Equal(t, "legato tenuto", (Legato | Tenuto).String())
+	assert.Equal(t, "", Articulation(0).String())
+}
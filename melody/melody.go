@@ -0,0 +1,176 @@
+// A melody is a linear succession of notes, perceived as a single entity, sounding over the harmony of some chords.
+//
+// Each note of a melody is either a chord tone, belonging to the chord sounding with it, or a non-chord tone,
+// named by how it's approached and left: a passing tone, a neighbor tone, a suspension or an appoggiatura.
+//
+// https://en.wikipedia.org/wiki/Nonchord_tone
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package melody
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Note of a melody, a pitch class in an octave, from a beat counted from 0 at the beginning, for some beats, at any dynamic and articulation of its own, and any finger suggested to play it
+type Note struct {
+	Class        note.Class
+	Octave       note.Octave
+	Beat         float64
+	Beats        float64
+	Dynamic      dynamics.Level // of the note, or Nil to play it at the dynamic of its phrase
+	Articulation Articulation   // of the note, e.g. Staccato, or 0 to play it plainly for its whole length
+	Finger       int            // suggested to play the note, from 1 of the thumb to 5 of the little finger, or 0 of none
+}
+
+// Harmony of a chord, sounding from a beat counted from 0 at the beginning until the beat of the next
+type Harmony struct {
+	Chord chord.Chord
+	Beat  float64
+}
+
+// Role of a note of a melody in the harmony sounding with it
+type Role int
+
+// Roles of notes
+const (
+	Other        Role = iota // a non-chord tone of none of the other roles, e.g. an escape tone or an anticipation
+	ChordTone                // belonging to the chord
+	Passing                  // approached and left by step in the same direction, e.g. D between C and E over C major
+	Neighbor                 // approached and left by step in opposite directions, e.g. D between C and C over C major
+	Suspension               // repeated from a chord tone of the chord before, resolving by step, e.g. C of F major, then over G major resolving to B
+	Appoggiatura             // approached by leap and resolved by step in the opposite direction, e.g. F over C major resolving to E
+)
+
+// Analysis of a note of a melody, its role in the chord sounding with it, if any
+type Analysis struct {
+	Note  Note
+	Chord chord.Chord
+	Role  Role
+}
+
+// NotesOf names, e.g. NotesOf("E4", "D4", "C4"), each lasting a beat, one after another
+func NotesOf(names ...string) []Note {
+	notes := make([]Note, len(names))
+	for n, name := range names {
+		nt := note.Named(name)
+		notes[n] = Note{Class: nt.Class, Octave: nt.Octave, Beat: float64(n), Beats: 1}
+	}
+	return notes
+}
+
+// Analyze the role of each note of a melody, in order, in the chord sounding when it begins, which is the last of the chords to begin at or before it,
+// so a note before any chord, or between chords, is only ever Other
+func Analyze(notes []Note, chords []Harmony) []Analysis {
+	analyses := make([]Analysis, len(notes))
+	for n, nt := range notes {
+		a := Analysis{Note: nt}
+		c, ok := chordAt(chords, nt.Beat)
+		switch {
+		case !ok:
+			a.Role = Other
+		case c.Contains(nt.Class):
+			a.Chord, a.Role = c, ChordTone
+		default:
+			a.Chord, a.Role = c, roleOf(notes, n, chords)
+		}
+		analyses[n] = a
+	}
+	return analyses
+}
+
+// String of the Role, e.g. "passing tone"
+func (of Role) String() string {
+	switch of {
+	case Other:
+		return "other"
+	case ChordTone:
+		return "chord tone"
+	case Passing:
+		return "passing tone"
+	case Neighbor:
+		return "neighbor tone"
+	case Suspension:
+		return "suspension"
+	case Appoggiatura:
+		return "appoggiatura"
+	}
+	return ""
+}
+
+// Step of the note, in semitones from C-1, e.g. 60 for middle C, the same as its MIDI note number
+func (n Note) Step() int {
+	return int(n.Octave+1)*12 + int(n.Class) - 1
+}
+
+//
+// Private
+//
+
+// chordAt a beat, the last of the chords to begin at or before it, in order of their beats
+func chordAt(chords []Harmony, beat float64) (c chord.Chord, ok bool) {
+	start := 0.0
+	for _, h := range chords {
+		if h.Beat <= beat && (!ok || h.Beat >= start) {
+			c, start, ok = h.Chord, h.Beat, true
+		}
+	}
+	return
+}
+
+// roleOf a non-chord tone, the note at some index of a melody, by the notes before and after it
+func roleOf(notes []Note, n int, chords []Harmony) Role {
+	if n == 0 || n == len(notes)-1 {
+		return Other
+	}
+	in := notes[n].Step() - notes[n-1].Step()
+	out := notes[n+1].Step() - notes[n].Step()
+	switch {
+	case in == 0 && isStep(out) && isChordTone(notes[n-1], chords):
+		return Suspension
+	case isStep(in) && isStep(out) && sign(in) == sign(out):
+		return Passing
+	case isStep(in) && isStep(out):
+		return Neighbor
+	case isLeap(in) && isStep(out) && sign(in) != sign(out):
+		return Appoggiatura
+	}
+	return Other
+}
+
+// isChordTone of the chord sounding when the note begins
+func isChordTone(nt Note, chords []Harmony) bool {
+	c, ok := chordAt(chords, nt.Beat)
+	return ok && c.Contains(nt.Class)
+}
+
+// isStep of a semitone or a whole tone, either way
+func isStep(semitones int) bool {
+	return semitones != 0 && semitones >= -2 && semitones <= 2
+}
+
+// isLeap of more than a whole tone, either way
+func isLeap(semitones int) bool {
+	return semitones < -2 || semitones > 2
+}
+
+// sign of some semitones, -1 down, 1 up or 0
+func sign(semitones int) int {
+	switch {
+	case semitones < 0:
+		return -1
+	case semitones > 0:
+		return 1
+	}
+	return 0
+}
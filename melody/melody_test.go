@@ -0,0 +1,91 @@
+// A melody is a linear succession of notes, perceived as a single entity, sounding over the harmony of some chords.
+package melody
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestNotesOf(t *testing.T) {
+	assert.Equal(t, []Note{
+		{Class: note.E, Octave: 4, Beat: 0, Beats: 1},
+		{Class: note.D, Octave: 4, Beat: 1, Beats: 1},
+		{Class: note.C, Octave: 5, Beat: 2, Beats: 1},
+	}, NotesOf("E4", "D4", "C5"))
+}
+
+func TestNote_Step(t *testing.T) {
+	assert.Equal(t, 60, Note{Class: note.C, Octave: 4}.Step())
+	assert.Equal(t, 69, Note{Class: note.A, Octave: 4}.Step())
+}
+
+func TestAnalyze_Passing(t *testing.T) {
+	assertRoles(t, []Role{ChordTone, Passing, ChordTone, Passing, ChordTone}, NotesOf("E4", "D4", "C4", "D4", "E4"), harmonyOf("C"))
+}
+
+func TestAnalyze_Neighbor(t *testing.T) {
+	assertRoles(t, []Role{ChordTone, Neighbor, ChordTone, Neighbor, ChordTone}, NotesOf("G4", "A4", "G4", "F#4", "G4"), harmonyOf("C"))
+}
+
+func TestAnalyze_Appoggiatura(t *testing.T) {
+	assertRoles(t, []Role{ChordTone, Appoggiatura, ChordTone}, NotesOf("C4", "F4", "E4"), harmonyOf("C"))
+}
+
+func TestAnalyze_Suspension(t *testing.T) {
+	assertRoles(t, []Role{ChordTone, Suspension, ChordTone}, NotesOf("C5", "C5", "B4"), harmonyOf("F", "G"))
+	assertRoles(t, []Role{Other, Other, ChordTone}, NotesOf("D5", "D5", "C5"), harmonyOf("C", "C"), "unprepared, so not a suspension")
+}
+
+func TestAnalyze_Other(t *testing.T) {
+	assertRoles(t, []Role{Other, ChordTone, Other, ChordTone, Other}, NotesOf("D4", "C4", "D4", "B3", "A3"), harmonyOf("C", "C", "C", "G"))
+}
+
+func TestAnalyze_Chords(t *testing.T) {
+	notes := NotesOf("C4", "E4")
+	chords := []Harmony{{Chord: chord.Of("Am"), Beat: 1}, {Chord: chord.Of("C"), Beat: 0.5}}
+	analyses := Analyze(notes, chords)
+	assert.Equal(t, Other, analyses[0].Role, "before any chord")
+	assert.Equal(t, chord.Chord{}, analyses[0].Chord)
+	assert.Equal(t, ChordTone, analyses[1].Role)
+	assert.Equal(t, note.A, analyses[1].Chord.Root, "the last chord to begin, regardless of order")
+	assert.Equal(t, notes[1], analyses[1].Note)
+}
+
+func TestAnalyze_Empty(t *testing.T) {
+	assert.Equal(t, []Analysis{}, Analyze(nil, harmonyOf("C")))
+}
+
+func TestRole_String(t *testing.T) {
+	assert.Equal(t, "chord tone", ChordTone.String())
+	assert.Equal(t, "passing tone", Passing.String())
+	assert.Equal(t, "neighbor tone", Neighbor.String())
+	assert.Equal(t, "suspension", Suspension.String())
+	assert.Equal(t, "appoggiatura", Appoggiatura.String())
+	assert.Equal(t, "other", Other.String())
+	assert.Equal(t, "", Role(99).String())
+}
+
+//
+// Private
+//
+
+// harmonyOf chords named, each lasting a beat, one after another
+func harmonyOf(names ...string) []Harmony {
+	chords := make([]Harmony, len(names))
+	for n, name := range names {
+		chords[n] = Harmony{Chord: chord.Of(name), Beat: float64(n)}
+	}
+	return chords
+}
+
+func assertRoles(t *testing.T, expect []Role, notes []Note, chords []Harmony, msgAndArgs ...interface{}) {
+	var roles []Role
+	for _, a := range Analyze(notes, chords) {
+		roles = append(roles, a.Role)
+	}
+	assert.Equal(t, expect, roles, msgAndArgs...)
+}
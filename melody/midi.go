@@ -0,0 +1,125 @@
+// A melody is read from a Standard MIDI File, as the highest of the notes that begin together, each cut short where the next begins,
+// and written to one, each note at the velocity of its dynamic, its length and velocity shaped by its articulation
+package melody
+
+import (
+	"context"
+	"io"
+	"math"
+
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Shaping of the MIDI of an articulated note, by its length and velocity
+const (
+	StaccatoLength = 0.5                  // of the length of a staccato note that sounds
+	PortatoLength  = 0.75                 // of the length of a note both staccato and tenuto that sounds
+	LegatoOverlap  = midi.Resolution / 32 // ticks of the next note overlapped by a legato note
+	TenutoStress   = 8                    // velocity added to a tenuto note
+)
+
+// ReadMIDI of a melody, in quarter note beats of 4/4, the highest of the notes that begin together on any track or channel, except the drum channel,
+// each cut short where the next begins, with no title or key
+func ReadMIDI(r io.Reader) (Tune, error) {
+	return ReadMIDIContext(context.Background(), r)
+}
+
+// ReadMIDIContext of a melody, the same as ReadMIDI, returning the error of the context as soon as it's done, e.g. when a server cancels a request
+func ReadMIDIContext(ctx context.Context, r io.Reader) (Tune, error) {
+	notes, err := midi.ReadContext(ctx, r)
+	if err != nil {
+		return Tune{}, err
+	}
+	return Tune{Meter: meter.Common, Notes: NotesOfMIDI(notes)}, nil
+}
+
+// NotesOfMIDI of a melody, in quarter note beats, the highest of the notes that begin together, except on the drum channel, each cut short where the next begins
+func NotesOfMIDI(notes []midi.Note) []Note {
+	var melody []Note
+	for _, n := range notes {
+		if n.Channel == drumChannel || n.Number < 0 {
+			continue
+		}
+		nt := Note{Class: note.Class(n.Number%12 + 1), Octave: note.Octave(n.Number/12 - 1), Beat: float64(n.Start) / midi.Quarter, Beats: float64(n.Duration) / midi.Quarter}
+		if len(melody) > 0 {
+			last := &melody[len(melody)-1]
+			if last.Beat == nt.Beat {
+				if nt.Step() > last.Step() {
+					*last = nt
+				}
+				continue
+			}
+			if last.Beat+last.Beats > nt.Beat {
+				last.Beats = nt.Beat - last.Beat
+			}
+		}
+		melody = append(melody, nt)
+	}
+	return melody
+}
+
+// MIDINotes of the tune, each from its beat for its beats, in the unit of its meter, at the velocity of its dynamic, or the next louder if accented,
+// sounding for half its length if staccato, until it overlaps the next note if legato, and stressed if tenuto, e.g. to write a generated bass line
+func (t Tune) MIDINotes() []midi.Note {
+	ticks := float64(t.Meter.TicksPerBeat())
+	if ticks == 0 {
+		ticks = midi.Quarter
+	}
+	notes := make([]midi.Note, len(t.Notes))
+	for n, nt := range t.Notes {
+		start, end := int(math.Round(nt.Beat*ticks)), int(math.Round((nt.Beat+nt.Beats)*ticks))
+		mn := midi.Note{Number: nt.Step(), Velocity: nt.Dynamic.Velocity(), Start: start, Duration: end - start}
+		switch a := nt.Articulation; {
+		case a.Has(Staccato | Tenuto):
+			mn.Duration = int(math.Round(float64(mn.Duration) * PortatoLength))
+		case a.Has(Staccato):
+			mn.Duration = int(math.Round(float64(mn.Duration) * StaccatoLength))
+		case a.Has(Legato) && n+1 < len(t.Notes):
+			next := int(math.Round(t.Notes[n+1].Beat * ticks))
+			if next > start {
+				mn.Duration = next - start + LegatoOverlap
+			}
+		}
+		if nt.Articulation.Has(Accent) {
+			mn.Velocity = accentedOf(nt.Dynamic)
+		}
+		if nt.Articulation.Has(Tenuto) {
+			mn.Velocity += TenutoStress
+		}
+		if mn.Velocity > 127 {
+			mn.Velocity = 127
+		}
+		notes[n] = mn
+	}
+	return notes
+}
+
+// WriteMIDI of the tune, its MIDINotes, at its tempo, if any
+func (t Tune) WriteMIDI(w io.Writer) error {
+	ticks := t.Meter.TicksPerBeat()
+	if ticks == 0 {
+		ticks = midi.Quarter
+	}
+	return midi.WriteTempo(w, t.MIDINotes(), t.Tempo*float64(ticks)/midi.Quarter)
+}
+
+//
+// Private
+//
+
+// accentedOf the velocity of a dynamic, that of the next louder, or above fortissimo as much louder again, e.g. of F for an accent at MF
+func accentedOf(l dynamics.Level) int {
+	if l == dynamics.Nil {
+		l = dynamics.MF
+	}
+	if l < dynamics.FF {
+		return (l + 1).Velocity()
+	}
+	return 2*dynamics.FF.Velocity() - dynamics.F.Velocity()
+}
+
+// drumChannel of General MIDI, whose notes are drums, not pitches
+const drumChannel = 9
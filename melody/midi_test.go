@@ -0,0 +1,99 @@
+// A melody is read from a Standard MIDI File, as the highest of the notes that begin together, each cut short where the next begins,
+// and written to one, each note at the velocity of its dynamic, its length and velocity shaped by its articulation
+package melody
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestReadMIDI(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, midi.Write(&out, []midi.Note{
+		{Number: 60, Start: 0, Duration: midi.Whole},
+		{Number: 64, Start: 0, Duration: midi.Quarter},
+		{Number: 67, Start: midi.Quarter, Duration: midi.Half},
+		{Number: 36, Channel: 9, Start: midi.Quarter, Duration: midi.Quarter},
+		{Number: 65, Start: midi.Half, Duration: midi.Quarter},
+	}))
+	tune, err := ReadMIDI(&out)
+	assert.Nil(t, err)
+	assert.Equal(t, meter.Common, tune.Meter)
+	assert.Equal(t, []Note{
+		{Class: note.E, Octave: 4, Beat: 0, Beats: 1},
+		{Class: note.G, Octave: 4, Beat: 1, Beats: 1},
+		{Class: note.F, Octave: 4, Beat: 2, Beats: 1},
+	}, tune.Notes)
+}
+
+func TestReadMIDI_Invalid(t *testing.T) {
+	_, err := ReadMIDI(bytes.NewReader([]byte("not a MIDI file")))
+	assert.True(t, errors.Is(err, midi.ErrInvalidFile))
+}
+
+func TestReadMIDIContext(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, midi.Write(&out, []midi.Note{{Number: 60, Duration: midi.Quarter}}))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := ReadMIDIContext(ctx, &out)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestTune_MIDINotes(t *testing.T) {
+	tune := Tune{Meter: meter.Common, Notes: []Note{
+		{Class: note.C, Octave: 4, Beat: 0, Beats: 1},
+		{Class: note.D, Octave: 4, Beat: 1, Beats: 1, Articulation: Staccato},
+		{Class: note.E, Octave: 4, Beat: 2, Beats: 1, Articulation: Staccato | Tenuto},
+		{Class: note.F, Octave: 4, Beat: 3, Beats: 0.5, Articulation: Legato},
+		{Class: note.G, Octave: 4, Beat: 4, Beats: 2, Dynamic: dynamics.P, Articulation: Accent},
+		{Class: note.A, Octave: 4, Beat: 6, Beats: 2, Dynamic: dynamics.FF, Articulation: Accent | Tenuto},
+	}}
+	assert.Equal(t, []midi.Note{
+		{Number: 60, Velocity: 80, Start: 0, Duration: midi.Quarter},
+		{Number: 62, Velocity: 80, Start: midi.Quarter, Duration: midi.Eighth},
+		{Number: 64, Velocity: 88, Start: midi.Half, Duration: midi.Quarter * 3 / 4},
+		{Number: 65, Velocity: 80, Start: midi.Quarter * 3, Duration: midi.Quarter + LegatoOverlap},
+		{Number: 67, Velocity: 64, Start: midi.Whole, Duration: midi.Half},
+		{Number: 69, Velocity: 127, Start: midi.Whole + midi.Half, Duration: midi.Half},
+	}, tune.MIDINotes())
+}
+
+func TestTune_MIDINotes_Unit(t *testing.T) {
+	m, err := meter.Parse("6/8")
+	assert.Nil(t, err)
+	tune := Tune{Meter: m, Notes: []Note{
+		{Class: note.C, Octave: 4, Beat: 0, Beats: 3},
+		{Class: note.D, Octave: 4, Beat: 3, Beats: 3, Articulation: Legato},
+	}}
+	assert.Equal(t, []midi.Note{
+		{Number: 60, Velocity: 80, Start: 0, Duration: midi.Eighth * 3},
+		{Number: 62, Velocity: 80, Start: midi.Eighth * 3, Duration: midi.Eighth * 3},
+	}, tune.MIDINotes())
+}
+
+func TestTune_WriteMIDI(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, Tune{Meter: meter.Common, Notes: NotesOf("C4", "E4")}.WriteMIDI(&out))
+	tune, err := ReadMIDI(&out)
+	assert.Nil(t, err)
+	assert.Equal(t, NotesOf("C4", "E4"), tune.Notes)
+}
+
+func TestTune_WriteMIDI_Tempo(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, Tune{Meter: meter.Common, Tempo: 80, Notes: NotesOf("C4")}.WriteMIDI(&out))
+	assert.Equal(t, []byte{0x00, 0xFF, 0x51, 0x03, 0x0B, 0x71, 0xB0}, out.Bytes()[22:29], "750000 microseconds per quarter note")
+	tune, err := ReadMIDI(&out)
+	assert.Nil(t, err)
+	assert.Equal(t, NotesOf("C4"), tune.Notes)
+}
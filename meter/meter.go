@@ -0,0 +1,227 @@
+// A meter, or time signature, is the number of beats in each bar and the note value of each beat, e.g. 3/4, with the beats grouped, e.g. 2+2+3 of 7/8.
+//
+// The first beat of each group is stressed, and the first of the bar most of all, so a meter gives every beat its strength.
+//
+// https://en.wikipedia.org/wiki/Metre_(music)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package meter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidMeter when a time signature can't be parsed, e.g. "4/0", or its groups don't add up to its beats
+var ErrInvalidMeter = errors.New("invalid meter")
+
+// Common time, i.e. 4/4
+var Common = Meter{Beats: 4, Unit: 4, Groups: []int{2, 2}}
+
+// Meter of some beats per bar, each of a unit, e.g. 8 for eighth notes, in groups of beats that add up to all of them
+type Meter struct {
+	Beats  int
+	Unit   int
+	Groups []int // or if none, the groups implied by the beats, as for New
+}
+
+// Strength of a beat, from an Offbeat between beats to the Strong downbeat of the bar
+type Strength int
+
+// Strengths of beats
+const (
+	Offbeat Strength = iota
+	Weak
+	Medium // the first beat of a group after the first
+	Strong // the downbeat
+)
+
+// String of the Strength, e.g. "Strong" or "Offbeat"
+func (of Strength) String() string {
+	switch of {
+	case Offbeat:
+		return "Offbeat"
+	case Weak:
+		return "Weak"
+	case Medium:
+		return "Medium"
+	case Strong:
+		return "Strong"
+	}
+	return ""
+}
+
+// New meter of some beats per bar of a unit, in some groups of beats, or else the groups implied by the beats: in threes if compound, e.g. 6/8,
+// else twos with a three at the end of an odd number, e.g. 2+2 of 4/4 or 2+2+3 of 7/8
+func New(beats, unit int, groups ...int) (Meter, error) {
+	if len(groups) == 0 {
+		groups = groupsOf(beats)
+	}
+	m := Meter{Beats: beats, Unit: unit, Groups: groups}
+	return m, m.Validate()
+}
+
+// Parse a time signature, e.g. "3/4", with any grouping of its beats, e.g. "2+2+3/8" or "7/8 (2+2+3)"
+func Parse(text string) (Meter, error) {
+	m := rgxMeter.FindStringSubmatch(text)
+	if m == nil {
+		return Meter{}, fmt.Errorf("%w %q, expected e.g. 3/4 or 2+2+3/8", ErrInvalidMeter, text)
+	}
+	unit, _ := strconv.Atoi(m[3])
+	groups := groupsIn(m[2])
+	if len(groups) == 0 {
+		groups = groupsIn(m[4])
+	}
+	beats := sum(groups)
+	if len(m[1]) > 0 {
+		beats, _ = strconv.Atoi(m[1])
+	}
+	meter, err := New(beats, unit, groups...)
+	if err != nil {
+		return Meter{}, fmt.Errorf("%w %q, expected beats of a unit that is a power of 2, in groups adding up to them", ErrInvalidMeter, text)
+	}
+	return meter, nil
+}
+
+// Grouped by some text of groups of its beats, e.g. "3+2+2", without modifying the meter, or an error if they don't add up to its beats
+func (m Meter) Grouped(text string) (Meter, error) {
+	if !rgxGroups.MatchString(text) {
+		return m, fmt.Errorf("%w groups %q, expected e.g. 2+2+3", ErrInvalidMeter, text)
+	}
+	grouped, err := New(m.Beats, m.Unit, groupsIn(text)...)
+	if err != nil {
+		return m, fmt.Errorf("%w groups %q, expected them to add up to %d beats", ErrInvalidMeter, text, m.Beats)
+	}
+	return grouped, nil
+}
+
+// Validate the meter, of at least one beat of a unit that is a power of 2, grouped into all of its beats
+func (m Meter) Validate() error {
+	if m.Beats < 1 || m.Unit < 1 || m.Unit&(m.Unit-1) != 0 || sum(m.groups()) != m.Beats {
+		return fmt.Errorf("%w %s", ErrInvalidMeter, m)
+	}
+	for _, g := range m.groups() {
+		if g < 1 {
+			return fmt.Errorf("%w %s", ErrInvalidMeter, m)
+		}
+	}
+	return nil
+}
+
+// String of the time signature, e.g. "7/8", with its groups if they aren't implied by its beats, e.g. "3+2+2/8"
+func (m Meter) String() string {
+	if equal(m.Groups, groupsOf(m.Beats)) || len(m.Groups) == 0 {
+		return fmt.Sprintf("%d/%d", m.Beats, m.Unit)
+	}
+	var groups []string
+	for _, g := range m.Groups {
+		groups = append(groups, strconv.Itoa(g))
+	}
+	return fmt.Sprintf("%s/%d", strings.Join(groups, "+"), m.Unit)
+}
+
+// Strengths of each beat of a bar, from the first, e.g. Strong, Weak, Medium, Weak for 4/4
+func (m Meter) Strengths() []Strength {
+	strengths := make([]Strength, 0, m.Beats)
+	for n, g := range m.groups() {
+		for i := 0; i < g; i++ {
+			switch {
+			case i > 0:
+				strengths = append(strengths, Weak)
+			case n == 0:
+				strengths = append(strengths, Strong)
+			default:
+				strengths = append(strengths, Medium)
+			}
+		}
+	}
+	return strengths
+}
+
+// StrengthOf a beat of a bar, counted from 1, or Offbeat if it falls between beats, e.g. 2.5, or outside the bar
+func (m Meter) StrengthOf(beat float64) Strength {
+	strengths := m.Strengths()
+	if beat != float64(int(beat)) || beat < 1 || int(beat) > len(strengths) {
+		return Offbeat
+	}
+	return strengths[int(beat)-1]
+}
+
+//
+// Private
+//
+
+var rgxMeter, _ = regexp.Compile(`^\s*(?:([0-9]+)|\(?([0-9]+(?:\s*\+\s*[0-9]+)+)\)?)\s*/\s*([0-9]+)\s*(?:\(?([0-9]+(?:\s*\+\s*[0-9]+)+)\)?)?\s*$`)
+
+var rgxGroups, _ = regexp.Compile(`^\s*[0-9]+(?:\s*\+\s*[0-9]+)*\s*$`)
+
+// groups of the meter, or if none, the groups implied by its beats
+func (m Meter) groups() []int {
+	if len(m.Groups) == 0 {
+		return groupsOf(m.Beats)
+	}
+	return m.Groups
+}
+
+// groupsIn text, e.g. 2, 2 and 3 in "2+2+3"
+func groupsIn(text string) (groups []int) {
+	if len(text) == 0 {
+		return
+	}
+	for _, g := range strings.Split(text, "+") {
+		n, _ := strconv.Atoi(strings.TrimSpace(g))
+		groups = append(groups, n)
+	}
+	return
+}
+
+// groupsOf some beats implied by their number, in threes if compound, e.g. 3+3 of 6/8, else in twos with a three at the end of an odd number
+func groupsOf(beats int) (groups []int) {
+	switch {
+	case beats <= 3:
+		return []int{beats}
+	case beats%3 == 0:
+		for n := 0; n < beats/3; n++ {
+			groups = append(groups, 3)
+		}
+		return
+	}
+	for remaining := beats; remaining > 0; {
+		if remaining == 3 {
+			return append(groups, 3)
+		}
+		groups = append(groups, 2)
+		remaining -= 2
+	}
+	return
+}
+
+func sum(values []int) (total int) {
+	for _, v := range values {
+		total += v
+	}
+	return
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
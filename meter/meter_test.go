@@ -0,0 +1,108 @@
+// A meter, or time signature, is the number of beats in each bar and the note value of each beat, e.g. 3/4, with the beats grouped, e.g. 2+2+3 of 7/8.
+package meter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParse(t *testing.T) {
+	assertParse(t, Meter{Beats: 4, Unit: 4, Groups: []int{2, 2}}, "4/4")
+	assertParse(t, Meter{Beats: 3, Unit: 4, Groups: []int{3}}, "3/4")
+	assertParse(t, Meter{Beats: 2, Unit: 2, Groups: []int{2}}, "2/2")
+	assertParse(t, Meter{Beats: 6, Unit: 8, Groups: []int{3, 3}}, "6/8")
+	assertParse(t, Meter{Beats: 12, Unit: 8, Groups: []int{3, 3, 3, 3}}, "12/8")
+	assertParse(t, Meter{Beats: 5, Unit: 4, Groups: []int{2, 3}}, "5/4")
+	assertParse(t, Meter{Beats: 7, Unit: 8, Groups: []int{2, 2, 3}}, "7/8")
+	assertParse(t, Meter{Beats: 7, Unit: 8, Groups: []int{3, 2, 2}}, "3+2+2/8")
+	assertParse(t, Meter{Beats: 7, Unit: 8, Groups: []int{2, 3, 2}}, "7/8 (2+3+2)")
+	assertParse(t, Meter{Beats: 9, Unit: 8, Groups: []int{2, 2, 2, 3}}, " (2+2+2+3) / 8 ")
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, text := range []string{"4/0", "4/3", "0/4", "waltz", "", "4", "7/8 (2+2)", "4/4/4"} {
+		_, err := Parse(text)
+		assert.True(t, errors.Is(err, ErrInvalidMeter), text)
+	}
+	_, err := Parse("waltz")
+	assert.Equal(t, `invalid meter "waltz", expected e.g. 3/4 or 2+2+3/8`, err.Error())
+	_, err = Parse("7/8 (2+2)")
+	assert.Equal(t, `invalid meter "7/8 (2+2)", expected beats of a unit that is a power of 2, in groups adding up to them`, err.Error())
+}
+
+func TestNew(t *testing.T) {
+	m, err := New(7, 8, 3, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, 4}, m.Groups)
+	_, err = New(7, 8, 3, 3)
+	assert.True(t, errors.Is(err, ErrInvalidMeter))
+	assert.Equal(t, "invalid meter 3+3/8", err.Error())
+	_, err = New(4, 8, 4, 0)
+	assert.True(t, errors.Is(err, ErrInvalidMeter))
+}
+
+func TestMeter_Grouped(t *testing.T) {
+	m, err := Meter{Beats: 7, Unit: 8}.Grouped("3+2+2")
+	assert.Nil(t, err)
+	assert.Equal(t, Meter{Beats: 7, Unit: 8, Groups: []int{3, 2, 2}}, m)
+	m, err = Common.Grouped(" 3 + 1 ")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, 1}, m.Groups)
+	assert.Equal(t, []int{2, 2}, Common.Groups, "without modifying the meter")
+	m, err = Common.Grouped("4")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{4}, m.Groups)
+	_, err = Meter{Beats: 7, Unit: 8}.Grouped("2+2")
+	assert.True(t, errors.Is(err, ErrInvalidMeter))
+	assert.Equal(t, `invalid meter groups "2+2", expected them to add up to 7 beats`, err.Error())
+	_, err = Common.Grouped("2,2")
+	assert.Equal(t, `invalid meter groups "2,2", expected e.g. 2+2+3`, err.Error())
+}
+
+func TestMeter_Validate(t *testing.T) {
+	assert.Nil(t, Common.Validate())
+	assert.Nil(t, Meter{Beats: 3, Unit: 4}.Validate())
+	assert.True(t, errors.Is(Meter{Beats: 4}.Validate(), ErrInvalidMeter))
+}
+
+func TestMeter_String(t *testing.T) {
+	assert.Equal(t, "4/4", Common.String())
+	assert.Equal(t, "7/8", Meter{Beats: 7, Unit: 8, Groups: []int{2, 2, 3}}.String())
+	assert.Equal(t, "3+2+2/8", Meter{Beats: 7, Unit: 8, Groups: []int{3, 2, 2}}.String())
+	assert.Equal(t, "3/4", Meter{Beats: 3, Unit: 4}.String())
+}
+
+func TestMeter_Strengths(t *testing.T) {
+	assert.Equal(t, []Strength{Strong, Weak, Medium, Weak}, Common.Strengths())
+	assert.Equal(t, []Strength{Strong, Weak, Weak}, Meter{Beats: 3, Unit: 4}.Strengths())
+	assert.Equal(t, []Strength{Strong, Weak, Weak, Medium, Weak, Weak}, Meter{Beats: 6, Unit: 8}.Strengths())
+	assert.Equal(t, []Strength{Strong, Weak, Medium, Weak, Medium, Weak, Weak}, Meter{Beats: 7, Unit: 8, Groups: []int{2, 2, 3}}.Strengths())
+}
+
+func TestMeter_StrengthOf(t *testing.T) {
+	assert.Equal(t, Strong, Common.StrengthOf(1))
+	assert.Equal(t, Weak, Common.StrengthOf(2))
+	assert.Equal(t, Medium, Common.StrengthOf(3))
+	assert.Equal(t, Offbeat, Common.StrengthOf(2.5))
+	assert.Equal(t, Offbeat, Common.StrengthOf(5))
+	assert.Equal(t, Offbeat, Common.StrengthOf(0))
+}
+
+func TestStrength_String(t *testing.T) {
+	assert.Equal(t, "[Strong Weak Medium Weak]", fmt.Sprint(Common.Strengths()))
+	assert.Equal(t, "Offbeat", Offbeat.String())
+	assert.Equal(t, "", Strength(9).String())
+}
+
+//
+// Private
+//
+
+func assertParse(t *testing.T, expect Meter, text string) {
+	m, err := Parse(text)
+	assert.Nil(t, err, text)
+	assert.Equal(t, expect, m, text)
+}
@@ -0,0 +1,50 @@
+// Bars of a meter are subdivided into a grid of ticks, e.g. sixteenth notes of 4/4, for generating rhythms and exporting MIDI
+package meter
+
+import (
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// Tick of a grid, at a step of a beat of a bar, all counted from 0, and its Time in MIDI ticks from the beginning of the first bar
+type Tick struct {
+	Bar      int
+	Beat     int
+	Step     int
+	Time     int
+	Strength Strength // of its beat if it's the first step, else Offbeat
+}
+
+// TicksPerBeat of the meter in MIDI ticks, e.g. midi.Quarter for 4/4, or midi.Eighth for 7/8
+func (m Meter) TicksPerBeat() int {
+	if m.Unit < 1 {
+		return 0
+	}
+	return midi.Whole / m.Unit
+}
+
+// TicksPerBar of the meter in MIDI ticks, e.g. midi.Whole for 4/4
+func (m Meter) TicksPerBar() int {
+	return m.Beats * m.TicksPerBeat()
+}
+
+// Subdivide some bars of the meter into a grid, of a resolution of steps per beat, e.g. 4 for sixteenth notes of 4/4
+func (m Meter) Subdivide(bars, resolution int) []Tick {
+	if resolution < 1 {
+		return nil
+	}
+	strengths := m.Strengths()
+	step := m.TicksPerBeat() / resolution
+	var ticks []Tick
+	for bar := 0; bar < bars; bar++ {
+		for beat, strength := range strengths {
+			for s := 0; s < resolution; s++ {
+				t := Tick{Bar: bar, Beat: beat, Step: s, Time: bar*m.TicksPerBar() + beat*m.TicksPerBeat() + s*step}
+				if s == 0 {
+					t.Strength = strength
+				}
+				ticks = append(ticks, t)
+			}
+		}
+	}
+	return ticks
+}
@@ -0,0 +1,45 @@
+// Bars of a meter are subdivided into a grid of ticks, e.g. sixteenth notes of 4/4, for generating rhythms and exporting MIDI
+package meter
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestMeter_Ticks(t *testing.T) {
+	assert.Equal(t, midi.Quarter, Common.TicksPerBeat())
+	assert.Equal(t, midi.Whole, Common.TicksPerBar())
+	m, _ := Parse("7/8")
+	assert.Equal(t, midi.Eighth, m.TicksPerBeat())
+	assert.Equal(t, 7*midi.Eighth, m.TicksPerBar())
+	assert.Equal(t, 0, Meter{}.TicksPerBar())
+}
+
+func TestMeter_Subdivide(t *testing.T) {
+	m, _ := Parse("3/4")
+	assert.Equal(t, []Tick{
+		{Bar: 0, Beat: 0, Step: 0, Time: 0, Strength: Strong},
+		{Bar: 0, Beat: 0, Step: 1, Time: 240, Strength: Offbeat},
+		{Bar: 0, Beat: 1, Step: 0, Time: 480, Strength: Weak},
+		{Bar: 0, Beat: 1, Step: 1, Time: 720, Strength: Offbeat},
+		{Bar: 0, Beat: 2, Step: 0, Time: 960, Strength: Weak},
+		{Bar: 0, Beat: 2, Step: 1, Time: 1200, Strength: Offbeat},
+		{Bar: 1, Beat: 0, Step: 0, Time: 1440, Strength: Strong},
+		{Bar: 1, Beat: 0, Step: 1, Time: 1680, Strength: Offbeat},
+		{Bar: 1, Beat: 1, Step: 0, Time: 1920, Strength: Weak},
+		{Bar: 1, Beat: 1, Step: 1, Time: 2160, Strength: Offbeat},
+		{Bar: 1, Beat: 2, Step: 0, Time: 2400, Strength: Weak},
+		{Bar: 1, Beat: 2, Step: 1, Time: 2640, Strength: Offbeat},
+	}, m.Subdivide(2, 2))
+}
+
+func TestMeter_Subdivide_Sixteenths(t *testing.T) {
+	ticks := Common.Subdivide(4, 4)
+	assert.Equal(t, 64, len(ticks))
+	assert.Equal(t, Tick{Bar: 3, Beat: 3, Step: 3, Time: 4*midi.Whole - midi.Quarter/4}, ticks[63])
+	assert.Equal(t, Medium, ticks[8].Strength)
+	assert.Nil(t, Common.Subdivide(1, 0))
+}
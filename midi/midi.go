@@ -0,0 +1,171 @@
+// MIDI (Musical Instrument Digital Interface) is a technical standard for communication between electronic musical instruments and computers.
+//
+// Notes are written as a Standard MIDI File, to be played by a synthesizer or opened in a DAW.
+//
+// https://en.wikipedia.org/wiki/MIDI
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package midi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Resolution of every file, in ticks per quarter note
+const Resolution = 480
+
+// Durations in ticks
+const (
+	Whole   = Resolution * 4
+	Half    = Resolution * 2
+	Quarter = Resolution
+	Eighth  = Resolution / 2
+)
+
+// Tempo of every file, in beats per minute
+const Tempo = 120
+
+// Velocity of a note if none is given, i.e. mezzo-forte
+const DefaultVelocity = 80
+
+// Note to be played, from a start to a duration in ticks
+type Note struct {
+	Number   int // MIDI note number, e.g. 60 for middle C
+	Velocity int // from 1 to 127, or DefaultVelocity if 0
+	Channel  int // from 0 to 15
+	Start    int // in ticks from the beginning
+	Duration int // in ticks
+}
+
+// NumberOf a pitch class in an octave, e.g. 60 for C4 (middle C), or -1 for the Nil class
+func NumberOf(class note.Class, octave note.Octave) int {
+	if class == note.Nil {
+		return -1
+	}
+	return int(octave+1)*12 + int(class) - 1
+}
+
+// TicksOf a duration at the Tempo, e.g. 480 for half a second
+func TicksOf(d time.Duration) int {
+	return int(d * Resolution * Tempo / time.Minute)
+}
+
+// Write a Standard MIDI File of the notes, in format 0 (a single track), at the default Tempo of 120 beats per minute
+func Write(w io.Writer, notes []Note) error {
+	return write(w, notes, nil)
+}
+
+// WriteTempo of a Standard MIDI File of the notes, like Write, but at a tempo of some quarter notes per minute, e.g. 80 to practice slowly, or the default Tempo if it isn't above 0
+func WriteTempo(w io.Writer, notes []Note, bpm float64) error {
+	return write(w, notes, tempoEvent(bpm))
+}
+
+// WriteClip of a Standard MIDI File of the notes, like WriteTempo, with its track named, e.g. "chorus", so a DAW names the clip it's dropped in as, e.g. Ableton Live
+func WriteClip(w io.Writer, name string, notes []Note, bpm float64) error {
+	return write(w, notes, append(nameEvent(name), tempoEvent(bpm)...))
+}
+
+//
+// Private
+//
+
+// write a Standard MIDI File of the notes, in format 0, its track beginning with any meta events
+func write(w io.Writer, notes []Note, meta []byte) error {
+	var file bytes.Buffer
+	writeHeader(&file, 0, 1)
+	writeTrack(&file, notes, meta)
+	_, err := file.WriteTo(w)
+	return err
+}
+
+// writeHeader chunk of a Standard MIDI File, of a format and its number of tracks
+func writeHeader(file *bytes.Buffer, format int, tracks int) {
+	file.WriteString("MThd")
+	binary.Write(file, binary.BigEndian, uint32(6))
+	binary.Write(file, binary.BigEndian, uint16(format))
+	binary.Write(file, binary.BigEndian, uint16(tracks))
+	binary.Write(file, binary.BigEndian, uint16(Resolution))
+}
+
+// writeTrack chunk of the notes, beginning with any events at its first tick, then ending
+func writeTrack(file *bytes.Buffer, notes []Note, first []byte) {
+	var track bytes.Buffer
+	track.Write(first)
+	tick := 0
+	for _, e := range eventsOf(notes) {
+		writeVarLen(&track, e.tick-tick)
+		track.Write(e.data)
+		tick = e.tick
+	}
+	track.Write([]byte{0x00, 0xFF, 0x2F, 0x00}) // end of track
+	file.WriteString("MTrk")
+	binary.Write(file, binary.BigEndian, uint32(track.Len()))
+	track.WriteTo(file)
+}
+
+// nameEvent of a track, a meta event at its beginning
+func nameEvent(name string) []byte {
+	meta := []byte{0x00, 0xFF, 0x03}
+	var length bytes.Buffer
+	writeVarLen(&length, len(name))
+	return append(append(meta, length.Bytes()...), name...)
+}
+
+// tempoEvent of some quarter notes per minute, a meta event at the beginning of a track, or none if it isn't above 0, for the default Tempo
+func tempoEvent(bpm float64) []byte {
+	if bpm <= 0 {
+		return nil
+	}
+	micros := int(math.Round(float64(time.Minute/time.Microsecond) / bpm))
+	return []byte{0x00, 0xFF, 0x51, 0x03, byte(micros >> 16), byte(micros >> 8), byte(micros)}
+}
+
+// event of a track, at a tick, its status and data bytes
+type event struct {
+	tick int
+	data []byte
+}
+
+// eventsOf notes, a note on and off for each, in order of their ticks, with every note off before any note on at the same tick
+func eventsOf(notes []Note) []event {
+	var ons, offs []event
+	for _, n := range notes {
+		if n.Number < 0 || n.Number > 127 {
+			continue
+		}
+		velocity := n.Velocity
+		if velocity == 0 {
+			velocity = DefaultVelocity
+		}
+		channel := byte(n.Channel & 0x0F)
+		ons = append(ons, event{n.Start, []byte{0x90 | channel, byte(n.Number), byte(velocity & 0x7F)}})
+		offs = append(offs, event{n.Start + n.Duration, []byte{0x80 | channel, byte(n.Number), 0}})
+	}
+	events := append(offs, ons...)
+	sort.SliceStable(events, func(i, j int) bool { return events[i].tick < events[j].tick })
+	return events
+}
+
+// writeVarLen quantity, 7 bits per byte, most significant first, with the high bit set on all but the last byte
+func writeVarLen(buf *bytes.Buffer, value int) {
+	b := []byte{byte(value & 0x7F)}
+	for value >>= 7; value > 0; value >>= 7 {
+		b = append([]byte{byte(value&0x7F) | 0x80}, b...)
+	}
+	buf.Write(b)
+}
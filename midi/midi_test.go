@@ -0,0 +1,115 @@
+// MIDI (Musical Instrument Digital Interface) is a technical standard for communication between electronic musical instruments and computers.
+package midi
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestNumberOf(t *testing.T) {
+	assert.Equal(t, 60, NumberOf(note.C, 4))
+	assert.Equal(t, 69, NumberOf(note.A, 4))
+	assert.Equal(t, 0, NumberOf(note.C, -1))
+	assert.Equal(t, 127, NumberOf(note.G, 9))
+	assert.Equal(t, -1, NumberOf(note.Nil, 4))
+}
+
+func TestTicksOf(t *testing.T) {
+	assert.Equal(t, Quarter, TicksOf(500*time.Millisecond))
+	assert.Equal(t, Whole, TicksOf(2*time.Second))
+	assert.Equal(t, 9, TicksOf(10*time.Millisecond))
+	assert.Equal(t, -96, TicksOf(-100*time.Millisecond))
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, []Note{
+		{Number: 60, Start: 0, Duration: Quarter},
+		{Number: 64, Velocity: 100, Channel: 1, Start: Quarter, Duration: Whole},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{
+		'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0x01, 0xE0,
+		'M', 'T', 'r', 'k', 0, 0, 0, 22,
+		0x00, 0x90, 60, 80,
+		0x83, 0x60, 0x80, 60, 0,
+		0x00, 0x91, 64, 100,
+		0x8F, 0x00, 0x81, 64, 0,
+		0x00, 0xFF, 0x2F, 0x00,
+	}, buf.Bytes())
+}
+
+func TestWrite_Simultaneous(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, []Note{
+		{Number: 60, Start: 0, Duration: Quarter},
+		{Number: 60, Start: Quarter, Duration: Quarter},
+		{Number: 64, Start: 0, Duration: Half},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{
+		0x00, 0x90, 60, 80,
+		0x00, 0x90, 64, 80,
+		0x83, 0x60, 0x80, 60, 0,
+		0x00, 0x90, 60, 80,
+		0x83, 0x60, 0x80, 60, 0,
+		0x00, 0x80, 64, 0,
+		0x00, 0xFF, 0x2F, 0x00,
+	}, buf.Bytes()[22:])
+}
+
+func TestWrite_OutOfRange(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, []Note{{Number: -1, Duration: Quarter}, {Number: 128, Duration: Quarter}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x00, 0xFF, 0x2F, 0x00}, buf.Bytes()[22:])
+}
+
+func TestWriteTempo(t *testing.T) {
+	var buf bytes.Buffer
+	notes := []Note{{Number: 60, Velocity: 80, Start: 0, Duration: Quarter}}
+	assert.Nil(t, WriteTempo(&buf, notes, 80))
+	assert.Equal(t, []byte{0x00, 0xFF, 0x51, 0x03, 0x0B, 0x71, 0xB0}, buf.Bytes()[22:29], "750000 microseconds per quarter note")
+	read, err := Read(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, notes, read)
+	var plain, at bytes.Buffer
+	assert.Nil(t, Write(&plain, notes))
+	assert.Nil(t, WriteTempo(&at, notes, 0))
+	assert.Equal(t, plain.Bytes(), at.Bytes())
+}
+
+func TestWriteClip(t *testing.T) {
+	var buf bytes.Buffer
+	notes := []Note{{Number: 60, Velocity: 80, Start: 0, Duration: Quarter}, {Number: 64, Velocity: 80, Start: Quarter, Duration: Quarter}}
+	assert.Nil(t, WriteClip(&buf, "chorus", notes, 80))
+	assert.Equal(t, append([]byte{0x00, 0xFF, 0x03, 0x06}, "chorus"...), buf.Bytes()[22:32], "named chorus")
+	assert.Equal(t, []byte{0x00, 0xFF, 0x51, 0x03, 0x0B, 0x71, 0xB0}, buf.Bytes()[32:39], "750000 microseconds per quarter note")
+	read, err := Read(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, notes, read)
+	buf.Reset()
+	assert.Nil(t, WriteClip(&buf, "", notes, 0))
+	assert.Equal(t, []byte{0x00, 0xFF, 0x03, 0x00, 0x00, 0x90}, buf.Bytes()[22:28], "unnamed, at the default tempo")
+}
+
+func TestWrite_Error(t *testing.T) {
+	err := Write(failingWriter{}, []Note{{Number: 60, Duration: Quarter}})
+	assert.NotNil(t, err)
+}
+
+//
+// Private
+//
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("failed")
+}
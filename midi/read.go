@@ -0,0 +1,178 @@
+// Standard MIDI Files are read back into notes, from any number of tracks, at the resolution of this package
+package midi
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+)
+
+// ErrInvalidFile when reading something that isn't a Standard MIDI File, or is cut short
+var ErrInvalidFile = errors.New("invalid MIDI file")
+
+// Read the notes of a Standard MIDI File, of every track and channel, in order of their start then their number,
+// with their ticks scaled to the Resolution of this package, and any note left sounding at the end of its track ending there
+func Read(r io.Reader) ([]Note, error) {
+	return ReadContext(context.Background(), r)
+}
+
+// ReadContext of the notes of a Standard MIDI File, the same as Read, returning the error of the context as soon as it's done,
+// e.g. when a server cancels a request, or its deadline passes
+func ReadContext(ctx context.Context, r io.Reader) ([]Note, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	in, err := ioutil.ReadAll(bufio.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+	if len(in) < 14 || string(in[:4]) != "MThd" {
+		return nil, fmt.Errorf("%w, expected a header beginning MThd", ErrInvalidFile)
+	}
+	size := int(binary.BigEndian.Uint32(in[4:8]))
+	division := int(binary.BigEndian.Uint16(in[12:14]))
+	if size < 6 || division == 0 || division&0x8000 != 0 {
+		return nil, fmt.Errorf("%w, expected a division in ticks per quarter note", ErrInvalidFile)
+	}
+	var notes []Note
+	for pos := 8 + size; pos < len(in); {
+		if pos+8 > len(in) {
+			return nil, fmt.Errorf("%w, cut short in a chunk header", ErrInvalidFile)
+		}
+		kind, size := string(in[pos:pos+4]), int(binary.BigEndian.Uint32(in[pos+4:pos+8]))
+		pos += 8
+		if pos+size > len(in) {
+			return nil, fmt.Errorf("%w, cut short in a chunk of %d bytes", ErrInvalidFile, size)
+		}
+		if kind == "MTrk" {
+			track, err := readTrack(ctx, in[pos:pos+size], division)
+			if err != nil {
+				return nil, err
+			}
+			notes = append(notes, track...)
+		}
+		pos += size
+	}
+	sort.SliceStable(notes, func(i, j int) bool {
+		if notes[i].Start == notes[j].Start {
+			return notes[i].Number < notes[j].Number
+		}
+		return notes[i].Start < notes[j].Start
+	})
+	return notes, nil
+}
+
+//
+// Private
+//
+
+// eventsPerCheck of a track read between each check of whether its context is done
+const eventsPerCheck = 1024
+
+// sounding note, from the tick it began, at a velocity
+type sounding struct {
+	tick     int
+	velocity int
+}
+
+// readTrack of events, into notes from each note on to the note off of the same number on the same channel, unless the context is done
+func readTrack(ctx context.Context, data []byte, division int) ([]Note, error) {
+	var notes []Note
+	on := make(map[int][]sounding)
+	tick, pos := 0, 0
+	var status byte
+	end := func(channel, number int) {
+		key := channel<<8 | number
+		if len(on[key]) == 0 {
+			return
+		}
+		s := on[key][0]
+		on[key] = on[key][1:]
+		notes = append(notes, Note{Number: number, Velocity: s.velocity, Channel: channel, Start: s.tick * Resolution / division, Duration: (tick - s.tick) * Resolution / division})
+	}
+	for events := 0; pos < len(data); events++ {
+		if events%eventsPerCheck == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		delta, n := readVarLen(data[pos:])
+		if n == 0 {
+			return nil, fmt.Errorf("%w, cut short in a delta time", ErrInvalidFile)
+		}
+		tick, pos = tick+delta, pos+n
+		if pos >= len(data) {
+			return nil, fmt.Errorf("%w, cut short after a delta time", ErrInvalidFile)
+		}
+		if data[pos]&0x80 != 0 {
+			status = data[pos]
+			pos++
+		} else if status == 0 {
+			return nil, fmt.Errorf("%w, expected a status byte", ErrInvalidFile)
+		}
+		switch {
+		case status == 0xFF || status == 0xF0 || status == 0xF7:
+			if status == 0xFF {
+				pos++ // meta event type
+			}
+			length, n := readVarLen(data[min(pos, len(data)):])
+			if n == 0 || pos+n+length > len(data) {
+				return nil, fmt.Errorf("%w, cut short in a meta or system exclusive event", ErrInvalidFile)
+			}
+			pos += n + length
+			status = 0 // running status is cancelled
+		case status&0xF0 == 0xC0 || status&0xF0 == 0xD0:
+			pos++
+		default:
+			if pos+2 > len(data) {
+				return nil, fmt.Errorf("%w, cut short in a channel event", ErrInvalidFile)
+			}
+			channel, number, velocity := int(status&0x0F), int(data[pos]), int(data[pos+1])
+			switch {
+			case status&0xF0 == 0x90 && velocity > 0:
+				key := channel<<8 | number
+				on[key] = append(on[key], sounding{tick, velocity})
+			case status&0xF0 == 0x80 || status&0xF0 == 0x90:
+				end(channel, number)
+			}
+			pos += 2
+		}
+	}
+	var keys []int
+	for key := range on {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	for _, key := range keys {
+		for len(on[key]) > 0 {
+			end(key>>8, key&0xFF)
+		}
+	}
+	return notes, nil
+}
+
+// readVarLen quantity from the beginning of some data, and the number of bytes it took, or 0 if it's cut short
+func readVarLen(data []byte) (value int, n int) {
+	for n < len(data) && n < 4 {
+		b := data[n]
+		value = value<<7 | int(b&0x7F)
+		n++
+		if b&0x80 == 0 {
+			return value, n
+		}
+	}
+	return 0, 0
+}
+
+// min of two values
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
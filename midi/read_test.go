@@ -0,0 +1,70 @@
+// Standard MIDI Files are read back into notes, from any number of tracks, at the resolution of this package
+package midi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestRead(t *testing.T) {
+	notes := []Note{
+		{Number: 64, Velocity: DefaultVelocity, Start: Quarter, Duration: Quarter},
+		{Number: 60, Velocity: 100, Start: 0, Duration: Half},
+		{Number: 67, Velocity: 90, Channel: 9, Start: Half, Duration: Eighth},
+	}
+	var out bytes.Buffer
+	assert.Nil(t, Write(&out, notes))
+	read, err := Read(&out)
+	assert.Nil(t, err)
+	assert.Equal(t, []Note{notes[1], notes[0], notes[2]}, read)
+}
+
+func TestRead_Division(t *testing.T) {
+	// format 1, two tracks at 96 ticks per quarter note, using running status, a note on of velocity 0 as a note off, and a note never ended
+	file := []byte{
+		'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 1, 0, 2, 0, 96,
+		'M', 'T', 'r', 'k', 0, 0, 0, 11, 0x00, 0xFF, 0x51, 0x03, 0x07, 0xA1, 0x20, 0x00, 0xFF, 0x2F, 0x00,
+		'M', 'T', 'r', 'k', 0, 0, 0, 17, 0x00, 0x90, 60, 70, 0x60, 60, 0, 0x00, 62, 70, 0x00, 0xC0, 0x05, 0x30, 0xFF, 0x2F, 0x00,
+	}
+	read, err := Read(bytes.NewReader(file))
+	assert.Nil(t, err)
+	assert.Equal(t, []Note{
+		{Number: 60, Velocity: 70, Start: 0, Duration: Quarter},
+		{Number: 62, Velocity: 70, Start: Quarter, Duration: Eighth},
+	}, read)
+}
+
+func TestRead_Invalid(t *testing.T) {
+	for _, file := range [][]byte{
+		[]byte("RIFF"),
+		{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0xE7, 0x28},
+		{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 1, 0xE0, 'M', 'T', 'r', 'k', 0, 0, 0, 9, 0x00, 0x90, 60},
+		{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 1, 0xE0, 'M', 'T', 'r', 'k', 0, 0, 0, 3, 0x00, 0x90, 60},
+		{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 1, 0xE0, 'M', 'T', 'r', 'k', 0, 0, 0, 2, 0x00, 60},
+	} {
+		_, err := Read(bytes.NewReader(file))
+		assert.True(t, errors.Is(err, ErrInvalidFile), string(file))
+	}
+}
+
+func TestReadContext(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, Write(&out, []Note{{Number: 60, Duration: Quarter}}))
+	file := out.Bytes()
+	read, err := ReadContext(context.Background(), bytes.NewReader(file))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(read))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ReadContext(ctx, bytes.NewReader(file))
+	assert.True(t, errors.Is(err, context.Canceled))
+	ctx, cancel = context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	_, err = ReadContext(ctx, bytes.NewReader(file))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
@@ -0,0 +1,73 @@
+// Tracks of notes are written together as a Standard MIDI File of format 1, each named, on its own channel and played by its own instrument, e.g. chords on a piano over a bass
+package midi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Programs of General MIDI, numbered from 1, to play a track by, e.g. of the chords and bass of a progression
+const (
+	AcousticGrandPiano = 1
+	ElectricPiano      = 5
+	Organ              = 17
+	AcousticGuitar     = 25
+	AcousticBass       = 33
+	ElectricBass       = 34
+	StringEnsemble     = 49
+	Pad                = 89
+)
+
+// Errors of a track on a channel, or played by a program, that doesn't exist
+var (
+	ErrChannelRange = errors.New("channel out of range")
+	ErrProgramRange = errors.New("program out of range")
+)
+
+// Track of a Standard MIDI File of format 1, named, e.g. "bass", its notes all on one channel, played by a program
+type Track struct {
+	Name    string
+	Channel int // from 0 to 15, of every note of the track
+	Program int // of General MIDI, from 1 of AcousticGrandPiano to 128, or 0 to leave it to the synthesizer
+	Notes   []Note
+}
+
+// Validate the channel and program of the Track
+func (t Track) Validate() error {
+	if t.Channel < 0 || t.Channel > 15 {
+		return fmt.Errorf("%w: %d of track %q, expected 0 to 15", ErrChannelRange, t.Channel, t.Name)
+	}
+	if t.Program < 0 || t.Program > 128 {
+		return fmt.Errorf("%w: %d of track %q, expected 1 to 128, or 0 for none", ErrProgramRange, t.Program, t.Name)
+	}
+	return nil
+}
+
+// WriteTracks of a Standard MIDI File of format 1, a first track of the tempo, in quarter notes per minute, or the default Tempo if it isn't above 0,
+// then each track, named, with a change to its program, if any, before its notes, or an error if any track is invalid
+func WriteTracks(w io.Writer, bpm float64, tracks ...Track) error {
+	for _, t := range tracks {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	var file bytes.Buffer
+	writeHeader(&file, 1, len(tracks)+1)
+	writeTrack(&file, nil, tempoEvent(bpm))
+	for _, t := range tracks {
+		first := nameEvent(t.Name)
+		if t.Program > 0 {
+			first = append(first, 0x00, 0xC0|byte(t.Channel), byte(t.Program-1))
+		}
+		notes := make([]Note, len(t.Notes))
+		for n, note := range t.Notes {
+			note.Channel = t.Channel
+			notes[n] = note
+		}
+		writeTrack(&file, notes, first)
+	}
+	_, err := file.WriteTo(w)
+	return err
+}
@@ -0,0 +1,52 @@
+// Tracks of notes are written together as a Standard MIDI File of format 1, each named, on its own channel and played by its own instrument, e.g. chords on a piano over a bass
+package midi
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestWriteTracks(t *testing.T) {
+	chords := Track{Name: "chords", Channel: 0, Program: AcousticGrandPiano, Notes: []Note{
+		{Number: 60, Velocity: DefaultVelocity, Start: 0, Duration: Whole},
+		{Number: 64, Velocity: DefaultVelocity, Start: 0, Duration: Whole},
+	}}
+	bass := Track{Name: "bass", Channel: 1, Program: AcousticBass, Notes: []Note{
+		{Number: 36, Velocity: DefaultVelocity, Channel: 5, Start: 0, Duration: Whole},
+	}}
+	var buf bytes.Buffer
+	assert.Nil(t, WriteTracks(&buf, 90, chords, bass))
+	b := buf.Bytes()
+	assert.Equal(t, []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 1, 0, 3, 0x01, 0xE0}, b[:14], "format 1 of 3 tracks")
+	assert.Equal(t, []byte{'M', 'T', 'r', 'k', 0, 0, 0, 11, 0x00, 0xFF, 0x51, 0x03}, b[14:26], "a first track of the tempo")
+	assert.Contains(t, buf.String(), "chords\x00\xC0\x00")
+	assert.Contains(t, buf.String(), "bass\x00\xC1\x20")
+	read, err := Read(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, []Note{
+		{Number: 36, Velocity: DefaultVelocity, Channel: 1, Start: 0, Duration: Whole}, // on the channel of its track
+		chords.Notes[0],
+		chords.Notes[1],
+	}, read)
+}
+
+func TestWriteTracks_NoProgram(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, WriteTracks(&buf, 0, Track{Name: "pad", Channel: 2}))
+	assert.NotContains(t, buf.String(), "\xC2")
+	assert.Equal(t, []byte{'M', 'T', 'r', 'k', 0, 0, 0, 4, 0x00, 0xFF, 0x2F, 0x00}, buf.Bytes()[14:26], "no tempo, of the default")
+}
+
+func TestTrack_Validate(t *testing.T) {
+	assert.Nil(t, Track{Channel: 15, Program: 128}.Validate())
+	err := Track{Name: "lead", Channel: 16}.Validate()
+	assert.True(t, errors.Is(err, ErrChannelRange))
+	assert.Equal(t, "channel out of range: 16 of track \"lead\", expected 0 to 15", err.Error())
+	err = Track{Name: "lead", Program: 129}.Validate()
+	assert.True(t, errors.Is(err, ErrProgramRange))
+	assert.Equal(t, "program out of range: 129 of track \"lead\", expected 1 to 128, or 0 for none", err.Error())
+	assert.True(t, errors.Is(WriteTracks(&bytes.Buffer{}, 0, Track{Channel: -1}), ErrChannelRange))
+}
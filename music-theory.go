@@ -10,12 +10,11 @@
 //
 //     $ music-theory chord "Cm nondominant -5 679"
 //
-//     root: C
-//     tones:
-//       3: D#
-//       6: A
-//       7: A#
-//       9: D
+//     TONE  NOTE  INTERVAL  FREQUENCY
+//     3     Eb    m3        311.13Hz
+//     6     A     M6        440.00Hz
+//     7     Bb    m7        466.16Hz
+//     9     D     9         587.33Hz
 //
 // List known chord-building rules
 //
@@ -28,12 +27,15 @@
 //     - Augmented Triad
 //     - Diminished Triad
 //     - Suspended Triad
+//     - Suspended Second
+//     - Power Chord
 //     - Omit Fifth
 //     - Flat Fifth
 //     - Add Sixth
 //     - Augmented Sixth
 //     - Omit Sixth
 //     - Add Seventh
+//     - Flat Seventh
 //     - Dominant Seventh
 //     - Major Seventh
 //     - Minor Seventh
@@ -59,19 +61,50 @@
 //     - Dominant Thirteenth
 //     - Major Thirteenth
 //     - Minor Thirteenth
+//     - Flat Ninth
+//     - Sharp Eleventh
+//     - Flat Thirteenth
+//
+// Tell about a chord-building rule, by its name or an alias, its aliases, a description and its typical usages
+//
+//     $ music-theory chords --about m7b5
+//
+//     name: Half Diminished Seventh
+//     aliases:
+//     - half diminished
+//     - m7b5
+//     description: A diminished triad with a minor seventh, a minor seventh with its fifth
+//       lowered
+//     usages:
+//     - the ii of a minor ii-V-i, e.g. Bm7b5 E7 Am
+//     - the vii of a major key
+//
+// Arpeggiate a Chord, in a pattern of up, down, updown, converge or random, over some octaves
+//
+//     $ music-theory arpeggio "Cmaj7" --pattern updown
+//
+//     STEP  NOTE  TONE  INTERVAL  FREQUENCY
+//     1     C4    1     P1        261.63Hz
+//     2     E4    3     M3        329.63Hz
+//     3     G4    5     P5        392.00Hz
+//     4     B4    7     M7        493.88Hz
+//     5     G4    5     P5        392.00Hz
+//     6     E4    3     M3        329.63Hz
 //
 // Determine a Scale
 //
 //     $ music-theory scale "C aug"
 //
-//     root: C
-//     tones:
-//     1: C
-//     2: D#
-//     3: E
-//     4: G
-//     5: G#
-//     6: B
+//     TONE  NOTE  INTERVAL  FREQUENCY
+//     1     C     P1        261.63Hz
+//     2     D#    A2        311.13Hz
+//     3     E     M3        329.63Hz
+//     4     G     P5        392.00Hz
+//     5     G#    A5        415.30Hz
+//     6     B     M7        493.88Hz
+//
+//     STEPS    WH H WH H WH H
+//     FORMULA  1 #2 3 5 #5 7
 //
 // List known scale-building rules
 //
@@ -94,15 +127,231 @@
 //     - Aeolian
 //     - Locrian
 //
+// Tell about a scale-building rule, by its name or an alias, the parent scale it's a mode of, its characteristic degrees, typical genres and notes on it
+//
+//     $ music-theory scales --about dorian
+//
+//     name: Dorian
+//     aliases:
+//     - dor
+//     parent: Major
+//     degree: 2
+//     characteristic:
+//     - b3
+//     - "6"
+//     genres:
+//     - jazz
+//     - funk
+//     - folk
+//     - rock
+//     notes: A minor mode brightened by its major 6th, e.g. of So What and Scarborough Fair;
+//       Kafi thaat of Hindustani music, Kharaharapriya of Carnatic music; the Dorian of
+//       ancient Greece was instead the Phrygian of today
+//
+// Compare two scales, by the tones they share and those only one has, and the pivot chords diatonic to both
+//
+//     $ music-theory compare-scales "C major" "A harmonic minor"
+//
+//     shared                    C D E F A B
+//     only in C major           G
+//     only in A harmonic minor  Ab
+//
+//     PIVOT  DEGREE IN C major  DEGREE IN A harmonic minor
+//     Dm     2                  4
+//     Dm7    2                  4
+//     F      4                  6
+//     FM7    4                  6
+//     Am     6                  1
+//     Bdim   7                  2
+//     Bm7b5  7                  2
+//
+// Compare two progressions, by their similarity and the alignment of their chords, transposing the second to be the most similar
+//
+//     $ music-theory compare-progressions --transpose "Dm7 G7 C" "Em7 A7 D"
+//
+// List the classic progressions built in, of a genre, by their numerals and the chords of them in a key
+//
+//     $ music-theory progressions --genre jazz
+//
+//     NAME                  GENRE  ERA    KEY      NUMERALS               CHORDS
+//     ii-V-I                jazz   1930s  C major  ii7 V7 Imaj7           Dm7 G7 CM7
+//     minor ii-V-i          jazz   1930s  A minor  iiø7 V7 i7             Bm7b5 E7 Am7
+//     I-vi-ii-V turnaround  jazz   1930s  C major  Imaj7 vi7 ii7 V7       CM7 Am7 Dm7 G7
+//     iii-vi-ii-V-I         jazz   1940s  C major  iii7 vi7 ii7 V7 Imaj7  Em7 Am7 Dm7 G7 CM7
+//     backdoor ii-V         jazz   1940s  C major  iv7 bVII7 Imaj7        Fm7 Bb7 CM7
+//
+// Search for the chords and scales containing some notes, or exactly or only some of them, scored by how many of their tones match
+//
+//     $ music-theory search --notes "C E G Bb D" --limit 2
+//
+//     KIND   NAME          SCORE  MISSING  EXTRA
+//     chord  C9            100%   -        -
+//     chord  FM13          71%    -        F A
+//     scale  C mixolydian  71%    -        F A
+//     scale  D minor       71%    -        F A
+//
 // Determine a key
 //
 //    $ music-theory key Db
 //
-//    root: Db
-//    mode: Major
-//    relative:
-//      root: Bb
-//      mode: Minor
+//    ROOT  MODE   RELATIVE  PARALLEL
+//    Db    Major  Bb Minor  C# Minor
+//
+//    RELATED   DISTANCE
+//    Bb Minor  0
+//    Ab Major  +1
+//    F Minor   +1
+//    Gb Major  -1
+//    Eb Minor  -1
+//
+// With its key signature, the tones of its scale, and the diatonic triad and seventh chord on each degree
+//
+//    $ music-theory key --full Db
+//
+// Render in another format than the default table, e.g. yaml, json, lilypond, musicxml, svg, abc, midi, a staff in plain text, braille music, or the code of sonicpi or sc (SuperCollider)
+//
+//    $ music-theory chord --format lilypond Cm7
+//
+//    \version "2.18.2"
+//    {
+//      <c' es' g' bes'>1
+//    }
+//
+//    $ music-theory chord --format sonicpi Cm7
+//
+//    use_bpm 120
+//    play_chord [:c4, :eb4, :g4, :bb4], sustain: 4
+//
+//    $ music-theory key --format staff Eb
+//
+//    $ music-theory chord --format braille Cm7
+//
+//    ⠣⠐⠾⠬⠣⠔⠒⠣⠅
+//
+// Spell notes with sharps or flats in every format, or following the key of each value, also set by the environment, e.g. MUSIC_THEORY_SPELLING=flats,
+// respelling a note enharmonically whatever its interval, e.g. the major third C# of A7 as Db
+//
+//    $ music-theory chord --spelling flats A7
+//
+//    TONE  NOTE  INTERVAL  FREQUENCY
+//    1     A     P1        440.00Hz
+//    3     Db    M3        554.37Hz
+//    5     E     P5        659.26Hz
+//    7     G     m7        783.99Hz
+//
+// Write accidentals as the glyphs of Unicode, as YAML, JSON, a table or a diagram, or in ASCII by default, also set by the environment, e.g. MUSIC_THEORY_ACCIDENTALS=unicode
+//
+//    $ music-theory chord --accidentals unicode Bb7
+//
+// Draw a diagram of a chord or scale, on a keyboard, staff, fretboard or the circle of fifths, as SVG, or PNG if the file is named .png
+//
+//    $ music-theory chord "Cm7" --diagram keyboard --out cm7.svg
+//
+//    $ music-theory chord "C" --diagram fretboard --instrument ukulele --out c.svg
+//
+// Suggest a capo for a key, to play it in the open chord shapes of another key on a guitar
+//
+//    $ music-theory capo Eb --shapes open
+//
+//    CAPO  SHAPES IN  DIFFICULTY  CHORDS
+//    1     D major    4.5         Eb (D)  Fm (Em)  Ab (G)  Bb (A)
+//    6     A major    5.3         Eb (A)  Ab (D)  Bb (E)
+//    3     C major    6.0         Eb (C)  Fm (Dm)  Gm (Em)  Bb (G)  Cm (Am)
+//
+//    $ music-theory capo Eb --max-difficulty 6
+//
+//    CAPO  SHAPES IN  DIFFICULTY  CHORDS
+//    6     A major    4.5         Eb (A)  Bb (E)
+//
+// Practice a scale in a pattern, e.g. in thirds, written as ABC notation with the finger suggested for each note, or as MIDI
+//
+//    $ music-theory practice "G major" --pattern thirds --tempo 80 --midi thirds.mid
+//
+// Generate an etude to sight-read, a random melody in a key, at a level of difficulty from 1 to 5, as ABC, MusicXML or LilyPond to print
+//
+//    $ music-theory etude --level 3 --seed 42 --format musicxml D > etude.musicxml
+//
+// Guide a solo over a progression, by the tones to target on each chord, their approach notes, and the scales to choose from, as a table or JSON
+//
+//    $ music-theory guide "Dm7 G7 Cmaj7"
+//
+//    KEY  C Major
+//
+//    BEAT  CHORD  TARGETS  DEGREES  BELOW  ABOVE  SCALES
+//    1     Dm7    F C      b3 b7    E B    G D    D dorian, D minor, D phrygian
+//    5     G7     B F      3 b7     A# E   C G    G mixolydian
+//    9     CM7    E B      3 7      D# A#  F C    C major, C lydian, C augmented
+//
+// Calculate a polyrhythm of two pulses against each other, shown as a grid of each and their composite, with the time of each pulse, and write its click track as MIDI
+//
+//    $ music-theory polyrhythm 3:4 --bpm 100 --midi click.mid
+//
+//    3    x..|.x.|..x|...
+//    4    x..|x..|x..|x..
+//    3:4  X..|xx.|x.x|x..
+//
+// Click a metronome of a meter, accenting the first beat of each group, written as MIDI or WAV to practice an odd meter
+//
+//    $ music-theory click 7/8 --bpm 140 --bars 16 --accent 3+2+2 --wav click.wav
+//
+//    3+2+2/8  Xxx|Xx|Xx
+//    16 bars at 140 bpm, 48s
+//
+// Generate a Euclidean rhythm of some pulses spread evenly over some steps, played by an instrument of the drum kit, and write it as MIDI
+//
+//    $ music-theory euclidean 3 8 --instrument rim --midi tresillo.mid
+//
+//    rim  x..x|..x.
+//
+// Export a song from a YAML file as CSV of its chords, or as a MIDI clip of each section, to drop into a DAW
+//
+//    $ music-theory export autumn-leaves.yaml
+//
+//    bar,beat,chord,notes
+//    1,1,Am7,A C E G
+//    1,3,D7,D F# A C
+//
+// Import a score of MusicXML, e.g. from notation software, as the YAML of a song of its key, meter, tempo and chord symbols
+//
+//    $ music-theory export --yaml blues.musicxml
+//
+// Take an ear-training quiz of intervals, chords or scales, answering each question by name
+//
+//    $ music-theory quiz intervals --count 3
+//
+//    1. Name the interval from F4 up to A4: M3
+//    Correct!
+//    2. Name the interval from B4 up to B5: P5
+//    Incorrect, it's P8, as in Somewhere Over the Rainbow
+//    3. Name the interval from Db4 up to Ab4: perfect fifth
+//    Correct!
+//    Score: 2/3 (66%)
+//
+// Listen to a MIDI instrument, naming the chord of the notes held and estimating the key of the chords played so far, each time they change
+//
+//    $ music-theory listen --midi-port /dev/snd/midiC1D0
+//
+//    Listening to /dev/snd/midiC1D0
+//    E3                       -            -
+//    E3 C4                    -            -
+//    E3 C4 G4                 C/E          C major
+//
+// Open a port by its number with the RtMidi library, on any platform it supports, by building with `go build -tags rtmidi`
+//
+// Send the analysis as OSC messages, e.g. to a patch of Max/MSP, SuperCollider or TouchDesigner
+//
+//    $ music-theory listen --midi-port /dev/snd/midiC1D0 --osc localhost:57120
+//
+// Serve an HTTP API
+//
+//    $ music-theory serve --port 8080
+//
+//    $ curl localhost:8080/chord/Cm7
+//    {"root":"C","tones":{"1":"C","3":"Eb","5":"G","7":"Bb"}}
+//
+// Serve the gRPC MusicTheory service of proto/music_theory.proto instead
+//
+//    $ music-theory serve --grpc --port 9090
 //
 // Credit
 //
@@ -118,13 +367,32 @@ package main
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"gopkg.in/urfave/cli.v1"
 
+	"github.com/go-music-theory/music-theory/bassline"
 	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/chordtrack"
+	"github.com/go-music-theory/music-theory/diagram"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/etude"
+	"github.com/go-music-theory/music-theory/exercise"
+	"github.com/go-music-theory/music-theory/fretboard"
+	"github.com/go-music-theory/music-theory/groove"
+	"github.com/go-music-theory/music-theory/harmonize"
+	"github.com/go-music-theory/music-theory/humanize"
 	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/quiz"
+	"github.com/go-music-theory/music-theory/render"
 	"github.com/go-music-theory/music-theory/scale"
-	"github.com/jahway603/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/server"
+	"github.com/go-music-theory/music-theory/symbol"
 )
 
 func main() {
@@ -144,6 +412,7 @@ func app() *cli.App {
 	app.Authors = []cli.Author{
 		{Name: "Charney Kaye", Email: "hi@charneykaye.com"},
 	}
+	app.Before = loadConfig
 	app.Commands = commands
 	return app
 }
@@ -155,10 +424,50 @@ var commands = []cli.Command{
 		Aliases:     []string{"c"},
 		Usage:       "build a Chord",
 		Description: "Chord is a named harmonic set of three or more pitch classes specified by a name, e.g. C or Cm6 or D♭m679-5",
-		Action: func(c *cli.Context) {
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "strict", Usage: "Reject a name unless every part of it is unambiguously matched by some chord form"},
+			cli.IntFlag{Name: "inversion, i", Usage: "Put the nth tone of the chord in the bass, e.g. 1 for the first inversion, as a slash chord would, e.g. C/E"},
+			formatFlag,
+			schemaFlag,
+			colorFlag,
+			accidentalsFlag,
+			spellingFlag,
+			dynamicsFlag,
+			cli.BoolFlag{Name: "explain", Usage: "Explain which chord forms matched which parts of the name, and the tones each added or omitted"},
+			diagramFlag,
+			instrumentFlag,
+			outFlag,
+		},
+		Action: func(c *cli.Context) error {
 			name := c.Args().First()
-			if len(name) > 0 {
-				fmt.Fprintf(c.App.Writer, "%s", chord.Of(name).ToYAML())
+			if len(name) > 0 && c.Bool("explain") {
+				fmt.Fprintf(c.App.Writer, "%s", chord.Explain(name).ToYAML())
+			} else if len(name) > 0 {
+				var options []chord.Option
+				if c.Bool("strict") {
+					options = append(options, chord.WithStrict())
+				}
+				v, err := chord.Parse(name, options...)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				if n := c.Int("inversion"); n != 0 {
+					v = v.Inversion(n)
+				}
+				if kind := c.String("diagram"); len(kind) > 0 {
+					var spelling render.Spelling
+					var accidentals symbol.Accidentals
+					if spelling, err = spellingOf(c); err == nil {
+						if accidentals, err = accidentalsOf(c); err == nil {
+							err = writeDiagram(c.App.Writer, kind, c.String("instrument"), diagram.OfChord(render.Spelled(v, spelling).(chord.Chord)), c.String("out"), diagram.WithAccidentals(accidentals))
+						}
+					}
+				} else {
+					err = renderTo(c, v)
+				}
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
 			} else {
 				// no arguments
 				err := cli.ShowCommandHelp(c, "chord")
@@ -166,15 +475,76 @@ var commands = []cli.Command{
 					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
 				}
 			}
+			return nil
 		},
 	},
 
 	{ // List all Chords
 		Name:        "chords",
 		Usage:       "list all known Chords",
-		Description: "The Chord DNA is this software is a sequential chain of rules to be executed by matching text in the chord name to its musical implications from the root of the chord.",
-		Action: func(c *cli.Context) {
-			fmt.Fprintf(c.App.Writer, "%s", chord.ChordFormList.ToYAML())
+		Description: "The Chord DNA is this software is a sequential chain of rules to be executed by matching text in the chord name to its musical implications from the root of the chord. Tell --about one of them, by its name or an alias, its aliases, a description and its typical usages, e.g. chords --about \"Half Diminished Seventh\" or chords --about m7b5",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "about", Usage: "Tell about a form, by its name or an alias, e.g. \"Half Diminished Seventh\", half-diminished or m7b5"},
+		},
+		Action: func(c *cli.Context) error {
+			if !c.IsSet("about") {
+				fmt.Fprintf(c.App.Writer, "%s", chord.FormNames().ToYAML())
+				return nil
+			}
+			info, err := chord.FormInfo(c.String("about"))
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+			}
+			fmt.Fprintf(c.App.Writer, "%s", info.ToYAML())
+			return nil
+		},
+	},
+
+	{ // Arpeggiate a Chord
+		Name:        "arpeggio",
+		Aliases:     []string{"a"},
+		Usage:       "arpeggiate a Chord",
+		Description: "Arpeggio is a chord whose tones are played one after another, in a pattern, e.g. up, down, updown, converge or random, over some octaves from middle C",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "pattern", Value: "up", Usage: "Set the pattern, one of " + strings.Join(chord.PatternNames, ", ")},
+			cli.IntFlag{Name: "octaves", Value: 1, Usage: "Set the number of octaves"},
+			cli.Int64Flag{Name: "seed", Usage: "Set the seed of the random pattern, so the same seed always gives the same order (default: the current time)"},
+			formatFlag,
+			colorFlag,
+			accidentalsFlag,
+			spellingFlag,
+			dynamicsFlag,
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if len(name) > 0 {
+				seed := c.Int64("seed")
+				if !c.IsSet("seed") {
+					seed = time.Now().UnixNano()
+				}
+				pattern, err := chord.PatternNamed(c.String("pattern"), seed)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				if err = atLeastOne("octaves", c.Int("octaves")); err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				v, err := chord.Parse(name)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				err = renderTo(c, chord.Arpeggiate(v, pattern, c.Int("octaves")))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "arpeggio")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
 		},
 	},
 
@@ -183,10 +553,43 @@ var commands = []cli.Command{
 		Aliases:     []string{"c"},
 		Usage:       "build a Scale",
 		Description: "Scale is any set of musical notes ordered by fundamental frequency or pitch specified by a name, e.g. C or Cm6 or D♭m679-5",
-		Action: func(c *cli.Context) {
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "strict", Usage: "Reject a name unless every part of it is unambiguously matched by some scale mode"},
+			formatFlag,
+			schemaFlag,
+			colorFlag,
+			accidentalsFlag,
+			spellingFlag,
+			dynamicsFlag,
+			diagramFlag,
+			instrumentFlag,
+			outFlag,
+		},
+		Action: func(c *cli.Context) error {
 			name := c.Args().First()
 			if len(name) > 0 {
-				fmt.Fprintf(c.App.Writer, "%s", scale.Of(name).ToYAML())
+				var options []scale.Option
+				if c.Bool("strict") {
+					options = append(options, scale.WithStrict())
+				}
+				v, err := scale.Parse(name, options...)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				if kind := c.String("diagram"); len(kind) > 0 {
+					var spelling render.Spelling
+					var accidentals symbol.Accidentals
+					if spelling, err = spellingOf(c); err == nil {
+						if accidentals, err = accidentalsOf(c); err == nil {
+							err = writeDiagram(c.App.Writer, kind, c.String("instrument"), diagram.OfScale(render.Spelled(v, spelling).(scale.Scale)), c.String("out"), diagram.WithAccidentals(accidentals))
+						}
+					}
+				} else {
+					err = renderTo(c, v)
+				}
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
 			} else {
 				// no arguments
 				err := cli.ShowCommandHelp(c, "scale")
@@ -194,15 +597,117 @@ var commands = []cli.Command{
 					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
 				}
 			}
+			return nil
 		},
 	},
 
 	{ // List all Scales
 		Name:        "scales",
 		Usage:       "list all known Scales",
-		Description: "The Scale DNA is this software is a sequential chain of rules to be executed by matching text in the scale name to its musical implications from the root of the scale.",
-		Action: func(c *cli.Context) {
-			fmt.Fprintf(c.App.Writer, "%s", scale.ScaleModeList.ToYAML())
+		Description: "The Scale DNA is this software is a sequential chain of rules to be executed by matching text in the scale name to its musical implications from the root of the scale. Tell --about one of them, by its name or an alias, its aliases, the parent scale it's a mode of, its characteristic degrees, typical genres and notes on it, e.g. scales --about dorian or scales --about \"jazz minor\"",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "about", Usage: "Tell about a mode, by its name or an alias, e.g. Dorian, \"Melodic Minor Ascend\" or \"jazz minor\""},
+		},
+		Action: func(c *cli.Context) error {
+			if !c.IsSet("about") {
+				fmt.Fprintf(c.App.Writer, "%s", scale.ModeNames().ToYAML())
+				return nil
+			}
+			info, err := scale.ModeInfo(c.String("about"))
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+			}
+			fmt.Fprintf(c.App.Writer, "%s", info.ToYAML())
+			return nil
+		},
+	},
+
+	{ // Compare Scales
+		Name:        "compare-scales",
+		Usage:       "Compare two Scales, by their shared tones and pivot chords",
+		Description: "Compare two scales, or the scales of two keys, listing the tones they share and those only one has, and the pivot chords diatonic to both, by the degree of each in either scale, e.g. to plan a modulation, e.g. compare-scales \"C major\" \"A harmonic minor\"",
+		Action: func(c *cli.Context) error {
+			if c.NArg() == 2 {
+				err := compareScales(c.App.Writer, c.Args().Get(0), c.Args().Get(1))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// not two scales
+				err := cli.ShowCommandHelp(c, "compare-scales")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+
+	{ // Compare Progressions
+		Name:        "compare-progressions",
+		Usage:       "Compare two Progressions, by their similarity and the alignment of their chords",
+		Description: "Compare two progressions, each of chord names separated by spaces, by their similarity from 0 to 100%, of the fewest insertions, deletions and substitutions of chords that turn one into the other, each substitution costing the share of tones the chords don't have in common, and list their alignment, e.g. compare-progressions \"C Am F G\" \"C F G\"",
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "transpose, t", Usage: "Transpose the second progression to be the most similar to the first, e.g. of a cover in another key"},
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() == 2 {
+				err := compareProgressions(c.App.Writer, c.Args().Get(0), c.Args().Get(1), c.Bool("transpose"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// not two progressions
+				err := cli.ShowCommandHelp(c, "compare-progressions")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+
+	{ // List Progressions
+		Name:        "progressions",
+		Usage:       "list the classic Progressions built in, of a genre",
+		Description: "List the classic progressions built in, of a --genre, one of " + strings.Join(progression.Genres(), ", ") + ", or else of every genre, each by its name, genre, era and key, and its numerals and the chords of them in that key, e.g. progressions --genre jazz",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "genre, g", Usage: "Set the genre of the progressions to list, one of " + strings.Join(progression.Genres(), ", ") + " (default: every genre)"},
+		},
+		Action: func(c *cli.Context) error {
+			err := listProgressions(c.App.Writer, c.String("genre"))
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+			}
+			return nil
+		},
+	},
+
+	{ // Search Chords and Scales
+		Name:        "search",
+		Usage:       "Search for the chords and scales containing some notes",
+		Description: "Search the common chords and scales on every root for those containing some notes, or exactly or only some of them, each scored by how many of its tones match, e.g. search --notes \"C E G Bb D\"",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "notes, n", Usage: "Set the notes to search for, separated by spaces, e.g. \"C E G Bb D\""},
+			cli.BoolFlag{Name: "exact", Usage: "Find only the chords and scales of exactly the notes"},
+			cli.BoolFlag{Name: "partial", Usage: "Also find the chords and scales of only some of the notes"},
+			cli.IntFlag{Name: "limit, l", Value: 10, Usage: "Set the most chords, and the most scales, to list, or 0 for all"},
+		},
+		Action: func(c *cli.Context) error {
+			notes := c.String("notes")
+			if len(notes) > 0 {
+				err := searchNotes(c.App.Writer, notes, c.Bool("exact"), c.Bool("partial"), c.Int("limit"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no notes
+				err := cli.ShowCommandHelp(c, "search")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
 		},
 	},
 
@@ -211,10 +716,26 @@ var commands = []cli.Command{
 		Aliases:     []string{"k"},
 		Usage:       "find a Key",
 		Description: "The key of a piece is a group of pitches, or scale upon which a music composition is created in classical, Western art, and Western pop music.",
-		Action: func(c *cli.Context) {
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "full", Usage: "List the key signature, the tones of the scale, and the diatonic triad and seventh chord on each degree"},
+			formatFlag,
+			schemaFlag,
+			colorFlag,
+			accidentalsFlag,
+			spellingFlag,
+			dynamicsFlag,
+		},
+		Action: func(c *cli.Context) error {
 			name := c.Args().First()
 			if len(name) > 0 {
-				fmt.Fprintf(c.App.Writer, "%s", key.Of(name).ToYAML())
+				v, err := key.Parse(name)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				err = renderTo(c, v)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
 			} else {
 				// no arguments
 				err := cli.ShowCommandHelp(c, "key")
@@ -222,6 +743,7 @@ var commands = []cli.Command{
 					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
 				}
 			}
+			return nil
 		},
 	},
 
@@ -229,24 +751,25 @@ var commands = []cli.Command{
 		Name:        "pitch",
 		Aliases:     []string{"p"},
 		Usage:       "find a note pitch in Hz",
-		Description: "The pitch is note frequency described in Hz. Based on standard concert pitch and twelve-tone equal temperament. As an argument, pass a note in international pitch notation.",
+		Description: "The pitch is note frequency described in Hz. Based on standard concert pitch and twelve-tone equal temperament. As an argument, pass a note in international pitch notation, with any quarter-tone accidental, e.g. \"E𝄳4\" or \"D half-flat\" 4.",
 		Flags: []cli.Flag{
-			cli.IntFlag{Name: "tuning, t", Value: 440, Usage: "Set the pitch of the root note A 4"},
+			cli.StringFlag{Name: "tuning, t", Value: "440", Usage: "Set the pitch of the root note A 4 in Hz, e.g. 432 or 415.3, or a preset: " + strings.Join(pitch.TuningNames(), ", ")},
+			cli.IntFlag{Name: "precision", Value: pitch.DefaultPrecision, Usage: "Set the number of decimal places of the pitch, from 0 to 10"},
 		},
-		Action: func(c *cli.Context) {
+		Action: func(c *cli.Context) error {
 			name := c.Args().First()
 			octave := c.Args().Get(1)
-			tuning := c.Int("tuning")
 			if len(name) > 0 {
-				var notePitch string
-				var err error
+				tuning, err := pitch.TuningOf(c.String("tuning"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
 				if len(octave) > 0 {
-					notePitch, err = pitch.OfClassAndOctave(name, octave, tuning)
-				} else {
-					notePitch, err = pitch.OfNote(name, tuning)
+					name = strings.TrimSpace(name) + octave
 				}
+				notePitch, err := pitch.OfNoteWithPrecision(name, tuning, c.Int("precision"))
 				if err != nil {
-					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
 				}
 				fmt.Fprintf(c.App.Writer, "%v\n", notePitch)
 			} else {
@@ -256,6 +779,680 @@ var commands = []cli.Command{
 					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
 				}
 			}
+			return nil
+		},
+	},
+	{ // List the Frequencies of a Scale or Chord
+		Name:        "freqs",
+		Usage:       "list the frequencies of a scale or chord in Hz",
+		Description: "Frequency table of every tone of a scale across a range of octaves, e.g. freqs \"A minor\" --octave 3..5, with its MIDI note number and pitch in Hz, or of a chord with --chord, e.g. freqs --chord Cmaj7.",
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "chord", Usage: "List the tones of a chord instead of a scale"},
+			cli.StringFlag{Name: "octave, o", Value: "4", Usage: "Set the range of octaves, e.g. 3..5, or a single octave"},
+			cli.StringFlag{Name: "tuning, t", Value: "440", Usage: "Set the pitch of the root note A 4 in Hz, e.g. 432 or 415.3, or a preset: " + strings.Join(pitch.TuningNames(), ", ")},
+			formatFlag,
+			colorFlag,
+			accidentalsFlag,
+			spellingFlag,
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if len(name) > 0 {
+				v, err := freqsOf(name, c.Bool("chord"), c.String("octave"), c.String("tuning"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				err = renderTo(c, v)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "freqs")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Play a Groove
+		Name:        "groove",
+		Usage:       "show a drum Groove, or write it as MIDI",
+		Description: "Groove is a drum pattern on a grid of steps, one of " + strings.Join(groove.Names(), ", ") + ", shown with a line for each instrument and a bar between each beat, with some swing, and humanized at random when written as MIDI, e.g. groove backbeat --swing 60 --humanize 10ms --midi backbeat.mid, or written as a pattern of the Hydrogen drum machine, e.g. groove bossa --hydrogen bossa.h2pattern",
+		Flags: []cli.Flag{
+			cli.IntFlag{Name: "swing", Usage: "Set the swing, in percent of each pair of steps taken by the first, from 50 (straight) to 75 (default: the pattern's own)"},
+			cli.IntFlag{Name: "bars", Value: 4, Usage: "Set the number of bars of MIDI"},
+			cli.StringFlag{Name: "midi", Usage: "Write the groove to a MIDI file at this path, on the drum channel"},
+			cli.DurationFlag{Name: "humanize", Usage: "Move each note of MIDI earlier or later at random, by up to this much time, e.g. 10ms"},
+			cli.IntFlag{Name: "jitter", Usage: "Make each note of MIDI softer or louder at random, by up to this much velocity, e.g. 8"},
+			cli.Int64Flag{Name: "seed", Usage: "Set the seed of the humanization, so the same seed always gives the same MIDI (default: the current time)"},
+			cli.StringFlag{Name: "dynamics", Usage: "Shape the dynamics of the MIDI, one of " + strings.Join(dynamics.LevelNames, ", ") + ", or two apart by .. of a crescendo or diminuendo, e.g. p..f (default: each accent louder than a hit)"},
+			cli.StringFlag{Name: "hydrogen", Usage: "Write a bar of the groove to a pattern file of the Hydrogen drum machine at this path, e.g. backbeat.h2pattern, on its " + groove.HydrogenKit + " drum kit"},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if len(name) > 0 {
+				p, err := groove.Named(name)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				if c.IsSet("swing") {
+					if p, err = p.Swung(c.Int("swing")); err != nil {
+						return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+					}
+				}
+				fmt.Fprint(c.App.Writer, p.String())
+				if path := c.String("midi"); len(path) > 0 {
+					seed := c.Int64("seed")
+					if !c.IsSet("seed") {
+						seed = time.Now().UnixNano()
+					}
+					phrase, err := dynamics.ParsePhrase(c.String("dynamics"))
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+					}
+					notes := humanize.Apply(phrase.Apply(p.Notes(c.Int("bars"))), c.Duration("humanize"), c.Int("jitter"), seed)
+					if err = writeGrooveFile(path, notes); err != nil {
+						return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+					}
+				}
+				if path := c.String("hydrogen"); len(path) > 0 {
+					if err = writeTuneFile(path, p.WriteHydrogen); err != nil {
+						return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+					}
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "groove")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Generate a Bass Line
+		Name:        "bassline",
+		Usage:       "generate a Bass Line of a progression, or write it as MIDI",
+		Description: "Generate a bass line of a progression, a chord every bar of 4/4, in a style, one of " + strings.Join(bassline.StyleNames, ", ") + ", shown with a bar between each chord, e.g. bassline --style root-fifth --midi bass.mid C Am F G",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "style, s", Value: "walking", Usage: "Set the style, one of " + strings.Join(bassline.StyleNames, ", ")},
+			cli.StringFlag{Name: "midi", Usage: "Write the bass line to a MIDI file at this path"},
+			cli.StringFlag{Name: "dynamics", Usage: "Shape the dynamics of the MIDI, one of " + strings.Join(dynamics.LevelNames, ", ") + ", or two apart by .. of a crescendo or diminuendo, e.g. p..f (default: each downbeat accented)"},
+		},
+		Action: func(c *cli.Context) error {
+			names := c.Args()
+			if len(names) > 0 {
+				l, err := generateBassline(names, c.String("style"), c.String("dynamics"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				fmt.Fprintln(c.App.Writer, l.String())
+				if path := c.String("midi"); len(path) > 0 {
+					if err = writeBasslineFile(path, l); err != nil {
+						return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+					}
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "bassline")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Generate a Chord Track
+		Name:        "chord-track",
+		Usage:       "generate a Chord Track of a progression, and a bass track, written as MIDI",
+		Description: "Generate a track of the chords of a progression, each on a channel, played by a program of General MIDI, held, strummed or arpeggiated, and humanized at random, over a bass track of the lowest note of each chord, e.g. the E of C/E, listed by channel and program, e.g. chord-track --program 25 --strum 20ms --bass --midi chords.mid C/E F G7 C",
+		Flags: []cli.Flag{
+			cli.IntFlag{Name: "program, p", Value: midi.AcousticGrandPiano, Usage: "Set the program of General MIDI to play the chords, from 1 to 128, e.g. 5 of an electric piano, or 0 to leave it to the synthesizer"},
+			cli.IntFlag{Name: "channel", Value: 1, Usage: "Set the channel of the chords, from 1 to 16"},
+			cli.Float64Flag{Name: "beats, b", Value: 4, Usage: "Set the beats of each chord, e.g. 2 of two chords every bar of 4/4"},
+			cli.Float64Flag{Name: "tempo, t", Usage: "Set the tempo, in beats per minute (default: 120)"},
+			cli.DurationFlag{Name: "strum", Usage: "Strum each chord up, each tone beginning this much time after the one below it, e.g. 20ms"},
+			cli.StringFlag{Name: "arpeggio", Usage: "Arpeggiate each chord in eighth notes, in a pattern, one of " + strings.Join(chord.PatternNames, ", ")},
+			cli.BoolFlag{Name: "bass", Usage: "Add a bass track of the lowest note of each chord, leaving the bass of each slash chord out of the chords"},
+			cli.IntFlag{Name: "bass-channel", Value: 2, Usage: "Set the channel of the bass track, from 1 to 16"},
+			cli.IntFlag{Name: "bass-program", Value: midi.AcousticBass, Usage: "Set the program of General MIDI to play the bass track, from 1 to 128, e.g. 34 of an electric bass"},
+			cli.DurationFlag{Name: "humanize", Usage: "Move each note earlier or later at random, by up to this much time, e.g. 10ms"},
+			cli.IntFlag{Name: "jitter", Usage: "Make each note softer or louder at random, by up to this much velocity, e.g. 8"},
+			cli.Int64Flag{Name: "seed", Usage: "Set the seed of the humanization and the random arpeggio, so the same seed always gives the same MIDI (default: the current time)"},
+			cli.StringFlag{Name: "midi", Usage: "Write the tracks to a MIDI file at this path"},
+		},
+		Action: func(c *cli.Context) error {
+			names := c.Args()
+			if len(names) > 0 {
+				channel, err := channelOf(c.Int("channel"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				seed := c.Int64("seed")
+				if !c.IsSet("seed") {
+					seed = time.Now().UnixNano()
+				}
+				opts := []chordtrack.Option{
+					chordtrack.WithProgram(c.Int("program")),
+					chordtrack.WithChannel(channel),
+					chordtrack.WithBeatsPerChord(c.Float64("beats")),
+					chordtrack.WithTempo(c.Float64("tempo")),
+					chordtrack.WithStrum(c.Duration("strum")),
+					chordtrack.WithHumanize(c.Duration("humanize"), c.Int("jitter"), seed),
+				}
+				if name := c.String("arpeggio"); len(name) > 0 {
+					pattern, err := chord.PatternNamed(name, seed)
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+					}
+					opts = append(opts, chordtrack.WithArpeggio(pattern))
+				}
+				if c.Bool("bass") {
+					bassChannel, err := channelOf(c.Int("bass-channel"))
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+					}
+					opts = append(opts, chordtrack.WithBass(bassChannel, c.Int("bass-program")))
+				}
+				if err = writeChordTracks(c.App.Writer, names, c.String("midi"), opts...); err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "chord-track")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Harmonize a Melody
+		Name:        "harmonize-melody",
+		Usage:       "propose chords to Harmonize a Melody, read from ABC, MIDI or MusicXML",
+		Description: "Harmonize a melody, from a file of ABC notation, or MIDI if named .mid, or the melody of a score of MusicXML if named .musicxml, .xml or .mxl, with a diatonic chord for every bar, or some --beats, in a style, one of " + strings.Join(harmonize.StyleNames(), ", ") + ", ranking the alternatives from the best, e.g. harmonize-melody --style sevenths tune.abc",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "key, k", Usage: "Set the key of the melody (default: the key of the file, or else guessed from the notes)"},
+			cli.StringFlag{Name: "style, s", Value: "triads", Usage: "Set the style, one of " + strings.Join(harmonize.StyleNames(), ", ")},
+			cli.Float64Flag{Name: "beats", Usage: "Set the beats spanned by each chord (default: a bar)"},
+			cli.IntFlag{Name: "count, n", Value: 3, Usage: "Set the number of alternatives"},
+		},
+		Action: func(c *cli.Context) error {
+			path := c.Args().First()
+			if len(path) > 0 {
+				tune, err := readTuneFile(path)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				err = harmonizeMelody(c.App.Writer, tune, c.String("key"), c.String("style"), c.Float64("beats"), c.Int("count"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "harmonize-melody")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Suggest Substitutions
+		Name:        "subs",
+		Usage:       "suggest Substitutions for a chord",
+		Description: "Suggest substitutions for a chord, in a key, each by its kind, the tritone substitution or backdoor dominant of a dominant seventh chord, the relative or parallel chords of a major or minor chord, or a diminished passing chord, and why it works, e.g. subs G7 --key C",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "key, k", Usage: "Set the key of the chord, to suggest only the relative chords in it"},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if len(name) > 0 {
+				err := suggestSubstitutions(c.App.Writer, name, c.String("key"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "subs")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Reharmonize a Progression
+		Name:        "reharm",
+		Usage:       "Reharmonize a progression, substituting some of its chords",
+		Description: "Reharmonize a progression, in a key, substituting as many of its chords as the aggressiveness allows while preserving its final cadence, listing the best alternatives, each with a diff of what changed and why, e.g. reharm --aggressiveness 0.5 C Am Dm7 G7 C",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "key, k", Usage: "Set the key of the progression (default: the key of its chords)"},
+			cli.Float64Flag{Name: "aggressiveness, a", Value: 0.5, Usage: "Set the fraction of the chords before the cadence that may be substituted, from 0 to 1, favoring chromatic substitutes the higher it is"},
+		},
+		Action: func(c *cli.Context) error {
+			names := c.Args()
+			if len(names) > 0 {
+				err := reharmonize(c.App.Writer, names, c.String("key"), c.Float64("aggressiveness"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "reharm")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Voice a Chorale
+		Name:        "satb",
+		Usage:       "voice a progression as a four-part SATB Chorale",
+		Description: "Voice each chord of a progression for soprano, alto, tenor and bass, within their ranges, moving each voice as little as it can, avoiding parallel fifths and octaves, and listing any rule that couldn't be followed, e.g. satb --format lilypond C F G7 C",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "key, k", Usage: "Set the key of the progression (default: the key of its chords)"},
+			formatFlag,
+			colorFlag,
+			accidentalsFlag,
+		},
+		Action: func(c *cli.Context) error {
+			names := c.Args()
+			if len(names) > 0 {
+				v, err := realizeChorale(names, c.String("key"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				err = renderTo(c, v)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "satb")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Suggest a Capo
+		Name:        "capo",
+		Usage:       "suggest a Capo for a key, to play it in open chord shapes",
+		Description: "Suggest the frets of a capo for a key, on a guitar in standard tuning, from the best, of the least difficulty of its shapes on average, then playing the most of the I, IV and V chords of the key, then the most of its diatonic chords, in some shapes, one of " + strings.Join(fretboard.ShapeSetNames, ", ") + ", or shapes named, separated by commas, e.g. capo Eb --shapes open",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "shapes, s", Value: "open", Usage: "Set the shapes to play, one of " + strings.Join(fretboard.ShapeSetNames, ", ") + ", or shapes named, separated by commas, e.g. G,C,D,Em"},
+			cli.IntFlag{Name: "max-difficulty", Usage: "Hide the shapes more difficult than this, adding up each string stopped, fret spanned, barre and muted string, e.g. 5 (default: any)"},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if len(name) > 0 {
+				err := suggestCapos(c.App.Writer, name, c.String("shapes"), c.Int("max-difficulty"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "capo")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Practice a Scale
+		Name:        "practice",
+		Usage:       "generate an exercise to Practice a scale, in a pattern, as ABC or MIDI",
+		Description: "Generate an exercise of a scale in a pattern, one of " + strings.Join(exercise.PatternNames, ", ") + ", in eighth notes up and back down, written as ABC notation with the finger of the right hand suggested for each note, e.g. practice \"G major\" --pattern thirds --tempo 80 --midi thirds.mid",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "pattern, p", Value: "straight", Usage: "Set the pattern, one of " + strings.Join(exercise.PatternNames, ", ")},
+			cli.Float64Flag{Name: "tempo, t", Usage: "Set the tempo, in beats per minute (default: 120)"},
+			cli.IntFlag{Name: "octaves", Value: 1, Usage: "Set the octaves to go up and back down"},
+			cli.StringFlag{Name: "midi", Usage: "Write the exercise to a MIDI file at this path"},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if len(name) > 0 {
+				err := practiceScale(c.App.Writer, name, c.String("pattern"), c.Float64("tempo"), c.Int("octaves"), c.String("midi"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "practice")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Generate an Etude
+		Name:        "etude",
+		Usage:       "generate an Etude to sight-read, in a key, at a level of difficulty",
+		Description: "Generate a random melody to sight-read, in a key, at a level of difficulty from 1 to " + strconv.Itoa(etude.MaxLevel) + ", each wider in range, with bigger leaps and more complex rhythms, written as " + strings.Join(etudeFormats, ", ") + " for printing, e.g. etude --level 3 --format musicxml D",
+		Flags: []cli.Flag{
+			cli.IntFlag{Name: "level, l", Value: 1, Usage: "Set the level of difficulty, from 1 to " + strconv.Itoa(etude.MaxLevel)},
+			cli.IntFlag{Name: "bars", Value: 8, Usage: "Set the number of bars of 4/4"},
+			cli.Int64Flag{Name: "seed", Usage: "Set the seed of the random melody, so the same seed always gives the same etude (default: the current time)"},
+			cli.StringFlag{Name: "format, f", Value: render.ABC, Usage: "Set the output format, one of " + strings.Join(etudeFormats, ", ")},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if len(name) > 0 {
+				seed := c.Int64("seed")
+				if !c.IsSet("seed") {
+					seed = time.Now().UnixNano()
+				}
+				err := writeEtude(c.App.Writer, name, c.Int("level"), c.Int("bars"), seed, c.String("format"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "etude")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Guide a Solo
+		Name:        "guide",
+		Usage:       "Guide a solo over a progression, by the tones to target on each chord",
+		Description: "Guide a solo over each chord of a progression, in a key, on a timeline of beats, by its guide tones to target, the third and seventh, each approached from a semitone below or the next tone above of a scale, and the scales to choose from, e.g. guide --format json \"Dm7 G7 Cmaj7\"",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "key, k", Usage: "Set the key of the progression (default: the key of its chords)"},
+			cli.Float64Flag{Name: "beats, b", Value: 4, Usage: "Set the beats of each chord"},
+			formatFlag,
+			colorFlag,
+			accidentalsFlag,
+		},
+		Action: func(c *cli.Context) error {
+			names := c.Args()
+			if len(names) > 0 {
+				v, err := soloingGuide(names, c.String("key"), c.Float64("beats"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				err = renderTo(c, v)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "guide")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Calculate a Polyrhythm
+		Name:        "polyrhythm",
+		Usage:       "calculate a Polyrhythm of two pulses against each other, or write its click track as MIDI",
+		Description: "Calculate a polyrhythm of two pulses against each other in the same span, e.g. 3:4, the second each a beat at a tempo, shown as a grid of each pulse and their composite, with a bar between each beat, and the steps where they align, and the time of each pulse, e.g. polyrhythm 3:4 --bpm 100 --midi click.mid",
+		Flags: []cli.Flag{
+			cli.Float64Flag{Name: "bpm", Value: midi.Tempo, Usage: "Set the tempo, in beats per minute of the second pulse"},
+			cli.IntFlag{Name: "cycles", Value: 4, Usage: "Set the number of cycles of MIDI"},
+			cli.StringFlag{Name: "midi", Usage: "Write a click track to a MIDI file at this path, on the drum channel, a wood block for each pulse"},
+		},
+		Action: func(c *cli.Context) error {
+			ratio := c.Args().First()
+			if len(ratio) > 0 {
+				err := writePolyrhythm(c.App.Writer, ratio, c.Float64("bpm"), c.Int("cycles"), c.String("midi"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "polyrhythm")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Click a Metronome
+		Name:        "click",
+		Usage:       "write a metronome Click track of a meter, as MIDI or WAV",
+		Description: "Click each beat of some bars of a meter at a tempo, in beats of its unit per minute, accenting the first beat of each group, e.g. 2+2+3 of 7/8, as the meter implies or as accented, shown as a bar of accents, and written as MIDI or WAV, e.g. click 7/8 --bpm 140 --bars 16 --accent 2+2+3 --wav click.wav",
+		Flags: []cli.Flag{
+			cli.Float64Flag{Name: "bpm", Value: midi.Tempo, Usage: "Set the tempo, in beats of the unit of the meter per minute"},
+			cli.IntFlag{Name: "bars", Value: 4, Usage: "Set the number of bars"},
+			cli.StringFlag{Name: "accent", Usage: "Set the groups of beats, each accented on its first, separated by +, e.g. 2+2+3 (default: as the meter implies)"},
+			cli.StringFlag{Name: "midi", Usage: "Write the click track to a MIDI file at this path, on the drum channel"},
+			cli.StringFlag{Name: "wav", Usage: "Write the click track to a WAV file at this path"},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if len(name) > 0 {
+				err := writeClickTrack(c.App.Writer, name, c.String("accent"), c.Float64("bpm"), c.Int("bars"), c.String("midi"), c.String("wav"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "click")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Generate a Euclidean Rhythm
+		Name:        "euclidean",
+		Usage:       "generate a Euclidean rhythm of some pulses over some steps, or write it as MIDI",
+		Description: "Generate a Euclidean rhythm, some pulses spread as evenly as they can be over some steps, rotated to begin on another step, shown as a grid of an instrument of the drum kit, one of " + strings.Join(groove.InstrumentNames(), ", ") + ", with a bar between each beat, and written as MIDI, e.g. euclidean --rotation 2 --instrument rim --midi tresillo.mid 3 8",
+		Flags: []cli.Flag{
+			cli.IntFlag{Name: "rotation, r", Usage: "Rotate the rhythm to begin this many steps later, or earlier if negative"},
+			cli.StringFlag{Name: "instrument, i", Value: "rim", Usage: "Set the instrument, one of " + strings.Join(groove.InstrumentNames(), ", ")},
+			cli.IntFlag{Name: "steps-per-beat", Value: 4, Usage: "Set the steps of each beat, e.g. 4 of sixteenth notes"},
+			cli.IntFlag{Name: "bars", Value: 4, Usage: "Set the number of repetitions of MIDI"},
+			cli.StringFlag{Name: "midi", Usage: "Write the rhythm to a MIDI file at this path, on the drum channel"},
+		},
+		Action: func(c *cli.Context) error {
+			args := c.Args()
+			if len(args) > 0 {
+				err := writeEuclidean(c.App.Writer, args, c.Int("rotation"), c.String("instrument"), c.Int("steps-per-beat"), c.Int("bars"), c.String("midi"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "euclidean")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Export a Song
+		Name:        "export",
+		Usage:       "Export a song file for a DAW, as CSV of its chords or a MIDI clip of each section",
+		Description: "Export a song from a YAML file, or a score of MusicXML named .musicxml, .xml or .mxl, or a ChordPro chart named .cho, e.g. from notation software, writing a row of CSV of each chord, its bar, beat, name and notes, or with --yaml, the song as YAML, e.g. the chords of the score, or with --clips, a MIDI clip of each section to a directory, named by its number and section, e.g. 1-verse.mid, to drop into a DAW, e.g. the session view of Ableton Live, e.g. export --clips clips autumn-leaves.yaml",
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "yaml", Usage: "Write the song as YAML, instead of CSV of the chords, e.g. to analyze the chords of a score"},
+			cli.StringFlag{Name: "clips", Usage: "Write a MIDI clip of each section to this directory, instead of CSV of the chords"},
+		},
+		Action: func(c *cli.Context) error {
+			path := c.Args().First()
+			if len(path) > 0 {
+				err := exportSong(c.App.Writer, path, c.Bool("yaml"), c.String("clips"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "export")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Analyze Songs in a Batch
+		Name:        "analyze",
+		Usage:       "Analyze song files in a batch, writing CSV of a row of each chord or bar of each, e.g. to load into pandas or R",
+		Description: "Analyze songs from YAML files, or scores of MusicXML named .musicxml, .xml or .mxl, or ChordPro charts named .cho, or of -, from each path read from a line of the standard input, writing CSV of a row of each chord, its source, title, key, section, bar, beat, beats, name, root, bass, type, numeral, function and count of tones outside the key, or with --per bar, a row of each bar, streamed as each song is analyzed, e.g. find songs -name '*.yaml' | analyze --per bar - > bars.csv, or with --annotate, a lead sheet of each song, its bars with the chord symbols on top and their Roman numerals beneath, and the cadence and any modulation of each section annotated inline, e.g. analyze --annotate chart.cho",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "per", Value: "chord", Usage: "Write a row per chord or per bar"},
+			cli.BoolFlag{Name: "annotate", Usage: "Write a lead sheet of each song, annotated with its numerals, cadences and modulations, instead of CSV"},
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() > 0 {
+				err := analyzeSongs(stdin, c.App.Writer, c.Args(), c.String("per"), c.Bool("annotate"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "analyze")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Summarize the Statistics of a Song
+		Name:        "stats",
+		Usage:       "Summarize the statistics of the chords of a song file, relative to the corpus of classic progressions",
+		Description: "Summarize the chords of a song from a YAML file, or a score of MusicXML named .musicxml, .xml or .mxl, or a ChordPro chart named .cho, its key, or else the key of its chords, the average beats of each chord, its chromaticism, the share of the tones of its chords outside the scale of its key, the count of each type of chord, and each chord of a type or numeral unusual in the corpus of classic progressions, e.g. stats autumn-leaves.yaml",
+		Action: func(c *cli.Context) error {
+			path := c.Args().First()
+			if len(path) > 0 {
+				err := songStats(c.App.Writer, path)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "stats")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Transpose a Song
+		Name:        "transpose",
+		Usage:       "Transpose a song file some semitones, its key, chords and melody",
+		Description: "Transpose a song from a YAML file, or a score of MusicXML named .musicxml, .xml or .mxl, or a ChordPro chart named .cho, some semitones, up if positive or down if negative, its key, each chord, renamed as it was written, and each note of its melody, first detecting its key from its chords or melody if asked, and simplifying each chord to an easier symbol of the same function in its key with --simplify, e.g. C7 of C9#11 of tensions, or Am of Am7 of triads, rendered in a format, the song as YAML or JSON, or else its melody, or its progression, or a lead sheet, e.g. transpose --semitones -2 --format musicxml autumn-leaves.yaml, or transpose --simplify triads --format leadsheet chart.cho",
+		Flags: []cli.Flag{
+			cli.IntFlag{Name: "semitones, s", Usage: "Set the semitones to transpose by, up if positive or down if negative, e.g. -2"},
+			cli.BoolFlag{Name: "detect-key", Usage: "Detect the key of the song from its chords, or else its melody, before transposing it, replacing any key of the file"},
+			cli.StringFlag{Name: "simplify", Usage: "Simplify every chord for a beginner's chart, before transposing it, to one of " + strings.Join(chord.SimplifyLevelNames, ", ") + ", e.g. triads of Am of Am7"},
+			cli.StringFlag{Name: "format, f", Value: render.YAML, Usage: "Set the output format, one of " + strings.Join(render.Formats(), ", ")},
+		},
+		Action: func(c *cli.Context) error {
+			path := c.Args().First()
+			if len(path) > 0 {
+				err := transposeSong(c.App.Writer, path, c.Int("semitones"), c.Bool("detect-key"), c.String("simplify"), c.String("format"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "transpose")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Take a Quiz
+		Name:        "quiz",
+		Usage:       "take an ear-training Quiz of intervals, chords or scales",
+		Description: "Quiz of randomized questions about intervals, chords or scales, e.g. quiz intervals, each answered by name on a line, e.g. M3, Cm7 or D dorian, keeping score.",
+		Flags: []cli.Flag{
+			cli.IntFlag{Name: "count, n", Value: 10, Usage: "Set the number of questions"},
+			cli.Int64Flag{Name: "seed", Usage: "Set the seed of the questions, so the same seed always asks the same questions (default: the current time)"},
+			cli.StringFlag{Name: "midi", Usage: "Write the notes of each question to a MIDI file in this directory, to hear them"},
+		},
+		Action: func(c *cli.Context) error {
+			kind := c.Args().First()
+			if len(kind) > 0 {
+				seed := c.Int64("seed")
+				if !c.IsSet("seed") {
+					seed = time.Now().UnixNano()
+				}
+				s, err := quiz.New(quiz.Kind(kind), seed)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+				err = runQuiz(stdin, c.App.Writer, s, c.Int("count"), c.String("midi"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no arguments
+				err := cli.ShowCommandHelp(c, "quiz")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+	{ // Listen to MIDI
+		Name:        "listen",
+		Usage:       "Listen to a MIDI instrument, naming its chords and estimating its key as it's played",
+		Description: "Listen to the notes held on a MIDI instrument, on a --midi-port, writing them, the chord they sound and the key estimated by the chords played so far, each time they change, e.g. listen --midi-port /dev/snd/midiC1D0. The port is a raw MIDI device, or - for the standard input, or if built with -tags rtmidi, the number of an input port of the RtMidi library. With --osc, each change is also sent as OSC messages, /music-theory/notes, /music-theory/chord, /music-theory/key and /music-theory/scale, e.g. to a patch of Max/MSP, SuperCollider or TouchDesigner.",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "midi-port", Usage: "Set the MIDI input port, a raw MIDI device, e.g. /dev/snd/midiC1D0, or - for the standard input, or with -tags rtmidi, the number of a port, e.g. 0"},
+			cli.StringFlag{Name: "osc", Usage: "Send the notes, chord, key and suggested scale as OSC messages over UDP to this host and port, e.g. localhost:57120"},
+		},
+		Action: func(c *cli.Context) error {
+			port := c.String("midi-port")
+			if len(port) > 0 {
+				err := listenTo(c.App.Writer, port, c.String("osc"))
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+				}
+			} else {
+				// no port
+				err := cli.ShowCommandHelp(c, "listen")
+				if err != nil {
+					fmt.Fprintf(c.App.Writer, "Error occurred: %v\n", err)
+				}
+			}
+			return nil
+		},
+	},
+
+	{ // Serve the HTTP API, or the gRPC service
+		Name:        "serve",
+		Usage:       "serve an HTTP API, or the gRPC service",
+		Description: "Serve REST endpoints /chord/{name}, /scale/{name}, /key/{name}, /pitch/{note} and /progression/analyze, responding with JSON, or YAML if requested by the Accept header, or with --grpc, the MusicTheory service of proto/music_theory.proto.",
+		Flags: []cli.Flag{
+			cli.IntFlag{Name: "port, p", Value: 8080, Usage: "Set the port to listen on"},
+			cli.BoolFlag{Name: "grpc", Usage: "Serve the gRPC MusicTheory service instead of the HTTP API"},
+		},
+		Action: func(c *cli.Context) error {
+			addr := fmt.Sprintf(":%d", c.Int("port"))
+			listenAndServe := server.ListenAndServe
+			if c.Bool("grpc") {
+				listenAndServe = server.ListenAndServeGRPC
+			}
+			fmt.Fprintf(c.App.Writer, "Serving on %s\n", addr)
+			if err := listenAndServe(addr); err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error occurred: %v", err), 1)
+			}
+			return nil
 		},
 	},
 }
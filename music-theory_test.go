@@ -2,8 +2,14 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
+	"net"
 	"os"
 	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+	"gopkg.in/urfave/cli.v1"
 )
 
 func TestMusicTheory(t *testing.T) {
@@ -25,3 +31,101 @@ func TestHelpCmd(t *testing.T) {
 	}
 	main()
 }
+
+func TestParseErrorExitCode(t *testing.T) {
+	assertExitCode(t, 1, "Error occurred: unknown form \"jams\" at position 2 of chord \"C jams\"\n", "chord", "C jams")
+	assertExitCode(t, 1, "Error occurred: unknown root \"H\" at position 0 of scale \"H\"\n", "scale", "H")
+	assertExitCode(t, 1, "Error occurred: unknown mode \"7\" at position 8 of key \"C minor 7\"\n", "key", "C minor 7")
+	assertExitCode(t, 0, "", "chord", "C minor 7")
+}
+
+func TestStrictExitCode(t *testing.T) {
+	assertExitCode(t, 1, "Error occurred: unknown form \"th\" at position 9 of chord \"C minor 7th\"\n", "chord", "--strict", "C minor 7th")
+	assertExitCode(t, 0, "", "chord", "C minor 7th")
+	assertExitCode(t, 1, "Error occurred: ambiguous modes \"dorian lydian\" at position 2 of scale \"C dorian lydian\"\n", "scale", "--strict", "C dorian lydian")
+}
+
+func TestInversionExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "chord", "--inversion", "1", "Am7")
+	assertExitCode(t, 0, "", "chord", "-f", "lilypond", "C/E")
+}
+
+func TestExplainExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "chord", "--explain", "Cm679-5")
+	assertExitCode(t, 0, "", "chord", "--explain", "C jams")
+}
+
+func TestChordsExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "chords")
+	assertExitCode(t, 0, "", "chords", "--about", "Half Diminished Seventh")
+	assertExitCode(t, 0, "", "chords", "--about", "m7b5")
+	assertExitCode(t, 1, "Error occurred: unknown form \"jams\", expected the name or alias of a known form\n", "chords", "--about", "jams")
+}
+
+func TestScalesExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "scales")
+	assertExitCode(t, 0, "", "scales", "--about", "Dorian")
+	assertExitCode(t, 0, "", "scales", "--about", "jazz minor")
+	assertExitCode(t, 1, "Error occurred: unknown mode \"jams\", expected the name or alias of a known mode\n", "scales", "--about", "jams")
+}
+
+func TestArpeggioExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "arpeggio", "--pattern", "updown", "Cmaj7")
+	assertExitCode(t, 0, "", "arpeggio", "--pattern", "random", "--seed", "7", "--octaves", "2", "-f", "midi", "Cm")
+	assertExitCode(t, 1, "Error occurred: unknown pattern \"sideways\"\n", "arpeggio", "--pattern", "sideways", "C")
+	assertExitCode(t, 1, "Error occurred: unknown form \"jams\" at position 2 of chord \"C jams\"\n", "arpeggio", "C jams")
+	assertExitCode(t, 1, "Error occurred: out of range octaves 0, expected at least 1\n", "arpeggio", "--octaves", "0", "C")
+	assertExitCode(t, 1, "Error occurred: out of range octaves -5, expected at least 1\n", "arpeggio", "--octaves", "-5", "C")
+}
+
+func TestPitchExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "pitch", "A4")
+	assertExitCode(t, 0, "", "pitch", "--tuning", "baroque", "--precision", "3", "C", "4")
+	assertExitCode(t, 0, "", "pitch", "-t", "415.3", "A4")
+	assertExitCode(t, 1, "Error occurred: unknown tuning \"loud\"\n", "pitch", "-t", "loud", "A4")
+	assertExitCode(t, 1, "Error occurred: out of range octave 11 of \"C11\", expected -1 to 10\n", "pitch", "C11")
+	assertExitCode(t, 1, "Error occurred: precision out of range: 50000000, expected 0 to 10\n", "pitch", "--precision", "50000000", "A4")
+}
+
+func TestFreqsExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "freqs", "--octave", "3..5", "A minor")
+	assertExitCode(t, 0, "", "freqs", "--chord", "-t", "verdi", "-f", "yaml", "Cmaj7")
+	assertExitCode(t, 1, "Error occurred: invalid range \"5..3\", expected ascending octaves from -1 to 10\n", "freqs", "-o", "5..3", "C")
+	assertExitCode(t, 1, "Error occurred: unknown form \"jams\" at position 2 of chord \"C jams\"\n", "freqs", "--chord", "C jams")
+}
+
+func TestFormatExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "chord", "--format", "lilypond", "Cm7")
+	assertExitCode(t, 0, "", "scale", "-f", "table", "C minor")
+	assertExitCode(t, 0, "", "key", "-f", "musicxml", "Eb")
+	assertExitCode(t, 0, "", "key", "--full", "Eb")
+	assertExitCode(t, 1, "Error occurred: unknown format \"pdf\"\n", "key", "-f", "pdf", "Eb")
+}
+
+func TestServeExitCode(t *testing.T) {
+	l, err := net.Listen("tcp", ":0")
+	assert.Nil(t, err)
+	defer l.Close()
+	port := l.Addr().(*net.TCPAddr).Port
+	assertExitCode(t, 1, fmt.Sprintf("Error occurred: listen tcp :%d: bind: address already in use\n", port), "serve", "--port", fmt.Sprint(port))
+	assertExitCode(t, 1, fmt.Sprintf("Error occurred: listen tcp :%d: bind: address already in use\n", port), "serve", "--grpc", "--port", fmt.Sprint(port))
+}
+
+//
+// Private
+//
+
+func assertExitCode(t *testing.T, expectCode int, expectErr string, args ...string) {
+	oldArgs, oldExiter, oldErrWriter := os.Args, cli.OsExiter, cli.ErrWriter
+	defer func() { os.Args, cli.OsExiter, cli.ErrWriter = oldArgs, oldExiter, oldErrWriter }()
+
+	code := 0
+	cli.OsExiter = func(c int) { code = c }
+	errOut := &bytes.Buffer{}
+	cli.ErrWriter = errOut
+
+	os.Args = append([]string{"cmd"}, args...)
+	main()
+	assert.Equal(t, expectCode, code)
+	assert.Equal(t, expectErr, errOut.String())
+}
@@ -3,14 +3,19 @@ package note
 
 import (
 	"regexp"
+	"strings"
+	"unicode/utf8"
 )
 
-// AdjSymbolOf the adjustment symbol (Sharp or Flat) for a given name (e.g. of a chord, scale or key)
+// AdjSymbolOf the adjustment symbol (Sharp or Flat) for a given name (e.g. of a chord, scale or key), counted without allocating
 func AdjSymbolOf(name string) AdjSymbol {
-	numSharps := len(rgxSharpIn.FindAllString(name, -1))
-	numFlats := len(rgxFlatIn.FindAllString(name, -1))
-	numSharpish := len(rgxSharpishIn.FindAllString(name, -1))
-	numFlattish := len(rgxFlattishIn.FindAllString(name, -1))
+	numSharps := strings.Count(name, "#") + strings.Count(name, "♯") + strings.Count(name, "major")
+	numFlats := strings.Count(name, "b") + strings.Count(name, "♭")
+	if strings.HasPrefix(name, "F") {
+		numFlats++
+	}
+	numSharpish := countSharpishIn(name)
+	numFlattish := countFlattishIn(name)
 	// sharp/flat has precedent over sharpish/flattish; overall default is sharp
 	if numSharps > 0 && numSharps > numFlats {
 		return Sharp
@@ -50,10 +55,52 @@ const (
 //
 
 var (
-	rgxSharpIn, _    = regexp.Compile("[♯#]|major")
-	rgxFlatIn, _     = regexp.Compile("^F|[♭b]")
 	rgxSharpBegin, _ = regexp.Compile("^[♯#]")
 	rgxFlatBegin, _  = regexp.Compile("^[♭b]")
-	rgxSharpishIn, _ = regexp.Compile("(M|maj|major|aug)")
-	rgxFlattishIn, _ = regexp.Compile("([^a-z]|^)(m|min|minor|dim)")
 )
+
+// countSharpishIn a name the matches of (M|maj|major|aug), leftmost first and not overlapping, as a regular expression would
+func countSharpishIn(name string) (count int) {
+	for i := 0; i < len(name); {
+		if size := prefixIn(name[i:], "M", "maj", "aug"); size > 0 {
+			count++
+			i += size
+		} else {
+			i++
+		}
+	}
+	return
+}
+
+// countFlattishIn a name the matches of ([^a-z]|^)(m|min|minor|dim), leftmost first and not overlapping, as a regular expression would
+func countFlattishIn(name string) (count int) {
+	for i := 0; i < len(name); {
+		r, width := utf8.DecodeRuneInString(name[i:])
+		if r < 'a' || r > 'z' {
+			if size := prefixIn(name[i+width:], "m", "dim"); size > 0 {
+				count++
+				i += width + size
+				continue
+			}
+		}
+		if i == 0 {
+			if size := prefixIn(name, "m", "dim"); size > 0 {
+				count++
+				i += size
+				continue
+			}
+		}
+		i += width
+	}
+	return
+}
+
+// prefixIn a name the length of the first of the given prefixes it begins with, or 0 if none
+func prefixIn(name string, prefixes ...string) int {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return len(prefix)
+		}
+	}
+	return 0
+}
@@ -20,6 +20,16 @@ func TestAdjSymbolOf(t *testing.T) {
 	assert.Equal(t, Sharp, AdjSymbolOf("CM M9 m7")) // More Sharpish than Flattish
 	assert.Equal(t, Flat, AdjSymbolOf("Cm m9 M7"))  // More Flattish than Sharpish
 	assert.Equal(t, Sharp, AdjSymbolOf("C major"))
+	assert.Equal(t, Flat, AdjSymbolOf("Amm"))
+	assert.Equal(t, Flat, AdjSymbolOf("AmBm"))
+	assert.Equal(t, Flat, AdjSymbolOf("Fb"))
+	assert.Equal(t, Sharp, AdjSymbolOf("E#"))
+	assert.Equal(t, Sharp, AdjSymbolOf("Cmaj major"))
+	assert.Equal(t, Flat, AdjSymbolOf("Abaug"))
+	assert.Equal(t, Flat, AdjSymbolOf("éminor"))
+	assert.Equal(t, Flat, AdjSymbolOf("Gmmaj7"))
+	assert.Equal(t, Flat, AdjSymbolOf("maug"))
+	assert.Equal(t, Sharp, AdjSymbolOf(""))
 }
 
 func TestAdjSymbolBegin(t *testing.T) {
@@ -0,0 +1,29 @@
+// A quarter tone is half of a semitone, written as a half-sharp or half-flat accidental, e.g. "D half-flat" or "E𝄳", for maqam or microtonal music.
+package note
+
+import (
+	"regexp"
+)
+
+// QuarterTone in cents, half of the 100 cents of a semitone
+const QuarterTone = 50
+
+// CentsOf the quarter-tone accidental in a name, +50 for half-sharp, -50 for half-flat, or 0 if there is none, e.g. CentsOf("D half-flat")
+func CentsOf(text string) int {
+	switch {
+	case rgxHalfSharp.MatchString(text):
+		return QuarterTone
+	case rgxHalfFlat.MatchString(text):
+		return -QuarterTone
+	}
+	return 0
+}
+
+//
+// Private
+//
+
+var (
+	rgxHalfSharp, _ = regexp.Compile("(?i)half[- ]?sharp|𝄲")
+	rgxHalfFlat, _  = regexp.Compile("(?i)half[- ]?flat|𝄳")
+)
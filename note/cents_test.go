@@ -0,0 +1,28 @@
+// A quarter tone is half of a semitone, written as a half-sharp or half-flat accidental, e.g. "D half-flat" or "E𝄳", for maqam or microtonal music.
+package note
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestCentsOf(t *testing.T) {
+	assert.Equal(t, -50, CentsOf("D half-flat"))
+	assert.Equal(t, -50, CentsOf("D Half Flat"))
+	assert.Equal(t, -50, CentsOf("Dhalfflat4"))
+	assert.Equal(t, -50, CentsOf("E𝄳"))
+	assert.Equal(t, 50, CentsOf("F half-sharp"))
+	assert.Equal(t, 50, CentsOf("C𝄲5"))
+	assert.Equal(t, 0, CentsOf("Eb"))
+	assert.Equal(t, 0, CentsOf(""))
+}
+
+func TestNamed_QuarterTone(t *testing.T) {
+	assert.Equal(t, &Note{Class: E, Octave: 4, Cents: -50}, Named("E𝄳4"))
+	assert.Equal(t, &Note{Class: D, Cents: -50}, Named("D half-flat"))
+	assert.Equal(t, &Note{Class: F, Octave: 3, Cents: 50}, Named("F half-sharp 3"))
+	class, ok := ClassNamed("D half-flat")
+	assert.True(t, ok)
+	assert.Equal(t, D, class)
+}
@@ -127,15 +127,8 @@ func baseStepOf(text string) int {
 	if len(text) < 2 {
 		return 0
 	}
-
-	switch AdjSymbolBegin(text[1:]) {
-	case Sharp:
-		return 1
-	case Flat:
-		return -1
-	default:
-		return 0
-	}
+	step, _ := accidentalBegin(text[1:])
+	return step
 }
 
 func stepFrom(name Class, inc int) (Class, Octave) {
@@ -0,0 +1,113 @@
+// Note names are normalized from a letter in either case and any accidentals, in ASCII, in Unicode or in words, e.g. "d♭", "F𝄪" or "E double flat",
+// to a letter in upper case and accidentals in ASCII, e.g. "Db", "F##" or "Ebb", so every parser needn't handle them all again.
+package note
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Normalize a note name, a letter from A to G in either case, any accidentals, sharp or flat, double, natural or a quarter tone,
+// in ASCII, in Unicode or in words, and any octave, to the letter in upper case, its accidentals in ASCII, any quarter tone in Unicode, and the octave,
+// e.g. "Db" of "d♭" or "d-flat", "F##" of "F𝄪", "C4" of "c♮4", "Bbb" of "B double flat", or "E𝄳4" of "e half-flat 4", or empty if it's not a valid name
+func Normalize(text string) string {
+	letter, alter, cents, octave, ok := parseName(text)
+	if !ok {
+		return ""
+	}
+	name := letter + accidentalsOf(alter)
+	switch {
+	case cents > 0:
+		name += "𝄲"
+	case cents < 0:
+		name += "𝄳"
+	}
+	if octave != nil {
+		name += strconv.Itoa(*octave)
+	}
+	return name
+}
+
+// IsValid note name, one that can be normalized, e.g. "d♭" or "F𝄪2", but not "H" or "C♭x"
+func IsValid(text string) bool {
+	_, _, _, _, ok := parseName(text)
+	return ok
+}
+
+// ClassNamed the pitch class of a note name, in any of the forms normalized, regardless of any octave or quarter tone,
+// e.g. Cs of "d♭4" or C of "B#", and true, or else Nil and false if it's not a valid name
+func ClassNamed(text string) (Class, bool) {
+	letter, alter, _, _, ok := parseName(text)
+	if !ok {
+		return Nil, false
+	}
+	class, _ := baseNameOf(letter).Step(alter)
+	return class, true
+}
+
+//
+// Private
+//
+
+// rgxName of a note, its letter, accidentals in ASCII, Unicode or words, any quarter tone, and any octave, each maybe after a space, or a word after a dash, e.g. "d-flat"
+var rgxName, _ = regexp.Compile(`^([A-Ga-g])((?:\s*(?:[#bx]|-?(?i:(?:double[- ]?)?(?:sharp|flat)|natural)))*)\s*(-?(?i:half[- ]?(?:sharp|flat))|𝄲|𝄳)?\s*(-?[0-9]+)?$`)
+
+// rgxAccidentalWord of an accidental in words, e.g. "double flat"
+var rgxAccidentalWord, _ = regexp.Compile(`(?i)(double[- ]?)?(sharp|flat)|natural`)
+
+// parseName of a note, into its letter in upper case, its accidentals in semitones, any quarter tone in cents, and any octave, or false if it's not valid,
+// e.g. "D", -1, 0, 4 of "d♭4", or an accidental that's neither sharp nor flat, e.g. "C#b"
+func parseName(text string) (letter string, alter int, cents int, octave *int, ok bool) {
+	m := rgxName.FindStringSubmatch(strings.TrimSpace(symbol.Normalize(text)))
+	if m == nil {
+		return
+	}
+	sharps, flats := false, false
+	for _, word := range rgxAccidentalWord.FindAllStringSubmatch(m[2], -1) {
+		semitones := 0
+		switch strings.ToLower(word[2]) {
+		case "sharp":
+			semitones = 1
+		case "flat":
+			semitones = -1
+		}
+		if len(word[1]) > 0 {
+			semitones *= 2
+		}
+		alter += semitones
+		sharps, flats = sharps || semitones > 0, flats || semitones < 0
+	}
+	for _, r := range rgxAccidentalWord.ReplaceAllString(m[2], "") {
+		switch r {
+		case '#':
+			alter, sharps = alter+1, true
+		case 'x':
+			alter, sharps = alter+2, true
+		case 'b':
+			alter, flats = alter-1, true
+		}
+	}
+	if sharps && flats {
+		return
+	}
+	cents = CentsOf(m[3])
+	if len(m[4]) > 0 {
+		o, err := strconv.Atoi(m[4])
+		if err != nil {
+			return
+		}
+		octave = &o
+	}
+	return strings.ToUpper(m[1]), alter, cents, octave, true
+}
+
+// accidentalsOf some semitones, in ASCII, e.g. "bb" of -2
+func accidentalsOf(alter int) string {
+	if alter < 0 {
+		return strings.Repeat("b", -alter)
+	}
+	return strings.Repeat("#", alter)
+}
@@ -0,0 +1,84 @@
+// Note names are normalized from a letter in either case and any accidentals, in ASCII, in Unicode or in words, e.g. "d♭", "F𝄪" or "E double flat",
+// to a letter in upper case and accidentals in ASCII, e.g. "Db", "F##" or "Ebb", so every parser needn't handle them all again.
+package note
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	for text, expect := range map[string]string{
+		"C":              "C",
+		"d♭":             "Db",
+		"D♭":             "Db",
+		"f#":             "F#",
+		"F♯":             "F#",
+		"G＃":             "G#",
+		"F𝄪":             "F##",
+		"Fx":             "F##",
+		"B𝄫":             "Bbb",
+		"Bbb":            "Bbb",
+		"c♮4":            "C4",
+		"c₄":             "C4",
+		"B♭₋₁":           "Bb-1",
+		"A♭-1":           "Ab-1",
+		"C04":            "C4",
+		" e flat ":       "Eb",
+		"B double flat":  "Bbb",
+		"g Sharp 3":      "G#3",
+		"A natural":      "A",
+		"C double-sharp": "C##",
+		"E𝄳4":            "E𝄳4",
+		"e half-flat 4":  "E𝄳4",
+		"F half sharp":   "F𝄲",
+		"Db𝄳":            "Db𝄳",
+		"H":              "",
+		"":               "",
+		"C#b":            "",
+		"C♭x":            "",
+		"Cm7":            "",
+		"C4 4":           "",
+		"Db flatter":     "",
+		"d-flat":         "Db",
+		"c-sharp4":       "C#4",
+		"B-double-flat":  "Bbb",
+		"e-half-flat 4":  "E𝄳4",
+		"d-":             "",
+		"d-b":            "",
+	} {
+		assert.Equal(t, expect, Normalize(text), text)
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	assert.True(t, IsValid("d♭"))
+	assert.True(t, IsValid("F𝄪2"))
+	assert.True(t, IsValid("D half-flat"))
+	assert.False(t, IsValid("H"))
+	assert.False(t, IsValid("C♭x"))
+	assert.False(t, IsValid("Eb major"))
+	assert.False(t, IsValid(""))
+}
+
+func TestClassNamed(t *testing.T) {
+	for text, expect := range map[string]Class{
+		"C":      C,
+		"d♭4":    Cs,
+		"B#":     C,
+		"Cb":     B,
+		"F𝄪":     G,
+		"E𝄫":     D,
+		"a flat": Gs,
+		"d-flat": Cs,
+		"E𝄳4":    E,
+	} {
+		class, ok := ClassNamed(text)
+		assert.True(t, ok, text)
+		assert.Equal(t, expect, class, text)
+	}
+	class, ok := ClassNamed("H")
+	assert.False(t, ok)
+	assert.Equal(t, Nil, class)
+}
@@ -17,6 +17,7 @@ package note
 type Note struct {
 	Class  Class  // Class of pitch
 	Octave Octave // Octave #
+	Cents  int    // Deviation from the pitch of the class, e.g. -50 for a half-flat, which a chord, scale or key drops
 
 	Performer string  // Can be used to sort out whose Notes are whose
 	Position  float64 // Can be used to represent time within the composition
@@ -31,8 +32,9 @@ func Named(text string) (n *Note) {
 	// First the name, including octave shift.
 	n.Class, n.Octave = NameOf(text)
 
-	// Last, add the originally named octave.
+	// Last, add the originally named octave, and any quarter tone.
 	n.Octave += OctaveOf(text)
+	n.Cents = CentsOf(text)
 
 	return
 }
@@ -43,9 +45,3 @@ func OfClass(class Class) (n *Note) {
 	n.Class = class
 	return
 }
-
-// ClassNamed returns a pitch Class
-func ClassNamed(text string) Class {
-	n := Named(text)
-	return n.Class
-}
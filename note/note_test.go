@@ -14,14 +14,17 @@ func TestNamed(t *testing.T) {
 	})
 }
 
+func TestNamed_DoubleAccidental(t *testing.T) {
+	assert.Equal(t, &Note{Class: D, Octave: 4}, Named("C##4"))
+	assert.Equal(t, &Note{Class: Fs, Octave: 2}, Named("E𝄪2"))
+	assert.Equal(t, &Note{Class: A, Octave: 3}, Named("Bbb3"))
+	assert.Equal(t, &Note{Class: C}, Named("D𝄫"))
+	assert.Equal(t, &Note{Class: A, Octave: 3}, Named("C𝄫b4"))
+}
+
 func TestOfClass(t *testing.T) {
 	n := OfClass(C)
 	assert.Equal(t, n, &Note{
 		Class: C,
 	})
 }
-
-func TestClassNamed(t *testing.T) {
-	n := ClassNamed("C")
-	assert.Equal(t, n, C)
-}
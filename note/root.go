@@ -2,28 +2,45 @@
 package note
 
 import (
-	"regexp"
 	"strings"
 )
 
+// RootAndRemaining of a name, e.g. C# and "m7" of "C# m7", or D and "m" of "C##m", with all its leading accidentals, parsed without allocating, e.g. in a real-time MIDI processor
+func RootAndRemaining(name string) (Class, string) {
+	root := baseNameOf(name)
+	if root == Nil {
+		return Nil, name
+	}
+	size := 1
+	if step, width := accidentalBegin(name[1:]); width > 0 {
+		root, _ = root.Step(step)
+		size += width
+	}
+	return root, strings.TrimSpace(name[size:])
+}
+
 //
 // Private
 //
 
-var (
-	rgxSingle, _ = regexp.Compile("^[ABCDEFG]")
-	rgxDouble, _ = regexp.Compile("^[ABCDEFG][♯#♭b]")
-)
-
-// Parse all forms using Regexp's against a string
-func RootAndRemaining(name string) (Class, string) {
-	if r := rgxDouble.FindString(name); len(r) > 0 {
-		return ClassNamed(r), strings.TrimSpace(name[len(r):])
+// accidentalBegin a name, the semitones of all its leading sharps and flats and the bytes they're written in, e.g. 2 and 2 of "##", or 0 and 0 if there's none
+func accidentalBegin(name string) (step int, width int) {
+	for {
+		switch rest := name[width:]; {
+		case strings.HasPrefix(rest, "#"):
+			step, width = step+1, width+1
+		case strings.HasPrefix(rest, "♯"):
+			step, width = step+1, width+len("♯")
+		case strings.HasPrefix(rest, "𝄪"):
+			step, width = step+2, width+len("𝄪")
+		case strings.HasPrefix(rest, "b"):
+			step, width = step-1, width+1
+		case strings.HasPrefix(rest, "♭"):
+			step, width = step-1, width+len("♭")
+		case strings.HasPrefix(rest, "𝄫"):
+			step, width = step-2, width+len("𝄫")
+		default:
+			return step, width
+		}
 	}
-
-	if r := rgxSingle.FindString(name); len(r) > 0 {
-		return ClassNamed(r), strings.TrimSpace(name[len(r):])
-	}
-
-	return Nil, name
 }
@@ -15,6 +15,21 @@ func TestRootOf(t *testing.T) {
 	assertRootAndRemaining(t, "JAMS", Nil, "JAMS")
 }
 
+func TestRootOf_DoubleAccidental(t *testing.T) {
+	assertRootAndRemaining(t, "C##m", D, "m")
+	assertRootAndRemaining(t, "D𝄫 m", C, "m")
+	assertRootAndRemaining(t, "E𝄪7", Fs, "7")
+	assertRootAndRemaining(t, "Bbbmaj7", A, "maj7")
+	assertRootAndRemaining(t, "Cb", B, "")
+}
+
+func TestRootOf_WithoutAllocating(t *testing.T) {
+	assert.Equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		RootAndRemaining("Bb minor major 7")
+		AdjSymbolOf("Bb minor major 7")
+	}))
+}
+
 //
 // Private
 //
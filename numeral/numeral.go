@@ -0,0 +1,130 @@
+// A numeral is how a chord is written by the degree of the key it's built on, in upper case of a major third or lower case of a minor one,
+// marked ° of a diminished triad, ø of a half-diminished seventh chord or + of an augmented triad, with any accidental of a degree not in the key,
+// and the figure of its seventh and inversion, e.g. "bVII", "viiø7" or "V65", written the same by the Roman numerals of the roman package,
+// and by the diatonic and borrowed chords of a key, which the roman package builds on.
+//
+// https://en.wikipedia.org/wiki/Roman_numeral_analysis
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package numeral
+
+import (
+	"strings"
+)
+
+// Quality of the triad of a numeral, by its third and fifth
+type Quality int
+
+// Qualities of a triad
+const (
+	Major Quality = iota
+	Minor
+	Diminished
+	Augmented
+)
+
+// String of the quality, e.g. "diminished"
+func (of Quality) String() string {
+	switch of {
+	case Major:
+		return "major"
+	case Minor:
+		return "minor"
+	case Diminished:
+		return "diminished"
+	case Augmented:
+		return "augmented"
+	}
+	return ""
+}
+
+// QualityOf a triad, by the semitones up from its root to its third and fifth, e.g. Diminished of 3 and 6, or false of a third that's neither minor nor major
+func QualityOf(third, fifth int) (Quality, bool) {
+	third, fifth = (third%12+12)%12, (fifth%12+12)%12
+	switch {
+	case third == 3 && fifth == 6:
+		return Diminished, true
+	case third == 4 && fifth == 8:
+		return Augmented, true
+	case third == 3:
+		return Minor, true
+	case third == 4:
+		return Major, true
+	}
+	return Major, false
+}
+
+// Sevenths of a numeral, by the semitones up from its root, or none of a triad
+const (
+	NoSeventh         = 0
+	DiminishedSeventh = 9  // e.g. of vii°7
+	MinorSeventh      = 10 // e.g. of V7, ii7 or viiø7
+	MajorSeventh      = 11 // e.g. of Imaj7
+)
+
+// Numerals of each degree, from I of the tonic
+var Numerals = []string{"I", "II", "III", "IV", "V", "VI", "VII"}
+
+// Figures of each inversion of a triad and of a seventh chord, by the intervals above the bass, from root position
+var (
+	TriadFigures   = []string{"", "6", "64"}
+	SeventhFigures = []string{"7", "65", "43", "42"}
+)
+
+// Figure of a seventh and an inversion, e.g. "65" of a seventh chord in first inversion, "64" of a triad in second, or "" of a triad in root position
+func Figure(seventh int, inversion int) string {
+	if seventh == NoSeventh {
+		return TriadFigures[inversion%3]
+	}
+	return SeventhFigures[inversion%4]
+}
+
+// Of a chord on a degree, from 1 to 7, some semitones from the key's own degree, of a quality, seventh and inversion,
+// e.g. "bVII" of the 7th degree a flat, "ii°6" of a diminished triad in first inversion, "viiø7" of a half-diminished seventh chord,
+// "Imaj7" or "i(maj7)" of a major seventh, or "" of a degree out of range
+func Of(accidental int, degree int, quality Quality, seventh int, inversion int) string {
+	if degree < 1 || degree > len(Numerals) {
+		return ""
+	}
+	var b strings.Builder
+	for n := accidental; n < 0; n++ {
+		b.WriteString("b")
+	}
+	for n := accidental; n > 0; n-- {
+		b.WriteString("#")
+	}
+	numeral := Numerals[degree-1]
+	switch quality {
+	case Minor:
+		b.WriteString(strings.ToLower(numeral))
+	case Diminished:
+		b.WriteString(strings.ToLower(numeral))
+		if seventh == MinorSeventh {
+			b.WriteString("ø")
+		} else {
+			b.WriteString("°")
+		}
+	case Augmented:
+		b.WriteString(numeral + "+")
+	default:
+		b.WriteString(numeral)
+	}
+	figure := Figure(seventh, inversion)
+	switch {
+	case seventh == MajorSeventh && (quality == Minor || quality == Diminished):
+		b.WriteString("(maj" + figure + ")")
+	case seventh == MajorSeventh:
+		b.WriteString("maj" + figure)
+	default:
+		b.WriteString(figure)
+	}
+	return b.String()
+}
@@ -0,0 +1,59 @@
+// A numeral is how a chord is written by the degree of the key it's built on, in upper case of a major third or lower case of a minor one,
+// marked ° of a diminished triad, ø of a half-diminished seventh chord or + of an augmented triad, with any accidental of a degree not in the key,
+// and the figure of its seventh and inversion, e.g. "bVII", "viiø7" or "V65", written the same by the Roman numerals of the roman package,
+// and by the diatonic and borrowed chords of a key, which the roman package builds on.
+package numeral
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestOf(t *testing.T) {
+	assert.Equal(t, "I", Of(0, 1, Major, NoSeventh, 0))
+	assert.Equal(t, "ii", Of(0, 2, Minor, NoSeventh, 0))
+	assert.Equal(t, "vii°", Of(0, 7, Diminished, NoSeventh, 0))
+	assert.Equal(t, "III+", Of(0, 3, Augmented, NoSeventh, 0))
+	assert.Equal(t, "bVII", Of(-1, 7, Major, NoSeventh, 0))
+	assert.Equal(t, "#iv°", Of(1, 4, Diminished, NoSeventh, 0))
+	assert.Equal(t, "V7", Of(0, 5, Major, MinorSeventh, 0))
+	assert.Equal(t, "bIImaj7", Of(-1, 2, Major, MajorSeventh, 0))
+	assert.Equal(t, "iiø7", Of(0, 2, Diminished, MinorSeventh, 0))
+	assert.Equal(t, "vii°7", Of(0, 7, Diminished, DiminishedSeventh, 0))
+	assert.Equal(t, "i(maj7)", Of(0, 1, Minor, MajorSeventh, 0))
+	assert.Equal(t, "V65", Of(0, 5, Major, MinorSeventh, 1))
+	assert.Equal(t, "ii°6", Of(0, 2, Diminished, NoSeventh, 1))
+	assert.Equal(t, "Imaj42", Of(0, 1, Major, MajorSeventh, 3))
+	assert.Equal(t, "", Of(0, 8, Major, NoSeventh, 0))
+	assert.Equal(t, "", Of(0, 0, Major, NoSeventh, 0))
+}
+
+func TestQualityOf(t *testing.T) {
+	for semitones, quality := range map[[2]int]Quality{
+		{4, 7}:   Major,
+		{3, 7}:   Minor,
+		{3, 6}:   Diminished,
+		{4, 8}:   Augmented,
+		{3, -5}:  Minor,
+		{-9, -6}: Diminished,
+	} {
+		q, ok := QualityOf(semitones[0], semitones[1])
+		assert.True(t, ok, "%v", semitones)
+		assert.Equal(t, quality, q, "%v", semitones)
+	}
+	_, ok := QualityOf(5, 7)
+	assert.False(t, ok)
+}
+
+func TestFigure(t *testing.T) {
+	assert.Equal(t, "", Figure(NoSeventh, 0))
+	assert.Equal(t, "64", Figure(NoSeventh, 2))
+	assert.Equal(t, "7", Figure(MinorSeventh, 0))
+	assert.Equal(t, "42", Figure(MajorSeventh, 3))
+}
+
+func TestQuality_String(t *testing.T) {
+	assert.Equal(t, "diminished", Diminished.String())
+	assert.Equal(t, "", Quality(9).String())
+}
@@ -0,0 +1,113 @@
+// Open Sound Control (OSC) is a protocol for communication among computers, synthesizers and other multimedia devices,
+// e.g. the patches of Max/MSP, SuperCollider, Pure Data or TouchDesigner.
+//
+// Messages of an address and some arguments are encoded as OSC 1.0 and sent over UDP.
+//
+// https://en.wikipedia.org/wiki/Open_Sound_Control
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package osc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+)
+
+// ErrInvalidMessage when encoding a message whose address doesn't begin with a slash, or with an argument of a type OSC doesn't have
+var ErrInvalidMessage = errors.New("invalid OSC message")
+
+// Message to an address, e.g. "/music-theory/chord", of some arguments, each a string, an int32 (or int), or a float32 (or float64)
+type Message struct {
+	Address   string
+	Arguments []interface{}
+}
+
+// MarshalBinary encoding of the message, its address, the type tag of its arguments, e.g. ",sif", then each argument, padded to 4 bytes
+func (m Message) MarshalBinary() ([]byte, error) {
+	if !strings.HasPrefix(m.Address, "/") {
+		return nil, fmt.Errorf("%w address %q, expected it to begin with /", ErrInvalidMessage, m.Address)
+	}
+	var buf, args bytes.Buffer
+	tags := ","
+	for _, arg := range m.Arguments {
+		switch v := arg.(type) {
+		case string:
+			tags += "s"
+			writeString(&args, v)
+		case int32:
+			tags += "i"
+			binary.Write(&args, binary.BigEndian, v)
+		case int:
+			tags += "i"
+			binary.Write(&args, binary.BigEndian, int32(v))
+		case float32:
+			tags += "f"
+			binary.Write(&args, binary.BigEndian, math.Float32bits(v))
+		case float64:
+			tags += "f"
+			binary.Write(&args, binary.BigEndian, math.Float32bits(float32(v)))
+		default:
+			return nil, fmt.Errorf("%w argument %v of %T, expected a string, int32 or float32", ErrInvalidMessage, arg, arg)
+		}
+	}
+	writeString(&buf, m.Address)
+	writeString(&buf, tags)
+	buf.Write(args.Bytes())
+	return buf.Bytes(), nil
+}
+
+// Client sending messages over UDP to a host and port
+type Client struct {
+	conn net.Conn
+}
+
+// Dial a host and port to send messages to, e.g. "localhost:57120" of SuperCollider
+func Dial(address string) (*Client, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn}, nil
+}
+
+// Send each message in a packet of its own, in order
+func (c *Client) Send(messages ...Message) error {
+	for _, m := range messages {
+		packet, err := m.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if _, err = c.conn.Write(packet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close the client
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+//
+// Private
+//
+
+// writeString terminated by at least one null byte, and padded with them to a multiple of 4 bytes
+func writeString(buf *bytes.Buffer, s string) {
+	buf.WriteString(s)
+	buf.Write(make([]byte, 4-len(s)%4))
+}
@@ -0,0 +1,56 @@
+// Open Sound Control (OSC) is a protocol for communication among computers, synthesizers and other multimedia devices,
+// e.g. the patches of Max/MSP, SuperCollider, Pure Data or TouchDesigner.
+package osc
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestMessage_MarshalBinary(t *testing.T) {
+	packet, err := Message{Address: "/chord", Arguments: []interface{}{"Am7", 57, float32(0.5)}}.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{
+		'/', 'c', 'h', 'o', 'r', 'd', 0, 0,
+		',', 's', 'i', 'f', 0, 0, 0, 0,
+		'A', 'm', '7', 0,
+		0, 0, 0, 57,
+		0x3F, 0, 0, 0,
+	}, packet)
+}
+
+func TestMessage_MarshalBinary_NoArguments(t *testing.T) {
+	packet, err := Message{Address: "/key"}.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{'/', 'k', 'e', 'y', 0, 0, 0, 0, ',', 0, 0, 0}, packet)
+}
+
+func TestMessage_MarshalBinary_Invalid(t *testing.T) {
+	_, err := Message{Address: "chord"}.MarshalBinary()
+	assert.True(t, errors.Is(err, ErrInvalidMessage))
+	_, err = Message{Address: "/chord", Arguments: []interface{}{true}}.MarshalBinary()
+	assert.True(t, errors.Is(err, ErrInvalidMessage))
+}
+
+func TestClient_Send(t *testing.T) {
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer server.Close()
+	c, err := Dial(server.LocalAddr().String())
+	assert.Nil(t, err)
+	defer c.Close()
+	assert.Nil(t, c.Send(Message{Address: "/a", Arguments: []interface{}{int32(1)}}, Message{Address: "/b"}))
+	assert.Nil(t, server.SetReadDeadline(time.Now().Add(time.Second)))
+	buf := make([]byte, 64)
+	n, _, err := server.ReadFrom(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{'/', 'a', 0, 0, ',', 'i', 0, 0, 0, 0, 0, 1}, buf[:n])
+	n, _, err = server.ReadFrom(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{'/', 'b', 0, 0, ',', 0, 0, 0}, buf[:n])
+	assert.NotNil(t, c.Send(Message{Address: "b"}))
+}
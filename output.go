@@ -0,0 +1,127 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/urfave/cli.v1"
+
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/render"
+	"github.com/go-music-theory/music-theory/schema"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// formatFlag to choose the output format of a command, from any registered with the render package
+var formatFlag = cli.StringFlag{Name: "format, f", Value: render.Table, Usage: "Set the output format, one of " + strings.Join(render.Formats(), ", ")}
+
+// schemaFlag to choose the version of the schema of YAML or JSON, for the models that have versions
+var schemaFlag = cli.StringFlag{Name: "schema", Value: string(schema.Latest), Usage: "Set the schema version of yaml or json, one of " + schemaVersions()}
+
+// colorFlag to choose whether to highlight notes in color, by default only when writing to a terminal
+var colorFlag = cli.StringFlag{Name: "color", Value: colorAuto, Usage: "Highlight notes in color, one of auto, always, never"}
+
+// spellingFlag to prefer sharps or flats over how a value is spelled, in every format, also set by the environment, e.g. MUSIC_THEORY_SPELLING=flats
+var spellingFlag = cli.StringFlag{Name: "spelling", EnvVar: "MUSIC_THEORY_SPELLING", Usage: "Spell notes with sharps or flats, or following the key of the value, one of " + spellingNames() + " (default: as the value is named)"}
+
+// accidentalsFlag to write the accidentals of notes in ASCII or Unicode, as YAML, JSON, a table or a diagram, also set by the environment, e.g. MUSIC_THEORY_ACCIDENTALS=unicode
+var accidentalsFlag = cli.StringFlag{Name: "accidentals", EnvVar: "MUSIC_THEORY_ACCIDENTALS", Value: string(symbol.ASCII), Usage: "Write the accidentals of notes, e.g. of Bb, in ascii, or as the glyphs of unicode, one of " + strings.Join(symbol.AccidentalsNames, ", ")}
+
+// dynamicsFlag to play the notes at a dynamic, or from one to another, by the velocity of MIDI or the gain of Sonic Pi or SuperCollider
+var dynamicsFlag = cli.StringFlag{Name: "dynamics", Usage: "Set the dynamics, one of " + strings.Join(dynamics.LevelNames, ", ") + ", or two apart by .. of a crescendo or diminuendo, e.g. p..f (default: as each format plays)"}
+
+// Values of the color flag
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
+)
+
+// renderTo the writer of the app, a value in the format and color of the command's flags
+func renderTo(c *cli.Context, v interface{}) error {
+	var options []render.Option
+	switch c.String("color") {
+	case colorAlways:
+		options = append(options, render.WithColor())
+	case colorAuto:
+		if isTerminal(c.App.Writer) {
+			options = append(options, render.WithColor())
+		}
+	case colorNever:
+	default:
+		return fmt.Errorf("unknown color %q, expected one of auto, always, never", c.String("color"))
+	}
+	if c.Bool("full") {
+		options = append(options, render.WithFull())
+	}
+	spelling, err := spellingOf(c)
+	if err != nil {
+		return err
+	}
+	options = append(options, render.WithSpelling(spelling))
+	accidentals, err := accidentalsOf(c)
+	if err != nil {
+		return err
+	}
+	options = append(options, render.WithAccidentals(accidentals))
+	phrase, err := dynamics.ParsePhrase(c.String("dynamics"))
+	if err != nil {
+		return err
+	}
+	options = append(options, render.WithDynamics(phrase))
+	if len(c.String("schema")) > 0 {
+		version, err := schema.Parse(c.String("schema"))
+		if err != nil {
+			return err
+		}
+		options = append(options, render.WithSchema(version))
+	}
+	return render.To(c.App.Writer, c.String("format"), v, options...)
+}
+
+// schemaVersions listed for the usage of a flag, e.g. "v1, v2"
+func schemaVersions() string {
+	var names []string
+	for _, v := range schema.Versions() {
+		names = append(names, string(v))
+	}
+	return strings.Join(names, ", ")
+}
+
+// spellingOf the command's flag, or of its environment variable, or empty to spell each value as it's named
+func spellingOf(c *cli.Context) (render.Spelling, error) {
+	return render.ParseSpelling(c.String("spelling"))
+}
+
+// accidentalsOf the command's flag, or of its environment variable, in ASCII by default
+func accidentalsOf(c *cli.Context) (symbol.Accidentals, error) {
+	return symbol.ParseAccidentals(c.String("accidentals"))
+}
+
+// spellingNames listed for the usage of a flag, e.g. "sharps, flats, key"
+func spellingNames() string {
+	var names []string
+	for _, s := range render.Spellings() {
+		names = append(names, string(s))
+	}
+	return strings.Join(names, ", ")
+}
+
+// isTerminal if the writer is a character device, unless color is disabled by the environment, e.g. NO_COLOR=1 or TERM=dumb
+func isTerminal(w io.Writer) bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,64 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestColorExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "chord", "--color", "always", "Cm7")
+	assertExitCode(t, 0, "", "scale", "--color", "never", "C")
+	assertExitCode(t, 1, "Error occurred: unknown color \"sometimes\", expected one of auto, always, never\n", "key", "--color", "sometimes", "C")
+}
+
+func TestSchemaExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "chord", "-f", "yaml", "--schema", "v1", "Cm7")
+	assertExitCode(t, 0, "", "scale", "-f", "json", "--schema", "v2", "C minor")
+	assertExitCode(t, 1, "Error occurred: unknown schema version \"v9\"\n", "key", "-f", "json", "--schema", "v9", "Eb")
+}
+
+func TestSpellingExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "chord", "--spelling", "flats", "A7")
+	assertExitCode(t, 0, "", "scale", "--spelling", "key", "--diagram", "staff", "C lydian")
+	assertExitCode(t, 1, "Error occurred: unknown spelling \"naturals\"\n", "key", "--spelling", "naturals", "Eb")
+	assertExitCode(t, 1, "Error occurred: unknown spelling \"naturals\"\n", "chord", "--spelling", "naturals", "--diagram", "keyboard", "Eb")
+
+	os.Setenv("MUSIC_THEORY_SPELLING", "naturals")
+	defer os.Unsetenv("MUSIC_THEORY_SPELLING")
+	assertExitCode(t, 1, "Error occurred: unknown spelling \"naturals\"\n", "freqs", "A")
+}
+
+func TestAccidentalsExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "chord", "--accidentals", "unicode", "Bb7")
+	assertExitCode(t, 0, "", "scale", "--accidentals", "ascii", "-f", "json", "Eb major")
+	assertExitCode(t, 0, "", "chord", "--accidentals", "unicode", "--diagram", "staff", "F#m")
+	assertExitCode(t, 1, "Error occurred: unknown accidentals \"emoji\", expected one of ascii, unicode\n", "key", "--accidentals", "emoji", "Eb")
+	assertExitCode(t, 1, "Error occurred: unknown accidentals \"emoji\", expected one of ascii, unicode\n", "scale", "--accidentals", "emoji", "--diagram", "keyboard", "Eb")
+
+	os.Setenv("MUSIC_THEORY_ACCIDENTALS", "emoji")
+	defer os.Unsetenv("MUSIC_THEORY_ACCIDENTALS")
+	assertExitCode(t, 1, "Error occurred: unknown accidentals \"emoji\", expected one of ascii, unicode\n", "freqs", "A")
+}
+
+func TestSpellingNames(t *testing.T) {
+	assert.Equal(t, "sharps, flats, key", spellingNames())
+}
+
+func TestSchemaVersions(t *testing.T) {
+	assert.Equal(t, "v1, v2", schemaVersions())
+}
+
+func TestIsTerminal(t *testing.T) {
+	assert.False(t, isTerminal(&bytes.Buffer{}))
+
+	f, err := ioutil.TempFile("", "music-theory")
+	assert.Nil(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	assert.False(t, isTerminal(f))
+}
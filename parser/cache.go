@@ -0,0 +1,74 @@
+// The cache of a parser keeps the results of the most recently parsed names, discarding the least recently used when full
+package parser
+
+import (
+	"container/list"
+	"sync"
+)
+
+//
+// Private
+//
+
+// cache of results, least recently used last in its list, safe for concurrent use
+type cache struct {
+	mutex   sync.Mutex
+	size    int
+	order   *list.List // of *entry, the most recently used first
+	entries map[cacheKey]*list.Element
+}
+
+// cacheKey of a result, the kind and name that was parsed, e.g. a chord named "Cm7"
+type cacheKey struct {
+	kind Kind
+	name string
+}
+
+// result of parsing a name, e.g. a chord.Chord, and any error, e.g. a *chord.ParseError
+type result struct {
+	value interface{}
+	err   error
+}
+
+type entry struct {
+	key    cacheKey
+	result result
+}
+
+func newCache(size int) *cache {
+	return &cache{size: size, order: list.New(), entries: make(map[cacheKey]*list.Element, size)}
+}
+
+// get the result of a key, if it's cached, marking it the most recently used
+func (c *cache) get(k cacheKey) (result, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if e, ok := c.entries[k]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*entry).result, true
+	}
+	return result{}, false
+}
+
+// put the result of a key in the cache, as the most recently used, discarding the least recently used if it's full
+func (c *cache) put(k cacheKey, r result) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if e, ok := c.entries[k]; ok {
+		e.Value.(*entry).result = r
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[k] = c.order.PushFront(&entry{key: k, result: r})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).key)
+	}
+}
+
+func (c *cache) len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.order.Len()
+}
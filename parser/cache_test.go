@@ -0,0 +1,33 @@
+// The cache of a parser keeps the results of the most recently parsed names, discarding the least recently used when full
+package parser
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestCache_LeastRecentlyUsed(t *testing.T) {
+	c := newCache(2)
+	c.put(cacheKey{Chord, "C"}, result{value: 1})
+	c.put(cacheKey{Chord, "G"}, result{value: 2})
+	_, ok := c.get(cacheKey{Chord, "C"})
+	assert.True(t, ok)
+	c.put(cacheKey{Chord, "F"}, result{value: 3})
+	_, ok = c.get(cacheKey{Chord, "G"})
+	assert.False(t, ok, "the least recently used is discarded")
+	r, ok := c.get(cacheKey{Chord, "C"})
+	assert.True(t, ok)
+	assert.Equal(t, 1, r.value)
+	assert.Equal(t, 2, c.len())
+}
+
+func TestCache_Replace(t *testing.T) {
+	c := newCache(2)
+	c.put(cacheKey{Key, "C"}, result{value: 1})
+	c.put(cacheKey{Key, "C"}, result{value: 2})
+	r, ok := c.get(cacheKey{Key, "C"})
+	assert.True(t, ok)
+	assert.Equal(t, 2, r.value)
+	assert.Equal(t, 1, c.len())
+}
@@ -0,0 +1,27 @@
+// Options of a parser, e.g. to keep the most recent results in a cache, or to count its hits and misses
+package parser
+
+// Option of a parser, e.g. WithCache(1000)
+type Option func(p *Parser)
+
+// Hook called after each name is parsed, with whether its result came from the cache, e.g. to export the hit rate as a metric
+type Hook func(kind Kind, name string, hit bool)
+
+// WithCache of the results of the most recently parsed names, up to a size, of chords, scales and keys together,
+// discarding the least recently used result when full; a size less than 1 caches nothing
+func WithCache(size int) Option {
+	return func(p *Parser) {
+		if size > 0 {
+			p.cache = newCache(size)
+		} else {
+			p.cache = nil
+		}
+	}
+}
+
+// WithHook called after each name is parsed, with whether its result came from the cache
+func WithHook(hook Hook) Option {
+	return func(p *Parser) {
+		p.hook = hook
+	}
+}
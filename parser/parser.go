@@ -0,0 +1,138 @@
+// A parser of the names of chords, scales and keys, which may keep the most recent results in a cache,
+// since an analysis job parses the same few names over and over, e.g. the chord symbols of a corpus of lead sheets.
+//
+// A Parser is safe for concurrent use; each chord or scale it returns has tones of its own, even from the cache.
+//
+// https://en.wikipedia.org/wiki/Cache_replacement_policies#Least_recently_used_(LRU)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package parser
+
+import (
+	"sync/atomic"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Parser of chords, scales and keys, e.g. New(WithCache(1000))
+type Parser struct {
+	hits   uint64 // first, aligned for atomic use
+	misses uint64
+	cache  *cache // or nil if results aren't cached
+	hook   Hook
+}
+
+// New parser, with options, e.g. New(WithCache(1000), WithHook(h))
+func New(options ...Option) *Parser {
+	p := &Parser{}
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// Chord of a name, as chord.Parse, from the cache if it was parsed recently
+func (p *Parser) Chord(name string) (chord.Chord, error) {
+	r := p.resultOf(Chord, name, func() (interface{}, error) { return chord.Parse(name) })
+	if p.cache == nil {
+		return r.value.(chord.Chord), r.err
+	}
+	return r.value.(chord.Chord).Copy(), r.err
+}
+
+// Scale of a name, as scale.Parse, from the cache if it was parsed recently
+func (p *Parser) Scale(name string) (scale.Scale, error) {
+	r := p.resultOf(Scale, name, func() (interface{}, error) { return scale.Parse(name) })
+	if p.cache == nil {
+		return r.value.(scale.Scale), r.err
+	}
+	return r.value.(scale.Scale).Copy(), r.err
+}
+
+// Key of a name, as key.Parse, from the cache if it was parsed recently
+func (p *Parser) Key(name string) (key.Key, error) {
+	r := p.resultOf(Key, name, func() (interface{}, error) { return key.Parse(name) })
+	return r.value.(key.Key), r.err
+}
+
+// Stats of the cache of the parser, its hits and misses so far, and how many results it keeps now
+func (p *Parser) Stats() Stats {
+	s := Stats{Hits: atomic.LoadUint64(&p.hits), Misses: atomic.LoadUint64(&p.misses)}
+	if p.cache != nil {
+		s.Size = p.cache.len()
+	}
+	return s
+}
+
+// Stats of the cache of a parser
+type Stats struct {
+	Hits   uint64 // names whose result was in the cache
+	Misses uint64 // names parsed anew, always, if there's no cache
+	Size   int    // of the results in the cache
+}
+
+// HitRate of the names parsed, from 0 to 1, the hits of all the names, or 0 if none has been parsed yet
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Kind of name that's parsed, e.g. Chord
+type Kind int
+
+const (
+	Chord Kind = iota
+	Scale
+	Key
+)
+
+// String of the kind, e.g. "chord"
+func (of Kind) String() string {
+	switch of {
+	case Chord:
+		return "chord"
+	case Scale:
+		return "scale"
+	case Key:
+		return "key"
+	}
+	return "unknown"
+}
+
+//
+// Private
+//
+
+// resultOf a kind of name, from the cache, or else parsed and kept in it, counting a hit or miss and calling the hook
+func (p *Parser) resultOf(kind Kind, name string, parse func() (interface{}, error)) result {
+	k := cacheKey{kind: kind, name: name}
+	r, hit := result{}, false
+	if p.cache != nil {
+		r, hit = p.cache.get(k)
+	}
+	if hit {
+		atomic.AddUint64(&p.hits, 1)
+	} else {
+		atomic.AddUint64(&p.misses, 1)
+		r.value, r.err = parse()
+		if p.cache != nil {
+			p.cache.put(k, r)
+		}
+	}
+	if p.hook != nil {
+		p.hook(kind, name, hit)
+	}
+	return r
+}
@@ -0,0 +1,162 @@
+// A parser of the names of chords, scales and keys, which may keep the most recent results in a cache
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestParser_Chord(t *testing.T) {
+	p := New(WithCache(10))
+	for n := 0; n < 2; n++ {
+		c, err := p.Chord("Cm7")
+		assert.Nil(t, err)
+		assert.Equal(t, chord.Of("Cm7"), c)
+	}
+	_, err := p.Chord("C jams")
+	assert.True(t, errors.Is(err, chord.ErrUnknownForm))
+	_, err = p.Chord("C jams")
+	assert.True(t, errors.Is(err, chord.ErrUnknownForm), "the error is cached too")
+	assert.Equal(t, Stats{Hits: 2, Misses: 2, Size: 2}, p.Stats())
+}
+
+func TestParser_Scale(t *testing.T) {
+	p := New(WithCache(10))
+	for n := 0; n < 2; n++ {
+		s, err := p.Scale("D dorian")
+		assert.Nil(t, err)
+		assert.Equal(t, scale.Of("D dorian"), s)
+	}
+	assert.Equal(t, Stats{Hits: 1, Misses: 1, Size: 1}, p.Stats())
+}
+
+func TestParser_Key(t *testing.T) {
+	p := New(WithCache(10))
+	for n := 0; n < 2; n++ {
+		k, err := p.Key("Bb minor")
+		assert.Nil(t, err)
+		assert.Equal(t, key.Of("Bb minor"), k)
+	}
+	_, err := p.Key("H major")
+	assert.True(t, errors.Is(err, key.ErrUnknownRoot))
+	assert.Equal(t, Stats{Hits: 1, Misses: 2, Size: 2}, p.Stats())
+}
+
+func TestParser_KindsApart(t *testing.T) {
+	p := New(WithCache(10))
+	_, _ = p.Chord("C")
+	_, _ = p.Scale("C")
+	_, _ = p.Key("C")
+	assert.Equal(t, Stats{Misses: 3, Size: 3}, p.Stats())
+}
+
+func TestParser_Unshared(t *testing.T) {
+	p := New(WithCache(10))
+	c, _ := p.Chord("C7")
+	c.Tones[0].Class = note.D
+	again, _ := p.Chord("C7")
+	assert.Equal(t, chord.Of("C7"), again)
+	s, _ := p.Scale("C major")
+	delete(s.Tones, scale.I7)
+	sAgain, _ := p.Scale("C major")
+	assert.Equal(t, scale.Of("C major"), sAgain)
+}
+
+func TestParser_NoCache(t *testing.T) {
+	p := New()
+	for n := 0; n < 3; n++ {
+		c, err := p.Chord("G7")
+		assert.Nil(t, err)
+		assert.Equal(t, chord.Of("G7"), c)
+	}
+	assert.Equal(t, Stats{Misses: 3}, p.Stats())
+	assert.Equal(t, Stats{Misses: 0}, New(WithCache(0)).Stats())
+}
+
+func TestParser_Hook(t *testing.T) {
+	var events []string
+	p := New(WithCache(10), WithHook(func(kind Kind, name string, hit bool) {
+		events = append(events, fmt.Sprintf("%v %s %v", kind, name, hit))
+	}))
+	_, _ = p.Chord("Cm7")
+	_, _ = p.Chord("Cm7")
+	_, _ = p.Scale("A minor")
+	_, _ = p.Key("F")
+	assert.Equal(t, []string{"chord Cm7 false", "chord Cm7 true", "scale A minor false", "key F false"}, events)
+}
+
+func TestStats_HitRate(t *testing.T) {
+	assert.Equal(t, 0.0, Stats{}.HitRate())
+	assert.Equal(t, 0.75, Stats{Hits: 3, Misses: 1}.HitRate())
+}
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "chord", Chord.String())
+	assert.Equal(t, "scale", Scale.String())
+	assert.Equal(t, "key", Key.String())
+	assert.Equal(t, "unknown", Kind(9).String())
+}
+
+func TestParser_Concurrent(t *testing.T) {
+	p := New(WithCache(4))
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 100; n++ {
+				name := benchmarkNames[n%len(benchmarkNames)]
+				c, err := p.Chord(name)
+				assert.Nil(t, err)
+				assert.Equal(t, chord.Of(name), c)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, uint64(800), p.Stats().Hits+p.Stats().Misses)
+	assert.Equal(t, 4, p.Stats().Size)
+}
+
+func BenchmarkParser_Chord(b *testing.B) {
+	p := New()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Chord(benchmarkNames[i%len(benchmarkNames)])
+	}
+}
+
+func BenchmarkParser_Chord_Cached(b *testing.B) {
+	p := New(WithCache(100))
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Chord(benchmarkNames[i%len(benchmarkNames)])
+	}
+}
+
+func BenchmarkParser_Scale(b *testing.B) {
+	p := New()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Scale("C harmonic minor")
+	}
+}
+
+func BenchmarkParser_Scale_Cached(b *testing.B) {
+	p := New(WithCache(100))
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Scale("C harmonic minor")
+	}
+}
+
+//
+// Private
+//
+
+// benchmarkNames of chords, as found in a lead sheet
+var benchmarkNames = []string{"C", "Cm7", "G7", "Bbmaj7", "F#m7b5", "Ebdim7", "Aaug", "Dsus4", "C13", "Abm9", "E7#9", "Gadd9"}
@@ -0,0 +1,100 @@
+// A pitch class is written as an integer from 0 of C to 11 of B, regardless of its octave or spelling, so that intervals and transpositions are arithmetic modulo 12.
+//
+// https://en.wikipedia.org/wiki/Pitch_class
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package pc
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+// PC is a pitch class, an integer from 0 of C to 11 of B
+type PC int
+
+// Key to spell a pitch class in, e.g. a key.Key, by the fifths of its key signature from C major, the number of sharps if positive, or flats if negative
+type Key interface {
+	Fifths() int
+}
+
+// Of a note.Class, e.g. 0 of note.C or 10 of note.As, and true, or 0 and false of note.Nil
+func Of(class note.Class) (PC, bool) {
+	if class < note.C || class > note.B {
+		return 0, false
+	}
+	return PC(class - note.C), true
+}
+
+// Mod of any number of semitones above C, to a pitch class, e.g. 11 of -1 or 2 of 14
+func Mod(semitones int) PC {
+	return PC((semitones%12 + 12) % 12)
+}
+
+// Add some semitones to a pitch class, up if positive or down if negative, e.g. 2 of Add(11, 3)
+func Add(p PC, semitones int) PC {
+	return Mod(int(p) + semitones)
+}
+
+// Interval up from one pitch class to another, in semitones from 0 to 11, e.g. 7 from C to G, or 5 from G up to C
+func Interval(from, to PC) int {
+	return int(Mod(int(to) - int(from)))
+}
+
+// TransposeSet of pitch classes by some semitones, each in the same order, e.g. 2 6 9 of 0 4 7 by 2
+func TransposeSet(set []PC, semitones int) []PC {
+	transposed := make([]PC, 0, len(set))
+	for _, p := range set {
+		transposed = append(transposed, Add(p, semitones))
+	}
+	return transposed
+}
+
+// Class of the pitch class, e.g. note.As of 10
+func (p PC) Class() note.Class {
+	return note.C + note.Class(Mod(int(p)))
+}
+
+// String of the pitch class, spelled with sharps or flats, e.g. "A#" or "Bb" of 10
+func (p PC) String(with note.AdjSymbol) string {
+	return p.Class().String(with)
+}
+
+// SpelledIn a key, the name of the pitch class with the sharps or flats of its key signature, or sharps if it has neither, e.g. "Bb" of 10 in F major
+func (p PC) SpelledIn(k Key) string {
+	if k.Fifths() < 0 {
+		return p.String(note.Flat)
+	}
+	return p.String(note.Sharp)
+}
+
+// OfSet of the pitch classes of a tone set, in ascending order from C, e.g. 0 4 7 of a C major triad
+func OfSet(s toneset.Set) []PC {
+	set := make([]PC, 0, len(s))
+	for class := range s {
+		if p, ok := Of(class); ok {
+			set = append(set, p)
+		}
+	}
+	sort.Slice(set, func(i, j int) bool { return set[i] < set[j] })
+	return set
+}
+
+// ToneSet of some pitch classes, e.g. to find the chords or scales containing them
+func ToneSet(set []PC) toneset.Set {
+	s := toneset.Of()
+	for _, p := range set {
+		s[p.Class()] = true
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+// A pitch class is written as an integer from 0 of C to 11 of B, regardless of its octave or spelling, so that intervals and transpositions are arithmetic modulo 12.
+package pc
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestOf(t *testing.T) {
+	p, ok := Of(note.C)
+	assert.True(t, ok)
+	assert.Equal(t, PC(0), p)
+	p, ok = Of(note.As)
+	assert.True(t, ok)
+	assert.Equal(t, PC(10), p)
+	p, ok = Of(note.B)
+	assert.True(t, ok)
+	assert.Equal(t, PC(11), p)
+	_, ok = Of(note.Nil)
+	assert.False(t, ok)
+}
+
+func TestMod(t *testing.T) {
+	assert.Equal(t, PC(11), Mod(-1))
+	assert.Equal(t, PC(2), Mod(14))
+	assert.Equal(t, PC(0), Mod(-24))
+}
+
+func TestAdd(t *testing.T) {
+	assert.Equal(t, PC(2), Add(11, 3))
+	assert.Equal(t, PC(9), Add(0, -3))
+	assert.Equal(t, PC(4), Add(4, 12))
+}
+
+func TestInterval(t *testing.T) {
+	assert.Equal(t, 7, Interval(0, 7))
+	assert.Equal(t, 5, Interval(7, 0))
+	assert.Equal(t, 0, Interval(3, 3))
+	assert.Equal(t, 1, Interval(11, 0))
+}
+
+func TestTransposeSet(t *testing.T) {
+	assert.Equal(t, []PC{2, 6, 9}, TransposeSet([]PC{0, 4, 7}, 2))
+	assert.Equal(t, []PC{11, 3, 6}, TransposeSet([]PC{0, 4, 7}, -1))
+	assert.Equal(t, []PC{}, TransposeSet(nil, 5))
+}
+
+func TestPC_Class(t *testing.T) {
+	assert.Equal(t, note.C, PC(0).Class())
+	assert.Equal(t, note.As, PC(10).Class())
+	assert.Equal(t, note.B, PC(-1).Class())
+}
+
+func TestPC_String(t *testing.T) {
+	assert.Equal(t, "A#", PC(10).String(note.Sharp))
+	assert.Equal(t, "Bb", PC(10).String(note.Flat))
+	assert.Equal(t, "E", PC(4).String(note.Flat))
+}
+
+func TestPC_SpelledIn(t *testing.T) {
+	assert.Equal(t, "Bb", PC(10).SpelledIn(key.Of("F")))
+	assert.Equal(t, "A#", PC(10).SpelledIn(key.Of("B")))
+	assert.Equal(t, "C#", PC(1).SpelledIn(key.Of("C")))
+}
+
+func TestOfSet(t *testing.T) {
+	assert.Equal(t, []PC{0, 4, 7}, OfSet(toneset.Of(note.G, note.C, note.E)))
+	assert.Equal(t, []PC{}, OfSet(toneset.Of()))
+}
+
+func TestToneSet(t *testing.T) {
+	assert.True(t, toneset.Of(note.D, note.Fs, note.A).Equal(ToneSet([]PC{2, 6, 9})))
+	assert.True(t, ToneSet(OfSet(toneset.Of(note.Cs, note.F))).Equal(toneset.Of(note.Cs, note.F)))
+}
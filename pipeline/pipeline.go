@@ -0,0 +1,188 @@
+// A pipeline composes the stages of a workflow over a song, from a lead sheet parsed, through analyses and transformations of it, to rendering it in some format, e.g.
+//
+//     err := pipeline.New().Parse(leadsheet).DetectKey().Transpose(2).Render(os.Stdout, render.MusicXML)
+//
+// so that no combination of stages needs glue code of its own. Once a stage fails, every stage after it is skipped, and its error is returned at the end of the pipeline.
+//
+// https://en.wikipedia.org/wiki/Pipeline_(software)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package pipeline
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/harmonize"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/render"
+	"github.com/go-music-theory/music-theory/song"
+)
+
+var (
+	// ErrNothingParsed when a stage runs before any song is parsed, or given From
+	ErrNothingParsed = errors.New("nothing parsed")
+
+	// ErrNothingToDetect when detecting the key of a song that has neither chords nor notes
+	ErrNothingToDetect = errors.New("no chords or notes to detect the key of")
+)
+
+// Pipeline of stages over a song, each changing the pipeline it's called on and returning it, to chain the next
+type Pipeline struct {
+	song   song.Song
+	parsed bool
+	err    error
+}
+
+// New pipeline, of nothing until a song is parsed, e.g. New().Parse(leadsheet), or given, e.g. New().From(s)
+func New() *Pipeline {
+	return &Pipeline{}
+}
+
+// Parse a lead sheet, the MusicXML of a score if it begins with "<", or else the YAML of a song, replacing any song parsed before
+func (p *Pipeline) Parse(leadsheet string) *Pipeline {
+	return p.from(func() (song.Song, error) {
+		if strings.HasPrefix(strings.TrimSpace(leadsheet), "<") {
+			return song.ReadMusicXML(strings.NewReader(leadsheet))
+		}
+		return song.Load(strings.NewReader(leadsheet))
+	})
+}
+
+// Chords named, of a song of a bar of 4/4 each, in one section, as an unmarked score is, without a key until one is detected,
+// e.g. Chords("Dm7", "G7", "Cmaj7").DetectKey()
+func (p *Pipeline) Chords(names ...string) *Pipeline {
+	return p.from(func() (song.Song, error) {
+		var bars []song.Bar
+		for _, name := range names {
+			if _, err := chord.Parse(name); err != nil {
+				return song.Song{}, err
+			}
+			bars = append(bars, song.BarOf(4, name))
+		}
+		s := song.New("", key.Key{})
+		s.Add(song.UnmarkedSection, bars...)
+		return s, nil
+	})
+}
+
+// From a song, e.g. read from a file, replacing any song parsed before
+func (p *Pipeline) From(s song.Song) *Pipeline {
+	return p.from(func() (song.Song, error) {
+		return s, nil
+	})
+}
+
+// DetectKey of the song, replacing any key it had, the key that best fits the tones of its chords, or if it has none, of the notes of its melody
+func (p *Pipeline) DetectKey() *Pipeline {
+	return p.Then(func(s song.Song) (song.Song, error) {
+		switch {
+		case len(s.Progression().Chords) > 0:
+			s.Key = s.Progression().Key()
+		case len(s.Notes) > 0:
+			s.Key = harmonize.KeyOf(s.Notes)
+		default:
+			return s, ErrNothingToDetect
+		}
+		return s, nil
+	})
+}
+
+// Validate the song, its tempo, its time signature, and that every chord is placed within the beats of its bar
+func (p *Pipeline) Validate() *Pipeline {
+	return p.Then(func(s song.Song) (song.Song, error) {
+		return s, s.Validate()
+	})
+}
+
+// Transpose the song some semitones, up if positive or down if negative, its key, every chord and every note of its melody
+func (p *Pipeline) Transpose(semitones int) *Pipeline {
+	return p.Then(func(s song.Song) (song.Song, error) {
+		return s.Transposed(semitones), nil
+	})
+}
+
+// Simplify every chord of the song to a level, in its key if it has one, e.g. Simplify(chord.SimplifyTriads) of a chart for a beginner
+func (p *Pipeline) Simplify(level chord.SimplifyLevel) *Pipeline {
+	return p.Then(func(s song.Song) (song.Song, error) {
+		return s.Simplified(level), nil
+	})
+}
+
+// Then a stage of any transformation of the song, e.g. Then(func(s song.Song) (song.Song, error) { s.Tempo /= 2; return s, nil }),
+// which stops the pipeline if it fails
+func (p *Pipeline) Then(stage func(s song.Song) (song.Song, error)) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	if !p.parsed {
+		p.err = ErrNothingParsed
+		return p
+	}
+	s, err := stage(p.song)
+	if err != nil {
+		p.err = err
+		return p
+	}
+	p.song = s
+	return p
+}
+
+// Song at the end of the pipeline, or the error of the stage that failed
+func (p *Pipeline) Song() (song.Song, error) {
+	if p.err == nil && !p.parsed {
+		return song.Song{}, ErrNothingParsed
+	}
+	return p.song, p.err
+}
+
+// Err of the stage that failed, or nil if none has
+func (p *Pipeline) Err() error {
+	return p.err
+}
+
+// Render the song at the end of the pipeline to a writer, in a format of the render registry, with any options, the song itself in a format that renders songs,
+// e.g. YAML or JSON, or else the melody of it, if it has one, or else its progression, e.g. Render(os.Stdout, render.MusicXML),
+// or the error of the stage that failed
+func (p *Pipeline) Render(w io.Writer, format string, options ...render.Option) error {
+	s, err := p.Song()
+	if err != nil {
+		return err
+	}
+	err = render.To(w, format, s, options...)
+	if !errors.Is(err, render.ErrUnsupported) {
+		return err
+	}
+	if len(s.Notes) > 0 {
+		return render.To(w, format, s.Tune(), options...)
+	}
+	return render.To(w, format, s.Progression(), options...)
+}
+
+//
+// Private
+//
+
+// from a song parsed, the source of the pipeline, unless a stage before it failed
+func (p *Pipeline) from(parse func() (song.Song, error)) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	s, err := parse()
+	if err != nil {
+		p.err = err
+		return p
+	}
+	p.song, p.parsed = s, true
+	return p
+}
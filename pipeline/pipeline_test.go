@@ -0,0 +1,138 @@
+// A pipeline composes the stages of a workflow over a song, from a lead sheet parsed, through analyses and transformations of it, to rendering it in some format, e.g.
+package pipeline
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/render"
+	"github.com/go-music-theory/music-theory/song"
+)
+
+func TestPipeline_Parse(t *testing.T) {
+	s, err := New().Parse(exampleLeadSheet).DetectKey().Transpose(2).Song()
+	assert.Nil(t, err)
+	assert.Equal(t, "Example", s.Title)
+	assert.Equal(t, "E Minor", s.Key.Canonical())
+	assert.Equal(t, "Am7 D7", s.Sections[0].Bars[0].String(4))
+	assert.Equal(t, "Gmaj7", s.Sections[0].Bars[1].String(4))
+	assert.Equal(t, "F#m7b5 B7", s.Sections[1].Bars[0].String(4))
+}
+
+func TestPipeline_Parse_MusicXML(t *testing.T) {
+	s, err := New().Parse(`
+<score-partwise version="3.1"><part id="P1"><measure number="1">
+<harmony><root><root-step>F</root-step></root><kind>major</kind></harmony>
+<note><pitch><step>A</step><octave>4</octave></pitch><duration>4</duration><type>whole</type></note>
+</measure></part></score-partwise>`).Transpose(-1).Song()
+	assert.Nil(t, err)
+	assert.Equal(t, "E", s.Sections[0].Bars[0].String(4))
+	assert.Equal(t, []melody.Note{{Class: note.Gs, Octave: 4, Beats: 4}}, s.Notes)
+}
+
+func TestPipeline_Chords(t *testing.T) {
+	s, err := New().Chords("Dm7", "G7", "Cmaj7").DetectKey().Song()
+	assert.Nil(t, err)
+	assert.Equal(t, key.Of("C major"), s.Key)
+	assert.Equal(t, []song.Section{{Name: song.UnmarkedSection, Bars: []song.Bar{song.BarOf(4, "Dm7"), song.BarOf(4, "G7"), song.BarOf(4, "Cmaj7")}}}, s.Sections)
+	_, err = New().Chords("Dm7", "Hb").Song()
+	assert.True(t, errors.Is(err, chord.ErrUnknownRoot))
+}
+
+func TestPipeline_From(t *testing.T) {
+	s := song.New("Tune", key.Key{})
+	s.Notes = []melody.Note{{Class: note.A, Octave: 4, Beats: 1}, {Class: note.C, Octave: 5, Beat: 1, Beats: 1}, {Class: note.E, Octave: 5, Beat: 2, Beats: 1}, {Class: note.A, Octave: 4, Beat: 3, Beats: 1}}
+	detected, err := New().From(s).DetectKey().Song()
+	assert.Nil(t, err)
+	assert.Equal(t, "A Minor", detected.Key.Canonical(), "of the melody, without chords")
+	assert.Equal(t, key.Key{}, s.Key)
+	_, err = New().From(song.New("Empty", key.Key{})).DetectKey().Song()
+	assert.True(t, errors.Is(err, ErrNothingToDetect))
+}
+
+func TestPipeline_Simplify(t *testing.T) {
+	s, err := New().Parse(exampleLeadSheet).DetectKey().Simplify(chord.SimplifyTriads).Transpose(2).Song()
+	assert.Nil(t, err)
+	assert.Equal(t, "Am D", s.Sections[0].Bars[0].String(4))
+	assert.Equal(t, "G", s.Sections[0].Bars[1].String(4))
+	assert.Equal(t, "F#dim B", s.Sections[1].Bars[0].String(4))
+	assert.True(t, errors.Is(New().Simplify(chord.SimplifyTriads).Err(), ErrNothingParsed))
+}
+
+func TestPipeline_Then(t *testing.T) {
+	halved := func(s song.Song) (song.Song, error) {
+		s.Tempo /= 2
+		return s, nil
+	}
+	s, err := New().Chords("C").Then(halved).Then(halved).Song()
+	assert.Nil(t, err)
+	assert.Equal(t, 30.0, s.Tempo)
+	failed := errors.New("failed")
+	ran := false
+	p := New().Chords("C").Then(func(s song.Song) (song.Song, error) { return s, failed }).Then(func(s song.Song) (song.Song, error) {
+		ran = true
+		return s, nil
+	})
+	assert.Equal(t, failed, p.Err())
+	assert.False(t, ran, "every stage after a failure skipped")
+	_, err = p.Chords("G").Song()
+	assert.Equal(t, failed, err, "even a source")
+}
+
+func TestPipeline_Validate(t *testing.T) {
+	s, _ := New().Parse(exampleLeadSheet).Song()
+	s.Tempo = 0
+	err := New().From(s).Validate().Transpose(1).Err()
+	assert.True(t, errors.Is(err, song.ErrInvalidTempo))
+	assert.Nil(t, New().Parse(exampleLeadSheet).Validate().Err())
+}
+
+func TestPipeline_NothingParsed(t *testing.T) {
+	_, err := New().Song()
+	assert.True(t, errors.Is(err, ErrNothingParsed))
+	assert.True(t, errors.Is(New().Transpose(2).Err(), ErrNothingParsed))
+	assert.True(t, errors.Is(New().Render(&bytes.Buffer{}, render.YAML), ErrNothingParsed))
+	_, err = New().Parse("meter: waltz\n").Song()
+	assert.True(t, errors.Is(err, meter.ErrInvalidMeter))
+}
+
+func TestPipeline_Render(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, New().Parse(exampleLeadSheet).Transpose(2).Render(&buf, render.YAML))
+	assert.Contains(t, buf.String(), "title: Example")
+	assert.Contains(t, buf.String(), "Am7 D7")
+	buf.Reset()
+	assert.Nil(t, New().Chords("C", "G7").Transpose(2).Render(&buf, render.MusicXML), "a song that can't be rendered, as its progression")
+	assert.Contains(t, buf.String(), "<step>D</step>")
+	assert.Contains(t, buf.String(), "<step>A</step>")
+	buf.Reset()
+	s := song.New("Tune", key.Of("C major"))
+	s.Add("A", song.BarOf(4, "C"))
+	s.Notes = []melody.Note{{Class: note.E, Octave: 4, Beats: 4}}
+	assert.Nil(t, New().From(s).Transpose(5).Render(&buf, render.ABC), "as its melody")
+	assert.True(t, strings.HasPrefix(buf.String(), "X:1\nT:Tune\n"), buf.String())
+	assert.Contains(t, buf.String(), "K:F")
+	assert.True(t, errors.Is(New().From(s).Render(&buf, "tab"), render.ErrUnknownFormat))
+}
+
+//
+// Private
+//
+
+// exampleLeadSheet of a song in D minor, of a verse and a chorus
+const exampleLeadSheet = `title: Example
+sections:
+- name: verse
+  bars: [Gm7 C7, Fmaj7]
+- name: chorus
+  bars: [Em7b5 A7, Dm]
+`
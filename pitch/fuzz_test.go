@@ -0,0 +1,34 @@
+//go:build go1.18
+// +build go1.18
+
+// Pitches are parsed from any text without panicking, e.g. from the arguments of the command line or the query of a request
+package pitch
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func FuzzParse(f *testing.F) {
+	for _, text := range []string{"A#3", "C#-1", "Bb10", "c4", " D♯6 ", "F𝄪2", "Dx2", "E𝄳4", "D half-flat 4", "Cb4", "H4", "A#", "C11", "C99999999999999999999"} {
+		f.Add(text)
+	}
+	f.Fuzz(func(t *testing.T, text string) {
+		p, err := Parse(text)
+		if err != nil {
+			if !errors.Is(err, ErrUnknownNote) && !errors.Is(err, ErrNoOctave) && !errors.Is(err, ErrOctaveRange) {
+				t.Errorf("text:%q error %v is none of the errors of a pitch", text, err)
+			}
+			return
+		}
+		if p.Class < note.C || p.Class > note.B {
+			t.Errorf("text:%q class is %d", text, int(p.Class))
+		}
+		if hz := p.Frequency(440); math.IsNaN(hz) || math.IsInf(hz, 0) || hz <= 0 {
+			t.Errorf("text:%q frequency is %v", text, hz)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+// Pitches are parsed from scientific pitch notation, e.g. A#3 or c4, with typed errors for an unknown note or an octave out of range
+package pitch
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Range of octaves in scientific pitch notation, from C-1 (MIDI note 0) to B10
+const (
+	MinOctave = -1
+	MaxOctave = 10
+)
+
+var (
+	// ErrUnknownNote when a pitch doesn't begin with a note letter and accidentals, e.g. "H4"
+	ErrUnknownNote = errors.New("unknown note")
+
+	// ErrNoOctave when a pitch doesn't end with an octave, e.g. "A#"
+	ErrNoOctave = errors.New("no octave")
+
+	// ErrOctaveRange when the octave of a pitch is outside of MinOctave to MaxOctave, e.g. "C11"
+	ErrOctaveRange = errors.New("out of range octave")
+)
+
+// Pitch of a note, in a particular octave, e.g. A#3
+type Pitch struct {
+	Class  note.Class
+	Octave note.Octave
+	Cents  int // deviation from the pitch of the class, e.g. -50 for a half-flat
+}
+
+// Parse a pitch in scientific pitch notation, e.g. Parse("A#3"), with its letter in either case, e.g. "c4",
+// any accidentals in ASCII or unicode, e.g. "B♭10", "F𝄪2" or "Dx2", any quarter-tone accidental, e.g. "E𝄳4" or "D half-flat 4",
+// and an octave from MinOctave to MaxOctave, e.g. "C#-1", which may be subscript, e.g. "C₄".
+// An accidental beyond B or below C changes the octave, e.g. "Cb4" is B3.
+func Parse(text string) (Pitch, error) {
+	s := strings.TrimSpace(symbol.Normalize(text))
+	m := rgxPitch.FindStringSubmatch(s)
+	if m == nil {
+		if rgxPitchName.MatchString(s) {
+			return Pitch{}, fmt.Errorf("%w in %q", ErrNoOctave, text)
+		}
+		return Pitch{}, fmt.Errorf("%w %q", ErrUnknownNote, text)
+	}
+	octave, err := strconv.Atoi(m[4])
+	if err != nil || octave < MinOctave || octave > MaxOctave {
+		return Pitch{}, fmt.Errorf("%w %s of %q, expected %d to %d", ErrOctaveRange, m[4], text, MinOctave, MaxOctave)
+	}
+	step := letterSteps[strings.ToUpper(m[1])] + alterOf(m[2]) + octave*12 - 1
+	return Pitch{
+		Class:  note.Class((step%12+12)%12 + 1),
+		Octave: note.Octave(floorDiv(step, 12)),
+		Cents:  note.CentsOf(m[3]),
+	}, nil
+}
+
+// Frequency of the pitch in Hz, with A4 tuned to some Hz, e.g. 440
+func (p Pitch) Frequency(tuning float64) float64 {
+	return OfStepAndCents(int(p.Class)+int(p.Octave)*12, p.Cents, tuning)
+}
+
+//
+// Private
+//
+
+var (
+	rgxPitch, _     = regexp.Compile(`^([A-Ga-g])([#bx]*)\s*((?i:half[- ]?(?:sharp|flat))|𝄲|𝄳)?\s*(-?[0-9]+)$`)
+	rgxPitchName, _ = regexp.Compile(`^([A-Ga-g])([#bx]*)\s*((?i:half[- ]?(?:sharp|flat))|𝄲|𝄳)?$`)
+)
+
+// letterSteps from C0, like a note.Class
+var letterSteps = map[string]int{"C": 1, "D": 3, "E": 5, "F": 6, "G": 8, "A": 10, "B": 12}
+
+// alterOf accidentals in ASCII, in semitones, e.g. -1 for b or +2 for x
+func alterOf(accidentals string) (alter int) {
+	for _, r := range accidentals {
+		switch r {
+		case '#':
+			alter++
+		case 'b':
+			alter--
+		case 'x':
+			alter += 2
+		}
+	}
+	return
+}
+
+// floorDiv of a by b, rounding down, so that any number of accidentals below C steps down an octave, e.g. -1 for -1 / 12
+func floorDiv(a, b int) int {
+	if a < 0 && a%b != 0 {
+		return a/b - 1
+	}
+	return a / b
+}
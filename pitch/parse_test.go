@@ -0,0 +1,84 @@
+package pitch
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestParse(t *testing.T) {
+	assertParse(t, Pitch{Class: note.As, Octave: 3}, "A#3")
+	assertParse(t, Pitch{Class: note.Cs, Octave: -1}, "C#-1")
+	assertParse(t, Pitch{Class: note.As, Octave: 10}, "Bb10")
+	assertParse(t, Pitch{Class: note.C, Octave: 4}, "c4")
+	assertParse(t, Pitch{Class: note.As, Octave: 4}, "bb4")
+	assertParse(t, Pitch{Class: note.B, Octave: 4}, "b4")
+	assertParse(t, Pitch{Class: note.Ds, Octave: 6}, " D♯6 ")
+	assertParse(t, Pitch{Class: note.As, Octave: 2}, "B♭2")
+	assertParse(t, Pitch{Class: note.G, Octave: 2}, "F𝄪2")
+	assertParse(t, Pitch{Class: note.E, Octave: 2}, "Dx2")
+	assertParse(t, Pitch{Class: note.A, Octave: 5}, "B𝄫5")
+	assertParse(t, Pitch{Class: note.E, Octave: 3}, "E♮3")
+	assertParse(t, Pitch{Class: note.C, Octave: 4}, "C₄")
+	assertParse(t, Pitch{Class: note.As, Octave: -1}, "A♯₋₁")
+}
+
+func TestParse_OctaveShift(t *testing.T) {
+	assertParse(t, Pitch{Class: note.B, Octave: 3}, "Cb4")
+	assertParse(t, Pitch{Class: note.C, Octave: 5}, "B#4")
+	assertParse(t, Pitch{Class: note.B, Octave: -2}, "Cb-1")
+	assertParse(t, Pitch{Class: note.C, Octave: -16}, "C"+strings.Repeat("𝄫", 120)+"4")
+	assertParse(t, Pitch{Class: note.B, Octave: -17}, "C"+strings.Repeat("𝄫", 120)+"b4")
+}
+
+func TestParse_QuarterTone(t *testing.T) {
+	assertParse(t, Pitch{Class: note.E, Octave: 4, Cents: -50}, "E𝄳4")
+	assertParse(t, Pitch{Class: note.D, Octave: 4, Cents: -50}, "D half-flat 4")
+	assertParse(t, Pitch{Class: note.Fs, Octave: 3, Cents: 50}, "F# Half Sharp3")
+}
+
+func TestParse_Errors(t *testing.T) {
+	assertParseError(t, ErrUnknownNote, `unknown note "H4"`, "H4")
+	assertParseError(t, ErrUnknownNote, `unknown note ""`, "")
+	assertParseError(t, ErrUnknownNote, `unknown note "C 7th"`, "C 7th")
+	assertParseError(t, ErrNoOctave, `no octave in "A#"`, "A#")
+	assertParseError(t, ErrOctaveRange, `out of range octave 11 of "C11", expected -1 to 10`, "C11")
+	assertParseError(t, ErrOctaveRange, `out of range octave -2 of "C-2", expected -1 to 10`, "C-2")
+	assertParseError(t, ErrOctaveRange, `out of range octave 99999999999999999999 of "C99999999999999999999", expected -1 to 10`, "C99999999999999999999")
+}
+
+func TestPitch_Frequency(t *testing.T) {
+	assert.Equal(t, 440.0, Pitch{Class: note.A, Octave: 4}.Frequency(440))
+	assert.Equal(t, 8.18, Pitch{Class: note.C, Octave: -1}.Frequency(440))
+	assert.Equal(t, 320.24, Pitch{Class: note.E, Octave: 4, Cents: -50}.Frequency(440))
+}
+
+func TestOfNote_Errors(t *testing.T) {
+	p, err := OfNote("C11", 440)
+	assert.Equal(t, "n/a", p)
+	assert.True(t, errors.Is(err, ErrOctaveRange))
+	_, err = OfClassAndOctave("H", "4", 440)
+	assert.True(t, errors.Is(err, ErrUnknownNote))
+	_, err = OfClassAndOctave("C", "four", 440)
+	assert.NotNil(t, err)
+}
+
+//
+// Private
+//
+
+func assertParse(t *testing.T, expect Pitch, text string) {
+	p, err := Parse(text)
+	assert.Nil(t, err, text)
+	assert.Equal(t, expect, p, text)
+}
+
+func assertParseError(t *testing.T, expect error, expectMessage string, text string) {
+	_, err := Parse(text)
+	assert.True(t, errors.Is(err, expect), text)
+	assert.Equal(t, expectMessage, err.Error())
+}
@@ -1,46 +1,66 @@
 package pitch
 
 import (
+	"fmt"
 	"math"
 	"strconv"
-
-	"github.com/go-music-theory/music-theory/note"
+	"strings"
 )
 
 var A4Num = 58 // step no from C0
 
-func OfNote(name string, tuning int) (string, error) {
-	class := note.ClassNamed(name)
-	octave := note.OctaveOf(name)
-	return format(calcPitch(class, int(octave), tuning))
+func OfNote(name string, tuning float64) (string, error) {
+	return OfNoteWithPrecision(name, tuning, DefaultPrecision)
 }
 
-func OfClassAndOctave(class string, octaveStr string, tuning int) (string, error) {
-	root, class := note.RootAndRemaining(class)
-
-	octave, err := strconv.Atoi(octaveStr)
+// OfNoteWithPrecision formats the frequency of a note to some decimal places, from 0 to MaxPrecision, e.g. OfNoteWithPrecision("C4", 415, 3) is "246.760Hz"
+func OfNoteWithPrecision(name string, tuning float64, precision int) (string, error) {
+	if precision < 0 || precision > MaxPrecision {
+		return format(-1, precision, fmt.Errorf("%w: %d, expected 0 to %d", ErrPrecisionRange, precision, MaxPrecision))
+	}
+	p, err := Parse(name)
 	if err != nil {
-		return format(-1, err)
+		return format(-1, precision, err)
 	}
+	return format(exactOf(int(p.Class)+int(p.Octave)*12, p.Cents, tuning), precision, nil)
+}
 
-	return format(calcPitch(root, octave, tuning))
+func OfClassAndOctave(class string, octaveStr string, tuning float64) (string, error) {
+	if _, err := strconv.Atoi(octaveStr); err != nil {
+		return format(-1, DefaultPrecision, err)
+	}
+	return OfNote(strings.TrimSpace(class)+octaveStr, tuning)
 }
 
-func calcPitch(note note.Class, octave int, tuning int) (float64, error) {
-	stepNo := int(note) + octave*12
+// OfStep number from C0, e.g. A4Num, returns its frequency in Hz
+func OfStep(stepNo int, tuning float64) float64 {
 	diffFromA4 := abs(A4Num - stepNo)
 	magnitude := math.Pow(math.Pow(2, 1.0/12), float64(diffFromA4))
 
 	if stepNo < A4Num {
-		return round(float64(tuning) / magnitude), nil
+		return round(tuning / magnitude)
 	} else {
-		return round(float64(tuning) * magnitude), nil
+		return round(tuning * magnitude)
 	}
 }
 
-func format(pitch float64, err error) (string, error) {
+// OfStepAndCents number from C0, and cents from that step, e.g. -50 for a half-flat, returns its frequency in Hz
+func OfStepAndCents(stepNo int, cents int, tuning float64) float64 {
+	if cents == 0 {
+		return OfStep(stepNo, tuning)
+	}
+	return round(exactOf(stepNo, cents, tuning))
+}
+
+// exactOf a step number from C0, and cents from that step, its frequency in Hz without rounding
+func exactOf(stepNo int, cents int, tuning float64) float64 {
+	semitones := float64(stepNo-A4Num) + float64(cents)/100
+	return tuning * math.Pow(2, semitones/12)
+}
+
+func format(pitch float64, precision int, err error) (string, error) {
 	if err == nil {
-		return strconv.FormatFloat(pitch, 'f', 2, 64) + "Hz", nil
+		return Format(pitch, precision), nil
 	} else {
 		return "n/a", err
 	}
@@ -32,14 +32,43 @@ func TestPitchOfNote(t *testing.T) {
 	assertPitchOfNote(t, "864.00Hz", "A5", 432)
 }
 
-func assertPitchOfClassAndOctave(t *testing.T, expected string, class string, octave string, tuning int) {
+func assertPitchOfClassAndOctave(t *testing.T, expected string, class string, octave string, tuning float64) {
 	actual, err := OfClassAndOctave(class, octave, tuning)
 	assert.Nil(t, err)
 	assert.Equal(t, expected, actual)
 }
 
-func assertPitchOfNote(t *testing.T, expected string, name string, tuning int) {
+func assertPitchOfNote(t *testing.T, expected string, name string, tuning float64) {
 	actual, err := OfNote(name, tuning)
 	assert.Nil(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestOfStep(t *testing.T) {
+	assert.Equal(t, 440.0, OfStep(A4Num, 440))
+	assert.Equal(t, 261.63, OfStep(49, 440))
+	assert.Equal(t, 880.0, OfStep(A4Num+12, 440))
+	assert.Equal(t, 216.0, OfStep(A4Num-12, 432))
+}
+
+func TestOfStepAndCents(t *testing.T) {
+	assert.Equal(t, 440.0, OfStepAndCents(A4Num, 0, 440))
+	assert.Equal(t, 452.89, OfStepAndCents(A4Num, 50, 440))
+	assert.Equal(t, 427.47, OfStepAndCents(A4Num, -50, 440))
+	assert.Equal(t, OfStep(A4Num+1, 440), OfStepAndCents(A4Num, 100, 440))
+}
+
+func TestPitchOfNote_QuarterTone(t *testing.T) {
+	assertPitchOfNote(t, "320.24Hz", "E𝄳4", 440)
+	assertPitchOfNote(t, "285.30Hz", "D half-flat4", 440)
+	assertPitchOfNote(t, "452.89Hz", "A half-sharp 4", 440)
+	assertPitchOfClassAndOctave(t, "285.30Hz", "D half-flat", "4", 440)
+	assertPitchOfClassAndOctave(t, "320.24Hz", "E𝄳", "4", 440)
+}
+
+func TestPitchOfNote_CaseAndUnicode(t *testing.T) {
+	assertPitchOfNote(t, "261.63Hz", "c4", 440)
+	assertPitchOfNote(t, "233.08Hz", "B♭3", 440)
+	assertPitchOfNote(t, "8.66Hz", "C#-1", 440)
+	assertPitchOfClassAndOctave(t, "261.63Hz", "c", "4", 440)
+}
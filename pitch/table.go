@@ -0,0 +1,126 @@
+// A frequency table lists every tone of a scale or chord in each octave of a range, with its MIDI note number and frequency in Hz
+package pitch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+// ErrInvalidRange when a range of octaves can't be parsed, e.g. "5..3" or "high"
+var ErrInvalidRange = errors.New("invalid range")
+
+// Tones of anything with a set of pitch classes, e.g. a scale.Scale or chord.Chord
+type Tones interface {
+	ToneSet() toneset.Set
+}
+
+// RangeOptions of a table, the octaves from and to, both inclusive, the tuning of A4 in Hz, and the spelling of its notes
+type RangeOptions struct {
+	From      note.Octave
+	To        note.Octave
+	Tuning    float64 // Standard if 0
+	AdjSymbol note.AdjSymbol
+}
+
+// Table of the frequencies of some tones, in ascending order
+type Table struct {
+	Tuning    float64
+	AdjSymbol note.AdjSymbol
+	Rows      []Row
+}
+
+// Row of a table, a tone in an octave, its MIDI note number, e.g. 60 for middle C, and frequency in Hz
+type Row struct {
+	Class     note.Class
+	Octave    note.Octave
+	MIDI      int
+	Frequency float64
+}
+
+// String of the row's note in scientific pitch notation, e.g. "C#4", spelled with an adjustment symbol
+func (r Row) String(with note.AdjSymbol) string {
+	return r.Class.String(with) + strconv.Itoa(int(r.Octave))
+}
+
+// TableFor the tones of a scale or chord, in each octave of a range, e.g. TableFor(scale.Of("A minor"), RangeOptions{From: 3, To: 5})
+func TableFor(tones Tones, opts RangeOptions) Table {
+	t := Table{Tuning: opts.Tuning, AdjSymbol: opts.AdjSymbol}
+	if t.Tuning == 0 {
+		t.Tuning = Standard
+	}
+	classes := tones.ToneSet().Classes()
+	for octave := opts.From; octave <= opts.To; octave++ {
+		for _, class := range classes {
+			step := int(class) + int(octave)*12
+			t.Rows = append(t.Rows, Row{
+				Class:     note.Class(class),
+				Octave:    octave,
+				MIDI:      int(octave+1)*12 + int(class) - 1,
+				Frequency: OfStep(step, t.Tuning),
+			})
+		}
+	}
+	return t
+}
+
+// ParseRange of octaves, from and to, both inclusive, e.g. "3..5", or a single octave, e.g. "4"
+func ParseRange(text string) (from, to note.Octave, err error) {
+	m := rgxRange.FindStringSubmatch(text)
+	if m == nil {
+		return 0, 0, fmt.Errorf("%w %q, expected e.g. 3..5", ErrInvalidRange, text)
+	}
+	f, _ := strconv.Atoi(m[1])
+	t := f
+	if len(m[2]) > 0 {
+		t, _ = strconv.Atoi(m[2])
+	}
+	if f < MinOctave || t > MaxOctave || f > t {
+		return 0, 0, fmt.Errorf("%w %q, expected ascending octaves from %d to %d", ErrInvalidRange, text, MinOctave, MaxOctave)
+	}
+	return note.Octave(f), note.Octave(t), nil
+}
+
+// ToYAML of the Table, e.g. for the command-line utility
+func (t Table) ToYAML() string {
+	out, _ := yaml.Marshal(specTableFrom(t))
+	return string(out[:])
+}
+
+// ToJSON of the Table, e.g. for a web app
+func (t Table) ToJSON() string {
+	out, _ := json.Marshal(specTableFrom(t))
+	return string(out[:])
+}
+
+//
+// Private
+//
+
+var rgxRange, _ = regexp.Compile(`^\s*(-?[0-9]{1,2})\s*(?:\.\.\s*(-?[0-9]{1,2}))?\s*$`)
+
+type specTable struct {
+	Tuning float64   `yaml:"tuning" json:"tuning"`
+	Rows   []specRow `yaml:"rows" json:"rows"`
+}
+
+type specRow struct {
+	Note      string  `yaml:"note" json:"note"`
+	MIDI      int     `yaml:"midi" json:"midi"`
+	Frequency float64 `yaml:"frequency" json:"frequency"`
+}
+
+func specTableFrom(t Table) specTable {
+	s := specTable{Tuning: t.Tuning, Rows: []specRow{}}
+	for _, r := range t.Rows {
+		s.Rows = append(s.Rows, specRow{Note: r.String(t.AdjSymbol), MIDI: r.MIDI, Frequency: r.Frequency})
+	}
+	return s
+}
@@ -0,0 +1,90 @@
+// A frequency table lists every tone of a scale or chord in each octave of a range, with its MIDI note number and frequency in Hz
+package pitch
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestTableFor(t *testing.T) {
+	table := TableFor(tones(toneset.Of(1, 5, 8)), RangeOptions{From: 3, To: 4}) // C E G
+	assert.Equal(t, Standard, table.Tuning)
+	assert.Equal(t, []Row{
+		{Class: note.C, Octave: 3, MIDI: 48, Frequency: 130.81},
+		{Class: note.E, Octave: 3, MIDI: 52, Frequency: 164.81},
+		{Class: note.G, Octave: 3, MIDI: 55, Frequency: 196},
+		{Class: note.C, Octave: 4, MIDI: 60, Frequency: 261.63},
+		{Class: note.E, Octave: 4, MIDI: 64, Frequency: 329.63},
+		{Class: note.G, Octave: 4, MIDI: 67, Frequency: 392},
+	}, table.Rows)
+}
+
+func TestTableFor_Tuning(t *testing.T) {
+	table := TableFor(tones(toneset.Of(10)), RangeOptions{From: -1, To: 5, Tuning: 432}) // A
+	assert.Equal(t, 7, len(table.Rows))
+	assert.Equal(t, Row{Class: note.A, Octave: -1, MIDI: 9, Frequency: 13.5}, table.Rows[0])
+	assert.Equal(t, Row{Class: note.A, Octave: 4, MIDI: 69, Frequency: 432}, table.Rows[5])
+}
+
+func TestTableFor_NoTones(t *testing.T) {
+	assert.Equal(t, 0, len(TableFor(tones(toneset.Of()), RangeOptions{From: 3, To: 5}).Rows))
+}
+
+func TestTable_ToYAML(t *testing.T) {
+	table := TableFor(tones(toneset.Of(2, 10)), RangeOptions{From: 4, To: 4, AdjSymbol: note.Flat}) // Db A
+	assert.Equal(t, `tuning: 440
+rows:
+- note: Db4
+  midi: 61
+  frequency: 277.18
+- note: A4
+  midi: 69
+  frequency: 440
+`, table.ToYAML())
+}
+
+func TestTable_ToJSON(t *testing.T) {
+	table := TableFor(tones(toneset.Of(2)), RangeOptions{From: 4, To: 4, AdjSymbol: note.Sharp}) // C#
+	assert.Equal(t, `{"tuning":440,"rows":[{"note":"C#4","midi":61,"frequency":277.18}]}`, table.ToJSON())
+	assert.Equal(t, `{"tuning":440,"rows":[]}`, TableFor(tones(toneset.Of()), RangeOptions{}).ToJSON())
+}
+
+func TestParseRange(t *testing.T) {
+	assertParseRange(t, 3, 5, "3..5")
+	assertParseRange(t, 4, 4, "4")
+	assertParseRange(t, -1, 10, " -1 .. 10 ")
+}
+
+func TestParseRange_Invalid(t *testing.T) {
+	for _, text := range []string{"5..3", "high", "", "3..", "-2..4", "3..11", "3-5"} {
+		_, _, err := ParseRange(text)
+		assert.True(t, errors.Is(err, ErrInvalidRange), text)
+	}
+	_, _, err := ParseRange("5..3")
+	assert.Equal(t, `invalid range "5..3", expected ascending octaves from -1 to 10`, err.Error())
+	_, _, err = ParseRange("high")
+	assert.Equal(t, `invalid range "high", expected e.g. 3..5`, err.Error())
+}
+
+//
+// Private
+//
+
+// tones of a set of pitch classes, e.g. of a scale or chord
+type tones toneset.Set
+
+func (t tones) ToneSet() toneset.Set {
+	return toneset.Set(t)
+}
+
+func assertParseRange(t *testing.T, expectFrom, expectTo note.Octave, text string) {
+	from, to, err := ParseRange(text)
+	assert.Nil(t, err, text)
+	assert.Equal(t, expectFrom, from, text)
+	assert.Equal(t, expectTo, to, text)
+}
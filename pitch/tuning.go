@@ -0,0 +1,67 @@
+// Tuning is the reference frequency of A4 in Hz, e.g. 440 for concert pitch, or one of the named historical presets
+package pitch
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Standard tuning of A4 in Hz, i.e. concert pitch
+const Standard = 440.0
+
+// DefaultPrecision of a formatted frequency, in decimal places
+const DefaultPrecision = 2
+
+// MaxPrecision of a formatted frequency, in decimal places, far finer than any difference of pitch that can be heard
+const MaxPrecision = 10
+
+// ErrPrecisionRange when formatting a frequency to fewer than 0, or more than MaxPrecision, decimal places, e.g. 50000000
+var ErrPrecisionRange = errors.New("precision out of range")
+
+// ErrUnknownTuning when a tuning is neither a preset nor a positive number of Hz, e.g. "loud"
+var ErrUnknownTuning = errors.New("unknown tuning")
+
+// Tunings of A4 in Hz, by the name of their preset
+var Tunings = map[string]float64{
+	"baroque":   415,
+	"classical": 430,
+	"verdi":     432,
+	"standard":  440,
+	"chorton":   466,
+}
+
+// TuningNames of the presets, from the lowest to the highest
+func TuningNames() []string {
+	var names []string
+	for name := range Tunings {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return Tunings[names[i]] < Tunings[names[j]] })
+	return names
+}
+
+// TuningOf some text, either the name of a preset, e.g. "baroque", or a positive number of Hz, e.g. "432" or "415.3Hz"
+func TuningOf(text string) (float64, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if tuning, ok := Tunings[text]; ok {
+		return tuning, nil
+	}
+	tuning, err := strconv.ParseFloat(strings.TrimSuffix(text, "hz"), 64)
+	if err != nil || tuning <= 0 {
+		return 0, fmt.Errorf("%w %q", ErrUnknownTuning, text)
+	}
+	return tuning, nil
+}
+
+// Format a frequency in Hz to some decimal places, from 0 to MaxPrecision, e.g. Format(261.6256, 3) is "261.626Hz"
+func Format(hz float64, precision int) string {
+	if precision < 0 {
+		precision = 0
+	} else if precision > MaxPrecision {
+		precision = MaxPrecision
+	}
+	return strconv.FormatFloat(hz, 'f', precision, 64) + "Hz"
+}
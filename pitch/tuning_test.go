@@ -0,0 +1,85 @@
+// Tuning is the reference frequency of A4 in Hz, e.g. 440 for concert pitch, or one of the named historical presets
+package pitch
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestTuningOf(t *testing.T) {
+	assertTuningOf(t, 440, "440")
+	assertTuningOf(t, 432, "verdi")
+	assertTuningOf(t, 415, "Baroque")
+	assertTuningOf(t, 430, " classical ")
+	assertTuningOf(t, 440, "standard")
+	assertTuningOf(t, 466, "chorton")
+	assertTuningOf(t, 415.3, "415.3")
+	assertTuningOf(t, 442, "442Hz")
+}
+
+func TestTuningOf_Unknown(t *testing.T) {
+	for _, text := range []string{"loud", "", "0", "-440", "Hz"} {
+		_, err := TuningOf(text)
+		assert.True(t, errors.Is(err, ErrUnknownTuning), text)
+	}
+	_, err := TuningOf("loud")
+	assert.Equal(t, `unknown tuning "loud"`, err.Error())
+}
+
+func TestTuningNames(t *testing.T) {
+	assert.Equal(t, []string{"baroque", "classical", "verdi", "standard", "chorton"}, TuningNames())
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "261.63Hz", Format(261.6255653, DefaultPrecision))
+	assert.Equal(t, "261.626Hz", Format(261.6255653, 3))
+	assert.Equal(t, "262Hz", Format(261.6255653, 0))
+	assert.Equal(t, "262Hz", Format(261.6255653, -1))
+	assert.Equal(t, "261.6255653000Hz", Format(261.6255653, 50000000))
+}
+
+func TestOfNote_FloatTuning(t *testing.T) {
+	assertPitchOfNote(t, "415.00Hz", "A4", 415)
+	assertPitchOfNote(t, "415.30Hz", "A4", 415.3)
+	assertPitchOfNote(t, "256.87Hz", "C4", 432)
+	assertPitchOfClassAndOctave(t, "207.50Hz", "A", "3", Tunings["baroque"])
+}
+
+func TestOfNoteWithPrecision(t *testing.T) {
+	assertPitchOfNoteWithPrecision(t, "261.626Hz", "C4", 440, 3)
+	assertPitchOfNoteWithPrecision(t, "261.6256Hz", "C4", 440, 4)
+	assertPitchOfNoteWithPrecision(t, "246.760Hz", "C4", 415, 3)
+	assertPitchOfNoteWithPrecision(t, "440Hz", "A4", 440, 0)
+	p, err := OfNoteWithPrecision("H4", 440, 3)
+	assert.Equal(t, "n/a", p)
+	assert.True(t, errors.Is(err, ErrUnknownNote))
+	assertPitchOfNoteWithPrecision(t, "440.0000000000Hz", "A4", 440, MaxPrecision)
+	for _, precision := range []int{-1, MaxPrecision + 1, 50000000} {
+		p, err = OfNoteWithPrecision("A4", 440, precision)
+		assert.Equal(t, "n/a", p)
+		assert.True(t, errors.Is(err, ErrPrecisionRange))
+	}
+}
+
+func TestOfStep_FloatTuning(t *testing.T) {
+	assert.Equal(t, 415.0, OfStep(A4Num, 415))
+	assert.Equal(t, 207.65, OfStep(A4Num-12, 415.3))
+}
+
+//
+// Private
+//
+
+func assertTuningOf(t *testing.T, expect float64, text string) {
+	tuning, err := TuningOf(text)
+	assert.Nil(t, err, text)
+	assert.Equal(t, expect, tuning, text)
+}
+
+func assertPitchOfNoteWithPrecision(t *testing.T, expected string, name string, tuning float64, precision int) {
+	actual, err := OfNoteWithPrecision(name, tuning, precision)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,44 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-music-theory/music-theory/rhythm"
+)
+
+// writePolyrhythm of a ratio, e.g. "3:4", at some beats per minute of its second voice, writing its grid, where it aligns, and the time of each pulse to the millisecond,
+// and a click track of some cycles as MIDI to a file at a path, if any
+func writePolyrhythm(w io.Writer, ratio string, bpm float64, cycles int, midiPath string) error {
+	a, b, err := rhythm.Parse(ratio)
+	if err != nil {
+		return err
+	}
+	c, err := rhythm.Polyrhythm(a, b, bpm)
+	if err != nil {
+		return err
+	}
+	if len(midiPath) > 0 {
+		if err = writeTuneFile(midiPath, func(w io.Writer) error { return c.WriteMIDI(w, cycles) }); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(w, c.String())
+	var aligned []string
+	for _, step := range c.Alignments {
+		aligned = append(aligned, strconv.Itoa(step+1))
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "STEPS\t%d\nCYCLE\t%s\nALIGNED\t%s\n\n", c.Steps, c.Duration(), strings.Join(aligned, " "))
+	fmt.Fprintln(tw, "VOICE\tPULSE\tSTEP\tTIME")
+	voices := [2]int{a, b}
+	for _, p := range c.Pulses {
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%s\n", voices[p.Voice], p.Index+1, p.Step+1, p.Time.Round(time.Millisecond))
+	}
+	return tw.Flush()
+}
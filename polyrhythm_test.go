@@ -0,0 +1,54 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/rhythm"
+)
+
+func TestWritePolyrhythm(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, writePolyrhythm(&out, "3:2", 120, 1, ""))
+	assert.Equal(t, "3    x.x|.x.\n2    x..|x..\n3:2  X.x|xx.\n\n"+
+		"STEPS    6\nCYCLE    1s\nALIGNED  1\n\n"+
+		"VOICE  PULSE  STEP  TIME\n"+
+		"3      1      1     0s\n"+
+		"2      1      1     0s\n"+
+		"3      2      3     333ms\n"+
+		"2      2      4     500ms\n"+
+		"3      3      5     667ms\n", out.String())
+	assert.True(t, errors.Is(writePolyrhythm(&out, "3/4", 120, 1, ""), rhythm.ErrInvalidRatio))
+	assert.True(t, errors.Is(writePolyrhythm(&out, "3:4", 0, 1, ""), rhythm.ErrTempoRange))
+}
+
+func TestWritePolyrhythm_MIDI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "polyrhythm")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "click.mid")
+	var out bytes.Buffer
+	assert.Nil(t, writePolyrhythm(&out, "3:4", 100, 2, path))
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+	notes, err := midi.Read(f)
+	assert.Nil(t, err)
+	assert.Equal(t, 14, len(notes))
+	assert.NotNil(t, writePolyrhythm(&out, "3:4", 100, 2, filepath.Join(dir, "missing", "click.mid")))
+}
+
+func TestPolyrhythmExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "polyrhythm")
+	assertExitCode(t, 0, "", "polyrhythm", "--bpm", "100", "3:4")
+	assertExitCode(t, 1, "Error occurred: invalid ratio \"3/4\", expected e.g. 3:4\n", "polyrhythm", "3/4")
+	assertExitCode(t, 1, "Error occurred: out of range pulses 40, expected 1 to 16\n", "polyrhythm", "3:40")
+}
@@ -0,0 +1,58 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-music-theory/music-theory/exercise"
+	"github.com/go-music-theory/music-theory/render"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// practiceScale named, in a pattern named, one of the exercise.PatternNames, at a tempo, or the default of 0, up and down some octaves,
+// writing the exercise as ABC notation with the finger suggested for each note, and as MIDI to a file at a path, if any
+func practiceScale(w io.Writer, scaleName string, patternName string, tempo float64, octaves int, midiPath string) error {
+	if err := atLeastOne("octaves", octaves); err != nil {
+		return err
+	}
+	s, err := scale.Parse(scaleName)
+	if err != nil {
+		return err
+	}
+	p, err := exercise.PatternNamed(patternName)
+	if err != nil {
+		return err
+	}
+	tune := exercise.Generate(s, p, exercise.WithTempo(tempo), exercise.WithOctaves(octaves))
+	tune.Title = scaleName + " in " + p.String()
+	if len(midiPath) > 0 {
+		if err = writeTuneFile(midiPath, tune.WriteMIDI); err != nil {
+			return err
+		}
+	}
+	return render.To(w, render.ABC, tune)
+}
+
+// atLeastOne of some count named, e.g. octaves, or an error of a count less than 1
+func atLeastOne(name string, count int) error {
+	if count < 1 {
+		return fmt.Errorf("out of range %s %d, expected at least 1", name, count)
+	}
+	return nil
+}
+
+// writeTuneFile at a path, written by a function, e.g. the WriteMIDI of a tune
+func writeTuneFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = write(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
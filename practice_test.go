@@ -0,0 +1,50 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/exercise"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestPracticeScale(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, practiceScale(&out, "C major", "straight", 80, 1, ""))
+	assert.Equal(t, "X:1\nT:C major in straight\nM:4/4\nL:1/8\nQ:1/4=80\nK:C\n"+
+		"!1!C !2!D !3!E !1!F !2!G !3!A !4!B !5!c | !4!B !3!A !2!G !1!F !3!E !2!D !1!C2 |]\n", out.String())
+	err := practiceScale(&out, "C major", "sixths", 80, 1, "")
+	assert.True(t, errors.Is(err, exercise.ErrUnknownPattern))
+}
+
+func TestPracticeScale_MIDI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "practice")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "thirds.mid")
+	var out bytes.Buffer
+	assert.Nil(t, practiceScale(&out, "G major", "thirds", 0, 2, path))
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+	notes, err := midi.Read(f)
+	assert.Nil(t, err)
+	assert.Equal(t, 52, len(notes))
+	assert.Equal(t, 67, notes[0].Number)
+	assert.NotNil(t, practiceScale(&out, "G major", "thirds", 0, 1, filepath.Join(dir, "missing", "thirds.mid")))
+}
+
+func TestPracticeExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "practice")
+	assertExitCode(t, 0, "", "practice", "--pattern", "arpeggios", "--tempo", "60", "D minor")
+	assertExitCode(t, 1, "Error occurred: unknown pattern \"sixths\", expected one of straight, thirds, fourths, arpeggios\n", "practice", "-p", "sixths", "G major")
+	assertExitCode(t, 1, "Error occurred: out of range octaves 0, expected at least 1\n", "practice", "--octaves", "0", "G major")
+	assertExitCode(t, 1, "Error occurred: out of range octaves -5, expected at least 1\n", "practice", "--octaves", "-5", "G major")
+}
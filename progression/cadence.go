@@ -0,0 +1,106 @@
+// The Cadence that ends a Progression is detected by its final two chords, in each of the keys it may be in, ranked by how well each fits and the confidence of the key,
+// e.g. an authentic cadence in C major of G to C, or else a half cadence in F major
+package progression
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+// CadenceKind of the final two chords of a progression, in a key
+type CadenceKind int
+
+const (
+	Authentic CadenceKind = iota // the dominant, or the leading tone, to the tonic
+	Plagal                       // the subdominant to the tonic
+	Half                         // anything to the dominant
+	Deceptive                    // the dominant to the submediant, or the subdominant
+)
+
+// String of the CadenceKind, e.g. "authentic" or "plagal"
+func (of CadenceKind) String() string {
+	switch of {
+	case Authentic:
+		return "authentic"
+	case Plagal:
+		return "plagal"
+	case Half:
+		return "half"
+	case Deceptive:
+		return "deceptive"
+	}
+	return ""
+}
+
+// Cadence of a kind, in a key, e.g. a half cadence in A minor
+type Cadence struct {
+	Kind CadenceKind
+	Key  key.Key
+}
+
+// String of the Cadence, its kind and key, e.g. "authentic cadence in C major"
+func (c Cadence) String() string {
+	return c.Kind.String() + " cadence in " + c.Key.Root.String(c.Key.AdjSymbol) + " " + strings.ToLower(c.Key.Mode.String())
+}
+
+// Cadences that may end the Progression, of its final two chords in each of its candidate keys, ranked by how well each fits, weighed by the confidence of its key,
+// with its confidence among them all, or none for fewer than two chords or none that fit, e.g. an authentic cadence in C major before a half cadence in F major of C F G C
+func (p Progression) Cadences() []candidate.Candidate[Cadence] {
+	var candidates []candidate.Candidate[Cadence]
+	for _, k := range p.Keys() {
+		for _, c := range p.CadencesIn(k.Value) {
+			candidates = append(candidates, candidate.Of(c.Value, c.Score*k.Confidence))
+		}
+	}
+	return candidate.Rank(candidates)
+}
+
+// CadencesIn a key, of the final two chords of the Progression, ranked by how well each fits, from 0 to 1 of a textbook cadence, e.g. 1 of V to I, or a half of vii to I,
+// with its confidence among them all, or none for fewer than two chords, either of them without a root, e.g. of a name that didn't parse, or none that fit
+func (p Progression) CadencesIn(k key.Key) []candidate.Candidate[Cadence] {
+	if len(p.Chords) < 2 || k.Root == note.Nil || p.Chords[len(p.Chords)-2].Root == note.Nil || p.Chords[len(p.Chords)-1].Root == note.Nil {
+		return nil
+	}
+	from := (k.Root.Diff(p.Chords[len(p.Chords)-2].Root) + 12) % 12
+	to := (k.Root.Diff(p.Chords[len(p.Chords)-1].Root) + 12) % 12
+	var candidates []candidate.Candidate[Cadence]
+	for _, fit := range cadenceFits {
+		if fit.from >= 0 && fit.from != from || fit.to != to || fit.mode != key.Nil && fit.mode != k.Mode || fit.kind == Half && from == to {
+			continue
+		}
+		candidates = append(candidates, candidate.Of(Cadence{Kind: fit.kind, Key: k}, fit.score))
+	}
+	ranked := candidate.Rank(candidates)
+	if trace.Enabled() {
+		trace.Ranked("progression.cadence", p.names(), ranked, Cadence.String)
+	}
+	return ranked
+}
+
+//
+// Private
+//
+
+// cadenceFit of a kind of cadence, by the semitones up from the tonic of the roots of its final two chords, from any chord of -1,
+// in a mode of key, or any of Nil, and how well it fits, from 0 to 1
+type cadenceFit struct {
+	kind     CadenceKind
+	from, to int
+	mode     key.Mode
+	score    float64
+}
+
+// cadenceFits of every kind of cadence, e.g. of the submediant 9 semitones up of a major key or 8 of a minor key
+var cadenceFits = []cadenceFit{
+	{Authentic, 7, 0, key.Nil, 1},
+	{Authentic, 11, 0, key.Nil, 0.5},
+	{Plagal, 5, 0, key.Nil, 1},
+	{Half, -1, 7, key.Nil, 1},
+	{Deceptive, 7, 9, key.Major, 1},
+	{Deceptive, 7, 8, key.Minor, 1},
+	{Deceptive, 7, 5, key.Nil, 0.5},
+}
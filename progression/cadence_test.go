@@ -0,0 +1,65 @@
+// The Cadence that ends a Progression is detected by its final two chords, in each of the keys it may be in, ranked by how well each fits and the confidence of the key,
+// e.g. an authentic cadence in C major of G to C, or else a half cadence in F major
+package progression
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/key"
+)
+
+func TestCadenceKind_String(t *testing.T) {
+	assert.Equal(t, "authentic", Authentic.String())
+	assert.Equal(t, "plagal", Plagal.String())
+	assert.Equal(t, "half", Half.String())
+	assert.Equal(t, "deceptive", Deceptive.String())
+}
+
+func TestProgression_Cadences(t *testing.T) {
+	cadences := Of("C", "F", "G", "C").Cadences()
+	assert.Equal(t, []string{"authentic cadence in C major", "half cadence in F major", "authentic cadence in C minor", "half cadence in F minor"}, cadenceNamesOf(cadences))
+	assert.InDelta(t, 0.33, cadences[0].Confidence, 0.01)
+	cadences = Of("Dm7", "G7").Cadences()
+	assert.Equal(t, "half cadence in C major", cadences[0].Value.String())
+	assert.Equal(t, "authentic cadence in G minor", cadences[1].Value.String()) // as likely, of a fifth down to a final G
+	assert.Equal(t, cadences[0].Confidence, cadences[1].Confidence)
+	assert.Nil(t, Of("C").Cadences())
+	assert.Nil(t, Of("G", "X").Cadences())
+	assert.Nil(t, Of("X", "C").Cadences())
+}
+
+func TestProgression_CadencesIn(t *testing.T) {
+	assertCadences(t, "C major", []string{"authentic cadence in C major"}, "Dm7", "G7", "C")
+	assertCadences(t, "C major", []string{"plagal cadence in C major"}, "C", "F", "C")
+	assertCadences(t, "C major", []string{"half cadence in C major"}, "C", "Am", "Dm", "G")
+	assertCadences(t, "C major", []string{"deceptive cadence in C major"}, "C", "F", "G7", "Am")
+	assertCadences(t, "A minor", []string{"deceptive cadence in A minor"}, "Am", "Dm", "E7", "F")
+	assertCadences(t, "C major", []string{"deceptive cadence in C major"}, "C", "G", "F")
+	assertCadences(t, "C major", nil, "G", "G")
+	assertCadences(t, "C major", nil, "C", "Em")
+	assertCadences(t, "C major", nil, "C")
+	assertCadences(t, "C major", nil, "G", "X")
+	assertCadences(t, "", nil, "G", "C")
+	cadences := Of("C", "F", "Bdim", "C").CadencesIn(key.Of("C major"))
+	assert.Equal(t, Cadence{Kind: Authentic, Key: key.Of("C major")}, cadences[0].Value)
+	assert.Equal(t, 0.5, cadences[0].Score) // of the leading tone, not the dominant
+	assert.Equal(t, 1.0, cadences[0].Confidence)
+}
+
+//
+// Private
+//
+
+func assertCadences(t *testing.T, keyName string, expect []string, names ...string) {
+	assert.Equal(t, expect, cadenceNamesOf(Of(names...).CadencesIn(key.Of(keyName))), names)
+}
+
+func cadenceNamesOf(cadences []candidate.Candidate[Cadence]) (names []string) {
+	for _, c := range cadences {
+		names = append(names, c.Value.String())
+	}
+	return
+}
@@ -0,0 +1,110 @@
+// A corpus of classic progressions is built in, each tagged by its genre and era, e.g. the ii-V-I of jazz or the twelve-bar blues,
+// to list, or to train a model of the progressions of a genre
+package progression
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/key"
+)
+
+// ErrUnknownGenre when naming a genre that isn't in the corpus, e.g. "polka"
+var ErrUnknownGenre = errors.New("unknown genre")
+
+// Classic progression of the corpus, by its name, genre and era, and a song of its chords in a key
+type Classic struct {
+	Name  string // e.g. "ii-V-I"
+	Genre string // e.g. "jazz"
+	Era   string // when it became common, e.g. "1930s"
+	Song
+}
+
+// Corpus of classic progressions built in, of every genre, in order of genre and then era
+func Corpus() []Classic {
+	classics := make([]Classic, 0, len(corpus))
+	for _, c := range corpus {
+		k := key.Of(c.key)
+		p := Of(strings.Fields(c.chords)...)
+		for i := range p.Chords {
+			p.Chords[i] = p.Chords[i].SpelledIn(k)
+		}
+		classics = append(classics, Classic{Name: c.name, Genre: c.genre, Era: c.era, Song: Song{Key: k, Progression: p}})
+	}
+	return classics
+}
+
+// CorpusOf a genre, e.g. "jazz", the classic progressions built in of only that genre, or of every genre if empty,
+// returning ErrUnknownGenre if none is of the genre
+func CorpusOf(genre string) ([]Classic, error) {
+	if len(genre) == 0 {
+		return Corpus(), nil
+	}
+	var classics []Classic
+	for _, c := range Corpus() {
+		if strings.EqualFold(c.Genre, genre) {
+			classics = append(classics, c)
+		}
+	}
+	if len(classics) == 0 {
+		return nil, fmt.Errorf("%w %q, expected one of %s", ErrUnknownGenre, genre, strings.Join(Genres(), ", "))
+	}
+	return classics, nil
+}
+
+// Genres of the corpus, in alphabetical order
+func Genres() []string {
+	seen := make(map[string]bool)
+	var genres []string
+	for _, c := range corpus {
+		if !seen[c.genre] {
+			seen[c.genre] = true
+			genres = append(genres, c.genre)
+		}
+	}
+	sort.Strings(genres)
+	return genres
+}
+
+// Songs of some classic progressions, e.g. to Train a model on those of a genre
+func Songs(classics []Classic) []Song {
+	songs := make([]Song, 0, len(classics))
+	for _, c := range classics {
+		songs = append(songs, c.Song)
+	}
+	return songs
+}
+
+//
+// Private
+//
+
+// corpus of classic progressions, each of the names of its chords in a key, separated by spaces
+var corpus = []struct {
+	name, genre, era, key, chords string
+}{
+	{"twelve-bar blues", "blues", "1910s", "C", "C7 C7 C7 C7 F7 F7 C7 C7 G7 F7 C7 G7"},
+	{"quick-change blues", "blues", "1920s", "C", "C7 F7 C7 C7 F7 F7 C7 C7 G7 F7 C7 G7"},
+	{"minor blues", "blues", "1940s", "C minor", "Cm7 Cm7 Cm7 Cm7 Fm7 Fm7 Cm7 Cm7 Abmaj7 G7 Cm7 G7"},
+	{"romanesca", "classical", "1550s", "C", "C G Am Em F C F G"},
+	{"Pachelbel's canon", "classical", "1680s", "D", "D A Bm F#m G D G A"},
+	{"authentic cadence", "classical", "1700s", "C", "C F G C"},
+	{"circle of fifths", "classical", "1700s", "A minor", "Am Dm G C F Bdim E Am"},
+	{"Andalusian cadence", "classical", "1800s", "A minor", "Am G F E"},
+	{"ii-V-I", "jazz", "1930s", "C", "Dm7 G7 Cmaj7"},
+	{"minor ii-V-i", "jazz", "1930s", "A minor", "Bm7b5 E7 Am7"},
+	{"I-vi-ii-V turnaround", "jazz", "1930s", "C", "Cmaj7 Am7 Dm7 G7"},
+	{"iii-vi-ii-V-I", "jazz", "1940s", "C", "Em7 Am7 Dm7 G7 Cmaj7"},
+	{"backdoor ii-V", "jazz", "1940s", "C", "Fm7 Bb7 Cmaj7"},
+	{"doo-wop", "pop", "1950s", "C", "C Am F G"},
+	{"axis", "pop", "2000s", "C", "C G Am F"},
+	{"sensitive female", "pop", "2000s", "C", "Am F C G"},
+	{"I-IV-vi-V", "pop", "2010s", "G", "G C Em D"},
+	{"three-chord rock", "rock", "1950s", "G", "G C D G"},
+	{"Mixolydian vamp", "rock", "1960s", "D", "D C G D"},
+	{"Aeolian vamp", "rock", "1970s", "A minor", "Am F G Am"},
+	{"minor descent", "rock", "1970s", "A minor", "Am G F G"},
+	{"I-bIII-IV", "rock", "1990s", "E", "E G A E"},
+}
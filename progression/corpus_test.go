@@ -0,0 +1,60 @@
+// A corpus of classic progressions is built in, each tagged by its genre and era, e.g. the ii-V-I of jazz or the twelve-bar blues,
+// to list, or to train a model of the progressions of a genre
+package progression
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestCorpus(t *testing.T) {
+	classics := Corpus()
+	assert.True(t, len(classics) > 0)
+	for _, c := range classics {
+		assert.NotEmpty(t, c.Name)
+		assert.Contains(t, Genres(), c.Genre)
+		assert.NotEmpty(t, c.Era)
+		assert.NotEmpty(t, c.Progression.Chords, c.Name)
+		assert.NotContains(t, c.Numerals(), "?", c.Name)
+	}
+}
+
+func TestCorpusOf(t *testing.T) {
+	jazz, err := CorpusOf("Jazz")
+	assert.Nil(t, err)
+	for _, c := range jazz {
+		assert.Equal(t, "jazz", c.Genre)
+	}
+	assert.Equal(t, "ii-V-I", jazz[0].Name)
+	assert.Equal(t, []string{"ii7", "V7", "Imaj7"}, jazz[0].Numerals())
+	assert.Equal(t, []string{"Dm7", "G7", "CM7"}, namesOf(jazz[0].Progression))
+	all, err := CorpusOf("")
+	assert.Nil(t, err)
+	assert.Equal(t, len(Corpus()), len(all))
+	_, err = CorpusOf("polka")
+	assert.True(t, errors.Is(err, ErrUnknownGenre))
+	assert.Contains(t, err.Error(), "blues, classical, jazz, pop, rock")
+}
+
+func TestGenres(t *testing.T) {
+	assert.Equal(t, []string{"blues", "classical", "jazz", "pop", "rock"}, Genres())
+}
+
+func TestSongs(t *testing.T) {
+	rock, err := CorpusOf("rock")
+	assert.Nil(t, err)
+	songs := Songs(rock)
+	assert.Equal(t, len(rock), len(songs))
+	assert.Equal(t, rock[0].Song, songs[0])
+	m := Train(songs, 1)
+	assert.Equal(t, 8, len(m.Sample(rock[0].Key, 8, 1).Chords))
+}
+
+func TestClassic_Numerals(t *testing.T) {
+	pachelbel := Corpus()[4]
+	assert.Equal(t, "Pachelbel's canon", pachelbel.Name)
+	assert.Equal(t, []string{"I", "V", "vi", "iii", "IV", "I", "IV", "V"}, pachelbel.Numerals())
+	assert.Equal(t, []string{"D", "A", "Bm", "F#m", "G", "D", "G", "A"}, namesOf(pachelbel.Progression))
+}
@@ -0,0 +1,94 @@
+// The Key of a Progression is estimated by the scale that contains the most of its tones.
+package progression
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+// Key that best fits all the tones of the Progression, favoring a key whose root begins or ends the progression
+func (p Progression) Key() key.Key {
+	best, _ := candidate.Best(p.Keys())
+	return best
+}
+
+// Keys of every major and minor key, ranked by how well each fits all the tones of the Progression, with its confidence among them all,
+// favoring a key whose root begins or ends the progression, or none for no chords
+func (p Progression) Keys() []candidate.Candidate[key.Key] {
+	if len(p.Chords) == 0 {
+		return nil
+	}
+
+	adjSymbol := p.adjSymbol()
+	var candidates []candidate.Candidate[key.Key]
+	for _, root := range keyRoots {
+		for _, mode := range keyModes {
+			name := root.String(adjSymbol) + " " + mode
+			candidates = append(candidates, candidate.Of(key.Of(name), float64(p.scoreIn(scale.Of(name)))))
+		}
+	}
+	ranked := candidate.Rank(candidates)
+	if trace.Enabled() {
+		trace.Ranked("progression.key", p.names(), ranked, key.Key.Canonical)
+	}
+	return ranked
+}
+
+//
+// Private
+//
+
+var (
+	keyRoots = []note.Class{note.C, note.Cs, note.D, note.Ds, note.E, note.F, note.Fs, note.G, note.Gs, note.A, note.As, note.B}
+	keyModes = []string{"major", "minor"}
+)
+
+// scoreIn counts each chord tone found in the scale, plus one each if the scale root begins or ends the progression
+func (p Progression) scoreIn(s scale.Scale) (score int) {
+	classes := make(map[note.Class]bool)
+	for _, class := range s.Tones {
+		classes[class] = true
+	}
+	for _, c := range p.Chords {
+		for _, t := range c.Tones {
+			if classes[t.Class] {
+				score++
+			}
+		}
+	}
+	if p.Chords[0].Root == s.Root {
+		score++
+	}
+	if p.Chords[len(p.Chords)-1].Root == s.Root {
+		score++
+	}
+	return
+}
+
+// names of the chords in the progression, e.g. "Dm7 G7 C"
+func (p Progression) names() string {
+	names := make([]string, len(p.Chords))
+	for n, c := range p.Chords {
+		names[n] = c.Name()
+	}
+	return strings.Join(names, " ")
+}
+
+// adjSymbol of most of the chords in the progression, with sharps by default
+func (p Progression) adjSymbol() note.AdjSymbol {
+	numFlats := 0
+	for _, c := range p.Chords {
+		if c.AdjSymbol == note.Flat {
+			numFlats++
+		}
+	}
+	if numFlats*2 > len(p.Chords) {
+		return note.Flat
+	}
+	return note.Sharp
+}
@@ -0,0 +1,52 @@
+// The Key of a Progression is estimated by the scale that contains the most of its tones.
+package progression
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, key.Of("C major"), Of("Dm7", "G7", "C").Key())
+	assert.Equal(t, key.Of("C major"), Of("C", "F", "G", "C").Key())
+	assert.Equal(t, key.Of("A minor"), Of("Am", "F", "C", "G").Key())
+	assert.Equal(t, key.Of("A major"), Of("A", "D", "E", "A").Key())
+	assert.Equal(t, key.Of("Bb major"), Of("Eb", "F7", "Bb").Key())
+}
+
+func TestKey_Empty(t *testing.T) {
+	assert.Equal(t, key.Key{}, Of().Key())
+}
+
+func TestKeys(t *testing.T) {
+	keys := Of("Dm7", "G7", "C").Keys()
+	assert.Equal(t, 24, len(keys))
+	assert.Equal(t, key.Of("C major"), keys[0].Value)
+	assert.Equal(t, 12.0, keys[0].Score)
+	assert.Equal(t, key.Of("D minor"), keys[1].Value)
+	assert.Equal(t, key.Of("A minor"), keys[2].Value)
+	assert.True(t, keys[0].Confidence > keys[1].Confidence)
+	var sum float64
+	for _, k := range keys {
+		sum += k.Confidence
+	}
+	assert.InDelta(t, 1, sum, 1e-9)
+	assert.Nil(t, Of().Keys())
+}
+
+func TestKeys_Traced(t *testing.T) {
+	var events []trace.Event
+	previous := trace.Set(trace.HookFunc(func(e trace.Event) {
+		if e.Scope == "progression.key" {
+			events = append(events, e)
+		}
+	}))
+	defer trace.Set(previous)
+	keys := Of("Dm7", "G7", "C").Keys()
+	assert.Equal(t, 24, len(events))
+	assert.Equal(t, trace.Event{Scope: "progression.key", Input: "Dm7 G7 C", Rule: "C Major", Score: keys[0].Score}, events[0])
+}
@@ -0,0 +1,221 @@
+// A Markov model of chord progressions learns from a corpus of songs how often each Roman numeral follows the numerals before it,
+// and samples new progressions in the same style, in any key
+package progression
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/roman"
+)
+
+// Start of each song, padding the history of its first numerals
+const Start = "^"
+
+// ErrInvalidModel loaded, of an order less than 1, or a history not of that many numerals
+var ErrInvalidModel = errors.New("invalid model")
+
+// Song of a corpus, a progression and the key it's in, e.g. of a song.Song, Song{Key: s.Key, Progression: s.Progression()}
+type Song struct {
+	Key         key.Key
+	Progression Progression
+}
+
+// Numerals of the chords of the song, each in its key, e.g. "ii7", "V7" and "Imaj7" of the ii-V-I, or "?" of a chord neither diatonic nor borrowed
+func (s Song) Numerals() []string {
+	numerals := make([]string, 0, len(s.Progression.Chords))
+	chords := chordsByNumeral(s.Key)
+	for _, c := range s.Progression.Chords {
+		numeral, ok := numeralIn(s.Key, c, chords)
+		if !ok {
+			numeral = "?"
+		}
+		numerals = append(numerals, numeral)
+	}
+	return numerals
+}
+
+// Model of the Roman numerals of a corpus, of an order, the number of numerals before each one that it depends on,
+// saved and loaded as JSON, each history of numerals joined by spaces, to the count of each numeral that followed it,
+// e.g. {"order":2,"transitions":{"^ ^":{"I":3},"^ I":{"IV":2,"vi":1}}}
+type Model struct {
+	Order       int                       `json:"order"`
+	Transitions map[string]map[string]int `json:"transitions"`
+}
+
+// Train a model of an order, e.g. 2, on a corpus of songs, each chord by its numeral in the song's key,
+// diatonic or borrowed from a parallel mode, e.g. "ii7" of Dm7 or "bVI" of Ab in C major, skipping any chord that's neither
+func Train(corpus []Song, order int) Model {
+	m, _ := TrainContext(context.Background(), corpus, order)
+	return m
+}
+
+// TrainContext of a model, the same as Train, returning the error of the context as soon as it's done, checked before each song,
+// e.g. when a server cancels a request, or its deadline passes
+func TrainContext(ctx context.Context, corpus []Song, order int) (Model, error) {
+	if order < 1 {
+		order = 1
+	}
+	m := Model{Order: order, Transitions: make(map[string]map[string]int)}
+	for _, s := range corpus {
+		if err := ctx.Err(); err != nil {
+			return Model{}, err
+		}
+		history, chords := m.start(), chordsByNumeral(s.Key)
+		for _, c := range s.Progression.Chords {
+			numeral, ok := numeralIn(s.Key, c, chords)
+			if !ok {
+				continue
+			}
+			following := m.Transitions[strings.Join(history, " ")]
+			if following == nil {
+				following = make(map[string]int)
+				m.Transitions[strings.Join(history, " ")] = following
+			}
+			following[numeral]++
+			history = append(history[1:], numeral)
+		}
+	}
+	return m, nil
+}
+
+// Sample a progression of a length from the model, in a key, of each numeral drawn by how often it followed the numerals before it
+// and spelled in the key, the same progression of the same seed, starting over from the Start of a song wherever no numeral
+// that followed has a chord in the key
+func (m Model) Sample(k key.Key, length int, seed int64) Progression {
+	return m.SampleFrom(k, length, rand.NewSource(seed))
+}
+
+// SampleFrom a source of random numbers a progression of a length from the model, in a key, the same as Sample, the same progression of a source of the same numbers
+func (m Model) SampleFrom(k key.Key, length int, src rand.Source) Progression {
+	p, _ := m.sample(context.Background(), k, length, rand.New(src))
+	return p
+}
+
+// SampleContext of a progression, the same as Sample, returning the error of the context as soon as it's done, checked before each chord,
+// e.g. when a server cancels a request for a long progression
+func (m Model) SampleContext(ctx context.Context, k key.Key, length int, seed int64) (Progression, error) {
+	return m.sample(ctx, k, length, rand.New(rand.NewSource(seed)))
+}
+
+// Save the model as JSON
+func (m Model) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(m)
+}
+
+// Load a model saved as JSON, returning ErrInvalidModel if it's not of an order of at least 1 with each context of that many numerals
+func Load(r io.Reader) (Model, error) {
+	var m Model
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return Model{}, err
+	}
+	if m.Order < 1 {
+		return Model{}, fmt.Errorf("%w of order %d", ErrInvalidModel, m.Order)
+	}
+	for history := range m.Transitions {
+		if len(strings.Fields(history)) != m.Order {
+			return Model{}, fmt.Errorf("%w history %q", ErrInvalidModel, history)
+		}
+	}
+	if m.Transitions == nil {
+		m.Transitions = make(map[string]map[string]int)
+	}
+	return m, nil
+}
+
+//
+// Private
+//
+
+// sample a progression of a length from the model, in a key, of random numbers, unless the context is done
+func (m Model) sample(ctx context.Context, k key.Key, length int, r *rand.Rand) (Progression, error) {
+	chords := chordsByNumeral(k)
+	p := Progression{}
+	history := m.start()
+	for len(p.Chords) < length {
+		if err := ctx.Err(); err != nil {
+			return Progression{}, err
+		}
+		numeral, ok := m.draw(r, history, chords)
+		if !ok && strings.Join(history, " ") != strings.Join(m.start(), " ") {
+			history = m.start()
+			numeral, ok = m.draw(r, history, chords)
+		}
+		if !ok {
+			break
+		}
+		p.Chords = append(p.Chords, chords[numeral])
+		history = append(history[1:], numeral)
+	}
+	return p, nil
+}
+
+// start of a song, a context of only Start
+func (m Model) start() []string {
+	history := make([]string, m.Order)
+	for i := range history {
+		history[i] = Start
+	}
+	return history
+}
+
+// draw the next numeral after a context, at random by its count, from only those with a chord, in alphabetical order so the same seed draws the same numeral
+func (m Model) draw(r *rand.Rand, history []string, chords map[string]chord.Chord) (string, bool) {
+	var numerals []string
+	total := 0
+	for numeral, count := range m.Transitions[strings.Join(history, " ")] {
+		if _, ok := chords[numeral]; ok && count > 0 {
+			numerals = append(numerals, numeral)
+			total += count
+		}
+	}
+	if total == 0 {
+		return "", false
+	}
+	sort.Strings(numerals)
+	n := r.Intn(total)
+	for _, numeral := range numerals {
+		if n -= m.Transitions[strings.Join(history, " ")][numeral]; n < 0 {
+			return numeral, true
+		}
+	}
+	return "", false
+}
+
+// numeralIn a key of a chord, its Roman numeral in root position, as roman.Analyze writes it, of a chord diatonic or borrowed in the key
+func numeralIn(k key.Key, c chord.Chord, chords map[string]chord.Chord) (string, bool) {
+	r, ok := roman.Analyze(c, k)
+	if !ok {
+		return "", false
+	}
+	r.Inversion = 0
+	numeral := r.String()
+	if known, ok := chords[numeral]; !ok || !known.ToneSet().Equal(c.ToneSet()) {
+		return "", false
+	}
+	return numeral, true
+}
+
+// chordsByNumeral of a key, each diatonic or borrowed chord by its Roman numeral, as roman.Analyze writes it, the diatonic first of any numeral of both
+func chordsByNumeral(k key.Key) map[string]chord.Chord {
+	chords := make(map[string]chord.Chord)
+	for _, b := range k.ModalInterchange() {
+		if r, ok := roman.Analyze(b.Chord, k); ok {
+			chords[r.String()] = b.Chord
+		}
+	}
+	for _, d := range k.Chords() {
+		if r, ok := roman.Analyze(d.Chord, k); ok {
+			chords[r.String()] = d.Chord
+		}
+	}
+	return chords
+}
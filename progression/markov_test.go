@@ -0,0 +1,142 @@
+// A Markov model of chord progressions learns from a corpus of songs how often each Roman numeral follows the numerals before it,
+// and samples new progressions in the same style, in any key
+package progression
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/internal/golden"
+	"github.com/go-music-theory/music-theory/key"
+)
+
+func TestTrain(t *testing.T) {
+	m := Train([]Song{
+		{Key: key.Of("C"), Progression: Of("C", "Am", "F", "G")},
+		{Key: key.Of("G"), Progression: Of("G", "Em", "C", "D")},
+		{Key: key.Of("C"), Progression: Of("C", "F", "Ab", "G")},
+	}, 1)
+	assert.Equal(t, 1, m.Order)
+	assert.Equal(t, map[string]int{"I": 3}, m.Transitions["^"])
+	assert.Equal(t, map[string]int{"vi": 2, "IV": 1}, m.Transitions["I"])
+	assert.Equal(t, map[string]int{"V": 2, "bVI": 1}, m.Transitions["IV"])
+	assert.Equal(t, map[string]int{"V": 1}, m.Transitions["bVI"])
+}
+
+func TestTrain_Order(t *testing.T) {
+	m := Train([]Song{{Key: key.Of("C"), Progression: Of("Dm7", "G7", "Cmaj7")}}, 2)
+	assert.Equal(t, map[string]map[string]int{
+		"^ ^":    {"ii7": 1},
+		"^ ii7":  {"V7": 1},
+		"ii7 V7": {"Imaj7": 1},
+	}, m.Transitions)
+	assert.Equal(t, 1, Train(nil, 0).Order)
+}
+
+func TestTrain_SkipsChordsOutsideKey(t *testing.T) {
+	m := Train([]Song{{Key: key.Of("C"), Progression: Of("C", "E", "G")}}, 1)
+	assert.Equal(t, map[string]int{"V": 1}, m.Transitions["I"])
+}
+
+func TestTrainContext(t *testing.T) {
+	corpus := []Song{{Key: key.Of("C"), Progression: Of("C", "F", "G")}}
+	m, err := TrainContext(context.Background(), corpus, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, Train(corpus, 1), m)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = TrainContext(ctx, corpus, 1)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestSong_Numerals(t *testing.T) {
+	s := Song{Key: key.Of("C"), Progression: Of("Dm7", "G7", "Cmaj7", "Ab", "E")}
+	assert.Equal(t, []string{"ii7", "V7", "Imaj7", "bVI", "?"}, s.Numerals())
+}
+
+func TestModel_Sample(t *testing.T) {
+	m := Train([]Song{
+		{Key: key.Of("C"), Progression: Of("C", "Am", "F", "G")},
+		{Key: key.Of("C"), Progression: Of("C", "F", "G", "C")},
+	}, 1)
+	p := m.Sample(key.Of("D"), 8, 1)
+	assert.Equal(t, 8, len(p.Chords))
+	assert.Equal(t, "D", p.Chords[0].Name())
+	for _, c := range p.Chords {
+		assert.Contains(t, []string{"D", "Bm", "G", "A"}, c.Name())
+	}
+	assert.Equal(t, p, m.Sample(key.Of("D"), 8, 1))
+}
+
+func TestModel_Sample_StartsOver(t *testing.T) {
+	m := Train([]Song{{Key: key.Of("C"), Progression: Of("C", "G")}}, 1)
+	assert.Equal(t, []string{"Eb", "Bb", "Eb", "Bb", "Eb"}, namesOf(m.Sample(key.Of("Eb"), 5, 7)))
+	assert.Empty(t, Train(nil, 1).Sample(key.Of("C"), 4, 1).Chords)
+}
+
+func TestModel_SampleContext(t *testing.T) {
+	m := Train([]Song{{Key: key.Of("C"), Progression: Of("C", "F", "G")}}, 1)
+	p, err := m.SampleContext(context.Background(), key.Of("G"), 6, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, m.Sample(key.Of("G"), 6, 3), p)
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	_, err = m.SampleContext(ctx, key.Of("G"), 6, 3)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestModel_Sample_Golden(t *testing.T) {
+	var out strings.Builder
+	m := Train(Songs(Corpus()), 2)
+	for _, k := range []key.Key{key.Of("C"), key.Of("Bb"), key.Of("F# minor")} {
+		for seed := int64(0); seed < 4; seed++ {
+			fmt.Fprintf(&out, "%s %d %s\n", k.Canonical(), seed, strings.Join(namesOf(m.Sample(k, 12, seed)), " "))
+		}
+	}
+	golden.Assert(t, "sample", out.String())
+}
+
+func TestModel_SampleFrom(t *testing.T) {
+	m := Train(Songs(Corpus()), 1)
+	assert.Equal(t, m.Sample(key.Of("A"), 8, 5), m.SampleFrom(key.Of("A"), 8, rand.NewSource(5)))
+}
+
+func TestModel_Save(t *testing.T) {
+	m := Train([]Song{{Key: key.Of("C"), Progression: Of("C", "G")}}, 1)
+	var buf bytes.Buffer
+	assert.Nil(t, m.Save(&buf))
+	assert.Equal(t, `{"order":1,"transitions":{"I":{"V":1},"^":{"I":1}}}`+"\n", buf.String())
+	loaded, err := Load(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, m, loaded)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	_, err := Load(strings.NewReader(`{"order":0}`))
+	assert.True(t, errors.Is(err, ErrInvalidModel))
+	_, err = Load(strings.NewReader(`{"order":2,"transitions":{"^":{"I":1}}}`))
+	assert.True(t, errors.Is(err, ErrInvalidModel))
+	_, err = Load(strings.NewReader(`not json`))
+	assert.NotNil(t, err)
+	m, err := Load(strings.NewReader(`{"order":1}`))
+	assert.Nil(t, err)
+	assert.NotNil(t, m.Transitions)
+}
+
+//
+// Private
+//
+
+func namesOf(p Progression) (names []string) {
+	for _, c := range p.Chords {
+		names = append(names, c.Name())
+	}
+	return
+}
@@ -0,0 +1,189 @@
+// The next chord of a progression is suggested by functional harmony, the tonic moving to the predominant, to the dominant, and back to the tonic,
+// and the common exceptions to it, e.g. the deceptive cadence of V to vi, or the plagal of IV to I, each weighted by how idiomatic it is, e.g. for a songwriting assistant
+package progression
+
+import (
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/roman"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+// Function of a chord in a key, its role in functional harmony
+type Function int
+
+const (
+	Tonic       Function = iota // at rest, e.g. I, iii or vi
+	Predominant                 // moving away from the tonic, leading to the dominant, e.g. ii or IV
+	Dominant                    // in tension, resolving to the tonic, e.g. V or vii°
+)
+
+// String of the Function, e.g. "predominant"
+func (of Function) String() string {
+	switch of {
+	case Tonic:
+		return "tonic"
+	case Predominant:
+		return "predominant"
+	case Dominant:
+		return "dominant"
+	}
+	return ""
+}
+
+// FunctionOf a Roman numeral, by its degree, tonic of the 1st, 3rd and 6th, predominant of the 2nd and 4th, and dominant of the 5th and 7th,
+// but predominant of the bVI borrowed from the parallel minor, and of a numeral applied to a chord, the function before that chord's, e.g. predominant of V7/V
+func FunctionOf(r roman.RomanNumeral) Function {
+	if r.Target != nil {
+		return (FunctionOf(*r.Target) + 2) % 3
+	}
+	if r.Degree == 6 && r.Accidental < 0 {
+		return Predominant
+	}
+	switch r.Degree {
+	case 2, 4:
+		return Predominant
+	case 5, 7:
+		return Dominant
+	}
+	return Tonic
+}
+
+// Transition of functional harmony, from one function to the next, its weight, from 0 to 1 of the most idiomatic, and an explanation of it
+type Transition struct {
+	From, To    Function
+	Weight      float64
+	Explanation string
+}
+
+// Transitions of functional harmony, the tonic to the predominant, to the dominant, and back to the tonic, or the tonic straight to the dominant,
+// or prolonging the tonic or predominant, but never the dominant back to the predominant, but for the exceptions of a progression's NextOptions
+var Transitions = []Transition{
+	{Tonic, Predominant, 1, "moves away from the tonic, to the predominant"},
+	{Predominant, Dominant, 1, "leads from the predominant to the dominant"},
+	{Dominant, Tonic, 1, "resolves the dominant to the tonic"},
+	{Tonic, Dominant, 0.5, "moves straight from the tonic to the dominant"},
+	{Tonic, Tonic, 0.25, "prolongs the tonic"},
+	{Predominant, Predominant, 0.25, "prolongs the predominant"},
+}
+
+// Next chord that may follow another in a key, by its Roman numeral and function, and an explanation of why it's idiomatic
+type Next struct {
+	Numeral     roman.RomanNumeral
+	Chord       chord.Chord
+	Function    Function
+	Explanation string
+}
+
+// String of the next chord, its name and numeral, e.g. "G (V)"
+func (n Next) String() string {
+	return n.Chord.Name() + " (" + n.Numeral.String() + ")"
+}
+
+// NextOptions of the chords that may follow the current chord in a key, each diatonic triad of the key, or a common chord borrowed or applied in it,
+// weighted by the Transitions of their functions and how strongly each represents its function, e.g. the V more than the vii° of the dominant,
+// or by an exception to it, e.g. the deceptive cadence of V to vi, ranked with the confidence of each, or none of a chord without a numeral in the key,
+// e.g. C (I), then Am (vi) of a deceptive cadence, Em (iii) and F (IV) after G in C major,
+// and of a dominant not in the key, analyzed as applied to the chord it tonicizes, that chord first, e.g. Am (vi) after E7 (V7/vi) in C major
+func NextOptions(current chord.Chord, k key.Key) []candidate.Candidate[Next] {
+	from, ok := roman.AnalyzeApplied(current, k)
+	if !ok {
+		from, ok = roman.Analyze(current, k)
+	}
+	if !ok {
+		return nil
+	}
+	if from.Inversion = 0; from.Target == nil {
+		from.Seventh = roman.NoSeventh
+	}
+	var candidates []candidate.Candidate[Next]
+	options, ok := nextOptions[k.Mode]
+	if !ok {
+		options = nextOptions[key.Major]
+	}
+	for _, option := range options {
+		to := roman.Of(option.numeral)
+		n := Next{Numeral: to, Chord: to.In(k), Function: FunctionOf(to)}
+		if to.String() == from.String() || n.Chord.ToneSet().Equal(current.ToneSet()) {
+			continue
+		}
+		weight, explanation := nextWeightOf(k.Mode, from, to, option.strength)
+		if weight <= 0 {
+			continue
+		}
+		n.Explanation = from.String() + " to " + to.String() + " " + explanation
+		candidates = append(candidates, candidate.Of(n, weight))
+	}
+	ranked := candidate.Rank(candidates)
+	if trace.Enabled() {
+		trace.Ranked("progression.next", current.Name(), ranked, Next.String)
+	}
+	return ranked
+}
+
+//
+// Private
+//
+
+// nextOption of a key, a Roman numeral that may follow any chord, and how strongly it represents its function, from 0 to 1
+type nextOption struct {
+	numeral  string
+	strength float64
+}
+
+// nextOptions of a major or minor key, each diatonic triad, and the common chords borrowed or applied in it
+var nextOptions = map[key.Mode][]nextOption{
+	key.Major: {{"I", 1}, {"ii", 1}, {"iii", 0.25}, {"IV", 1}, {"V", 1}, {"vi", 0.5}, {"vii°", 0.5}, {"iv", 0.25}, {"bVI", 0.25}, {"bVII", 0.25}, {"V7/V", 0.5}},
+	key.Minor: {{"i", 1}, {"ii°", 0.5}, {"III", 0.5}, {"iv", 1}, {"V", 1}, {"VI", 0.5}, {"VII", 0.5}, {"v", 0.25}, {"#vii°", 0.5}, {"V7/V", 0.25}},
+}
+
+// nextException to the Transitions of functional harmony, in a mode of key, or any of Nil, from a Roman numeral to another, its weight, and an explanation of it
+type nextException struct {
+	mode        key.Mode
+	from, to    string
+	weight      float64
+	explanation string
+}
+
+// nextExceptions of common progressions, weighed in place of the Transitions of their functions
+var nextExceptions = []nextException{
+	{key.Major, "V", "vi", 0.75, "is a deceptive cadence, resolving the dominant to the submediant in place of the tonic"},
+	{key.Minor, "V", "VI", 0.75, "is a deceptive cadence, resolving the dominant to the submediant in place of the tonic"},
+	{key.Major, "IV", "I", 0.75, "is a plagal cadence, resolving the subdominant to the tonic"},
+	{key.Minor, "iv", "i", 0.75, "is a plagal cadence, resolving the subdominant to the tonic"},
+	{key.Major, "iv", "I", 0.5, "is a minor plagal cadence, resolving the subdominant borrowed from the parallel minor to the tonic"},
+	{key.Major, "IV", "iv", 0.5, "borrows the minor subdominant from the parallel minor, darkening the predominant"},
+	{key.Major, "V", "IV", 0.25, "is a retrogression of blues and rock, the dominant falling back to the subdominant"},
+	{key.Major, "vi", "ii", 1, "falls a fifth, around the circle of fifths"},
+	{key.Major, "iii", "vi", 0.75, "falls a fifth, around the circle of fifths"},
+	{key.Minor, "VI", "iv", 0.5, "falls a third, to the subdominant"},
+	{key.Minor, "VII", "III", 0.75, "resolves the subtonic as the dominant of the relative major"},
+	{key.Major, "bVII", "I", 0.5, "is a backdoor resolution to the tonic, of the subtonic borrowed from the parallel minor"},
+}
+
+// appliedWeight of the resolution of an applied dominant to any chord but the one it tonicizes, of the weight of the Transition of their functions
+const appliedWeight = 0.5
+
+// nextWeightOf the transition of a Roman numeral to another in a mode of key, and an explanation of it, by the resolution of an applied dominant to its target,
+// by an exception to functional harmony, or else by the Transition of their functions, weighed by how strongly the next represents its function,
+// and of the appliedWeight of any but the target of an applied dominant
+func nextWeightOf(mode key.Mode, from, to roman.RomanNumeral, strength float64) (float64, string) {
+	if from.Target != nil && from.Target.String() == to.String() {
+		return 1, "resolves the applied dominant to the chord it tonicizes"
+	}
+	if from.Target != nil {
+		strength *= appliedWeight
+	}
+	for _, e := range nextExceptions {
+		if (e.mode == key.Nil || e.mode == mode) && e.from == from.String() && e.to == to.String() {
+			return e.weight, e.explanation
+		}
+	}
+	for _, t := range Transitions {
+		if t.From == FunctionOf(from) && t.To == FunctionOf(to) {
+			return t.Weight * strength, t.Explanation
+		}
+	}
+	return 0, ""
+}
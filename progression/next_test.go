@@ -0,0 +1,129 @@
+// The next chord of a progression is suggested by functional harmony, the tonic moving to the predominant, to the dominant, and back to the tonic,
+package progression
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/roman"
+	"github.com/go-music-theory/music-theory/trace"
+)
+
+func TestNextOptions(t *testing.T) {
+	next := NextOptions(chord.Of("G"), key.Of("C major"))
+	assert.Equal(t, []string{"C (I)", "Am (vi)", "Em (iii)", "F (IV)"}, nextNamesOf(next))
+	assert.Equal(t, []float64{1, 0.75, 0.25, 0.25}, nextScoresOf(next))
+	assert.InDelta(t, 1/2.25, next[0].Confidence, 0.0001)
+	assert.Equal(t, Tonic, next[0].Value.Function)
+	assert.Equal(t, "V to I resolves the dominant to the tonic", next[0].Value.Explanation)
+	assert.Equal(t, "V to vi is a deceptive cadence, resolving the dominant to the submediant in place of the tonic", next[1].Value.Explanation)
+	assert.Equal(t, "V to IV is a retrogression of blues and rock, the dominant falling back to the subdominant", next[3].Value.Explanation)
+}
+
+func TestNextOptions_Tonic(t *testing.T) {
+	next := NextOptions(chord.Of("C"), key.Of("C major"))
+	assert.Equal(t, []string{"Dm (ii)", "F (IV)", "G (V)", "D7 (V7/V)"}, nextNamesOf(next)[:4])
+	assert.Equal(t, "I to ii moves away from the tonic, to the predominant", next[0].Value.Explanation)
+	assert.Equal(t, roman.Of("V7/V"), next[3].Value.Numeral)
+	assert.Equal(t, Predominant, next[3].Value.Function)
+	assert.NotContains(t, nextNamesOf(next), "C (I)")
+}
+
+func TestNextOptions_Predominant(t *testing.T) {
+	next := NextOptions(chord.Of("Dm7"), key.Of("C major"))
+	assert.Equal(t, []string{"G (V)", "Bdim (vii°)"}, nextNamesOf(next)[:2])
+	next = NextOptions(chord.Of("F"), key.Of("C major"))
+	assert.Equal(t, []string{"G (V)", "C (I)", "Bdim (vii°)", "Fm (iv)"}, nextNamesOf(next)[:4])
+	assert.Equal(t, "IV to I is a plagal cadence, resolving the subdominant to the tonic", next[1].Value.Explanation)
+}
+
+func TestNextOptions_Applied(t *testing.T) {
+	next := NextOptions(chord.Of("D7"), key.Of("C major"))
+	assert.Equal(t, "G (V)", next[0].Value.String())
+	assert.Equal(t, "V7/V to V resolves the applied dominant to the chord it tonicizes", next[0].Value.Explanation)
+	next = NextOptions(chord.Of("E7"), key.Of("C major"))
+	assert.Equal(t, "Am (vi)", next[0].Value.String())
+	assert.Equal(t, "V7/vi to vi resolves the applied dominant to the chord it tonicizes", next[0].Value.Explanation)
+	assert.True(t, next[0].Score > next[1].Score)
+	next = NextOptions(chord.Of("A7"), key.Of("C major"))
+	assert.Equal(t, "Dm (ii)", next[0].Value.String())
+	assert.True(t, next[0].Score > next[1].Score)
+}
+
+func TestNextOptions_Minor(t *testing.T) {
+	next := NextOptions(chord.Of("E7"), key.Of("A minor"))
+	assert.Equal(t, []string{"Am (i)", "F (VI)", "C (III)"}, nextNamesOf(next))
+	next = NextOptions(chord.Of("G"), key.Of("A minor"))
+	assert.Equal(t, []string{"Am (i)", "C (III)", "F (VI)"}, nextNamesOf(next))
+	next = NextOptions(chord.Of("Dm"), key.Of("A minor"))
+	assert.Equal(t, "E (V)", next[0].Value.String())
+}
+
+func TestNextOptions_Unknown(t *testing.T) {
+	assert.Nil(t, NextOptions(chord.Of("Csus4"), key.Of("C major")))
+	assert.Nil(t, NextOptions(chord.Chord{}, key.Of("C major")))
+}
+
+func TestNextOptions_Traced(t *testing.T) {
+	var events []trace.Event
+	previous := trace.Set(trace.HookFunc(func(e trace.Event) {
+		if e.Scope == "progression.next" {
+			events = append(events, e)
+		}
+	}))
+	defer trace.Set(previous)
+	NextOptions(chord.Of("G"), key.Of("C major"))
+	assert.Equal(t, 4, len(events))
+	assert.Equal(t, trace.Event{Scope: "progression.next", Input: "G", Rule: "C (I)", Score: 1}, events[0])
+}
+
+func TestFunctionOf(t *testing.T) {
+	for numeral, expect := range map[string]Function{
+		"I": Tonic, "ii": Predominant, "iii": Tonic, "IV": Predominant, "V7": Dominant, "vi": Tonic, "vii°": Dominant,
+		"bVI": Predominant, "bVII": Dominant, "bII6": Predominant, "V7/V": Predominant, "V7/IV": Tonic, "V7/vi": Dominant,
+	} {
+		assert.Equal(t, expect, FunctionOf(roman.Of(numeral)), numeral)
+	}
+}
+
+func TestFunction_String(t *testing.T) {
+	assert.Equal(t, "tonic", Tonic.String())
+	assert.Equal(t, "predominant", Predominant.String())
+	assert.Equal(t, "dominant", Dominant.String())
+}
+
+func TestTransitions(t *testing.T) {
+	for _, from := range []Function{Tonic, Predominant, Dominant} {
+		found := false
+		for _, tr := range Transitions {
+			if tr.From == from && tr.To == (from+1)%3 {
+				assert.Equal(t, float64(1), tr.Weight)
+				found = true
+			}
+			assert.False(t, tr.From == Dominant && tr.To == Predominant)
+		}
+		assert.True(t, found, from.String())
+	}
+}
+
+//
+// Private
+//
+
+func nextNamesOf(next []candidate.Candidate[Next]) (names []string) {
+	for _, n := range next {
+		names = append(names, n.Value.String())
+	}
+	return
+}
+
+func nextScoresOf(next []candidate.Candidate[Next]) (scores []float64) {
+	for _, n := range next {
+		scores = append(scores, n.Score)
+	}
+	return
+}
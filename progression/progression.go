@@ -0,0 +1,32 @@
+// A chord progression (or harmonic progression) is a succession of musical chords, which are two or more notes, typically sounded simultaneously.
+//
+// https://en.wikipedia.org/wiki/Chord_progression
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package progression
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+)
+
+// Progression of Chords, in order
+type Progression struct {
+	Chords []chord.Chord
+}
+
+// Of a sequence of chord names, e.g. Of("Dm7", "G7", "C")
+func Of(names ...string) Progression {
+	p := Progression{}
+	for _, name := range names {
+		p.Chords = append(p.Chords, chord.Of(name))
+	}
+	return p
+}
@@ -0,0 +1,24 @@
+// A chord progression (or harmonic progression) is a succession of musical chords, which are two or more notes, typically sounded simultaneously.
+package progression
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+)
+
+func TestOf(t *testing.T) {
+	p := Of("Dm7", "G7", "C")
+	assert.Equal(t, []chord.Chord{
+		chord.Of("Dm7"),
+		chord.Of("G7"),
+		chord.Of("C"),
+	}, p.Chords)
+}
+
+func TestOf_Empty(t *testing.T) {
+	p := Of()
+	assert.Equal(t, 0, len(p.Chords))
+}
@@ -0,0 +1,113 @@
+// Two progressions are compared by aligning their chords, the fewest insertions, deletions and substitutions of one chord for another that turn one into the other,
+// each substitution costing the distance between the chords, from 0 of the same tones to 1 of none in common, e.g. for cover-song or plagiarism analysis
+package progression
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+)
+
+// Gap in an alignment, where a chord of one progression has none in the other
+const Gap = -1
+
+// Alignment of two progressions, by the pairs of their chords, in order, and the similarity of the progressions from 0 to 1 of the same chords
+type Alignment struct {
+	Pairs      []Pair
+	Similarity float64
+}
+
+// Pair of an alignment, by the index of a chord in each progression, or Gap of a chord inserted or deleted, and the distance between them, 1 of a gap
+type Pair struct {
+	A        int
+	B        int
+	Distance float64
+}
+
+// Align two progressions, e.g. Align(Of("Dm7", "G7", "C"), Of("Dm7", "Db7", "C")), by the least total distance of their pairs of chords,
+// and their similarity, 1 less that distance divided by the length of the longer progression
+func Align(a, b Progression) Alignment {
+	n, m := len(a.Chords), len(b.Chords)
+	cost := make([][]float64, n+1)
+	for i := range cost {
+		cost[i] = make([]float64, m+1)
+		cost[i][0] = float64(i)
+	}
+	for j := 0; j <= m; j++ {
+		cost[0][j] = float64(j)
+	}
+	for i := 1; i <= n; i++ {
+		for j := 1; j <= m; j++ {
+			cost[i][j] = min3(cost[i-1][j]+1, cost[i][j-1]+1, cost[i-1][j-1]+chordDistance(a.Chords[i-1], b.Chords[j-1]))
+		}
+	}
+	var pairs []Pair
+	for i, j := n, m; i > 0 || j > 0; {
+		switch {
+		case i > 0 && j > 0 && cost[i][j] == cost[i-1][j-1]+chordDistance(a.Chords[i-1], b.Chords[j-1]):
+			pairs = append(pairs, Pair{A: i - 1, B: j - 1, Distance: chordDistance(a.Chords[i-1], b.Chords[j-1])})
+			i, j = i-1, j-1
+		case i > 0 && cost[i][j] == cost[i-1][j]+1:
+			pairs = append(pairs, Pair{A: i - 1, B: Gap, Distance: 1})
+			i--
+		default:
+			pairs = append(pairs, Pair{A: Gap, B: j - 1, Distance: 1})
+			j--
+		}
+	}
+	for l, r := 0, len(pairs)-1; l < r; l, r = l+1, r-1 {
+		pairs[l], pairs[r] = pairs[r], pairs[l]
+	}
+	similarity := 1.0
+	if longer := maxInt(n, m); longer > 0 {
+		similarity = 1 - cost[n][m]/float64(longer)
+	}
+	return Alignment{Pairs: pairs, Similarity: similarity}
+}
+
+// Similarity of two progressions, from 0 to 1 of the same chords, by the distance of their alignment, e.g. 0.78 of Dm7 G7 C and Dm7 Db7 C
+func Similarity(a, b Progression) float64 {
+	return Align(a, b).Similarity
+}
+
+// TransposedSimilarity of two progressions, the most of their similarity with the second transposed by any number of semitones, and that number, from 0 to 11 up,
+// e.g. 1 and 2 of Dm7 G7 C and Em7 A7 D, the same progression in another key
+func TransposedSimilarity(a, b Progression) (float64, int) {
+	best, bestSemitones := Similarity(a, b), 0
+	for semitones := 1; semitones < 12; semitones++ {
+		transposed := Progression{}
+		for _, c := range b.Chords {
+			transposed.Chords = append(transposed.Chords, c.Transpose(-semitones))
+		}
+		if similarity := Similarity(a, transposed); similarity > best {
+			best, bestSemitones = similarity, semitones
+		}
+	}
+	return best, bestSemitones
+}
+
+//
+// Private
+//
+
+// chordDistance between two chords, the share of their tones that aren't in both, from 0 of the same tones to 1 of none in common
+func chordDistance(a, b chord.Chord) float64 {
+	return 1 - a.ToneSet().Similarity(b.ToneSet())
+}
+
+// min3 of three costs
+func min3(a, b, c float64) float64 {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// maxInt of two integers
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,48 @@
+// Two progressions are compared by aligning their chords, the fewest insertions, deletions and substitutions of one chord for another that turn one into the other,
+// each substitution costing the distance between the chords, from 0 of the same tones to 1 of none in common, e.g. for cover-song or plagiarism analysis
+package progression
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+)
+
+func TestAlign(t *testing.T) {
+	a := Align(Of("C", "Am", "F", "G"), Of("C", "F", "G"))
+	assert.Equal(t, []Pair{{0, 0, 0}, {1, Gap, 1}, {2, 1, 0}, {3, 2, 0}}, a.Pairs)
+	assert.Equal(t, 0.75, a.Similarity)
+	a = Align(Of("Dm7", "G7", "C"), Of("Dm7", "Db7", "C"))
+	assert.Equal(t, 3, len(a.Pairs))
+	assert.InDelta(t, 2.0/3, a.Pairs[1].Distance, 0.001)
+	assert.InDelta(t, 7.0/9, a.Similarity, 0.001)
+}
+
+func TestAlign_Empty(t *testing.T) {
+	assert.Equal(t, Alignment{Similarity: 1}, Align(Of(), Of()))
+	assert.Equal(t, Alignment{Pairs: []Pair{{Gap, 0, 1}}, Similarity: 0}, Align(Of(), Of("C")))
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, Similarity(Of("C", "F", "G"), Of("C", "F", "G")))
+	assert.Equal(t, 1.0, Similarity(Of("C6"), Of("Am7")))
+	assert.Equal(t, 0.0, Similarity(Of("C"), Of("F#")))
+	assert.Equal(t, Similarity(Of("C", "G"), Of("C", "Am", "G")), Similarity(Of("C", "Am", "G"), Of("C", "G")))
+}
+
+func TestTransposedSimilarity(t *testing.T) {
+	similarity, semitones := TransposedSimilarity(Of("Dm7", "G7", "C"), Of("Em7", "A7", "D"))
+	assert.Equal(t, 1.0, similarity)
+	assert.Equal(t, 2, semitones)
+	similarity, semitones = TransposedSimilarity(Of("C", "F"), Of("C", "F"))
+	assert.Equal(t, 1.0, similarity)
+	assert.Equal(t, 0, semitones)
+}
+
+func TestChordDistance(t *testing.T) {
+	assert.Equal(t, 0.0, chordDistance(chord.Of("C"), chord.Of("C major")))
+	assert.Equal(t, 0.5, chordDistance(chord.Of("C"), chord.Of("Am")))
+	assert.Equal(t, 1.0, chordDistance(chord.Of("C"), chord.Of("Db")))
+}
@@ -0,0 +1,60 @@
+// Progressions are expressed with their estimated Key and each of their Chords, e.g. Dm7 G7 C in C major
+package progression
+
+import (
+	"encoding/json"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/schema"
+)
+
+// ToYAML of the Progression, e.g. for the command-line utility
+func (p Progression) ToYAML() string {
+	spec := specFrom(p)
+	out, _ := yaml.Marshal(spec)
+	return string(out[:])
+}
+
+// ToJSON of the Progression, e.g. for a web app
+func (p Progression) ToJSON() string {
+	spec := specFrom(p)
+	out, _ := json.Marshal(spec)
+	return string(out[:])
+}
+
+//
+// Private
+//
+
+func specFrom(p Progression) specProgression {
+	s := specProgression{}
+	k := p.Key()
+	s.Key.Root = k.Root.String(k.AdjSymbol)
+	s.Key.Mode = k.Mode.String()
+	s.Chords = make([]specChord, 0, len(p.Chords))
+	for _, c := range p.Chords {
+		sc := specChord{}
+		sc.Root = c.Root.String(c.AdjSymbol)
+		for _, t := range c.OrderedTones() {
+			sc.Tones = append(sc.Tones, schema.Degree{Number: int(t.Degree.Interval), Name: t.Class.String(c.AdjSymbol)})
+		}
+		s.Chords = append(s.Chords, sc)
+	}
+	return s
+}
+
+type specProgression struct {
+	Key    specKey     `json:"key"`
+	Chords []specChord `json:"chords"`
+}
+
+type specKey struct {
+	Root string `json:"root"`
+	Mode string `json:"mode"`
+}
+
+type specChord struct {
+	Root  string         `json:"root"`
+	Tones schema.Degrees `json:"tones"`
+}
@@ -0,0 +1,25 @@
+// Progressions are expressed with their estimated Key and each of their Chords, e.g. Dm7 G7 C in C major
+package progression
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestToYAML(t *testing.T) {
+	p := Of("G7", "C")
+	out := p.ToYAML()
+	assert.Equal(t, "key:\n  root: C\n  mode: Major\nchords:\n- root: G\n  tones:\n    1: G\n    3: B\n    5: D\n    7: F\n- root: C\n  tones:\n    1: C\n    3: E\n    5: G\n", out)
+}
+
+func TestToJSON(t *testing.T) {
+	p := Of("G7", "C")
+	out := p.ToJSON()
+	assert.Equal(t, `{"key":{"root":"C","mode":"Major"},"chords":[{"root":"G","tones":{"1":"G","3":"B","5":"D","7":"F"}},{"root":"C","tones":{"1":"C","3":"E","5":"G"}}]}`, out)
+}
+
+func TestToJSON_AscendingIntervals(t *testing.T) {
+	p := Of("G13", "C")
+	assert.Contains(t, p.ToJSON(), `"tones":{"1":"G","5":"D","7":"F","9":"A","11":"C","13":"E"}`)
+}
@@ -0,0 +1,31 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// listProgressions of the corpus built in, of a genre, or of every genre if empty, writing a table of each by name, genre, era and key,
+// and its numerals and the chords of them in that key
+func listProgressions(w io.Writer, genre string) error {
+	classics, err := progression.CorpusOf(genre)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tGENRE\tERA\tKEY\tNUMERALS\tCHORDS")
+	for _, c := range classics {
+		var names []string
+		for _, ch := range c.Progression.Chords {
+			names = append(names, ch.Name())
+		}
+		k := c.Key.Root.String(c.Key.AdjSymbol) + " " + strings.ToLower(c.Key.Mode.String())
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", c.Name, c.Genre, c.Era, k, strings.Join(c.Numerals(), " "), strings.Join(names, " "))
+	}
+	return tw.Flush()
+}
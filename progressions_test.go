@@ -0,0 +1,31 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestListProgressions(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, listProgressions(&out, "blues"))
+	assert.Equal(t, "NAME                GENRE  ERA    KEY      NUMERALS                                   CHORDS\n"+
+		"twelve-bar blues    blues  1910s  C major  I7 I7 I7 I7 IV7 IV7 I7 I7 V7 IV7 I7 V7     C7 C7 C7 C7 F7 F7 C7 C7 G7 F7 C7 G7\n"+
+		"quick-change blues  blues  1920s  C major  I7 IV7 I7 I7 IV7 IV7 I7 I7 V7 IV7 I7 V7    C7 F7 C7 C7 F7 F7 C7 C7 G7 F7 C7 G7\n"+
+		"minor blues         blues  1940s  C minor  i7 i7 i7 i7 iv7 iv7 i7 i7 VImaj7 V7 i7 V7  Cm7 Cm7 Cm7 Cm7 Fm7 Fm7 Cm7 Cm7 AbM7 G7 Cm7 G7\n", out.String())
+	out.Reset()
+	assert.Nil(t, listProgressions(&out, ""))
+	assert.Equal(t, len(progression.Corpus())+1, strings.Count(out.String(), "\n"))
+	assert.NotNil(t, listProgressions(&out, "polka"))
+}
+
+func TestListProgressionsExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "progressions")
+	assertExitCode(t, 0, "", "progressions", "--genre", "jazz")
+	assertExitCode(t, 1, "Error occurred: unknown genre \"polka\", expected one of blues, classical, jazz, pop, rock\n", "progressions", "--genre", "polka")
+}
@@ -0,0 +1,71 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-music-theory/music-theory/quiz"
+)
+
+// stdin to read the answers to a quiz from
+var stdin io.Reader = os.Stdin
+
+// runQuiz of some questions, reading each answer from a line of the reader, until the reader ends, then writing the score,
+// and writing the notes of each question to a MIDI file in a directory, if any, e.g. question-1.mid
+func runQuiz(r io.Reader, w io.Writer, s *quiz.Session, count int, midiDir string) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; n <= count; n++ {
+		q := s.Next()
+		if midiDir != "" {
+			path := filepath.Join(midiDir, fmt.Sprintf("question-%d.mid", n))
+			err := writeMIDIFile(path, q)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%d. %s (%s): ", n, q.Prompt, path)
+		} else {
+			fmt.Fprintf(w, "%d. %s: ", n, q.Prompt)
+		}
+		if !scanner.Scan() {
+			fmt.Fprintln(w)
+			break
+		}
+		correct, err := s.Answer(scanner.Text())
+		if err != nil {
+			return err
+		}
+		if correct {
+			fmt.Fprintln(w, "Correct!")
+		} else {
+			fmt.Fprintf(w, "Incorrect, it's %s%s\n", q.Answer, songOf(q))
+		}
+	}
+	fmt.Fprintf(w, "Score: %s\n", s.Score())
+	return scanner.Err()
+}
+
+// songOf a question to remember its answer by, e.g. ", as in Here Comes the Bride" of a P4, or nothing if there's none
+func songOf(q quiz.Question) string {
+	if len(q.Songs) == 0 {
+		return ""
+	}
+	return ", as in " + q.Songs[0].Title
+}
+
+// writeMIDIFile of the notes of a question
+func writeMIDIFile(path string, q quiz.Question) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = q.WriteMIDI(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,21 @@
+// Questions of a quiz are written as MIDI, so their notes can be heard
+package quiz
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// WriteMIDI of the notes of the question, a chord all at once as a whole note, or else one quarter note after another
+func (q Question) WriteMIDI(w io.Writer) error {
+	var notes []midi.Note
+	for n, nt := range q.Notes {
+		if q.Kind == Chords {
+			notes = append(notes, midi.Note{Number: midi.NumberOf(nt.Class, nt.Octave), Duration: midi.Whole})
+		} else {
+			notes = append(notes, midi.Note{Number: midi.NumberOf(nt.Class, nt.Octave), Start: n * midi.Quarter, Duration: midi.Quarter})
+		}
+	}
+	return midi.Write(w, notes)
+}
@@ -0,0 +1,38 @@
+// Questions of a quiz are written as MIDI, so their notes can be heard
+package quiz
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestQuestion_WriteMIDI_Interval(t *testing.T) {
+	assertMIDI(t, []midi.Note{
+		{Number: 60, Duration: midi.Quarter},
+		{Number: 64, Start: midi.Quarter, Duration: midi.Quarter},
+	}, Question{Kind: Intervals, Notes: []*note.Note{{Class: note.C, Octave: 4}, {Class: note.E, Octave: 4}}})
+}
+
+func TestQuestion_WriteMIDI_Chord(t *testing.T) {
+	assertMIDI(t, []midi.Note{
+		{Number: 60, Duration: midi.Whole},
+		{Number: 64, Duration: midi.Whole},
+		{Number: 67, Duration: midi.Whole},
+	}, Question{Kind: Chords, Notes: []*note.Note{{Class: note.C, Octave: 4}, {Class: note.E, Octave: 4}, {Class: note.G, Octave: 4}}})
+}
+
+//
+// Private
+//
+
+func assertMIDI(t *testing.T, expect []midi.Note, q Question) {
+	var expectOut, out bytes.Buffer
+	assert.Nil(t, midi.Write(&expectOut, expect))
+	assert.Nil(t, q.WriteMIDI(&out))
+	assert.Equal(t, expectOut.Bytes(), out.Bytes())
+}
@@ -0,0 +1,151 @@
+// Questions of a quiz are generated at random, and answered by name, e.g. "M3", "Cm7" or "D dorian"
+package quiz
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/interval"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Question of a quiz, prompting for the name of some notes
+type Question struct {
+	Kind   Kind
+	Prompt string          // e.g. "Name the interval from C4 up to E4"
+	Notes  []*note.Note    // of the prompt, in the order they're played, or all at once for a chord
+	Answer string          // expected, e.g. "M3", "Cm7" or "D dorian"
+	Songs  []interval.Song // opening with the interval of an interval question, to remember it by, e.g. Here Comes the Bride of a P4
+}
+
+// Check an answer to the question, which is correct if it names the same notes as the expected answer, regardless of spelling,
+// e.g. an interval of "m3" or "minor third", a chord of "C#m" or "Dbm", or a scale of "C major" or "C ionian"
+func (q Question) Check(answer string) bool {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return false
+	}
+	switch q.Kind {
+	case Intervals:
+		expect, ok := semitonesNamed(q.Answer)
+		actual, ok2 := semitonesNamed(answer)
+		return ok && ok2 && expect == actual
+	case Chords:
+		expect := chord.Of(q.Answer)
+		actual, err := chord.Parse(answer)
+		return err == nil && actual.Root == expect.Root && actual.ToneSet().Equal(expect.ToneSet())
+	case Scales:
+		expect := scale.Of(q.Answer)
+		actual, err := scale.Parse(answer)
+		return err == nil && actual.Root == expect.Root && actual.ToneSet().Equal(expect.ToneSet())
+	}
+	return false
+}
+
+//
+// Private
+//
+
+// quizOctave of the lowest note of every question
+const quizOctave = note.Octave(4)
+
+// roots of questions, spelled the way they're most often written
+var roots = []string{"C", "Db", "D", "Eb", "E", "F", "F#", "G", "Ab", "A", "Bb", "B"}
+
+// chordSuffixes of the chord questions, appended to a root
+var chordSuffixes = []string{"", "m", "7", "maj7", "m7", "dim", "aug", "dim7", "m7b5", "sus4", "6", "m6"}
+
+// scaleModes of the scale questions, appended to a root
+var scaleModes = []string{"major", "minor", "harmonic minor", "melodic minor ascend", "dorian", "phrygian", "lydian", "mixolydian", "locrian"}
+
+// intervalNames by semitones, the first of each the name of the answer, and the rest also accepted
+var intervalNames = [][]string{
+	1:  {"m2", "minor 2nd", "minor second", "half step", "semitone"},
+	2:  {"M2", "major 2nd", "major second", "whole step", "whole tone"},
+	3:  {"m3", "minor 3rd", "minor third", "A2"},
+	4:  {"M3", "major 3rd", "major third", "d4"},
+	5:  {"P4", "perfect 4th", "perfect fourth"},
+	6:  {"TT", "tritone", "A4", "augmented 4th", "augmented fourth", "d5", "diminished 5th", "diminished fifth"},
+	7:  {"P5", "perfect 5th", "perfect fifth"},
+	8:  {"m6", "minor 6th", "minor sixth", "A5"},
+	9:  {"M6", "major 6th", "major sixth", "d7"},
+	10: {"m7", "minor 7th", "minor seventh"},
+	11: {"M7", "major 7th", "major seventh"},
+	12: {"P8", "octave", "perfect octave"},
+}
+
+// intervalQuestion of two notes, the second some semitones above the first
+func intervalQuestion(r *rand.Rand) Question {
+	name := roots[r.Intn(len(roots))]
+	root, _ := note.ClassNamed(name)
+	adjSymbol := note.AdjSymbolOf(name)
+	semitones := 1 + r.Intn(12)
+	low := &note.Note{Class: root, Octave: quizOctave}
+	step := int(root) - 1 + semitones
+	high := &note.Note{Class: note.Class(step%12 + 1), Octave: quizOctave + note.Octave(step/12)}
+	return Question{
+		Kind:   Intervals,
+		Prompt: "Name the interval from " + nameOf(low, adjSymbol) + " up to " + nameOf(high, adjSymbol),
+		Notes:  []*note.Note{low, high},
+		Answer: intervalNames[semitones][0],
+		Songs:  interval.Songs(semitones, interval.Ascending),
+	}
+}
+
+// chordQuestion of the notes of a chord, from the root
+func chordQuestion(r *rand.Rand) Question {
+	name := roots[r.Intn(len(roots))] + chordSuffixes[r.Intn(len(chordSuffixes))]
+	a := chord.Arpeggiate(chord.Of(name), chord.Up, 1)
+	return Question{
+		Kind:   Chords,
+		Prompt: "Name the chord of " + namesOf(a.Notes, a.Chord.AdjSymbol),
+		Notes:  a.Notes,
+		Answer: name,
+	}
+}
+
+// scaleQuestion of the notes of a scale, from the root up an octave
+func scaleQuestion(r *rand.Rand) Question {
+	root := roots[r.Intn(len(roots))]
+	name := root + " " + scaleModes[r.Intn(len(scaleModes))]
+	s := scale.Of(name)
+	var notes []*note.Note
+	for _, p := range s.PitchesInRange(root+strconv.Itoa(int(quizOctave)), root+strconv.Itoa(int(quizOctave)+1)) {
+		notes = append(notes, &note.Note{Class: p.Class, Octave: p.Octave})
+	}
+	return Question{
+		Kind:   Scales,
+		Prompt: "Name the scale of " + namesOf(notes, s.AdjSymbol),
+		Notes:  notes,
+		Answer: name,
+	}
+}
+
+// semitonesNamed of an interval, by any of its names, with case ignored, except for the short names, where it matters, e.g. m3 and M3
+func semitonesNamed(name string) (int, bool) {
+	for semitones, names := range intervalNames {
+		for _, n := range names {
+			if name == n || (len(n) > 2 && strings.EqualFold(name, n)) {
+				return semitones, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// nameOf a note in scientific pitch notation, e.g. Eb4
+func nameOf(n *note.Note, adjSymbol note.AdjSymbol) string {
+	return n.Class.String(adjSymbol) + strconv.Itoa(int(n.Octave))
+}
+
+// namesOf notes, without their octaves, e.g. C Eb G
+func namesOf(notes []*note.Note, adjSymbol note.AdjSymbol) string {
+	var names []string
+	for _, n := range notes {
+		names = append(names, n.Class.String(adjSymbol))
+	}
+	return strings.Join(names, " ")
+}
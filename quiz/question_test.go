@@ -0,0 +1,103 @@
+// Questions of a quiz are generated at random, and answered by name, e.g. "M3", "Cm7" or "D dorian"
+package quiz
+
+import (
+	"math/rand"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/interval"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestIntervalQuestion(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100; n++ {
+		q := intervalQuestion(r)
+		assert.Equal(t, Intervals, q.Kind)
+		assert.Equal(t, 2, len(q.Notes))
+		semitones := int(q.Notes[1].Class) + int(q.Notes[1].Octave)*12 - int(q.Notes[0].Class) - int(q.Notes[0].Octave)*12
+		assert.Equal(t, intervalNames[semitones][0], q.Answer)
+		assert.Equal(t, interval.Names[semitones], q.Answer)
+		assert.NotEmpty(t, q.Songs)
+		assert.Equal(t, interval.Songs(semitones, interval.Ascending), q.Songs)
+		assert.True(t, q.Check(q.Answer))
+	}
+}
+
+func TestChordQuestion(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100; n++ {
+		q := chordQuestion(r)
+		assert.Equal(t, Chords, q.Kind)
+		assert.True(t, len(q.Notes) >= 3)
+		assert.True(t, q.Check(q.Answer), q.Answer)
+	}
+}
+
+func TestScaleQuestion(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100; n++ {
+		q := scaleQuestion(r)
+		assert.Equal(t, Scales, q.Kind)
+		assert.Equal(t, 8, len(q.Notes))
+		assert.Equal(t, q.Notes[0].Class, q.Notes[7].Class)
+		assert.True(t, q.Check(q.Answer), q.Answer)
+	}
+}
+
+func TestQuestion_Check_Intervals(t *testing.T) {
+	q := Question{Kind: Intervals, Answer: "TT"}
+	assert.True(t, q.Check("TT"))
+	assert.True(t, q.Check("A4"))
+	assert.True(t, q.Check("d5"))
+	assert.True(t, q.Check(" Tritone "))
+	assert.True(t, q.Check("augmented fourth"))
+	assert.False(t, q.Check("P5"))
+	assert.False(t, q.Check(""))
+	q = Question{Kind: Intervals, Answer: "m3"}
+	assert.True(t, q.Check("minor 3rd"))
+	assert.False(t, q.Check("M3"))
+}
+
+func TestQuestion_Check_Chords(t *testing.T) {
+	q := Question{Kind: Chords, Answer: "Dbm"}
+	assert.True(t, q.Check("Dbm"))
+	assert.True(t, q.Check("C#m"))
+	assert.True(t, q.Check("C# minor"))
+	assert.False(t, q.Check("Db"))
+	assert.False(t, q.Check("C jams"))
+	q = Question{Kind: Chords, Answer: "Cdim7"}
+	assert.False(t, q.Check("Ebdim7"))
+}
+
+func TestQuestion_Check_Scales(t *testing.T) {
+	q := Question{Kind: Scales, Answer: "C major"}
+	assert.True(t, q.Check("C ionian"))
+	assert.True(t, q.Check("C"))
+	assert.False(t, q.Check("A minor"))
+	assert.False(t, q.Check("H major"))
+	q = Question{Kind: Scales, Answer: "A minor"}
+	assert.True(t, q.Check("A aeolian"))
+}
+
+func TestQuestion_Check_UnknownKind(t *testing.T) {
+	assert.False(t, Question{Kind: "rhythms", Answer: "x"}.Check("x"))
+}
+
+func TestSemitonesNamed(t *testing.T) {
+	semitones, ok := semitonesNamed("P8")
+	assert.True(t, ok)
+	assert.Equal(t, 12, semitones)
+	_, ok = semitonesNamed("p8")
+	assert.False(t, ok)
+	_, ok = semitonesNamed("P9")
+	assert.False(t, ok)
+}
+
+func TestNamesOf(t *testing.T) {
+	notes := []*note.Note{{Class: note.C, Octave: 4}, {Class: note.Ds, Octave: 4}}
+	assert.Equal(t, "C Eb", namesOf(notes, note.Flat))
+	assert.Equal(t, "D#4", nameOf(notes[1], note.Sharp))
+}
@@ -0,0 +1,105 @@
+// An ear-training quiz asks randomized questions about intervals, chords or scales, checks the answers, and keeps score.
+//
+// The same seed always asks the same questions, e.g. to repeat a quiz, and every question's notes can be written as MIDI, to hear them.
+//
+// https://en.wikipedia.org/wiki/Ear_training
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package quiz
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// Kind of questions in a quiz
+type Kind string
+
+// Kinds of questions
+const (
+	Intervals Kind = "intervals"
+	Chords    Kind = "chords"
+	Scales    Kind = "scales"
+)
+
+// KindNames of all the kinds of questions
+var KindNames = []string{string(Intervals), string(Chords), string(Scales)}
+
+var (
+	// ErrUnknownKind when starting a quiz of a kind that isn't known, e.g. "rhythms"
+	ErrUnknownKind = errors.New("unknown kind")
+
+	// ErrNoQuestion when answering before a question has been asked, or answering the same question twice
+	ErrNoQuestion = errors.New("no question")
+)
+
+// Session of a quiz, asking one question at a time and keeping score of the answers
+type Session struct {
+	Kind    Kind
+	Asked   int // number of questions answered
+	Correct int // number of questions answered correctly
+
+	rand     *rand.Rand
+	question *Question
+}
+
+// New session of a quiz of a kind of questions, which are randomized by a seed, e.g. New(Intervals, 42)
+func New(kind Kind, seed int64) (*Session, error) {
+	return NewFrom(kind, rand.NewSource(seed))
+}
+
+// NewFrom a source of random numbers a session of a quiz of a kind of questions, the same questions of a source of the same numbers
+func NewFrom(kind Kind, src rand.Source) (*Session, error) {
+	switch kind {
+	case Intervals, Chords, Scales:
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownKind, kind)
+	}
+	return &Session{Kind: kind, rand: rand.New(src)}, nil
+}
+
+// Next question of the quiz, replacing any question that hasn't been answered
+func (s *Session) Next() Question {
+	var q Question
+	switch s.Kind {
+	case Intervals:
+		q = intervalQuestion(s.rand)
+	case Chords:
+		q = chordQuestion(s.rand)
+	case Scales:
+		q = scaleQuestion(s.rand)
+	}
+	s.question = &q
+	return q
+}
+
+// Answer the current question, keeping score, and returning whether the answer is correct
+func (s *Session) Answer(answer string) (bool, error) {
+	if s.question == nil {
+		return false, ErrNoQuestion
+	}
+	correct := s.question.Check(answer)
+	s.question = nil
+	s.Asked++
+	if correct {
+		s.Correct++
+	}
+	return correct, nil
+}
+
+// Score of the answers so far, e.g. "7/10 (70%)"
+func (s *Session) Score() string {
+	if s.Asked == 0 {
+		return "0/0"
+	}
+	return fmt.Sprintf("%d/%d (%d%%)", s.Correct, s.Asked, s.Correct*100/s.Asked)
+}
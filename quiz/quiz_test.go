@@ -0,0 +1,92 @@
+// An ear-training quiz asks randomized questions about intervals, chords or scales, checks the answers, and keeps score.
+package quiz
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/internal/golden"
+)
+
+func TestNew(t *testing.T) {
+	for _, kind := range KindNames {
+		s, err := New(Kind(kind), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, Kind(kind), s.Kind)
+		assert.Equal(t, Kind(kind), s.Next().Kind)
+	}
+}
+
+func TestNew_UnknownKind(t *testing.T) {
+	_, err := New("rhythms", 1)
+	assert.True(t, errors.Is(err, ErrUnknownKind))
+	assert.Equal(t, `unknown kind "rhythms"`, err.Error())
+}
+
+func TestSession_Next_Seed(t *testing.T) {
+	a, _ := New(Chords, 42)
+	b, _ := New(Chords, 42)
+	for n := 0; n < 10; n++ {
+		assert.Equal(t, a.Next(), b.Next())
+	}
+}
+
+func TestSession_Next_Golden(t *testing.T) {
+	var out strings.Builder
+	for _, kind := range KindNames {
+		s, _ := New(Kind(kind), 42)
+		for n := 0; n < 8; n++ {
+			q := s.Next()
+			fmt.Fprintf(&out, "%s: %s = %s\n", kind, q.Prompt, q.Answer)
+		}
+	}
+	golden.Assert(t, "next", out.String())
+}
+
+func TestNewFrom(t *testing.T) {
+	a, _ := New(Scales, 42)
+	b, err := NewFrom(Scales, rand.NewSource(42))
+	assert.Nil(t, err)
+	for n := 0; n < 10; n++ {
+		assert.Equal(t, a.Next(), b.Next())
+	}
+	_, err = NewFrom("rhythms", rand.NewSource(42))
+	assert.True(t, errors.Is(err, ErrUnknownKind))
+}
+
+func TestSession_Answer(t *testing.T) {
+	s, _ := New(Intervals, 7)
+	q := s.Next()
+	correct, err := s.Answer(q.Answer)
+	assert.Nil(t, err)
+	assert.True(t, correct)
+	s.Next()
+	correct, err = s.Answer("nonsense")
+	assert.Nil(t, err)
+	assert.False(t, correct)
+	assert.Equal(t, 2, s.Asked)
+	assert.Equal(t, 1, s.Correct)
+	assert.Equal(t, "1/2 (50%)", s.Score())
+}
+
+func TestSession_Answer_NoQuestion(t *testing.T) {
+	s, _ := New(Scales, 7)
+	_, err := s.Answer("C major")
+	assert.True(t, errors.Is(err, ErrNoQuestion))
+	q := s.Next()
+	_, err = s.Answer(q.Answer)
+	assert.Nil(t, err)
+	_, err = s.Answer(q.Answer)
+	assert.True(t, errors.Is(err, ErrNoQuestion))
+	assert.Equal(t, 1, s.Asked)
+}
+
+func TestSession_Score(t *testing.T) {
+	s, _ := New(Chords, 1)
+	assert.Equal(t, "0/0", s.Score())
+}
@@ -0,0 +1,64 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/interval"
+	"github.com/go-music-theory/music-theory/quiz"
+)
+
+func TestRunQuiz(t *testing.T) {
+	s, _ := quiz.New(quiz.Intervals, 1)
+	var out bytes.Buffer
+	assert.Nil(t, runQuiz(strings.NewReader("M3\nP5\nperfect fifth\n"), &out, s, 3, ""))
+	assert.Equal(t, "1. Name the interval from F4 up to A4: Correct!\n2. Name the interval from B4 up to B5: Incorrect, it's P8, as in Somewhere Over the Rainbow\n3. Name the interval from Db4 up to Ab4: Correct!\nScore: 2/3 (66%)\n", out.String())
+}
+
+func TestRunQuiz_EndOfAnswers(t *testing.T) {
+	s, _ := quiz.New(quiz.Chords, 1)
+	var out bytes.Buffer
+	assert.Nil(t, runQuiz(strings.NewReader("Fmaj7\n"), &out, s, 10, ""))
+	assert.Equal(t, "1. Name the chord of F A C E: Correct!\n2. Name the chord of B D Gb Ab: \nScore: 1/1 (100%)\n", out.String())
+}
+
+func TestRunQuiz_MIDI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quiz")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	s, _ := quiz.New(quiz.Scales, 1)
+	var out bytes.Buffer
+	assert.Nil(t, runQuiz(strings.NewReader("F lydian\nB harmonic minor\n"), &out, s, 2, dir))
+	for _, name := range []string{"question-1.mid", "question-2.mid"} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, name))
+		assert.Nil(t, err)
+		assert.Equal(t, "MThd", string(b[:4]))
+	}
+	assert.Contains(t, out.String(), filepath.Join(dir, "question-1.mid"))
+	assert.Contains(t, out.String(), "Score: 2/2 (100%)")
+}
+
+func TestRunQuiz_MIDIError(t *testing.T) {
+	s, _ := quiz.New(quiz.Scales, 1)
+	assert.NotNil(t, runQuiz(strings.NewReader(""), &bytes.Buffer{}, s, 1, filepath.Join(os.TempDir(), "no", "such", "dir")))
+}
+
+func TestQuizExitCode(t *testing.T) {
+	oldStdin := stdin
+	defer func() { stdin = oldStdin }()
+	stdin = strings.NewReader("C\n")
+	assertExitCode(t, 0, "", "quiz", "--count", "1", "--seed", "3", "chords")
+	assertExitCode(t, 1, "Error occurred: unknown kind \"rhythms\"\n", "quiz", "rhythms")
+}
+
+func TestSongOf(t *testing.T) {
+	assert.Equal(t, ", as in Here Comes the Bride", songOf(quiz.Question{Kind: quiz.Intervals, Answer: "P4", Songs: interval.Songs(5, interval.Ascending)}))
+	assert.Equal(t, "", songOf(quiz.Question{Kind: quiz.Chords, Answer: "Cm7"}))
+}
@@ -0,0 +1,50 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/reharm"
+)
+
+// reharmonize some chord names, in a key named, or if the key name is empty, the key of the progression, by some aggressiveness from 0 to 1, writing each alternative and its diff from the original
+func reharmonize(w io.Writer, names []string, keyName string, aggressiveness float64) error {
+	if !(aggressiveness >= 0 && aggressiveness <= 1) {
+		return fmt.Errorf("%w: %v, expected 0 to 1", reharm.ErrAggressivenessRange, aggressiveness)
+	}
+	var p progression.Progression
+	for _, name := range names {
+		c, err := chord.Parse(name)
+		if err != nil {
+			return err
+		}
+		p.Chords = append(p.Chords, c)
+	}
+	var k key.Key
+	if len(keyName) > 0 {
+		var err error
+		k, err = key.Parse(keyName)
+		if err != nil {
+			return err
+		}
+	}
+	reharms := reharm.Progression(p, k, aggressiveness)
+	if len(reharms) == 0 {
+		_, err := fmt.Fprintln(w, "No reharmonizations")
+		return err
+	}
+	for n, r := range reharms {
+		if n > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%d. %s\n", n+1, r.String())
+		if _, err := io.WriteString(w, r.Diff()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
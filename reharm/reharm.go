@@ -0,0 +1,263 @@
+// Reharmonization replaces some of the chords of a progression with substitutes, e.g. the tritone substitution of a dominant, to color a familiar tune anew.
+//
+// The cadence that ends a progression is preserved, while the chords before it are substituted, as many as the aggressiveness allows,
+// favoring the diatonic relatives of a chord when it's mild, and chromatic substitutes when it's bold, and any that move the root smoothly to the next chord.
+//
+// https://en.wikipedia.org/wiki/Reharmonization
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package reharm
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/substitute"
+)
+
+// Alternatives of reharmonization, at most
+const Alternatives = 3
+
+// ErrAggressivenessRange of an aggressiveness that isn't from 0 to 1, e.g. 1.5
+var ErrAggressivenessRange = errors.New("aggressiveness out of range")
+
+// Reharmonization of a progression, its chords after substitution, each change from the original, and its score, the higher the better
+type Reharmonization struct {
+	Progression progression.Progression
+	Names       []string // of each chord, e.g. "Db7"
+	Original    []string // names of the chords of the original progression, spelled in its key
+	Changes     []Change
+	Score       float64
+}
+
+// Change to a chord of the original progression, counted from 0, replacing it, or inserting a passing chord before it
+type Change struct {
+	Index        int
+	From         string // name of the original chord, e.g. "G7"
+	Substitution substitute.Substitution
+}
+
+// Progression reharmonized in a key, or if the key is Nil, the key of the progression, by some aggressiveness from 0 to 1,
+// the fraction of the chords before the final cadence that may be substituted, ranking the Alternatives from the best.
+// No chord of the cadence is substituted: the final chord, and the dominant or subdominant before a final tonic, nor any chord without a root, e.g. of a name that didn't parse.
+func Progression(p progression.Progression, k key.Key, aggressiveness float64) []Reharmonization {
+	if len(p.Chords) == 0 || aggressiveness <= 0 {
+		return nil
+	}
+	if k.Mode == key.Nil {
+		k = p.Key()
+	}
+	aggressiveness = math.Min(aggressiveness, 1)
+	cadence := cadenceOf(p, k)
+	maxChanges := int(math.Ceil(aggressiveness * float64(cadence)))
+	beam := []path{{}}
+	original := make([]string, len(p.Chords))
+	for n, c := range p.Chords {
+		c = c.SpelledIn(k)
+		original[n] = substitute.NameOf(c)
+		options := []option{{chords: []chord.Chord{c}, names: []string{substitute.NameOf(c)}}}
+		if n < cadence && c.Root != note.Nil {
+			for _, s := range substitute.For(c, k) {
+				if s.Kind == substitute.Passing && n == 0 {
+					continue // nothing to pass from
+				}
+				o := option{chords: []chord.Chord{s.Chord}, names: []string{s.Name}, change: &Change{Index: n, From: substitute.NameOf(c), Substitution: s}}
+				if s.Kind == substitute.Passing {
+					o.chords, o.names = append(o.chords, c), append(o.names, substitute.NameOf(c))
+				}
+				o.score = weightOf(s.Kind, aggressiveness)
+				options = append(options, o)
+			}
+		}
+		var next []path
+		for _, pa := range beam {
+			for _, o := range options {
+				if o.change != nil && len(pa.changes) == maxChanges || pa.repeats(o) {
+					continue
+				}
+				next = append(next, pa.then(o))
+			}
+		}
+		sort.SliceStable(next, func(i, j int) bool { return next[i].score > next[j].score })
+		if len(next) > beamWidth {
+			next = next[:beamWidth]
+		}
+		beam = next
+	}
+	var reharms []Reharmonization
+	seen := make(map[string]bool)
+	for _, pa := range beam {
+		if len(reharms) == Alternatives {
+			break
+		}
+		if len(pa.changes) == 0 || seen[strings.Join(pa.names, " ")] {
+			continue
+		}
+		seen[strings.Join(pa.names, " ")] = true
+		reharms = append(reharms, Reharmonization{Progression: progression.Progression{Chords: pa.chords}, Names: pa.names, Original: original, Changes: pa.changes, Score: pa.score})
+	}
+	return reharms
+}
+
+// String of the chords of the reharmonization, separated by bars, e.g. "Dm7 | Db7 | Cmaj7"
+func (r Reharmonization) String() string {
+	return strings.Join(r.Names, " | ")
+}
+
+// Diff of the reharmonization from the Original progression, a line for each chord, kept with a leading space,
+// or removed with a leading -, or added with a leading + and the kind and explanation of its substitution, e.g.
+//
+//      Dm7
+//    - G7
+//    + Db7  tritone: Db7 shares the tritone of G7, B and F, and resolves down a semitone to C, as G7 resolves down a fifth
+//      Cmaj7
+//
+func (r Reharmonization) Diff() string {
+	changes := make(map[int]Change)
+	for _, c := range r.Changes {
+		changes[c.Index] = c
+	}
+	width := 0
+	for _, name := range r.Names {
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+	var b strings.Builder
+	for n, name := range r.Original {
+		change, ok := changes[n]
+		switch {
+		case !ok:
+			b.WriteString("  " + name + "\n")
+		case change.Substitution.Kind == substitute.Passing:
+			b.WriteString("+ " + pad(change.Substitution.Name, width) + "  " + change.Substitution.String() + "\n")
+			b.WriteString("  " + name + "\n")
+		default:
+			b.WriteString("- " + name + "\n")
+			b.WriteString("+ " + pad(change.Substitution.Name, width) + "  " + change.Substitution.String() + "\n")
+		}
+	}
+	return b.String()
+}
+
+//
+// Private
+//
+
+// beamWidth of the search for the best reharmonizations
+const beamWidth = 32
+
+// Weights of the substitutions by kind, the diatonic relatives favored when mild, and the chromatic substitutes when bold
+const (
+	relativeWeight  = 1.0
+	chromaticWeight = 2.0 // times the aggressiveness
+	semitoneBonus   = 0.5 // of a root moving by semitone to the next, e.g. Db7 to C
+	fifthBonus      = 0.25
+)
+
+// option for a chord of the progression, kept, replaced, or preceded by a passing chord, with its change, if any, and its weight
+type option struct {
+	chords []chord.Chord
+	names  []string
+	change *Change
+	score  float64
+}
+
+// path of the search, the chords chosen so far, the changes made, whether its last chord is a substitute, not the original nor after a passing chord, and the score of them
+type path struct {
+	chords  []chord.Chord
+	names   []string
+	changes []Change
+	changed bool
+	score   float64
+}
+
+// then an option of the next chord, after the path, scoring how smoothly the root moves to it from the chord before
+func (pa path) then(o option) path {
+	next := path{
+		chords:  append(append([]chord.Chord{}, pa.chords...), o.chords...),
+		names:   append(append([]string{}, pa.names...), o.names...),
+		changes: pa.changes,
+		changed: o.change != nil && o.change.Substitution.Kind != substitute.Passing,
+		score:   pa.score + o.score,
+	}
+	if o.change != nil {
+		next.changes = append(append([]Change{}, pa.changes...), *o.change)
+		if len(pa.chords) > 0 {
+			next.score += motionOf(pa.chords[len(pa.chords)-1], o.chords[0])
+		}
+		for m := 1; m < len(o.chords); m++ {
+			next.score += motionOf(o.chords[m-1], o.chords[m])
+		}
+	} else if pa.changed {
+		next.score += motionOf(pa.chords[len(pa.chords)-1], o.chords[0]) // from a substitute to the chord after it
+	}
+	return next
+}
+
+// repeats the chord before, whether an option moves from the last chord of the path to itself, by a change of either,
+// e.g. C to the C substituted for Am, or passes from a chord back to it, e.g. Am G#dim7 Am, though the original may repeat a chord
+func (pa path) repeats(o option) bool {
+	if len(pa.names) == 0 {
+		return false
+	}
+	last := pa.names[len(pa.names)-1]
+	if o.change != nil && o.change.Substitution.Kind == substitute.Passing {
+		return last == o.names[len(o.names)-1]
+	}
+	return (o.change != nil || pa.changed) && last == o.names[0]
+}
+
+// weightOf a substitution of a kind, by the aggressiveness
+func weightOf(kind substitute.Kind, aggressiveness float64) float64 {
+	if kind == substitute.Relative {
+		return relativeWeight
+	}
+	return chromaticWeight * aggressiveness
+}
+
+// motionOf the root from one chord to the next, a bonus for moving by semitone or down a fifth, else nothing, e.g. to or from a chord without a root
+func motionOf(from, to chord.Chord) float64 {
+	if from.Root == note.Nil || to.Root == note.Nil {
+		return 0
+	}
+	switch (from.Root.Diff(to.Root) + 12) % 12 {
+	case 1, 11:
+		return semitoneBonus
+	case 5:
+		return fifthBonus
+	}
+	return 0
+}
+
+// cadenceOf a progression in a key, the index of its first chord to preserve, the final chord,
+// or the chord before it, if it's the dominant or subdominant of an authentic or plagal cadence to a final tonic
+func cadenceOf(p progression.Progression, k key.Key) int {
+	last := len(p.Chords) - 1
+	if last > 0 && k.Root != note.Nil && p.Chords[last].Root == k.Root && p.Chords[last-1].Root != note.Nil {
+		switch (k.Root.Diff(p.Chords[last-1].Root) + 12) % 12 {
+		case 7, 5:
+			return last - 1
+		}
+	}
+	return last
+}
+
+// pad a name with spaces to a width
+func pad(name string, width int) string {
+	return name + strings.Repeat(" ", width-len(name))
+}
@@ -0,0 +1,117 @@
+// Reharmonization replaces some of the chords of a progression with substitutes, e.g. the tritone substitution of a dominant, to color a familiar tune anew.
+package reharm
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/substitute"
+)
+
+func TestProgression(t *testing.T) {
+	reharms := Progression(progression.Of("Dm7", "G7", "Cmaj7"), key.Key{}, 1)
+	assert.Equal(t, []string{"Dmaj7 | G7 | Cmaj7", "Fmaj7 | G7 | Cmaj7"}, stringsOf(reharms))
+	assert.Equal(t, []string{"Dm7", "G7", "Cmaj7"}, reharms[0].Original)
+	assert.Equal(t, []Change{{Index: 0, From: "Dm7", Substitution: reharms[0].Changes[0].Substitution}}, reharms[0].Changes)
+	assert.Equal(t, substitute.Parallel, reharms[0].Changes[0].Substitution.Kind)
+	assert.Equal(t, 3, len(reharms[0].Progression.Chords))
+	assert.True(t, reharms[0].Score > reharms[1].Score)
+}
+
+func TestProgression_Aggressiveness(t *testing.T) {
+	p := progression.Of("C", "Am", "Dm7", "G7", "C")
+	mild := Progression(p, key.Of("C"), 0.3)
+	assert.Equal(t, []string{"Em | Am | Dm7 | G7 | C", "C | F | Dm7 | G7 | C", "C | G#dim7 | Am | Dm7 | G7 | C"}, stringsOf(mild))
+	for _, r := range mild {
+		assert.Equal(t, 1, len(r.Changes))
+	}
+	bold := Progression(p, key.Of("C"), 5)
+	assert.Equal(t, "Cm | G#dim7 | Am | C#dim7 | Dm7 | G7 | C", bold[0].String())
+	assert.Equal(t, 3, len(bold[0].Changes))
+	assert.Equal(t, Alternatives, len(bold))
+	assert.Nil(t, Progression(p, key.Of("C"), 0))
+	assert.Nil(t, Progression(progression.Progression{}, key.Of("C"), 1))
+}
+
+func TestProgression_PreservesCadence(t *testing.T) {
+	for _, r := range Progression(progression.Of("C", "Am", "F", "G7", "C"), key.Of("C"), 1) {
+		assert.Equal(t, []string{"G7", "C"}, r.Names[len(r.Names)-2:])
+	}
+	for _, r := range Progression(progression.Of("Am", "F", "C"), key.Of("C"), 1) {
+		assert.Equal(t, []string{"F", "C"}, r.Names[len(r.Names)-2:])
+	}
+	changed := false // the F before a final Am isn't a cadence
+	for _, r := range Progression(progression.Of("C", "F", "Am"), key.Of("C"), 1) {
+		assert.Equal(t, "Am", r.Names[len(r.Names)-1])
+		for _, c := range r.Changes {
+			changed = changed || c.Index == 1
+		}
+	}
+	assert.True(t, changed)
+}
+
+func TestProgression_NoRoot(t *testing.T) {
+	for _, r := range Progression(progression.Of("Am", "X", "G7", "C"), key.Of("C"), 1) {
+		for _, c := range r.Changes {
+			assert.Equal(t, 0, c.Index, r.String())
+		}
+	}
+	assert.NotEmpty(t, Progression(progression.Of("Am", "X", "G7", "C"), key.Of("C"), 1))
+	assert.Nil(t, Progression(progression.Of("X", "C"), key.Of("C"), 1))
+	assert.Nil(t, Progression(progression.Of("X", "C"), key.Key{}, 1))
+}
+
+func TestProgression_NoRepeatedChord(t *testing.T) {
+	for _, p := range []progression.Progression{progression.Of("C", "Am", "F", "G7", "C"), progression.Of("C", "Am", "Dm7", "G7", "C"), progression.Of("F", "C", "Am", "G")} {
+		for _, aggressiveness := range []float64{0.3, 0.6, 1} {
+			for _, r := range Progression(p, key.Of("C"), aggressiveness) {
+				for n := 1; n < len(r.Names); n++ {
+					assert.NotEqual(t, r.Names[n-1], r.Names[n], r.String())
+				}
+			}
+		}
+	}
+	assert.Contains(t, stringsOf(Progression(progression.Of("C", "C", "F", "G", "C"), key.Of("C"), 0.3)), "C | C | Dm | G | C") // as the original repeats it
+}
+
+func TestProgression_PassingToAnotherChord(t *testing.T) {
+	for _, p := range []progression.Progression{progression.Of("C", "Am", "Dm7", "G7", "C"), progression.Of("Am", "F", "C", "G"), progression.Of("C", "F", "Am")} {
+		for _, aggressiveness := range []float64{0.3, 0.6, 1} {
+			for _, r := range Progression(p, key.Of("C"), aggressiveness) {
+				for n := 2; n < len(r.Names); n++ {
+					if passing := r.Names[n-1]; strings.HasSuffix(passing, "dim7") {
+						assert.NotEqual(t, r.Names[n-2], r.Names[n], r.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestReharmonization_Diff(t *testing.T) {
+	reharms := Progression(progression.Of("C", "Am", "Dm7", "G7", "C"), key.Of("C"), 1)
+	assert.Equal(t, "- C\n"+
+		"+ Cm      parallel: Cm, borrowed from the parallel minor of C, shares its root and fifth\n"+
+		"+ G#dim7  passing diminished: G#dim7, a semitone below Am, passes into it, each of its tones leading by step to a tone of Am\n"+
+		"  Am\n"+
+		"+ C#dim7  passing diminished: C#dim7, a semitone below Dm7, passes into it, each of its tones leading by step to a tone of Dm7\n"+
+		"  Dm7\n"+
+		"  G7\n"+
+		"  C\n", reharms[0].Diff())
+}
+
+//
+// Private
+//
+
+func stringsOf(reharms []Reharmonization) []string {
+	var s []string
+	for _, r := range reharms {
+		s = append(s, r.String())
+	}
+	return s
+}
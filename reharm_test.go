@@ -0,0 +1,51 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/reharm"
+)
+
+func TestReharmonize(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, reharmonize(&out, []string{"Dm7", "G7", "Cmaj7"}, "C", 1))
+	assert.Equal(t, "1. Dmaj7 | G7 | Cmaj7\n"+
+		"- Dm7\n"+
+		"+ Dmaj7  parallel: Dmaj7, borrowed from the parallel major of Dm7, shares its root and fifth\n"+
+		"  G7\n"+
+		"  Cmaj7\n"+
+		"\n"+
+		"2. Fmaj7 | G7 | Cmaj7\n"+
+		"- Dm7\n"+
+		"+ Fmaj7  relative: Fmaj7, the relative major of Dm7, shares F, A and C\n"+
+		"  G7\n"+
+		"  Cmaj7\n", out.String())
+	out.Reset()
+	assert.Nil(t, reharmonize(&out, []string{"C"}, "", 1))
+	assert.Equal(t, "No reharmonizations\n", out.String())
+	assert.NotNil(t, reharmonize(&out, []string{"Hb"}, "", 1))
+	assert.NotNil(t, reharmonize(&out, []string{"C"}, "H major", 1))
+}
+
+func TestReharmonize_AggressivenessRange(t *testing.T) {
+	var out bytes.Buffer
+	for _, aggressiveness := range []float64{-0.1, 1.5, math.NaN()} {
+		err := reharmonize(&out, []string{"Dm7", "G7", "Cmaj7"}, "C", aggressiveness)
+		assert.True(t, errors.Is(err, reharm.ErrAggressivenessRange), "of %v", aggressiveness)
+	}
+	assert.Nil(t, reharmonize(&out, []string{"Dm7", "G7", "Cmaj7"}, "C", 0))
+	assert.Equal(t, "No reharmonizations\n", out.String())
+}
+
+func TestReharmExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "reharm")
+	assertExitCode(t, 0, "", "reharm", "--key", "C", "--aggressiveness", "0.3", "C", "Am", "Dm7", "G7", "C")
+	assertExitCode(t, 1, "Error occurred: unknown root \"Hb\" at position 0 of chord \"Hb\"\n", "reharm", "Hb")
+	assertExitCode(t, 1, "Error occurred: aggressiveness out of range: 1.5, expected 0 to 1\n", "reharm", "--aggressiveness", "1.5", "C", "G7", "C")
+}
@@ -0,0 +1,218 @@
+// Render ABC notation of a chord, scale, key, progression, arpeggio or tune, e.g. to share it as plain text or play it with abc2midi
+package render
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+// abcBeats of every bar, in quarter notes, with the unit note length a quarter note
+const abcBeats = 4
+
+func renderABC(w io.Writer, v interface{}, o Options) error {
+	k := key.Of("C")
+	var bars []string
+	switch t := v.(type) {
+	case chord.Chord:
+		bars = append(bars, abcChord(chordVoicing(t), nil))
+	case scale.Scale:
+		bars = abcMelody(scaleVoicing(t), nil)
+	case key.Key:
+		k = t
+		bars = abcMelody(scaleVoicing(keyScale(t)), abcSignatureOf(t))
+	case progression.Progression:
+		if len(t.Chords) > 0 {
+			k = t.Key()
+		}
+		for _, c := range t.Chords {
+			bars = append(bars, abcChord(chordVoicing(c), abcSignatureOf(k)))
+		}
+	case chord.Arpeggio:
+		bars = abcMelody(arpeggioVoicing(t), nil)
+	case melody.Tune:
+		return abcTune(w, t)
+	default:
+		return unsupported(ABC, v)
+	}
+	_, err := fmt.Fprintf(w, "X:1\nM:%d/4\nL:1/4\nK:%s\n%s |]\n", abcBeats, abcKeyOf(k), strings.Join(bars, " | "))
+	return err
+}
+
+// abcChord of simultaneous tones for a whole bar, e.g. [CEG]4
+func abcChord(v voicing, signature map[string]int) string {
+	bar := make(map[string]int)
+	var pitches []string
+	for _, t := range v.Tones {
+		pitches = append(pitches, abcPitch(t, v.AdjSymbol, signature, bar))
+	}
+	return "[" + strings.Join(pitches, "") + "]" + strconv.Itoa(abcBeats)
+}
+
+// abcMelody of successive quarter note tones, in bars, e.g. C D E F | G A B c
+func abcMelody(v voicing, signature map[string]int) []string {
+	var bars []string
+	var bar map[string]int
+	var pitches []string
+	for n, t := range v.Tones {
+		if n%abcBeats == 0 {
+			if len(pitches) > 0 {
+				bars = append(bars, strings.Join(pitches, " "))
+			}
+			bar, pitches = make(map[string]int), nil
+		}
+		pitches = append(pitches, abcPitch(t, v.AdjSymbol, signature, bar))
+	}
+	if len(pitches) > 0 {
+		bars = append(bars, strings.Join(pitches, " "))
+	}
+	return bars
+}
+
+// abcTune of its title, meter, tempo and key, with an eighth note unit, then its measures, e.g. !1!G2 !2!A !3!B-, each note fingered and articulated if it's the first of it,
+// tied to the next of it, and beginning or ending any slur
+func abcTune(w io.Writer, t melody.Tune) error {
+	beats, unit := t.Meter.Beats, t.Meter.Unit
+	if unit == 0 {
+		beats, unit = abcBeats, 4
+	}
+	k := t.Key
+	if k.Mode == key.Nil {
+		k = key.Of("C")
+	}
+	header := "X:1\n"
+	if len(t.Title) > 0 {
+		header += fmt.Sprintf("T:%s\n", t.Title)
+	}
+	header += fmt.Sprintf("M:%d/%d\nL:1/8\n", beats, unit)
+	if t.Tempo > 0 {
+		header += fmt.Sprintf("Q:1/%d=%g\n", unit, t.Tempo)
+	}
+	signature := abcSignatureOf(k)
+	var bars []string
+	for _, events := range tuneMeasures(t) {
+		bar := make(map[string]int)
+		var written []string
+		for _, e := range events {
+			written = append(written, abcEvent(t, e, signature, bar))
+		}
+		bars = append(bars, strings.Join(written, " "))
+	}
+	_, err := fmt.Fprintf(w, "%sK:%s\n%s |]\n", header, abcKeyOf(k), strings.Join(bars, " | "))
+	return err
+}
+
+// abcEvent of a tune, a note or rest and its length in eighth notes, e.g. !3!.c/2, fingered and articulated if it's the first of a note, tied to the next of it, and beginning or ending any slur
+func abcEvent(t melody.Tune, e tuneEvent, signature map[string]int, bar map[string]int) string {
+	length := abcLengthOf(e.Length.Ticks)
+	if e.Note < 0 {
+		return "z" + length
+	}
+	nt := t.Notes[e.Note]
+	var written string
+	if e.First {
+		if slurBegins(t.Notes, e.Note) {
+			written += "("
+		}
+		if nt.Finger > 0 {
+			written += fmt.Sprintf("!%d!", nt.Finger)
+		}
+		if nt.Articulation.Has(melody.Staccato) {
+			written += "."
+		}
+		if nt.Articulation.Has(melody.Tenuto) {
+			written += "!tenuto!"
+		}
+		if nt.Articulation.Has(melody.Accent) {
+			written += "!>!"
+		}
+	}
+	written += abcPitch(tuneTone(nt), t.Key.AdjSymbol, signature, bar) + length
+	if e.Tied {
+		written += "-"
+	} else if slurEnds(t.Notes, e.Note) {
+		written += ")"
+	}
+	return written
+}
+
+// abcLengthOf some ticks, in eighth notes, e.g. 2 of a quarter note, 3/2 of a dotted eighth, /2 of a sixteenth, or nothing of an eighth
+func abcLengthOf(ticks int) string {
+	divisor, rest := ticks, midi.Eighth
+	for rest > 0 {
+		divisor, rest = rest, divisor%rest
+	}
+	num, den := ticks/divisor, midi.Eighth/divisor
+	switch {
+	case den == 1 && num == 1:
+		return ""
+	case den == 1:
+		return strconv.Itoa(num)
+	case num == 1:
+		return "/" + strconv.Itoa(den)
+	}
+	return strconv.Itoa(num) + "/" + strconv.Itoa(den)
+}
+
+// abcPitch of a tone, e.g. C for middle C, ^c for the C# above it, or z (a rest) for the Nil class,
+// with an accidental only if its alteration differs from the key signature or an accidental earlier in the bar, which is remembered
+func abcPitch(t tone, adjSymbol note.AdjSymbol, signature map[string]int, bar map[string]int) string {
+	letter, alter := spellingOf(t.Class, adjSymbol)
+	if letter == "" {
+		return "z"
+	}
+	pitch := letter
+	switch {
+	case t.Octave > 4:
+		pitch = strings.ToLower(letter) + strings.Repeat("'", int(t.Octave)-5)
+	case t.Octave < 4:
+		pitch = letter + strings.Repeat(",", 4-int(t.Octave))
+	}
+	current, ok := bar[pitch]
+	if !ok {
+		current = signature[letter]
+	}
+	bar[pitch] = alter
+	if alter == current {
+		return pitch
+	}
+	switch alter {
+	case 1:
+		return "^" + pitch
+	case -1:
+		return "_" + pitch
+	}
+	return "=" + pitch
+}
+
+// abcKeyOf a key, e.g. Eb for E-flat major or F#m for F-sharp minor
+func abcKeyOf(k key.Key) string {
+	name := k.Root.String(k.AdjSymbol)
+	if k.Mode == key.Minor {
+		name += "m"
+	}
+	return name
+}
+
+// abcSignatureOf a key, the alteration of each letter in its scale
+func abcSignatureOf(k key.Key) map[string]int {
+	signature := make(map[string]int)
+	for _, t := range scaleVoicing(keyScale(k)).Tones {
+		letter, alter := spellingOf(t.Class, k.AdjSymbol)
+		signature[letter] = alter
+	}
+	return signature
+}
@@ -0,0 +1,98 @@
+// Render ABC notation of a chord, scale, key, progression, arpeggio or tune, e.g. to share it as plain text or play it with abc2midi
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderABC_Chord(t *testing.T) {
+	assertABC(t, "X:1\nM:4/4\nL:1/4\nK:C\n[C_EG_B]4 |]\n", chord.Of("Cm7"))
+}
+
+func TestRenderABC_Scale(t *testing.T) {
+	assertABC(t, "X:1\nM:4/4\nL:1/4\nK:C\nA B c d | e f g |]\n", scale.Of("A minor"))
+	assertABC(t, "X:1\nM:4/4\nL:1/4\nK:C\nC D _E F | G _A B |]\n", scale.Of("C harmonic minor"))
+}
+
+func TestRenderABC_Key(t *testing.T) {
+	assertABC(t, "X:1\nM:4/4\nL:1/4\nK:Eb\nE F G A | B c d |]\n", key.Of("Eb"))
+	assertABC(t, "X:1\nM:4/4\nL:1/4\nK:Dm\nD E F G | A B c |]\n", key.Of("D minor"))
+}
+
+func TestRenderABC_Progression(t *testing.T) {
+	assertABC(t, "X:1\nM:4/4\nL:1/4\nK:C\n[DFAc]4 | [GBdf]4 | [CEG]4 |]\n", progression.Of("Dm7", "G7", "C"))
+	assertABC(t, "X:1\nM:4/4\nL:1/4\nK:C\n |]\n", progression.Of())
+}
+
+func TestRenderABC_Arpeggio(t *testing.T) {
+	assertABC(t, "X:1\nM:4/4\nL:1/4\nK:C\nC E G B | c e g b | g e c B | G E |]\n", chord.Arpeggiate(chord.Of("Cmaj7"), chord.UpDown, 2))
+}
+
+func TestRenderABC_Tune(t *testing.T) {
+	assertABC(t, "X:1\nM:3/4\nL:1/8\nK:F\n.C2 !>!D2 (E2- | E F G2) z2 | .!tenuto!B4 z2 |]\n", testTune(t))
+	tune := testTune(t)
+	tune.Title, tune.Tempo = "Practice", 80
+	tune.Notes[0].Finger, tune.Notes[1].Finger = 1, 2
+	assertABC(t, "X:1\nT:Practice\nM:3/4\nL:1/8\nQ:1/4=80\nK:F\n!1!.C2 !2!!>!D2 (E2- | E F G2) z2 | .!tenuto!B4 z2 |]\n", tune)
+	assertABC(t, "X:1\nM:4/4\nL:1/8\nK:C\nC/2 z6 z3/2 |]\n", melody.Tune{Notes: []melody.Note{{Class: note.C, Octave: 4, Beats: 0.25}}})
+}
+
+func TestRenderABC_Tune_Read(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderABC(&out, testTune(t), Options{}))
+	read, err := melody.ReadABC(strings.NewReader(out.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, testTune(t).Key, read.Key)
+	assert.Equal(t, len(testTune(t).Notes), len(read.Notes))
+	for n, nt := range testTune(t).Notes {
+		assert.Equal(t, nt.Step(), read.Notes[n].Step())
+		assert.Equal(t, nt.Beat, read.Notes[n].Beat)
+		assert.Equal(t, nt.Articulation, read.Notes[n].Articulation)
+	}
+}
+
+func TestABCLengthOf(t *testing.T) {
+	assert.Equal(t, "", abcLengthOf(midi.Eighth))
+	assert.Equal(t, "2", abcLengthOf(midi.Quarter))
+	assert.Equal(t, "3", abcLengthOf(midi.Quarter*3/2))
+	assert.Equal(t, "3/2", abcLengthOf(midi.Eighth*3/2))
+	assert.Equal(t, "/4", abcLengthOf(midi.Quarter/8))
+}
+
+func TestABCPitch(t *testing.T) {
+	bar := make(map[string]int)
+	assert.Equal(t, "^F", abcPitch(tone{Class: note.Fs, Octave: 4}, note.Sharp, nil, bar))
+	assert.Equal(t, "F", abcPitch(tone{Class: note.Fs, Octave: 4}, note.Sharp, nil, bar))
+	assert.Equal(t, "=F", abcPitch(tone{Class: note.F, Octave: 4}, note.Sharp, nil, bar))
+	assert.Equal(t, "f", abcPitch(tone{Class: note.F, Octave: 5}, note.Sharp, nil, bar))
+	assert.Equal(t, "c''", abcPitch(tone{Class: note.C, Octave: 7}, note.Sharp, nil, bar))
+	assert.Equal(t, "C,,", abcPitch(tone{Class: note.C, Octave: 2}, note.Sharp, nil, bar))
+	assert.Equal(t, "z", abcPitch(tone{Class: note.Nil, Octave: 4}, note.Sharp, nil, bar))
+	assert.Equal(t, "=B", abcPitch(tone{Class: note.B, Octave: 4}, note.Sharp, map[string]int{"B": -1}, bar))
+}
+
+func TestRenderABC_Unsupported(t *testing.T) {
+	assert.NotNil(t, renderABC(&bytes.Buffer{}, 42, Options{}))
+}
+
+//
+// Private
+//
+
+func assertABC(t *testing.T, expect string, v interface{}) {
+	var out bytes.Buffer
+	assert.Nil(t, renderABC(&out, v, Options{}))
+	assert.Equal(t, expect, out.String())
+}
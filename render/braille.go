@@ -0,0 +1,172 @@
+// Render Braille music notation of a chord, scale, key, progression or arpeggio, in Unicode braille cells, e.g. to read on a braille display
+package render
+
+import (
+	"io"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+// Durations of a note, by the dots 3 and 6 of its cell, written as their digits
+const (
+	brailleQuarter = 6
+	brailleWhole   = 36
+)
+
+// brailleFinalBar at the end of the music
+const brailleFinalBar = "⠣⠅"
+
+// brailleLetterDots of the cell of each letter, by its dots 1, 2, 4 and 5, e.g. 145 of C
+var brailleLetterDots = map[byte]int{'C': 145, 'D': 15, 'E': 124, 'F': 1245, 'G': 125, 'A': 24, 'B': 245}
+
+// brailleOctaveDots of the mark of each octave, e.g. 5 of the octave from middle C
+var brailleOctaveDots = map[int]string{0: "⠈⠈", 1: "⠈", 2: "⠘", 3: "⠸", 4: "⠐", 5: "⠨", 6: "⠰", 7: "⠠", 8: "⠠⠠"}
+
+// brailleAccidentals of a sharp, flat or natural, by the accidental of a note on the staff
+var brailleAccidentals = map[string]string{"#": "⠩", "b": "⠣", "n": "⠡"}
+
+// brailleIntervals of the sign of each interval below the written note of a chord, from a second to an octave
+var brailleIntervals = map[int]string{2: "⠌", 3: "⠬", 4: "⠼", 5: "⠔", 6: "⠴", 7: "⠒", 8: "⠤"}
+
+// brailleNumbers of the upper cells of the digits 1 to 7 after the numeric sign, for a key signature of many sharps or flats
+var brailleNumbers = map[int]string{1: "⠁", 2: "⠃", 3: "⠉", 4: "⠙", 5: "⠑", 6: "⠋", 7: "⠛"}
+
+// brailleNumericSign before a number
+const brailleNumericSign = "⠼"
+
+// brailleMusic written so far, remembering the step of the last written note, to mark its octave only when the reader can't tell it
+type brailleMusic struct {
+	Signature map[string]int
+	prev      int
+	started   bool
+}
+
+func renderBraille(w io.Writer, v interface{}, o Options) error {
+	k := key.Of("C")
+	m := &brailleMusic{}
+	var measures []string
+	switch t := v.(type) {
+	case chord.Chord:
+		measures = append(measures, m.chord(chordVoicing(t)))
+	case scale.Scale:
+		measures = m.melody(scaleVoicing(t))
+	case key.Key:
+		k = t
+		m.Signature = abcSignatureOf(t)
+		measures = m.melody(scaleVoicing(keyScale(t)))
+	case progression.Progression:
+		if len(t.Chords) > 0 {
+			k = t.Key()
+		}
+		m.Signature = abcSignatureOf(k)
+		for _, c := range t.Chords {
+			measures = append(measures, m.chord(chordVoicing(c)))
+		}
+	case chord.Arpeggio:
+		measures = m.melody(arpeggioVoicing(t))
+	default:
+		return unsupported(Braille, v)
+	}
+	music := strings.Join(measures, " ") + brailleFinalBar
+	if signature := brailleSignatureOf(k); len(signature) > 0 {
+		music = signature + " " + music
+	}
+	_, err := io.WriteString(w, music+"\n")
+	return err
+}
+
+// chord of simultaneous tones for a whole measure, its highest tone written as a whole note, and each tone below it by the sign of its interval from that note,
+// with the mark of its octave before the sign if the interval is more than an octave
+func (m *brailleMusic) chord(v voicing) string {
+	bar := make(map[int]int)
+	var notes []staffNote
+	for _, t := range v.Tones {
+		notes = append(notes, staffNoteOf(t, v.AdjSymbol, m.Signature, bar))
+	}
+	if len(notes) == 0 {
+		return ""
+	}
+	top := notes[len(notes)-1]
+	cells := m.note(top, brailleWhole)
+	for i := len(notes) - 2; i >= 0; i-- {
+		n := notes[i]
+		interval := top.Step - n.Step + 1
+		if interval < 2 {
+			continue
+		}
+		cells += brailleAccidentals[n.Accidental]
+		if interval > 8 {
+			cells += brailleOctaveDots[n.Step/7]
+		}
+		simple := (interval-2)%7 + 2
+		cells += brailleIntervals[simple]
+	}
+	return cells
+}
+
+// melody of successive quarter note tones, in measures of 4
+func (m *brailleMusic) melody(v voicing) []string {
+	var measures []string
+	var bar map[int]int
+	var cells string
+	for n, t := range v.Tones {
+		if n%abcBeats == 0 {
+			if len(cells) > 0 {
+				measures = append(measures, cells)
+			}
+			bar, cells = make(map[int]int), ""
+		}
+		cells += m.note(staffNoteOf(t, v.AdjSymbol, m.Signature, bar), brailleQuarter)
+	}
+	if len(cells) > 0 {
+		measures = append(measures, cells)
+	}
+	return measures
+}
+
+// note of a duration, after its accidental, if any, and the mark of its octave if it's the first note,
+// or it leaps a sixth or more from the last note, or a fourth or fifth into another octave
+func (m *brailleMusic) note(n staffNote, duration int) string {
+	cells := brailleAccidentals[n.Accidental]
+	leap := n.Step - m.prev
+	if leap < 0 {
+		leap = -leap
+	}
+	if !m.started || leap >= 5 || (leap >= 3 && n.Step/7 != m.prev/7) {
+		cells += brailleOctaveDots[n.Step/7]
+	}
+	m.prev, m.started = n.Step, true
+	return cells + brailleCellOf(brailleLetterDots[staffLetters[n.Step%7]]*100+duration)
+}
+
+// brailleSignatureOf a key, e.g. ⠣⠣ of two flats, or ⠼⠙⠩ of four sharps, or empty of none
+func brailleSignatureOf(k key.Key) string {
+	fifths := k.Fifths()
+	sign := brailleAccidentals["#"]
+	if fifths < 0 {
+		fifths, sign = -fifths, brailleAccidentals["b"]
+	}
+	if fifths > 3 {
+		return brailleNumericSign + brailleNumbers[fifths] + sign
+	}
+	return strings.Repeat(sign, fifths)
+}
+
+// brailleCellOf the dots raised, written as their digits, e.g. 1456 of ⠹
+func brailleCellOf(dots int) string {
+	cell := rune(0x2800)
+	for ; dots > 0; dots /= 10 {
+		if d := dots % 10; d > 0 {
+			cell |= 1 << uint(d-1)
+		}
+	}
+	return string(cell)
+}
@@ -0,0 +1,66 @@
+// Render Braille music notation of a chord, scale, key, progression or arpeggio, in Unicode braille cells, e.g. to read on a braille display
+package render
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderBraille_Chord(t *testing.T) {
+	assertBraille(t, "⠣⠐⠾⠬⠣⠔⠒⠣⠅\n", chord.Of("Cm7"))
+	assertBraille(t, "⠨⠮⠬⠔⠩⠤⠐⠌⠐⠴⠣⠅\n", chord.Of("C13"))
+}
+
+func TestRenderBraille_Scale(t *testing.T) {
+	assertBraille(t, "⠐⠹⠱⠫⠻ ⠳⠪⠺⠣⠅\n", scale.Of("C major"))
+	assertBraille(t, "⠐⠹⠱⠣⠫⠻ ⠳⠣⠪⠺⠣⠅\n", scale.Of("C harmonic minor"))
+}
+
+func TestRenderBraille_Key(t *testing.T) {
+	assertBraille(t, "⠣⠣⠣ ⠐⠫⠻⠳⠪ ⠺⠹⠱⠣⠅\n", key.Of("Eb"))
+	assertBraille(t, "⠼⠙⠩ ⠐⠫⠻⠳⠪ ⠺⠹⠱⠣⠅\n", key.Of("E"))
+}
+
+func TestRenderBraille_Progression(t *testing.T) {
+	assertBraille(t, "⠨⠽⠬⠔⠒ ⠿⠬⠔⠒ ⠐⠷⠬⠔⠣⠅\n", progression.Of("Dm7", "G7", "C"))
+	assertBraille(t, "⠣⠅\n", progression.Of())
+}
+
+func TestRenderBraille_Arpeggio(t *testing.T) {
+	assertBraille(t, "⠐⠹⠫⠳⠨⠹ ⠫⠳⠣⠅\n", chord.Arpeggiate(chord.Of("C"), chord.Up, 2))
+}
+
+func TestRenderBraille_Unsupported(t *testing.T) {
+	assert.True(t, errors.Is(renderBraille(new(bytes.Buffer), 42, Options{}), ErrUnsupported))
+}
+
+func TestBrailleSignatureOf(t *testing.T) {
+	assert.Equal(t, "", brailleSignatureOf(key.Of("C")))
+	assert.Equal(t, "⠩⠩", brailleSignatureOf(key.Of("D")))
+	assert.Equal(t, "⠣", brailleSignatureOf(key.Of("D minor")))
+	assert.Equal(t, "⠼⠑⠣", brailleSignatureOf(key.Of("Db")))
+}
+
+func TestBrailleCellOf(t *testing.T) {
+	assert.Equal(t, "⠀", brailleCellOf(0))
+	assert.Equal(t, "⠹", brailleCellOf(1456))
+	assert.Equal(t, "⠿", brailleCellOf(123456))
+}
+
+//
+// Private
+//
+
+func assertBraille(t *testing.T, expect string, v interface{}) {
+	var out bytes.Buffer
+	assert.Nil(t, renderBraille(&out, v, Options{}))
+	assert.Equal(t, expect, out.String())
+}
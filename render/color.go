@@ -0,0 +1,70 @@
+// Notes are highlighted in ANSI color, e.g. for a terminal: the tonic in one color, chord tones and tensions in others, and scale degrees shaded
+package render
+
+import (
+	"strings"
+)
+
+//
+// Private
+//
+
+// ANSI color codes
+const (
+	colorReset   = "\x1b[0m"
+	colorTonic   = "\x1b[1;31m" // bold red
+	colorTone    = "\x1b[32m"   // green
+	colorTension = "\x1b[33m"   // yellow
+)
+
+// colorDegrees of a scale, shades of blue from the 2nd degree to the 7th and beyond, in 256-color mode
+var colorDegrees = []string{
+	"\x1b[38;5;117m",
+	"\x1b[38;5;111m",
+	"\x1b[38;5;75m",
+	"\x1b[38;5;69m",
+	"\x1b[38;5;33m",
+	"\x1b[38;5;27m",
+}
+
+// colorChordTone by its interval: the root is the tonic, the 3rd, 5th and 7th are chord tones, and all others are tensions
+func colorChordTone(interval int) string {
+	switch interval {
+	case 1:
+		return colorTonic
+	case 3, 5, 7:
+		return colorTone
+	}
+	return colorTension
+}
+
+// colorScaleDegree by its number: the tonic, else a shade for each degree above it
+func colorScaleDegree(degree int) string {
+	if degree <= 1 {
+		return colorTonic
+	}
+	i := degree - 2
+	if i >= len(colorDegrees) {
+		i = len(colorDegrees) - 1
+	}
+	return colorDegrees[i]
+}
+
+// colored text, unless the color is empty
+func colored(text string, color string) string {
+	if color == "" {
+		return text
+	}
+	return color + text + colorReset
+}
+
+// coloredLines of text, each line after the first n lines in a color, leaving any line past the colors plain
+func coloredLines(text string, skip int, colors []string) string {
+	lines := strings.Split(text, "\n")
+	for n, color := range colors {
+		if skip+n < len(lines) && lines[skip+n] != "" {
+			lines[skip+n] = colored(lines[skip+n], color)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,58 @@
+// Notes are highlighted in ANSI color, e.g. for a terminal: the tonic in one color, chord tones and tensions in others, and scale degrees shaded
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestColorChordTone(t *testing.T) {
+	assert.Equal(t, colorTonic, colorChordTone(1))
+	assert.Equal(t, colorTone, colorChordTone(3))
+	assert.Equal(t, colorTone, colorChordTone(7))
+	assert.Equal(t, colorTension, colorChordTone(9))
+	assert.Equal(t, colorTension, colorChordTone(6))
+}
+
+func TestColorScaleDegree(t *testing.T) {
+	assert.Equal(t, colorTonic, colorScaleDegree(1))
+	assert.Equal(t, colorDegrees[0], colorScaleDegree(2))
+	assert.Equal(t, colorDegrees[5], colorScaleDegree(7))
+	assert.Equal(t, colorDegrees[5], colorScaleDegree(8))
+}
+
+func TestRenderTable_ChordColor(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, To(&out, Table, chord.Of("C9"), WithColor()))
+	assert.Equal(t, "TONE  NOTE  INTERVAL  FREQUENCY\n"+
+		colorTonic+"1     C     P1        261.63Hz"+colorReset+"\n"+
+		colorTone+"3     E     M3        329.63Hz"+colorReset+"\n"+
+		colorTone+"5     G     P5        392.00Hz"+colorReset+"\n"+
+		colorTone+"7     A#    m7        466.16Hz"+colorReset+"\n"+
+		colorTension+"9     D     9         587.33Hz"+colorReset+"\n", out.String())
+}
+
+func TestRenderTable_ScaleColor(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, To(&out, Table, scale.Of("C"), WithColor()))
+	assert.Contains(t, out.String(), colorTonic+"1     C")
+	assert.Contains(t, out.String(), colorDegrees[1]+"3     E")
+}
+
+func TestRenderTable_ProgressionColor(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, To(&out, Table, progression.Of("G7", "C"), WithColor()))
+	assert.Contains(t, out.String(), "1      G     "+colorTonic+"G"+colorReset+" "+colorTone+"B"+colorReset)
+}
+
+func TestRenderTable_NoColor(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, To(&out, Table, chord.Of("C9")))
+	assert.NotContains(t, out.String(), "\x1b[")
+}
@@ -0,0 +1,203 @@
+// Render a lead sheet of a song or progression in plain text, which reads as Markdown, its bars in rows with the chord symbols on top and their Roman numerals beneath,
+// and the cadence that ends each section and any modulation at its beginning annotated inline, e.g. to read the analysis of a chart
+package render
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/roman"
+	"github.com/go-music-theory/music-theory/song"
+)
+
+//
+// Private
+//
+
+// leadSheetBarsPerRow of the chart of each section
+const leadSheetBarsPerRow = 4
+
+func renderLeadSheet(w io.Writer, v interface{}, o Options) error {
+	var s song.Song
+	switch t := v.(type) {
+	case song.Song:
+		s = t
+	case progression.Progression:
+		s = song.Song{Meter: meter.Common}
+		var bars []song.Bar
+		for _, c := range t.Chords {
+			bars = append(bars, song.Bar{Chords: []song.BarChord{{Name: c.Name(), Chord: c, Beat: 1}}})
+		}
+		s.Add("", bars...)
+	default:
+		return unsupported(LeadSheet, v)
+	}
+	var b strings.Builder
+	if len(s.Title) > 0 {
+		fmt.Fprintf(&b, "# %s\n\n", s.Title)
+	}
+	current := s.Key
+	if current.Mode == key.Nil {
+		current = s.Progression().Key()
+	}
+	var about []string
+	if current.Mode != key.Nil {
+		about = append(about, leadSheetKeyName(current))
+	}
+	if s.Meter.Beats > 0 {
+		about = append(about, s.Meter.String())
+	}
+	if s.Tempo > 0 {
+		about = append(about, strconv.FormatFloat(s.Tempo, 'f', -1, 64)+" BPM")
+	}
+	if len(about) > 0 {
+		fmt.Fprintf(&b, "%s\n\n", strings.Join(about, ", "))
+	}
+	for _, sec := range s.Sections {
+		if len(sec.Name) > 0 {
+			fmt.Fprintf(&b, "## %s\n\n", sec.Name)
+		}
+		p := leadSheetChanges(sec)
+		var modulation string
+		if k, ok := leadSheetModulation(p, current); ok {
+			current = k
+			modulation = "modulation to " + leadSheetKeyName(k)
+		}
+		var cadence string
+		if best, ok := candidate.Best(p.CadencesIn(current)); ok {
+			cadence = best.String()
+		}
+		chords, numerals := make([]string, len(sec.Bars)), make([]string, len(sec.Bars))
+		widths := make([]int, leadSheetBarsPerRow)
+		for n, bar := range sec.Bars {
+			chords[n], numerals[n] = leadSheetBarOf(bar, current)
+			if width := len([]rune(chords[n])); width > widths[n%leadSheetBarsPerRow] {
+				widths[n%leadSheetBarsPerRow] = width
+			}
+		}
+		for from := 0; from < len(sec.Bars); from += leadSheetBarsPerRow {
+			to := from + leadSheetBarsPerRow
+			if to > len(sec.Bars) {
+				to = len(sec.Bars)
+			}
+			var notes []string
+			if from == 0 && len(modulation) > 0 {
+				notes = append(notes, modulation)
+			}
+			if to == len(sec.Bars) && len(cadence) > 0 {
+				notes = append(notes, cadence)
+			}
+			writeLeadSheetRow(&b, chords[from:to], numerals[from:to], widths, notes)
+		}
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeLeadSheetRow of some bars, indented as a block of Markdown, a line of their chord symbols, followed by any annotations in parentheses,
+// above a line of their Roman numerals, each bar padded to the width of its column in the rows of its section
+func writeLeadSheetRow(b *strings.Builder, chords, numerals []string, widths []int, notes []string) {
+	top, bottom := "    |", "    |"
+	for n := range chords {
+		top += " " + leadSheetPad(chords[n], widths[n]) + " |"
+		bottom += " " + leadSheetPad(numerals[n], widths[n]) + " |"
+	}
+	if len(notes) > 0 {
+		top += " (" + strings.Join(notes, "; ") + ")"
+	}
+	b.WriteString(top + "\n" + bottom + "\n")
+}
+
+// leadSheetBarOf a bar, its chord symbols, and their Roman numerals in a key, each aligned with its chord, of a dominant not in the key applied to the chord it tonicizes,
+// e.g. V7/vi of E7 in C major, or "?" of a chord without one,
+// or "%" of a bar without a chord, repeating the one before
+func leadSheetBarOf(bar song.Bar, k key.Key) (chords, numerals string) {
+	if len(bar.Chords) == 0 {
+		return "%", ""
+	}
+	var names, figures []string
+	for _, bc := range bar.Chords {
+		numeral := "?"
+		if r, ok := roman.AnalyzeApplied(bc.Chord, k); ok {
+			numeral = r.String()
+		} else if r, ok := roman.Analyze(bc.Chord, k); ok {
+			numeral = r.String()
+		}
+		width := len([]rune(bc.Name))
+		if n := len([]rune(numeral)); n > width {
+			width = n
+		}
+		names = append(names, leadSheetPad(bc.Name, width))
+		figures = append(figures, leadSheetPad(numeral, width))
+	}
+	return strings.Join(names, " "), strings.Join(figures, " ")
+}
+
+// leadSheetPad some text with spaces to a width, in runes, e.g. of the ° of vii°
+func leadSheetPad(text string, width int) string {
+	if n := len([]rune(text)); n < width {
+		return text + strings.Repeat(" ", width-n)
+	}
+	return text
+}
+
+// leadSheetChanges of a section, the progression of its chords, without repeating a chord held from one bar into the next
+func leadSheetChanges(sec song.Section) progression.Progression {
+	s := song.Song{Sections: []song.Section{sec}}
+	return s.Progression()
+}
+
+// leadSheetModulation of the chords of a section, to the key that best fits them, if any of their tones are outside the current key,
+// and that key has another signature, and fits them better than the current key, e.g. to A major of A E7 A after C major,
+// but not to F major of C F after C major, nor to the A minor of the same signature of Am E7 Am,
+// and only tonicizing a chord of the key, not modulating, if the tones outside it are all of dominants applied to its chords, e.g. of C E7 Am D7 G in C major,
+// unless the section begins and ends on the tonic of that key, e.g. to G major of G D G after C major
+func leadSheetModulation(p progression.Progression, current key.Key) (key.Key, bool) {
+	if current.Mode == key.Nil || len(p.Chords) == 0 {
+		return key.Key{}, false
+	}
+	s := current.Scale()
+	outside, unexplained := false, false
+	for _, c := range p.Chords {
+		if s.ContainsChord(c) {
+			continue
+		}
+		outside = true
+		if _, ok := roman.AnalyzeApplied(c, current); !ok {
+			unexplained = true
+		}
+	}
+	if !outside {
+		return key.Key{}, false
+	}
+	keys := p.Keys()
+	if len(keys) == 0 {
+		return key.Key{}, false
+	}
+	best := keys[0]
+	if best.Value.Fifths() == current.Fifths() {
+		return key.Key{}, false
+	}
+	first, last := p.Chords[0].Root, p.Chords[len(p.Chords)-1].Root
+	if !unexplained && (first != best.Value.Root || last != best.Value.Root) {
+		return key.Key{}, false
+	}
+	for _, k := range keys {
+		if k.Value.Root == current.Root && k.Value.Mode == current.Mode && k.Score >= best.Score {
+			return key.Key{}, false
+		}
+	}
+	return best.Value, true
+}
+
+// leadSheetKeyName of a key, e.g. "C major"
+func leadSheetKeyName(k key.Key) string {
+	return k.Root.String(k.AdjSymbol) + " " + strings.ToLower(k.Mode.String())
+}
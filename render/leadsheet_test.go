@@ -0,0 +1,103 @@
+// Render a lead sheet of a song or progression in plain text, which reads as Markdown, its bars in rows with the chord symbols on top and their Roman numerals beneath,
+// and the cadence that ends each section and any modulation at its beginning annotated inline, e.g. to read the analysis of a chart
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/song"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+func TestRenderLeadSheet_Song(t *testing.T) {
+	s := song.New("Autumn Leaves", key.Of("G major"))
+	s.Add("verse", song.BarOf(4, "Am7", "D7"), song.BarOf(4, "Gmaj7"), song.BarOf(4, "Cmaj7"), song.BarOf(4, "F#m7b5"), song.BarOf(4, "B7"), song.Bar{})
+	s.Add("bridge", song.BarOf(4, "E"), song.BarOf(4, "B7"), song.BarOf(4, "E"))
+	s.Add("chorus", song.BarOf(4, "C"), song.BarOf(4, "D7"), song.BarOf(4, "G"))
+	assert.Equal(t, strings.Join([]string{
+		"# Autumn Leaves",
+		"",
+		"G major, 4/4, 120 BPM",
+		"",
+		"## verse",
+		"",
+		"    | Am7 D7 | Gmaj7 | Cmaj7  | F#m7b5 |",
+		"    | ii7 V7 | Imaj7 | IVmaj7 | viiø7  |",
+		"    | B7     | %     |",
+		"    | V7/vi  |       |",
+		"",
+		"## bridge",
+		"",
+		"    | E | B7 | E | (modulation to E major; authentic cadence in E major)",
+		"    | I | V7 | I |",
+		"",
+		"## chorus",
+		"",
+		"    | C  | D7 | G | (modulation to G major; authentic cadence in G major)",
+		"    | IV | V7 | I |",
+		"",
+	}, "\n")+"\n", renderLeadSheetString(t, s))
+}
+
+func TestRenderLeadSheet_Tonicized(t *testing.T) {
+	s, err := song.ReadChordPro(strings.NewReader(`{key: C}
+{start_of_verse: verse}
+[C]Tonicizing [E7]the six, [Am]then [D7]the five, [G]then
+{end_of_verse}
+{start_of_verse: coda}
+[G]Modulating [D]to the [G]five
+{end_of_verse}
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"C major, 4/4, 120 BPM",
+		"",
+		"## verse",
+		"",
+		"    | C | E7    | Am | D7   |",
+		"    | I | V7/vi | vi | V7/V |",
+		"    | G | (half cadence in C major)",
+		"    | V |",
+		"",
+		"## coda",
+		"",
+		"    | G | D | G | (modulation to G major; authentic cadence in G major)",
+		"    | I | V | I |",
+		"",
+	}, "\n")+"\n", renderLeadSheetString(t, s))
+}
+
+func TestRenderLeadSheet_Progression(t *testing.T) {
+	assert.Equal(t, strings.Join([]string{
+		"A minor, 4/4",
+		"",
+		"    | Am    | Dm | E7 | Am |",
+		"    | i     | iv | V7 | i  |",
+		"    | Dsus4 | E7 | Am | (authentic cadence in A minor)",
+		"    | ?     | V7 | i  |",
+		"",
+	}, "\n")+"\n", renderLeadSheetString(t, progression.Of("Am", "Dm", "E7", "Am", "Dsus4", "E7", "Am")))
+}
+
+func TestRenderLeadSheet_Accidentals(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, To(&out, LeadSheet, progression.Of("C", "Bb", "F", "C"), WithAccidentals(symbol.Unicode)))
+	assert.Contains(t, out.String(), "| C | B♭   | F  | C | (plagal cadence in C major)")
+	assert.Contains(t, out.String(), "| I | bVII | IV | I |")
+}
+
+//
+// Private
+//
+
+func renderLeadSheetString(t *testing.T, v interface{}) string {
+	var out bytes.Buffer
+	assert.Nil(t, To(&out, LeadSheet, v))
+	return out.String()
+}
@@ -0,0 +1,196 @@
+// Render LilyPond notation of a chord, scale, key, progression, arpeggio, chorale or tune, e.g. to engrave it as sheet music
+package render
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/satb"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+const lilyPondVersion = "2.18.2"
+
+func renderLilyPond(w io.Writer, v interface{}, o Options) error {
+	var body []string
+	switch t := v.(type) {
+	case chord.Chord:
+		body = append(body, lilyPondChord(chordVoicing(t))+"1")
+	case scale.Scale:
+		body = append(body, lilyPondMelody(scaleVoicing(t)))
+	case key.Key:
+		body = append(body, lilyPondKey(t), lilyPondMelody(scaleVoicing(keyScale(t))))
+	case progression.Progression:
+		if len(t.Chords) > 0 {
+			body = append(body, lilyPondKey(t.Key()))
+		}
+		for _, c := range t.Chords {
+			body = append(body, lilyPondChord(chordVoicing(c))+"1")
+		}
+	case chord.Arpeggio:
+		body = append(body, lilyPondMelody(arpeggioVoicing(t)))
+	case satb.Chorale:
+		_, err := fmt.Fprintf(w, "\\version \"%s\"\n%s", lilyPondVersion, lilyPondChorale(t))
+		return err
+	case melody.Tune:
+		body = append(body, lilyPondTune(t)...)
+	default:
+		return unsupported(LilyPond, v)
+	}
+	_, err := fmt.Fprintf(w, "\\version \"%s\"\n{\n  %s\n}\n", lilyPondVersion, strings.Join(body, "\n  "))
+	return err
+}
+
+// lilyPondChord of simultaneous tones, e.g. <c' es' g'>
+func lilyPondChord(v voicing) string {
+	var pitches []string
+	for _, t := range v.Tones {
+		pitches = append(pitches, lilyPondPitch(t, v.AdjSymbol))
+	}
+	return "<" + strings.Join(pitches, " ") + ">"
+}
+
+// lilyPondMelody of successive quarter note tones, e.g. c'4 d'4 e'4
+func lilyPondMelody(v voicing) string {
+	var pitches []string
+	for _, t := range v.Tones {
+		pitches = append(pitches, lilyPondPitch(t, v.AdjSymbol)+"4")
+	}
+	return strings.Join(pitches, " ")
+}
+
+// lilyPondChorale on a choir staff, the soprano and alto on a treble staff, and the tenor and bass on a bass staff, stems up for the upper voice of each, in whole notes
+func lilyPondChorale(c satb.Chorale) string {
+	voices := choraleVoicings(c)
+	signature := ""
+	if c.Key.Mode != key.Nil {
+		signature = " " + lilyPondKey(c.Key)
+	}
+	var b strings.Builder
+	b.WriteString("\\new ChoirStaff <<\n")
+	for n, clef := range []string{"treble", "bass"} {
+		fmt.Fprintf(&b, "  \\new Staff <<\n    \\clef %s%s\n", clef, signature)
+		for m, direction := range []string{"\\voiceOne", "\\voiceTwo"} {
+			voice := satb.Voices[n*2+m]
+			v := voices[voice]
+			var pitches []string
+			for _, t := range v.Tones {
+				if t.Class == note.Nil {
+					pitches = append(pitches, "r1")
+				} else {
+					pitches = append(pitches, lilyPondPitch(t, v.AdjSymbol)+"1")
+				}
+			}
+			fmt.Fprintf(&b, "    \\new Voice = \"%s\" { %s %s }\n", voice, direction, strings.Join(pitches, " "))
+		}
+		b.WriteString("  >>\n")
+	}
+	b.WriteString(">>\n")
+	return b.String()
+}
+
+// lilyPondTune in the bass clef if it's mostly below middle C, by its key signature, if any, and time signature, then its notes a measure per line,
+// each articulated, e.g. c'4-. for staccato, and slurred where legato, e.g. c'4( d'4 e'4)
+func lilyPondTune(t melody.Tune) []string {
+	var lines []string
+	if tuneInBass(t) {
+		lines = append(lines, "\\clef bass")
+	}
+	if t.Key.Mode != key.Nil {
+		lines = append(lines, lilyPondKey(t.Key))
+	}
+	if t.Meter.Unit > 0 {
+		lines = append(lines, fmt.Sprintf("\\time %d/%d", t.Meter.Beats, t.Meter.Unit))
+	}
+	for _, events := range tuneMeasures(t) {
+		var written []string
+		for _, e := range events {
+			written = append(written, lilyPondEvent(t, e))
+		}
+		lines = append(lines, strings.Join(written, " ")+" |")
+	}
+	return lines
+}
+
+// lilyPondEvent of a tune, a note or rest and its length, e.g. c'4., fingered and articulated if it's the first of a note, tied to the next of it, and beginning or ending any slur
+func lilyPondEvent(t melody.Tune, e tuneEvent) string {
+	length := strconv.Itoa(e.Length.Type)
+	if e.Length.Dotted {
+		length += "."
+	}
+	if e.Note < 0 {
+		return "r" + length
+	}
+	nt := t.Notes[e.Note]
+	written := lilyPondPitch(tuneTone(nt), t.Key.AdjSymbol) + length
+	if e.First && nt.Finger > 0 {
+		written += "-" + strconv.Itoa(nt.Finger)
+	}
+	if e.First {
+		switch a := nt.Articulation; {
+		case a.Has(melody.Staccato | melody.Tenuto):
+			written += "-_"
+		case a.Has(melody.Staccato):
+			written += "-."
+		case a.Has(melody.Tenuto):
+			written += "--"
+		}
+		if nt.Articulation.Has(melody.Accent) {
+			written += "->"
+		}
+	}
+	if e.Tied {
+		written += "~"
+	} else if slurEnds(t.Notes, e.Note) {
+		written += ")"
+	}
+	if e.First && slurBegins(t.Notes, e.Note) {
+		written += "("
+	}
+	return written
+}
+
+// lilyPondKey signature, e.g. \key es \major
+func lilyPondKey(k key.Key) string {
+	mode := "\\major"
+	if k.Mode == key.Minor {
+		mode = "\\minor"
+	}
+	return "\\key " + lilyPondName(k.Root, k.AdjSymbol) + " " + mode
+}
+
+// lilyPondPitch in absolute octave entry, e.g. c' for middle C or es'' for the E-flat above it
+func lilyPondPitch(t tone, adjSymbol note.AdjSymbol) string {
+	name := lilyPondName(t.Class, adjSymbol)
+	if t.Octave > 3 {
+		return name + strings.Repeat("'", int(t.Octave)-3)
+	}
+	return name + strings.Repeat(",", 3-int(t.Octave))
+}
+
+// lilyPondName of a pitch class, in the default (Dutch) note names, e.g. cis or es, or r (a rest) for the Nil class
+func lilyPondName(class note.Class, adjSymbol note.AdjSymbol) string {
+	letter, alter := spellingOf(class, adjSymbol)
+	switch {
+	case letter == "":
+		return "r"
+	case alter > 0:
+		return strings.ToLower(letter) + "is"
+	case alter < 0 && (letter == "E" || letter == "A"):
+		return strings.ToLower(letter) + "s"
+	case alter < 0:
+		return strings.ToLower(letter) + "es"
+	}
+	return strings.ToLower(letter)
+}
@@ -0,0 +1,85 @@
+// Render LilyPond notation of a chord, scale, key, progression, arpeggio, chorale or tune, e.g. to engrave it as sheet music
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/satb"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderLilyPond_Chord(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderLilyPond(&out, chord.Of("Cm7"), Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n{\n  <c' es' g' bes'>1\n}\n", out.String())
+}
+
+func TestRenderLilyPond_SlashChord(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderLilyPond(&out, chord.Of("C/E"), Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n{\n  <e c' e' g'>1\n}\n", out.String())
+}
+
+func TestRenderLilyPond_Tune(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderLilyPond(&out, testTune(t), Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n{\n  \\key f \\major\n  \\time 3/4\n  c'4-. d'4-> e'4~( |\n  e'8 f'8 g'4) r4 |\n  bes'2-_ r4 |\n}\n", out.String())
+}
+
+func TestRenderLilyPond_Tune_Bass(t *testing.T) {
+	var out bytes.Buffer
+	tune := melody.Tune{Meter: meter.Common, Notes: melody.NotesOf("C2", "G2", "C3")}
+	tune.Notes[1].Articulation = melody.Tenuto | melody.Accent
+	tune.Notes[2].Finger = 5
+	assert.Nil(t, renderLilyPond(&out, tune, Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n{\n  \\clef bass\n  \\time 4/4\n  c,4 g,4---> c4-5 r4 |\n}\n", out.String())
+}
+
+func TestRenderLilyPond_Scale(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderLilyPond(&out, scale.Of("A minor"), Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n{\n  a'4 b'4 c''4 d''4 e''4 f''4 g''4\n}\n", out.String())
+}
+
+func TestRenderLilyPond_Key(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderLilyPond(&out, key.Of("D minor"), Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n{\n  \\key d \\minor\n  d'4 e'4 f'4 g'4 a'4 bes'4 c''4\n}\n", out.String())
+}
+
+func TestRenderLilyPond_Progression(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderLilyPond(&out, progression.Of("Dm7", "G7", "C"), Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n{\n  \\key c \\major\n  <d' f' a' c''>1\n  <g' b' d'' f''>1\n  <c' e' g'>1\n}\n", out.String())
+}
+
+func TestRenderLilyPond_Arpeggio(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderLilyPond(&out, chord.Arpeggiate(chord.Of("Cm"), chord.UpDown, 2), Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n{\n  c'4 es'4 g'4 c''4 es''4 g''4 es''4 c''4 g'4 es'4\n}\n", out.String())
+}
+
+func TestRenderLilyPond_Chorale(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderLilyPond(&out, satb.Realize(progression.Of("Bb", "F7", "Bb"), key.Key{}), Options{}))
+	assert.Equal(t, "\\version \"2.18.2\"\n\\new ChoirStaff <<\n"+
+		"  \\new Staff <<\n    \\clef treble \\key bes \\major\n"+
+		"    \\new Voice = \"soprano\" { \\voiceOne f'1 f'1 f'1 }\n"+
+		"    \\new Voice = \"alto\" { \\voiceTwo bes1 a1 bes1 }\n  >>\n"+
+		"  \\new Staff <<\n    \\clef bass \\key bes \\major\n"+
+		"    \\new Voice = \"tenor\" { \\voiceOne d1 es1 d1 }\n"+
+		"    \\new Voice = \"bass\" { \\voiceTwo bes,1 f,1 bes,1 }\n  >>\n>>\n", out.String())
+}
+
+func TestLilyPondPitch(t *testing.T) {
+	assert.Equal(t, "c", lilyPondPitch(tone{Class: 1, Octave: 3}, 0))
+	assert.Equal(t, "as,", lilyPondPitch(tone{Class: 9, Octave: 2}, 2))
+}
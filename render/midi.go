@@ -0,0 +1,58 @@
+// Render a Standard MIDI File of a chord, scale, key, progression, arpeggio or tune, at the velocity of any dynamics, e.g. to play it with a synthesizer or open it in a DAW
+package render
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+func renderMIDI(w io.Writer, v interface{}, o Options) error {
+	var notes []midi.Note
+	switch t := v.(type) {
+	case chord.Chord:
+		notes = midiChord(playedVoicing(t), 0)
+	case scale.Scale:
+		notes = midiMelody(scaleVoicing(t), 0)
+	case key.Key:
+		notes = midiMelody(scaleVoicing(keyScale(t)), 0)
+	case progression.Progression:
+		for n, c := range t.Chords {
+			notes = append(notes, midiChord(playedVoicing(c), n*midi.Whole)...)
+		}
+	case chord.Arpeggio:
+		notes = midiMelody(arpeggioVoicing(t), 0)
+	case melody.Tune:
+		notes = t.MIDINotes()
+	default:
+		return unsupported(MIDI, v)
+	}
+	return midi.Write(w, o.Dynamics.Apply(notes))
+}
+
+// midiChord of simultaneous whole notes, from a start in ticks
+func midiChord(v voicing, start int) []midi.Note {
+	var notes []midi.Note
+	for _, t := range v.Tones {
+		notes = append(notes, midi.Note{Number: midi.NumberOf(t.Class, t.Octave), Start: start, Duration: midi.Whole})
+	}
+	return notes
+}
+
+// midiMelody of successive quarter notes, from a start in ticks
+func midiMelody(v voicing, start int) []midi.Note {
+	var notes []midi.Note
+	for n, t := range v.Tones {
+		notes = append(notes, midi.Note{Number: midi.NumberOf(t.Class, t.Octave), Start: start + n*midi.Quarter, Duration: midi.Quarter})
+	}
+	return notes
+}
@@ -0,0 +1,105 @@
+// Render a Standard MIDI File of a chord, scale, key, progression, arpeggio or tune, at the velocity of any dynamics, e.g. to play it with a synthesizer or open it in a DAW
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderMIDI(t *testing.T) {
+	for _, v := range []interface{}{chord.Of("Cm7"), scale.Of("A minor"), key.Of("Eb"), progression.Of("Dm7", "G7", "C"), chord.Arpeggiate(chord.Of("C"), chord.Up, 1)} {
+		var out bytes.Buffer
+		assert.Nil(t, renderMIDI(&out, v, Options{}))
+		assert.Equal(t, "MThd", out.String()[:4])
+	}
+}
+
+func TestRenderMIDI_Chord(t *testing.T) {
+	assertMIDI(t, []midi.Note{
+		{Number: 60, Duration: midi.Whole},
+		{Number: 63, Duration: midi.Whole},
+		{Number: 67, Duration: midi.Whole},
+	}, chord.Of("Cm"))
+}
+
+func TestRenderMIDI_SlashChord(t *testing.T) {
+	assertMIDI(t, []midi.Note{
+		{Number: 55, Duration: midi.Whole},
+		{Number: 60, Duration: midi.Whole},
+		{Number: 63, Duration: midi.Whole},
+		{Number: 67, Duration: midi.Whole},
+	}, chord.Of("Cm").Inversion(2))
+}
+
+func TestRenderMIDI_ChordNotes(t *testing.T) {
+	assertMIDI(t, []midi.Note{
+		{Number: 60, Duration: midi.Whole},
+		{Number: 64, Duration: midi.Whole},
+		{Number: 67, Duration: midi.Whole},
+		{Number: 71, Duration: midi.Whole},
+		{Number: 74, Duration: midi.Whole},
+		{Number: 81, Duration: midi.Whole},
+	}, chord.Of("Cmaj13"))
+}
+
+func TestRenderMIDI_Dynamics(t *testing.T) {
+	var expect, out bytes.Buffer
+	assert.Nil(t, midi.Write(&expect, []midi.Note{
+		{Number: 60, Velocity: 49, Start: 0, Duration: midi.Whole},
+		{Number: 64, Velocity: 49, Start: 0, Duration: midi.Whole},
+		{Number: 67, Velocity: 49, Start: 0, Duration: midi.Whole},
+		{Number: 65, Velocity: 96, Start: midi.Whole, Duration: midi.Whole},
+		{Number: 69, Velocity: 96, Start: midi.Whole, Duration: midi.Whole},
+		{Number: 72, Velocity: 96, Start: midi.Whole, Duration: midi.Whole},
+	}))
+	assert.Nil(t, renderMIDI(&out, progression.Of("C", "F"), Options{Dynamics: dynamics.Phrase{From: dynamics.P, To: dynamics.F}}))
+	assert.Equal(t, expect.Bytes(), out.Bytes())
+}
+
+func TestRenderMIDI_Progression(t *testing.T) {
+	assertMIDI(t, []midi.Note{
+		{Number: 67, Start: 0, Duration: midi.Whole},
+		{Number: 71, Start: 0, Duration: midi.Whole},
+		{Number: 74, Start: 0, Duration: midi.Whole},
+		{Number: 60, Start: midi.Whole, Duration: midi.Whole},
+		{Number: 64, Start: midi.Whole, Duration: midi.Whole},
+		{Number: 67, Start: midi.Whole, Duration: midi.Whole},
+	}, progression.Of("G", "C"))
+}
+
+func TestRenderMIDI_Arpeggio(t *testing.T) {
+	assertMIDI(t, []midi.Note{
+		{Number: 60, Start: 0, Duration: midi.Quarter},
+		{Number: 64, Start: midi.Quarter, Duration: midi.Quarter},
+		{Number: 67, Start: 2 * midi.Quarter, Duration: midi.Quarter},
+		{Number: 64, Start: 3 * midi.Quarter, Duration: midi.Quarter},
+	}, chord.Arpeggiate(chord.Of("C"), chord.UpDown, 1))
+}
+
+func TestRenderMIDI_Tune(t *testing.T) {
+	assertMIDI(t, testTune(t).MIDINotes(), testTune(t))
+}
+
+func TestRenderMIDI_Unsupported(t *testing.T) {
+	assert.NotNil(t, renderMIDI(&bytes.Buffer{}, 42, Options{}))
+}
+
+//
+// Private
+//
+
+func assertMIDI(t *testing.T, expect []midi.Note, v interface{}) {
+	var expectOut, out bytes.Buffer
+	assert.Nil(t, midi.Write(&expectOut, expect))
+	assert.Nil(t, renderMIDI(&out, v, Options{}))
+	assert.Equal(t, expectOut.Bytes(), out.Bytes())
+}
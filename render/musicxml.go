@@ -0,0 +1,327 @@
+// Render MusicXML of a chord, scale, key, progression, arpeggio, chorale or tune, e.g. to open it in notation software
+package render
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/satb"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+const (
+	musicXMLDivisions = 8 // of a quarter note of a tune, so its shortest, a thirty-second note, is 1
+	musicXMLVersion   = "3.1"
+	musicXMLDoctype   = `<!DOCTYPE score-partwise PUBLIC "-//Recordare//DTD MusicXML 3.1 Partwise//EN" "http://www.musicxml.org/dtds/partwise.dtd">`
+)
+
+func renderMusicXML(w io.Writer, v interface{}, o Options) error {
+	var measures []xmlMeasure
+	switch t := v.(type) {
+	case satb.Chorale:
+		return writeMusicXML(w, xmlChorale(t))
+	case melody.Tune:
+		return writeMusicXML(w, xmlTune(t))
+	case chord.Chord:
+		measures = append(measures, xmlMeasure{Notes: xmlChord(chordVoicing(t))})
+	case scale.Scale:
+		measures = append(measures, xmlMeasure{Notes: xmlMelody(scaleVoicing(t))})
+	case key.Key:
+		measures = append(measures, xmlMeasure{Notes: xmlMelody(scaleVoicing(keyScale(t)))})
+	case progression.Progression:
+		for _, c := range t.Chords {
+			measures = append(measures, xmlMeasure{Notes: xmlChord(chordVoicing(c))})
+		}
+	case chord.Arpeggio:
+		measures = append(measures, xmlMeasure{Notes: xmlMelody(arpeggioVoicing(t))})
+	default:
+		return unsupported(MusicXML, v)
+	}
+	if len(measures) == 0 {
+		measures = append(measures, xmlMeasure{})
+	}
+	for n := range measures {
+		measures[n].Number = n + 1
+	}
+	measures[0].Attributes = &xmlAttributes{Divisions: 1, Clef: xmlClef{Sign: "G", Line: 2}}
+	switch t := v.(type) {
+	case key.Key:
+		measures[0].Attributes.Key = xmlKeyOf(t)
+	case progression.Progression:
+		if len(t.Chords) > 0 {
+			measures[0].Attributes.Key = xmlKeyOf(t.Key())
+		}
+	}
+
+	return writeMusicXML(w, xmlScore{
+		Version:  musicXMLVersion,
+		PartList: xmlPartList{ScoreParts: []xmlScorePart{{ID: "P1", PartName: "Music"}}},
+		Parts:    []xmlPart{{ID: "P1", Measures: measures}},
+	})
+}
+
+// writeMusicXML of a score, with its header and doctype
+func writeMusicXML(w io.Writer, score xmlScore) error {
+	out, err := xml.MarshalIndent(score, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, xml.Header+musicXMLDoctype+"\n"+string(out)+"\n")
+	return err
+}
+
+// xmlChorale in open score, a part for each voice from the soprano to the bass, in whole notes, or an empty measure for a chord that couldn't be voiced,
+// the tenor in the treble clef an octave down
+func xmlChorale(c satb.Chorale) xmlScore {
+	score := xmlScore{Version: musicXMLVersion}
+	clefs := map[satb.Voice]xmlClef{
+		satb.Soprano: {Sign: "G", Line: 2},
+		satb.Alto:    {Sign: "G", Line: 2},
+		satb.Tenor:   {Sign: "G", Line: 2, OctaveChange: -1},
+		satb.Bass:    {Sign: "F", Line: 4},
+	}
+	for n, v := range choraleVoicings(c) {
+		voice := satb.Voices[n]
+		id := "P" + strconv.Itoa(n+1)
+		score.PartList.ScoreParts = append(score.PartList.ScoreParts, xmlScorePart{ID: id, PartName: strings.Title(voice.String())})
+		var measures []xmlMeasure
+		for m, t := range v.Tones {
+			xm := xmlMeasure{Number: m + 1}
+			if t.Class != note.Nil {
+				xm.Notes = []xmlNote{{Pitch: xmlPitchOf(t, v.AdjSymbol), Duration: 4, Type: "whole"}}
+			}
+			measures = append(measures, xm)
+		}
+		if len(measures) == 0 {
+			measures = append(measures, xmlMeasure{Number: 1})
+		}
+		measures[0].Attributes = &xmlAttributes{Divisions: 1, Clef: clefs[voice]}
+		if c.Key.Mode != key.Nil {
+			measures[0].Attributes.Key = xmlKeyOf(c.Key)
+		}
+		score.Parts = append(score.Parts, xmlPart{ID: id, Measures: measures})
+	}
+	return score
+}
+
+// xmlTune in one part, in the bass clef if it's mostly below middle C, by its key signature, if any, and time signature,
+// each note articulated, tied across measures or into lengths that can be written, and slurred where legato
+func xmlTune(t melody.Tune) xmlScore {
+	var measures []xmlMeasure
+	for m, events := range tuneMeasures(t) {
+		xm := xmlMeasure{Number: m + 1}
+		for _, e := range events {
+			xm.Notes = append(xm.Notes, xmlTuneNote(t, e))
+		}
+		measures = append(measures, xm)
+	}
+	if len(measures) == 0 {
+		measures = append(measures, xmlMeasure{Number: 1})
+	}
+	measures[0].Attributes = &xmlAttributes{Divisions: musicXMLDivisions, Clef: xmlClef{Sign: "G", Line: 2}}
+	if tuneInBass(t) {
+		measures[0].Attributes.Clef = xmlClef{Sign: "F", Line: 4}
+	}
+	if t.Key.Mode != key.Nil {
+		measures[0].Attributes.Key = xmlKeyOf(t.Key)
+	}
+	if t.Meter.Unit > 0 {
+		measures[0].Attributes.Time = &xmlTime{Beats: t.Meter.Beats, BeatType: t.Meter.Unit}
+	}
+	return xmlScore{
+		Version:  musicXMLVersion,
+		PartList: xmlPartList{ScoreParts: []xmlScorePart{{ID: "P1", PartName: "Music"}}},
+		Parts:    []xmlPart{{ID: "P1", Measures: measures}},
+	}
+}
+
+// xmlTuneNote of an event of a tune, a note or rest of its length, fingered and articulated if it's the first of a note, tied to any before or after it, and beginning or ending any slur
+func xmlTuneNote(t melody.Tune, e tuneEvent) xmlNote {
+	xn := xmlNote{Duration: e.Length.Ticks * musicXMLDivisions / midi.Quarter, Type: xmlTypes[e.Length.Type]}
+	if e.Length.Dotted {
+		xn.Dot = &struct{}{}
+	}
+	if e.Note < 0 {
+		xn.Rest = &struct{}{}
+		return xn
+	}
+	nt := t.Notes[e.Note]
+	xn.Pitch = xmlPitchOf(tuneTone(nt), t.Key.AdjSymbol)
+	n := xmlNotations{}
+	if !e.First {
+		xn.Ties = append(xn.Ties, xmlTie{Type: "stop"})
+		n.Tied = append(n.Tied, xmlTie{Type: "stop"})
+	}
+	if e.Tied {
+		xn.Ties = append(xn.Ties, xmlTie{Type: "start"})
+		n.Tied = append(n.Tied, xmlTie{Type: "start"})
+	} else if slurEnds(t.Notes, e.Note) {
+		n.Slurs = append(n.Slurs, xmlSlur{Type: "stop", Number: 1})
+	}
+	if e.First && slurBegins(t.Notes, e.Note) {
+		n.Slurs = append(n.Slurs, xmlSlur{Type: "start", Number: 1})
+	}
+	if a := nt.Articulation; e.First && (a.Has(melody.Staccato) || a.Has(melody.Accent) || a.Has(melody.Tenuto)) {
+		n.Articulations = &xmlArticulations{}
+		if a.Has(melody.Accent) {
+			n.Articulations.Accent = &struct{}{}
+		}
+		if a.Has(melody.Staccato) {
+			n.Articulations.Staccato = &struct{}{}
+		}
+		if a.Has(melody.Tenuto) {
+			n.Articulations.Tenuto = &struct{}{}
+		}
+	}
+	if e.First && nt.Finger > 0 {
+		n.Technical = &xmlTechnical{Fingering: nt.Finger}
+	}
+	if len(n.Tied) > 0 || len(n.Slurs) > 0 || n.Articulations != nil || n.Technical != nil {
+		xn.Notations = &n
+	}
+	return xn
+}
+
+// xmlTypes of notes, by the denominator of their length, e.g. "quarter" of 4
+var xmlTypes = map[int]string{1: "whole", 2: "half", 4: "quarter", 8: "eighth", 16: "16th", 32: "32nd"}
+
+// xmlChord of simultaneous whole notes
+func xmlChord(v voicing) []xmlNote {
+	var notes []xmlNote
+	for n, t := range v.Tones {
+		xn := xmlNote{Pitch: xmlPitchOf(t, v.AdjSymbol), Duration: 4, Type: "whole"}
+		if n > 0 {
+			xn.Chord = &struct{}{}
+		}
+		notes = append(notes, xn)
+	}
+	return notes
+}
+
+// xmlMelody of successive quarter notes
+func xmlMelody(v voicing) []xmlNote {
+	var notes []xmlNote
+	for _, t := range v.Tones {
+		notes = append(notes, xmlNote{Pitch: xmlPitchOf(t, v.AdjSymbol), Duration: 1, Type: "quarter"})
+	}
+	return notes
+}
+
+func xmlPitchOf(t tone, adjSymbol note.AdjSymbol) *xmlPitch {
+	step, alter := spellingOf(t.Class, adjSymbol)
+	return &xmlPitch{Step: step, Alter: alter, Octave: int(t.Octave)}
+}
+
+// xmlKeyOf a key, by its number of fifths from C major (sharps are positive, flats negative) and its mode
+func xmlKeyOf(k key.Key) *xmlKey {
+	mode := "major"
+	if k.Mode == key.Minor {
+		mode = "minor"
+	}
+	return &xmlKey{Fifths: k.Fifths(), Mode: mode}
+}
+
+type xmlScore struct {
+	XMLName  xml.Name    `xml:"score-partwise"`
+	Version  string      `xml:"version,attr"`
+	PartList xmlPartList `xml:"part-list"`
+	Parts    []xmlPart   `xml:"part"`
+}
+
+type xmlPartList struct {
+	ScoreParts []xmlScorePart `xml:"score-part"`
+}
+
+type xmlScorePart struct {
+	ID       string `xml:"id,attr"`
+	PartName string `xml:"part-name"`
+}
+
+type xmlPart struct {
+	ID       string       `xml:"id,attr"`
+	Measures []xmlMeasure `xml:"measure"`
+}
+
+type xmlMeasure struct {
+	Number     int            `xml:"number,attr"`
+	Attributes *xmlAttributes `xml:"attributes,omitempty"`
+	Notes      []xmlNote      `xml:"note"`
+}
+
+type xmlAttributes struct {
+	Divisions int      `xml:"divisions"`
+	Key       *xmlKey  `xml:"key,omitempty"`
+	Time      *xmlTime `xml:"time,omitempty"`
+	Clef      xmlClef  `xml:"clef"`
+}
+
+type xmlTime struct {
+	Beats    int `xml:"beats"`
+	BeatType int `xml:"beat-type"`
+}
+
+type xmlKey struct {
+	Fifths int    `xml:"fifths"`
+	Mode   string `xml:"mode"`
+}
+
+type xmlClef struct {
+	Sign         string `xml:"sign"`
+	Line         int    `xml:"line"`
+	OctaveChange int    `xml:"clef-octave-change,omitempty"`
+}
+
+type xmlNote struct {
+	Chord     *struct{}     `xml:"chord,omitempty"`
+	Pitch     *xmlPitch     `xml:"pitch,omitempty"`
+	Rest      *struct{}     `xml:"rest,omitempty"`
+	Duration  int           `xml:"duration"`
+	Ties      []xmlTie      `xml:"tie"`
+	Type      string        `xml:"type"`
+	Dot       *struct{}     `xml:"dot,omitempty"`
+	Notations *xmlNotations `xml:"notations,omitempty"`
+}
+
+type xmlTie struct {
+	Type string `xml:"type,attr"`
+}
+
+type xmlNotations struct {
+	Tied          []xmlTie          `xml:"tied"`
+	Slurs         []xmlSlur         `xml:"slur"`
+	Articulations *xmlArticulations `xml:"articulations,omitempty"`
+	Technical     *xmlTechnical     `xml:"technical,omitempty"`
+}
+
+type xmlSlur struct {
+	Type   string `xml:"type,attr"`
+	Number int    `xml:"number,attr"`
+}
+
+type xmlArticulations struct {
+	Accent   *struct{} `xml:"accent,omitempty"`
+	Staccato *struct{} `xml:"staccato,omitempty"`
+	Tenuto   *struct{} `xml:"tenuto,omitempty"`
+}
+
+type xmlTechnical struct {
+	Fingering int `xml:"fingering"`
+}
+
+type xmlPitch struct {
+	Step   string `xml:"step"`
+	Alter  int    `xml:"alter,omitempty"`
+	Octave int    `xml:"octave"`
+}
@@ -0,0 +1,133 @@
+// Render MusicXML of a chord, scale, key, progression, arpeggio, chorale or tune, e.g. to open it in notation software
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/satb"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderMusicXML_Chord(t *testing.T) {
+	score := testRenderMusicXML(t, chord.Of("Cm"))
+	measures := score.Parts[0].Measures
+	assert.Equal(t, 1, len(measures))
+	assert.Equal(t, []xmlNote{
+		{Pitch: &xmlPitch{Step: "C", Octave: 4}, Duration: 4, Type: "whole"},
+		{Chord: &struct{}{}, Pitch: &xmlPitch{Step: "E", Alter: -1, Octave: 4}, Duration: 4, Type: "whole"},
+		{Chord: &struct{}{}, Pitch: &xmlPitch{Step: "G", Octave: 4}, Duration: 4, Type: "whole"},
+	}, measures[0].Notes)
+	assert.Nil(t, measures[0].Attributes.Key)
+}
+
+func TestRenderMusicXML_Scale(t *testing.T) {
+	score := testRenderMusicXML(t, scale.Of("G major"))
+	notes := score.Parts[0].Measures[0].Notes
+	assert.Equal(t, 7, len(notes))
+	assert.Equal(t, xmlNote{Pitch: &xmlPitch{Step: "F", Alter: 1, Octave: 5}, Duration: 1, Type: "quarter"}, notes[6])
+}
+
+func TestRenderMusicXML_Key(t *testing.T) {
+	score := testRenderMusicXML(t, key.Of("Bb"))
+	assert.Equal(t, &xmlKey{Fifths: -2, Mode: "major"}, score.Parts[0].Measures[0].Attributes.Key)
+}
+
+func TestRenderMusicXML_Progression(t *testing.T) {
+	score := testRenderMusicXML(t, progression.Of("Dm7", "G7", "C"))
+	measures := score.Parts[0].Measures
+	assert.Equal(t, 3, len(measures))
+	assert.Equal(t, 3, measures[2].Number)
+	assert.Equal(t, &xmlKey{Fifths: 0, Mode: "major"}, measures[0].Attributes.Key)
+	assert.Nil(t, measures[1].Attributes)
+}
+
+func TestRenderMusicXML_Arpeggio(t *testing.T) {
+	score := testRenderMusicXML(t, chord.Arpeggiate(chord.Of("C"), chord.Converge, 1))
+	notes := score.Parts[0].Measures[0].Notes
+	assert.Equal(t, 3, len(notes))
+	assert.Equal(t, &xmlPitch{Step: "C", Octave: 4}, notes[0].Pitch)
+	assert.Equal(t, &xmlPitch{Step: "G", Octave: 4}, notes[1].Pitch)
+	assert.Equal(t, "quarter", notes[2].Type)
+}
+
+func TestRenderMusicXML_Chorale(t *testing.T) {
+	score := testRenderMusicXML(t, satb.Realize(progression.Of("Dm7", "G7", "C"), key.Key{}))
+	assert.Equal(t, []xmlScorePart{{ID: "P1", PartName: "Soprano"}, {ID: "P2", PartName: "Alto"}, {ID: "P3", PartName: "Tenor"}, {ID: "P4", PartName: "Bass"}}, score.PartList.ScoreParts)
+	assert.Equal(t, 4, len(score.Parts))
+	assert.Equal(t, 3, len(score.Parts[0].Measures))
+	assert.Equal(t, &xmlPitch{Step: "A", Octave: 4}, score.Parts[0].Measures[0].Notes[0].Pitch)
+	assert.Equal(t, &xmlPitch{Step: "B", Octave: 3}, score.Parts[2].Measures[1].Notes[0].Pitch)
+	assert.Equal(t, xmlClef{Sign: "G", Line: 2, OctaveChange: -1}, score.Parts[2].Measures[0].Attributes.Clef)
+	assert.Equal(t, xmlClef{Sign: "F", Line: 4}, score.Parts[3].Measures[0].Attributes.Clef)
+	assert.Equal(t, &xmlKey{Fifths: 0, Mode: "major"}, score.Parts[3].Measures[0].Attributes.Key)
+	assert.Nil(t, score.Parts[3].Measures[1].Attributes)
+}
+
+func TestRenderMusicXML_Tune(t *testing.T) {
+	score := testRenderMusicXML(t, testTune(t))
+	measures := score.Parts[0].Measures
+	assert.Equal(t, 3, len(measures))
+	assert.Equal(t, &xmlAttributes{Divisions: 8, Key: &xmlKey{Fifths: -1, Mode: "major"}, Time: &xmlTime{Beats: 3, BeatType: 4}, Clef: xmlClef{Sign: "G", Line: 2}}, measures[0].Attributes)
+	assert.Equal(t, &xmlArticulations{Staccato: &struct{}{}}, measures[0].Notes[0].Notations.Articulations)
+	assert.Equal(t, &xmlArticulations{Accent: &struct{}{}}, measures[0].Notes[1].Notations.Articulations)
+	assert.Equal(t, xmlNote{Pitch: &xmlPitch{Step: "E", Octave: 4}, Duration: 8, Ties: []xmlTie{{Type: "start"}}, Type: "quarter",
+		Notations: &xmlNotations{Tied: []xmlTie{{Type: "start"}}, Slurs: []xmlSlur{{Type: "start", Number: 1}}}}, measures[0].Notes[2])
+	assert.Equal(t, xmlNote{Pitch: &xmlPitch{Step: "E", Octave: 4}, Duration: 4, Ties: []xmlTie{{Type: "stop"}}, Type: "eighth",
+		Notations: &xmlNotations{Tied: []xmlTie{{Type: "stop"}}}}, measures[1].Notes[0])
+	assert.Equal(t, []xmlSlur{{Type: "stop", Number: 1}}, measures[1].Notes[2].Notations.Slurs)
+	assert.Equal(t, xmlNote{Rest: &struct{}{}, Duration: 8, Type: "quarter"}, measures[1].Notes[3])
+	assert.Equal(t, &xmlPitch{Step: "B", Alter: -1, Octave: 4}, measures[2].Notes[0].Pitch)
+	assert.Equal(t, &xmlArticulations{Staccato: &struct{}{}, Tenuto: &struct{}{}}, measures[2].Notes[0].Notations.Articulations)
+}
+
+func TestRenderMusicXML_Tune_Bass(t *testing.T) {
+	score := testRenderMusicXML(t, melody.Tune{Notes: []melody.Note{{Class: note.C, Octave: 2, Beat: 0, Beats: 3}}})
+	measures := score.Parts[0].Measures
+	assert.Equal(t, &xmlAttributes{Divisions: 8, Clef: xmlClef{Sign: "F", Line: 4}}, measures[0].Attributes)
+	assert.Equal(t, xmlNote{Pitch: &xmlPitch{Step: "C", Octave: 2}, Duration: 24, Type: "half", Dot: &struct{}{}}, measures[0].Notes[0])
+	assert.Equal(t, 1, len(testRenderMusicXML(t, melody.Tune{}).Parts[0].Measures))
+}
+
+func TestRenderMusicXML_Tune_Fingering(t *testing.T) {
+	tune := melody.Tune{Notes: []melody.Note{{Class: note.C, Octave: 4, Beat: 0, Beats: 6, Finger: 1}}}
+	measures := testRenderMusicXML(t, tune).Parts[0].Measures
+	assert.Equal(t, &xmlTechnical{Fingering: 1}, measures[0].Notes[0].Notations.Technical)
+	assert.Nil(t, measures[1].Notes[0].Notations.Technical, "only of the first of a tied note")
+	var out bytes.Buffer
+	assert.Nil(t, renderMusicXML(&out, tune, Options{}))
+	assert.Contains(t, out.String(), "<fingering>1</fingering>")
+}
+
+func TestXMLKeyOf(t *testing.T) {
+	assert.Equal(t, 0, xmlKeyOf(key.Of("A minor")).Fifths)
+	assert.Equal(t, 1, xmlKeyOf(key.Of("G")).Fifths)
+	assert.Equal(t, 5, xmlKeyOf(key.Of("B")).Fifths)
+	assert.Equal(t, -6, xmlKeyOf(key.Of("Gb")).Fifths)
+	assert.Equal(t, -5, xmlKeyOf(key.Of("Db")).Fifths)
+	assert.Equal(t, 7, xmlKeyOf(key.Of("C#")).Fifths)
+	assert.Equal(t, -1, xmlKeyOf(key.Of("F")).Fifths)
+	assert.Equal(t, -1, xmlKeyOf(key.Of("D minor")).Fifths)
+}
+
+//
+// Private
+//
+
+func testRenderMusicXML(t *testing.T, v interface{}) xmlScore {
+	var out bytes.Buffer
+	assert.Nil(t, renderMusicXML(&out, v, Options{}))
+	assert.True(t, strings.HasPrefix(out.String(), xml.Header+musicXMLDoctype+"\n<score-partwise version=\"3.1\">"))
+	var score xmlScore
+	assert.Nil(t, xml.Unmarshal(out.Bytes(), &score))
+	return score
+}
@@ -0,0 +1,76 @@
+// Values are rendered plainly by default, or e.g. in color with an Option, e.g. To(os.Stdout, "table", chord.Of("Cm7"), WithColor())
+package render
+
+import (
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/schema"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Option for rendering a value
+type Option func(*Options)
+
+// Options for rendering a value, which each Renderer honors as it can, e.g. only the table is rendered in color
+type Options struct {
+	Color    bool            // highlight notes with ANSI color codes, e.g. for a terminal
+	Full     bool            // list everything about the value, e.g. the scale, signature and diatonic chords of a key
+	Schema   schema.Version  // of YAML or JSON, for the models that have versions, else their original schema
+	Spelling Spelling        // of sharps or flats preferred, by every format, else as each value is spelled
+	Dynamics dynamics.Phrase // of the notes, by the velocity of MIDI or the gain of Sonic Pi or SuperCollider, else as each plays by default
+
+	Accidentals symbol.Accidentals // of the notes written as YAML, JSON or a table, in Unicode, else in ASCII
+}
+
+// WithColor highlighting of notes with ANSI color codes: the tonic in one color, chord tones and tensions in others, and scale degrees shaded
+func WithColor() Option {
+	return func(o *Options) {
+		o.Color = true
+	}
+}
+
+// WithFull listing of everything about a value, e.g. the scale, key signature and diatonic chords of a key in a table
+func WithFull() Option {
+	return func(o *Options) {
+		o.Full = true
+	}
+}
+
+// WithSchema version of YAML or JSON, e.g. schema.V2, for the models that have versions
+func WithSchema(v schema.Version) Option {
+	return func(o *Options) {
+		o.Schema = v
+	}
+}
+
+// WithSpelling of the notes of a value preferred over how it's spelled, e.g. PreferFlats, honored by every format
+func WithSpelling(s Spelling) Option {
+	return func(o *Options) {
+		o.Spelling = s
+	}
+}
+
+// WithDynamics of the notes of a value, at one level, e.g. dynamics.At(dynamics.P), or a crescendo or diminuendo from its first notes to its last,
+// by the velocity of MIDI, or the gain of Sonic Pi or SuperCollider
+func WithDynamics(p dynamics.Phrase) Option {
+	return func(o *Options) {
+		o.Dynamics = p
+	}
+}
+
+// WithAccidentals of the notes written as YAML, JSON or a table, in ASCII or Unicode, e.g. WithAccidentals(symbol.Unicode) to write Bb as B♭
+func WithAccidentals(a symbol.Accidentals) Option {
+	return func(o *Options) {
+		o.Accidentals = a
+	}
+}
+
+//
+// Private
+//
+
+func optionsOf(opts []Option) (o Options) {
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return
+}
@@ -0,0 +1,38 @@
+// Values are rendered plainly by default, or e.g. in color with an Option, e.g. To(os.Stdout, "table", chord.Of("Cm7"), WithColor())
+package render
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/schema"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+func TestWithColor(t *testing.T) {
+	assert.Equal(t, Options{Color: true}, optionsOf([]Option{WithColor()}))
+	assert.Equal(t, Options{}, optionsOf(nil))
+}
+
+func TestWithFull(t *testing.T) {
+	assert.Equal(t, Options{Full: true}, optionsOf([]Option{WithFull()}))
+}
+
+func TestWithSchema(t *testing.T) {
+	assert.Equal(t, Options{Schema: schema.V2}, optionsOf([]Option{WithSchema(schema.V2)}))
+	assert.Equal(t, Options{Color: true, Schema: schema.V1}, optionsOf([]Option{WithColor(), WithSchema(schema.V1)}))
+}
+
+func TestWithSpelling(t *testing.T) {
+	assert.Equal(t, Options{Spelling: PreferFlats}, optionsOf([]Option{WithSpelling(PreferFlats)}))
+}
+
+func TestWithDynamics(t *testing.T) {
+	assert.Equal(t, Options{Dynamics: dynamics.At(dynamics.P)}, optionsOf([]Option{WithDynamics(dynamics.At(dynamics.P))}))
+}
+
+func TestWithAccidentals(t *testing.T) {
+	assert.Equal(t, Options{Accidentals: symbol.Unicode}, optionsOf([]Option{WithAccidentals(symbol.Unicode)}))
+}
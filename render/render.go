@@ -0,0 +1,136 @@
+// Render music theory models in different formats, e.g. YAML, JSON, a text table, LilyPond, MusicXML, SVG, ABC, MIDI, a staff in plain text, Braille music, a lead sheet, or the code of Sonic Pi or SuperCollider.
+//
+// Each format is rendered by a Renderer in a registry, so new formats can be added without touching every command.
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package render
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Formats built in to the registry
+const (
+	YAML     = "yaml"
+	JSON     = "json"
+	Table    = "table"
+	LilyPond = "lilypond"
+	MusicXML = "musicxml"
+	SVG      = "svg"
+	ABC      = "abc"
+	MIDI     = "midi"
+	Staff    = "staff"
+	Braille  = "braille"
+
+	LeadSheet = "leadsheet"
+
+	SonicPi       = "sonicpi"
+	SuperCollider = "sc"
+)
+
+var (
+	// ErrUnknownFormat when rendering to a format that isn't registered
+	ErrUnknownFormat = errors.New("unknown format")
+
+	// ErrDuplicateFormat when registering a format that is already registered
+	ErrDuplicateFormat = errors.New("duplicate format")
+
+	// ErrUnsupported when a renderer can't render a type of value, e.g. a key as a LilyPond chord
+	ErrUnsupported = errors.New("unsupported value")
+)
+
+// Renderer writes a value, e.g. a chord.Chord, scale.Scale, key.Key, progression.Progression or chord.Arpeggio, in some format, honoring the options it can
+type Renderer interface {
+	Render(w io.Writer, v interface{}, o Options) error
+}
+
+// RendererFunc is an ordinary func used as a Renderer
+type RendererFunc func(w io.Writer, v interface{}, o Options) error
+
+// Render a value by calling the func
+func (f RendererFunc) Render(w io.Writer, v interface{}, o Options) error {
+	return f(w, v, o)
+}
+
+// Register a Renderer for a format, e.g. Register("tab", myRenderer).
+// Register formats at startup, before anything is rendered; it's not safe to register while rendering.
+func Register(format string, r Renderer) error {
+	if _, ok := renderers[format]; ok {
+		return fmt.Errorf("%w %q", ErrDuplicateFormat, format)
+	}
+	renderers[format] = r
+	return nil
+}
+
+// To a writer, a value in a format, with any options, e.g. To(os.Stdout, "table", chord.Of("Cm7"), WithColor())
+func To(w io.Writer, format string, v interface{}, options ...Option) error {
+	r, ok := renderers[format]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownFormat, format)
+	}
+	o := optionsOf(options)
+	if o.Accidentals == symbol.Unicode && accidentalFormats[format] {
+		var b strings.Builder
+		if err := r.Render(&b, Spelled(v, o.Spelling), o); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w, o.Accidentals.Of(b.String()))
+		return err
+	}
+	return r.Render(w, Spelled(v, o.Spelling), o)
+}
+
+// Formats that are registered, in alphabetical order
+func Formats() []string {
+	var formats []string
+	for format := range renderers {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+//
+// Private
+//
+
+var renderers = map[string]Renderer{
+	YAML:     RendererFunc(renderYAML),
+	JSON:     RendererFunc(renderJSON),
+	Table:    RendererFunc(renderTable),
+	LilyPond: RendererFunc(renderLilyPond),
+	MusicXML: RendererFunc(renderMusicXML),
+	SVG:      RendererFunc(renderSVG),
+	ABC:      RendererFunc(renderABC),
+	MIDI:     RendererFunc(renderMIDI),
+	Staff:    RendererFunc(renderStaff),
+	Braille:  RendererFunc(renderBraille),
+
+	LeadSheet: RendererFunc(renderLeadSheet),
+
+	SonicPi:       RendererFunc(renderSonicPi),
+	SuperCollider: RendererFunc(renderSuperCollider),
+}
+
+// accidentalFormats whose notes are written in Unicode WithAccidentals, of text that's read as it's written,
+// but not of the formats that write notes in a syntax of their own, e.g. the "bes" of LilyPond or the "_B" of ABC
+var accidentalFormats = map[string]bool{YAML: true, JSON: true, Table: true, LeadSheet: true}
+
+// unsupported error for a value
+func unsupported(format string, v interface{}) error {
+	return fmt.Errorf("%w %T in format %q", ErrUnsupported, v, format)
+}
@@ -0,0 +1,96 @@
+// Render music theory models in different formats, e.g. YAML, JSON, a text table, LilyPond, MusicXML, SVG, ABC, MIDI, or the code of Sonic Pi or SuperCollider.
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+func TestTo(t *testing.T) {
+	var out bytes.Buffer
+	err := To(&out, JSON, chord.Of("Cm"))
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"root\":\"C\",\"tones\":{\"1\":\"C\",\"3\":\"Eb\",\"5\":\"G\"}}\n", out.String())
+}
+
+func TestTo_Spelling(t *testing.T) {
+	var out bytes.Buffer
+	err := To(&out, JSON, chord.Of("Cm"), WithSpelling(PreferSharps))
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"root\":\"C\",\"tones\":{\"1\":\"C\",\"3\":\"D#\",\"5\":\"G\"}}\n", out.String())
+}
+
+func TestTo_Accidentals(t *testing.T) {
+	var out bytes.Buffer
+	err := To(&out, JSON, chord.Of("Cm7"), WithAccidentals(symbol.Unicode))
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"root\":\"C\",\"tones\":{\"1\":\"C\",\"3\":\"E♭\",\"5\":\"G\",\"7\":\"B♭\"}}\n", out.String())
+	out.Reset()
+	assert.Nil(t, To(&out, Table, chord.Of("Cm7"), WithAccidentals(symbol.Unicode)))
+	assert.Contains(t, out.String(), "7     B♭    m7        466.16Hz\n")
+	out.Reset()
+	assert.Nil(t, To(&out, YAML, chord.Of("C#m"), WithAccidentals(symbol.Unicode)))
+	assert.Contains(t, out.String(), "root: C♯\n")
+	for _, format := range []string{YAML, JSON, Table} {
+		out.Reset()
+		assert.Nil(t, To(&out, format, chord.Of("Cm7"), WithAccidentals(symbol.ASCII)))
+		assert.NotContains(t, out.String(), "♭", format)
+	}
+	for _, format := range []string{LilyPond, ABC} {
+		var ascii bytes.Buffer
+		assert.Nil(t, To(&ascii, format, chord.Of("Cm7")))
+		out.Reset()
+		assert.Nil(t, To(&out, format, chord.Of("Cm7"), WithAccidentals(symbol.Unicode)))
+		assert.Equal(t, ascii.String(), out.String(), format)
+	}
+}
+
+func TestTo_UnknownFormat(t *testing.T) {
+	err := To(&bytes.Buffer{}, "pdf", chord.Of("Cm"))
+	assert.True(t, errors.Is(err, ErrUnknownFormat))
+	assert.Equal(t, `unknown format "pdf"`, err.Error())
+}
+
+func TestTo_Unsupported(t *testing.T) {
+	for _, format := range Formats() {
+		err := To(&bytes.Buffer{}, format, 42)
+		assert.True(t, errors.Is(err, ErrUnsupported), format)
+	}
+	assert.Equal(t, `unsupported value int in format "table"`, To(&bytes.Buffer{}, Table, 42).Error())
+}
+
+func TestRegister(t *testing.T) {
+	defer delete(renderers, "root")
+
+	err := Register("root", RendererFunc(func(w io.Writer, v interface{}, o Options) error {
+		c, ok := v.(chord.Chord)
+		if !ok {
+			return unsupported("root", v)
+		}
+		_, err := fmt.Fprintln(w, c.Root.String(c.AdjSymbol))
+		return err
+	}))
+	assert.Nil(t, err)
+	assert.Contains(t, Formats(), "root")
+
+	var out bytes.Buffer
+	assert.Nil(t, To(&out, "root", chord.Of("Bbm7")))
+	assert.Equal(t, "Bb\n", out.String())
+}
+
+func TestRegister_Duplicate(t *testing.T) {
+	err := Register(YAML, RendererFunc(renderJSON))
+	assert.True(t, errors.Is(err, ErrDuplicateFormat))
+}
+
+func TestFormats(t *testing.T) {
+	assert.Equal(t, []string{"abc", "braille", "json", "leadsheet", "lilypond", "midi", "musicxml", "sc", "sonicpi", "staff", "svg", "table", "yaml"}, Formats())
+}
@@ -0,0 +1,82 @@
+// Render Sonic Pi code of a chord, scale, key, progression or arpeggio, at the gain of any dynamics, e.g. to paste into a buffer and play it live
+package render
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+func renderSonicPi(w io.Writer, v interface{}, o Options) error {
+	var lines []string
+	switch t := v.(type) {
+	case chord.Chord:
+		lines = append(lines, sonicPiChord(playedVoicing(t), gainsOf(o.Dynamics, 1), 0))
+	case scale.Scale:
+		lines = append(lines, sonicPiMelody(scaleVoicing(t), o.Dynamics))
+	case key.Key:
+		lines = append(lines, sonicPiMelody(scaleVoicing(keyScale(t)), o.Dynamics))
+	case progression.Progression:
+		gains := gainsOf(o.Dynamics, len(t.Chords))
+		for n, c := range t.Chords {
+			lines = append(lines, sonicPiChord(playedVoicing(c), gains, n)+" # "+c.Name(), "sleep "+strconv.Itoa(liveCodeBarBeats))
+		}
+	case chord.Arpeggio:
+		lines = append(lines, sonicPiMelody(arpeggioVoicing(t), o.Dynamics))
+	default:
+		return unsupported(SonicPi, v)
+	}
+	_, err := fmt.Fprintf(w, "use_bpm %d\n%s\n", midi.Tempo, strings.Join(lines, "\n"))
+	return err
+}
+
+// sonicPiChord of simultaneous tones, sustained for a bar, at the nth of any gains, e.g. play_chord [:c4, :eb4, :g4], sustain: 4, amp: 0.4
+func sonicPiChord(v voicing, gains []string, n int) string {
+	line := fmt.Sprintf("play_chord %s, sustain: %d", sonicPiNotes(v), liveCodeBarBeats)
+	if n < len(gains) {
+		line += ", amp: " + gains[n]
+	}
+	return line
+}
+
+// sonicPiMelody of successive tones, a beat each, e.g. play_pattern_timed [:c4, :d4, :e4], [1], at any dynamics,
+// all at one gain, or each at its own, e.g. [:c4, :d4].zip([0.2, 0.4]).each { |n, a| play n, amp: a; sleep 1 }
+func sonicPiMelody(v voicing, p dynamics.Phrase) string {
+	switch gains := gainsOf(p, len(v.Tones)); {
+	case len(gains) == 0:
+		return fmt.Sprintf("play_pattern_timed %s, [1]", sonicPiNotes(v))
+	case p.From == p.To:
+		return fmt.Sprintf("play_pattern_timed %s, [1], amp: %s", sonicPiNotes(v), gains[0])
+	default:
+		return fmt.Sprintf("%s.zip([%s]).each { |n, a| play n, amp: a; sleep 1 }", sonicPiNotes(v), strings.Join(gains, ", "))
+	}
+}
+
+// sonicPiNotes of the tones of a voicing, in a ring of symbols, e.g. [:c4, :eb4, :g4]
+func sonicPiNotes(v voicing) string {
+	var names []string
+	for _, t := range v.Tones {
+		letter, alter := spellingOf(t.Class, v.AdjSymbol)
+		name := ":" + strings.ToLower(letter)
+		switch alter {
+		case 1:
+			name += "s"
+		case -1:
+			name += "b"
+		}
+		names = append(names, name+strconv.Itoa(int(t.Octave)))
+	}
+	return "[" + strings.Join(names, ", ") + "]"
+}
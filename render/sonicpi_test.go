@@ -0,0 +1,67 @@
+// Render Sonic Pi code of a chord, scale, key, progression or arpeggio, at the gain of any dynamics, e.g. to paste into a buffer and play it live
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderSonicPi_Chord(t *testing.T) {
+	assertSonicPi(t, "use_bpm 120\nplay_chord [:c4, :eb4, :g4, :bb4], sustain: 4\n", chord.Of("Cm7"))
+	assertSonicPi(t, "use_bpm 120\nplay_chord [:fs4, :as4, :cs5], sustain: 4\n", chord.Of("F#").SpelledIn(key.Of("F# major")))
+	assertSonicPi(t, "use_bpm 120\nplay_chord [:c4, :e4, :g4, :b4, :d5, :a5], sustain: 4\n", chord.Of("Cmaj13"))
+}
+
+func TestRenderSonicPi_Scale(t *testing.T) {
+	assertSonicPi(t, "use_bpm 120\nplay_pattern_timed [:d4, :e4, :f4, :g4, :a4, :b4, :c5], [1]\n", scale.Of("D dorian"))
+}
+
+func TestRenderSonicPi_Key(t *testing.T) {
+	assertSonicPi(t, "use_bpm 120\nplay_pattern_timed [:eb4, :f4, :g4, :ab4, :bb4, :c5, :d5], [1]\n", key.Of("Eb"))
+}
+
+func TestRenderSonicPi_Progression(t *testing.T) {
+	assertSonicPi(t, "use_bpm 120\n"+
+		"play_chord [:d4, :f4, :a4, :c5], sustain: 4 # Dm7\nsleep 4\n"+
+		"play_chord [:g4, :b4, :d5, :f5], sustain: 4 # G7\nsleep 4\n"+
+		"play_chord [:c4, :e4, :g4], sustain: 4 # C\nsleep 4\n", progression.Of("Dm7", "G7", "C"))
+}
+
+func TestRenderSonicPi_Arpeggio(t *testing.T) {
+	assertSonicPi(t, "use_bpm 120\nplay_pattern_timed [:c4, :e4, :g4, :e4], [1]\n", chord.Arpeggiate(chord.Of("C"), chord.UpDown, 1))
+}
+
+func TestRenderSonicPi_Dynamics(t *testing.T) {
+	assertSonicPiWith(t, "use_bpm 120\nplay_chord [:c4, :e4, :g4], sustain: 4, amp: 0.15\n", chord.Of("C"), dynamics.At(dynamics.P))
+	assertSonicPiWith(t, "use_bpm 120\nplay_pattern_timed [:c4, :e4, :g4], [1], amp: 0.78\n", chord.Arpeggiate(chord.Of("C"), chord.Up, 1), dynamics.At(dynamics.FF))
+	assertSonicPiWith(t, "use_bpm 120\n[:c4, :e4, :g4].zip([0.07, 0.33, 0.78]).each { |n, a| play n, amp: a; sleep 1 }\n", chord.Arpeggiate(chord.Of("C"), chord.Up, 1), dynamics.Phrase{From: dynamics.PP, To: dynamics.FF})
+	assertSonicPiWith(t, "use_bpm 120\n"+
+		"play_chord [:c4, :e4, :g4], sustain: 4, amp: 0.57 # C\nsleep 4\n"+
+		"play_chord [:g4, :b4, :d5], sustain: 4, amp: 0.15 # G\nsleep 4\n", progression.Of("C", "G"), dynamics.Phrase{From: dynamics.F, To: dynamics.P})
+}
+
+func TestRenderSonicPi_Unsupported(t *testing.T) {
+	assert.NotNil(t, renderSonicPi(&bytes.Buffer{}, 42, Options{}))
+}
+
+//
+// Private
+//
+
+func assertSonicPi(t *testing.T, expect string, v interface{}) {
+	assertSonicPiWith(t, expect, v, dynamics.Phrase{})
+}
+
+func assertSonicPiWith(t *testing.T, expect string, v interface{}, p dynamics.Phrase) {
+	var out bytes.Buffer
+	assert.Nil(t, renderSonicPi(&out, v, Options{Dynamics: p}))
+	assert.Equal(t, expect, out.String())
+}
@@ -0,0 +1,56 @@
+// Render YAML or JSON of any model that can express itself so, e.g. a chord.Chord
+package render
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/schema"
+)
+
+//
+// Private
+//
+
+type yamlSpec interface {
+	ToYAML() string
+}
+
+type jsonSpec interface {
+	ToJSON() string
+}
+
+// yamlSchemaSpec of a model with versions of its schema, e.g. a chord.Chord
+type yamlSchemaSpec interface {
+	ToYAMLSchema(v schema.Version) string
+}
+
+// jsonSchemaSpec of a model with versions of its schema, e.g. a chord.Chord
+type jsonSchemaSpec interface {
+	ToJSONSchema(v schema.Version) string
+}
+
+func renderYAML(w io.Writer, v interface{}, o Options) error {
+	if s, ok := v.(yamlSchemaSpec); ok && len(o.Schema) > 0 {
+		_, err := io.WriteString(w, s.ToYAMLSchema(o.Schema))
+		return err
+	}
+	s, ok := v.(yamlSpec)
+	if !ok {
+		return unsupported(YAML, v)
+	}
+	_, err := io.WriteString(w, s.ToYAML())
+	return err
+}
+
+func renderJSON(w io.Writer, v interface{}, o Options) error {
+	if s, ok := v.(jsonSchemaSpec); ok && len(o.Schema) > 0 {
+		_, err := io.WriteString(w, s.ToJSONSchema(o.Schema)+"\n")
+		return err
+	}
+	s, ok := v.(jsonSpec)
+	if !ok {
+		return unsupported(JSON, v)
+	}
+	_, err := io.WriteString(w, s.ToJSON()+"\n")
+	return err
+}
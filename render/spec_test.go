@@ -0,0 +1,41 @@
+// Render YAML or JSON of any model that can express itself so, e.g. a chord.Chord
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/scale"
+	"github.com/go-music-theory/music-theory/schema"
+)
+
+func TestRenderYAML(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderYAML(&out, key.Of("Eb"), Options{}))
+	assert.Equal(t, key.Of("Eb").ToYAML(), out.String())
+}
+
+func TestRenderJSON(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderJSON(&out, scale.Of("C minor"), Options{}))
+	assert.Equal(t, scale.Of("C minor").ToJSON()+"\n", out.String())
+}
+
+func TestRenderYAML_Schema(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderYAML(&out, chord.Of("Cm7"), Options{Schema: schema.V2}))
+	assert.Equal(t, chord.Of("Cm7").ToYAMLSchema(schema.V2), out.String())
+}
+
+func TestRenderJSON_Schema(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderJSON(&out, key.Of("Eb"), Options{Schema: schema.V1}))
+	assert.Equal(t, key.Of("Eb").ToJSON()+"\n", out.String())
+	out.Reset()
+	assert.Nil(t, renderJSON(&out, chord.Arpeggiate(chord.Of("C"), chord.Up, 1), Options{Schema: schema.V2}))
+	assert.Equal(t, chord.Arpeggiate(chord.Of("C"), chord.Up, 1).ToJSON()+"\n", out.String())
+}
@@ -0,0 +1,205 @@
+// Notes are spelled by a letter and an alteration, sharp or flat, for formats that name notes that way, e.g. MusicXML,
+// with sharps or flats as the value is spelled, or else as preferred by a Spelling, e.g. of a user who reads in flat keys
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Spelling of the notes of every value rendered, preferring sharps or flats, or following the key of each value,
+// or if empty, as the value is spelled, e.g. by the name it was parsed from
+type Spelling string
+
+// Spellings preferred over how a value is spelled
+const (
+	PreferSharps Spelling = "sharps" // e.g. G# of Fm
+	PreferFlats  Spelling = "flats"  // e.g. Db of A major
+	FollowKey    Spelling = "key"    // e.g. Ab of Fm in F minor, or Bb of C7, of flats in a key of neither
+)
+
+// ErrUnknownSpelling when parsing a spelling that doesn't exist, e.g. "naturals"
+var ErrUnknownSpelling = errors.New("unknown spelling")
+
+// Spellings preferred over how a value is spelled, e.g. for the usage of a flag
+func Spellings() []Spelling {
+	return []Spelling{PreferSharps, PreferFlats, FollowKey}
+}
+
+// ParseSpelling of a name, e.g. "flats", or of an empty name, as each value is spelled
+func ParseSpelling(name string) (Spelling, error) {
+	s := Spelling(strings.ToLower(strings.TrimSpace(name)))
+	if len(s) == 0 {
+		return s, nil
+	}
+	for _, known := range Spellings() {
+		if s == known {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("%w %q", ErrUnknownSpelling, name)
+}
+
+// Spelled value, a chord, scale, key, progression, arpeggio, tune or pitch table respelled by a preference, without changing its pitch classes,
+// or the value as it was of an empty Spelling, or of a type that's spelled otherwise, e.g. a chorale in its key, or a pitch table, of no key, following one
+func Spelled(v interface{}, s Spelling) interface{} {
+	if len(s) == 0 {
+		return v
+	}
+	switch t := v.(type) {
+	case chord.Chord:
+		return spelledChord(t, s)
+	case scale.Scale:
+		classes := make([]note.Class, 0, len(t.Tones))
+		for _, class := range t.Tones {
+			classes = append(classes, class)
+		}
+		t = t.Copy()
+		t.AdjSymbol = adjSymbolOf(s, t.Root, t.AdjSymbol, minorThird(t.Root, t.Tones[scale.Interval(3)]), classes)
+		return t
+	case key.Key:
+		switch fifths := t.Fifths(); {
+		case s == PreferSharps:
+			t.AdjSymbol = note.Sharp
+		case s == PreferFlats:
+			t.AdjSymbol = note.Flat
+		case fifths > 0:
+			t.AdjSymbol = note.Sharp
+		case fifths < 0:
+			t.AdjSymbol = note.Flat
+		}
+		return t
+	case progression.Progression:
+		p := progression.Progression{}
+		k := t.Key()
+		for _, c := range t.Chords {
+			if s == FollowKey {
+				p.Chords = append(p.Chords, c.SpelledIn(k))
+			} else {
+				p.Chords = append(p.Chords, spelledChord(c, s))
+			}
+		}
+		return p
+	case chord.Arpeggio:
+		t.Chord = spelledChord(t.Chord, s)
+		return t
+	case melody.Tune:
+		t.Key = Spelled(t.Key, s).(key.Key)
+		return t
+	case pitch.Table:
+		switch s {
+		case PreferSharps:
+			t.AdjSymbol = note.Sharp
+		case PreferFlats:
+			t.AdjSymbol = note.Flat
+		}
+		return t
+	}
+	return v
+}
+
+//
+// Private
+//
+
+// spelledChord by a preference, a copy with its own tones
+func spelledChord(c chord.Chord, s Spelling) chord.Chord {
+	classes := make([]note.Class, 0, len(c.Tones))
+	for _, t := range c.Tones {
+		classes = append(classes, t.Class)
+	}
+	third, _ := c.ClassAt(chord.I3)
+	c = c.Copy()
+	c.AdjSymbol = adjSymbolOf(s, c.Root, c.AdjSymbol, minorThird(c.Root, third), classes)
+	return c
+}
+
+// minorThird whether a third is a minor third up from a root, or false if either is missing, e.g. of a power chord C5
+func minorThird(root note.Class, third note.Class) bool {
+	if root == note.Nil || third == note.Nil {
+		return false
+	}
+	return root.Diff(third) == 3
+}
+
+// adjSymbolOf some pitch classes on a root, spelled by a preference, of sharps or flats, or following the key,
+// whichever of them writes each class on a letter of its own, e.g. sharps of F# in C lydian, else those of the key on the root,
+// minor or major, e.g. flats of F minor, or else flats in a key of neither, e.g. the Bb of C7
+func adjSymbolOf(s Spelling, root note.Class, adjSymbol note.AdjSymbol, minor bool, classes []note.Class) note.AdjSymbol {
+	switch s {
+	case PreferSharps:
+		return note.Sharp
+	case PreferFlats:
+		return note.Flat
+	case FollowKey:
+		sharps, flats := distinctLetters(classes, note.Sharp), distinctLetters(classes, note.Flat)
+		if sharps != flats {
+			if sharps {
+				return note.Sharp
+			}
+			return note.Flat
+		}
+		mode := key.Major
+		if minor {
+			mode = key.Minor
+		}
+		if (key.Key{Root: root, AdjSymbol: adjSymbol, Mode: mode}).Fifths() > 0 {
+			return note.Sharp
+		}
+		return note.Flat
+	}
+	return adjSymbol
+}
+
+// distinctLetters if spelled with sharps or flats, each of the pitch classes is on a letter no other is on
+func distinctLetters(classes []note.Class, adjSymbol note.AdjSymbol) bool {
+	letters := make(map[string]bool)
+	for _, class := range classes {
+		letter, _ := spellingOf(class, adjSymbol)
+		if letters[letter] {
+			return false
+		}
+		letters[letter] = true
+	}
+	return true
+}
+
+// spellingOf a pitch class, its letter and alteration in semitones, e.g. "E", -1 for Eb, or an empty letter for the Nil class
+func spellingOf(class note.Class, adjSymbol note.AdjSymbol) (string, int) {
+	switch class {
+	case note.C:
+		return "C", 0
+	case note.D:
+		return "D", 0
+	case note.E:
+		return "E", 0
+	case note.F:
+		return "F", 0
+	case note.G:
+		return "G", 0
+	case note.A:
+		return "A", 0
+	case note.B:
+		return "B", 0
+	}
+	if class == note.Nil {
+		return "", 0
+	}
+	if adjSymbol == note.Flat {
+		up, _ := class.Step(1)
+		letter, _ := spellingOf(up, adjSymbol)
+		return letter, -1
+	}
+	down, _ := class.Step(-1)
+	letter, _ := spellingOf(down, adjSymbol)
+	return letter, 1
+}
@@ -0,0 +1,107 @@
+// Notes are spelled by a letter and an alteration, sharp or flat, for formats that name notes that way, e.g. MusicXML,
+// with sharps or flats as the value is spelled, or else as preferred by a Spelling, e.g. of a user who reads in flat keys
+package render
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestSpellingOf(t *testing.T) {
+	testSpellingOf(t, note.C, note.Sharp, "C", 0)
+	testSpellingOf(t, note.Cs, note.Sharp, "C", 1)
+	testSpellingOf(t, note.Cs, note.Flat, "D", -1)
+	testSpellingOf(t, note.As, note.Flat, "B", -1)
+	testSpellingOf(t, note.Fs, note.Sharp, "F", 1)
+	testSpellingOf(t, note.Nil, note.Sharp, "", 0)
+}
+
+func TestParseSpelling(t *testing.T) {
+	s, err := ParseSpelling(" Flats")
+	assert.Nil(t, err)
+	assert.Equal(t, PreferFlats, s)
+	s, err = ParseSpelling("")
+	assert.Nil(t, err)
+	assert.Equal(t, Spelling(""), s)
+	_, err = ParseSpelling("naturals")
+	assert.True(t, errors.Is(err, ErrUnknownSpelling))
+	assert.Equal(t, `unknown spelling "naturals"`, err.Error())
+}
+
+func TestSpelled_Chord(t *testing.T) {
+	c := chord.Of("A7")
+	assert.Equal(t, c, Spelled(c, ""))
+	assert.Equal(t, note.Flat, Spelled(c, PreferFlats).(chord.Chord).AdjSymbol)
+	assert.Equal(t, note.Sharp, Spelled(chord.Of("Bb"), PreferSharps).(chord.Chord).AdjSymbol)
+	assert.Equal(t, note.Sharp, Spelled(c, FollowKey).(chord.Chord).AdjSymbol)
+	assert.Equal(t, note.Flat, Spelled(chord.Of("C7"), FollowKey).(chord.Chord).AdjSymbol)
+	assert.Equal(t, note.Flat, Spelled(chord.Of("Fm"), FollowKey).(chord.Chord).AdjSymbol)
+	assert.Equal(t, note.Sharp, Spelled(chord.Of("C#m"), FollowKey).(chord.Chord).AdjSymbol)
+	assert.Equal(t, chord.Of("A7").AdjSymbol, c.AdjSymbol)
+	for _, s := range []Spelling{PreferSharps, PreferFlats, FollowKey} {
+		for _, name := range []string{"C5", "Csus4", "G5"} {
+			assert.Equal(t, chord.Of(name).ToneSet(), Spelled(chord.Of(name), s).(chord.Chord).ToneSet(), name+" of no third")
+		}
+		assert.Equal(t, note.Nil, Spelled(chord.Chord{}, s).(chord.Chord).Root)
+	}
+	assert.Equal(t, note.Sharp, Spelled(chord.Of("C5"), PreferSharps).(chord.Chord).AdjSymbol)
+}
+
+func TestSpelled_Scale(t *testing.T) {
+	assert.Equal(t, note.Sharp, Spelled(scale.Of("C lydian"), FollowKey).(scale.Scale).AdjSymbol)
+	assert.Equal(t, note.Flat, Spelled(scale.Of("C minor"), FollowKey).(scale.Scale).AdjSymbol)
+	assert.Equal(t, note.Flat, Spelled(scale.Of("E major"), PreferFlats).(scale.Scale).AdjSymbol)
+	for _, s := range []Spelling{PreferSharps, PreferFlats, FollowKey} {
+		assert.Equal(t, note.Nil, Spelled(scale.Scale{}, s).(scale.Scale).Root)
+	}
+}
+
+func TestSpelled_Key(t *testing.T) {
+	assert.Equal(t, note.Flat, Spelled(key.Of("C#"), PreferFlats).(key.Key).AdjSymbol)
+	assert.Equal(t, note.Flat, Spelled(key.Of("F"), FollowKey).(key.Key).AdjSymbol)
+	assert.Equal(t, note.Sharp, Spelled(key.Of("E"), FollowKey).(key.Key).AdjSymbol)
+}
+
+func TestSpelled_Progression(t *testing.T) {
+	p := Spelled(progression.Of("Bb", "Gm", "Eb", "F"), PreferSharps).(progression.Progression)
+	for _, c := range p.Chords {
+		assert.Equal(t, note.Sharp, c.AdjSymbol)
+	}
+	p = Spelled(progression.Of("A#", "Gm", "D#", "F"), FollowKey).(progression.Progression)
+	for _, c := range p.Chords {
+		assert.Equal(t, note.Flat, c.AdjSymbol)
+	}
+}
+
+func TestSpelled_Others(t *testing.T) {
+	a := Spelled(chord.Arpeggiate(chord.Of("Cm"), chord.Up, 1), PreferSharps).(chord.Arpeggio)
+	assert.Equal(t, note.Sharp, a.Chord.AdjSymbol)
+	table := Spelled(pitch.Table{AdjSymbol: note.Sharp}, PreferFlats).(pitch.Table)
+	assert.Equal(t, note.Flat, table.AdjSymbol)
+	table = Spelled(pitch.Table{AdjSymbol: note.Sharp}, FollowKey).(pitch.Table)
+	assert.Equal(t, note.Sharp, table.AdjSymbol)
+	tune := Spelled(testTune(t), PreferSharps).(melody.Tune)
+	assert.Equal(t, note.Sharp, tune.Key.AdjSymbol)
+	assert.Equal(t, testTune(t).Notes, tune.Notes)
+	assert.Equal(t, 42, Spelled(42, PreferFlats))
+}
+
+//
+// Private
+//
+
+func testSpellingOf(t *testing.T, class note.Class, adjSymbol note.AdjSymbol, expectLetter string, expectAlter int) {
+	letter, alter := spellingOf(class, adjSymbol)
+	assert.Equal(t, expectLetter, letter)
+	assert.Equal(t, expectAlter, alter)
+}
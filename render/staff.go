@@ -0,0 +1,244 @@
+// Render a staff of a chord, scale, key, progression or arpeggio, drawn in plain text on five lines for a terminal, with a treble clef and the key signature
+package render
+
+import (
+	"io"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+// Steps of the letters of the lines of the treble staff, counted from C0, from the bottom line, E4, to the top line, F5
+const (
+	staffBottom = 2 + 7*4
+	staffTop    = 3 + 7*5
+)
+
+// staffLetters in order from C, the index of each its step above C in the same octave
+const staffLetters = "CDEFGAB"
+
+// Widths of the text of the staff, of the clef, each accidental of the key signature, each column of notes at least, and each bar line
+const (
+	staffClefWidth      = 6
+	staffSignatureWidth = 2
+	staffNoteWidth      = 5
+	staffBarWidth       = 2
+)
+
+// staffClef of a treble clef, a row of text at each step from G5 above the staff down to E4, curling around the line of G4
+var staffClef = []string{
+	"  /\\",
+	"  |/",
+	"  |",
+	" /|",
+	"/ |\\",
+	"| | )",
+	" \\|/",
+	"  |",
+	"\\_/",
+}
+
+// staffSignatureSteps of the letters of the sharps, or flats, of a key signature, on the lines and spaces they're written on the treble staff
+var staffSignatureSteps = map[int]map[string]int{
+	1:  {"F": 38, "C": 35, "G": 39, "D": 36, "A": 33, "E": 37, "B": 34},
+	-1: {"B": 34, "E": 37, "A": 33, "D": 36, "G": 32, "C": 35, "F": 31},
+}
+
+// staffNote on the staff, by its step, counted from C0, and the accidental written before it, if any
+type staffNote struct {
+	Step       int
+	Accidental string
+}
+
+// staffColumn of the staff, either of notes struck together and a label written below them, or a bar line
+type staffColumn struct {
+	Notes []staffNote
+	Label string
+	Bar   bool
+}
+
+func renderStaff(w io.Writer, v interface{}, o Options) error {
+	k := key.Of("C")
+	var columns []staffColumn
+	switch t := v.(type) {
+	case chord.Chord:
+		columns = staffChord(chordVoicing(t), t.Name(), nil)
+	case scale.Scale:
+		columns = staffMelody(scaleVoicing(t), nil)
+	case key.Key:
+		k = t
+		columns = staffMelody(scaleVoicing(keyScale(t)), abcSignatureOf(t))
+	case progression.Progression:
+		if len(t.Chords) > 0 {
+			k = t.Key()
+		}
+		for _, c := range t.Chords {
+			columns = append(columns, staffChord(chordVoicing(c), c.Name(), abcSignatureOf(k))...)
+		}
+	case chord.Arpeggio:
+		columns = staffMelody(arpeggioVoicing(t), nil)
+	default:
+		return unsupported(Staff, v)
+	}
+	_, err := io.WriteString(w, staffOf(k, columns))
+	return err
+}
+
+// staffChord of the simultaneous tones of a voicing in a column labeled by the name of the chord, and then a bar line
+func staffChord(v voicing, name string, signature map[string]int) []staffColumn {
+	bar := make(map[int]int)
+	column := staffColumn{Label: name}
+	for _, t := range v.Tones {
+		column.Notes = append(column.Notes, staffNoteOf(t, v.AdjSymbol, signature, bar))
+	}
+	return []staffColumn{column, {Bar: true}}
+}
+
+// staffMelody of the successive tones of a voicing, a column each labeled by its note name, and then a bar line
+func staffMelody(v voicing, signature map[string]int) []staffColumn {
+	bar := make(map[int]int)
+	var columns []staffColumn
+	for _, t := range v.Tones {
+		columns = append(columns, staffColumn{Notes: []staffNote{staffNoteOf(t, v.AdjSymbol, signature, bar)}, Label: t.Class.String(v.AdjSymbol)})
+	}
+	return append(columns, staffColumn{Bar: true})
+}
+
+// staffNoteOf a tone, on the step of its letter, with an accidental only if its alteration differs from the key signature
+// or an accidental earlier in the bar on the same step, which is remembered, e.g. # or b, or n for a natural
+func staffNoteOf(t tone, adjSymbol note.AdjSymbol, signature map[string]int, bar map[int]int) staffNote {
+	letter, alter := spellingOf(t.Class, adjSymbol)
+	n := staffNote{Step: strings.Index(staffLetters, letter) + 7*int(t.Octave)}
+	current, ok := bar[n.Step]
+	if !ok {
+		current = signature[letter]
+	}
+	bar[n.Step] = alter
+	if alter != current {
+		n.Accidental = staffAccidentalOf(alter)
+	}
+	return n
+}
+
+// staffAccidentalOf an alteration in semitones, # of a sharp, b of a flat, or n of a natural
+func staffAccidentalOf(alter int) string {
+	switch alter {
+	case 1:
+		return "#"
+	case -1:
+		return "b"
+	}
+	return "n"
+}
+
+// staffOf the columns, after a clef and the signature of a key, as rows of text from the highest step to the lowest, and then a row of their labels,
+// the clef drawn over the lines rather than breaking them
+func staffOf(k key.Key, columns []staffColumn) string {
+	high, low := staffTop+1, staffBottom
+	for _, c := range columns {
+		for _, n := range c.Notes {
+			if n.Step > high {
+				high = n.Step
+			}
+			if n.Step < low {
+				low = n.Step
+			}
+		}
+	}
+	sign := 1
+	if k.Fifths() < 0 {
+		sign = -1
+	}
+	accidentals := k.Accidentals()
+	width := staffClefWidth + len(accidentals)*staffSignatureWidth + 1
+	for _, c := range columns {
+		width += staffColumnWidth(c)
+	}
+	rows := make([][]rune, high-low+1)
+	for i := range rows {
+		fill := ' '
+		if step := high - i; step >= staffBottom && step <= staffTop && (step-staffBottom)%2 == 0 {
+			fill = '-'
+		}
+		rows[i] = []rune(strings.Repeat(string(fill), width))
+	}
+	put := func(step, x int, text string) {
+		for i, r := range []rune(text) {
+			if r != ' ' {
+				rows[high-step][x+i] = r
+			}
+		}
+	}
+	for i, line := range staffClef {
+		put(staffTop+1-i, 1, line)
+	}
+	x := staffClefWidth
+	for _, accidental := range accidentals {
+		put(staffSignatureSteps[sign][accidental[:1]], x, accidental[1:])
+		x += staffSignatureWidth
+	}
+	x++
+	labels := []rune(strings.Repeat(" ", width))
+	for _, c := range columns {
+		if c.Bar {
+			for step := staffBottom; step <= staffTop; step++ {
+				put(step, x+staffBarWidth-1, "|")
+			}
+		} else {
+			staffDrawNotes(c.Notes, x, put)
+			copy(labels[x+1:], []rune(c.Label))
+		}
+		x += staffColumnWidth(c)
+	}
+	var text strings.Builder
+	for _, row := range append(rows, labels) {
+		text.WriteString(strings.TrimRight(string(row), " ") + "\n")
+	}
+	return text.String()
+}
+
+// staffDrawNotes of a column at x, each an o on its step with any accidental before it, and any ledger lines it needs,
+// a note a second above the one below it drawn to the right, so they don't overlap
+func staffDrawNotes(notes []staffNote, x int, put func(step, x int, text string)) {
+	for _, n := range notes {
+		for ledger := staffBottom - 2; ledger >= n.Step; ledger -= 2 {
+			put(ledger, x, strings.Repeat("-", staffNoteWidth-1))
+		}
+		for ledger := staffTop + 2; ledger <= n.Step; ledger += 2 {
+			put(ledger, x, strings.Repeat("-", staffNoteWidth-1))
+		}
+	}
+	prev, shifted := 0, false
+	for i, n := range notes {
+		if i > 0 && n.Step-prev == 1 && !shifted {
+			put(n.Step, x+3, "o")
+			shifted = true
+		} else {
+			put(n.Step, x+2, "o")
+			shifted = false
+		}
+		if len(n.Accidental) > 0 {
+			put(n.Step, x+1, n.Accidental)
+		}
+		prev = n.Step
+	}
+}
+
+// staffColumnWidth of a column, of a bar line, or of notes wide enough for its label
+func staffColumnWidth(c staffColumn) int {
+	if c.Bar {
+		return staffBarWidth
+	}
+	if len(c.Label)+2 > staffNoteWidth {
+		return len(c.Label) + 2
+	}
+	return staffNoteWidth
+}
@@ -0,0 +1,107 @@
+// Render a staff of a chord, scale, key, progression or arpeggio, drawn in plain text on five lines for a terminal, with a treble clef and the key signature
+package render
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderStaff_Chord(t *testing.T) {
+	assertStaff(t, strings.Join([]string{
+		"   /\\",
+		"---|/--------|",
+		"   |         |",
+		"--/|---------|",
+		" / |\\        |",
+		"-|-|-)--bo---|",
+		"  \\|/        |",
+		"---|-----o---|",
+		" \\_/         |",
+		"--------bo---|",
+		"",
+		"       --o-",
+		"        Cm7",
+		"",
+	}, "\n"), chord.Of("Cm7"))
+}
+
+func TestRenderStaff_Chord_Seconds(t *testing.T) {
+	out := renderStaffString(t, chord.Of("Csus4"))
+	assert.Contains(t, out, "\n---|------o----|\n")
+	assert.Contains(t, out, "\n \\_/     o     |\n")
+}
+
+func TestRenderStaff_Scale(t *testing.T) {
+	out := renderStaffString(t, scale.Of("C harmonic minor"))
+	assert.Contains(t, out, "\n  \\|/                            bo        |\n")
+	assert.Contains(t, out, "\n------------------bo-----------------------|\n")
+	assert.True(t, strings.HasSuffix(out, "\n       --o-\n        C    D    Eb   F    G    Ab   B\n"))
+}
+
+func TestRenderStaff_Key(t *testing.T) {
+	out := renderStaffString(t, key.Of("D"))
+	assert.Contains(t, out, "\n---|/-#---")
+	assert.Contains(t, out, "\n / |\\   #   ")
+	assert.Equal(t, 2, strings.Count(out, "#")-strings.Count(out, "F#")-strings.Count(out, "C#"))
+	out = renderStaffString(t, key.Of("Eb"))
+	assert.Contains(t, out, "\n-|-|-)b---")
+	assert.Contains(t, out, "\n   |    b ")
+	assert.Contains(t, out, "\n  \\|/     b ")
+	assert.Equal(t, 3, strings.Count(out, "b")-strings.Count(out, "Eb")-strings.Count(out, "Ab")-strings.Count(out, "Bb"))
+	assert.True(t, strings.HasSuffix(out, "\n              Eb   F    G    Ab   Bb   C    D\n"))
+}
+
+func TestRenderStaff_Progression(t *testing.T) {
+	out := renderStaffString(t, progression.Of("Dm7", "G7", "C"))
+	assert.True(t, strings.HasPrefix(out, "   /\\\n---|/--------|--o---|------|\n"))
+	assert.True(t, strings.HasSuffix(out, "\n                     --o-\n        Dm7    G7     C\n"))
+}
+
+func TestRenderStaff_Arpeggio(t *testing.T) {
+	out := renderStaffString(t, chord.Arpeggiate(chord.Of("C"), chord.Up, 1))
+	assert.True(t, strings.HasSuffix(out, "\n       --o-\n        C    E    G\n"))
+}
+
+func TestRenderStaff_Unsupported(t *testing.T) {
+	assert.True(t, errors.Is(renderStaff(new(bytes.Buffer), 42, Options{}), ErrUnsupported))
+}
+
+func TestStaffNoteOf(t *testing.T) {
+	bar := make(map[int]int)
+	assert.Equal(t, staffNote{Step: 31, Accidental: "#"}, staffNoteOf(tone{Class: note.Fs, Octave: 4}, note.Sharp, nil, bar))
+	assert.Equal(t, staffNote{Step: 31}, staffNoteOf(tone{Class: note.Fs, Octave: 4}, note.Sharp, nil, bar))
+	assert.Equal(t, staffNote{Step: 31, Accidental: "n"}, staffNoteOf(tone{Class: note.F, Octave: 4}, note.Sharp, nil, bar))
+	assert.Equal(t, staffNote{Step: 38}, staffNoteOf(tone{Class: note.F, Octave: 5}, note.Sharp, nil, bar))
+	assert.Equal(t, staffNote{Step: 34, Accidental: "n"}, staffNoteOf(tone{Class: note.B, Octave: 4}, note.Flat, map[string]int{"B": -1}, bar))
+	assert.Equal(t, staffNote{Step: 36, Accidental: "b"}, staffNoteOf(tone{Class: note.Cs, Octave: 5}, note.Flat, nil, bar))
+}
+
+func TestStaffColumnWidth(t *testing.T) {
+	assert.Equal(t, staffBarWidth, staffColumnWidth(staffColumn{Bar: true}))
+	assert.Equal(t, staffNoteWidth, staffColumnWidth(staffColumn{Label: "C"}))
+	assert.Equal(t, 7, staffColumnWidth(staffColumn{Label: "Cmaj7"}))
+}
+
+//
+// Private
+//
+
+func renderStaffString(t *testing.T, v interface{}) string {
+	var out bytes.Buffer
+	assert.Nil(t, renderStaff(&out, v, Options{}))
+	return out.String()
+}
+
+func assertStaff(t *testing.T, expect string, v interface{}) {
+	assert.Equal(t, expect, renderStaffString(t, v))
+}
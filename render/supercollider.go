@@ -0,0 +1,95 @@
+// Render SuperCollider code of a chord, scale, key, progression or arpeggio, as arrays of MIDI note numbers played by an event or a pattern, at the gain of any dynamics
+package render
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+// liveCodeBarBeats of every chord of Sonic Pi or SuperCollider, held for a bar of 4/4, as a chord of ABC notation or MIDI
+const liveCodeBarBeats = 4
+
+func renderSuperCollider(w io.Writer, v interface{}, o Options) error {
+	var line string
+	switch t := v.(type) {
+	case chord.Chord:
+		amp := ""
+		if gains := gainsOf(o.Dynamics, 1); len(gains) > 0 {
+			amp = ", amp: " + gains[0]
+		}
+		line = fmt.Sprintf("(midinote: %s, dur: %d%s).play;", superColliderNotes(playedVoicing(t)), liveCodeBarBeats, amp)
+	case scale.Scale:
+		line = superColliderMelody(scaleVoicing(t), o.Dynamics)
+	case key.Key:
+		line = superColliderMelody(scaleVoicing(keyScale(t)), o.Dynamics)
+	case progression.Progression:
+		var chords, names []string
+		for _, c := range t.Chords {
+			chords = append(chords, superColliderNotes(playedVoicing(c)))
+			names = append(names, c.Name())
+		}
+		line = fmt.Sprintf("Pbind(\\midinote, Pseq([%s]), \\dur, %d%s).play; // %s", strings.Join(chords, ", "), liveCodeBarBeats, superColliderAmp(o.Dynamics, len(chords)), strings.Join(names, " "))
+	case chord.Arpeggio:
+		line = superColliderMelody(arpeggioVoicing(t), o.Dynamics)
+	default:
+		return unsupported(SuperCollider, v)
+	}
+	_, err := fmt.Fprintf(w, "TempoClock.default.tempo = %d / 60;\n%s\n", midi.Tempo, line)
+	return err
+}
+
+// superColliderMelody of successive tones, a beat each, at any dynamics, e.g. Pbind(\midinote, Pseq([60, 62, 64]), \dur, 1).play;
+func superColliderMelody(v voicing, p dynamics.Phrase) string {
+	return fmt.Sprintf("Pbind(\\midinote, Pseq(%s), \\dur, 1%s).play;", superColliderNotes(v), superColliderAmp(p, len(v.Tones)))
+}
+
+// superColliderAmp of a pattern of some events at any dynamics, all at one gain, e.g. , \amp, 0.4, or each at its own, e.g. , \amp, Pseq([0.2, 0.4]), or empty of none
+func superColliderAmp(p dynamics.Phrase, count int) string {
+	gains := gainsOf(p, count)
+	switch {
+	case len(gains) == 0:
+		return ""
+	case p.From == p.To:
+		return ", \\amp, " + gains[0]
+	}
+	return ", \\amp, Pseq([" + strings.Join(gains, ", ") + "])"
+}
+
+// gainsOf some notes or chords in a phrase of dynamics, each by its position from the first to the last, e.g. "0.4", or none if the phrase is Nil
+func gainsOf(p dynamics.Phrase, count int) []string {
+	if p.IsNil() {
+		return nil
+	}
+	gains := make([]string, count)
+	for n := range gains {
+		position := 0.0
+		if count > 1 {
+			position = float64(n) / float64(count-1)
+		}
+		gains[n] = strconv.FormatFloat(math.Round(p.GainAt(position)*100)/100, 'f', -1, 64)
+	}
+	return gains
+}
+
+// superColliderNotes of the tones of a voicing, in an array of MIDI note numbers, e.g. [60, 63, 67]
+func superColliderNotes(v voicing) string {
+	var numbers []string
+	for _, t := range v.Tones {
+		numbers = append(numbers, strconv.Itoa(midi.NumberOf(t.Class, t.Octave)))
+	}
+	return "[" + strings.Join(numbers, ", ") + "]"
+}
@@ -0,0 +1,61 @@
+// Render SuperCollider code of a chord, scale, key, progression or arpeggio, as arrays of MIDI note numbers played by an event or a pattern, at the gain of any dynamics
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/dynamics"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderSuperCollider_Chord(t *testing.T) {
+	assertSuperCollider(t, "TempoClock.default.tempo = 120 / 60;\n(midinote: [60, 63, 67, 70], dur: 4).play;\n", chord.Of("Cm7"))
+	assertSuperCollider(t, "TempoClock.default.tempo = 120 / 60;\n(midinote: [60, 64, 67, 71, 74, 81], dur: 4).play;\n", chord.Of("Cmaj13"))
+}
+
+func TestRenderSuperCollider_Scale(t *testing.T) {
+	assertSuperCollider(t, "TempoClock.default.tempo = 120 / 60;\nPbind(\\midinote, Pseq([62, 64, 65, 67, 69, 71, 72]), \\dur, 1).play;\n", scale.Of("D dorian"))
+}
+
+func TestRenderSuperCollider_Key(t *testing.T) {
+	assertSuperCollider(t, "TempoClock.default.tempo = 120 / 60;\nPbind(\\midinote, Pseq([69, 71, 72, 74, 76, 77, 79]), \\dur, 1).play;\n", key.Of("A minor"))
+}
+
+func TestRenderSuperCollider_Progression(t *testing.T) {
+	assertSuperCollider(t, "TempoClock.default.tempo = 120 / 60;\n"+
+		"Pbind(\\midinote, Pseq([[62, 65, 69, 72], [67, 71, 74, 77], [60, 64, 67]]), \\dur, 4).play; // Dm7 G7 C\n", progression.Of("Dm7", "G7", "C"))
+}
+
+func TestRenderSuperCollider_Dynamics(t *testing.T) {
+	assertSuperColliderWith(t, "TempoClock.default.tempo = 120 / 60;\n(midinote: [60, 64, 67], dur: 4, amp: 0.4).play;\n", chord.Of("C"), dynamics.At(dynamics.MF))
+	assertSuperColliderWith(t, "TempoClock.default.tempo = 120 / 60;\nPbind(\\midinote, Pseq([60, 62, 64, 65, 67, 69, 71]), \\dur, 1, \\amp, 0.07).play;\n", scale.Of("C major"), dynamics.At(dynamics.PP))
+	assertSuperColliderWith(t, "TempoClock.default.tempo = 120 / 60;\nPbind(\\midinote, Pseq([[60, 64, 67], [67, 71, 74]]), \\dur, 4, \\amp, Pseq([0.15, 0.57])).play; // C G\n", progression.Of("C", "G"), dynamics.Phrase{From: dynamics.P, To: dynamics.F})
+}
+
+func TestRenderSuperCollider_Arpeggio(t *testing.T) {
+	assertSuperCollider(t, "TempoClock.default.tempo = 120 / 60;\nPbind(\\midinote, Pseq([60, 64, 67, 64]), \\dur, 1).play;\n", chord.Arpeggiate(chord.Of("C"), chord.UpDown, 1))
+}
+
+func TestRenderSuperCollider_Unsupported(t *testing.T) {
+	assert.NotNil(t, renderSuperCollider(&bytes.Buffer{}, 42, Options{}))
+}
+
+//
+// Private
+//
+
+func assertSuperCollider(t *testing.T, expect string, v interface{}) {
+	assertSuperColliderWith(t, expect, v, dynamics.Phrase{})
+}
+
+func assertSuperColliderWith(t *testing.T, expect string, v interface{}, p dynamics.Phrase) {
+	var out bytes.Buffer
+	assert.Nil(t, renderSuperCollider(&out, v, Options{Dynamics: p}))
+	assert.Equal(t, expect, out.String())
+}
@@ -0,0 +1,110 @@
+// Render SVG of a chord, scale, key, progression or arpeggio, as its tones pressed on a piano keyboard, one keyboard per chord of a progression
+package render
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+//
+// Private
+//
+
+// Dimensions and colors of each keyboard
+const (
+	svgWhiteWidth  = 24
+	svgWhiteHeight = 96
+	svgBlackWidth  = 14
+	svgBlackHeight = 60
+	svgGap         = 16
+	svgRootFill    = "#d9534f"
+	svgToneFill    = "#5b9bd5"
+)
+
+// svgWhiteIndex of each natural pitch class, left to right within an octave
+var svgWhiteIndex = map[note.Class]int{note.C: 0, note.D: 1, note.E: 2, note.F: 3, note.G: 4, note.A: 5, note.B: 6}
+
+// svgBlackAfter each accidental pitch class, the natural pitch class of the white key to its left
+var svgBlackAfter = map[note.Class]note.Class{note.Cs: note.C, note.Ds: note.D, note.Fs: note.F, note.Gs: note.G, note.As: note.A}
+
+func renderSVG(w io.Writer, v interface{}, o Options) error {
+	voicings, ok := voicingsOf(v)
+	if !ok {
+		return unsupported(SVG, v)
+	}
+	low, high := rootOctave, rootOctave
+	for _, vc := range voicings {
+		for _, t := range vc.Tones {
+			if t.Octave < low {
+				low = t.Octave
+			}
+			if t.Octave > high {
+				high = t.Octave
+			}
+		}
+	}
+	octaves := int(high-low) + 1
+	width := octaves * 7 * svgWhiteWidth
+	height := len(voicings)*(svgWhiteHeight+svgGap) - svgGap
+	if height < svgWhiteHeight {
+		height = svgWhiteHeight
+	}
+	fmt.Fprintf(w, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", width, height, width, height)
+	for n, vc := range voicings {
+		writeSVGKeyboard(w, vc, low, octaves, n*(svgWhiteHeight+svgGap))
+	}
+	_, err := io.WriteString(w, "</svg>\n")
+	return err
+}
+
+// writeSVGKeyboard of some octaves from the lowest, at a vertical offset, with the tones of a voicing pressed
+func writeSVGKeyboard(w io.Writer, v voicing, low note.Octave, octaves int, y int) {
+	pressed := make(map[int]note.Class)
+	for _, t := range v.Tones {
+		pressed[svgKeyOf(t.Class, t.Octave)] = t.Class
+	}
+	fmt.Fprintf(w, "  <g transform=\"translate(0,%d)\">\n", y)
+	for o := 0; o < octaves; o++ {
+		octave := low + note.Octave(o)
+		for class := note.C; class <= note.B; class++ {
+			i, ok := svgWhiteIndex[class]
+			if !ok {
+				continue
+			}
+			x := (o*7 + i) * svgWhiteWidth
+			writeSVGKey(w, v, pressed, class, octave, x, svgWhiteWidth, svgWhiteHeight, "#ffffff")
+		}
+		for class := note.C; class <= note.B; class++ {
+			left, ok := svgBlackAfter[class]
+			if !ok {
+				continue
+			}
+			x := (o*7+svgWhiteIndex[left]+1)*svgWhiteWidth - svgBlackWidth/2
+			writeSVGKey(w, v, pressed, class, octave, x, svgBlackWidth, svgBlackHeight, "#000000")
+		}
+	}
+	fmt.Fprint(w, "  </g>\n")
+}
+
+// writeSVGKey as a rectangle, filled if pressed, and then labeled with its note name
+func writeSVGKey(w io.Writer, v voicing, pressed map[int]note.Class, class note.Class, octave note.Octave, x, width, height int, fill string) {
+	_, isPressed := pressed[svgKeyOf(class, octave)]
+	if isPressed {
+		fill = svgToneFill
+		if class == v.Root {
+			fill = svgRootFill
+		}
+	}
+	fmt.Fprintf(w, "    <rect x=\"%d\" y=\"0\" width=\"%d\" height=\"%d\" fill=\"%s\" stroke=\"#000000\"/>\n", x, width, height, fill)
+	if isPressed {
+		fmt.Fprintf(w, "    <text x=\"%d\" y=\"%d\" font-family=\"sans-serif\" font-size=\"9\" text-anchor=\"middle\" fill=\"#ffffff\">%s</text>\n",
+			x+width/2, height-6, class.String(v.AdjSymbol))
+	}
+}
+
+// svgKeyOf a pitch class in an octave, its number of semitones from C0
+func svgKeyOf(class note.Class, octave note.Octave) int {
+	return int(octave)*12 + int(class) - int(note.C)
+}
@@ -0,0 +1,49 @@
+// Render SVG of a chord, scale, key or progression, as its tones pressed on a piano keyboard, one keyboard per chord of a progression
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestRenderSVG_Chord(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderSVG(&out, chord.Of("Cm"), Options{}))
+	svg := out.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="168" height="96" viewBox="0 0 168 96">`))
+	assert.Equal(t, 1, strings.Count(svg, svgRootFill))
+	assert.Equal(t, 2, strings.Count(svg, svgToneFill))
+	assert.Equal(t, 12, strings.Count(svg, "<rect"))
+	assert.Contains(t, svg, ">Eb</text>")
+	assert.Nil(t, xml.Unmarshal(out.Bytes(), new(interface{})))
+}
+
+func TestRenderSVG_Progression(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderSVG(&out, progression.Of("Dm7", "G7", "C"), Options{}))
+	svg := out.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="336" height="320" viewBox="0 0 336 320">`))
+	assert.Equal(t, 3, strings.Count(svg, "<g "))
+	assert.Contains(t, svg, `<g transform="translate(0,224)">`)
+}
+
+func TestRenderSVG_Arpeggio(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderSVG(&out, chord.Arpeggiate(chord.Of("C"), chord.Up, 2), Options{}))
+	svg := out.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="336" height="96" viewBox="0 0 336 96">`))
+	assert.Equal(t, 2, strings.Count(svg, svgRootFill))
+	assert.Equal(t, 4, strings.Count(svg, svgToneFill))
+}
+
+func TestSVGKeyOf(t *testing.T) {
+	assert.Equal(t, 48, svgKeyOf(1, 4))
+	assert.Equal(t, 59, svgKeyOf(12, 4))
+}
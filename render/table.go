@@ -0,0 +1,213 @@
+// Render a text table of a chord, scale, key, progression, arpeggio, chorale, soloing guide or frequency table, with its columns aligned, and optionally in color, e.g. for a terminal
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/guide"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/satb"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+// standardTuning of A4 in Hz, i.e. concert pitch
+const standardTuning = 440
+
+func renderTable(w io.Writer, v interface{}, o Options) error {
+	var buf bytes.Buffer
+	var colors []string // of each line after the header, if in color
+	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	switch t := v.(type) {
+	case chord.Chord:
+		vc := chordVoicing(t)
+		writeTonesTable(tw, vc)
+		for _, tn := range vc.Tones {
+			colors = append(colors, colorChordTone(tn.Interval))
+		}
+	case scale.Scale:
+		vc := scaleVoicing(t)
+		writeTonesTable(tw, vc)
+		for _, tn := range vc.Tones {
+			colors = append(colors, colorScaleDegree(tn.Interval))
+		}
+		f := t.Formula()
+		fmt.Fprintf(tw, "\nSTEPS\t%s\nFORMULA\t%s\n", f.Steps, f.Intervals)
+	case key.Key:
+		fmt.Fprintln(tw, "ROOT\tMODE\tRELATIVE\tPARALLEL")
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", t.Root.String(t.AdjSymbol), t.Mode, relativeOf(t), parallelOf(t))
+		colors = append(colors, colorTonic)
+		if related := t.CloselyRelated(); len(related) > 0 {
+			fmt.Fprintln(tw, "\nRELATED\tDISTANCE")
+			for _, rk := range related {
+				fmt.Fprintf(tw, "%s %s\t%s\n", rk.Root.String(rk.AdjSymbol), rk.Mode, distanceOf(t.Distance(rk)))
+			}
+		}
+		if o.Full {
+			writeKeyDetails(tw, t)
+		}
+	case progression.Progression:
+		k := t.Key()
+		fmt.Fprintf(tw, "KEY\t%s %s\n\n", k.Root.String(k.AdjSymbol), k.Mode)
+		fmt.Fprintln(tw, "CHORD\tROOT\tTONES")
+		for n, c := range t.Chords {
+			var names []string
+			for _, tn := range chordVoicing(c).Tones {
+				name := tn.Class.String(c.AdjSymbol)
+				if o.Color {
+					name = colored(name, colorChordTone(tn.Interval)) // in the last column, so it doesn't throw off the alignment
+				}
+				names = append(names, name)
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\n", n+1, c.Root.String(c.AdjSymbol), strings.Join(names, " "))
+		}
+	case satb.Chorale:
+		voices := choraleVoicings(t)
+		fmt.Fprintf(tw, "KEY\t%s %s\n\n", t.Key.Root.String(t.Key.AdjSymbol), t.Key.Mode)
+		fmt.Fprintln(tw, "CHORD\tROOT\tSOPRANO\tALTO\tTENOR\tBASS")
+		for n, v := range t.Voicings {
+			names := []string{strconv.Itoa(n + 1), v.Chord.Root.String(t.AdjSymbol())}
+			for _, vc := range voices {
+				names = append(names, noteNameOf(vc.Tones[n], vc.AdjSymbol))
+			}
+			fmt.Fprintln(tw, strings.Join(names, "\t"))
+		}
+		if len(t.Violations) > 0 {
+			fmt.Fprintln(tw)
+			for _, v := range t.Violations {
+				fmt.Fprintf(tw, "VIOLATION\t%s\n", v)
+			}
+		}
+	case guide.Guide:
+		adj := t.AdjSymbol()
+		fmt.Fprintf(tw, "KEY\t%s %s\n\n", t.Key.Root.String(t.Key.AdjSymbol), t.Key.Mode)
+		fmt.Fprintln(tw, "BEAT\tCHORD\tTARGETS\tDEGREES\tBELOW\tABOVE\tSCALES")
+		for _, st := range t.Steps {
+			var targets, degrees, below, above []string
+			for _, tg := range st.Targets {
+				targets, degrees = append(targets, tg.Class.String(adj)), append(degrees, tg.Degree.String())
+				below, above = append(below, tg.Below.String(adj)), append(above, tg.Above.String(adj))
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", strconv.FormatFloat(st.Beat+1, 'f', -1, 64), st.Chord.Name(),
+				strings.Join(targets, " "), strings.Join(degrees, " "), strings.Join(below, " "), strings.Join(above, " "), strings.Join(st.Scales, ", "))
+		}
+	case chord.Arpeggio:
+		vc := arpeggioVoicing(t)
+		fmt.Fprintln(tw, "STEP\tNOTE\tTONE\tINTERVAL\tFREQUENCY")
+		for n, tn := range vc.Tones {
+			fmt.Fprintf(tw, "%d\t%s%d\t%d\t%s\t%s\n", n+1, tn.Class.String(vc.AdjSymbol), tn.Octave, tn.Interval, intervalNameOf(tn), frequencyOf(tn))
+			colors = append(colors, colorChordTone(tn.Interval))
+		}
+	case pitch.Table:
+		fmt.Fprintln(tw, "NOTE\tMIDI\tFREQUENCY")
+		for _, r := range t.Rows {
+			fmt.Fprintf(tw, "%s\t%d\t%s\n", r.String(t.AdjSymbol), r.MIDI, pitch.Format(r.Frequency, pitch.DefaultPrecision))
+		}
+	default:
+		return unsupported(Table, v)
+	}
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+	out := buf.String()
+	if o.Color {
+		out = coloredLines(out, 1, colors)
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// writeTonesTable of a voicing, each tone by its number, note name, interval from the root, and frequency at standard concert pitch
+func writeTonesTable(w io.Writer, v voicing) {
+	fmt.Fprintln(w, "TONE\tNOTE\tINTERVAL\tFREQUENCY")
+	for _, t := range v.Tones {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", t.Interval, t.Class.String(v.AdjSymbol), intervalNameOf(t), frequencyOf(t))
+	}
+}
+
+// writeKeyDetails of a key, its signature, the tones of its scale, and the diatonic triad and seventh chord on each degree
+func writeKeyDetails(w io.Writer, k key.Key) {
+	signature := strings.Join(k.Accidentals(), " ")
+	if len(signature) == 0 {
+		signature = "-"
+	}
+	fmt.Fprintf(w, "\nSIGNATURE\t%s\n\n", signature)
+	writeTonesTable(w, scaleVoicing(k.Scale()))
+	fmt.Fprintln(w, "\nDEGREE\tTRIAD\tSEVENTH")
+	chords := k.Chords()
+	for n := 0; n+1 < len(chords); n += 2 {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", chords[n].Degree, chords[n], chords[n+1])
+	}
+}
+
+// noteNameOf a tone, e.g. C4, or "-" for the Nil class
+func noteNameOf(t tone, adjSymbol note.AdjSymbol) string {
+	if t.Class == note.Nil {
+		return "-"
+	}
+	return t.Class.String(adjSymbol) + strconv.Itoa(int(t.Octave))
+}
+
+// intervalNameOf a tone, or "-" if its interval is unnamed, e.g. for a chord that wasn't parsed from a name
+func intervalNameOf(t tone) string {
+	if t.IntervalName == "" {
+		return "-"
+	}
+	return t.IntervalName
+}
+
+// frequencyOf a tone in Hz, e.g. 261.63Hz for middle C, or "-" for the Nil class
+func frequencyOf(t tone) string {
+	if t.Class == note.Nil {
+		return "-"
+	}
+	hz, err := pitch.OfClassAndOctave(t.Class.String(note.Sharp), strconv.Itoa(int(t.Octave)), standardTuning)
+	if err != nil {
+		return "-"
+	}
+	return hz
+}
+
+// relativeOf a key, its relative minor or major, spelled like the key, or empty if the key has no mode
+func relativeOf(k key.Key) string {
+	var rel key.Key
+	switch k.Mode {
+	case key.Major:
+		rel = k.RelativeMinor()
+	case key.Minor:
+		rel = k.RelativeMajor()
+	default:
+		return ""
+	}
+	return rel.Root.String(k.AdjSymbol) + " " + rel.Mode.String()
+}
+
+// distanceOf a key in fifths, signed unless it's zero, e.g. +1 of the dominant key
+func distanceOf(fifths int) string {
+	if fifths == 0 {
+		return "0"
+	}
+	return fmt.Sprintf("%+d", fifths)
+}
+
+// parallelOf a key, its parallel minor or major, or empty if the key has no mode
+func parallelOf(k key.Key) string {
+	pk := k.Parallel()
+	if pk.Mode == k.Mode {
+		return ""
+	}
+	return pk.Root.String(pk.AdjSymbol) + " " + pk.Mode.String()
+}
@@ -0,0 +1,115 @@
+// Render a text table of a chord, scale, key or progression, with its columns aligned, e.g. for a terminal
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/guide"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/satb"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestRenderTable_Chord(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, chord.Of("Cm7"), Options{}))
+	assert.Equal(t, "TONE  NOTE  INTERVAL  FREQUENCY\n"+
+		"1     C     P1        261.63Hz\n"+
+		"3     Eb    m3        311.13Hz\n"+
+		"5     G     P5        392.00Hz\n"+
+		"7     Bb    m7        466.16Hz\n", out.String())
+}
+
+func TestRenderTable_Scale(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, scale.Of("A harmonic minor"), Options{}))
+	assert.Equal(t, "TONE  NOTE  INTERVAL  FREQUENCY\n"+
+		"1     A     P1        440.00Hz\n"+
+		"2     B     M2        493.88Hz\n"+
+		"3     C     m3        523.25Hz\n"+
+		"4     D     P4        587.33Hz\n"+
+		"5     E     P5        659.26Hz\n"+
+		"6     F     m6        698.46Hz\n"+
+		"7     Ab    M7        830.61Hz\n"+
+		"\n"+
+		"STEPS    W H W W H WH H\n"+
+		"FORMULA  1 2 b3 4 5 b6 7\n", out.String())
+}
+
+func TestRenderTable_Key(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, key.Of("Bb"), Options{}))
+	assert.Equal(t, "ROOT  MODE   RELATIVE  PARALLEL\n"+
+		"Bb    Major  G Minor   Bb Minor\n"+
+		"\n"+
+		"RELATED   DISTANCE\n"+
+		"G Minor   0\n"+
+		"F Major   +1\n"+
+		"D Minor   +1\n"+
+		"Eb Major  -1\n"+
+		"C Minor   -1\n", out.String())
+}
+
+func TestRenderTable_Key_Full(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, key.Of("D"), Options{Full: true}))
+	assert.Contains(t, out.String(), "\nSIGNATURE  F# C#\n\n"+
+		"TONE  NOTE  INTERVAL  FREQUENCY\n"+
+		"1     D     P1        293.66Hz\n")
+	assert.Contains(t, out.String(), "\nDEGREE  TRIAD         SEVENTH\n"+
+		"1       D (I)         Dmaj7 (Imaj7)\n")
+	assert.Contains(t, out.String(), "7       C#dim (vii°)  C#m7b5 (viiø7)\n")
+	out.Reset()
+	assert.Nil(t, renderTable(&out, key.Of("C"), Options{Full: true}))
+	assert.Contains(t, out.String(), "\nSIGNATURE  -\n")
+}
+
+func TestRenderTable_Progression(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, progression.Of("Dm7", "G7", "C"), Options{}))
+	assert.Equal(t, "KEY  C Major\n\nCHORD  ROOT  TONES\n1      D     D F A C\n2      G     G B D F\n3      C     C E G\n", out.String())
+}
+
+func TestRenderTable_Arpeggio(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, chord.Arpeggiate(chord.Of("Cmaj7"), chord.UpDown, 1), Options{}))
+	assert.Equal(t, "STEP  NOTE  TONE  INTERVAL  FREQUENCY\n1     C4    1     P1        261.63Hz\n2     E4    3     M3        329.63Hz\n3     G4    5     P5        392.00Hz\n4     B4    7     M7        493.88Hz\n5     G4    5     P5        392.00Hz\n6     E4    3     M3        329.63Hz\n", out.String())
+}
+
+func TestRenderTable_Chorale(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, satb.Realize(progression.Of("Dm7", "G7", "C"), key.Key{}), Options{}))
+	assert.Equal(t, "KEY  C Major\n\nCHORD  ROOT  SOPRANO  ALTO  TENOR  BASS\n1      D     A4       F4    C4     D3\n2      G     G4       F4    B3     G3\n3      C     G4       E4    C4     C4\n", out.String())
+	out.Reset()
+	assert.Nil(t, renderTable(&out, satb.Realize(progression.Of("C13"), key.Of("C")), Options{}))
+	assert.True(t, strings.HasSuffix(out.String(), "\n\nVIOLATION  incomplete at chord 1\n"))
+}
+
+func TestRenderTable_Guide(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, guide.Of(progression.Of("Dm7", "G7"), key.Key{}), Options{}))
+	assert.Equal(t, "KEY  C Major\n\n"+
+		"BEAT  CHORD  TARGETS  DEGREES  BELOW  ABOVE  SCALES\n"+
+		"1     Dm7    F C      b3 b7    E B    G D    D dorian, D minor, D phrygian\n"+
+		"5     G7     B F      3 b7     A# E   C G    G mixolydian\n", out.String())
+}
+
+func TestRenderTable_PitchTable(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, renderTable(&out, pitch.TableFor(chord.Of("Cm"), pitch.RangeOptions{From: 3, To: 4, AdjSymbol: note.Flat}), Options{}))
+	assert.Equal(t, "NOTE  MIDI  FREQUENCY\n"+
+		"C3    48    130.81Hz\n"+
+		"Eb3   51    155.56Hz\n"+
+		"G3    55    196.00Hz\n"+
+		"C4    60    261.63Hz\n"+
+		"Eb4   63    311.13Hz\n"+
+		"G4    67    392.00Hz\n", out.String())
+}
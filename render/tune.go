@@ -0,0 +1,141 @@
+// Tunes are written in measures of their meter, each note cut short where the next begins, in plain or dotted lengths tied across any bar line, with rests between,
+// for formats of sheet music, e.g. LilyPond
+package render
+
+import (
+	"math"
+
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+//
+// Private
+//
+
+// tuneLength of a written note or rest, in ticks, by the denominator of its type, e.g. 4 of a quarter, and whether it's dotted
+type tuneLength struct {
+	Ticks  int
+	Type   int
+	Dotted bool
+}
+
+// tuneLengths that can be written, longest first, down to a thirty-second note
+var tuneLengths = []tuneLength{
+	{midi.Whole, 1, false},
+	{midi.Half * 3 / 2, 2, true},
+	{midi.Half, 2, false},
+	{midi.Quarter * 3 / 2, 4, true},
+	{midi.Quarter, 4, false},
+	{midi.Eighth * 3 / 2, 8, true},
+	{midi.Eighth, 8, false},
+	{midi.Quarter * 3 / 8, 16, true},
+	{midi.Quarter / 4, 16, false},
+	{midi.Quarter / 8, 32, false},
+}
+
+// tuneEvent of a measure, a written length of a note of the tune, or of a rest, the first of the note, or tied to the next of it
+type tuneEvent struct {
+	Note   int // of the tune, or -1 of a rest
+	Length tuneLength
+	First  bool
+	Tied   bool
+}
+
+// tuneMeasures of a tune, the events of each measure of its meter, or of 4/4 if it has none, the last filled with rests
+func tuneMeasures(t melody.Tune) [][]tuneEvent {
+	beat, bar := t.Meter.TicksPerBeat(), t.Meter.TicksPerBar()
+	if beat == 0 || bar == 0 {
+		beat, bar = midi.Quarter, midi.Whole
+	}
+	var measures [][]tuneEvent
+	write := func(n, start, end int) {
+		for at := start; at < end; {
+			m := at / bar
+			for len(measures) <= m {
+				measures = append(measures, nil)
+			}
+			until := (m + 1) * bar
+			if end < until {
+				until = end
+			}
+			for _, length := range tuneLengthsOf(until - at) {
+				measures[m] = append(measures[m], tuneEvent{Note: n, Length: length, First: n >= 0 && at == start, Tied: n >= 0})
+				at += length.Ticks
+			}
+			at = until
+		}
+		if n >= 0 && len(measures) > 0 {
+			last := &measures[len(measures)-1][len(measures[len(measures)-1])-1]
+			last.Tied = false
+		}
+	}
+	at := 0
+	for n, nt := range t.Notes {
+		start, end := ticksOf(nt.Beat, beat), ticksOf(nt.Beat+nt.Beats, beat)
+		if n+1 < len(t.Notes) {
+			if next := ticksOf(t.Notes[n+1].Beat, beat); next < end {
+				end = next
+			}
+		}
+		if start < at {
+			start = at
+		}
+		if len(tuneLengthsOf(end-start)) == 0 {
+			continue
+		}
+		if start > at {
+			write(-1, at, start)
+		}
+		write(n, start, end)
+		at = end
+	}
+	if at%bar > 0 {
+		write(-1, at, (at/bar+1)*bar)
+	}
+	return measures
+}
+
+// tuneLengthsOf some ticks, the fewest lengths that can be written adding up to them, longest first, ignoring less than a thirty-second note
+func tuneLengthsOf(ticks int) []tuneLength {
+	var lengths []tuneLength
+	for _, length := range tuneLengths {
+		for ticks >= length.Ticks {
+			lengths = append(lengths, length)
+			ticks -= length.Ticks
+		}
+	}
+	return lengths
+}
+
+// ticksOf a beat of a tune, in ticks from its beginning, of some ticks per beat
+func ticksOf(beat float64, ticks int) int {
+	return int(math.Round(beat * float64(ticks)))
+}
+
+// tuneTone of a note of a tune, the pitch class and octave a format writes
+func tuneTone(nt melody.Note) tone {
+	return tone{Class: nt.Class, Octave: nt.Octave}
+}
+
+// tuneInBass whether most of the notes of a tune are below middle C, so it's written in the bass clef
+func tuneInBass(t melody.Tune) bool {
+	below := 0
+	for _, nt := range t.Notes {
+		if nt.Step() < midi.NumberOf(note.C, 4) {
+			below++
+		}
+	}
+	return below*2 > len(t.Notes)
+}
+
+// slurBegins at a note of a tune, legato after one that isn't, and slurred into another
+func slurBegins(notes []melody.Note, n int) bool {
+	return notes[n].Articulation.Has(melody.Legato) && n+1 < len(notes) && (n == 0 || !notes[n-1].Articulation.Has(melody.Legato))
+}
+
+// slurEnds at a note of a tune, after a legato one, if it isn't legato itself or it's the last
+func slurEnds(notes []melody.Note, n int) bool {
+	return n > 0 && notes[n-1].Articulation.Has(melody.Legato) && (!notes[n].Articulation.Has(melody.Legato) || n+1 == len(notes))
+}
@@ -0,0 +1,93 @@
+// Tunes are written in measures of their meter, each note cut short where the next begins, in plain or dotted lengths tied across any bar line, with rests between,
+// for formats of sheet music, e.g. LilyPond
+package render
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestTuneMeasures(t *testing.T) {
+	measures := tuneMeasures(testTune(t))
+	assert.Equal(t, 3, len(measures))
+	assert.Equal(t, []tuneEvent{
+		{Note: 0, Length: tuneLength{midi.Quarter, 4, false}, First: true},
+		{Note: 1, Length: tuneLength{midi.Quarter, 4, false}, First: true},
+		{Note: 2, Length: tuneLength{midi.Quarter, 4, false}, First: true, Tied: true},
+	}, measures[0])
+	assert.Equal(t, []tuneEvent{
+		{Note: 2, Length: tuneLength{midi.Eighth, 8, false}},
+		{Note: 3, Length: tuneLength{midi.Eighth, 8, false}, First: true},
+		{Note: 4, Length: tuneLength{midi.Quarter, 4, false}, First: true},
+		{Note: -1, Length: tuneLength{midi.Quarter, 4, false}},
+	}, measures[1])
+	assert.Equal(t, []tuneEvent{
+		{Note: 5, Length: tuneLength{midi.Half, 2, false}, First: true},
+		{Note: -1, Length: tuneLength{midi.Quarter, 4, false}},
+	}, measures[2])
+}
+
+func TestTuneMeasures_Overlapping(t *testing.T) {
+	measures := tuneMeasures(melody.Tune{Notes: []melody.Note{
+		{Class: note.C, Octave: 4, Beat: 0, Beats: 4},
+		{Class: note.D, Octave: 4, Beat: 1.5, Beats: 2.5},
+	}})
+	assert.Equal(t, []tuneEvent{
+		{Note: 0, Length: tuneLength{midi.Quarter * 3 / 2, 4, true}, First: true},
+		{Note: 1, Length: tuneLength{midi.Half, 2, false}, First: true, Tied: true},
+		{Note: 1, Length: tuneLength{midi.Eighth, 8, false}},
+	}, measures[0], "in 4/4 of no meter")
+	assert.Equal(t, 0, len(tuneMeasures(melody.Tune{})))
+}
+
+func TestTuneLengthsOf(t *testing.T) {
+	assert.Equal(t, []tuneLength{{midi.Half * 3 / 2, 2, true}}, tuneLengthsOf(midi.Half*3/2))
+	assert.Equal(t, []tuneLength{{midi.Half, 2, false}, {midi.Quarter / 4, 16, false}}, tuneLengthsOf(midi.Half+midi.Quarter/4))
+	assert.Equal(t, []tuneLength{{midi.Whole, 1, false}, {midi.Whole, 1, false}}, tuneLengthsOf(2*midi.Whole))
+	assert.Equal(t, 0, len(tuneLengthsOf(midi.Quarter/16)))
+}
+
+func TestTuneInBass(t *testing.T) {
+	assert.False(t, tuneInBass(testTune(t)))
+	assert.True(t, tuneInBass(melody.Tune{Notes: melody.NotesOf("C2", "G2", "C4")}))
+	assert.False(t, tuneInBass(melody.Tune{}))
+}
+
+func TestSlurs(t *testing.T) {
+	notes := testTune(t).Notes
+	var begins, ends []bool
+	for n := range notes {
+		begins = append(begins, slurBegins(notes, n))
+		ends = append(ends, slurEnds(notes, n))
+	}
+	assert.Equal(t, []bool{false, false, true, false, false, false}, begins)
+	assert.Equal(t, []bool{false, false, false, false, true, false}, ends)
+	legato := []melody.Note{{Articulation: melody.Legato}, {Articulation: melody.Legato}}
+	assert.True(t, slurEnds(legato, 1), "at the last note")
+	assert.False(t, slurBegins(legato[1:], 0), "without a note to slur into")
+}
+
+//
+// Private
+//
+
+// testTune in F major in 3/4 of a staccato, an accented, two legato and a plain note, then a rest and a note both staccato and tenuto
+func testTune(t *testing.T) melody.Tune {
+	m, err := meter.Parse("3/4")
+	assert.Nil(t, err)
+	return melody.Tune{Key: key.Of("F"), Meter: m, Notes: []melody.Note{
+		{Class: note.C, Octave: 4, Beat: 0, Beats: 1, Articulation: melody.Staccato},
+		{Class: note.D, Octave: 4, Beat: 1, Beats: 1, Articulation: melody.Accent},
+		{Class: note.E, Octave: 4, Beat: 2, Beats: 1.5, Articulation: melody.Legato},
+		{Class: note.F, Octave: 4, Beat: 3.5, Beats: 0.5, Articulation: melody.Legato},
+		{Class: note.G, Octave: 4, Beat: 4, Beats: 1},
+		{Class: note.As, Octave: 4, Beat: 6, Beats: 2, Articulation: melody.Staccato | melody.Tenuto},
+	}}
+}
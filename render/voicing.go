@@ -0,0 +1,177 @@
+// Chords, scales and keys are voiced as their tones in ascending order from the root, under any bass of a slash chord or inversion, and arpeggios as their notes in order, for formats that need pitches, e.g. LilyPond
+package render
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/satb"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+//
+// Private
+//
+
+// rootOctave of every voicing
+const rootOctave = note.Octave(4)
+
+// voicing of a chord, scale or key, by its tones in ascending order from the root
+type voicing struct {
+	Root      note.Class
+	AdjSymbol note.AdjSymbol
+	Tones     []tone
+}
+
+// tone of a voicing, by its interval from the root and the name of that interval, pitch class, octave, and semitones above the root
+type tone struct {
+	Interval     int
+	IntervalName string
+	Class        note.Class
+	Octave       note.Octave
+	Semitones    int
+}
+
+// voicingsOf a value, one for a chord, scale or key, or one per chord of a progression, or false if the value can't be voiced
+func voicingsOf(v interface{}) ([]voicing, bool) {
+	switch t := v.(type) {
+	case chord.Chord:
+		return []voicing{chordVoicing(t)}, true
+	case scale.Scale:
+		return []voicing{scaleVoicing(t)}, true
+	case key.Key:
+		return []voicing{scaleVoicing(keyScale(t))}, true
+	case progression.Progression:
+		var voicings []voicing
+		for _, c := range t.Chords {
+			voicings = append(voicings, chordVoicing(c))
+		}
+		return voicings, true
+	case chord.Arpeggio:
+		return []voicing{arpeggioVoicing(t)}, true
+	}
+	return nil, false
+}
+
+func chordVoicing(c chord.Chord) voicing {
+	classes := make(map[int]note.Class)
+	names := make(map[int]string)
+	for _, t := range c.Tones {
+		classes[int(t.Degree.Interval)] = t.Class
+		names[int(t.Degree.Interval)] = t.Name
+	}
+	v := voicingOf(c.Root, c.AdjSymbol, classes, names)
+	if c.Bass != note.Nil && c.Bass != c.Root {
+		v.Tones = append([]tone{bassTone(c)}, v.Tones...)
+	}
+	return v
+}
+
+// bassTone of a slash chord or inversion, its bass in the nearest octave below the root, by the interval of the chord tone with its pitch class, if any
+func bassTone(c chord.Chord) tone {
+	rootStep := int(c.Root) + int(rootOctave)*12
+	step := int(c.Bass) + int(rootOctave)*12
+	for step >= rootStep {
+		step -= 12
+	}
+	t := tone{Class: c.Bass, Octave: note.Octave((step - 1) / 12), Semitones: step - rootStep}
+	for _, ct := range c.Tones {
+		if ct.Class == c.Bass {
+			t.Interval, t.IntervalName = int(ct.Degree.Interval), ct.Name
+			break
+		}
+	}
+	return t
+}
+
+func scaleVoicing(s scale.Scale) voicing {
+	classes := make(map[int]note.Class)
+	names := make(map[int]string)
+	for i, class := range s.Tones {
+		classes[int(i)] = class
+		names[int(i)] = s.ToneInterval[i]
+	}
+	return voicingOf(s.Root, s.AdjSymbol, classes, names)
+}
+
+// playedVoicing of a chord, by its notes as it's played, stacked within two octaves of its root in the root octave, avoiding a minor ninth, for formats that play it, e.g. MIDI
+func playedVoicing(c chord.Chord) voicing {
+	return notesVoicing(c, c.Notes(rootOctave), rootOctave)
+}
+
+// arpeggioVoicing of the notes of an arpeggio, in the order they're played rather than ascending, each by the interval of the chord tone with its pitch class
+func arpeggioVoicing(a chord.Arpeggio) voicing {
+	notes := make([]note.Note, len(a.Notes))
+	for n, played := range a.Notes {
+		notes[n] = *played
+	}
+	return notesVoicing(a.Chord, notes, chord.ArpeggioOctave)
+}
+
+// notesVoicing of a chord by some of its notes, in order, from its root in an octave, each by the interval of the chord tone with its pitch class
+func notesVoicing(c chord.Chord, notes []note.Note, octave note.Octave) voicing {
+	v := voicing{Root: c.Root, AdjSymbol: c.AdjSymbol}
+	rootStep := int(c.Root) + int(octave)*12
+	for _, n := range notes {
+		t := tone{Class: n.Class, Octave: n.Octave, Semitones: int(n.Class) + int(n.Octave)*12 - rootStep}
+		for _, ct := range c.Tones {
+			if ct.Class == n.Class {
+				t.Interval, t.IntervalName = int(ct.Degree.Interval), ct.Name
+				break
+			}
+		}
+		v.Tones = append(v.Tones, t)
+	}
+	return v
+}
+
+// choraleVoicings of each voice of a chorale, from the soprano to the bass, each sung by its notes in order, one per chord, with the Nil class for any chord that couldn't be voiced
+func choraleVoicings(c satb.Chorale) []voicing {
+	var voicings []voicing
+	for _, voice := range satb.Voices {
+		v := voicing{Root: c.Key.Root, AdjSymbol: c.AdjSymbol()}
+		for _, vc := range c.Voicings {
+			t := tone{Class: note.Nil}
+			if n := vc.Note(voice); n != nil {
+				t.Class, t.Octave = n.Class, n.Octave
+			}
+			v.Tones = append(v.Tones, t)
+		}
+		voicings = append(voicings, v)
+	}
+	return voicings
+}
+
+// keyScale of a key, its major or minor scale
+func keyScale(k key.Key) scale.Scale {
+	mode := key.Major
+	if k.Mode == key.Minor {
+		mode = key.Minor
+	}
+	return scale.Of(k.Root.String(k.AdjSymbol) + " " + strings.ToLower(mode.String()))
+}
+
+// voicingOf tones and the names of their intervals, by interval, each in the lowest octave above the tone before it, beginning from the root in the root octave
+func voicingOf(root note.Class, adjSymbol note.AdjSymbol, classes map[int]note.Class, names map[int]string) voicing {
+	v := voicing{Root: root, AdjSymbol: adjSymbol}
+	var intervals []int
+	for i := range classes {
+		intervals = append(intervals, i)
+	}
+	sort.Ints(intervals)
+	rootStep := int(root) + int(rootOctave)*12
+	prev := rootStep - 1
+	for _, i := range intervals {
+		step := int(classes[i]) + int(rootOctave)*12
+		for step <= prev {
+			step += 12
+		}
+		v.Tones = append(v.Tones, tone{Interval: i, IntervalName: names[i], Class: classes[i], Octave: note.Octave((step - 1) / 12), Semitones: step - rootStep})
+		prev = step
+	}
+	return v
+}
@@ -0,0 +1,68 @@
+// Chords, scales and keys are voiced as their tones in ascending order from the root, under any bass of a slash chord or inversion, and arpeggios as their notes in order, for formats that need pitches, e.g. LilyPond
+package render
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestVoicingsOf_Chord(t *testing.T) {
+	v, ok := voicingsOf(chord.Of("G9"))
+	assert.True(t, ok)
+	assert.Equal(t, []voicing{{Root: note.G, AdjSymbol: note.Sharp, Tones: []tone{
+		{1, "P1", note.G, 4, 0},
+		{3, "M3", note.B, 4, 4},
+		{5, "P5", note.D, 5, 7},
+		{7, "m7", note.F, 5, 10},
+		{9, "9", note.A, 5, 14},
+	}}}, v)
+}
+
+func TestVoicingsOf_SlashChord(t *testing.T) {
+	v, ok := voicingsOf(chord.Of("Am7/G"))
+	assert.True(t, ok)
+	assert.Equal(t, tone{7, "m7", note.G, 4, -2}, v[0].Tones[0])
+	assert.Equal(t, tone{1, "P1", note.A, 4, 0}, v[0].Tones[1])
+	v, _ = voicingsOf(chord.Of("C/D"))
+	assert.Equal(t, tone{0, "", note.D, 3, -10}, v[0].Tones[0])
+}
+
+func TestVoicingsOf_Key(t *testing.T) {
+	v, ok := voicingsOf(key.Of("A minor"))
+	assert.True(t, ok)
+	var names []string
+	for _, t := range v[0].Tones {
+		names = append(names, t.Class.String(v[0].AdjSymbol))
+	}
+	assert.Equal(t, []string{"A", "B", "C", "D", "E", "F", "G"}, names)
+	assert.Equal(t, note.Octave(4), v[0].Tones[0].Octave)
+	assert.Equal(t, note.Octave(5), v[0].Tones[2].Octave)
+}
+
+func TestVoicingsOf_Progression(t *testing.T) {
+	v, ok := voicingsOf(progression.Of("Dm7", "G7", "C"))
+	assert.True(t, ok)
+	assert.Equal(t, 3, len(v))
+	assert.Equal(t, note.C, v[2].Root)
+}
+
+func TestVoicingsOf_Arpeggio(t *testing.T) {
+	v, ok := voicingsOf(chord.Arpeggiate(chord.Of("Am"), chord.Down, 1))
+	assert.True(t, ok)
+	assert.Equal(t, []voicing{{Root: note.A, AdjSymbol: note.Flat, Tones: []tone{
+		{5, "P5", note.E, 5, 7},
+		{3, "m3", note.C, 5, 3},
+		{1, "P1", note.A, 4, 0},
+	}}}, v)
+}
+
+func TestVoicingsOf_Unsupported(t *testing.T) {
+	_, ok := voicingsOf("C")
+	assert.False(t, ok)
+}
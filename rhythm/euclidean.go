@@ -0,0 +1,109 @@
+// A Euclidean rhythm spreads some pulses as evenly as they can be over some steps, by the algorithm of Bjorklund, e.g. the tresillo "x..x..x." of 3 over 8,
+// and rotated to begin on another of its steps, it's the same rhythm heard from elsewhere in its cycle, e.g. "x..x.x.." of 3 over 8 rotated by 3.
+//
+// https://en.wikipedia.org/wiki/Euclidean_rhythm
+package rhythm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/groove"
+)
+
+// MaxSteps of a Euclidean rhythm, so its grid fits across a terminal
+const MaxSteps = 64
+
+// ErrStepsRange when a Euclidean rhythm has fewer than 1 step or more than MaxSteps
+var ErrStepsRange = errors.New("out of range steps")
+
+// Steps of a rhythm, each a pulse if true, or else a rest
+type Steps []bool
+
+// Euclidean rhythm of some pulses, from 0 to all, spread as evenly as they can be over some steps, from 1 to MaxSteps, beginning on the pulse of an onset,
+// and rotated to begin on a step of some rotation after it, or before it if negative, e.g. Euclidean(3, 8, 0) of "x..x..x."
+func Euclidean(pulses, steps, rotation int) (Steps, error) {
+	if steps < 1 || steps > MaxSteps {
+		return nil, fmt.Errorf("%w %d, expected 1 to %d", ErrStepsRange, steps, MaxSteps)
+	}
+	if pulses < 0 || pulses > steps {
+		return nil, fmt.Errorf("%w %d, expected 0 to %d", ErrPulsesRange, pulses, steps)
+	}
+	s := bjorklund(pulses, steps)
+	rotation = (rotation%steps + steps) % steps
+	return append(s[rotation:], s[:rotation]...), nil
+}
+
+// Pulses of the steps, how many there are
+func (s Steps) Pulses() (count int) {
+	for _, pulse := range s {
+		if pulse {
+			count++
+		}
+	}
+	return
+}
+
+// String of the steps as a grid, a Hit of each pulse, or else a Rest, e.g. "x..x..x."
+func (s Steps) String() string {
+	var b strings.Builder
+	for _, pulse := range s {
+		if pulse {
+			b.WriteByte(groove.Hit)
+		} else {
+			b.WriteByte(groove.Rest)
+		}
+	}
+	return b.String()
+}
+
+// Track of the steps played by an instrument of a groove, e.g. Track(groove.Rim)
+func (s Steps) Track(i groove.Instrument) groove.Track {
+	return groove.Track{Instrument: i, Steps: s.String()}
+}
+
+// Pattern of a groove, of the steps played by an instrument, some steps per beat, straight, e.g. to write it as MIDI
+func (s Steps) Pattern(i groove.Instrument, stepsPerBeat int) groove.Pattern {
+	return groove.Pattern{Name: fmt.Sprintf("E(%d,%d)", s.Pulses(), len(s)), Steps: len(s), StepsPerBeat: stepsPerBeat, Swing: groove.Straight, Tracks: []groove.Track{s.Track(i)}}
+}
+
+//
+// Private
+//
+
+// bjorklund spreads some pulses over some steps, pairing each of the groups of pulses with one of the remainder, and again with each group of what remains, until no more than one is left
+func bjorklund(pulses, steps int) Steps {
+	var groups, remainder []Steps
+	for n := 0; n < steps; n++ {
+		if n < pulses {
+			groups = append(groups, Steps{true})
+		} else {
+			remainder = append(remainder, Steps{false})
+		}
+	}
+	for len(groups) > 0 && len(remainder) > 0 {
+		paired := len(groups)
+		if len(remainder) < paired {
+			paired = len(remainder)
+		}
+		next := make([]Steps, paired)
+		for n := range next {
+			next[n] = append(append(Steps{}, groups[n]...), remainder[n]...)
+		}
+		if len(groups) > paired {
+			remainder = groups[paired:]
+		} else {
+			remainder = remainder[paired:]
+		}
+		groups = next
+		if len(remainder) <= 1 {
+			break
+		}
+	}
+	var s Steps
+	for _, g := range append(groups, remainder...) {
+		s = append(s, g...)
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+// A Euclidean rhythm spreads some pulses as evenly as they can be over some steps, by the algorithm of Bjorklund, e.g. the tresillo "x..x..x." of 3 over 8,
+package rhythm
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/groove"
+)
+
+func TestEuclidean(t *testing.T) {
+	for expect, args := range map[string][2]int{
+		"x.x":              {2, 3},
+		"x..x..x.":         {3, 8},
+		"x.xx.xx.":         {5, 8},
+		"x.x.x":            {3, 5},
+		"x.x.x.x..":        {4, 9},
+		"x.xx":             {3, 4},
+		"x.xxx":            {4, 5},
+		"x..x..x..x..x...": {5, 16},
+		"x.xx.x.xx.x.":     {7, 12},
+		"........":         {0, 8},
+		"xxxx":             {4, 4},
+		"x":                {1, 1},
+	} {
+		s, err := Euclidean(args[0], args[1], 0)
+		assert.Nil(t, err)
+		assert.Equal(t, expect, s.String(), "E(%d,%d)", args[0], args[1])
+		assert.Equal(t, args[0], s.Pulses())
+	}
+}
+
+func TestEuclidean_Rotation(t *testing.T) {
+	for rotation, expect := range map[int]string{
+		0:  "x..x..x.",
+		1:  "..x..x.x",
+		3:  "x..x.x..",
+		8:  "x..x..x.",
+		-2: "x.x..x..",
+	} {
+		s, err := Euclidean(3, 8, rotation)
+		assert.Nil(t, err)
+		assert.Equal(t, expect, s.String(), "rotated %d", rotation)
+	}
+}
+
+func TestEuclidean_Error(t *testing.T) {
+	_, err := Euclidean(3, 0, 0)
+	assert.True(t, errors.Is(err, ErrStepsRange))
+	_, err = Euclidean(3, 65, 0)
+	assert.Equal(t, "out of range steps 65, expected 1 to 64", err.Error())
+	_, err = Euclidean(9, 8, 0)
+	assert.True(t, errors.Is(err, ErrPulsesRange))
+	assert.Equal(t, "out of range pulses 9, expected 0 to 8", err.Error())
+	_, err = Euclidean(-1, 8, 0)
+	assert.True(t, errors.Is(err, ErrPulsesRange))
+}
+
+func TestSteps_Track(t *testing.T) {
+	s, _ := Euclidean(3, 8, 0)
+	assert.Equal(t, groove.Track{Instrument: groove.Rim, Steps: "x..x..x."}, s.Track(groove.Rim))
+}
+
+func TestSteps_Pattern(t *testing.T) {
+	s, _ := Euclidean(3, 8, 0)
+	p := s.Pattern(groove.Kick, 2)
+	assert.Equal(t, "E(3,8)", p.Name)
+	assert.Equal(t, 8, p.Steps)
+	assert.Equal(t, 2, p.StepsPerBeat)
+	assert.Equal(t, groove.Straight, p.Swing)
+	assert.Equal(t, "kick  x.|.x|..|x.\n", p.String())
+	assert.Equal(t, 3, len(p.Notes(1)))
+	assert.Equal(t, 720, p.Notes(1)[1].Start, "the second pulse on the 4th eighth note")
+}
@@ -0,0 +1,89 @@
+// A metronome clicks each beat of some bars of a meter at a tempo, accenting the downbeat, and the first beat of every other group, e.g. 2+2+3 of 7/8, to practice an odd meter
+package rhythm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-music-theory/music-theory/groove"
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+// ErrBarsRange when a click track has fewer than 1 bar
+var ErrBarsRange = errors.New("out of range bars")
+
+// ClickTrack of a metronome, some bars of a meter at a tempo, in beats of its unit per minute, e.g. 140 eighth notes of 7/8
+type ClickTrack struct {
+	Meter meter.Meter
+	BPM   float64
+	Bars  int
+}
+
+// Click of a click track, on a beat of a bar, both counted from 0 of the first, by the strength of the beat, at a time from the beginning of the track
+type Click struct {
+	Bar      int
+	Beat     int
+	Strength meter.Strength
+	Time     time.Duration
+}
+
+// Metronome of some bars of a meter, at some beats of its unit per minute, e.g. Metronome(m, 140, 16),
+// or an error if the meter is invalid, the tempo isn't more than 0, or there's less than a bar
+func Metronome(m meter.Meter, bpm float64, bars int) (ClickTrack, error) {
+	if err := m.Validate(); err != nil {
+		return ClickTrack{}, err
+	}
+	if bpm <= 0 {
+		return ClickTrack{}, fmt.Errorf("%w %v, expected more than 0 beats per minute", ErrTempoRange, bpm)
+	}
+	if bars < 1 {
+		return ClickTrack{}, fmt.Errorf("%w %d, expected at least 1", ErrBarsRange, bars)
+	}
+	return ClickTrack{Meter: m, BPM: bpm, Bars: bars}, nil
+}
+
+// Clicks of the track, of every beat of every bar, in order of time
+func (t ClickTrack) Clicks() []Click {
+	strengths := t.Meter.Strengths()
+	clicks := make([]Click, 0, t.Bars*len(strengths))
+	for bar := 0; bar < t.Bars; bar++ {
+		for beat, strength := range strengths {
+			clicks = append(clicks, Click{Bar: bar, Beat: beat, Strength: strength, Time: t.timeOf(bar*len(strengths) + beat)})
+		}
+	}
+	return clicks
+}
+
+// Duration of the track, the beats of all its bars at its tempo
+func (t ClickTrack) Duration() time.Duration {
+	return t.timeOf(t.Bars * t.Meter.Beats)
+}
+
+// String of a bar of the track, a Hit on each beat, an Accent on the first of each group, with a bar between the groups, e.g. "Xx|Xx|Xxx" of 2+2+3/8
+func (t ClickTrack) String() string {
+	var b strings.Builder
+	for _, strength := range t.Meter.Strengths() {
+		switch strength {
+		case meter.Medium:
+			b.WriteByte('|')
+			fallthrough
+		case meter.Strong:
+			b.WriteByte(groove.Accent)
+		default:
+			b.WriteByte(groove.Hit)
+		}
+	}
+	return b.String()
+}
+
+//
+// Private
+//
+
+// timeOf some beats of the track at its tempo, rounded to the nanosecond
+func (t ClickTrack) timeOf(beats int) time.Duration {
+	return time.Duration(math.Round(float64(time.Minute) * float64(beats) / t.BPM))
+}
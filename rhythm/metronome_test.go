@@ -0,0 +1,53 @@
+// A metronome clicks each beat of some bars of a meter at a tempo, accenting the downbeat, and the first beat of every other group, e.g. 2+2+3 of 7/8, to practice an odd meter
+package rhythm
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+func TestMetronome(t *testing.T) {
+	m, _ := meter.Parse("7/8")
+	c, err := Metronome(m, 140, 16)
+	assert.Nil(t, err)
+	assert.Equal(t, ClickTrack{Meter: m, BPM: 140, Bars: 16}, c)
+	_, err = Metronome(meter.Meter{Beats: 4, Unit: 3}, 140, 16)
+	assert.True(t, errors.Is(err, meter.ErrInvalidMeter))
+	_, err = Metronome(m, 0, 16)
+	assert.True(t, errors.Is(err, ErrTempoRange))
+	_, err = Metronome(m, 140, 0)
+	assert.True(t, errors.Is(err, ErrBarsRange))
+	assert.Equal(t, "out of range bars 0, expected at least 1", err.Error())
+}
+
+func TestClickTrack_Clicks(t *testing.T) {
+	c, _ := Metronome(meter.Meter{Beats: 3, Unit: 4}, 120, 2)
+	assert.Equal(t, []Click{
+		{Bar: 0, Beat: 0, Strength: meter.Strong, Time: 0},
+		{Bar: 0, Beat: 1, Strength: meter.Weak, Time: 500 * time.Millisecond},
+		{Bar: 0, Beat: 2, Strength: meter.Weak, Time: time.Second},
+		{Bar: 1, Beat: 0, Strength: meter.Strong, Time: 1500 * time.Millisecond},
+		{Bar: 1, Beat: 1, Strength: meter.Weak, Time: 2 * time.Second},
+		{Bar: 1, Beat: 2, Strength: meter.Weak, Time: 2500 * time.Millisecond},
+	}, c.Clicks())
+}
+
+func TestClickTrack_Duration(t *testing.T) {
+	c, _ := Metronome(meter.Meter{Beats: 7, Unit: 8}, 140, 16)
+	assert.Equal(t, 48*time.Second, c.Duration())
+}
+
+func TestClickTrack_String(t *testing.T) {
+	m, _ := meter.Parse("7/8")
+	c, _ := Metronome(m, 140, 1)
+	assert.Equal(t, "Xx|Xx|Xxx", c.String())
+	c, _ = Metronome(meter.Common, 120, 1)
+	assert.Equal(t, "Xx|Xx", c.String())
+	c, _ = Metronome(meter.Meter{Beats: 3, Unit: 4}, 120, 1)
+	assert.Equal(t, "Xxx", c.String())
+}
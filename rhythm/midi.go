@@ -0,0 +1,75 @@
+// Polyrhythms and metronomes are written as a click track of MIDI on the General MIDI drum channel, a wood block for each voice of a polyrhythm, accented where they align
+package rhythm
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/groove"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+// Clicks of each voice of a polyrhythm, A on the high wood block and B on the low, of a General MIDI drum kit
+var Clicks = [2]groove.Instrument{
+	A: {Name: "high wood block", Note: 76},
+	B: {Name: "low wood block", Note: 77},
+}
+
+// Notes of the cycle repeated some times, each pulse a click lasting until the next of its voice, B each a quarter note
+func (c Cycle) Notes(cycles int) []midi.Note {
+	var notes []midi.Note
+	for n := 0; n < cycles; n++ {
+		for _, p := range c.Pulses {
+			velocity := midi.DefaultVelocity
+			if c.aligned(p.Step) {
+				velocity = groove.AccentVelocity
+			}
+			notes = append(notes, midi.Note{Number: Clicks[p.Voice].Note, Velocity: velocity, Channel: groove.DrumChannel, Start: c.ticksOf(n*c.Steps + p.Step), Duration: c.ticksOf(c.Steps / c.pulsesOf(p.Voice))})
+		}
+	}
+	return notes
+}
+
+// WriteMIDI of the cycle repeated some times, as a click track at its tempo
+func (c Cycle) WriteMIDI(w io.Writer, cycles int) error {
+	return midi.WriteTempo(w, c.Notes(cycles), c.BPM)
+}
+
+// Notes of the click track, each beat a click lasting until the next, the first of each group on the high wood block, the downbeat accented, and the rest on the low
+func (t ClickTrack) Notes() []midi.Note {
+	beat := t.Meter.TicksPerBeat()
+	var notes []midi.Note
+	for n, c := range t.Clicks() {
+		instrument, velocity := Clicks[B], midi.DefaultVelocity
+		switch c.Strength {
+		case meter.Strong:
+			instrument, velocity = Clicks[A], groove.AccentVelocity
+		case meter.Medium:
+			instrument = Clicks[A]
+		}
+		notes = append(notes, midi.Note{Number: instrument.Note, Velocity: velocity, Channel: groove.DrumChannel, Start: n * beat, Duration: beat})
+	}
+	return notes
+}
+
+// WriteMIDI of the click track at its tempo, in beats of the unit of its meter
+func (t ClickTrack) WriteMIDI(w io.Writer) error {
+	return midi.WriteTempo(w, t.Notes(), t.BPM*float64(t.Meter.TicksPerBeat())/midi.Quarter)
+}
+
+//
+// Private
+//
+
+// ticksOf some steps of the grid of the cycle, B each a quarter note, rounded down
+func (c Cycle) ticksOf(steps int) int {
+	return steps * c.B * midi.Quarter / c.Steps
+}
+
+// pulsesOf a voice of the cycle in each cycle, A or B
+func (c Cycle) pulsesOf(voice int) int {
+	if voice == A {
+		return c.A
+	}
+	return c.B
+}
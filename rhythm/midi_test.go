@@ -0,0 +1,58 @@
+// Polyrhythms and metronomes are written as a click track of MIDI on the General MIDI drum channel, a wood block for each voice of a polyrhythm, accented where they align
+package rhythm
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/groove"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestCycle_Notes(t *testing.T) {
+	c, _ := Polyrhythm(3, 2, 120)
+	assert.Equal(t, []midi.Note{
+		{Number: 76, Velocity: groove.AccentVelocity, Channel: groove.DrumChannel, Start: 0, Duration: 320},
+		{Number: 77, Velocity: groove.AccentVelocity, Channel: groove.DrumChannel, Start: 0, Duration: 480},
+		{Number: 76, Velocity: midi.DefaultVelocity, Channel: groove.DrumChannel, Start: 320, Duration: 320},
+		{Number: 77, Velocity: midi.DefaultVelocity, Channel: groove.DrumChannel, Start: 480, Duration: 480},
+		{Number: 76, Velocity: midi.DefaultVelocity, Channel: groove.DrumChannel, Start: 640, Duration: 320},
+	}, c.Notes(1))
+	assert.Equal(t, 10, len(c.Notes(2)))
+	assert.Equal(t, 960, c.Notes(2)[5].Start, "the second cycle after the beats of B")
+	assert.Equal(t, 0, len(c.Notes(0)))
+}
+
+func TestCycle_WriteMIDI(t *testing.T) {
+	c, _ := Polyrhythm(3, 4, 100)
+	var buf bytes.Buffer
+	assert.Nil(t, c.WriteMIDI(&buf, 2))
+	assert.Equal(t, "MThd", buf.String()[:4])
+	assert.True(t, bytes.Contains(buf.Bytes(), []byte{0xFF, 0x51, 0x03, 0x09, 0x27, 0xC0}), "the tempo, 600000 microseconds per quarter note")
+}
+
+func TestClickTrack_Notes(t *testing.T) {
+	m, _ := meter.Parse("5/8")
+	c, _ := Metronome(m, 140, 1)
+	assert.Equal(t, []midi.Note{
+		{Number: 76, Velocity: groove.AccentVelocity, Channel: groove.DrumChannel, Start: 0, Duration: 240},
+		{Number: 77, Velocity: midi.DefaultVelocity, Channel: groove.DrumChannel, Start: 240, Duration: 240},
+		{Number: 76, Velocity: midi.DefaultVelocity, Channel: groove.DrumChannel, Start: 480, Duration: 240},
+		{Number: 77, Velocity: midi.DefaultVelocity, Channel: groove.DrumChannel, Start: 720, Duration: 240},
+		{Number: 77, Velocity: midi.DefaultVelocity, Channel: groove.DrumChannel, Start: 960, Duration: 240},
+	}, c.Notes())
+}
+
+func TestClickTrack_WriteMIDI(t *testing.T) {
+	m, _ := meter.Parse("7/8")
+	c, _ := Metronome(m, 140, 2)
+	var buf bytes.Buffer
+	assert.Nil(t, c.WriteMIDI(&buf))
+	assert.True(t, bytes.Contains(buf.Bytes(), []byte{0xFF, 0x51, 0x03, 0x0D, 0x14, 0x37}), "the tempo, 857143 microseconds per quarter note, of 140 eighth notes per minute")
+	notes, err := midi.Read(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, 14, len(notes))
+}
@@ -0,0 +1,177 @@
+// A polyrhythm sounds two pulses against each other in the same span, e.g. 3 against 4, so they align only at the beginning of each cycle,
+// and their composite pattern falls on a grid of the least common multiple of them, e.g. 12 steps of 3:4, the 4 each a beat at the tempo.
+//
+// https://en.wikipedia.org/wiki/Polyrhythm
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package rhythm
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-music-theory/music-theory/groove"
+)
+
+// MaxPulses of either voice of a polyrhythm, so its grid fits across a terminal
+const MaxPulses = 16
+
+var (
+	// ErrInvalidRatio when a polyrhythm can't be parsed, e.g. "3/4"
+	ErrInvalidRatio = errors.New("invalid ratio")
+
+	// ErrPulsesRange when a voice of a polyrhythm has fewer than 1 or more than MaxPulses
+	ErrPulsesRange = errors.New("out of range pulses")
+
+	// ErrTempoRange when a polyrhythm is at a tempo of 0 beats per minute or fewer
+	ErrTempoRange = errors.New("out of range tempo")
+)
+
+// Voices of a polyrhythm, A against B
+const (
+	A = iota
+	B
+)
+
+// Cycle of a polyrhythm, A pulses against B in the same span, B each a beat at a tempo, on a grid of steps, the least common multiple of A and B,
+// with every pulse of both voices in order of time, and the steps at which they align
+type Cycle struct {
+	A, B       int
+	BPM        float64
+	Steps      int
+	Pulses     []Pulse
+	Alignments []int // steps at which both voices pulse together, from 0 of the first
+}
+
+// Pulse of a voice of a polyrhythm, A or B, by its number in the voice, counted from 0 of the first,
+// at a step of the grid, and a time from the beginning of the cycle
+type Pulse struct {
+	Voice int
+	Index int
+	Step  int
+	Time  time.Duration
+}
+
+// Polyrhythm of some pulses of A against some of B, each of B a beat at some beats per minute, e.g. Polyrhythm(3, 4, 100),
+// or an error if either has fewer than 1 or more than MaxPulses, or the tempo isn't more than 0
+func Polyrhythm(a, b int, bpm float64) (Cycle, error) {
+	for _, pulses := range []int{a, b} {
+		if pulses < 1 || pulses > MaxPulses {
+			return Cycle{}, fmt.Errorf("%w %d, expected 1 to %d", ErrPulsesRange, pulses, MaxPulses)
+		}
+	}
+	if bpm <= 0 {
+		return Cycle{}, fmt.Errorf("%w %v, expected more than 0 beats per minute", ErrTempoRange, bpm)
+	}
+	c := Cycle{A: a, B: b, BPM: bpm, Steps: a * b / gcd(a, b)}
+	beat := time.Duration(float64(time.Minute) / bpm)
+	for step := 0; step < c.Steps; step++ {
+		for voice, pulses := range []int{a, b} {
+			if every := c.Steps / pulses; step%every == 0 {
+				c.Pulses = append(c.Pulses, Pulse{Voice: voice, Index: step / every, Step: step, Time: beat * time.Duration(step*b) / time.Duration(c.Steps)})
+			}
+		}
+		if c.aligned(step) {
+			c.Alignments = append(c.Alignments, step)
+		}
+	}
+	return c, nil
+}
+
+// Parse a polyrhythm of A against B, separated by a colon, e.g. "3:4", returning the pulses of each
+func Parse(text string) (int, int, error) {
+	m := rgxRatio.FindStringSubmatch(text)
+	if m == nil {
+		return 0, 0, fmt.Errorf("%w %q, expected e.g. 3:4", ErrInvalidRatio, text)
+	}
+	a, _ := strconv.Atoi(m[1])
+	b, _ := strconv.Atoi(m[2])
+	return a, b, nil
+}
+
+// Duration of the cycle, the beats of B at its tempo
+func (c Cycle) Duration() time.Duration {
+	return time.Duration(float64(time.Minute) / c.BPM * float64(c.B))
+}
+
+// Track of a voice of the cycle on its grid, a Hit at each step it pulses, or else a Rest, e.g. "x...x...x..." of the 3 of 3:4
+func (c Cycle) Track(voice int) string {
+	var b strings.Builder
+	for step := 0; step < c.Steps; step++ {
+		b.WriteByte(c.stepOf(step, func(p Pulse) bool { return p.Voice == voice }))
+	}
+	return b.String()
+}
+
+// Composite pattern of both voices of the cycle on its grid, a Hit at each step either pulses, an Accent where they align, or else a Rest, e.g. "X..xx.x.xx.." of 3:4
+func (c Cycle) Composite() string {
+	var b strings.Builder
+	for step := 0; step < c.Steps; step++ {
+		if c.aligned(step) {
+			b.WriteByte(groove.Accent)
+		} else {
+			b.WriteByte(c.stepOf(step, func(Pulse) bool { return true }))
+		}
+	}
+	return b.String()
+}
+
+// String of the cycle as a grid, a line for the track of each voice, by its pulses, and then the composite, with a bar between each beat of B,
+// e.g. "3    x..|.x.|..x|..."
+func (c Cycle) String() string {
+	labels := []string{strconv.Itoa(c.A), strconv.Itoa(c.B), fmt.Sprintf("%d:%d", c.A, c.B)}
+	lines := []string{c.Track(A), c.Track(B), c.Composite()}
+	var b strings.Builder
+	for n, line := range lines {
+		fmt.Fprintf(&b, "%-*s  ", len(labels[2]), labels[n])
+		for step := 0; step < len(line); step++ {
+			if step > 0 && step%(c.Steps/c.B) == 0 {
+				b.WriteByte('|')
+			}
+			b.WriteByte(line[step])
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+//
+// Private
+//
+
+var rgxRatio = regexp.MustCompile(`^\s*([0-9]+)\s*:\s*([0-9]+)\s*$`)
+
+// aligned at a step, whether both voices of the cycle pulse together
+func (c Cycle) aligned(step int) bool {
+	return step%(c.Steps/c.A) == 0 && step%(c.Steps/c.B) == 0
+}
+
+// stepOf the grid of the cycle, a Hit if any pulse matches at it, or else a Rest
+func (c Cycle) stepOf(step int, matches func(p Pulse) bool) byte {
+	for _, p := range c.Pulses {
+		if p.Step == step && matches(p) {
+			return groove.Hit
+		}
+	}
+	return groove.Rest
+}
+
+// gcd of two numbers more than 0, the greatest common divisor
+func gcd(a, b int) int {
+	for b > 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,84 @@
+// A polyrhythm sounds two pulses against each other in the same span, e.g. 3 against 4, so they align only at the beginning of each cycle,
+package rhythm
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestPolyrhythm(t *testing.T) {
+	c, err := Polyrhythm(3, 4, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, c.A)
+	assert.Equal(t, 4, c.B)
+	assert.Equal(t, 12, c.Steps)
+	assert.Equal(t, []int{0}, c.Alignments)
+	assert.Equal(t, []Pulse{
+		{Voice: A, Index: 0, Step: 0, Time: 0},
+		{Voice: B, Index: 0, Step: 0, Time: 0},
+		{Voice: B, Index: 1, Step: 3, Time: 600 * time.Millisecond},
+		{Voice: A, Index: 1, Step: 4, Time: 800 * time.Millisecond},
+		{Voice: B, Index: 2, Step: 6, Time: 1200 * time.Millisecond},
+		{Voice: A, Index: 2, Step: 8, Time: 1600 * time.Millisecond},
+		{Voice: B, Index: 3, Step: 9, Time: 1800 * time.Millisecond},
+	}, c.Pulses)
+	assert.Equal(t, 2400*time.Millisecond, c.Duration())
+}
+
+func TestPolyrhythm_Aligned(t *testing.T) {
+	c, err := Polyrhythm(2, 4, 120)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, c.Steps)
+	assert.Equal(t, []int{0, 2}, c.Alignments)
+	c, err = Polyrhythm(4, 6, 120)
+	assert.Nil(t, err)
+	assert.Equal(t, 12, c.Steps)
+	assert.Equal(t, []int{0, 6}, c.Alignments)
+}
+
+func TestPolyrhythm_Error(t *testing.T) {
+	_, err := Polyrhythm(0, 4, 100)
+	assert.True(t, errors.Is(err, ErrPulsesRange))
+	_, err = Polyrhythm(3, 17, 100)
+	assert.Equal(t, "out of range pulses 17, expected 1 to 16", err.Error())
+	_, err = Polyrhythm(3, 4, 0)
+	assert.True(t, errors.Is(err, ErrTempoRange))
+}
+
+func TestParse(t *testing.T) {
+	a, b, err := Parse("3:4")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, a)
+	assert.Equal(t, 4, b)
+	a, b, err = Parse(" 5 : 3 ")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, a)
+	assert.Equal(t, 3, b)
+	for _, text := range []string{"3/4", "3", "a:b", "-3:4", ""} {
+		_, _, err = Parse(text)
+		assert.True(t, errors.Is(err, ErrInvalidRatio), text)
+	}
+}
+
+func TestCycle_Track(t *testing.T) {
+	c, _ := Polyrhythm(3, 4, 100)
+	assert.Equal(t, "x...x...x...", c.Track(A))
+	assert.Equal(t, "x..x..x..x..", c.Track(B))
+}
+
+func TestCycle_Composite(t *testing.T) {
+	c, _ := Polyrhythm(3, 4, 100)
+	assert.Equal(t, "X..xx.x.xx..", c.Composite())
+	c, _ = Polyrhythm(2, 4, 100)
+	assert.Equal(t, "XxXx", c.Composite())
+}
+
+func TestCycle_String(t *testing.T) {
+	c, _ := Polyrhythm(3, 4, 100)
+	assert.Equal(t, "3    x..|.x.|..x|...\n4    x..|x..|x..|x..\n3:4  X..|xx.|x.x|x..\n", c.String())
+	c, _ = Polyrhythm(3, 2, 100)
+	assert.Equal(t, "3    x.x|.x.\n2    x..|x..\n3:2  X.x|xx.\n", c.String())
+}
@@ -0,0 +1,36 @@
+// Metronomes are written as audio of a WAV file, a click on each beat, higher and louder on the first of each group, highest on the downbeat
+package rhythm
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/audio"
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+// Pitches of the clicks of a metronome in Hz, by the strength of each beat, A6 on the downbeat, E6 on the first of every other group, and A5 on the rest
+var Pitches = map[meter.Strength]float64{
+	meter.Strong: 1760,
+	meter.Medium: 1318.51,
+	meter.Weak:   880,
+}
+
+// Gains of the clicks of a metronome, from 0 to 1, by the strength of each beat
+var Gains = map[meter.Strength]float64{
+	meter.Strong: 0.9,
+	meter.Medium: 0.7,
+	meter.Weak:   0.5,
+}
+
+// WriteWAV of the click track, lasting until the end of its last beat
+func (t ClickTrack) WriteWAV(w io.Writer) error {
+	samples := make([]float64, audio.SamplesOf(t.Duration()))
+	sounds := map[meter.Strength][]float64{}
+	for strength, pitch := range Pitches {
+		sounds[strength] = audio.Click(pitch, Gains[strength])
+	}
+	for _, c := range t.Clicks() {
+		samples = audio.Mix(samples, sounds[c.Strength], c.Time)
+	}
+	return audio.WriteWAV(w, samples)
+}
@@ -0,0 +1,28 @@
+// Metronomes are written as audio of a WAV file, a click on each beat, higher and louder on the first of each group, highest on the downbeat
+package rhythm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/audio"
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+func TestClickTrack_WriteWAV(t *testing.T) {
+	c, _ := Metronome(meter.Common, 120, 1)
+	var buf bytes.Buffer
+	assert.Nil(t, c.WriteWAV(&buf))
+	b := buf.Bytes()
+	assert.Equal(t, "RIFF", string(b[0:4]))
+	assert.Equal(t, uint32(audio.SamplesOf(c.Duration())*2), binary.LittleEndian.Uint32(b[40:]), "2 seconds of 16-bit samples")
+	silent := func(at int) bool { // of a sample a little after a time
+		return binary.LittleEndian.Uint16(b[44+at*2+20:]) == 0
+	}
+	assert.False(t, silent(0), "the downbeat")
+	assert.True(t, silent(audio.SampleRate/4), "between beats")
+	assert.False(t, silent(audio.SampleRate/2), "the second beat")
+}
@@ -0,0 +1,220 @@
+// The chord of a Roman numeral is realized in a key, to generate a progression in any key, and a chord in a key is analyzed as its Roman numeral, e.g. V65 of B7/D# in E minor
+package roman
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/numeral"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// In a key, the chord of the Roman numeral, on its degree of the major or natural minor scale of the key, raised or lowered by its accidental,
+// but in minor on its own degree of a flat of the 3rd, 6th or 7th, e.g. G of bVII in A minor, and on the leading tone of a diminished 7th, e.g. G#m7b5 of viiø7,
+// with the tone of its inversion in the bass, spelled in the key, or spelled with the accidental of a degree not in the key, e.g. G7/B of V65 or Bb of bVII in C major,
+// or of a numeral applied to a chord, in the major key of that chord, e.g. D7 of V7/V, or F#dim7 of vii°7/V in C major, spelled in that key, but with flats of a chord applied to a flat degree, e.g. Eb7 of V7/bVI,
+// or a chord of the Root Nil of an unknown numeral
+func (r RomanNumeral) In(k key.Key) chord.Chord {
+	if r.Degree < 1 || r.Degree > 7 {
+		return chord.Chord{}
+	}
+	if r.Target != nil {
+		target := r.Target.In(k)
+		if target.Root == note.Nil {
+			return chord.Chord{}
+		}
+		applied := r
+		applied.Target = nil
+		adj := note.Sharp
+		if r.Target.Accidental < 0 {
+			adj = note.Flat
+		}
+		return applied.In(key.Key{Root: target.Root, AdjSymbol: adj, Mode: key.Major})
+	}
+	accidental := accidentalIn(k, r)
+	root, _ := k.Scale().Tones[scale.Interval(r.Degree)].Step(accidental)
+	if root == note.Nil {
+		return chord.Chord{}
+	}
+	adj := k.AdjSymbol
+	switch {
+	case accidental < 0:
+		adj = note.Flat
+	case accidental > 0:
+		adj = note.Sharp
+	}
+	c := chord.Of(root.String(adj) + suffixOf(r.Quality, r.Seventh))
+	if accidental == 0 {
+		c = c.SpelledIn(k)
+	} else {
+		c.AdjSymbol = adj
+	}
+	return c.Inversion(r.Inversion)
+}
+
+// Analyze a chord in a key, its Roman numeral, by the degree of the key's major or natural minor scale of its root, its quality, seventh and inversion,
+// with an accidental of a root not in the key, in major flat but for the #IV, and in minor sharp but for the bII, e.g. bVII of Bb or #iv° of F#dim in C major,
+// or false of a chord it can't be written as, without a third, or with a bass that isn't its third, fifth or seventh
+func Analyze(c chord.Chord, k key.Key) (RomanNumeral, bool) {
+	if c.Root == note.Nil || classAt(c, chord.I3) == note.Nil {
+		return RomanNumeral{}, false
+	}
+	r := RomanNumeral{}
+	var ok bool
+	if r.Degree, r.Accidental, ok = degreeIn(k, c.Root); !ok {
+		return RomanNumeral{}, false
+	}
+	third, fifth := semitonesOf(c, chord.I3), 7
+	if classAt(c, chord.I5) != note.Nil {
+		fifth = semitonesOf(c, chord.I5)
+	}
+	if r.Quality, ok = numeral.QualityOf(third, fifth); !ok {
+		return RomanNumeral{}, false
+	}
+	if classAt(c, chord.I7) != note.Nil {
+		r.Seventh = semitonesOf(c, chord.I7)
+	}
+	switch c.Lowest() {
+	case c.Root:
+	case classAt(c, chord.I3):
+		r.Inversion = 1
+	case classAt(c, chord.I5):
+		r.Inversion = 2
+	case classAt(c, chord.I7):
+		r.Inversion = 3
+	default:
+		return RomanNumeral{}, false
+	}
+	return r, true
+}
+
+// AnalyzeApplied a chord in a key, as a dominant applied to the diatonic chord a fifth below it, tonicizing that chord, of a major triad or dominant seventh chord
+// with a tone not in the key, e.g. V7/vi of E7, V7/ii of A7 or V/V of D in C major, or false of a chord in the key, of a dominant of the tonic, which is only its V,
+// or of one a fifth above a degree not in the key, or of a diminished chord
+func AnalyzeApplied(c chord.Chord, k key.Key) (RomanNumeral, bool) {
+	if c.Root == note.Nil || classAt(c, chord.I3) == note.Nil || semitonesOf(c, chord.I3) != 4 {
+		return RomanNumeral{}, false
+	}
+	if classAt(c, chord.I5) != note.Nil && semitonesOf(c, chord.I5) != 7 {
+		return RomanNumeral{}, false
+	}
+	if classAt(c, chord.I7) != note.Nil && semitonesOf(c, chord.I7) != MinorSeventh {
+		return RomanNumeral{}, false
+	}
+	if k.Scale().ContainsChord(c) {
+		return RomanNumeral{}, false
+	}
+	root, _ := c.Root.Step(5)
+	degree, accidental, ok := degreeIn(k, root)
+	if !ok || accidental != 0 || degree == 1 {
+		return RomanNumeral{}, false
+	}
+	target, ok := Analyze(chord.Of(triadNameIn(k, degree)), k)
+	if !ok || target.Quality == Diminished {
+		return RomanNumeral{}, false
+	}
+	r, ok := Analyze(c, key.Key{Root: root, Mode: key.Major})
+	if !ok {
+		return RomanNumeral{}, false
+	}
+	r.Target = &target
+	return r, true
+}
+
+//
+// Private
+//
+
+// accidentalIn a key of a Roman numeral, the semitones from the key's own degree to its root, in minor none of a flat of the 3rd, 6th or 7th,
+// which the natural minor scale already lowers, and a sharp of a diminished 7th, on the leading tone, or else its own accidental
+func accidentalIn(k key.Key, r RomanNumeral) int {
+	if k.Mode != key.Minor {
+		return r.Accidental
+	}
+	switch {
+	case r.Accidental < 0 && (r.Degree == 3 || r.Degree == 6 || r.Degree == 7):
+		return r.Accidental + 1
+	case r.Accidental == 0 && r.Degree == 7 && r.Quality == Diminished:
+		return 1
+	}
+	return r.Accidental
+}
+
+// triadNameIn a key of its diatonic triad on a degree, by the tones of the key's major or natural minor scale a third and fifth above it, e.g. "Am" of the 6th of C major
+func triadNameIn(k key.Key, degree int) string {
+	s := k.Scale()
+	root := s.Tones[scale.Interval(degree)]
+	third := (root.Diff(s.Tones[scale.Interval((degree+1)%7+1)]) + 12) % 12
+	fifth := (root.Diff(s.Tones[scale.Interval((degree+3)%7+1)]) + 12) % 12
+	name := root.String(k.AdjSymbol)
+	switch {
+	case third == 3 && fifth == 6:
+		return name + "dim"
+	case third == 3:
+		return name + "m"
+	}
+	return name
+}
+
+// suffixOf a chord name, by the quality and seventh of a Roman numeral, e.g. "m7b5" of a diminished triad with a minor seventh
+func suffixOf(quality Quality, seventh int) string {
+	switch {
+	case quality == Diminished && seventh == DiminishedSeventh:
+		return "dim7"
+	case quality == Diminished && seventh == MinorSeventh:
+		return "m7b5"
+	case quality == Diminished && seventh == MajorSeventh:
+		return "dimM7"
+	case quality == Diminished:
+		return "dim"
+	case quality == Augmented && seventh == MajorSeventh:
+		return "augM7"
+	case quality == Augmented && seventh == MinorSeventh:
+		return "aug7"
+	case quality == Augmented:
+		return "aug"
+	case quality == Minor && seventh == MajorSeventh:
+		return "mM7"
+	case quality == Minor && seventh == MinorSeventh:
+		return "m7"
+	case quality == Minor:
+		return "m"
+	case seventh == MajorSeventh:
+		return "maj7"
+	case seventh == MinorSeventh:
+		return "7"
+	}
+	return ""
+}
+
+// degreeIn a key of a root, on its major or natural minor scale, and the accidental of a root not in it,
+// trying the flat before the sharp in major, but for the bV, and the sharp before the flat in minor, but for the #I
+func degreeIn(k key.Key, root note.Class) (degree int, accidental int, ok bool) {
+	s := k.Scale()
+	accidentals, avoid := []int{0, -1, 1}, 5
+	if k.Mode == key.Minor {
+		accidentals, avoid = []int{0, 1, -1}, 1
+	}
+	for _, a := range accidentals {
+		for d := 1; d <= 7; d++ {
+			if a == accidentals[1] && d == avoid {
+				continue
+			}
+			if class, _ := s.Tones[scale.Interval(d)].Step(a); class == root && class != note.Nil {
+				return d, a, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// classAt an interval of a chord, the pitch class of its tone there, or Nil if it has none
+func classAt(c chord.Chord, i chord.Interval) note.Class {
+	class, _ := c.ClassAt(i)
+	return class
+}
+
+// semitonesOf the tone of a chord at an interval, up from its root, e.g. 3 of the minor third
+func semitonesOf(c chord.Chord, i chord.Interval) int {
+	return (c.Root.Diff(classAt(c, i)) + 12) % 12
+}
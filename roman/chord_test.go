@@ -0,0 +1,127 @@
+// The chord of a Roman numeral is realized in a key, to generate a progression in any key, and a chord in a key is analyzed as its Roman numeral, e.g. V65 of B7/D# in E minor
+package roman
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestRomanNumeral_In(t *testing.T) {
+	c := key.Of("C major")
+	assert.Equal(t, "G7/B", Of("V65").In(c).Name())
+	assert.Equal(t, "Ddim/F", Of("ii°6").In(c).Name())
+	assert.Equal(t, "Bb", Of("♭VII").In(c).Name())
+	assert.Equal(t, "Bm7b5", Of("viiø7").In(c).Name())
+	assert.Equal(t, "CM7", Of("Imaj7").In(c).Name())
+	assert.Equal(t, "CmM7", Of("i(maj7)").In(c).Name())
+	assert.Equal(t, "Eaug", Of("III+").In(c).Name())
+	assert.Equal(t, "F/C", Of("IV64").In(c).Name())
+	assert.Equal(t, "G7/F", Of("V42").In(c).Name())
+	assert.Equal(t, "Db/F", Of("bII6").In(c).Name())
+}
+
+func TestRomanNumeral_In_Minor(t *testing.T) {
+	e := key.Of("E minor")
+	assert.Equal(t, "B7/D#", Of("V65").In(e).Name())
+	assert.Equal(t, "Em", Of("i").In(e).Name())
+	assert.Equal(t, "D", Of("VII").In(e).Name())
+	assert.Equal(t, "D#dim7", Of("#vii°7").In(e).Name())
+	a := key.Of("A minor")
+	assert.Equal(t, "G", Of("♭VII").In(a).Name())
+	assert.Equal(t, "G", Of("VII").In(a).Name())
+	assert.Equal(t, "F", Of("bVI").In(a).Name())
+	assert.Equal(t, "C", Of("bIII").In(a).Name())
+	assert.Equal(t, "Bb", Of("bII").In(a).Name())
+	assert.Equal(t, "G#m7b5", Of("viiø7").In(a).Name())
+	assert.Equal(t, "G#dim7", Of("vii°7").In(a).Name())
+	assert.Equal(t, "G#m7b5", Of("#viiø7").In(a).Name())
+}
+
+func TestRomanNumeral_In_Applied(t *testing.T) {
+	c := key.Of("C major")
+	assert.Equal(t, "D7", Of("V7/V").In(c).Name())
+	assert.Equal(t, "F#dim7", Of("vii°7/V").In(c).Name())
+	assert.Equal(t, "A", Of("V/ii").In(c).Name())
+	assert.Equal(t, "Eb7", Of("V7/bVI").In(c).Name())
+	assert.Equal(t, "A7", Of("V7/V/V").In(c).Name())
+	assert.Equal(t, "D#dim7", Of("vii°7/V").In(key.Of("A minor")).Name())
+}
+
+func TestRomanNumeral_In_Spelling(t *testing.T) {
+	assert.Equal(t, note.Flat, Of("V7").In(key.Of("Eb major")).AdjSymbol)
+	assert.Equal(t, "Bb7", Of("V7").In(key.Of("Eb major")).Name())
+	assert.Equal(t, "F#m", Of("vi").In(key.Of("A major")).Name())
+}
+
+func TestRomanNumeral_In_Unknown(t *testing.T) {
+	assert.Equal(t, note.Nil, Of("X").In(key.Of("C major")).Root)
+	assert.Equal(t, note.Nil, Of("V7/X").In(key.Of("C major")).Root)
+}
+
+func TestAnalyze(t *testing.T) {
+	c := key.Of("C major")
+	for name, expect := range map[string]string{
+		"C": "I", "Dm7": "ii7", "G7/B": "V65", "Ddim/F": "ii°6", "Bb": "bVII", "Bm7b5": "viiø7", "CM7": "Imaj7",
+		"Eaug": "III+", "F#dim7": "#iv°7", "Ab": "bVI", "Db/F": "bII6", "G7/F": "V42", "C/G": "I64", "D7": "II7",
+	} {
+		r, ok := Analyze(chord.Of(name), c)
+		assert.True(t, ok, name)
+		assert.Equal(t, expect, r.String(), name)
+	}
+}
+
+func TestAnalyze_Minor(t *testing.T) {
+	a := key.Of("A minor")
+	for name, expect := range map[string]string{
+		"Am": "i", "Bdim": "ii°", "C": "III", "E7": "V7", "G#dim7": "#vii°7", "F#m": "#vi", "Bb": "bII", "A": "I",
+	} {
+		r, ok := Analyze(chord.Of(name), a)
+		assert.True(t, ok, name)
+		assert.Equal(t, expect, r.String(), name)
+	}
+}
+
+func TestAnalyze_Unknown(t *testing.T) {
+	c := key.Of("C major")
+	for _, name := range []string{"Csus4", "Csus2", "C/D"} {
+		_, ok := Analyze(chord.Of(name), c)
+		assert.False(t, ok, name)
+	}
+	_, ok := Analyze(chord.Chord{}, c)
+	assert.False(t, ok)
+}
+
+func TestAnalyze_In(t *testing.T) {
+	for _, k := range []key.Key{key.Of("C major"), key.Of("Eb major"), key.Of("A minor"), key.Of("F# minor")} {
+		for _, d := range k.Chords() {
+			r, ok := Analyze(d.Chord, k)
+			assert.True(t, ok, d.Name)
+			assert.Equal(t, d.Numeral, r.String(), d.Name)
+			assert.Equal(t, d.Chord.ToneSet(), r.In(k).ToneSet(), d.Numeral)
+		}
+	}
+}
+
+func TestAnalyzeApplied(t *testing.T) {
+	c := key.Of("C major")
+	for name, numeral := range map[string]string{"E7": "V7/vi", "A7": "V7/ii", "D7": "V7/V", "D": "V/V", "B7": "V7/iii", "C7": "V7/IV", "D7/F#": "V65/V"} {
+		r, ok := AnalyzeApplied(chord.Of(name), c)
+		assert.True(t, ok, name)
+		assert.Equal(t, numeral, r.String(), name)
+		assert.Equal(t, chord.Of(name).ToneSet(), r.In(c).ToneSet(), name)
+	}
+	for _, name := range []string{"G7", "C", "Am", "Bb", "F7", "Ab", "F#7", "Em7", "Csus4"} {
+		_, ok := AnalyzeApplied(chord.Of(name), c)
+		assert.False(t, ok, name)
+	}
+	r, ok := AnalyzeApplied(chord.Of("B7"), key.Of("A minor"))
+	assert.True(t, ok)
+	assert.Equal(t, "V7/v", r.String())
+	_, ok = AnalyzeApplied(chord.Of("E7"), key.Of("A minor"))
+	assert.False(t, ok, "the V7 of the tonic")
+}
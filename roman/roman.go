@@ -0,0 +1,186 @@
+// A Roman numeral names a chord by the degree of the key it's built on, in upper case of a major third or lower case of a minor one,
+// with any accidental of a degree not in the key, e.g. "bVII", the figure of its seventh and inversion, e.g. "V65",
+// and any chord it's applied to, tonicizing it, e.g. "V7/V", to write a progression in any key, or analyze one out of the key it's in.
+//
+// https://en.wikipedia.org/wiki/Roman_numeral_analysis
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package roman
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/numeral"
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Quality of the triad of a Roman numeral, by its third and fifth, written as the numeral package writes it
+type Quality = numeral.Quality
+
+// Qualities of a triad
+const (
+	Major      = numeral.Major
+	Minor      = numeral.Minor
+	Diminished = numeral.Diminished
+	Augmented  = numeral.Augmented
+)
+
+// Sevenths of a Roman numeral, by the semitones up from its root, or none of a triad
+const (
+	NoSeventh         = numeral.NoSeventh
+	DiminishedSeventh = numeral.DiminishedSeventh // e.g. of vii°7
+	MinorSeventh      = numeral.MinorSeventh      // e.g. of V7, ii7 or viiø7
+	MajorSeventh      = numeral.MajorSeventh      // e.g. of Imaj7
+)
+
+// ErrUnknownNumeral when parsing a Roman numeral without a degree from I to VII, e.g. "X"
+var ErrUnknownNumeral = errors.New("unknown numeral")
+
+// ErrUnknownFigure when parsing a Roman numeral with a quality, seventh or figure that it can't have, e.g. "V63"
+var ErrUnknownFigure = errors.New("unknown figure")
+
+// RomanNumeral of a chord, by the degree of a key it's built on
+type RomanNumeral struct {
+	Accidental int // semitones from the key's own degree, e.g. -1 of the flat of bVII
+	Degree     int // of the key, from 1 of the tonic
+	Quality    Quality
+	Seventh    int           // semitones up from the root, or NoSeventh of a triad
+	Inversion  int           // of the tone in the bass, from 0 of root position to 3 of the seventh
+	Target     *RomanNumeral // applied to, tonicizing it, e.g. the V of V7/V, or nil
+}
+
+// Of a Roman numeral, e.g. Of("V65"), with its symbols in ASCII or Unicode, e.g. Of("♭VII") or Of("ii°6"), or of an unknown one, Degree 0
+func Of(name string) RomanNumeral {
+	r, _ := Parse(name)
+	return r
+}
+
+// Parse a Roman numeral, e.g. Parse("V7/V"), returning an error wrapping ErrUnknownNumeral or ErrUnknownFigure of one it can't read
+func Parse(name string) (RomanNumeral, error) {
+	normalized := strings.TrimSpace(symbol.Normalize(name))
+	parts := strings.Split(normalized, "/")
+	var r *RomanNumeral
+	for n := len(parts) - 1; n >= 0; n-- {
+		part, err := parse(parts[n])
+		if err != nil {
+			return RomanNumeral{}, fmt.Errorf("%w %q of %q", err, parts[n], name)
+		}
+		part.Target = r
+		r = &part
+	}
+	return *r, nil
+}
+
+// String of the Roman numeral, e.g. "bVII", "ii°6", "viiø7" or "V65/V"
+func (r RomanNumeral) String() string {
+	written := numeral.Of(r.Accidental, r.Degree, r.Quality, r.Seventh, r.Inversion)
+	if len(written) > 0 && r.Target != nil {
+		written += "/" + r.Target.String()
+	}
+	return written
+}
+
+// Figure of the Roman numeral, its seventh and inversion, e.g. "65" of a seventh chord in first inversion, "64" of a triad in second, or "" of a triad in root position
+func (r RomanNumeral) Figure() string {
+	return numeral.Figure(r.Seventh, r.Inversion)
+}
+
+//
+// Private
+//
+
+// longestNumeralsFirst of the degrees, to parse each numeral before any that it begins with, e.g. VII before VI and V
+var longestNumeralsFirst = []int{7, 3, 2, 4, 6, 5, 1}
+
+// parse a Roman numeral without any chord it's applied to, e.g. "bVII", "viiø65" or "Imaj7"
+func parse(text string) (RomanNumeral, error) {
+	r := RomanNumeral{}
+	for ; len(text) > 0 && (text[0] == 'b' || text[0] == '#'); text = text[1:] {
+		if text[0] == 'b' {
+			r.Accidental--
+		} else {
+			r.Accidental++
+		}
+	}
+	for _, d := range longestNumeralsFirst {
+		if strings.HasPrefix(text, numeral.Numerals[d-1]) {
+			r.Degree = d
+			break
+		}
+		if strings.HasPrefix(text, strings.ToLower(numeral.Numerals[d-1])) {
+			r.Degree, r.Quality = d, Minor
+			break
+		}
+	}
+	if r.Degree == 0 {
+		return RomanNumeral{}, ErrUnknownNumeral
+	}
+	text = text[len(numeral.Numerals[r.Degree-1]):]
+	halfDiminished := false
+	switch {
+	case strings.HasPrefix(text, "°"), strings.HasPrefix(text, "o"):
+		r.Quality = Diminished
+		text = strings.TrimPrefix(strings.TrimPrefix(text, "°"), "o")
+	case strings.HasPrefix(text, "ø"):
+		r.Quality = Diminished
+		halfDiminished = true
+		text = strings.TrimPrefix(text, "ø")
+	case strings.HasPrefix(text, "+"):
+		r.Quality = Augmented
+		text = text[1:]
+	}
+	major := false
+	if inner := strings.TrimSuffix(strings.TrimPrefix(text, "("), ")"); len(inner) == len(text)-2 {
+		text = inner
+	}
+	for _, prefix := range []string{"maj", "M", "Δ"} {
+		if strings.HasPrefix(text, prefix) {
+			major = true
+			text = strings.TrimPrefix(text, prefix)
+			break
+		}
+	}
+	if (major || halfDiminished) && len(text) == 0 {
+		text = "7" // implied, e.g. of "Imaj" or "viiø"
+	}
+	if text == "2" {
+		text = "42"
+	}
+	switch {
+	case indexOf(numeral.TriadFigures, text) >= 0 && !major && !halfDiminished:
+		r.Inversion = indexOf(numeral.TriadFigures, text)
+	case indexOf(numeral.SeventhFigures, text) >= 0:
+		r.Inversion = indexOf(numeral.SeventhFigures, text)
+		switch {
+		case major:
+			r.Seventh = MajorSeventh
+		case r.Quality == Diminished && !halfDiminished:
+			r.Seventh = DiminishedSeventh
+		default:
+			r.Seventh = MinorSeventh
+		}
+	default:
+		return RomanNumeral{}, ErrUnknownFigure
+	}
+	return r, nil
+}
+
+// indexOf some text in a list, or -1 if it isn't
+func indexOf(list []string, text string) int {
+	for n, s := range list {
+		if s == text {
+			return n
+		}
+	}
+	return -1
+}
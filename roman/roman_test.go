@@ -0,0 +1,101 @@
+// A Roman numeral names a chord by the degree of the key it's built on, in upper case of a major third or lower case of a minor one,
+package roman
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParse(t *testing.T) {
+	r, err := Parse("V65")
+	assert.Nil(t, err)
+	assert.Equal(t, RomanNumeral{Degree: 5, Quality: Major, Seventh: MinorSeventh, Inversion: 1}, r)
+
+	r, err = Parse("ii°6")
+	assert.Nil(t, err)
+	assert.Equal(t, RomanNumeral{Degree: 2, Quality: Diminished, Inversion: 1}, r)
+
+	r, err = Parse("♭VII")
+	assert.Nil(t, err)
+	assert.Equal(t, RomanNumeral{Accidental: -1, Degree: 7, Quality: Major}, r)
+}
+
+func TestParse_Sevenths(t *testing.T) {
+	assert.Equal(t, RomanNumeral{Degree: 2, Quality: Minor, Seventh: MinorSeventh}, Of("ii7"))
+	assert.Equal(t, RomanNumeral{Degree: 1, Quality: Major, Seventh: MajorSeventh}, Of("Imaj7"))
+	assert.Equal(t, RomanNumeral{Degree: 1, Quality: Major, Seventh: MajorSeventh}, Of("IM7"))
+	assert.Equal(t, RomanNumeral{Degree: 4, Quality: Major, Seventh: MajorSeventh, Inversion: 1}, Of("IVmaj65"))
+	assert.Equal(t, RomanNumeral{Degree: 1, Quality: Minor, Seventh: MajorSeventh}, Of("i(maj7)"))
+	assert.Equal(t, RomanNumeral{Degree: 7, Quality: Diminished, Seventh: MinorSeventh}, Of("viiø7"))
+	assert.Equal(t, RomanNumeral{Degree: 7, Quality: Diminished, Seventh: MinorSeventh}, Of("viiø"))
+	assert.Equal(t, RomanNumeral{Degree: 7, Quality: Diminished, Seventh: DiminishedSeventh}, Of("vii°7"))
+	assert.Equal(t, RomanNumeral{Degree: 7, Quality: Diminished, Seventh: DiminishedSeventh}, Of("viio7"))
+	assert.Equal(t, RomanNumeral{Degree: 3, Quality: Augmented}, Of("III+"))
+}
+
+func TestParse_Inversions(t *testing.T) {
+	assert.Equal(t, 0, Of("V7").Inversion)
+	assert.Equal(t, 1, Of("V65").Inversion)
+	assert.Equal(t, 2, Of("V43").Inversion)
+	assert.Equal(t, 3, Of("V42").Inversion)
+	assert.Equal(t, 3, Of("V2").Inversion)
+	assert.Equal(t, 1, Of("V6").Inversion)
+	assert.Equal(t, 2, Of("I64").Inversion)
+	assert.Equal(t, 1, Of("V⁶₅").Inversion)
+}
+
+func TestParse_Applied(t *testing.T) {
+	r, err := Parse("V7/V")
+	assert.Nil(t, err)
+	assert.Equal(t, RomanNumeral{Degree: 5, Quality: Major, Seventh: MinorSeventh, Target: &RomanNumeral{Degree: 5, Quality: Major}}, r)
+	assert.Equal(t, RomanNumeral{Degree: 7, Quality: Diminished, Seventh: DiminishedSeventh, Inversion: 1, Target: &RomanNumeral{Degree: 2, Quality: Minor}}, Of("vii°65/ii"))
+	assert.Equal(t, 5, Of("V/V/V").Target.Target.Degree)
+}
+
+func TestParse_Degrees(t *testing.T) {
+	for n, name := range []string{"I", "ii", "iii", "IV", "V", "vi", "VII"} {
+		assert.Equal(t, n+1, Of(name).Degree, name)
+	}
+	assert.Equal(t, RomanNumeral{Accidental: 1, Degree: 4, Quality: Minor}, Of("#iv"))
+	assert.Equal(t, RomanNumeral{Accidental: -2, Degree: 7, Quality: Major}, Of("bbVII"))
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, name := range []string{"", "X", "b", "7"} {
+		_, err := Parse(name)
+		assert.True(t, errors.Is(err, ErrUnknownNumeral), name)
+	}
+	for _, name := range []string{"V63", "I9", "Vi", "Imaj64", "V7/V63", "iiø6"} {
+		_, err := Parse(name)
+		assert.True(t, errors.Is(err, ErrUnknownFigure), name)
+	}
+	assert.Equal(t, 0, Of("X").Degree)
+}
+
+func TestRomanNumeral_String(t *testing.T) {
+	for _, name := range []string{"I", "V65", "ii°6", "bVII", "V7/V", "vii°7/V", "viiø7", "viiø65", "Imaj7", "IVmaj43", "i(maj7)", "III+", "#iv°7", "V42/IV", "V7/V/V", "bIImaj7"} {
+		assert.Equal(t, name, Of(name).String())
+	}
+	assert.Equal(t, "bVII", Of("♭VII").String())
+	assert.Equal(t, "V42", Of("V2").String())
+	assert.Equal(t, "viiø7", Of("viiø").String())
+	assert.Equal(t, "Imaj7", Of("IM7").String())
+	assert.Equal(t, "", RomanNumeral{}.String())
+}
+
+func TestRomanNumeral_Figure(t *testing.T) {
+	assert.Equal(t, "", Of("V").Figure())
+	assert.Equal(t, "6", Of("V6").Figure())
+	assert.Equal(t, "64", Of("V64").Figure())
+	assert.Equal(t, "7", Of("Imaj7").Figure())
+	assert.Equal(t, "43", Of("V43").Figure())
+}
+
+func TestQuality_String(t *testing.T) {
+	assert.Equal(t, "major", Major.String())
+	assert.Equal(t, "minor", Minor.String())
+	assert.Equal(t, "diminished", Diminished.String())
+	assert.Equal(t, "augmented", Augmented.String())
+}
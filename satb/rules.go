@@ -0,0 +1,333 @@
+// The rules of four-part voice leading: each voice in its range, spaced no more than an octave from the next voice up,
+// every chord complete, no doubled leading tone, and no parallel fifths or octaves between any two voices
+package satb
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Rule of voice leading
+type Rule int
+
+// Rules of voice leading, each reported as a Violation when it couldn't be followed
+const (
+	Unvoiced       Rule = iota // a chord of no tones that could be sung
+	OutOfRange                 // a voice beyond its range
+	Spacing                    // more than an octave between adjacent upper voices
+	Incomplete                 // the root, third or seventh of a chord not sung
+	DoubledLeading             // the leading tone of the key sung by more than one voice
+	ParallelFifths             // two voices a perfect fifth apart, moving to another perfect fifth
+	ParallelOctave             // two voices an octave or unison apart, moving to another
+)
+
+// Violation of a rule, at a chord of the progression counted from 0, between some voices if the rule is about them
+type Violation struct {
+	Rule   Rule
+	Chord  int
+	Voices []Voice
+}
+
+// String of the Rule, e.g. "parallel fifths"
+func (of Rule) String() string {
+	switch of {
+	case Unvoiced:
+		return "unvoiced"
+	case OutOfRange:
+		return "out of range"
+	case Spacing:
+		return "spacing"
+	case Incomplete:
+		return "incomplete"
+	case DoubledLeading:
+		return "doubled leading tone"
+	case ParallelFifths:
+		return "parallel fifths"
+	case ParallelOctave:
+		return "parallel octaves"
+	}
+	return ""
+}
+
+// String of the Violation, e.g. "parallel fifths between tenor and bass at chord 3", counting chords from 1
+func (v Violation) String() string {
+	text := v.Rule.String()
+	switch len(v.Voices) {
+	case 0:
+	case 1:
+		text += " of " + v.Voices[0].String()
+	default:
+		text += " between " + v.Voices[0].String() + " and " + v.Voices[1].String()
+	}
+	return fmt.Sprintf("%s at chord %d", text, v.Chord+1)
+}
+
+//
+// Private
+//
+
+// Costs of the choices of voicing, in semitones of motion
+const (
+	violationCost  = 100.0 // of breaking any rule
+	bassMotionCost = 0.5   // per semitone of the bass, which is expected to leap
+	omittedTone    = 10.0  // of leaving out each tone of an incomplete chord
+	omittedFifth   = 2.0   // of leaving out the fifth of a chord
+	doubledThird   = 3.0   // of doubling the third instead of the root or fifth
+	doubledFifth   = 1.0   // of doubling the fifth instead of the root
+)
+
+// candidate voicing of a chord, its MIDI note numbers from the soprano to the bass, the rules it breaks, and the cost of them and its doubling
+type candidate struct {
+	notes  [4]int
+	broken []Violation
+	cost   float64
+}
+
+// violations of the candidate, at a chord counted from 0
+func (c candidate) violations(n int) []Violation {
+	var violations []Violation
+	for _, v := range c.broken {
+		v.Chord = n
+		violations = append(violations, v)
+	}
+	return violations
+}
+
+// leadingToneOf a key, the pitch class a semitone below its tonic, or Nil for no key
+func leadingToneOf(k key.Key) note.Class {
+	if k.Mode == key.Nil {
+		return note.Nil
+	}
+	return note.Class((int(k.Root)+10)%12 + 1)
+}
+
+// candidatesOf voicings of a chord in root position, within the ranges and spacing of the voices, and complete, i.e. every tone sung but the fifth,
+// or else, if none are, every voicing of the chord's tones in order of the voices, each with the rules it breaks
+func candidatesOf(c chord.Chord, leading note.Class) []candidate {
+	tones := tonesOf(c)
+	if len(tones) == 0 {
+		return nil
+	}
+	strict := voicingsOf(c, tones, leading, true)
+	if len(strict) > 0 {
+		return strict
+	}
+	return voicingsOf(c, tones, leading, false)
+}
+
+// tonesOf a chord, its pitch classes by their lowest interval from the root
+func tonesOf(c chord.Chord) map[note.Class]chord.Interval {
+	tones := make(map[note.Class]chord.Interval)
+	for _, t := range c.Tones {
+		if t.Class == note.Nil {
+			continue
+		}
+		if _, ok := tones[t.Class]; !ok {
+			tones[t.Class] = t.Degree.Interval
+		}
+	}
+	return tones
+}
+
+// voicingsOf a chord, with the bass on its root, strictly within the rules of range, spacing and completeness, or else breaking them for a greater cost
+func voicingsOf(c chord.Chord, tones map[note.Class]chord.Interval, leading note.Class, strict bool) []candidate {
+	low, high := 28, 96 // the lowest and highest notes considered when not strict, E1 to C7
+	var candidates []candidate
+	for b := low; b <= high; b++ {
+		if note.Class(b%12+1) != c.Root || (strict && !inRange(Bass, b)) {
+			continue
+		}
+		for t := b; t <= high; t++ {
+			if !isTone(tones, t) || (strict && !inRange(Tenor, t)) {
+				continue
+			}
+			for a := t; a <= high && (!strict || a-t <= MaxSpacing); a++ {
+				if !isTone(tones, a) || (strict && !inRange(Alto, a)) {
+					continue
+				}
+				for s := a; s <= high && (!strict || s-a <= MaxSpacing); s++ {
+					if !isTone(tones, s) || (strict && !inRange(Soprano, s)) {
+						continue
+					}
+					cd, complete := scored([4]int{s, a, t, b}, tones, leading)
+					if strict && !complete {
+						continue
+					}
+					candidates = append(candidates, cd)
+				}
+			}
+		}
+	}
+	if !strict {
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].cost < candidates[j].cost })
+		if len(candidates) > maxRelaxed {
+			candidates = candidates[:maxRelaxed]
+		}
+	}
+	return candidates
+}
+
+// maxRelaxed candidates of a chord that can't be voiced within the rules, the least costly
+const maxRelaxed = 64
+
+// scored candidate of some notes, by the rules they break and their doubling, and whether the chord is complete
+func scored(notes [4]int, tones map[note.Class]chord.Interval, leading note.Class) (candidate, bool) {
+	cd := candidate{notes: notes}
+	for _, v := range Voices {
+		if !inRange(v, notes[v]) {
+			cd.broken = append(cd.broken, Violation{Rule: OutOfRange, Voices: []Voice{v}})
+		}
+	}
+	for v := Soprano; v < Tenor; v++ {
+		if notes[v]-notes[v+1] > MaxSpacing {
+			cd.broken = append(cd.broken, Violation{Rule: Spacing, Voices: []Voice{v, v + 1}})
+		}
+	}
+	sung := make(map[chord.Interval]int)
+	var leadingSung int
+	for _, n := range notes {
+		class := note.Class(n%12 + 1)
+		sung[tones[class]]++
+		if class == leading {
+			leadingSung++
+		}
+	}
+	omitted := 0
+	for _, i := range tones {
+		if i != chord.I5 && sung[i] == 0 {
+			omitted++
+		}
+	}
+	complete := omitted == 0
+	if !complete {
+		cd.broken = append(cd.broken, Violation{Rule: Incomplete})
+	}
+	if leadingSung > 1 {
+		cd.broken = append(cd.broken, Violation{Rule: DoubledLeading})
+	}
+	cd.cost = violationCost*float64(len(cd.broken)) + omittedTone*float64(omitted)
+	if hasFifth(tones) && sung[chord.I5] == 0 {
+		cd.cost += omittedFifth
+	}
+	switch {
+	case sung[chord.I3] > 1:
+		cd.cost += doubledThird
+	case sung[chord.I5] > 1:
+		cd.cost += doubledFifth
+	}
+	return cd, complete
+}
+
+// hasFifth of a chord, i.e. a tone at its fifth
+func hasFifth(tones map[note.Class]chord.Interval) bool {
+	for _, i := range tones {
+		if i == chord.I5 {
+			return true
+		}
+	}
+	return false
+}
+
+// cheapest voicing of each chord, the index of a candidate, or -1 if it has none, for the least cost of motion and broken rules over the whole progression
+func cheapest(candidates [][]candidate) []int {
+	costs := make([][]float64, len(candidates))
+	from := make([][]int, len(candidates))
+	for n := range candidates {
+		costs[n] = make([]float64, len(candidates[n]))
+		from[n] = make([]int, len(candidates[n]))
+		prev := -1
+		for m := n - 1; m >= 0 && prev < 0; m-- {
+			if len(candidates[m]) > 0 {
+				prev = m
+			}
+		}
+		for i, cd := range candidates[n] {
+			from[n][i] = -1
+			if prev < 0 {
+				costs[n][i] = cd.cost
+				continue
+			}
+			for j, pc := range candidates[prev] {
+				cost := costs[prev][j] + motionCost(pc.notes, cd.notes) + cd.cost
+				if from[n][i] < 0 || cost < costs[n][i] {
+					costs[n][i], from[n][i] = cost, j
+				}
+			}
+		}
+	}
+	chosen := make([]int, len(candidates))
+	next := -1
+	for n := len(candidates) - 1; n >= 0; n-- {
+		chosen[n] = -1
+		if len(candidates[n]) == 0 {
+			continue
+		}
+		if next < 0 {
+			for i := range costs[n] {
+				if next < 0 || costs[n][i] < costs[n][next] {
+					next = i
+				}
+			}
+		}
+		chosen[n] = next
+		next = from[n][next]
+	}
+	return chosen
+}
+
+// motionCost from one voicing to the next, the semitones moved by each upper voice, and by the bass for less, plus any parallels
+func motionCost(from, to [4]int) float64 {
+	cost := 0.0
+	for _, v := range Voices {
+		moved := float64(abs(to[v] - from[v]))
+		if v == Bass {
+			moved *= bassMotionCost
+		}
+		cost += moved
+	}
+	return cost + violationCost*float64(len(motionViolations(from, to, 0)))
+}
+
+// motionViolations from one voicing to the next, at a chord counted from 0, of parallel fifths or octaves between any two voices
+func motionViolations(from, to [4]int, n int) []Violation {
+	var violations []Violation
+	for upper := Soprano; upper < Bass; upper++ {
+		for lower := upper + 1; lower <= Bass; lower++ {
+			before, after := from[upper]-from[lower], to[upper]-to[lower]
+			if from[upper] == to[upper] || before%12 != after%12 {
+				continue
+			}
+			switch before % 12 {
+			case 7:
+				violations = append(violations, Violation{Rule: ParallelFifths, Chord: n, Voices: []Voice{upper, lower}})
+			case 0:
+				violations = append(violations, Violation{Rule: ParallelOctave, Chord: n, Voices: []Voice{upper, lower}})
+			}
+		}
+	}
+	return violations
+}
+
+// inRange of a voice, a MIDI note number
+func inRange(v Voice, number int) bool {
+	r := Ranges[v]
+	return number >= r.Low && number <= r.High
+}
+
+// isTone of a chord, a MIDI note number
+func isTone(tones map[note.Class]chord.Interval, number int) bool {
+	_, ok := tones[note.Class(number%12+1)]
+	return ok
+}
+
+// abs value of some semitones
+func abs(semitones int) int {
+	if semitones < 0 {
+		return -semitones
+	}
+	return semitones
+}
@@ -0,0 +1,78 @@
+// The rules of four-part voice leading: each voice in its range, spaced no more than an octave from the next voice up,
+package satb
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestRule_String(t *testing.T) {
+	assert.Equal(t, "unvoiced", Unvoiced.String())
+	assert.Equal(t, "out of range", OutOfRange.String())
+	assert.Equal(t, "spacing", Spacing.String())
+	assert.Equal(t, "incomplete", Incomplete.String())
+	assert.Equal(t, "doubled leading tone", DoubledLeading.String())
+	assert.Equal(t, "parallel fifths", ParallelFifths.String())
+	assert.Equal(t, "parallel octaves", ParallelOctave.String())
+	assert.Equal(t, "", Rule(99).String())
+}
+
+func TestViolation_String(t *testing.T) {
+	assert.Equal(t, "incomplete at chord 1", Violation{Rule: Incomplete}.String())
+	assert.Equal(t, "out of range of bass at chord 2", Violation{Rule: OutOfRange, Chord: 1, Voices: []Voice{Bass}}.String())
+	assert.Equal(t, "parallel fifths between tenor and bass at chord 3", Violation{Rule: ParallelFifths, Chord: 2, Voices: []Voice{Tenor, Bass}}.String())
+}
+
+//
+// Private
+//
+
+func TestLeadingToneOf(t *testing.T) {
+	assert.Equal(t, note.B, leadingToneOf(key.Of("C")))
+	assert.Equal(t, note.Gs, leadingToneOf(key.Of("A minor")))
+	assert.Equal(t, note.E, leadingToneOf(key.Of("F")))
+	assert.Equal(t, note.Nil, leadingToneOf(key.Key{}))
+}
+
+func TestCandidatesOf(t *testing.T) {
+	candidates := candidatesOf(chord.Of("G7"), note.B)
+	assert.True(t, len(candidates) > 0)
+	for _, cd := range candidates {
+		for _, v := range cd.broken {
+			assert.Equal(t, DoubledLeading, v.Rule) // the only rule of a chord that a strict candidate may break
+		}
+		assert.Equal(t, note.G, note.Class(cd.notes[Bass]%12+1))
+	}
+	assert.Equal(t, 0, len(candidatesOf(chord.Chord{}, note.B)))
+}
+
+func TestScored(t *testing.T) {
+	tones := tonesOf(chord.Of("G"))
+	cd, complete := scored([4]int{71, 67, 59, 43}, tones, note.B) // B4 G4 B3 G2
+	assert.True(t, complete)
+	assert.Equal(t, []Violation{{Rule: DoubledLeading}}, cd.broken)
+	cd, complete = scored([4]int{67, 62, 55, 43}, tones, note.B) // G4 D4 G3 G2
+	assert.False(t, complete)
+	assert.Equal(t, []Violation{{Rule: Incomplete}}, cd.broken)
+	cd, complete = scored([4]int{86, 71, 62, 31}, tones, note.B) // D6 B4 D4 G1
+	assert.True(t, complete)
+	assert.Equal(t, []Violation{
+		{Rule: OutOfRange, Voices: []Voice{Soprano}},
+		{Rule: OutOfRange, Voices: []Voice{Bass}},
+		{Rule: Spacing, Voices: []Voice{Soprano, Alto}},
+	}, cd.broken)
+}
+
+func TestMotionViolations(t *testing.T) {
+	assert.Equal(t, []Violation{{Rule: ParallelFifths, Chord: 1, Voices: []Voice{Tenor, Bass}}},
+		motionViolations([4]int{72, 64, 55, 48}, [4]int{72, 65, 57, 50}, 1)) // C4-G3 to D3-A3
+	assert.Equal(t, []Violation{{Rule: ParallelOctave, Chord: 2, Voices: []Voice{Soprano, Bass}}},
+		motionViolations([4]int{72, 67, 64, 48}, [4]int{74, 65, 62, 50}, 2)) // C5 over C3 to D5 over D3
+	assert.Equal(t, 0, len(motionViolations([4]int{67, 64, 60, 48}, [4]int{67, 64, 60, 48}, 1)))
+	assert.Equal(t, 0, len(motionViolations([4]int{67, 64, 60, 48}, [4]int{69, 65, 60, 53}, 1)))
+}
@@ -0,0 +1,129 @@
+// A chorale is harmonized in four parts, soprano, alto, tenor and bass, each voice singing a tone of every chord,
+// within its range, moving as little as it can from one chord to the next, without parallel fifths or octaves.
+//
+// https://en.wikipedia.org/wiki/SATB
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package satb
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// Voice of a chorale, from the highest
+type Voice int
+
+// Voices of a chorale, from the highest
+const (
+	Soprano Voice = iota
+	Alto
+	Tenor
+	Bass
+)
+
+// Voices of a chorale, from the highest, e.g. to range over the notes of a voicing
+var Voices = []Voice{Soprano, Alto, Tenor, Bass}
+
+// MaxSpacing between adjacent upper voices, in semitones, i.e. an octave; the tenor may be any distance above the bass
+const MaxSpacing = 12
+
+// Range of a voice, from its lowest note to its highest, as MIDI note numbers, e.g. 60 for middle C
+type Range struct {
+	Low, High int
+}
+
+// Ranges of each voice, e.g. the soprano from middle C to the G an octave and a fifth above it
+var Ranges = map[Voice]Range{
+	Soprano: {60, 79}, // C4 to G5
+	Alto:    {55, 74}, // G3 to D5
+	Tenor:   {48, 67}, // C3 to G4
+	Bass:    {40, 60}, // E2 to C4
+}
+
+// Chorale of a progression in a key, a voicing of each chord, with any violation of the rules that couldn't be avoided
+type Chorale struct {
+	Key        key.Key
+	Voicings   []Voicing
+	Violations []Violation
+}
+
+// Voicing of a chord, the MIDI note number sung by each voice, e.g. 60 for middle C, indexed by Voice, or -1 if it couldn't be voiced
+type Voicing struct {
+	Chord chord.Chord
+	Notes [4]int
+}
+
+// Realize a progression as a chorale in a key, or if the key is Nil, the key of the progression, in root position, with each voice within its range,
+// no more than an octave between adjacent upper voices, every tone of each chord sung but its fifth, each voice moving as little as it can
+// from one chord to the next, avoiding parallel fifths and octaves and a doubled leading tone, else reporting each violation that couldn't be avoided
+func Realize(p progression.Progression, k key.Key) Chorale {
+	if k.Mode == key.Nil && len(p.Chords) > 0 {
+		k = p.Key()
+	}
+	c := Chorale{Key: k}
+	if len(p.Chords) == 0 {
+		return c
+	}
+	leading := leadingToneOf(k)
+	candidates := make([][]candidate, len(p.Chords))
+	for n, ch := range p.Chords {
+		candidates[n] = candidatesOf(ch, leading)
+	}
+	for n, i := range cheapest(candidates) {
+		v := Voicing{Chord: p.Chords[n], Notes: [4]int{-1, -1, -1, -1}}
+		if i >= 0 {
+			v.Notes = candidates[n][i].notes
+			c.Violations = append(c.Violations, candidates[n][i].violations(n)...)
+		} else {
+			c.Violations = append(c.Violations, Violation{Rule: Unvoiced, Chord: n})
+		}
+		if n > 0 && i >= 0 && c.Voicings[n-1].Notes[0] >= 0 {
+			c.Violations = append(c.Violations, motionViolations(c.Voicings[n-1].Notes, v.Notes, n)...)
+		}
+		c.Voicings = append(c.Voicings, v)
+	}
+	return c
+}
+
+// String of the Voice, e.g. "soprano"
+func (of Voice) String() string {
+	switch of {
+	case Soprano:
+		return "soprano"
+	case Alto:
+		return "alto"
+	case Tenor:
+		return "tenor"
+	case Bass:
+		return "bass"
+	}
+	return ""
+}
+
+// AdjSymbol to spell the notes of the Chorale, flats in a key with flats in its signature, else sharps
+func (c Chorale) AdjSymbol() note.AdjSymbol {
+	if c.Key.Fifths() < 0 {
+		return note.Flat
+	}
+	return note.Sharp
+}
+
+// Note sung by a voice, its pitch class and octave, or nil if it couldn't be voiced
+func (v Voicing) Note(voice Voice) *note.Note {
+	number := v.Notes[voice]
+	if number < 0 {
+		return nil
+	}
+	return &note.Note{Class: note.Class(number%12 + 1), Octave: note.Octave(number/12 - 1)}
+}
@@ -0,0 +1,95 @@
+// A chorale is harmonized in four parts, soprano, alto, tenor and bass, each voice singing a tone of every chord,
+package satb
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestRealize(t *testing.T) {
+	c := Realize(progression.Of("C", "F", "G7", "C"), key.Of("C"))
+	assert.Equal(t, key.Of("C"), c.Key)
+	assert.Equal(t, 4, len(c.Voicings))
+	assert.Equal(t, [4]int{67, 64, 60, 48}, c.Voicings[0].Notes)
+	assert.Equal(t, [4]int{69, 65, 60, 53}, c.Voicings[1].Notes)
+	assert.Equal(t, [4]int{67, 65, 59, 55}, c.Voicings[2].Notes)
+	assert.Equal(t, [4]int{67, 64, 60, 60}, c.Voicings[3].Notes)
+	assert.Equal(t, 0, len(c.Violations))
+}
+
+func TestRealize_Rules(t *testing.T) {
+	for _, names := range [][]string{
+		{"C", "Am", "Dm", "G7", "C"},
+		{"Dm7", "G7", "Cmaj7"},
+		{"Am", "Dm", "E7", "Am"},
+		{"Bb", "Eb", "F7", "Bb"},
+		{"C", "D", "G", "C"},
+	} {
+		c := Realize(progression.Of(names...), key.Key{})
+		assert.Equal(t, 0, len(c.Violations), "%v: %v", names, c.Violations)
+		for n, v := range c.Voicings {
+			for _, voice := range Voices {
+				assert.True(t, inRange(voice, v.Notes[voice]), "%v: %s of chord %d", names, voice, n+1)
+			}
+			assert.Equal(t, v.Chord.Root, v.Note(Bass).Class, "%v: bass of chord %d", names, n+1)
+			assert.True(t, v.Notes[Soprano] >= v.Notes[Alto] && v.Notes[Alto] >= v.Notes[Tenor] && v.Notes[Tenor] >= v.Notes[Bass], "%v: order of chord %d", names, n+1)
+			if n > 0 {
+				assert.Equal(t, 0, len(motionViolations(c.Voicings[n-1].Notes, v.Notes, n)), "%v: parallels to chord %d", names, n+1)
+			}
+		}
+	}
+}
+
+func TestRealize_KeyOfProgression(t *testing.T) {
+	c := Realize(progression.Of("Am", "E", "Am"), key.Key{})
+	assert.Equal(t, key.Of("A minor"), c.Key)
+	assert.Equal(t, note.Gs, c.Voicings[1].Note(Soprano).Class) // G#, the leading tone, in the soprano
+}
+
+func TestRealize_Violation(t *testing.T) {
+	c := Realize(progression.Of("C13"), key.Of("C"))
+	assert.Equal(t, 1, len(c.Voicings))
+	assert.Equal(t, []Violation{{Rule: Incomplete, Chord: 0}}, c.Violations)
+}
+
+func TestRealize_Unvoiced(t *testing.T) {
+	c := Realize(progression.Progression{Chords: []chord.Chord{chord.Of("C"), {}}}, key.Of("C"))
+	assert.Equal(t, 2, len(c.Voicings))
+	assert.Equal(t, [4]int{-1, -1, -1, -1}, c.Voicings[1].Notes)
+	assert.Nil(t, c.Voicings[1].Note(Soprano))
+	assert.Equal(t, []Violation{{Rule: Unvoiced, Chord: 1}}, c.Violations)
+}
+
+func TestRealize_Empty(t *testing.T) {
+	c := Realize(progression.Of(), key.Key{})
+	assert.Equal(t, 0, len(c.Voicings))
+	assert.Equal(t, 0, len(c.Violations))
+}
+
+func TestVoice_String(t *testing.T) {
+	assert.Equal(t, "soprano", Soprano.String())
+	assert.Equal(t, "alto", Alto.String())
+	assert.Equal(t, "tenor", Tenor.String())
+	assert.Equal(t, "bass", Bass.String())
+	assert.Equal(t, "", Voice(4).String())
+}
+
+func TestChorale_AdjSymbol(t *testing.T) {
+	assert.Equal(t, note.Sharp, Chorale{Key: key.Of("E minor")}.AdjSymbol())
+	assert.Equal(t, note.Flat, Chorale{Key: key.Of("F")}.AdjSymbol())
+	assert.Equal(t, note.Sharp, Chorale{}.AdjSymbol())
+}
+
+func TestVoicing_Note(t *testing.T) {
+	v := Voicing{Notes: [4]int{72, 64, 55, 36}}
+	assert.Equal(t, &note.Note{Class: note.C, Octave: 5}, v.Note(Soprano))
+	assert.Equal(t, &note.Note{Class: note.E, Octave: 4}, v.Note(Alto))
+	assert.Equal(t, &note.Note{Class: note.G, Octave: 3}, v.Note(Tenor))
+	assert.Equal(t, &note.Note{Class: note.C, Octave: 2}, v.Note(Bass))
+}
@@ -0,0 +1,74 @@
+// Chorales are expressed with their Key, the notes sung by each voice of every chord, and any Violations of the rules
+package satb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ToYAML of the Chorale, e.g. for the command-line utility
+func (c Chorale) ToYAML() string {
+	spec := specFrom(c)
+	out, _ := yaml.Marshal(spec)
+	return string(out[:])
+}
+
+// ToJSON of the Chorale, e.g. for a web app
+func (c Chorale) ToJSON() string {
+	spec := specFrom(c)
+	out, _ := json.Marshal(spec)
+	return string(out[:])
+}
+
+//
+// Private
+//
+
+func specFrom(c Chorale) specChorale {
+	s := specChorale{}
+	s.Key.Root = c.Key.Root.String(c.Key.AdjSymbol)
+	s.Key.Mode = c.Key.Mode.String()
+	adj := c.AdjSymbol()
+	s.Voicings = make([]specVoicing, 0, len(c.Voicings))
+	for _, v := range c.Voicings {
+		sv := specVoicing{Root: v.Chord.Root.String(v.Chord.AdjSymbol)}
+		sv.Soprano, sv.Alto, sv.Tenor, sv.Bass = nameOf(v, Soprano, adj), nameOf(v, Alto, adj), nameOf(v, Tenor, adj), nameOf(v, Bass, adj)
+		s.Voicings = append(s.Voicings, sv)
+	}
+	for _, v := range c.Violations {
+		s.Violations = append(s.Violations, v.String())
+	}
+	return s
+}
+
+// nameOf the note sung by a voice, e.g. "C4", or empty if it couldn't be voiced
+func nameOf(v Voicing, voice Voice, adj note.AdjSymbol) string {
+	n := v.Note(voice)
+	if n == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s%d", n.Class.String(adj), n.Octave)
+}
+
+type specChorale struct {
+	Key        specKey       `json:"key"`
+	Voicings   []specVoicing `json:"voicings"`
+	Violations []string      `json:"violations,omitempty" yaml:"violations,omitempty"`
+}
+
+type specKey struct {
+	Root string `json:"root"`
+	Mode string `json:"mode"`
+}
+
+type specVoicing struct {
+	Root    string `json:"root"`
+	Soprano string `json:"soprano"`
+	Alto    string `json:"alto"`
+	Tenor   string `json:"tenor"`
+	Bass    string `json:"bass"`
+}
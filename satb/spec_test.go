@@ -0,0 +1,24 @@
+// Chorales are expressed with their Key, the notes sung by each voice of every chord, and any Violations of the rules
+package satb
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+func TestChorale_ToYAML(t *testing.T) {
+	c := Realize(progression.Of("F", "C7", "F"), key.Of("F"))
+	assert.Equal(t, "key:\n  root: F\n  mode: Major\nvoicings:\n"+
+		"- root: F\n  soprano: C4\n  alto: A3\n  tenor: F3\n  bass: F3\n"+
+		"- root: C\n  soprano: C4\n  alto: Bb3\n  tenor: E3\n  bass: C3\n"+
+		"- root: F\n  soprano: C4\n  alto: A3\n  tenor: F3\n  bass: F3\n", c.ToYAML())
+}
+
+func TestChorale_ToJSON(t *testing.T) {
+	c := Realize(progression.Of("C13"), key.Of("C"))
+	assert.Equal(t, `{"key":{"root":"C","mode":"Major"},"voicings":[{"root":"C","soprano":"F4","alto":"A3","tenor":"D3","bass":"C3"}],"violations":["incomplete at chord 1"]}`, c.ToJSON())
+}
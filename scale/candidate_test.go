@@ -0,0 +1,38 @@
+// Scales are detected of some tones as ranked candidates, each found by a search, with its confidence among all those found
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/candidate"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestCandidates(t *testing.T) {
+	candidates := Candidates(SearchOptions{Tones: toneset.Of(note.C, note.E, note.G, note.A, note.D)})
+	assert.Equal(t, 20, len(candidates))
+	assert.Equal(t, "C major", candidates[0].Value.Name)
+	assert.Equal(t, "A minor", candidates[15].Value.Name)
+	assert.InDelta(t, 0.05, candidates[0].Confidence, 1e-9) // each of the pentatonic is in as many scales
+	assert.Equal(t, candidates[0].Confidence, candidates[15].Confidence)
+}
+
+func TestCandidates_Exact(t *testing.T) {
+	candidates := Candidates(SearchOptions{Tones: Of("D dorian").ToneSet(), Exact: true})
+	assert.Equal(t, []string{"C major", "D dorian", "E phrygian", "F lydian", "G mixolydian", "A minor", "B locrian"}, namesOf(candidates))
+	assert.InDelta(t, 1.0/7, candidates[0].Confidence, 1e-9)
+}
+
+//
+// Private
+//
+
+func namesOf(candidates []candidate.Candidate[Found]) (names []string) {
+	for _, c := range candidates {
+		names = append(names, c.Value.Name)
+	}
+	return
+}
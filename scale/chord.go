@@ -0,0 +1,90 @@
+// Chords are built on a degree of a scale, a triad, seventh or ninth chord stacked in thirds of only the tones of the scale, e.g. the ii7 of a major scale
+package scale
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/interval"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ChordSize of a chord built on a degree of a scale, the number of its tones stacked in thirds
+type ChordSize int
+
+const (
+	Triad   ChordSize = 3
+	Seventh ChordSize = 4
+	Ninth   ChordSize = 5
+)
+
+// String of the chord size, e.g. "seventh"
+func (of ChordSize) String() string {
+	switch of {
+	case Triad:
+		return "triad"
+	case Seventh:
+		return "seventh"
+	case Ninth:
+		return "ninth"
+	}
+	return ""
+}
+
+// ChordAt a degree of the scale, from 1 of its root, the chord of a size stacked in thirds of only its tones, every other tone of the scale ascending from the degree,
+// spelled with the accidental of the scale, but for a root sharp or flat of the letter of its degree, e.g. Dm7 of Of("C major").ChordAt(2, Seventh), E7 of Of("A harmonic minor").ChordAt(5, Seventh) or G#dim7 of its ChordAt(7, Seventh),
+// or a chord of the Root Nil of a degree or size the scale doesn't have
+func (this Scale) ChordAt(degree int, size ChordSize) chord.Chord {
+	var classes []note.Class
+	for _, t := range this.OrderedTones() {
+		if t.Class != note.Nil {
+			classes = append(classes, t.Class)
+		}
+	}
+	if degree < 1 || degree > len(classes) || size < Triad || size > Ninth {
+		return chord.Chord{}
+	}
+	root := classes[degree-1]
+	adj := this.adjSymbolAt(degree, root, len(classes))
+	c := chord.Chord{Root: root, AdjSymbol: adj, Tones: make([]chord.Tone, 0, int(size))}
+	semitones, prev := 0, root
+	for n := 0; n < int(size); n++ {
+		class := classes[(degree-1+2*n)%len(classes)]
+		semitones += (prev.Diff(class) + 12) % 12
+		i := chord.Interval(2*n + 1)
+		d := chord.Degree{Interval: i}
+		d.Alteration = chord.Alteration(semitones - d.Semitones())
+		c.Tones = append(c.Tones, chord.Tone{Degree: d, Class: class, Name: interval.NameOf(2*n+1, semitones)})
+		prev = class
+	}
+	if parsed := chord.Of(c.Name()); parsed.Root == c.Root && parsed.ToneSet().Equal(c.ToneSet()) {
+		parsed.AdjSymbol = adj
+		return parsed
+	}
+	return c
+}
+
+//
+// Private
+//
+
+// letters of the names of notes, in order up from C
+const letters = "CDEFGAB"
+
+// adjSymbolAt a degree of a scale of seven tones, the accidental of the root of a chord on it, named on the letter of the degree, e.g. Sharp of the G# on the 7th of A harmonic minor,
+// or else the accidental of the scale, of a root natural on its letter or of a scale of more or fewer tones
+func (this Scale) adjSymbolAt(degree int, root note.Class, size int) note.AdjSymbol {
+	name := this.Root.String(this.AdjSymbol)
+	if size != len(letters) || name == "" {
+		return this.AdjSymbol
+	}
+	letter := letters[(strings.IndexByte(letters, name[0])+degree-1)%len(letters)]
+	natural, _ := note.ClassNamed(string(letter))
+	switch (natural.Diff(root) + 12) % 12 {
+	case 1:
+		return note.Sharp
+	case 11:
+		return note.Flat
+	}
+	return this.AdjSymbol
+}
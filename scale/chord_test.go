@@ -0,0 +1,66 @@
+// Chords are built on a degree of a scale, a triad, seventh or ninth chord stacked in thirds of only the tones of the scale, e.g. the ii7 of a major scale
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestScale_ChordAt(t *testing.T) {
+	s := Of("C major")
+	assert.Equal(t, []string{"C", "Dm", "Em", "F", "G", "Am", "Bdim"}, chordNamesAt(s, Triad))
+	assert.Equal(t, []string{"CM7", "Dm7", "Em7", "FM7", "G7", "Am7", "Bm7b5"}, chordNamesAt(s, Seventh))
+	assert.Equal(t, []string{"CM9", "Dm9", "Em7b9", "FM9", "G9", "Am9", "Bm7b5b9"}, chordNamesAt(s, Ninth))
+	assert.Equal(t, chord.Of("Dm7").Tones, s.ChordAt(2, Seventh).Tones)
+}
+
+func TestScale_ChordAt_Modes(t *testing.T) {
+	assert.Equal(t, "E7", Of("A harmonic minor").ChordAt(5, Seventh).Name())
+	assert.Equal(t, "CaugM7", Of("A harmonic minor").ChordAt(3, Seventh).Name())
+	assert.Equal(t, "Dm9", Of("D dorian").ChordAt(1, Ninth).Name())
+	var names []string
+	for _, t := range Of("E phrygian").ChordAt(1, Ninth).Tones {
+		names = append(names, t.Name)
+	}
+	assert.Equal(t, []string{"P1", "m3", "P5", "m7", "b9"}, names)
+}
+
+func TestScale_ChordAt_Spelling(t *testing.T) {
+	c := Of("Eb major").ChordAt(5, Seventh)
+	assert.Equal(t, "Bb7", c.Name())
+	assert.Equal(t, note.Flat, c.AdjSymbol)
+	c = Of("A harmonic minor").ChordAt(7, Seventh)
+	assert.Equal(t, "G#dim7", c.Name())
+	assert.Equal(t, note.Sharp, c.AdjSymbol)
+	assert.Equal(t, "G#dim", Of("A harmonic minor").ChordAt(7, Triad).Name())
+	assert.Equal(t, "BbM7", Of("D phrygian").ChordAt(6, Seventh).Name())
+}
+
+func TestScale_ChordAt_Invalid(t *testing.T) {
+	assert.Equal(t, note.Nil, Of("C major").ChordAt(0, Triad).Root)
+	assert.Equal(t, note.Nil, Of("C major").ChordAt(8, Triad).Root)
+	assert.Equal(t, note.Nil, Of("C major").ChordAt(1, ChordSize(6)).Root)
+	assert.Equal(t, note.Nil, Scale{}.ChordAt(1, Triad).Root)
+}
+
+func TestChordSize_String(t *testing.T) {
+	assert.Equal(t, "triad", Triad.String())
+	assert.Equal(t, "seventh", Seventh.String())
+	assert.Equal(t, "ninth", Ninth.String())
+	assert.Equal(t, "", ChordSize(2).String())
+}
+
+//
+// Private
+//
+
+func chordNamesAt(s Scale, size ChordSize) (names []string) {
+	for degree := 1; degree <= 7; degree++ {
+		names = append(names, s.ChordAt(degree, size).Name())
+	}
+	return
+}
@@ -0,0 +1,67 @@
+// Two scales are compared by the tones they share and those only one has, and the pivot chords diatonic to both, e.g. to plan a modulation from one to the other
+package scale
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Comparison of two scales, by the tones they share and those only in the first or only in the second,
+// each in the order of the tones of the first scale, or of the second of the tones only in it, and the pivot chords diatonic to both
+type Comparison struct {
+	Shared []note.Class
+	OnlyA  []note.Class
+	OnlyB  []note.Class
+	Pivots []Pivot
+}
+
+// Pivot chord diatonic to two scales, by the degree of its root in each, from 1 of the root of the scale, e.g. Dm, 2 of C major and 4 of A harmonic minor
+type Pivot struct {
+	Chord   chord.Chord
+	DegreeA int
+	DegreeB int
+}
+
+// Compare two scales, e.g. Compare(scale.Of("C major"), scale.Of("A harmonic minor")), the tones they share and those only one has,
+// and the triads and seventh chords on each of the shared tones that are diatonic to both, spelled as the first scale is, in the order of its degrees
+func Compare(a, b Scale) Comparison {
+	var c Comparison
+	bTones := b.ToneSet()
+	aTones := a.ToneSet()
+	degreesB := degreeNumbersOf(b)
+	for degree, t := range a.OrderedTones() {
+		if !bTones.Contains(t.Class) {
+			c.OnlyA = append(c.OnlyA, t.Class)
+			continue
+		}
+		c.Shared = append(c.Shared, t.Class)
+		for _, symbol := range pivotSymbols {
+			ch := chord.Of(t.Class.String(a.AdjSymbol) + symbol)
+			if a.ContainsChord(ch) && b.ContainsChord(ch) {
+				c.Pivots = append(c.Pivots, Pivot{Chord: ch, DegreeA: degree + 1, DegreeB: degreesB[t.Class]})
+			}
+		}
+	}
+	for _, t := range b.OrderedTones() {
+		if !aTones.Contains(t.Class) {
+			c.OnlyB = append(c.OnlyB, t.Class)
+		}
+	}
+	return c
+}
+
+//
+// Private
+//
+
+// pivotSymbols of the triads and seventh chords tried on each shared tone, after its root
+var pivotSymbols = []string{"", "m", "dim", "aug", "7", "M7", "m7", "m7b5", "dim7", "mM7", "aug7"}
+
+// degreeNumbersOf the tones of a scale, from 1 of its root
+func degreeNumbersOf(s Scale) map[note.Class]int {
+	degrees := make(map[note.Class]int)
+	for degree, t := range s.OrderedTones() {
+		degrees[t.Class] = degree + 1
+	}
+	return degrees
+}
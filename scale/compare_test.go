@@ -0,0 +1,44 @@
+// Two scales are compared by the tones they share and those only one has, and the pivot chords diatonic to both, e.g. to plan a modulation from one to the other
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestCompare(t *testing.T) {
+	c := Compare(Of("C major"), Of("A harmonic minor"))
+	assert.Equal(t, []note.Class{note.C, note.D, note.E, note.F, note.A, note.B}, c.Shared)
+	assert.Equal(t, []note.Class{note.G}, c.OnlyA)
+	assert.Equal(t, []note.Class{note.Gs}, c.OnlyB)
+	var names []string
+	for _, p := range c.Pivots {
+		names = append(names, p.Chord.Name())
+	}
+	assert.Equal(t, []string{"Dm", "Dm7", "F", "FM7", "Am", "Bdim", "Bm7b5"}, names)
+	assert.Equal(t, 2, c.Pivots[0].DegreeA)
+	assert.Equal(t, 4, c.Pivots[0].DegreeB)
+	assert.Equal(t, 6, c.Pivots[4].DegreeA)
+	assert.Equal(t, 1, c.Pivots[4].DegreeB)
+}
+
+func TestCompare_Relative(t *testing.T) {
+	c := Compare(Of("Eb major"), Of("C minor"))
+	assert.Equal(t, 7, len(c.Shared))
+	assert.Empty(t, c.OnlyA)
+	assert.Empty(t, c.OnlyB)
+	assert.Equal(t, 14, len(c.Pivots))
+	assert.Equal(t, "Eb", c.Pivots[0].Chord.Name())
+	assert.Equal(t, 3, c.Pivots[0].DegreeB)
+}
+
+func TestCompare_Distant(t *testing.T) {
+	c := Compare(Of("C major"), Of("F# major"))
+	assert.Equal(t, []note.Class{note.F, note.B}, c.Shared)
+	assert.Equal(t, 5, len(c.OnlyA))
+	assert.Equal(t, 5, len(c.OnlyB))
+	assert.Empty(t, c.Pivots)
+}
@@ -0,0 +1,29 @@
+// Membership of pitch classes and chords in a scale, regardless of their spelling
+package scale
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+// ToneSet of the pitch classes in the scale
+func (this Scale) ToneSet() toneset.Set {
+	s := toneset.Of()
+	for _, class := range this.Tones {
+		if class != note.Nil {
+			s[class] = true
+		}
+	}
+	return s
+}
+
+// Contains the pitch class, e.g. the scale "C minor" contains both D# and Eb
+func (this Scale) Contains(class note.Class) bool {
+	return this.ToneSet().Contains(class)
+}
+
+// ContainsChord if every tone of the chord is in the scale, i.e. the chord is diatonic to the scale
+func (this Scale) ContainsChord(c chord.Chord) bool {
+	return this.ToneSet().ContainsAll(c.ToneSet())
+}
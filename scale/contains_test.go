@@ -0,0 +1,42 @@
+// Membership of pitch classes and chords in a scale, regardless of their spelling
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestScale_ToneSet(t *testing.T) {
+	assert.Equal(t, toneset.Of(note.C, note.D, note.E, note.F, note.G, note.A, note.B), Of("C major").ToneSet())
+}
+
+func TestScale_Contains(t *testing.T) {
+	s := Of("C minor")
+	eb, _ := note.ClassNamed("Eb")
+	dSharp, _ := note.ClassNamed("D#")
+	assert.True(t, s.Contains(eb))
+	assert.True(t, s.Contains(dSharp))
+	assert.False(t, s.Contains(note.E))
+	assert.False(t, s.Contains(note.Nil))
+}
+
+func TestScale_ContainsChord(t *testing.T) {
+	s := Of("C major")
+	assert.True(t, s.ContainsChord(chord.Of("C")))
+	assert.True(t, s.ContainsChord(chord.Of("D minor 7")))
+	assert.True(t, s.ContainsChord(chord.Of("G7")))
+	assert.False(t, s.ContainsChord(chord.Of("D")))
+	assert.False(t, s.ContainsChord(chord.Of("C minor")))
+}
+
+func TestScale_ToneSet_Operations(t *testing.T) {
+	major, minor := Of("C major").ToneSet(), Of("C minor").ToneSet()
+	assert.Equal(t, toneset.Of(note.C, note.D, note.F, note.G), major.Intersection(minor))
+	assert.Equal(t, toneset.Of(note.E, note.A, note.B), major.Difference(minor))
+	assert.Equal(t, 10, len(major.Union(minor)))
+}
@@ -0,0 +1,51 @@
+// Scales are equal if they have the same root, spelling and tones, or equivalent if they share their pitch classes, e.g. a mode and its relative major,
+// and have a canonical form and a stable hash, e.g. to use as a map key or to deduplicate scales.
+package scale
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Equal to another scale, with the same root, spelling, and tone at each interval
+func (this Scale) Equal(other Scale) bool {
+	if this.Root != other.Root || this.AdjSymbol != other.AdjSymbol || len(this.Tones) != len(other.Tones) {
+		return false
+	}
+	for i, class := range this.Tones {
+		if otherClass, ok := other.Tones[i]; !ok || otherClass != class {
+			return false
+		}
+	}
+	return true
+}
+
+// EquivalentTo another scale, with the same pitch classes, regardless of their spelling or root, e.g. C major and A minor
+func (this Scale) EquivalentTo(other Scale) bool {
+	return this.ToneSet().Equal(other.ToneSet())
+}
+
+// Canonical form of the scale, its root, then each of its tones by interval, in order, spelled with its sharps or flats,
+// the same for any two scales that are Equal, e.g. "C: 1=C 2=D 3=E 4=F 5=G 6=A 7=B"
+func (this Scale) Canonical() string {
+	intervals := make([]int, 0, len(this.Tones))
+	for i := range this.Tones {
+		intervals = append(intervals, int(i))
+	}
+	sort.Ints(intervals)
+	var b strings.Builder
+	b.WriteString(this.Root.String(this.AdjSymbol) + ":")
+	for _, i := range intervals {
+		b.WriteString(" " + strconv.Itoa(i) + "=" + this.Tones[Interval(i)].String(this.AdjSymbol))
+	}
+	return b.String()
+}
+
+// Hash of the Canonical form of the scale, stable from one run to the next, e.g. to use as a map key
+func (this Scale) Hash() uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(this.Canonical()))
+	return h.Sum64()
+}
@@ -0,0 +1,31 @@
+// Scales are equal if they have the same root, spelling and tones, or equivalent if they share their pitch classes, e.g. a mode and its relative major,
+// and have a canonical form and a stable hash, e.g. to use as a map key or to deduplicate scales.
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestScale_Equal(t *testing.T) {
+	assert.True(t, Of("C major").Equal(Of("C ionian")))
+	assert.False(t, Of("C major").Equal(Of("A minor")))
+	assert.False(t, Of("C# major").Equal(Of("Db major")))
+}
+
+func TestScale_EquivalentTo(t *testing.T) {
+	assert.True(t, Of("C major").EquivalentTo(Of("A minor")))
+	assert.True(t, Of("C# major").EquivalentTo(Of("Db major")))
+	assert.False(t, Of("C major").EquivalentTo(Of("C minor")))
+}
+
+func TestScale_Canonical(t *testing.T) {
+	assert.Equal(t, "C: 1=C 2=D 3=E 4=F 5=G 6=A 7=B", Of("C major").Canonical())
+	assert.Equal(t, "F: 1=F 2=G 3=Ab 4=Bb 5=C 6=Db 7=Eb", Of("F minor").Canonical())
+}
+
+func TestScale_Hash(t *testing.T) {
+	assert.Equal(t, Of("C major").Hash(), Of("C ionian").Hash())
+	assert.NotEqual(t, Of("C major").Hash(), Of("A minor").Hash())
+}
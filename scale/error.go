@@ -0,0 +1,36 @@
+// Scale names that cannot be parsed are described by a ParseError, e.g. for an unknown root or mode
+package scale
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnknownRoot when a scale name doesn't begin with a note, e.g. "H minor"
+	ErrUnknownRoot = errors.New("unknown root")
+
+	// ErrUnknownMode when some word of a scale name isn't matched by any mode, e.g. the "jams" of "C jams"
+	ErrUnknownMode = errors.New("unknown mode")
+
+	// ErrAmbiguous when parsing strictly, and a mode matches from the middle of a word, or two modes match different parts with different intervals, e.g. "C dorian lydian"
+	ErrAmbiguous = errors.New("ambiguous modes")
+)
+
+// ParseError of a scale name, at the position of the text that could not be parsed
+type ParseError struct {
+	Name     string // scale name that was parsed
+	Position int    // of the text that could not be parsed, in bytes from the beginning of the name
+	Text     string // that could not be parsed
+	Err      error  // ErrUnknownRoot, ErrUnknownMode or ErrAmbiguous
+}
+
+// Error message, e.g. `unknown mode "jams" at position 2 of scale "C jams"`
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v %q at position %d of scale %q", e.Err, e.Text, e.Position, e.Name)
+}
+
+// Unwrap to the underlying error, e.g. for errors.Is(err, ErrUnknownMode)
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
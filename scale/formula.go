@@ -0,0 +1,80 @@
+// The formula of a scale is its pattern of whole and half steps, e.g. W W H W W W H, and the interval from the root of each of its tones, e.g. 1 2 3 4 5 6 7
+package scale
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/interval"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Formula of a scale, the steps from each of its tones to the next and up to the octave, and the degree of each tone counted up the major scale,
+// e.g. "W H W W H WH H" and "1 2 b3 4 5 b6 7" of a harmonic minor scale
+type Formula struct {
+	Steps     string `json:"steps"`     // each whole (W) or half (H) step, or a wider one of both, e.g. WH of an augmented second
+	Intervals string `json:"intervals"` // from the root, altered from the major scale, e.g. b3 of a minor third
+}
+
+// Formula of the scale, e.g. "W W H W W W H" and "1 2 3 4 5 6 7" of C major
+func (this Scale) Formula() Formula {
+	var tones []Tone
+	for _, t := range this.OrderedTones() {
+		if t.Class != note.Nil {
+			tones = append(tones, t)
+		}
+	}
+	var steps, intervals []string
+	for n, t := range tones {
+		semitones := semitonesBetween(this.Root, t)
+		next := 12
+		if n+1 < len(tones) {
+			next = semitonesBetween(this.Root, tones[n+1])
+		}
+		steps = append(steps, stepNameOf(next-semitones))
+		intervals = append(intervals, formulaIntervalOf(t.Interval, semitones))
+	}
+	return Formula{Steps: strings.Join(steps, " "), Intervals: strings.Join(intervals, " ")}
+}
+
+//
+// Private
+//
+
+// semitonesBetween the root of a scale and a tone, within the octave, e.g. 3 of the minor third of C minor
+func semitonesBetween(root note.Class, t Tone) int {
+	return ((int(t.Class)-int(root))%12 + 12) % 12
+}
+
+// stepNameOf a number of semitones, e.g. H of 1, W of 2, or WH of 3, else the number, e.g. 0 of a tone that repeats the one before it
+func stepNameOf(semitones int) string {
+	if semitones < 1 {
+		return strconv.Itoa(semitones)
+	}
+	name := strings.Repeat("W", semitones/2)
+	if semitones%2 == 1 {
+		name += "H"
+	}
+	return name
+}
+
+// formulaIntervalOf a tone by its interval and semitones from the root, altered by a semitone at most from the major scale, e.g. b3 of 3 semitones,
+// else named by its semitones alone, e.g. 6 of the 7th tone of a diminished scale, or 7 of its 8th
+func formulaIntervalOf(i Interval, semitones int) string {
+	number := int(i)
+	if number < 1 || number > 7 {
+		return semitoneFormulaIntervals[semitones]
+	}
+	switch semitones - interval.MajorSemitones[number-1] {
+	case -1:
+		return "b" + strconv.Itoa(number)
+	case 0:
+		return strconv.Itoa(number)
+	case 1:
+		return "#" + strconv.Itoa(number)
+	}
+	return semitoneFormulaIntervals[semitones]
+}
+
+// semitoneFormulaIntervals of the tones within the octave by their semitones from the root
+var semitoneFormulaIntervals = []string{"1", "b2", "2", "b3", "3", "4", "b5", "5", "b6", "6", "b7", "7"}
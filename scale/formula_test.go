@@ -0,0 +1,41 @@
+// The formula of a scale is its pattern of whole and half steps, e.g. W W H W W W H, and the interval from the root of each of its tones, e.g. 1 2 3 4 5 6 7
+package scale
+
+import (
+	"fmt"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/schema"
+)
+
+func TestScale_Formula(t *testing.T) {
+	for name, expect := range map[string]Formula{
+		"C major":          {Steps: "W W H W W W H", Intervals: "1 2 3 4 5 6 7"},
+		"A minor":          {Steps: "W H W W H W W", Intervals: "1 2 b3 4 5 b6 b7"},
+		"A harmonic minor": {Steps: "W H W W H WH H", Intervals: "1 2 b3 4 5 b6 7"},
+		"D dorian":         {Steps: "W H W W W H W", Intervals: "1 2 b3 4 5 6 b7"},
+		"E phrygian":       {Steps: "H W W W H W W", Intervals: "1 b2 b3 4 5 b6 b7"},
+		"F lydian":         {Steps: "W W W H W W H", Intervals: "1 2 3 #4 5 6 7"},
+		"B locrian":        {Steps: "H W W H W W W", Intervals: "1 b2 b3 4 b5 b6 b7"},
+		"C aug":            {Steps: "WH H WH H WH H", Intervals: "1 #2 3 5 #5 7"},
+		"C dim":            {Steps: "W H W H W H W H", Intervals: "1 2 b3 4 b5 b6 6 7"},
+	} {
+		assert.Equal(t, expect, Of(name).Formula(), fmt.Sprintf("name:%v", name))
+	}
+	assert.Equal(t, Formula{}, Scale{}.Formula())
+}
+
+func TestScale_Formula_ToYAMLSchema(t *testing.T) {
+	assert.Contains(t, Of("A harmonic minor").ToYAMLSchema(schema.V2), "formula:\n  steps: W H W W H WH H\n  intervals: 1 2 b3 4 5 b6 7\n")
+	assert.NotContains(t, Of("A harmonic minor").ToYAML(), "formula", "version 1 never changes")
+}
+
+func TestStepNameOf(t *testing.T) {
+	assert.Equal(t, "H", stepNameOf(1))
+	assert.Equal(t, "W", stepNameOf(2))
+	assert.Equal(t, "WH", stepNameOf(3))
+	assert.Equal(t, "WW", stepNameOf(4))
+	assert.Equal(t, "0", stepNameOf(0))
+}
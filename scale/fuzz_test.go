@@ -0,0 +1,79 @@
+//go:build go1.18
+// +build go1.18
+
+// Scales are parsed from any name without panicking, e.g. from the arguments of the command line or the query of a request
+package scale
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-music-theory/music-theory/locale"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func FuzzParse(f *testing.F) {
+	for _, name := range benchmarkNames {
+		f.Add(name)
+	}
+	f.Add("C jams")
+	f.Add("B♭ harmonic minor")
+	f.Add("H moll")
+	f.Add("Fis dorian")
+	f.Add("Sol mixolydian")
+	f.Fuzz(func(t *testing.T, name string) {
+		for _, options := range [][]Option{nil, {WithStrict()}} {
+			s, err := Parse(name, options...)
+			assertParsed(t, name, s, err)
+		}
+		for _, l := range []locale.Locale{locale.German, locale.Solfege} {
+			s, err := Parse(name, WithLocale(l))
+			var parseError *ParseError
+			if errors.As(err, &parseError) && (parseError.Position < 0 || parseError.Position > len(name)) {
+				t.Errorf("name:%q locale:%v position %d out of range", name, l, parseError.Position)
+			}
+			assertTones(t, name, s)
+		}
+	})
+}
+
+//
+// Private
+//
+
+// assertParsed scale has a root and valid tones, or else an error at a position of the name, of the text found there
+func assertParsed(t *testing.T, name string, s Scale, err error) {
+	assertTones(t, name, s)
+	if err == nil {
+		if s.Root == note.Nil {
+			t.Errorf("name:%q parsed without a root", name)
+		}
+		return
+	}
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("name:%q error %v is not a *ParseError", name, err)
+	}
+	if parseError.Position < 0 || parseError.Position > len(name) || !strings.HasPrefix(name[parseError.Position:], parseError.Text) {
+		t.Errorf("name:%q error %v is not of the text at its position", name, err)
+	}
+}
+
+// assertTones of a scale with a root are all note classes; a scale without one, of an unknown root, has none to step its tones from
+func assertTones(t *testing.T, name string, s Scale) {
+	if s.Root == note.Nil {
+		return
+	}
+	for i, class := range s.Tones {
+		if class < note.C || class > note.B {
+			t.Errorf("name:%q tone %v is %v", name, i, class)
+		}
+		if _, ok := s.ToneInterval[i]; !ok {
+			t.Errorf("name:%q tone %v has no interval", name, i)
+		}
+	}
+	if s.Root < note.C || s.Root > note.B {
+		t.Errorf("name:%q root is %d", name, int(s.Root))
+	}
+}
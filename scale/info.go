@@ -0,0 +1,199 @@
+// Scale modes are catalogued by their alternate names, the parent scale they're a mode of, their characteristic degrees, typical genres, and notes on them from other traditions, e.g. ModeInfo("Dorian")
+package scale
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/symbol"
+)
+
+// Info about a scale mode, its name, the aliases it's also known by, the parent scale it's a mode of from a degree, e.g. the 2nd of Major of Dorian,
+// the characteristic degrees that set it apart from the major or minor scale, e.g. 6 of Dorian, its typical genres, and notes on it, e.g. its names in other traditions
+type Info struct {
+	Name           string   `yaml:"name" json:"name"`
+	Aliases        []string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+	Parent         string   `yaml:"parent,omitempty" json:"parent,omitempty"`
+	Degree         int      `yaml:"degree,omitempty" json:"degree,omitempty"`
+	Characteristic []string `yaml:"characteristic,omitempty" json:"characteristic,omitempty"`
+	Genres         []string `yaml:"genres,omitempty" json:"genres,omitempty"`
+	Notes          string   `yaml:"notes,omitempty" json:"notes,omitempty"`
+}
+
+// ModeInfo of a known mode, by its name or any of its aliases, regardless of case, spaces or dashes, e.g. "Melodic Minor Ascend", "jazz minor" or "Jazz-Minor",
+// but an alias of its exact case first, e.g. "M" of Major and "m" of Minor; a registered mode is known only by its name
+func ModeInfo(name string) (Info, error) {
+	name = symbol.Normalize(strings.TrimSpace(name))
+	known := knownModes()
+	for _, m := range known {
+		i := infoOf(m)
+		for _, alias := range i.Aliases {
+			if alias == name {
+				return i, nil
+			}
+		}
+	}
+	folded := foldInfoName(name)
+	for _, m := range known {
+		i := infoOf(m)
+		if foldInfoName(i.Name) == folded {
+			return i, nil
+		}
+		for _, alias := range i.Aliases {
+			if foldInfoName(alias) == folded {
+				return i, nil
+			}
+		}
+	}
+	return Info{}, fmt.Errorf("%w %q, expected the name or alias of a known mode", ErrUnknownMode, name)
+}
+
+// ToYAML of the info about a mode
+func (i Info) ToYAML() string {
+	out, _ := yaml.Marshal(i)
+	return string(out[:])
+}
+
+//
+// Private
+//
+
+// infoOf a mode, as catalogued, or else only its name, e.g. of a registered mode
+func infoOf(m Mode) Info {
+	i, ok := infos[m.Name]
+	if !ok {
+		return Info{Name: m.Name}
+	}
+	i.Name = m.Name
+	return i
+}
+
+// foldInfoName to compare it regardless of case, spaces or dashes, e.g. "jazzminor" of "Jazz-Minor"
+func foldInfoName(name string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(name))
+}
+
+// infos of the built-in modes, by name
+var infos = map[string]Info{
+	"Default (Major)": {
+		Parent:         "Major",
+		Degree:         1,
+		Characteristic: []string{"4", "7"},
+		Genres:         []string{"classical", "folk", "pop", "rock"},
+		Notes:          "The major scale of a scale named by its root alone, e.g. C",
+	},
+	"Minor": {
+		Aliases:        []string{"m", "min"},
+		Parent:         "Major",
+		Degree:         6,
+		Characteristic: []string{"b3", "b6", "b7"},
+		Genres:         []string{"classical", "folk", "metal", "pop", "rock"},
+		Notes:          "The natural minor scale, the relative minor of the major scale a minor 3rd above its root, sharing its key signature",
+	},
+	"Major": {
+		Aliases:        []string{"M", "maj"},
+		Parent:         "Major",
+		Degree:         1,
+		Characteristic: []string{"4", "7"},
+		Genres:         []string{"classical", "folk", "pop", "rock"},
+		Notes:          "The basis of Western tonal harmony, of a whole or half step between each of its degrees; Bilawal thaat of Hindustani music, Dhirasankarabharanam of Carnatic music, and close to maqam Ajam of Arabic music",
+	},
+	"Natural Minor": {
+		Aliases:        []string{"nat min", "pure minor"},
+		Parent:         "Major",
+		Degree:         6,
+		Characteristic: []string{"b3", "b6", "b7"},
+		Genres:         []string{"classical", "folk", "metal", "pop", "rock"},
+		Notes:          "Natural as it's unaltered, unlike the harmonic and melodic minor scales, which raise its 7th, or its 6th and 7th, to lead to the tonic",
+	},
+	"Diminished": {
+		Aliases:        []string{"dim", "octatonic", "whole-half diminished"},
+		Characteristic: []string{"b3", "b5", "6", "7"},
+		Genres:         []string{"jazz", "film scores", "20th-century classical"},
+		Notes:          "Symmetric, of alternating whole and half steps, so it's the same on each of four roots a minor 3rd apart; the second mode of limited transposition of Messiaen, used by Rimsky-Korsakov, Stravinsky and Bartók",
+	},
+	"Augmented": {
+		Aliases:        []string{"aug", "augmented hexatonic"},
+		Characteristic: []string{"#2", "#5", "7"},
+		Genres:         []string{"jazz", "film scores"},
+		Notes:          "Symmetric, of alternating minor 3rds and half steps, two augmented triads a half step apart, so it's the same on each of three roots a major 3rd apart; favored by Oliver Nelson and Michael Brecker",
+	},
+	"Melodic Minor Ascend": {
+		Aliases:        []string{"melodic minor", "jazz minor", "mel min asc"},
+		Characteristic: []string{"b3", "6", "7"},
+		Genres:         []string{"classical", "jazz"},
+		Notes:          "A minor scale raising its 6th and 7th as it ascends to the tonic, so it's a major scale but for its minor 3rd; its modes give the lydian dominant and altered scales of jazz; close to Gourimanohari of Carnatic music",
+	},
+	"Melodic Minor Descend": {
+		Aliases:        []string{"mel min desc"},
+		Parent:         "Major",
+		Degree:         6,
+		Characteristic: []string{"b3", "b6", "b7"},
+		Genres:         []string{"classical"},
+		Notes:          "The natural minor scale, as the melodic minor descends from the tonic, no longer leading to it",
+	},
+	"Harmonic Minor": {
+		Aliases:        []string{"harm min"},
+		Characteristic: []string{"b3", "b6", "7"},
+		Genres:         []string{"classical", "flamenco", "klezmer", "metal"},
+		Notes:          "A minor scale raising its 7th to a leading tone, for the major dominant chord of a minor key, with an augmented 2nd between its 6th and 7th; close to Kiravani of Carnatic music and maqam Nahawand of Arabic music, and its 5th mode is the Phrygian dominant of flamenco and klezmer",
+	},
+	"Ionian": {
+		Aliases:        []string{"ion"},
+		Parent:         "Major",
+		Degree:         1,
+		Characteristic: []string{"4", "7"},
+		Genres:         []string{"classical", "folk", "pop", "rock"},
+		Notes:          "The major scale as a church mode, named by Glarean in 1547 for the Ionian Greeks",
+	},
+	"Dorian": {
+		Aliases:        []string{"dor"},
+		Parent:         "Major",
+		Degree:         2,
+		Characteristic: []string{"b3", "6"},
+		Genres:         []string{"jazz", "funk", "folk", "rock"},
+		Notes:          "A minor mode brightened by its major 6th, e.g. of So What and Scarborough Fair; Kafi thaat of Hindustani music, Kharaharapriya of Carnatic music; the Dorian of ancient Greece was instead the Phrygian of today",
+	},
+	"Phrygian": {
+		Aliases:        []string{"phr"},
+		Parent:         "Major",
+		Degree:         3,
+		Characteristic: []string{"b2"},
+		Genres:         []string{"flamenco", "metal"},
+		Notes:          "A minor mode darkened by its minor 2nd, of the Andalusian cadence of flamenco; Bhairavi thaat of Hindustani music, Hanumatodi of Carnatic music, and close to maqam Kurd of Arabic music",
+	},
+	"Lydian": {
+		Aliases:        []string{"lyd"},
+		Parent:         "Major",
+		Degree:         4,
+		Characteristic: []string{"#4"},
+		Genres:         []string{"film scores", "jazz", "progressive rock"},
+		Notes:          "A major mode of a raised 4th, dreamy and unresolved, the basis of the Lydian Chromatic Concept of George Russell; Kalyan thaat of Hindustani music, e.g. raga Yaman, and Mechakalyani of Carnatic music",
+	},
+	"Mixolydian": {
+		Aliases:        []string{"mix", "mixo", "dominant scale"},
+		Parent:         "Major",
+		Degree:         5,
+		Characteristic: []string{"b7"},
+		Genres:         []string{"blues", "celtic", "funk", "rock"},
+		Notes:          "A major mode of a minor 7th, the scale of the dominant seventh chord; Khamaj thaat of Hindustani music, Harikambhoji of Carnatic music, and of much bagpipe music",
+	},
+	"Aeolian": {
+		Aliases:        []string{"aeo"},
+		Parent:         "Major",
+		Degree:         6,
+		Characteristic: []string{"b3", "b6", "b7"},
+		Genres:         []string{"classical", "folk", "metal", "pop", "rock"},
+		Notes:          "The natural minor scale as a church mode, named by Glarean in 1547; Asavari thaat of Hindustani music, Natabhairavi of Carnatic music",
+	},
+	"Locrian": {
+		Aliases:        []string{"loc"},
+		Parent:         "Major",
+		Degree:         7,
+		Characteristic: []string{"b2", "b5"},
+		Genres:         []string{"jazz", "metal"},
+		Notes:          "The only diatonic mode of a diminished 5th, so its tonic chord is diminished and unstable; rare as a tonality, but the scale of the half-diminished ii of a minor key in jazz",
+	},
+}
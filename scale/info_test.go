@@ -0,0 +1,96 @@
+// Scale modes are catalogued by their alternate names, the parent scale they're a mode of, their characteristic degrees, typical genres, and notes on them from other traditions, e.g. ModeInfo("Dorian")
+package scale
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestModeInfo(t *testing.T) {
+	for name, mode := range map[string]string{
+		"Dorian":               "Dorian",
+		" dorian ":             "Dorian",
+		"dor":                  "Dorian",
+		"Melodic Minor Ascend": "Melodic Minor Ascend",
+		"jazz minor":           "Melodic Minor Ascend",
+		"Jazz-Minor":           "Melodic Minor Ascend",
+		"m":                    "Minor",
+		"M":                    "Major",
+		"MAJOR":                "Major",
+		"octatonic":            "Diminished",
+		"dominant scale":       "Mixolydian",
+		"Default (Major)":      "Default (Major)",
+	} {
+		i, err := ModeInfo(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, mode, i.Name, name)
+	}
+}
+
+func TestModeInfo_Lydian(t *testing.T) {
+	i, err := ModeInfo("lydian")
+	assert.Nil(t, err)
+	assert.Equal(t, "Major", i.Parent)
+	assert.Equal(t, 4, i.Degree)
+	assert.Equal(t, []string{"#4"}, i.Characteristic)
+	assert.Contains(t, i.Genres, "film scores")
+	assert.Contains(t, i.Notes, "Kalyan thaat of Hindustani music")
+}
+
+func TestModeInfo_Unknown(t *testing.T) {
+	_, err := ModeInfo("jams")
+	assert.True(t, errors.Is(err, ErrUnknownMode))
+	assert.Equal(t, `unknown mode "jams", expected the name or alias of a known mode`, err.Error())
+}
+
+func TestModeInfo_Registered(t *testing.T) {
+	defer restoreModes()()
+	assert.Nil(t, RegisterMode("Hirajoshi", ModeIntervals{2, 1, 4, 1}))
+	i, err := ModeInfo("hirajoshi")
+	assert.Nil(t, err)
+	assert.Equal(t, Info{Name: "Hirajoshi"}, i)
+}
+
+func TestModeInfo_EveryMode(t *testing.T) {
+	names := map[string]bool{}
+	for _, m := range modes {
+		names[foldInfoName(m.Name)] = true
+	}
+	aliases := map[string]string{}
+	for _, m := range modes {
+		i, err := ModeInfo(m.Name)
+		assert.Nil(t, err, m.Name)
+		assert.Equal(t, m.Name, i.Name)
+		assert.NotEmpty(t, i.Characteristic, m.Name)
+		assert.NotEmpty(t, i.Genres, m.Name)
+		assert.NotEmpty(t, i.Notes, m.Name)
+		for _, alias := range i.Aliases {
+			assert.False(t, names[foldInfoName(alias)], "%s of %s is the name of a mode", alias, m.Name)
+			other, ok := aliases[alias]
+			assert.False(t, ok, "%s is an alias of both %s and %s", alias, other, m.Name)
+			aliases[alias] = m.Name
+		}
+		if i.Parent != "" {
+			_, err := ModeInfo(i.Parent)
+			assert.Nil(t, err, m.Name)
+			assert.True(t, i.Degree >= 1 && i.Degree <= 7, m.Name)
+		}
+	}
+	assert.Len(t, infos, len(ScaleModeList))
+}
+
+func TestInfo_ToYAML(t *testing.T) {
+	i, err := ModeInfo("Phrygian")
+	assert.Nil(t, err)
+	assert.Equal(t, "name: Phrygian\n"+
+		"aliases:\n- phr\n"+
+		"parent: Major\n"+
+		"degree: 3\n"+
+		"characteristic:\n- b2\n"+
+		"genres:\n- flamenco\n- metal\n"+
+		"notes: A minor mode darkened by its minor 2nd, of the Andalusian cadence of flamenco;\n"+
+		"  Bhairavi thaat of Hindustani music, Hanumatodi of Carnatic music, and close to maqam\n"+
+		"  Kurd of Arabic music\n", i.ToYAML())
+}
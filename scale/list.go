@@ -13,8 +13,16 @@ func (l List) ToYAML() string {
 	return string(out[:])
 }
 
+// ScaleModeList of the names of all known modes, as of startup and any registered since; read ModeNames instead while modes may be registered
 var ScaleModeList List
 
+// ModeNames of all known modes, the built-in modes and any registered since, safe to read while modes are registered
+func ModeNames() List {
+	registry.RLock()
+	defer registry.RUnlock()
+	return ScaleModeList
+}
+
 //
 // Private
 //
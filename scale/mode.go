@@ -2,15 +2,21 @@
 package scale
 
 import (
-	"regexp"
+	"reflect"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/interval"
+	"github.com/go-music-theory/music-theory/match"
+	"github.com/go-music-theory/music-theory/trace"
 )
 
 // Mode is identified by positive/negative regular expressions, and then adds/removes pitch classes by interval from the root of the scale.
 type Mode struct {
-	Name string
-	pos  *regexp.Regexp
-	set  ModeIntervals
-	omit ModeOmit
+	Name  string
+	pos   *match.Matcher
+	set   ModeIntervals
+	omit  ModeOmit
+	whole *match.Matcher // longest-matching copy of pos, for strict parsing
 }
 
 // ModeAdd maps an interval-from-scale-root to a +/1 semitone adjustment
@@ -114,7 +120,7 @@ var modes = []Mode{
 
 	Mode{
 		Name: "Melodic Minor Ascend",
-		pos:  exp(melodicExp + nExp + minorExp + nExp + ascendExp),
+		pos:  exp(melodicExp + nExp + minorExp + "(" + nExp + ascendExp + "|$)"),
 		set:  ModeIntervals{2, 1, 2, 2, 2, 2},
 	},
 
@@ -173,9 +179,25 @@ var modes = []Mode{
 	},
 }
 
-func exp(s string) *regexp.Regexp {
-	r, _ := regexp.Compile(s)
-	return r
+// Regular expression of each word in a scale name, every part of which must be matched by some mode
+var rgxWord = exp("[^\\s.,+()]+")
+
+// exp compiled once, to a matcher with a prefilter of the runes any match must contain
+func exp(s string) *match.Matcher {
+	return match.MustCompile(s)
+}
+
+// longest-matching copy of a regular expression, e.g. to match all of "minor" instead of only "min"
+func longest(r *match.Matcher) *match.Matcher {
+	return r.Longest()
+}
+
+func init() {
+	for i := range modes {
+		if modes[i].pos != nil {
+			modes[i].whole = longest(modes[i].pos)
+		}
+	}
 }
 
 func (this *Mode) matchPosNegString(s string) bool {
@@ -195,29 +217,150 @@ func (this *Mode) matchPosNegString(s string) bool {
 	}
 }
 
+// unknownModeIn a name, the position and text of the first part of a word not matched by any mode, e.g. "r" of "majr",
+// or -1 if every part of every word is matched
+func unknownModeIn(name string) (int, string) {
+	matched := make([]bool, len(name))
+	for _, m := range knownModes() {
+		if m.pos == nil {
+			continue
+		}
+		for _, loc := range append(m.pos.FindAllStringIndex(name, -1), m.whole.FindAllStringIndex(name, -1)...) {
+			for i := loc[0]; i < loc[1]; i++ {
+				matched[i] = true
+			}
+		}
+	}
+	for _, loc := range rgxWord.FindAllStringIndex(name, -1) {
+		for i := loc[0]; i < loc[1]; i++ {
+			if !matched[i] {
+				j := i
+				for j < loc[1] && !matched[j] {
+					j++
+				}
+				return i, name[i:j]
+			}
+		}
+	}
+	return -1, ""
+}
+
+// strictErrorIn a name, the position and text of the first part of a word not wholly matched by some mode,
+// or else of the first mode matching from the middle of a word outside of any other match, or of two outermost modes matching different parts with different intervals, or -1 if there is none of these.
+func strictErrorIn(name string) (int, string, error) {
+	var all []modeMatch
+	for _, m := range knownModes() {
+		if m.whole == nil {
+			continue
+		}
+		for _, loc := range m.whole.FindAllStringIndex(name, -1) {
+			all = append(all, modeMatch{m, loc})
+		}
+	}
+	matched := make([]bool, len(name))
+	for _, mm := range all {
+		for i := mm.loc[0]; i < mm.loc[1]; i++ {
+			matched[i] = true
+		}
+	}
+	for _, loc := range rgxWord.FindAllStringIndex(name, -1) {
+		for i := loc[0]; i < loc[1]; i++ {
+			if !matched[i] {
+				j := i
+				for j < loc[1] && !matched[j] {
+					j++
+				}
+				return i, name[i:j], ErrUnknownMode
+			}
+		}
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].loc[0] < all[j].loc[0] })
+	for _, mm := range all {
+		if isMidWord(name, mm.loc[0]) && !mm.nestedIn(all) {
+			return mm.loc[0], name[mm.loc[0]:mm.loc[1]], ErrAmbiguous
+		}
+	}
+	var outer []modeMatch
+	for _, mm := range all {
+		if !mm.nestedIn(all) {
+			outer = append(outer, mm)
+		}
+	}
+	for i, a := range outer {
+		for _, b := range outer[i+1:] {
+			if !a.nests(b) && !a.sameIntervals(b) {
+				return a.loc[0], name[a.loc[0]:b.loc[1]], ErrAmbiguous
+			}
+		}
+	}
+	return -1, "", nil
+}
+
+// modeMatch is the location in a name where a mode matched
+type modeMatch struct {
+	mode Mode
+	loc  []int
+}
+
+// nests if either match is within the other
+func (a modeMatch) nests(b modeMatch) bool {
+	return (a.loc[0] <= b.loc[0] && b.loc[1] <= a.loc[1]) || (b.loc[0] <= a.loc[0] && a.loc[1] <= b.loc[1])
+}
+
+// nestedIn any other, larger match
+func (a modeMatch) nestedIn(all []modeMatch) bool {
+	for _, b := range all {
+		if b.loc[0] <= a.loc[0] && a.loc[1] <= b.loc[1] && b.loc[1]-b.loc[0] > a.loc[1]-a.loc[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// sameIntervals if both matched modes set the same intervals
+func (a modeMatch) sameIntervals(b modeMatch) bool {
+	return reflect.DeepEqual(a.mode.set, b.mode.set) && reflect.DeepEqual(a.mode.omit, b.mode.omit)
+}
+
+// isMidWord if the byte at a position in a name is a letter following another letter of the same case, e.g. the "lyd" of "mixolydian"
+func isMidWord(name string, i int) bool {
+	if i == 0 || i >= len(name) {
+		return false
+	}
+	prev, this := name[i-1], name[i]
+	return ('a' <= prev && prev <= 'z' && 'a' <= this && this <= 'z') ||
+		('A' <= prev && prev <= 'Z' && 'A' <= this && this <= 'Z')
+}
+
 // Build the scale by processing all Modes against the given name.
-func (this *Scale) parseModes(name string) {
-	var toDelete []Interval
-	for _, f := range modes {
+func (this *Scale) parseModes(name string, input string) {
+	var omitted [16]Interval // backing the intervals to delete, so that most parses don't allocate them
+	toDelete := omitted[:0]
+	for _, f := range knownModes() {
 		if f.MatchString(name) {
-			toDelete = append(toDelete, this.applyMode(f)...)
+			if trace.Enabled() {
+				trace.Emit(trace.Event{Scope: "scale.parse", Input: input, Rule: f.Name})
+			}
+			this.applyMode(f)
+			toDelete = append(toDelete, f.omit...)
 		}
 	}
 	for _, t := range toDelete {
 		delete(this.Tones, t)
+		delete(this.ToneInterval, t)
 	}
 	return
 }
 
-func (this *Scale) applyMode(f Mode) (toDelete []Interval) {
+func (this *Scale) applyMode(f Mode) {
 	ct := I1
+	semitones := 0
 	this.Tones[ct] = this.Root
+	this.ToneInterval[ct] = interval.NameOf(int(ct), semitones)
 	for _, c := range f.set {
 		ct++
+		semitones += c
 		this.Tones[ct], _ = this.Tones[ct-1].Step(c)
+		this.ToneInterval[ct] = interval.NameOf(int(ct), semitones)
 	}
-	for _, t := range f.omit {
-		toDelete = append(toDelete, t)
-	}
-	return
 }
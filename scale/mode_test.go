@@ -4,8 +4,10 @@ package scale
 import (
 	"testing"
 
-	"gopkg.in/music-theory.v0/note"
 	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/trace"
 )
 
 func TestListAllModes(t *testing.T) {
@@ -29,6 +31,18 @@ func TestScaleParseModes(t *testing.T) {
 	}, c.Tones)
 }
 
+func TestScaleParseModes_Traced(t *testing.T) {
+	var rules []string
+	previous := trace.Set(trace.HookFunc(func(e trace.Event) {
+		assert.Equal(t, "scale.parse", e.Scope)
+		assert.Equal(t, "D dorian", e.Input)
+		rules = append(rules, e.Rule)
+	}))
+	defer trace.Set(previous)
+	Of("D dorian")
+	assert.Equal(t, []string{"Default (Major)", "Dorian"}, rules)
+}
+
 //
 // Private
 //
@@ -0,0 +1,52 @@
+// Scale names are parsed leniently by default, or with an Option, e.g. strictly with Parse("C minor", WithStrict()), or in a locale with Of("La minor", WithLocale(locale.Solfege))
+package scale
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/locale"
+)
+
+// Option for parsing a scale name
+type Option func(*options)
+
+// WithStrict parsing, which rejects a scale name unless every part of it is matched by some mode, with no mode matching from the middle of a word outside of any other match
+func WithStrict() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// WithLocale of the note name at the beginning of a scale name, e.g. WithLocale(locale.German) to parse "H minor" as B minor
+func WithLocale(l locale.Locale) Option {
+	return func(o *options) {
+		o.locale = l
+	}
+}
+
+// WithSpellingKey to spell the scale in, with the sharps or flats of its key signature, e.g. WithSpellingKey(key.Of("Bb")) to spell A# major as Bb major
+func WithSpellingKey(k chord.Key) Option {
+	return func(o *options) {
+		o.spellingKey = k
+	}
+}
+
+//
+// Private
+//
+
+type options struct {
+	strict      bool
+	locale      locale.Locale
+	spellingKey chord.Key
+}
+
+func optionsOf(opts []Option) options {
+	if len(opts) == 0 {
+		return options{} // without allocating, as the options applied below would
+	}
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
@@ -0,0 +1,63 @@
+// Scale names are parsed leniently by default, or with an Option, e.g. strictly with Parse("C minor", WithStrict()), or in a locale with Of("La minor", WithLocale(locale.Solfege))
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/locale"
+)
+
+func TestWithStrict(t *testing.T) {
+	assert.Equal(t, options{strict: true}, optionsOf([]Option{WithStrict()}))
+	assert.Equal(t, options{}, optionsOf(nil))
+}
+
+func TestWithLocale(t *testing.T) {
+	assert.Equal(t, Of("A minor"), Of("La minor", WithLocale(locale.Solfege)))
+	assert.Equal(t, Of("B minor"), Of("H minor", WithLocale(locale.German)))
+	_, err := Parse("Sol mixolydian blues", WithLocale(locale.Solfege))
+	assert.Equal(t, &ParseError{Name: "Sol mixolydian blues", Position: 15, Text: "blues", Err: ErrUnknownMode}, err)
+}
+
+func TestWithSpellingKey(t *testing.T) {
+	s := Of("A# major", WithSpellingKey(signature(-2)))
+	assert.Equal(t, "Bb", s.Root.String(s.AdjSymbol))
+}
+
+func TestParse_Strict(t *testing.T) {
+	for _, name := range []string{"C", "C minor", "C melodic minor ascend", "G mixolydian", "F lydian", "C natural minor"} {
+		_, err := Parse(name, WithStrict())
+		assert.Nil(t, err, name)
+	}
+}
+
+func TestParse_StrictMelodicMinor(t *testing.T) {
+	for name, expect := range map[string]string{
+		"C melodic minor":         "1 2 b3 4 5 6 7",
+		"C melodic minor ascend":  "1 2 b3 4 5 6 7",
+		"C melodic minor descend": "1 2 b3 4 5 b6 b7",
+		"C mel min":               "1 2 b3 4 5 6 7",
+	} {
+		s, err := Parse(name, WithStrict())
+		assert.Nil(t, err, name)
+		assert.Equal(t, expect, s.Formula().Intervals, name)
+	}
+}
+
+func TestParse_StrictUnknownMode(t *testing.T) {
+	_, err := Parse("C minors", WithStrict())
+	assert.Equal(t, &ParseError{Name: "C minors", Position: 7, Text: "s", Err: ErrUnknownMode}, err)
+	_, err = Parse("C minors")
+	assert.Equal(t, &ParseError{Name: "C minors", Position: 7, Text: "s", Err: ErrUnknownMode}, err)
+}
+
+func TestParse_StrictAmbiguous(t *testing.T) {
+	_, err := Parse("C dorian lydian", WithStrict())
+	assert.Equal(t, &ParseError{Name: "C dorian lydian", Position: 2, Text: "dorian lydian", Err: ErrAmbiguous}, err)
+	_, err = Parse("C dorian lydian")
+	assert.Nil(t, err)
+	_, err = Parse("C dorianlydian", WithStrict())
+	assert.Equal(t, &ParseError{Name: "C dorianlydian", Position: 8, Text: "lydian", Err: ErrAmbiguous}, err)
+}
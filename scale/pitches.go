@@ -0,0 +1,79 @@
+// A scale is played across octaves as concrete pitches between two notes, e.g. for an arpeggiator or an exercise generator
+package scale
+
+import (
+	"strconv"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+)
+
+// Tuning of A4 in Hz for the frequency of every Pitch, i.e. concert pitch
+const Tuning = 440
+
+// Pitch of a scale tone in a particular octave
+type Pitch struct {
+	Class     note.Class
+	Octave    note.Octave
+	Frequency float64 // in Hz, with A4 tuned to 440Hz
+}
+
+// String of the pitch, e.g. "C#4", spelled with an adjustment symbol
+func (this Pitch) String(with note.AdjSymbol) string {
+	return this.Class.String(with) + strconv.Itoa(int(this.Octave))
+}
+
+// PitchesInRange of the scale from one note to another, both inclusive if they're in the scale, e.g. PitchesInRange("C4", "C5")
+// The pitches ascend if the first note is below the second, else they descend, e.g. PitchesInRange("G5", "G3")
+// Returns no pitches if either note can't be named
+func (this Scale) PitchesInRange(from, to string) (pitches []Pitch) {
+	fromStep, ok := stepOf(from)
+	if !ok {
+		return
+	}
+	toStep, ok := stepOf(to)
+	if !ok {
+		return
+	}
+	inc := 1
+	if toStep < fromStep {
+		inc = -1
+	}
+	tones := this.ToneSet()
+	for step := fromStep; ; step += inc {
+		class, octave := classAndOctaveOf(step)
+		if tones.Contains(class) {
+			pitches = append(pitches, Pitch{
+				Class:     class,
+				Octave:    octave,
+				Frequency: pitch.OfStep(step, Tuning),
+			})
+		}
+		if step == toStep {
+			return
+		}
+	}
+}
+
+//
+// Private
+//
+
+// stepOf a note name, its number of semitones from C0, e.g. 58 for "A4", or false if the note can't be named
+func stepOf(name string) (int, bool) {
+	n := note.Named(name)
+	if n.Class == note.Nil {
+		return 0, false
+	}
+	return int(n.Class) + int(n.Octave)*12, true
+}
+
+// classAndOctaveOf a number of semitones from C0
+func classAndOctaveOf(step int) (note.Class, note.Octave) {
+	i := step - 1
+	octave := i / 12
+	if i%12 < 0 {
+		octave--
+	}
+	return note.Class(i - octave*12 + 1), note.Octave(octave)
+}
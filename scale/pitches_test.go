@@ -0,0 +1,65 @@
+// A scale is played across octaves as concrete pitches between two notes, e.g. for an arpeggiator or an exercise generator
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestScale_PitchesInRange(t *testing.T) {
+	assert.Equal(t, []Pitch{
+		{note.C, 4, 261.63},
+		{note.D, 4, 293.66},
+		{note.E, 4, 329.63},
+		{note.F, 4, 349.23},
+		{note.G, 4, 392.00},
+		{note.A, 4, 440.00},
+		{note.B, 4, 493.88},
+		{note.C, 5, 523.25},
+	}, Of("C major").PitchesInRange("C4", "C5"))
+}
+
+func TestScale_PitchesInRange_Descending(t *testing.T) {
+	assert.Equal(t, []Pitch{
+		{note.As, 3, 233.08},
+		{note.A, 3, 220.00},
+		{note.G, 3, 196.00},
+		{note.F, 3, 174.61},
+		{note.E, 3, 164.81},
+		{note.D, 3, 146.83},
+	}, Of("D minor").PitchesInRange("Bb3", "C#3"))
+}
+
+func TestScale_PitchesInRange_AcrossOctaves(t *testing.T) {
+	pitches := Of("A minor").PitchesInRange("A2", "A5")
+	assert.Equal(t, 22, len(pitches))
+	assert.Equal(t, Pitch{note.A, 2, 110.00}, pitches[0])
+	assert.Equal(t, Pitch{note.C, 3, 130.81}, pitches[2])
+	assert.Equal(t, Pitch{note.A, 5, 880.00}, pitches[21])
+}
+
+func TestScale_PitchesInRange_Single(t *testing.T) {
+	assert.Equal(t, []Pitch{{note.G, 4, 392.00}}, Of("C major").PitchesInRange("G4", "G4"))
+	assert.Nil(t, Of("C major").PitchesInRange("G#4", "G#4"))
+}
+
+func TestScale_PitchesInRange_BelowC0(t *testing.T) {
+	assert.Equal(t, []Pitch{
+		{note.A, -1, 13.75},
+		{note.B, -1, 15.43},
+		{note.C, 0, 16.35},
+	}, Of("C major").PitchesInRange("A-1", "C0"))
+}
+
+func TestScale_PitchesInRange_Unnamed(t *testing.T) {
+	assert.Nil(t, Of("C major").PitchesInRange("X4", "C5"))
+	assert.Nil(t, Of("C major").PitchesInRange("C4", ""))
+}
+
+func TestPitch_String(t *testing.T) {
+	assert.Equal(t, "Bb3", Pitch{note.As, 3, 233.08}.String(note.Flat))
+	assert.Equal(t, "C#-1", Pitch{note.Cs, -1, 8.66}.String(note.Sharp))
+}
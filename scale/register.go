@@ -0,0 +1,149 @@
+// Scale modes can be registered by library users, or loaded from a YAML file, e.g. for local folk scales or synthetic scales.
+package scale
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/match"
+)
+
+var (
+	// ErrDuplicateMode when registering a mode with the same name as a known mode
+	ErrDuplicateMode = errors.New("duplicate mode")
+
+	// ErrInvalidIntervals when registering a mode without intervals, with an interval that isn't upward, or with too many intervals
+	ErrInvalidIntervals = errors.New("invalid intervals")
+)
+
+// RegisterMode named name, by the semitones between each successive tone of the scale,
+// e.g. RegisterMode("Hirajoshi", ModeIntervals{2, 1, 4, 1}) for Of("A hirajoshi").
+// The mode matches its name in a scale name regardless of case, and is applied after all the built-in modes, in the order they are registered.
+// It's safe to register a mode while scales are parsed in other goroutines, each parsed by the modes known when it began.
+func RegisterMode(name string, intervals ModeIntervals) error {
+	m, err := modeOf(name, intervals)
+	if err != nil {
+		return err
+	}
+	return registerModes(m)
+}
+
+// LoadModes from YAML and register each of them, e.g.
+//
+//     - name: Hirajoshi
+//       intervals: [2, 1, 4, 1]
+//
+// All of them are registered together, or none if any is invalid or a duplicate, and no scale is parsed by only some of them.
+func LoadModes(r io.Reader) error {
+	in, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var specs []specMode
+	err = yaml.UnmarshalStrict(in, &specs)
+	if err != nil {
+		return err
+	}
+	var loaded []Mode
+	for _, s := range specs {
+		m, err := modeOf(s.Name, s.Intervals)
+		if err != nil {
+			return err
+		}
+		loaded = append(loaded, m)
+	}
+	return registerModes(loaded...)
+}
+
+// LoadModesFile at a path, e.g. ~/.config/music-theory/scales.yaml, and register each of them
+func LoadModesFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = LoadModes(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+//
+// Private
+//
+
+// registry guards the known modes, which registering replaces with a longer copy, never changing a slice of them that parsing might be reading
+var registry sync.RWMutex
+
+// knownModes, the built-in modes and any registered since, as they are now
+func knownModes() []Mode {
+	registry.RLock()
+	defer registry.RUnlock()
+	return modes
+}
+
+// modeOf a name, by the semitones between each successive tone of the scale, matching its name regardless of case
+func modeOf(name string, intervals ModeIntervals) (Mode, error) {
+	if len(intervals) == 0 || len(intervals) >= int(I16) {
+		return Mode{}, fmt.Errorf("%w %v of mode %q", ErrInvalidIntervals, intervals, name)
+	}
+	for _, c := range intervals {
+		if c <= 0 {
+			return Mode{}, fmt.Errorf("%w %v of mode %q", ErrInvalidIntervals, intervals, name)
+		}
+	}
+	pos, err := match.Compile("(?i)" + modeNameExp(name))
+	if err != nil {
+		return Mode{}, fmt.Errorf("mode %q: %w", name, err)
+	}
+	var omit ModeOmit
+	for i := Interval(len(intervals) + 2); i <= I7; i++ {
+		omit = append(omit, i) // tones of the default major mode beyond those set by this mode
+	}
+	return Mode{Name: name, pos: pos, set: intervals, omit: omit, whole: longest(pos)}, nil
+}
+
+// registerModes after the known modes, all at once under the lock, or none of them if any has the name of a known mode or of another of them
+func registerModes(ms ...Mode) error {
+	registry.Lock()
+	defer registry.Unlock()
+	names := make(map[string]bool, len(modes)+len(ms))
+	for _, m := range modes {
+		names[m.Name] = true
+	}
+	for _, m := range ms {
+		if names[m.Name] {
+			return fmt.Errorf("%w %q", ErrDuplicateMode, m.Name)
+		}
+		names[m.Name] = true
+	}
+	list := ScaleModeList[:len(ScaleModeList):len(ScaleModeList)]
+	for _, m := range ms {
+		list = append(list, m.Name)
+	}
+	modes, ScaleModeList = append(modes[:len(modes):len(modes)], ms...), list
+	return nil
+}
+
+type specMode struct {
+	Name      string
+	Intervals ModeIntervals
+}
+
+// modeNameExp matches each word of a mode name, glued together as in the built-in modes, e.g. "Hungarian Gypsy" matches "hungarian.gypsy"
+func modeNameExp(name string) string {
+	var words []string
+	for _, w := range strings.Fields(name) {
+		words = append(words, regexp.QuoteMeta(w))
+	}
+	return strings.Join(words, nExp)
+}
@@ -0,0 +1,135 @@
+// Scale modes can be registered by library users, or loaded from a YAML file, e.g. for local folk scales or synthetic scales.
+package scale
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestRegisterMode(t *testing.T) {
+	defer restoreModes()()
+
+	err := RegisterMode("Hirajoshi", ModeIntervals{2, 1, 4, 1})
+	assert.Nil(t, err)
+
+	s := Of("A hirajoshi")
+	assert.Equal(t, map[Interval]note.Class{I1: note.A, I2: note.B, I3: note.C, I4: note.E, I5: note.F}, s.Tones)
+	assert.Equal(t, "Hirajoshi", ScaleModeList[len(ScaleModeList)-1])
+
+	_, err = Parse("A Hirajoshi", WithStrict())
+	assert.Nil(t, err)
+}
+
+func TestRegisterMode_WhileParsing(t *testing.T) {
+	defer restoreModes()()
+
+	var wg sync.WaitGroup
+	for n := 0; n < 8; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				assert.Equal(t, note.Ds, Of("C minor").Tones[I3])
+				_, err := Parse("C dorian", WithStrict())
+				assert.Nil(t, err)
+				assert.NotEmpty(t, ModeNames())
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		assert.Nil(t, RegisterMode(fmt.Sprintf("Concurrent %d", i), ModeIntervals{2, 2, 2}))
+	}
+	wg.Wait()
+	assert.Equal(t, "Concurrent 19", ModeNames()[len(ModeNames())-1])
+}
+
+func TestRegisterMode_Words(t *testing.T) {
+	defer restoreModes()()
+
+	assert.Nil(t, RegisterMode("Hungarian Gypsy", ModeIntervals{2, 1, 3, 1, 1, 2}))
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I2: note.D, I3: note.Ds, I4: note.Fs, I5: note.G, I6: note.Gs, I7: note.As}, Of("C hungarian.gypsy").Tones)
+}
+
+func TestRegisterMode_Duplicate(t *testing.T) {
+	defer restoreModes()()
+
+	err := RegisterMode("Dorian", ModeIntervals{2, 1, 2, 2, 2, 1})
+	assert.True(t, errors.Is(err, ErrDuplicateMode))
+	assert.Equal(t, `duplicate mode "Dorian"`, err.Error())
+}
+
+func TestRegisterMode_InvalidIntervals(t *testing.T) {
+	defer restoreModes()()
+
+	assert.True(t, errors.Is(RegisterMode("Empty", ModeIntervals{}), ErrInvalidIntervals))
+	assert.True(t, errors.Is(RegisterMode("Downward", ModeIntervals{2, -1}), ErrInvalidIntervals))
+	assert.True(t, errors.Is(RegisterMode("Endless", make(ModeIntervals, 16)), ErrInvalidIntervals))
+	assert.Equal(t, len(modes), len(ScaleModeList))
+}
+
+func TestLoadModes(t *testing.T) {
+	defer restoreModes()()
+
+	err := LoadModes(strings.NewReader("- name: Hirajoshi\n  intervals: [2, 1, 4, 1]\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I2: note.D, I3: note.Ds, I4: note.G, I5: note.Gs}, Of("C hirajoshi").Tones)
+}
+
+func TestLoadModes_Invalid(t *testing.T) {
+	defer restoreModes()()
+
+	assert.NotNil(t, LoadModes(strings.NewReader("- name: Hirajoshi\n  steps: [2, 1, 4, 1]\n")))
+	assert.NotNil(t, LoadModes(strings.NewReader("not a list")))
+}
+
+func TestLoadModes_AllOrNone(t *testing.T) {
+	defer restoreModes()()
+	known := len(modes)
+
+	err := LoadModes(strings.NewReader("- name: Hirajoshi\n  intervals: [2, 1, 4, 1]\n- name: Downward\n  intervals: [2, -1]\n"))
+	assert.True(t, errors.Is(err, ErrInvalidIntervals))
+	err = LoadModes(strings.NewReader("- name: Hirajoshi\n  intervals: [2, 1, 4, 1]\n- name: Hirajoshi\n  intervals: [2, 1, 4]\n"))
+	assert.True(t, errors.Is(err, ErrDuplicateMode))
+	err = LoadModes(strings.NewReader("- name: Hirajoshi\n  intervals: [2, 1, 4, 1]\n- name: Dorian\n  intervals: [2, 1, 2, 2, 2, 1]\n"))
+	assert.True(t, errors.Is(err, ErrDuplicateMode))
+	assert.Equal(t, known, len(modes), "of none of them registered")
+	assert.Equal(t, len(modes), len(ScaleModeList))
+}
+
+func TestLoadModesFile(t *testing.T) {
+	defer restoreModes()()
+
+	dir, err := ioutil.TempDir("", "scale")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "scales.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("- name: Hirajoshi\n  intervals: [2, 1, 4, 1]\n"), 0644))
+
+	assert.Nil(t, LoadModesFile(path))
+	assert.Equal(t, 5, len(Of("C hirajoshi").Tones))
+
+	assert.True(t, os.IsNotExist(LoadModesFile(filepath.Join(dir, "missing.yaml"))))
+}
+
+//
+// Private
+//
+
+// restoreModes returns a func to restore the known modes and their list, e.g. deferred after registering modes in a test
+func restoreModes() func() {
+	oldModes := append([]Mode(nil), modes...)
+	oldList := append(List(nil), ScaleModeList...)
+	return func() {
+		modes, ScaleModeList = oldModes, oldList
+	}
+}
@@ -4,6 +4,8 @@
 //
 // A scale ordered by increasing pitch is an ascending scale, and a scale ordered by decreasing pitch is a descending scale. Some scales contain different pitches when ascending than when descending. For example, the Melodic minor scale.
 //
+// A Scale is a value, never changed by its methods, and modes may be registered while scales are parsed in other goroutines.
+//
 // Credit
 //
 // Charney Kaye
@@ -16,25 +18,61 @@
 package scale
 
 import (
-	"gopkg.in/music-theory.v0/note"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/symbol"
 )
 
+// toneCapacity of the maps of a parsed scale, enough for a heptatonic scale or a bebop scale, so they needn't grow while its modes are applied
+const toneCapacity = 8
+
 // Scale in a particular key
 type Scale struct {
-	Root      note.Class
-	AdjSymbol note.AdjSymbol
-	Tones     map[Interval]note.Class
+	Root         note.Class
+	AdjSymbol    note.AdjSymbol
+	Tones        map[Interval]note.Class
+	ToneInterval map[Interval]string // name of the interval from the root to each tone, e.g. M2 or m3 or P5
 }
 
-// Of a particular key, e.g. Of("C minor 7")
-func Of(name string) Scale {
+// Of a particular key, e.g. Of("C minor 7"), with its symbols in ASCII or Unicode, e.g. Of("B♭ minor"), or with options, e.g. Of("La minor", WithLocale(locale.Solfege))
+func Of(name string, options ...Option) Scale {
+	o := optionsOf(options)
 	c := Scale{}
-	c.parse(name)
+	c.parse(o.locale.Translate(symbol.Normalize(name)))
+	if o.spellingKey != nil {
+		c = c.SpelledIn(o.spellingKey)
+	}
 	return c
 }
 
+// Parse a scale name, e.g. Parse("C minor"), returning a *ParseError if its root or any of its modes is unknown
+func Parse(name string, options ...Option) (Scale, error) {
+	o := optionsOf(options)
+	s := Of(name, options...)
+	normalized := symbol.Normalize(name)
+	translated := o.locale.Translate(normalized)
+	shift := len(normalized) - len(translated) // of each position after a note name translated from the locale
+	root, remaining := note.RootAndRemaining(translated)
+	if root == note.Nil {
+		return s, &ParseError{Name: name, Position: 0, Text: name, Err: ErrUnknownRoot}
+	}
+	if o.strict {
+		if i, text, err := strictErrorIn(remaining); i >= 0 {
+			at := shift + strings.LastIndex(translated, remaining) + i
+			position, text := symbol.Unnormalized(name, at, at+len(text))
+			return s, &ParseError{Name: name, Position: position, Text: text, Err: err}
+		}
+	} else if i, text := unknownModeIn(remaining); i >= 0 {
+		at := shift + strings.LastIndex(translated, remaining) + i
+		position, text := symbol.Unnormalized(name, at, at+len(text))
+		return s, &ParseError{Name: name, Position: position, Text: text, Err: ErrUnknownMode}
+	}
+	return s, nil
+}
+
 // Notes to obtain the notes from the Scale
-func (this *Scale) Notes() (notes []*note.Note) {
+func (this Scale) Notes() (notes []*note.Note) {
 	forAllIn(this.Tones, func(class note.Class) {
 		notes = append(notes, note.OfClass(class))
 	})
@@ -46,7 +84,9 @@ func (this *Scale) Notes() (notes []*note.Note) {
 //
 
 func (this *Scale) parse(name string) {
-	this.Tones = make(map[Interval]note.Class)
+	input := name
+	this.Tones = make(map[Interval]note.Class, toneCapacity)
+	this.ToneInterval = make(map[Interval]string, toneCapacity)
 
 	// determine whether the name is "sharps" or "flats"
 	this.AdjSymbol = note.AdjSymbolOf(name)
@@ -55,5 +95,5 @@ func (this *Scale) parse(name string) {
 	this.Root, name = note.RootAndRemaining(name)
 
 	// parse the scale Mode
-	this.parseModes(name)
+	this.parseModes(name, input)
 }
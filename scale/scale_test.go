@@ -8,9 +8,10 @@ import (
 
 	"fmt"
 	"gopkg.in/music-theory.v0/key"
-	"gopkg.in/music-theory.v0/note"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
+
+	"github.com/go-music-theory/music-theory/note"
 )
 
 func TestScaleExpectations(t *testing.T) {
@@ -34,6 +35,70 @@ func TestScaleExpectations(t *testing.T) {
 	}
 }
 
+func TestParseExpectations(t *testing.T) {
+	testExpectations := testExpectationManifest{}
+	file, err := ioutil.ReadFile("testdata/expectations.yaml")
+	assert.Nil(t, err)
+
+	err = yaml.Unmarshal(file, &testExpectations)
+	assert.Nil(t, err)
+
+	for name := range testExpectations.Scales {
+		actual, err := Parse(name)
+		assert.Nil(t, err, fmt.Sprintf("name:%v", name))
+		assert.Equal(t, Of(name), actual, fmt.Sprintf("name:%v", name))
+	}
+}
+
+func TestParse_UnknownRoot(t *testing.T) {
+	_, err := Parse("garbage")
+	assert.Equal(t, &ParseError{Name: "garbage", Position: 0, Text: "garbage", Err: ErrUnknownRoot}, err)
+}
+
+func TestParse_UnknownMode(t *testing.T) {
+	_, err := Parse("C jams")
+	assert.Equal(t, &ParseError{Name: "C jams", Position: 2, Text: "jams", Err: ErrUnknownMode}, err)
+	_, err = Parse("D dorian with jams")
+	assert.Equal(t, &ParseError{Name: "D dorian with jams", Position: 9, Text: "with", Err: ErrUnknownMode}, err)
+	_, err = Parse("E♭ dorian with jams")
+	assert.Equal(t, &ParseError{Name: "E♭ dorian with jams", Position: 12, Text: "with", Err: ErrUnknownMode}, err)
+}
+
+func TestParse_PartlyUnknownMode(t *testing.T) {
+	for name, expect := range map[string]*ParseError{
+		"C majr":    {Name: "C majr", Position: 5, Text: "r", Err: ErrUnknownMode},
+		"C majorx":  {Name: "C majorx", Position: 7, Text: "x", Err: ErrUnknownMode},
+		"C minr":    {Name: "C minr", Position: 5, Text: "r", Err: ErrUnknownMode},
+		"C dorianz": {Name: "C dorianz", Position: 8, Text: "z", Err: ErrUnknownMode},
+	} {
+		_, err := Parse(name)
+		assert.Equal(t, expect, err, name)
+	}
+	for _, name := range []string{"C maj", "C min", "C harmonic minor", "C mel min", "D dorian"} {
+		_, err := Parse(name)
+		assert.Nil(t, err, name)
+	}
+}
+
+func TestOf_Unicode(t *testing.T) {
+	assert.Equal(t, Of("Bb harmonic minor"), Of("B♭ harmonic minor"))
+	assert.Equal(t, Of("F# lydian"), Of("F＃ lydian"))
+	s := Of("E𝄫 major")
+	assert.Equal(t, note.D, s.Root)
+	assert.Equal(t, map[Interval]note.Class{I1: note.D, I2: note.E, I3: note.Fs, I4: note.G, I5: note.A, I6: note.B, I7: note.Cs}, s.Tones)
+}
+
+func TestParse_DoubleAccidentals(t *testing.T) {
+	s, err := Parse("F𝄪 major")
+	assert.Nil(t, err)
+	assert.Equal(t, note.G, s.Root)
+	assert.Equal(t, map[Interval]note.Class{I1: note.G, I2: note.A, I3: note.B, I4: note.C, I5: note.D, I6: note.E, I7: note.Fs}, s.Tones)
+	s, err = Parse("B𝄫 minor")
+	assert.Nil(t, err)
+	assert.Equal(t, note.A, s.Root)
+	assert.Equal(t, map[Interval]note.Class{I1: note.A, I2: note.B, I3: note.C, I4: note.D, I5: note.E, I6: note.F, I7: note.G}, s.Tones)
+}
+
 func TestNotes(t *testing.T) {
 	c := Of("C natural minor")
 	assert.Equal(t, []*note.Note{
@@ -47,9 +112,35 @@ func TestNotes(t *testing.T) {
 	}, c.Notes())
 }
 
+func TestOf_ToneInterval(t *testing.T) {
+	s := Of("C aug")
+	assert.Equal(t, map[Interval]string{I1: "P1", I2: "A2", I3: "M3", I4: "P5", I5: "A5", I6: "M7"}, s.ToneInterval)
+}
+
 func TestOf_Invalid(t *testing.T) {
 	k := key.Of("P-funk")
-	assert.Equal(t, note.Nil, k.Root)
+	assert.Equal(t, note.Nil, note.Class(k.Root))
+}
+
+func TestOf_AllocationBudget(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+	for _, name := range benchmarkNames {
+		assert.True(t, testing.AllocsPerRun(100, func() { Of(name) }) <= 4, fmt.Sprintf("name:%v", name))
+	}
+}
+
+func BenchmarkScaleOf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Of(benchmarkNames[i%len(benchmarkNames)])
+	}
+}
+
+func BenchmarkScaleParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse(benchmarkNames[i%len(benchmarkNames)])
+	}
 }
 
 //
@@ -64,3 +155,6 @@ type testKey struct {
 type testExpectationManifest struct {
 	Scales map[string]testKey
 }
+
+// benchmarkNames of scales, in many modes
+var benchmarkNames = []string{"C", "A minor", "D dorian", "G mixolydian", "F lydian", "E phrygian", "B locrian", "C harmonic minor", "Bb melodic minor ascend", "C natural minor"}
@@ -0,0 +1,53 @@
+// Scales can be searched by the tones they contain, over a catalog of the common scales on every root, each scored by how closely its tones match
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestSearch(t *testing.T) {
+	found := Search(SearchOptions{Tones: toneset.Of(note.C, note.E, note.G, note.As, note.D), AdjSymbol: note.Flat})
+	assert.Equal(t, 9, len(found))
+	assert.Equal(t, "C mixolydian", found[0].Name)
+	assert.Equal(t, note.C, found[0].Scale.Root)
+	assert.InDelta(t, 5.0/7, found[0].Score, 0.001)
+	assert.Equal(t, []note.Class{note.F, note.A}, found[0].Extra)
+	assert.Equal(t, "F melodic minor ascend", found[4].Name)
+	assert.Equal(t, []note.Class{note.F, note.Gs}, found[4].Extra)
+}
+
+func TestSearch_Exact(t *testing.T) {
+	found := Search(SearchOptions{Tones: Of("C major").ToneSet(), Exact: true})
+	assert.Equal(t, []string{"C major", "D dorian", "E phrygian", "F lydian", "G mixolydian", "A minor", "B locrian"}, foundNames(found))
+	assert.Equal(t, 0, len(Search(SearchOptions{Tones: toneset.Of(note.C, note.E, note.G), Exact: true})))
+}
+
+func TestSearch_Partial(t *testing.T) {
+	found := Search(SearchOptions{Tones: toneset.Of(note.C, note.Cs, note.D), Partial: true})
+	assert.True(t, len(found) > 0)
+	assert.Equal(t, "D augmented", found[0].Name)
+	for _, f := range found {
+		assert.True(t, len(f.Missing) < 3, f.Name)
+	}
+}
+
+func TestSearch_None(t *testing.T) {
+	assert.Equal(t, 0, len(Search(SearchOptions{})))
+}
+
+//
+// Private
+//
+
+func foundNames(found []Found) []string {
+	var names []string
+	for _, f := range found {
+		names = append(names, f.Name)
+	}
+	return names
+}
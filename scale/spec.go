@@ -2,15 +2,46 @@
 package scale
 
 import (
+	"encoding/json"
+
 	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/schema"
 )
 
+// ToYAML of the Scale, e.g. for the command-line utility
 func (c Scale) ToYAML() string {
 	spec := specFrom(c)
 	out, _ := yaml.Marshal(spec)
 	return string(out[:])
 }
 
+// ToJSON of the Scale, e.g. for a web app
+func (c Scale) ToJSON() string {
+	spec := specFrom(c)
+	out, _ := json.Marshal(spec)
+	return string(out[:])
+}
+
+// ToYAMLSchema of the Scale in a version of the schema, e.g. schema.V2 with its intervals and degrees, else as ToYAML
+func (c Scale) ToYAMLSchema(v schema.Version) string {
+	if v != schema.V2 {
+		return c.ToYAML()
+	}
+	out, _ := yaml.Marshal(specV2From(c))
+	return string(out[:])
+}
+
+// ToJSONSchema of the Scale in a version of the schema, e.g. schema.V2 with its intervals and degrees, else as ToJSON
+func (c Scale) ToJSONSchema(v schema.Version) string {
+	if v != schema.V2 {
+		return c.ToJSON()
+	}
+	out, _ := json.Marshal(specV2From(c))
+	return string(out[:])
+}
+
 //
 // Private
 //
@@ -18,14 +49,45 @@ func (c Scale) ToYAML() string {
 func specFrom(c Scale) specScale {
 	s := specScale{}
 	s.Root = c.Root.String(c.AdjSymbol)
-	s.Tones = make(map[int]string)
-	for i, t := range c.Tones {
-		s.Tones[int(i)] = t.String(c.AdjSymbol)
-	}
+	s.Tones = degreesOf(c.OrderedTones(), func(t Tone) string { return t.Class.String(c.AdjSymbol) })
 	return s
 }
 
 type specScale struct {
-	Root  string
-	Tones map[int]string
+	Root  string         `json:"root"`
+	Tones schema.Degrees `json:"tones"`
+}
+
+// specV2From a scale, its v1 spec, its formula, and each of its degrees in ascending order, with its note and interval from the root
+func specV2From(c Scale) specScaleV2 {
+	v1 := specFrom(c)
+	s := specScaleV2{Schema: string(schema.V2), Root: v1.Root, Tones: v1.Tones, Intervals: schema.Degrees{}, Degrees: []specDegree{}}
+	if len(c.ToneInterval) > 0 {
+		s.Intervals = degreesOf(c.namedTones(), func(t Tone) string { return t.Name })
+	}
+	if len(c.Tones) > 0 {
+		f := c.Formula()
+		s.Formula = &f
+	}
+	for _, t := range c.OrderedTones() {
+		if t.Class != note.Nil {
+			s.Degrees = append(s.Degrees, specDegree{Degree: int(t.Interval), Note: t.Class.String(c.AdjSymbol), Interval: t.Name})
+		}
+	}
+	return s
+}
+
+type specScaleV2 struct {
+	Schema    string         `json:"schema"`
+	Root      string         `json:"root"`
+	Tones     schema.Degrees `json:"tones"`
+	Intervals schema.Degrees `json:"intervals"`
+	Formula   *Formula       `json:"formula,omitempty" yaml:",omitempty"`
+	Degrees   []specDegree   `json:"degrees"`
+}
+
+type specDegree struct {
+	Degree   int    `json:"degree"`
+	Note     string `json:"note"`
+	Interval string `json:"interval,omitempty" yaml:",omitempty"`
 }
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/schema"
 )
 
 func TestToYAML(t *testing.T) {
@@ -12,3 +14,64 @@ func TestToYAML(t *testing.T) {
 	out := c.ToYAML()
 	assert.Equal(t, "root: C\ntones:\n  1: C\n  2: D\n  3: Eb\n  4: F\n  5: G\n  6: Ab\n  7: Bb\n", out)
 }
+
+func TestToYAMLSchema(t *testing.T) {
+	c := Of("D dorian")
+	assert.Equal(t, c.ToYAML(), c.ToYAMLSchema(schema.V1))
+	assert.Equal(t, `schema: v2
+root: D
+tones:
+  1: D
+  2: E
+  3: F
+  4: G
+  5: A
+  6: B
+  7: C
+intervals:
+  1: P1
+  2: M2
+  3: m3
+  4: P4
+  5: P5
+  6: M6
+  7: m7
+formula:
+  steps: W H W W W H W
+  intervals: 1 2 b3 4 5 6 b7
+degrees:
+- degree: 1
+  note: D
+  interval: P1
+- degree: 2
+  note: E
+  interval: M2
+- degree: 3
+  note: F
+  interval: m3
+- degree: 4
+  note: G
+  interval: P4
+- degree: 5
+  note: A
+  interval: P5
+- degree: 6
+  note: B
+  interval: M6
+- degree: 7
+  note: C
+  interval: m7
+`, c.ToYAMLSchema(schema.V2))
+}
+
+func TestToJSONSchema(t *testing.T) {
+	c := Of("C major")
+	assert.Equal(t, c.ToJSON(), c.ToJSONSchema(schema.V1))
+	assert.Equal(t, `{"schema":"v2","root":"C","tones":{"1":"C","2":"D","3":"E","4":"F","5":"G","6":"A","7":"B"},"intervals":{"1":"P1","2":"M2","3":"M3","4":"P4","5":"P5","6":"M6","7":"M7"},"formula":{"steps":"W W H W W W H","intervals":"1 2 3 4 5 6 7"},"degrees":[{"degree":1,"note":"C","interval":"P1"},{"degree":2,"note":"D","interval":"M2"},{"degree":3,"note":"E","interval":"M3"},{"degree":4,"note":"F","interval":"P4"},{"degree":5,"note":"G","interval":"P5"},{"degree":6,"note":"A","interval":"M6"},{"degree":7,"note":"B","interval":"M7"}]}`, c.ToJSONSchema(schema.V2))
+}
+
+func TestToJSON(t *testing.T) {
+	c := Of("Cm769-5")
+	out := c.ToJSON()
+	assert.Equal(t, `{"root":"C","tones":{"1":"C","2":"D","3":"Eb","4":"F","5":"G","6":"Ab","7":"Bb"}}`, out)
+}
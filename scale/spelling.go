@@ -0,0 +1,36 @@
+// Scales are respelled in a key, with sharps or flats like its key signature, e.g. the Eb of Bb major instead of D#
+package scale
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// SpelledIn a key, the same scale with its tones spelled with the sharps or flats of the key signature, without changing its pitch classes,
+// or as it was, if the key signature has neither, e.g. in C major. The scale returned has its own tones, not shared with this one.
+func (this Scale) SpelledIn(k chord.Key) Scale {
+	switch fifths := k.Fifths(); {
+	case fifths > 0:
+		this.AdjSymbol = note.Sharp
+	case fifths < 0:
+		this.AdjSymbol = note.Flat
+	}
+	return this.Copy()
+}
+
+// Copy of the scale, with its own copy of the maps of its tones, so that changing one doesn't change the other, e.g. of a scale kept in a cache
+func (this Scale) Copy() Scale {
+	tones := make(map[Interval]note.Class, len(this.Tones))
+	for i, class := range this.Tones {
+		tones[i] = class
+	}
+	this.Tones = tones
+	if this.ToneInterval != nil {
+		toneInterval := make(map[Interval]string, len(this.ToneInterval))
+		for i, name := range this.ToneInterval {
+			toneInterval[i] = name
+		}
+		this.ToneInterval = toneInterval
+	}
+	return this
+}
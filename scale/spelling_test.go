@@ -0,0 +1,47 @@
+// Scales are respelled in a key, with sharps or flats like its key signature, e.g. the Eb of Bb major instead of D#
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestScale_SpelledIn(t *testing.T) {
+	s := Of("A# major")
+	assert.Equal(t, "D#", s.Tones[I4].String(s.AdjSymbol))
+	spelled := s.SpelledIn(signature(-2))
+	assert.Equal(t, note.Flat, spelled.AdjSymbol)
+	assert.Equal(t, s.Tones, spelled.Tones)
+	assert.Equal(t, "Eb", spelled.Tones[I4].String(spelled.AdjSymbol))
+	assert.Equal(t, note.Flat, Of("Bb major").SpelledIn(signature(0)).AdjSymbol)
+}
+
+func TestScale_SpelledIn_Copied(t *testing.T) {
+	s := Of("C major")
+	spelled := s.SpelledIn(signature(1))
+	spelled.Tones[I4] = note.Fs
+	assert.Equal(t, note.F, s.Tones[I4])
+}
+
+func TestScale_Copy(t *testing.T) {
+	s := Of("C major")
+	copied := s.Copy()
+	assert.Equal(t, s, copied)
+	delete(copied.Tones, I7)
+	copied.ToneInterval[I1] = "R"
+	assert.Equal(t, Of("C major"), s)
+}
+
+//
+// Private
+//
+
+// signature of a key, by its fifths from C major
+type signature int
+
+func (s signature) Fifths() int {
+	return int(s)
+}
@@ -0,0 +1,48 @@
+// Tones of a scale in ascending order of interval from the root, e.g. 1 2 3 4 5 6 7, the same every time, unlike an iteration of its map
+package scale
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/schema"
+)
+
+// Tone of a scale, its pitch class at an interval from the root, and the name of that interval, e.g. m3 or P5
+type Tone struct {
+	Interval Interval
+	Class    note.Class
+	Name     string
+}
+
+// OrderedTones of the scale, copied from the map of its Tones and sorted in ascending order of interval, e.g. D E F G A B C of D dorian
+func (this Scale) OrderedTones() []Tone {
+	tones := make([]Tone, 0, len(this.Tones))
+	for i, class := range this.Tones {
+		tones = append(tones, Tone{Interval: i, Class: class, Name: this.ToneInterval[i]})
+	}
+	sort.Slice(tones, func(a, b int) bool { return tones[a].Interval < tones[b].Interval })
+	return tones
+}
+
+//
+// Private
+//
+
+// namedTones of the scale, those with an interval name, in ascending order of interval
+func (this Scale) namedTones() (tones []Tone) {
+	for _, t := range this.OrderedTones() {
+		if _, ok := this.ToneInterval[t.Interval]; ok {
+			tones = append(tones, t)
+		}
+	}
+	return
+}
+
+// degreesOf the tones of a scale, each named by the given function, in ascending order of interval
+func degreesOf(tones []Tone, nameOf func(t Tone) string) (degrees schema.Degrees) {
+	for _, t := range tones {
+		degrees = append(degrees, schema.Degree{Number: int(t.Interval), Name: nameOf(t)})
+	}
+	return
+}
@@ -0,0 +1,30 @@
+// Tones of a scale in ascending order of interval from the root, e.g. 1 2 3 4 5 6 7, the same every time, unlike an iteration of its map
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestScale_OrderedTones(t *testing.T) {
+	var classes []note.Class
+	var names []string
+	for _, tone := range Of("D dorian").OrderedTones() {
+		classes = append(classes, tone.Class)
+		names = append(names, tone.Name)
+	}
+	assert.Equal(t, []note.Class{note.D, note.E, note.F, note.G, note.A, note.B, note.C}, classes)
+	assert.Equal(t, []string{"P1", "M2", "m3", "P4", "P5", "M6", "m7"}, names)
+	assert.Equal(t, []Tone{}, Scale{}.OrderedTones())
+}
+
+func TestScale_OrderedTones_ToJSON(t *testing.T) {
+	s := Scale{Root: note.C, AdjSymbol: note.Sharp, Tones: map[Interval]note.Class{}}
+	for i, class := range []note.Class{note.C, note.Cs, note.D, note.Ds, note.E, note.F, note.Fs, note.G, note.Gs, note.A} {
+		s.Tones[Interval(i+1)] = class
+	}
+	assert.Equal(t, `{"root":"C","tones":{"1":"C","2":"C#","3":"D","4":"D#","5":"E","6":"F","7":"F#","8":"G","9":"G#","10":"A"}}`, s.ToJSON())
+}
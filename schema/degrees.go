@@ -0,0 +1,49 @@
+// Degrees are serialized as a mapping in ascending order of number, e.g. 1 3 5 7 9 11 13, in every version of the schema
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Degrees of a serialization, e.g. the tones of a chord by interval, in ascending order of number
+// both in YAML and in JSON, whose encoder would otherwise sort the keys of a map as strings, e.g. "11" before "3"
+type Degrees []Degree
+
+// Degree of a serialization, its number and name, e.g. 3 and "Eb"
+type Degree struct {
+	Number int
+	Name   string
+}
+
+// MarshalJSON as an object of each name by its number, in the order of the degrees
+func (d Degrees) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for n, degree := range d {
+		if n > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(degree.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(strconv.Quote(strconv.Itoa(degree.Number)))
+		buf.WriteByte(':')
+		buf.Write(name)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML as a mapping of each name by its number, in the order of the degrees
+func (d Degrees) MarshalYAML() (interface{}, error) {
+	m := make(yaml.MapSlice, 0, len(d))
+	for _, degree := range d {
+		m = append(m, yaml.MapItem{Key: degree.Number, Value: degree.Name})
+	}
+	return m, nil
+}
@@ -0,0 +1,47 @@
+// Degrees are serialized as a mapping in ascending order of number, e.g. 1 3 5 7 9 11 13, in every version of the schema
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestDegrees_MarshalJSON(t *testing.T) {
+	out, err := json.Marshal(struct {
+		Tones Degrees `json:"tones"`
+	}{Degrees{{1, "C"}, {3, "E"}, {11, "F"}, {13, "A"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"tones":{"1":"C","3":"E","11":"F","13":"A"}}`, string(out))
+	out, err = json.Marshal(Degrees{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{}`, string(out))
+	out, err = json.Marshal(Degrees{{4, `a "quoted" name`}})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"4":"a \"quoted\" name"}`, string(out))
+}
+
+func TestDegrees_MarshalYAML(t *testing.T) {
+	out, err := yaml.Marshal(struct {
+		Tones     Degrees
+		Intervals Degrees `yaml:",omitempty"`
+	}{Tones: Degrees{{1, "C"}, {11, "F"}, {3, "E"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, "tones:\n  1: C\n  11: F\n  3: E\n", string(out), "in the order given")
+}
+
+func TestDegrees_SameAsMap(t *testing.T) {
+	degrees := Degrees{{1, "C"}, {3, "Eb"}, {5, "G"}}
+	fromMap, err := json.Marshal(map[int]string{1: "C", 3: "Eb", 5: "G"})
+	assert.Nil(t, err)
+	fromDegrees, err := json.Marshal(degrees)
+	assert.Nil(t, err)
+	assert.Equal(t, string(fromMap), string(fromDegrees))
+	fromMap, err = yaml.Marshal(map[int]string{1: "C", 3: "Eb", 5: "G"})
+	assert.Nil(t, err)
+	fromDegrees, err = yaml.Marshal(degrees)
+	assert.Nil(t, err)
+	assert.Equal(t, string(fromMap), string(fromDegrees))
+}
@@ -0,0 +1,50 @@
+// Schema versions of the YAML and JSON serialization of chords, scales and keys, so that downstream parsers can rely on the fields of a version as the models grow.
+//
+// Version 1 is the original serialization, e.g. the root and tones of a chord, and is never changed.
+// Version 2 names itself, and adds e.g. the bass of a slash chord and the degrees and inversions of a chord, or the degrees of a scale or key.
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Version of the schema, e.g. V2
+type Version string
+
+// Versions of the schema, the Latest having the most fields
+const (
+	V1     Version = "v1"
+	V2     Version = "v2"
+	Latest         = V2
+)
+
+// ErrUnknownVersion when parsing a version that doesn't exist, e.g. "v9"
+var ErrUnknownVersion = errors.New("unknown schema version")
+
+// Versions of the schema, from the oldest to the Latest
+func Versions() []Version {
+	return []Version{V1, V2}
+}
+
+// Parse a version, e.g. "v2" or "2"
+func Parse(text string) (Version, error) {
+	v := Version("v" + strings.TrimPrefix(strings.ToLower(strings.TrimSpace(text)), "v"))
+	for _, known := range Versions() {
+		if v == known {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("%w %q", ErrUnknownVersion, text)
+}
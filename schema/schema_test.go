@@ -0,0 +1,40 @@
+// Schema versions of the YAML and JSON serialization of chords, scales and keys, so that downstream parsers can rely on the fields of a version as the models grow.
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParse(t *testing.T) {
+	assertParse(t, V1, "v1")
+	assertParse(t, V2, "v2")
+	assertParse(t, V2, "2")
+	assertParse(t, V2, " V2 ")
+}
+
+func TestParse_Unknown(t *testing.T) {
+	for _, text := range []string{"v9", "", "v", "latest", "vv2"} {
+		_, err := Parse(text)
+		assert.True(t, errors.Is(err, ErrUnknownVersion), text)
+	}
+	_, err := Parse("v9")
+	assert.Equal(t, `unknown schema version "v9"`, err.Error())
+}
+
+func TestVersions(t *testing.T) {
+	assert.Equal(t, []Version{V1, V2}, Versions())
+	assert.Equal(t, V2, Latest)
+}
+
+//
+// Private
+//
+
+func assertParse(t *testing.T, expect Version, text string) {
+	v, err := Parse(text)
+	assert.Nil(t, err, text)
+	assert.Equal(t, expect, v, text)
+}
@@ -0,0 +1,71 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/scale"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+// searchNotes named, e.g. "C E G Bb D", for the chords and then the scales containing them, or exactly or only some of them,
+// writing a table of up to a limit of each, or all of them if the limit is 0, by name, score, and the tones missing and extra
+func searchNotes(w io.Writer, names string, exact, partial bool, limit int) error {
+	tones, err := tonesNamed(names)
+	if err != nil {
+		return err
+	}
+	adjSymbol := note.AdjSymbolOf(names)
+	chords := chord.Search(chord.SearchOptions{Tones: tones, AdjSymbol: adjSymbol, Exact: exact, Partial: partial})
+	scales := scale.Search(scale.SearchOptions{Tones: tones, AdjSymbol: adjSymbol, Exact: exact, Partial: partial})
+	if len(chords) == 0 && len(scales) == 0 {
+		_, err = fmt.Fprintf(w, "No chords or scales of %s\n", names)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tNAME\tSCORE\tMISSING\tEXTRA")
+	for n, f := range chords {
+		if limit > 0 && n >= limit {
+			break
+		}
+		fmt.Fprintf(tw, "chord\t%s\t%.0f%%\t%s\t%s\n", f.Chord.Name(), f.Score*100, classNames(f.Missing, adjSymbol), classNames(f.Extra, adjSymbol))
+	}
+	for n, f := range scales {
+		if limit > 0 && n >= limit {
+			break
+		}
+		fmt.Fprintf(tw, "scale\t%s\t%.0f%%\t%s\t%s\n", f.Name, f.Score*100, classNames(f.Missing, adjSymbol), classNames(f.Extra, adjSymbol))
+	}
+	return tw.Flush()
+}
+
+// tonesNamed by note names separated by spaces or commas, e.g. "C E G Bb D", each without an octave
+func tonesNamed(names string) (toneset.Set, error) {
+	tones := toneset.Of()
+	for _, name := range strings.FieldsFunc(names, func(r rune) bool { return r == ' ' || r == ',' }) {
+		class, remaining := note.RootAndRemaining(name)
+		if class == note.Nil || len(remaining) > 0 {
+			return nil, fmt.Errorf("%w %q", pitch.ErrUnknownNote, name)
+		}
+		tones[class] = true
+	}
+	return tones, nil
+}
+
+// classNames spelled with an accidental, separated by spaces, or - of none
+func classNames(classes []note.Class, adjSymbol note.AdjSymbol) string {
+	if len(classes) == 0 {
+		return "-"
+	}
+	var names []string
+	for _, class := range classes {
+		names = append(names, class.String(adjSymbol))
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,54 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/toneset"
+)
+
+func TestSearchNotes(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, searchNotes(&out, "C E G Bb D", false, false, 2))
+	assert.Equal(t, "KIND   NAME          SCORE  MISSING  EXTRA\n"+
+		"chord  C9            100%   -        -\n"+
+		"chord  FM13          71%    -        F A\n"+
+		"scale  C mixolydian  71%    -        F A\n"+
+		"scale  D minor       71%    -        F A\n", out.String())
+	out.Reset()
+	assert.Nil(t, searchNotes(&out, "C E G A", true, false, 0))
+	assert.Equal(t, "KIND   NAME  SCORE  MISSING  EXTRA\n"+
+		"chord  C6    100%   -        -\n"+
+		"chord  Am7   100%   -        -\n", out.String())
+	out.Reset()
+	assert.Nil(t, searchNotes(&out, "C, Db, D", true, false, 0))
+	assert.Equal(t, "No chords or scales of C, Db, D\n", out.String())
+	assert.True(t, errors.Is(searchNotes(&out, "C H", false, false, 0), pitch.ErrUnknownNote))
+}
+
+func TestTonesNamed(t *testing.T) {
+	tones, err := tonesNamed("C, Eb G  Bb")
+	assert.Nil(t, err)
+	assert.Equal(t, toneset.Of(note.C, note.Ds, note.G, note.As), tones)
+	_, err = tonesNamed("C7")
+	assert.NotNil(t, err)
+}
+
+func TestClassNames(t *testing.T) {
+	assert.Equal(t, "-", classNames(nil, note.Sharp))
+	assert.Equal(t, "C# F#", classNames([]note.Class{note.Cs, note.Fs}, note.Sharp))
+	assert.Equal(t, "Db Gb", classNames([]note.Class{note.Cs, note.Fs}, note.Flat))
+}
+
+func TestSearchExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "search")
+	assertExitCode(t, 0, "", "search", "--notes", "C E G Bb D")
+	assertExitCode(t, 0, "", "search", "-n", "C E G", "--partial", "--limit", "0")
+	assertExitCode(t, 1, "Error occurred: unknown note \"H\"\n", "search", "-n", "C H")
+}
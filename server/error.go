@@ -0,0 +1,35 @@
+// Errors are responded in a structure, e.g. {"error":{"status":400,"message":"unknown root \"H\" at position 0 of chord \"H\""}}
+package server
+
+// Error responded by the server
+type Error struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+// Error message
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// ToJSON of the Error, wrapped in an "error" field
+func (e *Error) ToJSON() string {
+	return marshalJSON(specError{e})
+}
+
+// ToYAML of the Error, wrapped in an "error" field
+func (e *Error) ToYAML() string {
+	return marshalYAML(specError{e})
+}
+
+//
+// Private
+//
+
+func newError(status int, message string) *Error {
+	return &Error{Status: status, Message: message}
+}
+
+type specError struct {
+	Error *Error `json:"error"`
+}
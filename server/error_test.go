@@ -0,0 +1,15 @@
+// Errors are responded in a structure, e.g. {"error":{"status":400,"message":"unknown root \"H\" at position 0 of chord \"H\""}}
+package server
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestError(t *testing.T) {
+	err := newError(400, "unknown chord root: H")
+	assert.Equal(t, "unknown chord root: H", err.Error())
+	assert.Equal(t, `{"error":{"status":400,"message":"unknown chord root: H"}}`, err.ToJSON())
+	assert.Equal(t, "error:\n  status: 400\n  message: 'unknown chord root: H'\n", err.ToYAML())
+}
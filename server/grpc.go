@@ -0,0 +1,142 @@
+// The gRPC server serves the MusicTheory service of the protocol buffer schema, of the same models as the HTTP API, for typed clients in any language.
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/progression"
+	musictheory "github.com/go-music-theory/music-theory/proto"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// GRPCServer of the MusicTheory service, refusing a message larger than MaxBodyBytes, timing out a connection that doesn't set up within the ReadHeaderTimeout,
+// and closing one idle for the IdleTimeout
+func GRPCServer() *grpc.Server {
+	s := grpc.NewServer(grpc.MaxRecvMsgSize(MaxBodyBytes), grpc.ConnectionTimeout(ReadHeaderTimeout), grpc.KeepaliveParams(keepalive.ServerParameters{MaxConnectionIdle: IdleTimeout}))
+	musictheory.RegisterMusicTheoryServer(s, musicTheory{})
+	return s
+}
+
+// ListenAndServeGRPC the MusicTheory service at an address, e.g. ":8080"
+func ListenAndServeGRPC(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return GRPCServer().Serve(listener)
+}
+
+//
+// Private
+//
+
+// musicTheory service, of the same models as the endpoints of the HTTP API, with InvalidArgument of any name that doesn't parse
+type musicTheory struct {
+	musictheory.UnimplementedMusicTheoryServer
+}
+
+func (musicTheory) GetChord(_ context.Context, req *musictheory.NameRequest) (*musictheory.Chord, error) {
+	if len(strings.TrimSpace(req.GetName())) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing name of chord")
+	}
+	c, err := chord.Parse(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return protoChordOf(c), nil
+}
+
+func (musicTheory) GetScale(_ context.Context, req *musictheory.NameRequest) (*musictheory.Scale, error) {
+	if len(strings.TrimSpace(req.GetName())) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing name of scale")
+	}
+	s, err := scale.Parse(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ps := &musictheory.Scale{Root: s.Root.String(s.AdjSymbol)}
+	for _, t := range s.OrderedTones() {
+		ps.Tones = append(ps.Tones, &musictheory.Tone{Degree: int32(t.Interval), Class: t.Class.String(s.AdjSymbol), Name: t.Name})
+	}
+	return ps, nil
+}
+
+func (musicTheory) GetKey(_ context.Context, req *musictheory.NameRequest) (*musictheory.Key, error) {
+	if len(strings.TrimSpace(req.GetName())) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing name of key")
+	}
+	k, err := key.Parse(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &musictheory.Key{Root: k.Root.String(k.AdjSymbol), Mode: k.Mode.String(), Relative: protoRelativeKeyOf(k.Relative())}, nil
+}
+
+func (musicTheory) GetPitch(_ context.Context, req *musictheory.PitchRequest) (*musictheory.Pitch, error) {
+	tuning := req.GetTuning()
+	if tuning == 0 {
+		tuning = pitch.Standard
+	}
+	if _, err := pitch.Parse(req.GetNote()); errors.Is(err, pitch.ErrUnknownNote) {
+		return nil, status.Error(codes.InvalidArgument, "unknown note: "+req.GetNote())
+	}
+	precision := pitch.DefaultPrecision
+	if req.Precision != nil {
+		precision = int(req.GetPrecision())
+	}
+	p, err := pitch.OfNoteWithPrecision(req.GetNote(), tuning, precision)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &musictheory.Pitch{Note: req.GetNote(), Tuning: tuning, Pitch: p}, nil
+}
+
+func (musicTheory) AnalyzeProgression(_ context.Context, req *musictheory.ProgressionRequest) (*musictheory.Progression, error) {
+	var names []string
+	for _, name := range req.GetChords() {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			if _, err := chord.Parse(name); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no chords to analyze")
+	}
+	p := progression.Of(names...)
+	pp := &musictheory.Progression{Key: protoRelativeKeyOf(p.Key())}
+	for _, c := range p.Chords {
+		pp.Chords = append(pp.Chords, protoChordOf(c))
+	}
+	return pp, nil
+}
+
+// protoChordOf a chord, its root, its tones in order up from the root, each by its degree, class and the name of its interval, and the bass of a slash chord or inversion
+func protoChordOf(c chord.Chord) *musictheory.Chord {
+	pc := &musictheory.Chord{Root: c.Root.String(c.AdjSymbol)}
+	for _, t := range c.OrderedTones() {
+		pc.Tones = append(pc.Tones, &musictheory.Tone{Degree: int32(t.Degree.Interval), Class: t.Class.String(c.AdjSymbol), Name: t.Name})
+	}
+	if c.Bass != note.Nil {
+		pc.Bass = c.Bass.String(c.AdjSymbol)
+	}
+	return pc
+}
+
+// protoRelativeKeyOf a key, its root and mode
+func protoRelativeKeyOf(k key.Key) *musictheory.RelativeKey {
+	return &musictheory.RelativeKey{Root: k.Root.String(k.AdjSymbol), Mode: k.Mode.String()}
+}
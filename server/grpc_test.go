@@ -0,0 +1,116 @@
+// The gRPC server serves the MusicTheory service of the protocol buffer schema, of the same models as the HTTP API, for typed clients in any language.
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	musictheory "github.com/go-music-theory/music-theory/proto"
+)
+
+func TestGRPC_GetChord(t *testing.T) {
+	c, err := grpcClient(t).GetChord(context.Background(), &musictheory.NameRequest{Name: "Cm7/G"})
+	assert.Nil(t, err)
+	assert.Equal(t, "C", c.GetRoot())
+	assert.Equal(t, []string{"1 C P1", "3 Eb m3", "5 G P5", "7 Bb m7"}, toneStringsOf(c.GetTones()))
+	assert.Equal(t, "G", c.GetBass())
+}
+
+func TestGRPC_GetChord_UnknownRoot(t *testing.T) {
+	_, err := grpcClient(t).GetChord(context.Background(), &musictheory.NameRequest{Name: "garbage"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, `unknown root "garbage" at position 0 of chord "garbage"`, status.Convert(err).Message())
+	_, err = grpcClient(t).GetChord(context.Background(), &musictheory.NameRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_GetScale(t *testing.T) {
+	s, err := grpcClient(t).GetScale(context.Background(), &musictheory.NameRequest{Name: "C aug"})
+	assert.Nil(t, err)
+	assert.Equal(t, "C", s.GetRoot())
+	assert.Equal(t, []string{"1 C P1", "2 D# A2", "3 E M3", "4 G P5", "5 G# A5", "6 B M7"}, toneStringsOf(s.GetTones()))
+}
+
+func TestGRPC_GetKey(t *testing.T) {
+	k, err := grpcClient(t).GetKey(context.Background(), &musictheory.NameRequest{Name: "Db"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Db", k.GetRoot())
+	assert.Equal(t, "Major", k.GetMode())
+	assert.Equal(t, "Bb", k.GetRelative().GetRoot())
+	assert.Equal(t, "Minor", k.GetRelative().GetMode())
+}
+
+func TestGRPC_GetPitch(t *testing.T) {
+	p, err := grpcClient(t).GetPitch(context.Background(), &musictheory.PitchRequest{Note: "A4", Tuning: 432})
+	assert.Nil(t, err)
+	assert.Equal(t, "432.00Hz", p.GetPitch())
+	p, err = grpcClient(t).GetPitch(context.Background(), &musictheory.PitchRequest{Note: "A4"})
+	assert.Nil(t, err)
+	assert.Equal(t, 440.0, p.GetTuning(), "the standard pitch if unset")
+	assert.Equal(t, "440.00Hz", p.GetPitch())
+	_, err = grpcClient(t).GetPitch(context.Background(), &musictheory.PitchRequest{Note: "H4"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_GetPitch_Precision(t *testing.T) {
+	p, err := grpcClient(t).GetPitch(context.Background(), &musictheory.PitchRequest{Note: "C4", Tuning: 415, Precision: proto.Int32(3)})
+	assert.Nil(t, err)
+	assert.Equal(t, "246.760Hz", p.GetPitch())
+	p, err = grpcClient(t).GetPitch(context.Background(), &musictheory.PitchRequest{Note: "A4", Precision: proto.Int32(0)})
+	assert.Nil(t, err)
+	assert.Equal(t, "440Hz", p.GetPitch())
+	_, err = grpcClient(t).GetPitch(context.Background(), &musictheory.PitchRequest{Note: "A4", Precision: proto.Int32(50)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_AnalyzeProgression(t *testing.T) {
+	p, err := grpcClient(t).AnalyzeProgression(context.Background(), &musictheory.ProgressionRequest{Chords: []string{"Dm7", "G7", " ", "C"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "C", p.GetKey().GetRoot())
+	assert.Equal(t, "Major", p.GetKey().GetMode())
+	assert.Equal(t, 3, len(p.GetChords()))
+	assert.Equal(t, []string{"1 G P1", "3 B M3", "5 D P5", "7 F m7"}, toneStringsOf(p.GetChords()[1].GetTones()))
+	_, err = grpcClient(t).AnalyzeProgression(context.Background(), &musictheory.ProgressionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "no chords to analyze", status.Convert(err).Message())
+}
+
+//
+// Private
+//
+
+// grpcClient of the MusicTheory service of a GRPCServer, over an in-memory connection, stopped at the end of the test
+func grpcClient(t *testing.T) musictheory.MusicTheoryClient {
+	listener := bufconn.Listen(1 << 20)
+	s := GRPCServer()
+	go func() { _ = s.Serve(listener) }()
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+		s.Stop()
+	})
+	return musictheory.NewMusicTheoryClient(conn)
+}
+
+// toneStringsOf some tones, each its degree, class and the name of its interval, e.g. "3 Eb m3"
+func toneStringsOf(tones []*musictheory.Tone) (strs []string) {
+	for _, t := range tones {
+		strs = append(strs, fmt.Sprintf("%d %s %s", t.GetDegree(), t.GetClass(), t.GetName()))
+	}
+	return
+}
@@ -0,0 +1,145 @@
+// Responses are negotiated by the Accept header of the request, as either JSON (by default) or YAML.
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/schema"
+)
+
+// Content types of responses
+const (
+	ContentTypeJSON = "application/json"
+	ContentTypeYAML = "application/x-yaml"
+)
+
+// Negotiate the content type of a response to the request, or return false if none of its accepted types can be served
+func Negotiate(r *http.Request) (string, bool) {
+	accept := r.Header.Get("Accept")
+	if len(strings.TrimSpace(accept)) == 0 {
+		return ContentTypeJSON, true
+	}
+	for _, mediaType := range acceptedMediaTypes(accept) {
+		if contentType, ok := servedMediaTypes[mediaType]; ok {
+			return contentType, true
+		}
+	}
+	return "", false
+}
+
+//
+// Private
+//
+
+// spec is any model that can be expressed as JSON or YAML
+type spec interface {
+	ToJSON() string
+	ToYAML() string
+}
+
+// schemaSpec is any model that can be expressed as JSON or YAML in a version of its schema
+type schemaSpec interface {
+	spec
+	ToJSONSchema(v schema.Version) string
+	ToYAMLSchema(v schema.Version) string
+}
+
+// versioned model, expressed in a version of its schema
+type versioned struct {
+	model   schemaSpec
+	version schema.Version
+}
+
+func (v versioned) ToJSON() string {
+	return v.model.ToJSONSchema(v.version)
+}
+
+func (v versioned) ToYAML() string {
+	return v.model.ToYAMLSchema(v.version)
+}
+
+// servedMediaTypes maps each acceptable media type to the content type it is served as
+var servedMediaTypes = map[string]string{
+	"*/*":                ContentTypeJSON,
+	"application/*":      ContentTypeJSON,
+	"application/json":   ContentTypeJSON,
+	"application/x-yaml": ContentTypeYAML,
+	"application/yaml":   ContentTypeYAML,
+	"text/*":             ContentTypeYAML,
+	"text/yaml":          ContentTypeYAML,
+	"text/x-yaml":        ContentTypeYAML,
+}
+
+// respond with a model, in the negotiated content type
+func respond(w http.ResponseWriter, r *http.Request, status int, s spec) {
+	contentType, ok := Negotiate(r)
+	if !ok {
+		contentType = ContentTypeJSON
+		status = http.StatusNotAcceptable
+		s = newError(status, "not acceptable: "+r.Header.Get("Accept"))
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Vary", "Accept")
+	w.WriteHeader(status)
+	if r.Method == http.MethodHead {
+		return
+	}
+	switch contentType {
+	case ContentTypeYAML:
+		fmt.Fprint(w, s.ToYAML())
+	default:
+		fmt.Fprintln(w, s.ToJSON())
+	}
+}
+
+// respondError with a status and message, in the negotiated content type
+func respondError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	respond(w, r, status, newError(status, message))
+}
+
+// acceptedMediaTypes of an Accept header, ordered by descending quality, omitting any with zero quality
+func acceptedMediaTypes(accept string) []string {
+	type accepted struct {
+		mediaType string
+		quality   float64
+	}
+	var all []accepted
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		a := accepted{mediaType: strings.ToLower(strings.TrimSpace(params[0])), quality: 1}
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					a.quality = q
+				}
+			}
+		}
+		if a.quality > 0 {
+			all = append(all, a)
+		}
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].quality > all[j].quality })
+	mediaTypes := make([]string, len(all))
+	for i, a := range all {
+		mediaTypes[i] = a.mediaType
+	}
+	return mediaTypes
+}
+
+func marshalJSON(v interface{}) string {
+	out, _ := json.Marshal(v)
+	return string(out[:])
+}
+
+func marshalYAML(v interface{}) string {
+	out, _ := yaml.Marshal(v)
+	return string(out[:])
+}
@@ -0,0 +1,47 @@
+// Responses are negotiated by the Accept header of the request, as either JSON (by default) or YAML.
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	assertNegotiate(t, ContentTypeJSON, true, "")
+	assertNegotiate(t, ContentTypeJSON, true, "*/*")
+	assertNegotiate(t, ContentTypeJSON, true, "application/json")
+	assertNegotiate(t, ContentTypeYAML, true, "application/x-yaml")
+	assertNegotiate(t, ContentTypeYAML, true, "text/yaml, application/json;q=0.5")
+	assertNegotiate(t, ContentTypeJSON, true, "text/yaml;q=0.2, application/json")
+	assertNegotiate(t, ContentTypeJSON, true, "image/png, */*;q=0.1")
+	assertNegotiate(t, "", false, "image/png")
+	assertNegotiate(t, "", false, "application/json;q=0")
+}
+
+func TestRespond_YAML(t *testing.T) {
+	w := serve("GET", "/key/C", "", map[string]string{"Accept": "application/x-yaml"})
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, ContentTypeYAML, w.Header().Get("Content-Type"))
+	assert.Equal(t, "root: C\nmode: Major\nrelative:\n  root: A\n  mode: Minor\n", w.Body.String())
+}
+
+func TestRespond_NotAcceptable(t *testing.T) {
+	w := serve("GET", "/key/C", "", map[string]string{"Accept": "image/png"})
+	assert.Equal(t, 406, w.Code)
+	assert.Equal(t, ContentTypeJSON, w.Header().Get("Content-Type"))
+	assert.Equal(t, `{"error":{"status":406,"message":"not acceptable: image/png"}}`+"\n", w.Body.String())
+}
+
+//
+// Private
+//
+
+func assertNegotiate(t *testing.T, expectContentType string, expectOK bool, accept string) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Accept", accept)
+	contentType, ok := Negotiate(r)
+	assert.Equal(t, expectContentType, contentType, accept)
+	assert.Equal(t, expectOK, ok, accept)
+}
@@ -0,0 +1,240 @@
+// Server exposes the music theory models over HTTP, so that web apps can use the library without cgo or wasm.
+//
+// Every endpoint responds with JSON, or YAML if requested by the Accept header:
+//
+//     GET  /chord/{name}          e.g. /chord/Cm7 or /chord/Cm7?schema=v2
+//     GET  /scale/{name}          e.g. /scale/C%20minor
+//     GET  /key/{name}            e.g. /key/Db
+//     GET  /pitch/{note}          e.g. /pitch/A4?tuning=432 or /pitch/C4?tuning=baroque&precision=3
+//     POST /progression/analyze   e.g. {"chords":["Dm7","G7","C"]}
+//
+// A body larger than MaxBodyBytes is refused with 413 Request Entity Too Large.
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/scale"
+	"github.com/go-music-theory/music-theory/schema"
+)
+
+// MaxBodyBytes of any request body, plenty for the chords of a progression
+const MaxBodyBytes = 64 << 10
+
+// Timeouts of the server of ListenAndServe, to read the header and the whole of a request, to write its response, and to keep an idle connection alive
+const (
+	ReadHeaderTimeout = 5 * time.Second
+	ReadTimeout       = 10 * time.Second
+	WriteTimeout      = 10 * time.Second
+	IdleTimeout       = 60 * time.Second
+)
+
+// Handler for all the endpoints
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chord/", lookup("/chord/", chordOf))
+	mux.HandleFunc("/scale/", lookup("/scale/", scaleOf))
+	mux.HandleFunc("/key/", lookup("/key/", keyOf))
+	mux.HandleFunc("/pitch/", lookup("/pitch/", pitchOf))
+	mux.HandleFunc("/progression/analyze", analyzeProgression)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		respondError(w, r, http.StatusNotFound, "no such endpoint: "+r.URL.Path)
+	})
+	return mux
+}
+
+// ListenAndServe all the endpoints at an address, e.g. ":8080", by a server of the timeouts above, so a slow client can't hold a connection open
+func ListenAndServe(addr string) error {
+	return newServer(addr).ListenAndServe()
+}
+
+//
+// Private
+//
+
+// newServer of all the endpoints at an address, with the timeouts above
+func newServer(addr string) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           Handler(),
+		ReadHeaderTimeout: ReadHeaderTimeout,
+		ReadTimeout:       ReadTimeout,
+		WriteTimeout:      WriteTimeout,
+		IdleTimeout:       IdleTimeout,
+	}
+}
+
+// lookupFunc finds the model for a name taken from the request path, or returns an error
+type lookupFunc func(name string, r *http.Request) (spec, *Error)
+
+// lookup responds to GET requests for the model named by the remainder of the path after its prefix
+func lookup(prefix string, find lookupFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			respondError(w, r, http.StatusMethodNotAllowed, "method not allowed: "+r.Method)
+			return
+		}
+		name := strings.TrimSpace(strings.TrimPrefix(r.URL.Path, prefix))
+		if len(name) == 0 {
+			respondError(w, r, http.StatusBadRequest, "missing name after "+prefix)
+			return
+		}
+		s, err := find(name, r)
+		if err != nil {
+			respond(w, r, err.Status, err)
+			return
+		}
+		respond(w, r, http.StatusOK, s)
+	}
+}
+
+func chordOf(name string, r *http.Request) (spec, *Error) {
+	c, err := chord.Parse(name)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, err.Error())
+	}
+	return versionedOf(c, r)
+}
+
+func scaleOf(name string, r *http.Request) (spec, *Error) {
+	s, err := scale.Parse(name)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, err.Error())
+	}
+	return versionedOf(s, r)
+}
+
+func keyOf(name string, r *http.Request) (spec, *Error) {
+	k, err := key.Parse(name)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, err.Error())
+	}
+	return versionedOf(k, r)
+}
+
+// versionedOf a model in the version of its schema requested by the query, e.g. ?schema=v2, else in its original schema
+func versionedOf(m schemaSpec, r *http.Request) (spec, *Error) {
+	text := r.URL.Query().Get("schema")
+	if len(text) == 0 {
+		return m, nil
+	}
+	v, err := schema.Parse(text)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, err.Error())
+	}
+	return versioned{m, v}, nil
+}
+
+func pitchOf(name string, r *http.Request) (spec, *Error) {
+	tuning := pitch.Standard
+	if t := r.URL.Query().Get("tuning"); len(t) > 0 {
+		var err error
+		if tuning, err = pitch.TuningOf(t); err != nil {
+			return nil, newError(http.StatusBadRequest, "invalid tuning: "+t)
+		}
+	}
+	precision := pitch.DefaultPrecision
+	if pr := r.URL.Query().Get("precision"); len(pr) > 0 {
+		var err error
+		if precision, err = strconv.Atoi(pr); err != nil || precision < 0 || precision > pitch.MaxPrecision {
+			return nil, newError(http.StatusBadRequest, "invalid precision: "+pr)
+		}
+	}
+	if _, err := pitch.Parse(name); errors.Is(err, pitch.ErrUnknownNote) {
+		return nil, newError(http.StatusBadRequest, "unknown note: "+name)
+	}
+	p, err := pitch.OfNoteWithPrecision(name, tuning, precision)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, err.Error())
+	}
+	return specPitch{Note: name, Tuning: tuning, Pitch: p}, nil
+}
+
+// analyzeProgression responds to a POST of chord names, either as JSON {"chords":[...]} or as plain text separated by newlines or bars
+func analyzeProgression(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		respondError(w, r, http.StatusMethodNotAllowed, "method not allowed: "+r.Method)
+		return
+	}
+	tooLarge := "body too large, expected at most " + strconv.Itoa(MaxBodyBytes) + " bytes"
+	if r.ContentLength > MaxBodyBytes {
+		respondError(w, r, http.StatusRequestEntityTooLarge, tooLarge)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodyBytes))
+	if err != nil && len(body) >= MaxBodyBytes {
+		respondError(w, r, http.StatusRequestEntityTooLarge, tooLarge)
+		return
+	}
+	if err != nil {
+		respondError(w, r, http.StatusBadRequest, "cannot read body: "+err.Error())
+		return
+	}
+	var names []string
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		req := progressionRequest{}
+		if err := json.Unmarshal(body, &req); err != nil {
+			respondError(w, r, http.StatusBadRequest, "invalid JSON: "+err.Error())
+			return
+		}
+		names = req.Chords
+	} else {
+		names = strings.FieldsFunc(string(body), func(r rune) bool { return r == '\n' || r == '|' })
+	}
+	var chords []string
+	for _, name := range names {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			if _, err := chord.Parse(name); err != nil {
+				respondError(w, r, http.StatusBadRequest, err.Error())
+				return
+			}
+			chords = append(chords, name)
+		}
+	}
+	if len(chords) == 0 {
+		respondError(w, r, http.StatusBadRequest, "no chords to analyze")
+		return
+	}
+	respond(w, r, http.StatusOK, progression.Of(chords...))
+}
+
+type progressionRequest struct {
+	Chords []string `json:"chords"`
+}
+
+// specPitch of a note for the /pitch endpoint
+type specPitch struct {
+	Note   string  `json:"note"`
+	Tuning float64 `json:"tuning"`
+	Pitch  string  `json:"pitch"`
+}
+
+func (p specPitch) ToJSON() string {
+	return marshalJSON(p)
+}
+
+func (p specPitch) ToYAML() string {
+	return marshalYAML(p)
+}
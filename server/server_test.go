@@ -0,0 +1,176 @@
+// Server exposes the music theory models over HTTP, so that web apps can use the library without cgo or wasm.
+package server
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestChord(t *testing.T) {
+	assertResponse(t, "GET", "/chord/Cm769-5", "", 200,
+		`{"root":"C","tones":{"1":"C","3":"Eb","6":"A","7":"Bb","9":"D"}}`+"\n")
+}
+
+func TestChord_Schema(t *testing.T) {
+	assertResponse(t, "GET", "/chord/Cm?schema=v2", "", 200,
+		`{"schema":"v2","root":"C","tones":{"1":"C","3":"Eb","5":"G"},"intervals":{"1":"P1","3":"m3","5":"P5"},"degrees":[{"degree":"1","note":"C","interval":"P1"},{"degree":"b3","note":"Eb","interval":"m3"},{"degree":"5","note":"G","interval":"P5"}],"inversions":[{"bass":"C","notes":["C","Eb","G"]},{"bass":"Eb","notes":["Eb","G","C"]},{"bass":"G","notes":["G","C","Eb"]}]}`+"\n")
+	assertResponse(t, "GET", "/chord/Cm?schema=v1", "", 200,
+		`{"root":"C","tones":{"1":"C","3":"Eb","5":"G"}}`+"\n")
+	assertResponse(t, "GET", "/key/D?schema=v9", "", 400,
+		`{"error":{"status":400,"message":"unknown schema version \"v9\""}}`+"\n")
+}
+
+func TestChord_UnknownRoot(t *testing.T) {
+	assertResponse(t, "GET", "/chord/garbage", "", 400,
+		`{"error":{"status":400,"message":"unknown root \"garbage\" at position 0 of chord \"garbage\""}}`+"\n")
+}
+
+func TestChord_MissingName(t *testing.T) {
+	assertResponse(t, "GET", "/chord/", "", 400,
+		`{"error":{"status":400,"message":"missing name after /chord/"}}`+"\n")
+}
+
+func TestChord_MethodNotAllowed(t *testing.T) {
+	w := serve("POST", "/chord/C", "", nil)
+	assert.Equal(t, 405, w.Code)
+	assert.Equal(t, "GET, HEAD", w.Header().Get("Allow"))
+}
+
+func TestChord_UnknownForm(t *testing.T) {
+	assertResponse(t, "GET", "/chord/C%20jams", "", 400,
+		`{"error":{"status":400,"message":"unknown form \"jams\" at position 2 of chord \"C jams\""}}`+"\n")
+}
+
+func TestScale(t *testing.T) {
+	assertResponse(t, "GET", "/scale/C%20aug", "", 200,
+		`{"root":"C","tones":{"1":"C","2":"D#","3":"E","4":"G","5":"G#","6":"B"}}`+"\n")
+}
+
+func TestScale_UnknownMode(t *testing.T) {
+	assertResponse(t, "GET", "/scale/C%20jams", "", 400,
+		`{"error":{"status":400,"message":"unknown mode \"jams\" at position 2 of scale \"C jams\""}}`+"\n")
+}
+
+func TestKey(t *testing.T) {
+	assertResponse(t, "GET", "/key/Db", "", 200,
+		`{"root":"Db","mode":"Major","relative":{"root":"Bb","mode":"Minor"}}`+"\n")
+}
+
+func TestKey_UnknownRoot(t *testing.T) {
+	assertResponse(t, "GET", "/key/P-funk", "", 400,
+		`{"error":{"status":400,"message":"unknown root \"P-funk\" at position 0 of key \"P-funk\""}}`+"\n")
+}
+
+func TestPitch(t *testing.T) {
+	assertResponse(t, "GET", "/pitch/A4", "", 200,
+		`{"note":"A4","tuning":440,"pitch":"440.00Hz"}`+"\n")
+	assertResponse(t, "GET", "/pitch/A5?tuning=432", "", 200,
+		`{"note":"A5","tuning":432,"pitch":"864.00Hz"}`+"\n")
+}
+
+func TestPitch_TuningPresetAndPrecision(t *testing.T) {
+	assertResponse(t, "GET", "/pitch/C4?tuning=baroque&precision=3", "", 200,
+		`{"note":"C4","tuning":415,"pitch":"246.760Hz"}`+"\n")
+	assertResponse(t, "GET", "/pitch/A4?tuning=415.3", "", 200,
+		`{"note":"A4","tuning":415.3,"pitch":"415.30Hz"}`+"\n")
+	assertResponse(t, "GET", "/pitch/A4?precision=many", "", 400,
+		`{"error":{"status":400,"message":"invalid precision: many"}}`+"\n")
+	assertResponse(t, "GET", "/pitch/A4?precision=50000000", "", 400,
+		`{"error":{"status":400,"message":"invalid precision: 50000000"}}`+"\n")
+	assertResponse(t, "GET", "/pitch/A4?precision=10", "", 200,
+		`{"note":"A4","tuning":440,"pitch":"440.0000000000Hz"}`+"\n")
+}
+
+func TestPitch_InvalidTuning(t *testing.T) {
+	assertResponse(t, "GET", "/pitch/A4?tuning=loud", "", 400,
+		`{"error":{"status":400,"message":"invalid tuning: loud"}}`+"\n")
+}
+
+func TestPitch_UnknownNote(t *testing.T) {
+	assertResponse(t, "GET", "/pitch/H4", "", 400,
+		`{"error":{"status":400,"message":"unknown note: H4"}}`+"\n")
+}
+
+func TestPitch_OctaveRange(t *testing.T) {
+	assertResponse(t, "GET", "/pitch/C11", "", 400,
+		`{"error":{"status":400,"message":"out of range octave 11 of \"C11\", expected -1 to 10"}}`+"\n")
+	assertResponse(t, "GET", "/pitch/c-1", "", 200,
+		`{"note":"c-1","tuning":440,"pitch":"8.18Hz"}`+"\n")
+}
+
+func TestAnalyzeProgression_JSON(t *testing.T) {
+	w := serve("POST", "/progression/analyze", `{"chords":["G7","C"]}`, map[string]string{"Content-Type": "application/json"})
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, `{"key":{"root":"C","mode":"Major"},"chords":[{"root":"G","tones":{"1":"G","3":"B","5":"D","7":"F"}},{"root":"C","tones":{"1":"C","3":"E","5":"G"}}]}`+"\n", w.Body.String())
+}
+
+func TestAnalyzeProgression_Text(t *testing.T) {
+	assertResponse(t, "POST", "/progression/analyze", "G7 | C\n", 200,
+		`{"key":{"root":"C","mode":"Major"},"chords":[{"root":"G","tones":{"1":"G","3":"B","5":"D","7":"F"}},{"root":"C","tones":{"1":"C","3":"E","5":"G"}}]}`+"\n")
+}
+
+func TestAnalyzeProgression_Invalid(t *testing.T) {
+	w := serve("POST", "/progression/analyze", `{"chords":`, map[string]string{"Content-Type": "application/json"})
+	assert.Equal(t, 400, w.Code)
+	assertResponse(t, "POST", "/progression/analyze", "G7 | garbage", 400,
+		`{"error":{"status":400,"message":"unknown root \"garbage\" at position 0 of chord \"garbage\""}}`+"\n")
+	assertResponse(t, "POST", "/progression/analyze", " | ", 400,
+		`{"error":{"status":400,"message":"no chords to analyze"}}`+"\n")
+	assertResponse(t, "GET", "/progression/analyze", "", 405,
+		`{"error":{"status":405,"message":"method not allowed: GET"}}`+"\n")
+}
+
+func TestAnalyzeProgression_TooLarge(t *testing.T) {
+	assertResponse(t, "POST", "/progression/analyze", strings.Repeat("C | ", MaxBodyBytes), 413,
+		`{"error":{"status":413,"message":"body too large, expected at most 65536 bytes"}}`+"\n")
+	r := httptest.NewRequest("POST", "/progression/analyze", strings.NewReader(strings.Repeat("C | ", MaxBodyBytes)))
+	r.ContentLength = -1
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, r)
+	assert.Equal(t, 413, w.Code, "of a body of unknown length")
+	assert.Equal(t, 200, serve("POST", "/progression/analyze", strings.Repeat("C | ", MaxBodyBytes/4), nil).Code, "of a body of the most bytes")
+}
+
+func TestNewServer(t *testing.T) {
+	s := newServer(":8080")
+	assert.Equal(t, ":8080", s.Addr)
+	assert.Equal(t, ReadHeaderTimeout, s.ReadHeaderTimeout)
+	assert.Equal(t, ReadTimeout, s.ReadTimeout)
+	assert.Equal(t, WriteTimeout, s.WriteTimeout)
+	assert.Equal(t, IdleTimeout, s.IdleTimeout)
+}
+
+func TestNotFound(t *testing.T) {
+	assertResponse(t, "GET", "/jams", "", 404,
+		`{"error":{"status":404,"message":"no such endpoint: /jams"}}`+"\n")
+}
+
+func TestHead(t *testing.T) {
+	w := serve("HEAD", "/chord/C", "", nil)
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "", w.Body.String())
+}
+
+//
+// Private
+//
+
+func serve(method string, target string, body string, header map[string]string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range header {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, r)
+	return w
+}
+
+func assertResponse(t *testing.T, method string, target string, body string, expectStatus int, expectBody string) {
+	w := serve(method, target, body, nil)
+	assert.Equal(t, expectStatus, w.Code)
+	assert.Equal(t, ContentTypeJSON, w.Header().Get("Content-Type"))
+	assert.Equal(t, expectBody, w.Body.String())
+}
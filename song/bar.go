@@ -0,0 +1,105 @@
+// A bar of a song has its chords placed on its beats, counted from 1, e.g. Am7 on beat 1 and D7 on beat 3
+package song
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+)
+
+// Bar of a song, its chords in the order of their beats
+type Bar struct {
+	Chords []BarChord
+}
+
+// BarChord is a chord placed on a beat of its bar, counted from 1, which may fall between beats, e.g. 2.5 for the "and" of 2
+type BarChord struct {
+	Name  string
+	Chord chord.Chord
+	Beat  float64
+}
+
+// BarOf some beats, each chord named taking an equal share of them, e.g. BarOf(4, "Am7", "D7") is Am7 on 1 and D7 on 3.
+// A chord named "." continues the chord before it, e.g. BarOf(4, "C", ".", ".", "G7") is C on 1 and G7 on 4.
+// A chord that can't be parsed is placed all the same, as with chord.Of
+func BarOf(beats int, names ...string) Bar {
+	b, _ := parseBar(beats, names)
+	return b
+}
+
+// ChordAt a beat of the bar, the last chord placed on or before it, or false if there is none
+func (b Bar) ChordAt(beat float64) (BarChord, bool) {
+	var at BarChord
+	found := false
+	for _, bc := range b.Chords {
+		if bc.Beat <= beat {
+			at, found = bc, true
+		}
+	}
+	return at, found
+}
+
+// Changes of chord within the bar, i.e. the number of chords placed in it
+func (b Bar) Changes() int {
+	return len(b.Chords)
+}
+
+// String of the bar, e.g. "Am7 . D7 .", each chord on an equal share of some beats, the fewest that place every chord on its beat
+func (b Bar) String(beats int) string {
+	if len(b.Chords) == 0 {
+		return "."
+	}
+	slots := slotsOf(b, beats)
+	tokens := make([]string, slots)
+	for i := range tokens {
+		tokens[i] = "."
+	}
+	for _, bc := range b.Chords {
+		i := int((bc.Beat - 1) * float64(slots) / float64(beats))
+		if i >= 0 && i < slots {
+			tokens[i] = bc.Name
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+//
+// Private
+//
+
+// maxSlotsPerBeat of a bar when it's written as a string, e.g. sixteenth notes in 4/4
+const maxSlotsPerBeat = 4
+
+// parseBar of some beats from the names of chords each taking an equal share of them, or "." to continue the chord before it,
+// placing every chord, even one that can't be parsed, and returning the error of the first that can't
+func parseBar(beats int, names []string) (b Bar, err error) {
+	for i, name := range names {
+		if name == "." {
+			continue
+		}
+		c, parseErr := chord.Parse(name)
+		if parseErr != nil && err == nil {
+			err = parseErr
+		}
+		b.Chords = append(b.Chords, BarChord{Name: name, Chord: c, Beat: 1 + float64(i*beats)/float64(len(names))})
+	}
+	return
+}
+
+// slotsOf a bar when written as a string, the fewest equal shares of its beats that place every chord on its beat
+func slotsOf(b Bar, beats int) int {
+	for slots := 1; slots < beats*maxSlotsPerBeat; slots++ {
+		fits := true
+		for _, bc := range b.Chords {
+			pos := (bc.Beat - 1) * float64(slots) / float64(beats)
+			if pos != float64(int(pos)) {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			return slots
+		}
+	}
+	return beats * maxSlotsPerBeat
+}
@@ -0,0 +1,74 @@
+// A bar of a song has its chords placed on its beats, counted from 1, e.g. Am7 on beat 1 and D7 on beat 3
+package song
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestBarOf(t *testing.T) {
+	b := BarOf(4, "Dm7", "G7")
+	assert.Equal(t, 2, b.Changes())
+	assert.Equal(t, "Dm7", b.Chords[0].Name)
+	assert.Equal(t, note.D, b.Chords[0].Chord.Root)
+	assert.Equal(t, 1.0, b.Chords[0].Beat)
+	assert.Equal(t, "G7", b.Chords[1].Name)
+	assert.Equal(t, 3.0, b.Chords[1].Beat)
+}
+
+func TestBarOf_Continue(t *testing.T) {
+	b := BarOf(4, "C", ".", ".", "G7")
+	assert.Equal(t, 2, b.Changes())
+	assert.Equal(t, 1.0, b.Chords[0].Beat)
+	assert.Equal(t, 4.0, b.Chords[1].Beat)
+	assert.Equal(t, 0, BarOf(4, ".").Changes())
+	assert.Equal(t, 0, BarOf(4).Changes())
+}
+
+func TestBarOf_OffBeat(t *testing.T) {
+	b := BarOf(3, "C", "F", "G", "C", "F", "G")
+	assert.Equal(t, []float64{1, 1.5, 2, 2.5, 3, 3.5}, beatsOf(b))
+}
+
+func TestBar_ChordAt(t *testing.T) {
+	b := BarOf(4, "Dm7", "G7")
+	bc, ok := b.ChordAt(2)
+	assert.True(t, ok)
+	assert.Equal(t, "Dm7", bc.Name)
+	bc, ok = b.ChordAt(3.5)
+	assert.True(t, ok)
+	assert.Equal(t, "G7", bc.Name)
+	_, ok = BarOf(4, ".", "G7").ChordAt(2)
+	assert.False(t, ok)
+}
+
+func TestBar_String(t *testing.T) {
+	assert.Equal(t, "Dm7 G7", BarOf(4, "Dm7", "G7").String(4))
+	assert.Equal(t, "C . . G7", BarOf(4, "C", ".", ".", "G7").String(4))
+	assert.Equal(t, "C", BarOf(4, "C").String(4))
+	assert.Equal(t, "C . G", BarOf(3, "C", ".", "G").String(3))
+	assert.Equal(t, "C F G C F G", BarOf(3, "C", "F", "G", "C", "F", "G").String(3))
+	assert.Equal(t, ".", Bar{}.String(4))
+}
+
+func TestParseBar_UnknownChord(t *testing.T) {
+	b, err := parseBar(4, []string{"C", "C jams"})
+	assert.True(t, errors.Is(err, chord.ErrUnknownForm))
+	assert.Equal(t, 2, b.Changes())
+}
+
+//
+// Private
+//
+
+func beatsOf(b Bar) (beats []float64) {
+	for _, bc := range b.Chords {
+		beats = append(beats, bc.Beat)
+	}
+	return
+}
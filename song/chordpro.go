@@ -0,0 +1,130 @@
+// Songs are imported from a ChordPro chart, the chords in brackets inline with its lyrics, e.g. "[Am]Autumn [D7]leaves", and its directives in braces, e.g. "{title: Autumn Leaves}"
+package song
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+// ErrInvalidChordPro when reading a chart with a directive or chord that isn't closed, e.g. "{title: Autumn Leaves" or "[Am"
+var ErrInvalidChordPro = errors.New("invalid ChordPro")
+
+// UnnamedSection of the chords of a chart before its first section, or all of them if it has none
+const UnnamedSection = "chart"
+
+// ReadChordPro of a chart, as a song of its chords, each a bar of its own, as a chart doesn't say how long each lasts, in sections of each environment,
+// e.g. "verse" of {start_of_verse}, or its label, e.g. "Verse 2" of {start_of_verse: Verse 2} or {start_of_verse label="Verse 2"}, in the key, time signature and tempo of its directives,
+// and titled by its directive, ignoring the lyrics and every other directive, e.g. {comment: ...}, and a line beginning with #.
+// A chord that can't be parsed is placed all the same, as with chord.Of, but a directive of an unknown key, time signature or tempo is an error
+func ReadChordPro(r io.Reader) (Song, error) {
+	s := Song{Tempo: DefaultTempo, Meter: meter.Common}
+	section := UnnamedSection
+	var bars []Bar
+	flush := func() {
+		if len(bars) > 0 {
+			s.Add(section, bars...)
+			bars = nil
+		}
+	}
+	lines := bufio.NewScanner(r)
+	for n := 1; lines.Scan(); n++ {
+		line := strings.TrimSpace(lines.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "{") {
+			if !strings.HasSuffix(line, "}") {
+				return Song{}, fmt.Errorf("%w directive %q on line %d", ErrInvalidChordPro, line, n)
+			}
+			name, value := directiveOf(line)
+			switch name {
+			case "title", "t":
+				s.Title = value
+			case "key":
+				k, err := key.Parse(value)
+				if err != nil {
+					return Song{}, fmt.Errorf("%w key %q on line %d: %v", ErrInvalidChordPro, value, n, err)
+				}
+				s.Key = k
+			case "time":
+				m, err := meter.Parse(value)
+				if err != nil {
+					return Song{}, fmt.Errorf("%w time %q on line %d: %v", ErrInvalidChordPro, value, n, err)
+				}
+				s.Meter = m
+			case "tempo":
+				tempo, err := strconv.ParseFloat(value, 64)
+				if err != nil || tempo <= 0 {
+					return Song{}, fmt.Errorf("%w %q on line %d, expected beats per minute above 0", ErrInvalidTempo, value, n)
+				}
+				s.Tempo = tempo
+			default:
+				if environment, ok := environmentOf(name); ok {
+					flush()
+					section = environment
+					if label := strings.Trim(strings.TrimPrefix(value, "label="), `"`); len(label) > 0 {
+						section = label
+					}
+				} else if strings.HasPrefix(name, "end_of_") || strings.HasPrefix(name, "eo") && len(name) == 3 {
+					flush()
+					section = UnnamedSection
+				}
+			}
+			continue
+		}
+		for rest := line; ; {
+			open := strings.Index(rest, "[")
+			if open < 0 {
+				break
+			}
+			end := strings.Index(rest[open:], "]")
+			if end < 0 {
+				return Song{}, fmt.Errorf("%w chord %q on line %d", ErrInvalidChordPro, rest[open:], n)
+			}
+			if name := strings.TrimSpace(rest[open+1 : open+end]); len(name) > 0 && !strings.EqualFold(name, "N.C.") {
+				bars = append(bars, BarOf(s.Meter.Beats, name))
+			}
+			rest = rest[open+end+1:]
+		}
+	}
+	if err := lines.Err(); err != nil {
+		return Song{}, err
+	}
+	flush()
+	return s, nil
+}
+
+//
+// Private
+//
+
+// environments of ChordPro, by the abbreviation of each directive that begins one, e.g. "sov" of {start_of_verse}
+var environments = map[string]string{"sov": "verse", "soc": "chorus", "sob": "bridge", "sot": "tab", "sog": "grid"}
+
+// directiveOf a line of a chart, its name in lower case, and any value after a colon or space, e.g. "title" and "Autumn Leaves" of {title: Autumn Leaves}
+func directiveOf(line string) (name, value string) {
+	inner := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, "{"), "}"))
+	at := strings.IndexAny(inner, ": ")
+	if at < 0 {
+		return strings.ToLower(inner), ""
+	}
+	return strings.ToLower(inner[:at]), strings.TrimSpace(inner[at+1:])
+}
+
+// environmentOf a directive, the section it begins, e.g. "verse" of "start_of_verse" or "sov", or false if it doesn't begin one
+func environmentOf(name string) (string, bool) {
+	if environment, ok := environments[name]; ok {
+		return environment, true
+	}
+	if strings.HasPrefix(name, "start_of_") {
+		return strings.TrimPrefix(name, "start_of_"), true
+	}
+	return "", false
+}
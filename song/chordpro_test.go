@@ -0,0 +1,77 @@
+// Songs are imported from a ChordPro chart, the chords in brackets inline with its lyrics, e.g. "[Am]Autumn [D7]leaves", and its directives in braces, e.g. "{title: Autumn Leaves}"
+package song
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+func TestReadChordPro(t *testing.T) {
+	s, err := ReadChordPro(strings.NewReader(`{title: Autumn Leaves}
+{key: G}
+{time: 3/4}
+{tempo: 96}
+# a comment
+[N.C.]Intro [Em]
+{start_of_verse: Verse 1}
+The [Am7]falling [D7]leaves, [Gmaj7]drift by the [Cmaj7]window
+{comment: slowly}
+{end_of_verse}
+{soc}
+[F#m7b5]Since you [B7]went [Em]away
+{eoc}
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, "Autumn Leaves", s.Title)
+	assert.Equal(t, key.Of("G major"), s.Key)
+	assert.Equal(t, meter.Meter{Beats: 3, Unit: 4, Groups: []int{3}}, s.Meter)
+	assert.Equal(t, 96.0, s.Tempo)
+	assert.Equal(t, 3, len(s.Sections))
+	assert.Equal(t, UnnamedSection, s.Sections[0].Name)
+	assert.Equal(t, "Verse 1", s.Sections[1].Name)
+	assert.Equal(t, "chorus", s.Sections[2].Name)
+	assert.Equal(t, 1, len(s.Sections[0].Bars))
+	assert.Equal(t, "Em", s.Sections[0].Bars[0].String(3))
+	assert.Equal(t, 4, len(s.Sections[1].Bars))
+	assert.Equal(t, "Gmaj7", s.Sections[1].Bars[2].String(3))
+	assert.Equal(t, "F#m7b5", s.Sections[2].Bars[0].String(3))
+	assert.Nil(t, s.Validate())
+}
+
+func TestReadChordPro_Defaults(t *testing.T) {
+	s, err := ReadChordPro(strings.NewReader(`{start_of_bridge label="Middle 8"}
+[C][G/B]
+{end_of_bridge}
+[F]`))
+	assert.Nil(t, err)
+	assert.Equal(t, "", s.Title)
+	assert.Equal(t, key.Nil, s.Key.Mode)
+	assert.Equal(t, meter.Common, s.Meter)
+	assert.Equal(t, DefaultTempo, s.Tempo)
+	assert.Equal(t, 2, len(s.Sections))
+	assert.Equal(t, "Middle 8", s.Sections[0].Name)
+	assert.Equal(t, "C G/B", s.Sections[0].Bars[0].String(4)+" "+s.Sections[0].Bars[1].String(4))
+	assert.Equal(t, UnnamedSection, s.Sections[1].Name)
+}
+
+func TestReadChordPro_Errors(t *testing.T) {
+	_, err := ReadChordPro(strings.NewReader("{title: Autumn Leaves"))
+	assert.True(t, errors.Is(err, ErrInvalidChordPro))
+	assert.Equal(t, `invalid ChordPro directive "{title: Autumn Leaves" on line 1`, err.Error())
+	_, err = ReadChordPro(strings.NewReader("The [Am7]falling [D7 leaves"))
+	assert.True(t, errors.Is(err, ErrInvalidChordPro))
+	assert.Equal(t, `invalid ChordPro chord "[D7 leaves" on line 1`, err.Error())
+	_, err = ReadChordPro(strings.NewReader("{key: H#}"))
+	assert.True(t, errors.Is(err, ErrInvalidChordPro))
+	_, err = ReadChordPro(strings.NewReader("{time: 4/0}"))
+	assert.True(t, errors.Is(err, ErrInvalidChordPro))
+	_, err = ReadChordPro(strings.NewReader("\n{tempo: fast}"))
+	assert.True(t, errors.Is(err, ErrInvalidTempo))
+	assert.Equal(t, `invalid tempo "fast" on line 2, expected beats per minute above 0`, err.Error())
+}
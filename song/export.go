@@ -0,0 +1,113 @@
+// Songs are exported for production in a DAW, as a MIDI clip of each section, e.g. to drop into the session view of Ableton Live, or as CSV of every chord by its bar and beat
+package song
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Clip of a section of a song, named by the section, of the notes of its chords, each held until the next, from the beginning of the section
+type Clip struct {
+	Name  string
+	Notes []midi.Note
+	Tempo float64 // in quarter notes per minute
+}
+
+// Clips of the song, one of each section, in order, e.g. to write each as MIDI and drop it into a track of a DAW
+func (s Song) Clips() []Clip {
+	beat := s.beatTicks()
+	quarters := s.Tempo * float64(beat) / midi.Quarter
+	var clips []Clip
+	for _, sec := range s.Sections {
+		end := len(sec.Bars) * s.Meter.Beats * beat
+		placed := s.placed(sec)
+		var notes []midi.Note
+		for n, p := range placed {
+			until := end
+			if n+1 < len(placed) {
+				until = placed[n+1].start
+			}
+			for _, t := range p.bc.Chord.Notes(chord.ArpeggioOctave) {
+				notes = append(notes, midi.Note{Number: midi.NumberOf(t.Class, t.Octave), Start: p.start, Duration: until - p.start})
+			}
+		}
+		clips = append(clips, Clip{Name: sec.Name, Notes: notes, Tempo: quarters})
+	}
+	return clips
+}
+
+// WriteMIDI of the clip, as a Standard MIDI File of a track named by its section, at its tempo
+func (c Clip) WriteMIDI(w io.Writer) error {
+	return midi.WriteClip(w, c.Name, c.Notes, c.Tempo)
+}
+
+// WriteCSV of every chord of the song, a row of its bar, counted from 1 of the first bar of the song, its beat, its name, and its notes,
+// spelled with the sharps or flats of the song's key, if it has one, e.g. "2,3,D7,D F# A C"
+func (s Song) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{"bar", "beat", "chord", "notes"}); err != nil {
+		return err
+	}
+	for n, b := range s.Bars() {
+		for _, bc := range b.Chords {
+			c := bc.Chord
+			if s.Key.Root != note.Nil {
+				c = c.SpelledIn(s.Key)
+			}
+			row := []string{strconv.Itoa(n + 1), strconv.FormatFloat(bc.Beat, 'f', -1, 64), bc.Name, notesOf(c)}
+			if err := out.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+//
+// Private
+//
+
+// placement of a chord of a section, from a start in ticks
+type placement struct {
+	bc    BarChord
+	start int
+}
+
+// beatTicks of the beat unit of the song's meter, e.g. midi.Eighth of 6/8, or of a quarter note if it has none
+func (s Song) beatTicks() int {
+	if s.Meter.Unit < 1 {
+		return midi.Quarter
+	}
+	return midi.Whole / s.Meter.Unit
+}
+
+// placed chords of a section, in order, each from its beat of its bar
+func (s Song) placed(sec Section) []placement {
+	beat := s.beatTicks()
+	var placed []placement
+	for n, b := range sec.Bars {
+		for _, bc := range b.Chords {
+			placed = append(placed, placement{bc, (n*s.Meter.Beats)*beat + int((bc.Beat-1)*float64(beat))})
+		}
+	}
+	return placed
+}
+
+// notesOf a chord, from its root, e.g. "D F# A C" of D7
+func notesOf(c chord.Chord) string {
+	var names []string
+	for _, t := range c.OrderedTones() {
+		if t.Class == note.Nil {
+			continue
+		}
+		names = append(names, t.Class.String(c.AdjSymbol))
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,79 @@
+// Songs are exported for production in a DAW, as a MIDI clip of each section, e.g. to drop into the session view of Ableton Live, or as CSV of every chord by its bar and beat
+package song
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/midi"
+)
+
+func TestSong_Clips(t *testing.T) {
+	clips := exampleSong().Clips()
+	assert.Equal(t, 2, len(clips))
+	assert.Equal(t, "verse", clips[0].Name)
+	assert.Equal(t, float64(120), clips[0].Tempo)
+	assert.Equal(t, []int{62, 65, 69, 72, 67, 71, 74, 77, 60, 64, 67, 71}, numbersOf(clips[0].Notes))
+	assert.Equal(t, midi.Note{Number: 67, Start: 960, Duration: 960}, clips[0].Notes[4])   // G7 on 3 until the next bar
+	assert.Equal(t, midi.Note{Number: 60, Start: 1920, Duration: 1920}, clips[0].Notes[8]) // Cmaj7 until the end
+	assert.Equal(t, "chorus", clips[1].Name)
+	assert.Equal(t, midi.Note{Number: 67, Start: 0, Duration: 1440}, clips[1].Notes[0])   // G held through beat 3
+	assert.Equal(t, midi.Note{Number: 62, Start: 1440, Duration: 480}, clips[1].Notes[3]) // D7 on 4
+}
+
+func TestSong_Clips_CompoundMeter(t *testing.T) {
+	s := New("Jig", key.Of("D major"))
+	s.Meter, _ = meter.Parse("6/8")
+	s.Tempo = 180
+	s.Add("A", BarOf(6, "D", "A"))
+	clips := s.Clips()
+	assert.Equal(t, float64(90), clips[0].Tempo) // 180 eighth notes per minute
+	assert.Equal(t, midi.Note{Number: 62, Start: 0, Duration: 720}, clips[0].Notes[0])
+	assert.Equal(t, midi.Note{Number: 69, Start: 720, Duration: 720}, clips[0].Notes[3])
+}
+
+func TestClip_WriteMIDI(t *testing.T) {
+	c := exampleSong().Clips()[1]
+	var buf bytes.Buffer
+	assert.Nil(t, c.WriteMIDI(&buf))
+	assert.Contains(t, buf.String(), "chorus")
+	notes, err := midi.Read(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, len(c.Notes), len(notes))
+}
+
+func TestSong_WriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, exampleSong().WriteCSV(&buf))
+	assert.Equal(t, `bar,beat,chord,notes
+1,1,Dm7,D F A C
+1,3,G7,G B D F
+2,1,Cmaj7,C E G B
+3,1,G,G B D
+3,4,D7,D F# A C
+4,1,G,G B D
+`, buf.String())
+}
+
+func TestSong_WriteCSV_SpelledInKey(t *testing.T) {
+	s := New("Flats", key.Of("F major"))
+	s.Add("A", BarOf(4, "Gm7", "C7"))
+	var buf bytes.Buffer
+	assert.Nil(t, s.WriteCSV(&buf))
+	assert.Equal(t, "bar,beat,chord,notes\n1,1,Gm7,G Bb D F\n1,3,C7,C E G Bb\n", buf.String())
+}
+
+//
+// Private
+//
+
+func numbersOf(notes []midi.Note) (numbers []int) {
+	for _, n := range notes {
+		numbers = append(numbers, n.Number)
+	}
+	return
+}
@@ -0,0 +1,450 @@
+// Songs are imported from the MusicXML of a score, e.g. exported by notation software, its key signature, time signature, tempo, chord symbols and melody
+package song
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ErrInvalidMusicXML when reading a score that isn't partwise MusicXML, or a compressed file without one
+var ErrInvalidMusicXML = errors.New("invalid MusicXML")
+
+// UnmarkedSection of the bars of an imported score before its first rehearsal mark, or all of them if it has none
+const UnmarkedSection = "score"
+
+// ReadMusicXML of a partwise score, as a song of the measures of its first part, in sections beginning at each rehearsal mark, e.g. "A" or "Verse",
+// each measure a bar of the chord symbols of its harmony elements, on the beats they're placed, in the key, time signature and tempo it begins with,
+// and the notes of the first voice of the part as the song's melody, taking the highest note of a chord, joining tied notes and skipping grace notes.
+// A key signature of neither a major nor minor mode is read as the major key of its sharps or flats, and a chord symbol that can't be parsed is placed all the same, as with chord.Of
+func ReadMusicXML(r io.Reader) (Song, error) {
+	var score xmlScore
+	if err := xml.NewDecoder(r).Decode(&score); err != nil {
+		return Song{}, fmt.Errorf("%w: %v", ErrInvalidMusicXML, err)
+	}
+	s := Song{Title: score.Title, Tempo: DefaultTempo, Meter: meter.Common}
+	if len(s.Title) == 0 {
+		s.Title = score.MovementTitle
+	}
+	if len(score.Parts) == 0 {
+		return s, nil
+	}
+	i := xmlImport{song: &s, divisions: 1}
+	for _, m := range score.Parts[0].Measures {
+		if err := i.measure(m); err != nil {
+			return Song{}, err
+		}
+	}
+	if err := s.Validate(); err != nil {
+		return Song{}, err
+	}
+	return s, nil
+}
+
+// ReadCompressedMusicXML of the score of a compressed MusicXML file, a .mxl archive, of some size, the score named by its container, or else the first in it
+func ReadCompressedMusicXML(r io.ReaderAt, size int64) (Song, error) {
+	z, err := zip.NewReader(r, size)
+	if err != nil {
+		return Song{}, fmt.Errorf("%w: %v", ErrInvalidMusicXML, err)
+	}
+	name := ""
+	if f := zipFileNamed(z, "META-INF/container.xml"); f != nil {
+		var container xmlContainer
+		if err = decodeZipFile(f, &container); err != nil {
+			return Song{}, fmt.Errorf("%w: %v", ErrInvalidMusicXML, err)
+		}
+		if len(container.RootFiles) > 0 {
+			name = container.RootFiles[0].FullPath
+		}
+	}
+	if len(name) == 0 {
+		for _, f := range z.File {
+			if !strings.HasPrefix(f.Name, "META-INF/") && (path.Ext(f.Name) == ".xml" || path.Ext(f.Name) == ".musicxml") {
+				name = f.Name
+				break
+			}
+		}
+	}
+	f := zipFileNamed(z, name)
+	if f == nil {
+		return Song{}, fmt.Errorf("%w: no score in the compressed file", ErrInvalidMusicXML)
+	}
+	in, err := f.Open()
+	if err != nil {
+		return Song{}, err
+	}
+	defer in.Close()
+	return ReadMusicXML(in)
+}
+
+//
+// Private
+//
+
+// xmlKinds of harmony, by their names in MusicXML, and the suffix of a chord symbol of each
+var xmlKinds = map[string]string{
+	"major":              "",
+	"minor":              "m",
+	"augmented":          "aug",
+	"diminished":         "dim",
+	"dominant":           "7",
+	"major-seventh":      "maj7",
+	"minor-seventh":      "m7",
+	"diminished-seventh": "dim7",
+	"augmented-seventh":  "aug7",
+	"half-diminished":    "m7b5",
+	"major-minor":        "mM7",
+	"major-sixth":        "6",
+	"minor-sixth":        "m6",
+	"dominant-ninth":     "9",
+	"major-ninth":        "maj9",
+	"minor-ninth":        "m9",
+	"dominant-11th":      "11",
+	"major-11th":         "maj11",
+	"minor-11th":         "m11",
+	"dominant-13th":      "13",
+	"major-13th":         "maj13",
+	"minor-13th":         "m13",
+	"suspended-second":   "sus2",
+	"suspended-fourth":   "sus4",
+	"power":              "omit3",
+}
+
+// xmlMajorKeys and xmlMinorKeys of each key signature, by its fifths from 7 flats to 7 sharps
+var (
+	xmlMajorKeys = []string{"Cb", "Gb", "Db", "Ab", "Eb", "Bb", "F", "C", "G", "D", "A", "E", "B", "F#", "C#"}
+	xmlMinorKeys = []string{"Ab", "Eb", "Bb", "F", "C", "G", "D", "A", "E", "B", "F#", "C#", "G#", "D#", "A#"}
+)
+
+// xmlSteps of the letters of notes, in semitones up from C
+var xmlSteps = map[string]int{"C": 0, "D": 2, "E": 4, "F": 5, "G": 7, "A": 9, "B": 11}
+
+// xmlImport of the measures of a part into a song, remembering the divisions of a quarter note, the beat the measure begins on, the voice of the melody,
+// and whether the key, meter and tempo have been read yet
+type xmlImport struct {
+	song           *Song
+	divisions      int
+	start          float64 // in beats from the beginning
+	voice          string
+	keyRead        bool
+	meterRead      bool
+	tempoRead      bool
+	tempoQuarters  float64 // per minute, until the meter is known
+	sectionStarted bool
+}
+
+// measure of a part, imported as a bar of its chord symbols, appended to the current section, or the next if it begins with a rehearsal mark
+func (i *xmlImport) measure(m xmlMeasure) error {
+	var bar Bar
+	pos, end := 0, 0 // in divisions from the beginning of the measure
+	for _, e := range m.Elements {
+		switch e.XMLName.Local {
+		case "attributes":
+			if err := i.attributes(e); err != nil {
+				return err
+			}
+		case "direction", "sound":
+			for _, mark := range e.Rehearsals {
+				if mark = strings.TrimSpace(mark); len(mark) > 0 {
+					i.section(mark)
+				}
+			}
+			if e.Sound != nil && e.Sound.Tempo > 0 {
+				i.tempo(e.Sound.Tempo)
+			} else if e.Tempo > 0 {
+				i.tempo(e.Tempo)
+			}
+		case "harmony":
+			if name, ok := e.chordName(); ok {
+				bar.Chords = append(bar.Chords, BarChord{Name: name, Chord: chord.Of(name), Beat: 1 + i.beatsOf(pos+e.Offset)})
+			}
+		case "backup":
+			pos -= e.Duration
+		case "forward":
+			pos += e.Duration
+		case "note":
+			if e.Grace != nil {
+				continue
+			}
+			if e.Chord == nil {
+				i.note(e, pos)
+				pos += e.Duration
+			} else {
+				i.chordNote(e)
+			}
+		}
+		if pos > end {
+			end = pos
+		}
+	}
+	if !i.sectionStarted {
+		i.section(UnmarkedSection)
+	}
+	sections := i.song.Sections
+	sections[len(sections)-1].Bars = append(sections[len(sections)-1].Bars, bar)
+	if end > 0 {
+		i.start += i.beatsOf(end)
+	} else {
+		i.start += float64(i.song.Meter.Beats)
+	}
+	return nil
+}
+
+// attributes of a measure, its divisions of a quarter note, and the first key and time signature of the score
+func (i *xmlImport) attributes(e xmlElement) error {
+	if e.Divisions > 0 {
+		i.divisions = e.Divisions
+	}
+	if e.Key != nil && !i.keyRead {
+		if e.Key.Fifths < -7 || e.Key.Fifths > 7 {
+			return fmt.Errorf("%w: key signature of %d fifths, expected -7 to 7", ErrInvalidMusicXML, e.Key.Fifths)
+		}
+		name := xmlMajorKeys[e.Key.Fifths+7] + " major"
+		if strings.ToLower(e.Key.Mode) == "minor" {
+			name = xmlMinorKeys[e.Key.Fifths+7] + " minor"
+		}
+		i.song.Key, i.keyRead = key.Of(name), true
+	}
+	if e.Time != nil && !i.meterRead {
+		m, err := meter.Parse(e.Time.Beats + "/" + e.Time.BeatType)
+		if err != nil {
+			return err
+		}
+		i.song.Meter, i.meterRead = m, true
+		if i.tempoQuarters > 0 {
+			i.song.Tempo = i.tempoQuarters * float64(m.Unit) / 4
+		}
+	}
+	return nil
+}
+
+// section named by a rehearsal mark, begun with the next bar, or renaming the current section if it has no bars yet
+func (i *xmlImport) section(name string) {
+	sections := i.song.Sections
+	if i.sectionStarted && len(sections[len(sections)-1].Bars) == 0 {
+		sections[len(sections)-1].Name = name
+		return
+	}
+	i.song.Sections, i.sectionStarted = append(sections, Section{Name: name}), true
+}
+
+// tempo of the score, the first given, in quarter notes per minute, in beats of the meter per minute of the song
+func (i *xmlImport) tempo(quarters float64) {
+	if i.tempoRead {
+		return
+	}
+	i.tempoQuarters, i.tempoRead = quarters, true
+	i.song.Tempo = quarters * float64(i.song.Meter.Unit) / 4
+}
+
+// note of the melody, at a position of the measure, if it's the first voice, or a rest, or tied to the note before it
+func (i *xmlImport) note(e xmlElement, pos int) {
+	if len(i.voice) == 0 {
+		i.voice = e.Voice
+	}
+	if e.Voice != i.voice || e.Rest != nil || e.Pitch == nil {
+		return
+	}
+	n := e.Pitch.note()
+	n.Beat, n.Beats = i.start+i.beatsOf(pos), i.beatsOf(e.Duration)
+	notes := i.song.Notes
+	if e.tiedFrom() && len(notes) > 0 && notes[len(notes)-1].Step() == n.Step() {
+		notes[len(notes)-1].Beats += n.Beats
+		return
+	}
+	i.song.Notes = append(notes, n)
+}
+
+// chordNote of the melody, sounding with the note before it, replacing it if it's higher
+func (i *xmlImport) chordNote(e xmlElement) {
+	notes := i.song.Notes
+	if e.Voice != i.voice || e.Pitch == nil || len(notes) == 0 {
+		return
+	}
+	n := e.Pitch.note()
+	if last := &notes[len(notes)-1]; n.Step() > last.Step() {
+		last.Class, last.Octave = n.Class, n.Octave
+	}
+}
+
+// beatsOf some divisions of a quarter note, in beats of the meter, e.g. 3 of 12 divisions in 6/8
+func (i *xmlImport) beatsOf(divisions int) float64 {
+	return float64(divisions) / float64(i.divisions) * float64(i.song.Meter.Unit) / 4
+}
+
+type xmlScore struct {
+	XMLName       xml.Name  `xml:"score-partwise"`
+	Title         string    `xml:"work>work-title"`
+	MovementTitle string    `xml:"movement-title"`
+	Parts         []xmlPart `xml:"part"`
+}
+
+type xmlPart struct {
+	Measures []xmlMeasure `xml:"measure"`
+}
+
+type xmlMeasure struct {
+	Elements []xmlElement `xml:",any"`
+}
+
+// xmlElement of a measure, in order, any of its attributes, directions, harmonies, notes, backups or forwards
+type xmlElement struct {
+	XMLName xml.Name
+
+	// attributes
+	Divisions int `xml:"divisions"`
+	Key       *struct {
+		Fifths int    `xml:"fifths"`
+		Mode   string `xml:"mode"`
+	} `xml:"key"`
+	Time *struct {
+		Beats    string `xml:"beats"`
+		BeatType string `xml:"beat-type"`
+	} `xml:"time"`
+
+	// direction or sound
+	Rehearsals []string `xml:"direction-type>rehearsal"`
+	Sound      *struct {
+		Tempo float64 `xml:"tempo,attr"`
+	} `xml:"sound"`
+	Tempo float64 `xml:"tempo,attr"`
+
+	// harmony
+	Root   xmlStep     `xml:"root"`
+	Kind   xmlKind     `xml:"kind"`
+	Bass   *xmlStep    `xml:"bass"`
+	Degree []xmlDegree `xml:"degree"`
+	Offset int         `xml:"offset"`
+
+	// note, backup or forward
+	Duration int       `xml:"duration"`
+	Chord    *struct{} `xml:"chord"`
+	Grace    *struct{} `xml:"grace"`
+	Rest     *struct{} `xml:"rest"`
+	Pitch    *xmlPitch `xml:"pitch"`
+	Voice    string    `xml:"voice"`
+	Ties     []struct {
+		Type string `xml:"type,attr"`
+	} `xml:"tie"`
+}
+
+// chordName of a harmony, e.g. "Cm7b5/Gb", or false if it has no root or is no chord, i.e. N.C.
+func (e xmlElement) chordName() (string, bool) {
+	kind := strings.TrimSpace(e.Kind.Value)
+	if len(e.Root.Step) == 0 || kind == "none" {
+		return "", false
+	}
+	suffix, ok := xmlKinds[kind]
+	if !ok {
+		suffix = strings.TrimSpace(e.Kind.Text)
+	}
+	name := xmlNoteName(e.Root.Step, e.Root.Alter) + suffix
+	for _, d := range e.Degree {
+		value := strconv.Itoa(d.Value)
+		switch d.Type {
+		case "add":
+			name += "add" + xmlAccidentalOf(d.Alter) + value
+		case "alter":
+			name += xmlAccidentalOf(d.Alter) + value
+		case "subtract":
+			name += "omit" + value
+		}
+	}
+	if e.Bass != nil && len(e.Bass.BassStep) > 0 {
+		name += "/" + xmlNoteName(e.Bass.BassStep, e.Bass.BassAlter)
+	}
+	return name, true
+}
+
+// tiedFrom the note before it, by a tie that stops at it
+func (e xmlElement) tiedFrom() bool {
+	for _, t := range e.Ties {
+		if t.Type == "stop" {
+			return true
+		}
+	}
+	return false
+}
+
+// xmlStep of the root or bass of a harmony
+type xmlStep struct {
+	Step      string  `xml:"root-step"`
+	Alter     float64 `xml:"root-alter"`
+	BassStep  string  `xml:"bass-step"`
+	BassAlter float64 `xml:"bass-alter"`
+}
+
+type xmlKind struct {
+	Value string `xml:",chardata"`
+	Text  string `xml:"text,attr"`
+}
+
+type xmlDegree struct {
+	Value int     `xml:"degree-value"`
+	Alter float64 `xml:"degree-alter"`
+	Type  string  `xml:"degree-type"`
+}
+
+type xmlPitch struct {
+	Step   string  `xml:"step"`
+	Alter  float64 `xml:"alter"`
+	Octave int     `xml:"octave"`
+}
+
+// note of the pitch, its class and octave, e.g. B3 of C flat in the 4th octave
+func (p xmlPitch) note() melody.Note {
+	step := (p.Octave+1)*12 + xmlSteps[strings.ToUpper(p.Step)] + int(math.Round(p.Alter))
+	return melody.Note{Class: note.Class(step%12 + 1), Octave: note.Octave(step/12 - 1)}
+}
+
+// xmlNoteName of a step altered, e.g. "Bb" of B altered by -1
+func xmlNoteName(step string, alter float64) string {
+	return strings.ToUpper(strings.TrimSpace(step)) + xmlAccidentalOf(alter)
+}
+
+// xmlAccidentalOf an alteration in semitones, e.g. "b" of -1 or "##" of 2, rounding any microtone
+func xmlAccidentalOf(alter float64) string {
+	n := int(math.Round(alter))
+	if n < 0 {
+		return strings.Repeat("b", -n)
+	}
+	return strings.Repeat("#", n)
+}
+
+type xmlContainer struct {
+	RootFiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+// zipFileNamed in an archive, or nil if there's none
+func zipFileNamed(z *zip.Reader, name string) *zip.File {
+	for _, f := range z.File {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// decodeZipFile of XML into a value
+func decodeZipFile(f *zip.File, v interface{}) error {
+	in, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	return xml.NewDecoder(in).Decode(v)
+}
@@ -0,0 +1,139 @@
+// Songs are imported from the MusicXML of a score, e.g. exported by notation software, its key signature, time signature, tempo, chord symbols and melody
+package song
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestReadMusicXML(t *testing.T) {
+	s, err := ReadMusicXML(strings.NewReader(exampleMusicXML))
+	assert.Nil(t, err)
+	assert.Equal(t, "Example", s.Title)
+	assert.Equal(t, key.Of("E minor"), s.Key)
+	assert.Equal(t, meter.Meter{Beats: 3, Unit: 4, Groups: []int{3}}, s.Meter)
+	assert.Equal(t, 96.0, s.Tempo)
+	assert.Equal(t, 3, len(s.Sections))
+	assert.Equal(t, UnmarkedSection, s.Sections[0].Name)
+	assert.Equal(t, "A", s.Sections[1].Name)
+	assert.Equal(t, "B", s.Sections[2].Name)
+	assert.Equal(t, "Em . Am7b5/Eb", s.Sections[0].Bars[0].String(3))
+	assert.Equal(t, "B7b9", s.Sections[1].Bars[0].String(3))
+	assert.Equal(t, "Gadd9", s.Sections[2].Bars[0].String(3))
+	assert.Equal(t, 4, len(s.Progression().Chords))
+	assert.Equal(t, []melody.Note{
+		{Class: note.E, Octave: 4, Beat: 0, Beats: 1},
+		{Class: note.B, Octave: 4, Beat: 1, Beats: 1}, // the highest of a chord
+		{Class: note.C, Octave: 5, Beat: 2.5, Beats: 0.5},
+		{Class: note.B, Octave: 4, Beat: 3, Beats: 4}, // tied over the bar
+		{Class: note.G, Octave: 4, Beat: 7, Beats: 2},
+	}, s.Notes)
+}
+
+func TestReadMusicXML_Minimal(t *testing.T) {
+	s, err := ReadMusicXML(strings.NewReader(`<score-partwise version="3.1"><movement-title>Minimal</movement-title><part id="P1">
+<measure number="1"><harmony><root><root-step>C</root-step></root><kind>major</kind></harmony></measure>
+<measure number="2"><harmony><root><root-step>B</root-step><root-alter>-1</root-alter></root><kind text="7sus4">suspended-fourth</kind></harmony>
+<harmony><root><root-step>C</root-step></root><kind>none</kind></harmony></measure>
+</part></score-partwise>`))
+	assert.Nil(t, err)
+	assert.Equal(t, "Minimal", s.Title)
+	assert.Equal(t, key.Nil, s.Key.Mode)
+	assert.Equal(t, meter.Common, s.Meter)
+	assert.Equal(t, DefaultTempo, s.Tempo)
+	assert.Equal(t, []Section{{Name: UnmarkedSection, Bars: []Bar{BarOf(4, "C"), BarOf(4, "Bbsus4")}}}, s.Sections)
+	assert.Nil(t, s.Notes)
+}
+
+func TestReadMusicXML_Errors(t *testing.T) {
+	_, err := ReadMusicXML(strings.NewReader(`<score-timewise version="3.1"/>`))
+	assert.True(t, errors.Is(err, ErrInvalidMusicXML))
+	assert.Equal(t, "invalid MusicXML: expected element type <score-partwise> but have <score-timewise>", err.Error())
+	_, err = ReadMusicXML(strings.NewReader(`<score-partwise><part><measure><attributes><key><fifths>9</fifths></key></attributes></measure></part></score-partwise>`))
+	assert.Equal(t, "invalid MusicXML: key signature of 9 fifths, expected -7 to 7", err.Error())
+	_, err = ReadMusicXML(strings.NewReader(`<score-partwise><part><measure><attributes><time><beats>4</beats><beat-type>0</beat-type></time></attributes></measure></part></score-partwise>`))
+	assert.True(t, errors.Is(err, meter.ErrInvalidMeter))
+	_, err = ReadMusicXML(strings.NewReader(`<score-partwise><part><measure><harmony><root><root-step>C</root-step></root><kind>major</kind><offset>20</offset></harmony></measure></part></score-partwise>`))
+	assert.True(t, errors.Is(err, ErrBeatRange))
+}
+
+func TestReadCompressedMusicXML(t *testing.T) {
+	var buf bytes.Buffer
+	z := zip.NewWriter(&buf)
+	f, _ := z.Create("META-INF/container.xml")
+	f.Write([]byte(`<container><rootfiles><rootfile full-path="score/example.xml"/></rootfiles></container>`))
+	f, _ = z.Create("score/example.xml")
+	f.Write([]byte(exampleMusicXML))
+	assert.Nil(t, z.Close())
+	s, err := ReadCompressedMusicXML(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(t, err)
+	assert.Equal(t, "Example", s.Title)
+	assert.Equal(t, 5, len(s.Notes))
+
+	_, err = ReadCompressedMusicXML(strings.NewReader("not a zip"), 9)
+	assert.True(t, errors.Is(err, ErrInvalidMusicXML))
+	buf.Reset()
+	z = zip.NewWriter(&buf)
+	assert.Nil(t, z.Close())
+	_, err = ReadCompressedMusicXML(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Equal(t, "invalid MusicXML: no score in the compressed file", err.Error())
+}
+
+//
+// Private
+//
+
+// exampleMusicXML of a score in 3/4, of a key signature, a tempo, rehearsal marks and chord symbols, a bass clef second voice,
+// a chord, a grace note and a tie, as exported by notation software
+const exampleMusicXML = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<!DOCTYPE score-partwise PUBLIC "-//Recordare//DTD MusicXML 3.1 Partwise//EN" "http://www.musicxml.org/dtds/partwise.dtd">
+<score-partwise version="3.1">
+  <work><work-title>Example</work-title></work>
+  <part-list><score-part id="P1"><part-name>Piano</part-name></score-part><score-part id="P2"><part-name>Bass</part-name></score-part></part-list>
+  <part id="P1">
+    <measure number="1">
+      <attributes>
+        <divisions>2</divisions>
+        <key><fifths>1</fifths><mode>minor</mode></key>
+        <time><beats>3</beats><beat-type>4</beat-type></time>
+      </attributes>
+      <direction placement="above"><direction-type><metronome><beat-unit>quarter</beat-unit><per-minute>96</per-minute></metronome></direction-type><sound tempo="96"/></direction>
+      <harmony><root><root-step>E</root-step></root><kind text="m">minor</kind></harmony>
+      <note><pitch><step>E</step><octave>4</octave></pitch><duration>2</duration><voice>1</voice><type>quarter</type></note>
+      <note><pitch><step>G</step><octave>4</octave></pitch><duration>2</duration><voice>1</voice><type>quarter</type></note>
+      <note><chord/><pitch><step>B</step><octave>4</octave></pitch><duration>2</duration><voice>1</voice><type>quarter</type></note>
+      <harmony><root><root-step>A</root-step></root><kind>half-diminished</kind><bass><bass-step>E</bass-step><bass-alter>-1</bass-alter></bass></harmony>
+      <note><rest/><duration>1</duration><voice>1</voice><type>eighth</type></note>
+      <note><grace/><pitch><step>D</step><octave>5</octave></pitch><voice>1</voice><type>eighth</type></note>
+      <note><pitch><step>C</step><octave>5</octave></pitch><duration>1</duration><voice>1</voice><type>eighth</type></note>
+      <backup><duration>6</duration></backup>
+      <note><pitch><step>E</step><octave>3</octave></pitch><duration>6</duration><voice>2</voice><type>half</type><dot/></note>
+    </measure>
+    <measure number="2">
+      <direction placement="above"><direction-type><rehearsal>A</rehearsal></direction-type></direction>
+      <harmony><root><root-step>B</root-step></root><kind text="7">dominant</kind><degree><degree-value>9</degree-value><degree-alter>-1</degree-alter><degree-type>alter</degree-type></degree></harmony>
+      <note><pitch><step>B</step><octave>4</octave></pitch><duration>2</duration><tie type="start"/><voice>1</voice><type>quarter</type></note>
+      <note><pitch><step>B</step><octave>4</octave></pitch><duration>4</duration><tie type="stop"/><tie type="start"/><voice>1</voice><type>half</type></note>
+    </measure>
+    <measure number="3">
+      <direction placement="above"><direction-type><rehearsal>B</rehearsal></direction-type></direction>
+      <harmony><root><root-step>G</root-step></root><kind>major</kind><degree><degree-value>9</degree-value><degree-alter>0</degree-alter><degree-type>add</degree-type></degree></harmony>
+      <note><pitch><step>B</step><octave>4</octave></pitch><duration>2</duration><tie type="stop"/><voice>1</voice><type>quarter</type></note>
+      <note><pitch><step>G</step><octave>4</octave></pitch><duration>4</duration><voice>1</voice><type>half</type></note>
+    </measure>
+  </part>
+  <part id="P2">
+    <measure number="1"><note><rest measure="yes"/><duration>6</duration><voice>1</voice></note></measure>
+  </part>
+</score-partwise>
+`
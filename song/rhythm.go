@@ -0,0 +1,51 @@
+// Harmonic rhythm is the rate at which the chords of a song change, e.g. once per bar, or on every strong beat
+package song
+
+import (
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+// Rhythm of the harmony of a section, its chord changes per bar, and how many fall on a strong beat or a weak one
+type Rhythm struct {
+	Section           string
+	Bars              int
+	Changes           int
+	StrongBeatChanges int
+	WeakBeatChanges   int
+	PerBar            []int // changes in each bar
+}
+
+// Density of chord changes in the section, per bar, e.g. 2 for changes on beats 1 and 3 of every bar
+func (r Rhythm) Density() float64 {
+	if r.Bars == 0 {
+		return 0
+	}
+	return float64(r.Changes) / float64(r.Bars)
+}
+
+// HarmonicRhythm of each section of the song, counting a change wherever a chord differs from the one sounding before it,
+// even from the last bar of the section before, and whether it falls on a strong beat of the meter, i.e. the first of a group of beats, e.g. 1 or 3 in 4/4, or 1 or 4 in 6/8
+func (s Song) HarmonicRhythm() []Rhythm {
+	var rhythms []Rhythm
+	prev := ""
+	for _, sec := range s.Sections {
+		r := Rhythm{Section: sec.Name, Bars: len(sec.Bars), PerBar: make([]int, len(sec.Bars))}
+		for n, b := range sec.Bars {
+			for _, bc := range b.Chords {
+				if bc.Name == prev {
+					continue
+				}
+				prev = bc.Name
+				r.Changes++
+				r.PerBar[n]++
+				if s.Meter.StrengthOf(bc.Beat) >= meter.Medium {
+					r.StrongBeatChanges++
+				} else {
+					r.WeakBeatChanges++
+				}
+			}
+		}
+		rhythms = append(rhythms, r)
+	}
+	return rhythms
+}
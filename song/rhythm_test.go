@@ -0,0 +1,54 @@
+// Harmonic rhythm is the rate at which the chords of a song change, e.g. once per bar, or on every strong beat
+package song
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+func TestSong_HarmonicRhythm(t *testing.T) {
+	rhythms := exampleSong().HarmonicRhythm()
+	assert.Equal(t, []Rhythm{
+		{Section: "verse", Bars: 2, Changes: 3, StrongBeatChanges: 3, WeakBeatChanges: 0, PerBar: []int{2, 1}},
+		{Section: "chorus", Bars: 2, Changes: 3, StrongBeatChanges: 2, WeakBeatChanges: 1, PerBar: []int{2, 1}},
+	}, rhythms)
+	assert.Equal(t, 1.5, rhythms[0].Density())
+}
+
+func TestSong_HarmonicRhythm_HeldChord(t *testing.T) {
+	s := New("Held", key.Of("C major"))
+	s.Add("intro", BarOf(4, "C"), BarOf(4, "C"), BarOf(4, "C", ".", "F", "C"))
+	s.Add("verse", BarOf(4, "C"), BarOf(4, "G", "G", "G", "G"))
+	rhythms := s.HarmonicRhythm()
+	assert.Equal(t, Rhythm{Section: "intro", Bars: 3, Changes: 3, StrongBeatChanges: 2, WeakBeatChanges: 1, PerBar: []int{1, 0, 2}}, rhythms[0])
+	assert.Equal(t, Rhythm{Section: "verse", Bars: 2, Changes: 1, StrongBeatChanges: 1, WeakBeatChanges: 0, PerBar: []int{0, 1}}, rhythms[1])
+	assert.Equal(t, 0.5, rhythms[1].Density())
+}
+
+func TestSong_HarmonicRhythm_CompoundMeter(t *testing.T) {
+	s := New("Jig", key.Of("D major"))
+	s.Meter, _ = meter.Parse("6/8")
+	s.Add("A", BarOf(6, "D", "G", "A"), BarOf(6, "D", "A"))
+	r := s.HarmonicRhythm()[0]
+	assert.Equal(t, 5, r.Changes)
+	assert.Equal(t, 3, r.StrongBeatChanges) // D on 1, D on 1, A on 4
+	assert.Equal(t, 2, r.WeakBeatChanges)   // G on 3, A on 5
+}
+
+func TestSong_HarmonicRhythm_Grouped(t *testing.T) {
+	s := New("Odd", key.Of("A minor"))
+	s.Meter, _ = meter.Parse("3+2+2/8")
+	s.Add("A", BarOf(7, "Am", ".", ".", "G", ".", "F", "."), BarOf(7, "Am", "G", ".", ".", ".", ".", "."))
+	r := s.HarmonicRhythm()[0]
+	assert.Equal(t, 5, r.Changes)
+	assert.Equal(t, 4, r.StrongBeatChanges) // Am on 1, G on 4, F on 6, Am on 1
+	assert.Equal(t, 1, r.WeakBeatChanges)   // G on 2
+}
+
+func TestRhythm_Density_NoBars(t *testing.T) {
+	assert.Equal(t, 0.0, Rhythm{}.Density())
+}
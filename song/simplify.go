@@ -0,0 +1,64 @@
+// Songs are simplified for a beginner's chart, every chord to an easier symbol of the same function, e.g. C7 of C9#11, or Am of Am7
+package song
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Simplified to a level, every chord, simplified in the key of the song if it has one, and renamed, e.g. "Am" of "Am7" simplified to chord.SimplifyTriads,
+// with its root spelled as it was written, e.g. "F#m" of "F#m7", unless it's spelled in the key, or the song as it is of chord.SimplifyNone
+func (s Song) Simplified(level chord.SimplifyLevel) Song {
+	if level == chord.SimplifyNone {
+		return s
+	}
+	ss := s
+	ss.Sections = make([]Section, len(s.Sections))
+	for i, sec := range s.Sections {
+		ss.Sections[i] = Section{Name: sec.Name, Bars: make([]Bar, len(sec.Bars))}
+		for j, b := range sec.Bars {
+			ss.Sections[i].Bars[j] = b.simplified(level, s)
+		}
+	}
+	return ss
+}
+
+//
+// Private
+//
+
+// simplified bar of a song, to a level, each chord simplified in the key of the song if it has a root, and renamed
+func (b Bar) simplified(level chord.SimplifyLevel, s Song) Bar {
+	sb := Bar{Chords: make([]BarChord, len(b.Chords))}
+	for i, bc := range b.Chords {
+		if bc.Chord.Root != note.Nil {
+			if s.Key.Root != note.Nil {
+				bc.Chord = bc.Chord.SimplifyIn(s.Key, level)
+			} else {
+				bc.Chord = bc.Chord.Simplify(level)
+				bc.Chord.AdjSymbol = writtenAdjSymbol(bc.Name, bc.Chord.AdjSymbol)
+			}
+			bc.Name = bc.Chord.Name()
+		}
+		sb.Chords[i] = bc
+	}
+	return sb
+}
+
+// writtenAdjSymbol of a chord as it was named, sharp or flat as its root was written, e.g. Sharp of "F#m7", or else as the chord was spelled
+func writtenAdjSymbol(name string, adjSymbol note.AdjSymbol) note.AdjSymbol {
+	root, remaining := note.RootAndRemaining(name)
+	if root == note.Nil {
+		return adjSymbol
+	}
+	written := strings.TrimSuffix(strings.TrimSpace(name), remaining)
+	switch {
+	case strings.ContainsAny(written, "#♯"):
+		return note.Sharp
+	case strings.ContainsAny(written[1:], "b♭"):
+		return note.Flat
+	}
+	return adjSymbol
+}
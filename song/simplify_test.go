@@ -0,0 +1,34 @@
+// Songs are simplified for a beginner's chart, every chord to an easier symbol of the same function, e.g. C7 of C9#11, or Am of Am7
+package song
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+)
+
+func TestSong_Simplified(t *testing.T) {
+	s := exampleSong()
+	ss := s.Simplified(chord.SimplifyTriads)
+	assert.Equal(t, "Dm G", ss.Sections[0].Bars[0].String(4))
+	assert.Equal(t, "C", ss.Sections[0].Bars[1].String(1))
+	assert.Equal(t, "G . . D", ss.Sections[1].Bars[0].String(4))
+	assert.Equal(t, "Dm7 G7", s.Sections[0].Bars[0].String(4), "without changing the song")
+	assert.Equal(t, s.Key, ss.Key)
+	assert.Equal(t, s, s.Simplified(chord.SimplifyNone))
+}
+
+func TestSong_Simplified_Spelling(t *testing.T) {
+	s := New("Tune", key.Key{})
+	s.Add("A", BarOf(4, "F#m9", "Bbmaj9/D"), BarOf(4, "H7"), BarOf(4, "Asus4"))
+	ss := s.Simplified(chord.SimplifyTensions)
+	assert.Equal(t, "F#m7 BbM7/D", ss.Sections[0].Bars[0].String(4), "of each root as it was written")
+	assert.Equal(t, "H7", ss.Sections[0].Bars[1].String(4), "of a chord that can't be parsed as it was")
+	assert.Equal(t, "Asus4", ss.Sections[0].Bars[2].String(4))
+	assert.Equal(t, "A", s.Simplified(chord.SimplifyTriads).Sections[0].Bars[2].String(4))
+	s.Key = key.Of("C major")
+	assert.Equal(t, "Am", s.Simplified(chord.SimplifyTriads).Sections[0].Bars[2].String(4), "of the third of a key without C#")
+}
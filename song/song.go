@@ -0,0 +1,121 @@
+// A song is a timeline of sections, e.g. an intro, verse and chorus, each of bars, with the chords placed on the beats of each bar, in a key and at a tempo.
+//
+// It's the container that importers of lead sheets populate, and that analyzers of more than one chord consume.
+//
+// https://en.wikipedia.org/wiki/Song_structure
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package song
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// DefaultTempo of a new song, in beats per minute, which is in common time, i.e. 4/4
+const DefaultTempo = 120.0
+
+var (
+	// ErrInvalidTempo when a tempo isn't a positive number of beats per minute
+	ErrInvalidTempo = errors.New("invalid tempo")
+
+	// ErrBeatRange when a chord is placed outside the beats of its bar
+	ErrBeatRange = errors.New("out of range beat")
+)
+
+// Song of sections, in a key, at a tempo, in a meter of some beats per bar, e.g. 3/4
+type Song struct {
+	Title    string
+	Key      key.Key
+	Tempo    float64 // in beats per minute
+	Meter    meter.Meter
+	Sections []Section
+	Notes    []melody.Note // of its melody, if any, in beats of the meter from 0 at the beginning, e.g. imported from MusicXML
+}
+
+// Section of a song, named by its role, e.g. "verse" or "chorus", and its bars in order
+type Section struct {
+	Name string
+	Bars []Bar
+}
+
+// New song, with a title, in a key, in 4/4 at 120 beats per minute, e.g. New("Autumn Leaves", key.Of("G major"))
+func New(title string, k key.Key) Song {
+	return Song{Title: title, Key: k, Tempo: DefaultTempo, Meter: meter.Common}
+}
+
+// Add a section of bars to the end of the song, e.g. Add("verse", BarOf(4, "Am7", "D7"), BarOf(4, "Gmaj7"))
+func (s *Song) Add(name string, bars ...Bar) {
+	s.Sections = append(s.Sections, Section{Name: name, Bars: bars})
+}
+
+// Bars of every section of the song, in order
+func (s Song) Bars() (bars []Bar) {
+	for _, sec := range s.Sections {
+		bars = append(bars, sec.Bars...)
+	}
+	return
+}
+
+// Progression of the chord changes of the song, in order, without repeating a chord held from one bar into the next
+func (s Song) Progression() progression.Progression {
+	var p progression.Progression
+	prev := ""
+	for _, b := range s.Bars() {
+		for _, bc := range b.Chords {
+			if bc.Name != prev {
+				p.Chords = append(p.Chords, bc.Chord)
+				prev = bc.Name
+			}
+		}
+	}
+	return p
+}
+
+// Tune of the melody of the song, its notes in its key, meter and tempo, e.g. to render it as sheet music or harmonize it
+func (s Song) Tune() melody.Tune {
+	return melody.Tune{Title: s.Title, Key: s.Key, Meter: s.Meter, Tempo: s.Tempo, Notes: s.Notes}
+}
+
+// Duration of the song, every bar at its tempo, counting beats of its beat unit
+func (s Song) Duration() time.Duration {
+	if s.Tempo <= 0 {
+		return 0
+	}
+	beats := float64(len(s.Bars()) * s.Meter.Beats)
+	return time.Duration(beats * float64(time.Minute) / s.Tempo)
+}
+
+// Validate the tempo and time signature of the song, and that every chord is placed within the beats of its bar
+func (s Song) Validate() error {
+	if s.Tempo <= 0 {
+		return fmt.Errorf("%w %v, expected beats per minute above 0", ErrInvalidTempo, s.Tempo)
+	}
+	if err := s.Meter.Validate(); err != nil {
+		return err
+	}
+	for _, sec := range s.Sections {
+		for n, b := range sec.Bars {
+			for _, bc := range b.Chords {
+				if bc.Beat < 1 || bc.Beat >= float64(s.Meter.Beats+1) {
+					return fmt.Errorf("%w %v of %s in bar %d of %s, expected 1 to %d", ErrBeatRange, bc.Beat, bc.Name, n+1, sec.Name, s.Meter.Beats)
+				}
+			}
+		}
+	}
+	return nil
+}
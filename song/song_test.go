@@ -0,0 +1,88 @@
+// A song is a timeline of sections, e.g. an intro, verse and chorus, each of bars, with the chords placed on the beats of each bar, in a key and at a tempo.
+package song
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestNew(t *testing.T) {
+	s := New("Autumn Leaves", key.Of("G major"))
+	assert.Equal(t, "Autumn Leaves", s.Title)
+	assert.Equal(t, note.G, s.Key.Root)
+	assert.Equal(t, DefaultTempo, s.Tempo)
+	assert.Equal(t, meter.Common, s.Meter)
+	assert.Nil(t, s.Validate())
+}
+
+func TestSong_Add(t *testing.T) {
+	s := exampleSong()
+	assert.Equal(t, 2, len(s.Sections))
+	assert.Equal(t, "verse", s.Sections[0].Name)
+	assert.Equal(t, 2, len(s.Sections[0].Bars))
+	assert.Equal(t, "chorus", s.Sections[1].Name)
+	assert.Equal(t, 4, len(s.Bars()))
+}
+
+func TestSong_Progression(t *testing.T) {
+	p := exampleSong().Progression()
+	var roots []note.Class
+	for _, c := range p.Chords {
+		roots = append(roots, c.Root)
+	}
+	assert.Equal(t, []note.Class{note.D, note.G, note.C, note.G, note.D, note.G}, roots)
+}
+
+func TestSong_Tune(t *testing.T) {
+	s := exampleSong()
+	s.Notes = []melody.Note{{Class: note.G, Octave: 4, Beats: 4}}
+	assert.Equal(t, melody.Tune{Title: "Example", Key: s.Key, Meter: meter.Common, Tempo: DefaultTempo, Notes: s.Notes}, s.Tune())
+}
+
+func TestSong_Duration(t *testing.T) {
+	s := exampleSong()
+	assert.Equal(t, 8*time.Second, s.Duration())
+	s.Tempo = 60
+	assert.Equal(t, 16*time.Second, s.Duration())
+	s.Tempo = 0
+	assert.Equal(t, time.Duration(0), s.Duration())
+}
+
+func TestSong_Validate(t *testing.T) {
+	s := exampleSong()
+	assert.Nil(t, s.Validate())
+
+	s.Tempo = -1
+	assert.True(t, errors.Is(s.Validate(), ErrInvalidTempo))
+
+	s = exampleSong()
+	s.Meter.Unit = 3
+	err := s.Validate()
+	assert.True(t, errors.Is(err, meter.ErrInvalidMeter))
+	assert.Equal(t, "invalid meter 4/3", err.Error())
+
+	s = exampleSong()
+	s.Sections[1].Bars[1].Chords[0].Beat = 5
+	err = s.Validate()
+	assert.True(t, errors.Is(err, ErrBeatRange))
+	assert.Equal(t, "out of range beat 5 of G in bar 2 of chorus, expected 1 to 4", err.Error())
+}
+
+//
+// Private
+//
+
+func exampleSong() Song {
+	s := New("Example", key.Of("G major"))
+	s.Add("verse", BarOf(4, "Dm7", "G7"), BarOf(4, "Cmaj7"))
+	s.Add("chorus", BarOf(4, "G", ".", ".", "D7"), BarOf(4, "G"))
+	return s
+}
@@ -0,0 +1,126 @@
+// Songs are expressed in YAML or JSON with each bar written as its chords, e.g. "Am7 . D7 .", each named without spaces and taking an equal share of the beats
+package song
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+)
+
+// RepeatBar written in place of a bar repeats the bar before it
+const RepeatBar = "%"
+
+// Load a song from YAML, e.g.
+//
+//     title: Autumn Leaves
+//     key: G major
+//     tempo: 132
+//     meter: 4/4
+//     sections:
+//     - name: verse
+//       bars: [Am7 D7, Gmaj7, Cmaj7, "F#m7b5 B7"]
+//
+// with the tempo and meter defaulting to 120 beats per minute in 4/4, and returning an error for an unknown key or chord, or an invalid meter
+func Load(r io.Reader) (Song, error) {
+	in, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Song{}, err
+	}
+	var spec specSong
+	err = yaml.UnmarshalStrict(in, &spec)
+	if err != nil {
+		return Song{}, err
+	}
+	return songFrom(spec)
+}
+
+// ToYAML of the Song, e.g. for the command-line utility
+func (s Song) ToYAML() string {
+	out, _ := yaml.Marshal(specFrom(s))
+	return string(out[:])
+}
+
+// ToJSON of the Song, e.g. for a web app
+func (s Song) ToJSON() string {
+	out, _ := json.Marshal(specFrom(s))
+	return string(out[:])
+}
+
+//
+// Private
+//
+
+type specSong struct {
+	Title    string        `yaml:"title,omitempty" json:"title,omitempty"`
+	Key      string        `yaml:"key,omitempty" json:"key,omitempty"`
+	Tempo    float64       `yaml:"tempo,omitempty" json:"tempo,omitempty"`
+	Meter    string        `yaml:"meter,omitempty" json:"meter,omitempty"`
+	Sections []specSection `yaml:"sections" json:"sections"`
+}
+
+type specSection struct {
+	Name string   `yaml:"name" json:"name"`
+	Bars []string `yaml:"bars,flow" json:"bars"`
+}
+
+func specFrom(s Song) specSong {
+	spec := specSong{Title: s.Title, Tempo: s.Tempo, Meter: s.Meter.String(), Sections: []specSection{}}
+	if s.Key.Mode != key.Nil {
+		spec.Key = s.Key.Root.String(s.Key.AdjSymbol) + " " + strings.ToLower(s.Key.Mode.String())
+	}
+	for _, sec := range s.Sections {
+		ss := specSection{Name: sec.Name, Bars: []string{}}
+		for _, b := range sec.Bars {
+			ss.Bars = append(ss.Bars, b.String(s.Meter.Beats))
+		}
+		spec.Sections = append(spec.Sections, ss)
+	}
+	return spec
+}
+
+func songFrom(spec specSong) (Song, error) {
+	s := Song{Title: spec.Title, Tempo: DefaultTempo, Meter: meter.Common}
+	if len(spec.Key) > 0 {
+		k, err := key.Parse(spec.Key)
+		if err != nil {
+			return Song{}, err
+		}
+		s.Key = k
+	}
+	if spec.Tempo != 0 {
+		s.Tempo = spec.Tempo
+	}
+	if len(spec.Meter) > 0 {
+		m, err := meter.Parse(spec.Meter)
+		if err != nil {
+			return Song{}, err
+		}
+		s.Meter = m
+	}
+	if err := s.Validate(); err != nil {
+		return Song{}, err
+	}
+	for _, ss := range spec.Sections {
+		sec := Section{Name: ss.Name}
+		for n, text := range ss.Bars {
+			if strings.TrimSpace(text) == RepeatBar && len(sec.Bars) > 0 {
+				sec.Bars = append(sec.Bars, sec.Bars[len(sec.Bars)-1])
+				continue
+			}
+			b, err := parseBar(s.Meter.Beats, strings.Fields(text))
+			if err != nil {
+				return Song{}, fmt.Errorf("bar %d of %s: %w", n+1, ss.Name, err)
+			}
+			sec.Bars = append(sec.Bars, b)
+		}
+		s.Sections = append(s.Sections, sec)
+	}
+	return s, nil
+}
@@ -0,0 +1,96 @@
+// Songs are expressed in YAML or JSON with each bar written as its chords, e.g. "Am7 . D7 .", each named without spaces and taking an equal share of the beats
+package song
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestLoad(t *testing.T) {
+	s, err := Load(strings.NewReader(`title: Example
+key: D major
+tempo: 96
+meter: 3/4
+sections:
+- name: intro
+  bars: [D, "%"]
+- name: verse
+  bars: [Em7 . A7, D, .]
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, "Example", s.Title)
+	assert.Equal(t, note.D, s.Key.Root)
+	assert.Equal(t, key.Major, s.Key.Mode)
+	assert.Equal(t, 96.0, s.Tempo)
+	assert.Equal(t, meter.Meter{Beats: 3, Unit: 4, Groups: []int{3}}, s.Meter)
+	assert.Equal(t, 2, len(s.Sections))
+	assert.Equal(t, s.Sections[0].Bars[0], s.Sections[0].Bars[1])
+	assert.Equal(t, []float64{1, 3}, beatsOf(s.Sections[1].Bars[0]))
+	assert.Equal(t, 0, s.Sections[1].Bars[2].Changes())
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	s, err := Load(strings.NewReader(`sections:
+- name: verse
+  bars: [C G]
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultTempo, s.Tempo)
+	assert.Equal(t, meter.Common, s.Meter)
+	assert.Equal(t, key.Nil, s.Key.Mode)
+	assert.Equal(t, []float64{1, 3}, beatsOf(s.Sections[0].Bars[0]))
+}
+
+func TestLoad_Errors(t *testing.T) {
+	_, err := Load(strings.NewReader("meter: 4/0\n"))
+	assert.True(t, errors.Is(err, meter.ErrInvalidMeter))
+	_, err = Load(strings.NewReader("meter: waltz\n"))
+	assert.Equal(t, `invalid meter "waltz", expected e.g. 3/4 or 2+2+3/8`, err.Error())
+	_, err = Load(strings.NewReader("tempo: -3\n"))
+	assert.True(t, errors.Is(err, ErrInvalidTempo))
+	_, err = Load(strings.NewReader("key: H major\n"))
+	assert.True(t, errors.Is(err, key.ErrUnknownRoot))
+	_, err = Load(strings.NewReader("sections:\n- name: verse\n  bars: [C, Cjams]\n"))
+	assert.True(t, errors.Is(err, chord.ErrUnknownForm))
+	assert.Equal(t, `bar 2 of verse: unknown form "jams" at position 1 of chord "Cjams"`, err.Error())
+	_, err = Load(strings.NewReader("tempi: 120\n"))
+	assert.NotNil(t, err)
+}
+
+func TestToYAML(t *testing.T) {
+	assert.Equal(t, `title: Example
+key: G major
+tempo: 120
+meter: 4/4
+sections:
+- name: verse
+  bars: [Dm7 G7, Cmaj7]
+- name: chorus
+  bars: [G . . D7, G]
+`, exampleSong().ToYAML())
+}
+
+func TestToJSON(t *testing.T) {
+	assert.Equal(t, `{"title":"Example","key":"G major","tempo":120,"meter":"4/4","sections":[{"name":"verse","bars":["Dm7 G7","Cmaj7"]},{"name":"chorus","bars":["G . . D7","G"]}]}`, exampleSong().ToJSON())
+}
+
+func TestToYAML_RoundTrip(t *testing.T) {
+	s, err := Load(strings.NewReader(exampleSong().ToYAML()))
+	assert.Nil(t, err)
+	assert.Equal(t, exampleSong().ToYAML(), s.ToYAML())
+}
+
+func TestLoad_GroupedMeter(t *testing.T) {
+	s, err := Load(strings.NewReader("meter: 7/8 (3+2+2)\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, 2, 2}, s.Meter.Groups)
+	assert.Equal(t, "tempo: 120\nmeter: 3+2+2/8\nsections: []\n", s.ToYAML())
+}
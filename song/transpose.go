@@ -0,0 +1,69 @@
+// Songs are transposed up or down some semitones, every chord and note of the melody, into the key that many semitones away, e.g. for a singer's range
+package song
+
+import (
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Transposed some semitones, up if positive or down if negative, its key and every chord, renamed and spelled in the new key if it has one,
+// and every note of its melody, moved to another octave as it crosses C, e.g. Transposed(2) of a song in C major into D major
+func (s Song) Transposed(semitones int) Song {
+	ts := s
+	ts.Key = s.Key.Transposed(semitones)
+	ts.Sections = make([]Section, len(s.Sections))
+	for i, sec := range s.Sections {
+		ts.Sections[i] = Section{Name: sec.Name, Bars: make([]Bar, len(sec.Bars))}
+		for j, b := range sec.Bars {
+			ts.Sections[i].Bars[j] = b.transposed(semitones, ts)
+		}
+	}
+	if s.Notes != nil {
+		ts.Notes = make([]melody.Note, len(s.Notes))
+		for i, n := range s.Notes {
+			if n.Class != note.Nil {
+				var octaves note.Octave
+				n.Class, octaves = n.Class.Step(semitones)
+				n.Octave += octaves
+			}
+			ts.Notes[i] = n
+		}
+	}
+	return ts
+}
+
+//
+// Private
+//
+
+// transposed bar of a song, some semitones, each chord spelled in the key of the song if it has a root, and renamed
+func (b Bar) transposed(semitones int, s Song) Bar {
+	tb := Bar{Chords: make([]BarChord, len(b.Chords))}
+	for i, bc := range b.Chords {
+		if bc.Chord.Root != note.Nil {
+			bc.Chord = bc.Chord.Transpose(semitones)
+			if s.Key.Root != note.Nil {
+				bc.Chord = bc.Chord.SpelledIn(s.Key)
+			}
+			bc.Name = transposedName(bc.Name, bc.Chord)
+		}
+		tb.Chords[i] = bc
+	}
+	return tb
+}
+
+// transposedName of a chord as it was named, e.g. "Bbmaj7/D" of "Cmaj7/E" two semitones down, its root and any slash bass renamed as the chord is spelled
+// but the rest of its name as it was written, or else the name of the chord if its root isn't named plainly
+func transposedName(name string, c chord.Chord) string {
+	root, remaining := note.RootAndRemaining(name)
+	if root == note.Nil {
+		return c.Name()
+	}
+	if slash := strings.LastIndex(remaining, "/"); slash >= 0 && c.Bass != note.Nil {
+		remaining = remaining[:slash+1] + c.Bass.String(c.AdjSymbol)
+	}
+	return c.Root.String(c.AdjSymbol) + remaining
+}
@@ -0,0 +1,36 @@
+// Songs are transposed up or down some semitones, every chord and note of the melody, into the key that many semitones away, e.g. for a singer's range
+package song
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/melody"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestSong_Transposed(t *testing.T) {
+	s := exampleSong()
+	ts := s.Transposed(-2)
+	assert.Equal(t, "F Major", ts.Key.Canonical())
+	assert.Equal(t, "Cm7 F7", ts.Sections[0].Bars[0].String(4))
+	assert.Equal(t, "Bbmaj7", ts.Sections[0].Bars[1].String(1))
+	assert.Equal(t, "F . . C7", ts.Sections[1].Bars[0].String(4))
+	assert.Equal(t, "Dm7 G7", s.Sections[0].Bars[0].String(4), "without changing the song")
+	assert.Equal(t, s.Title, ts.Title)
+	assert.Equal(t, s.Meter, ts.Meter)
+	assert.Nil(t, ts.Notes)
+}
+
+func TestSong_Transposed_Melody(t *testing.T) {
+	s := New("Tune", key.Key{})
+	s.Add("A", BarOf(4, "A7/C#", "B♭ sus4"))
+	s.Notes = []melody.Note{{Class: note.B, Octave: 4, Beats: 1}, {Class: note.Nil, Beat: 1, Beats: 1}, {Class: note.C, Octave: 5, Beat: 2, Beats: 2}}
+	ts := s.Transposed(1)
+	assert.Equal(t, key.Key{}, ts.Key)
+	assert.Equal(t, "A#7/D Bsus4", ts.Sections[0].Bars[0].String(4), "spelled as each chord was, without a key")
+	assert.Equal(t, []melody.Note{{Class: note.C, Octave: 5, Beats: 1}, {Class: note.Nil, Beat: 1, Beats: 1}, {Class: note.Cs, Octave: 5, Beat: 2, Beats: 2}}, ts.Notes)
+	assert.Equal(t, note.B, s.Notes[0].Class)
+}
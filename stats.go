@@ -0,0 +1,41 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-music-theory/music-theory/stats"
+)
+
+// songStats from a file at a path, writing its key, its chords and beats, the average beats of each chord, its chromaticism,
+// a table of the count of each type of chord, and a table of each unusual chord relative to the corpus, by its bar, beat and numeral, and why
+func songStats(w io.Writer, path string) error {
+	s, err := readSongFile(path)
+	if err != nil {
+		return err
+	}
+	r := stats.Progression(s)
+	fmt.Fprintf(w, "Key: %s %s\n", r.Key.Root.String(r.Key.AdjSymbol), strings.ToLower(r.Key.Mode.String()))
+	fmt.Fprintf(w, "Chords: %d in %g beats, %.3g beats each\n", r.Chords, r.Beats, r.HarmonicRhythm)
+	fmt.Fprintf(w, "Chromaticism: %.0f%%\n", r.Chromaticism*100)
+	if r.Chords == 0 {
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nTYPE\tCOUNT\tSHARE")
+	for _, t := range r.Types {
+		fmt.Fprintf(tw, "%s\t%d\t%.0f%%\n", t.Type, t.Count, t.Share*100)
+	}
+	if err = tw.Flush(); err != nil || len(r.Unusual) == 0 {
+		return err
+	}
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nUNUSUAL\tBAR\tBEAT\tNUMERAL\tWHY")
+	for _, u := range r.Unusual {
+		fmt.Fprintf(tw, "%s\t%d\t%g\t%s\t%s\n", u.Name, u.Bar, u.Beat, u.Numeral, strings.Join(u.Reasons, "; "))
+	}
+	return tw.Flush()
+}
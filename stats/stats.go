@@ -0,0 +1,210 @@
+// Statistics of the chords of a song are a quick quantitative summary of its harmony, for musicology, e.g. to compare the songs of a genre or era:
+// a histogram of the types of its chords, an index of its chromaticism, its average harmonic rhythm,
+// and the chords of a type or numeral that's unusual relative to the corpus of classic progressions built in.
+//
+// https://en.wikipedia.org/wiki/Harmonic_rhythm
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package stats
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+	"github.com/go-music-theory/music-theory/roman"
+	"github.com/go-music-theory/music-theory/song"
+)
+
+// MinCorpusShare of the chords of the corpus of the type of a chord, or the numeral of its triad, below which it's unusual, e.g. of an augmented triad or a bII
+const MinCorpusShare = 0.02
+
+// Report of the statistics of the chords of a song, each chord a change from the one before it, not counting a chord repeated in the next bar
+type Report struct {
+	Key            key.Key     // of the song, or else of its progression, if it doesn't say
+	Chords         int         // changes of chord
+	Beats          float64     // of all its bars
+	Types          []TypeCount // of its chords, in descending order of count, then of the first of each type
+	Chromaticism   float64     // share of the tones of its chords outside the scale of its key, from 0 to 1
+	HarmonicRhythm float64     // average beats of each chord until the next, or 0 of no chords
+	Unusual        []Unusual   // chords of a type, or the numeral of a triad, less common in the corpus than the MinCorpusShare
+}
+
+// TypeCount of a type of chord in a song, e.g. 3 of "major triad, minor seventh", and its share of the chords of the song, from 0 to 1
+type TypeCount struct {
+	Type  string // quality of the chord, e.g. "minor triad, minor seventh"
+	Count int
+	Share float64
+}
+
+// Unusual chord of a song, at its bar and beat, each counted from 1, by its name as written and its numeral in the key, with the reasons it's unusual
+type Unusual struct {
+	Bar     int
+	Beat    float64
+	Name    string
+	Numeral string   // e.g. "bII", or "?" of a chord neither diatonic nor borrowed
+	Reasons []string // e.g. "augmented triad in 1% of the corpus"
+}
+
+// Progression of a song, the statistics of its chords, relative to the corpus of classic progressions built in
+func Progression(s song.Song) Report {
+	r := Report{Key: s.Key}
+	changes := changesOf(s)
+	p := progression.Progression{}
+	for _, c := range changes {
+		p.Chords = append(p.Chords, c.chord)
+	}
+	if r.Key.Mode == key.Nil {
+		r.Key = p.Key()
+	}
+	r.Chords = len(changes)
+	r.Beats = float64(len(s.Bars()) * beatsPerBarOf(s))
+	if r.Chords == 0 {
+		return r
+	}
+	r.HarmonicRhythm = r.Beats / float64(r.Chords)
+	r.Types = typeCountsOf(changes)
+	r.Chromaticism = chromaticismOf(changes, r.Key)
+	numerals := progression.Song{Key: r.Key, Progression: p}.Numerals()
+	for n, c := range changes {
+		if reasons := reasonsOf(typeOf(c.chord), numerals[n]); len(reasons) > 0 {
+			r.Unusual = append(r.Unusual, Unusual{Bar: c.bar, Beat: c.beat, Name: c.name, Numeral: numerals[n], Reasons: reasons})
+		}
+	}
+	return r
+}
+
+//
+// Private
+//
+
+// change of chord in a song, at a bar and beat, each counted from 1
+type change struct {
+	bar   int
+	beat  float64
+	name  string
+	chord chord.Chord
+}
+
+// frequencies of the types and the numerals of the triads of the chords of a corpus, and the count of its chords
+type frequencies struct {
+	types    map[string]int
+	numerals map[string]int
+	chords   int
+}
+
+// corpus of the frequencies of the classic progressions built in
+var corpus = frequenciesOf(progression.Songs(progression.Corpus()))
+
+// frequenciesOf the chords of some songs, by the type of each and the numeral of its triad in the key of its song
+func frequenciesOf(songs []progression.Song) frequencies {
+	f := frequencies{types: make(map[string]int), numerals: make(map[string]int)}
+	for _, s := range songs {
+		for n, numeral := range s.Numerals() {
+			f.types[typeOf(s.Progression.Chords[n])]++
+			f.numerals[triadNumeralOf(numeral)]++
+			f.chords++
+		}
+	}
+	return f
+}
+
+// changesOf chord in a song, each chord placed in its bars but one the same as the chord before it
+func changesOf(s song.Song) (changes []change) {
+	for n, b := range s.Bars() {
+		for _, bc := range b.Chords {
+			if len(changes) > 0 && changes[len(changes)-1].name == bc.Name {
+				continue
+			}
+			changes = append(changes, change{bar: n + 1, beat: bc.Beat, name: bc.Name, chord: bc.Chord})
+		}
+	}
+	return
+}
+
+// beatsPerBarOf a song, of its meter, or 4 if it hasn't any
+func beatsPerBarOf(s song.Song) int {
+	if s.Meter.Beats > 0 {
+		return s.Meter.Beats
+	}
+	return 4
+}
+
+// typeOf a chord, its quality, e.g. "major triad, minor seventh" of G7
+func typeOf(c chord.Chord) string {
+	return c.Quality().String()
+}
+
+// typeCountsOf some changes of chord, the count of each type, in descending order of count, then of the first of each type
+func typeCountsOf(changes []change) []TypeCount {
+	var counts []TypeCount
+	index := make(map[string]int)
+	for _, c := range changes {
+		t := typeOf(c.chord)
+		if _, ok := index[t]; !ok {
+			index[t] = len(counts)
+			counts = append(counts, TypeCount{Type: t})
+		}
+		counts[index[t]].Count++
+	}
+	for n := range counts {
+		counts[n].Share = float64(counts[n].Count) / float64(len(changes))
+	}
+	sort.SliceStable(counts, func(i, j int) bool { return counts[i].Count > counts[j].Count })
+	return counts
+}
+
+// chromaticismOf some changes of chord in a key, the share of all their tones outside its scale, e.g. the G# of E7 in A minor
+func chromaticismOf(changes []change, k key.Key) float64 {
+	s := k.Scale()
+	var tones, outside int
+	for _, c := range changes {
+		for _, class := range c.chord.ToneSet().Classes() {
+			tones++
+			if !s.Contains(class) {
+				outside++
+			}
+		}
+	}
+	if tones == 0 {
+		return 0
+	}
+	return float64(outside) / float64(tones)
+}
+
+// reasonsOf the type and numeral of a chord that it's unusual, of its type or the numeral of its triad less common in the corpus than the MinCorpusShare,
+// or of a numeral "?"
+func reasonsOf(chordType, numeral string) (reasons []string) {
+	if share := float64(corpus.types[chordType]) / float64(corpus.chords); share < MinCorpusShare {
+		reasons = append(reasons, fmt.Sprintf("%s in %.2g%% of the corpus", chordType, share*100))
+	}
+	triad := triadNumeralOf(numeral)
+	switch share := float64(corpus.numerals[triad]) / float64(corpus.chords); {
+	case numeral == "?":
+		reasons = append(reasons, "neither diatonic nor borrowed in the key")
+	case share < MinCorpusShare:
+		reasons = append(reasons, fmt.Sprintf("%s in %.2g%% of the corpus", triad, share*100))
+	}
+	return
+}
+
+// triadNumeralOf the numeral of a chord, the numeral of its triad in root position, without any seventh, as roman.Analyze writes it, e.g. "IV" of "IVmaj7" or "ii°" of "iiø7",
+// or the numeral as it is of one that isn't Roman, e.g. "?"
+func triadNumeralOf(numeral string) string {
+	r, err := roman.Parse(numeral)
+	if err != nil {
+		return numeral
+	}
+	r.Seventh, r.Inversion = roman.NoSeventh, 0
+	return r.String()
+}
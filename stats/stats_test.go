@@ -0,0 +1,74 @@
+// Statistics of the chords of a song are a quick quantitative summary of its harmony, for musicology, e.g. to compare the songs of a genre or era:
+package stats
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/meter"
+	"github.com/go-music-theory/music-theory/song"
+)
+
+func TestProgression(t *testing.T) {
+	r := Progression(exampleSong())
+	assert.Equal(t, key.Of("C major"), r.Key)
+	assert.Equal(t, 10, r.Chords) // Cmaj7 repeated in the next bar is the same chord
+	assert.Equal(t, 28.0, r.Beats)
+	assert.Equal(t, 2.8, r.HarmonicRhythm)
+	assert.Equal(t, []TypeCount{
+		{Type: "major triad, minor seventh", Count: 3, Share: 0.3},
+		{Type: "major triad", Count: 3, Share: 0.3},
+		{Type: "minor triad, minor seventh", Count: 1, Share: 0.1},
+		{Type: "major triad, major seventh", Count: 1, Share: 0.1},
+		{Type: "augmented triad", Count: 1, Share: 0.1},
+		{Type: "minor triad", Count: 1, Share: 0.1},
+	}, r.Types)
+	assert.InDelta(t, 0.2, r.Chromaticism, 1e-9) // of Ab, Bb, G#, G#, Db and Ab in 30 tones
+	assert.Equal(t, []Unusual{
+		{Bar: 4, Beat: 1, Name: "Ab", Numeral: "bVI", Reasons: []string{"bVI in 0% of the corpus"}},
+		{Bar: 4, Beat: 3, Name: "Bb", Numeral: "bVII", Reasons: []string{"bVII in 1.6% of the corpus"}},
+		{Bar: 5, Beat: 1, Name: "Caug", Numeral: "?", Reasons: []string{"augmented triad in 0% of the corpus", "neither diatonic nor borrowed in the key"}},
+		{Bar: 5, Beat: 3, Name: "E7", Numeral: "?", Reasons: []string{"neither diatonic nor borrowed in the key"}},
+		{Bar: 6, Beat: 3, Name: "Db7", Numeral: "?", Reasons: []string{"neither diatonic nor borrowed in the key"}},
+	}, r.Unusual)
+}
+
+func TestProgression_DetectsKey(t *testing.T) {
+	s := song.New("Untitled", key.Key{})
+	s.Meter, _ = meter.Parse("3/4")
+	s.Add("A", song.BarOf(3, "Am"), song.BarOf(3, "F", "E7"), song.BarOf(3, "Am"))
+	r := Progression(s)
+	assert.Equal(t, key.Of("A minor"), r.Key)
+	assert.Equal(t, 9.0, r.Beats)
+	assert.Equal(t, 2.25, r.HarmonicRhythm)
+	assert.InDelta(t, 1.0/13, r.Chromaticism, 1e-9) // the G# of E7
+	assert.Empty(t, r.Unusual)
+}
+
+func TestProgression_Empty(t *testing.T) {
+	r := Progression(song.New("Silence", key.Of("C major")))
+	assert.Equal(t, 0, r.Chords)
+	assert.Equal(t, 0.0, r.HarmonicRhythm)
+	assert.Nil(t, r.Types)
+}
+
+func TestTriadNumeralOf(t *testing.T) {
+	assert.Equal(t, "IV", triadNumeralOf("IVmaj7"))
+	assert.Equal(t, "ii°", triadNumeralOf("iiø7"))
+	assert.Equal(t, "i", triadNumeralOf("i(maj7)"))
+	assert.Equal(t, "bVII", triadNumeralOf("bVII7"))
+	assert.Equal(t, "?", triadNumeralOf("?"))
+}
+
+//
+// Private
+//
+
+func exampleSong() song.Song {
+	s := song.New("Example", key.Of("C major"))
+	s.Add("verse", song.BarOf(4, "Dm7", "G7"), song.BarOf(4, "Cmaj7"), song.BarOf(4, "Cmaj7"), song.BarOf(4, "Ab", "Bb"))
+	s.Add("chorus", song.BarOf(4, "Caug", "E7"), song.BarOf(4, "Am", "Db7"), song.BarOf(4, "C"))
+	return s
+}
@@ -0,0 +1,52 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestSongStats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stats")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	var buf bytes.Buffer
+	assert.Nil(t, songStats(&buf, writeSongFile(t, dir)))
+	assert.Equal(t, `Key: G major
+Chords: 4 in 12 beats, 3 beats each
+Chromaticism: 0%
+
+TYPE                        COUNT  SHARE
+major triad, major seventh  2      50%
+minor triad, minor seventh  1      25%
+major triad, minor seventh  1      25%
+`, buf.String())
+}
+
+func TestSongStats_Unusual(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stats")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "chromatic.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("title: Chromatic\nkey: C major\nsections:\n- name: A\n  bars: [C Ab, Caug, C]\n"), 0644))
+	var buf bytes.Buffer
+	assert.Nil(t, songStats(&buf, path))
+	assert.Contains(t, buf.String(), `UNUSUAL  BAR  BEAT  NUMERAL  WHY
+Ab       1    3     bVI      bVI in 0% of the corpus
+Caug     2    1     ?        augmented triad in 0% of the corpus; neither diatonic nor borrowed in the key
+`)
+}
+
+func TestSongStats_Command(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stats")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assertExitCode(t, 0, "", "stats")
+	assertExitCode(t, 0, "", "stats", writeSongFile(t, dir))
+	assertExitCode(t, 1, "Error occurred: open "+filepath.Join(dir, "missing.yaml")+": no such file or directory\n", "stats", filepath.Join(dir, "missing.yaml"))
+}
@@ -0,0 +1,37 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/substitute"
+)
+
+// suggestSubstitutions for a chord named, in a key named, or if the key name is empty, in no particular key, writing a table of each substitute, its kind and why it works
+func suggestSubstitutions(w io.Writer, name string, keyName string) error {
+	c, err := chord.Parse(name)
+	if err != nil {
+		return err
+	}
+	var k key.Key
+	if len(keyName) > 0 {
+		if k, err = key.Parse(keyName); err != nil {
+			return err
+		}
+	}
+	subs := substitute.For(c, k)
+	if len(subs) == 0 {
+		_, err = fmt.Fprintf(w, "No substitutions for %s\n", name)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHORD\tKIND\tEXPLANATION")
+	for _, s := range subs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", s.Name, s.Kind, s.Explanation)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,30 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestSuggestSubstitutions(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, suggestSubstitutions(&out, "G7", "C"))
+	assert.Equal(t, "CHORD   KIND                EXPLANATION\n"+
+		"Db7     tritone             Db7 shares the tritone of G7, B and F, and resolves down a semitone to C, as G7 resolves down a fifth\n"+
+		"Bb7     backdoor dominant   Bb7, the bVII7 of C, resolves up a whole step to C in place of G7, sharing D and F\n"+
+		"F#dim7  passing diminished  F#dim7, a semitone below G7, passes into it, each of its tones leading by step to a tone of G7\n", out.String())
+	out.Reset()
+	assert.Nil(t, suggestSubstitutions(&out, "Csus4", ""))
+	assert.Equal(t, "No substitutions for Csus4\n", out.String())
+	assert.NotNil(t, suggestSubstitutions(&out, "Hb", ""))
+	assert.NotNil(t, suggestSubstitutions(&out, "C", "H major"))
+}
+
+func TestSubsExitCode(t *testing.T) {
+	assertExitCode(t, 0, "", "subs")
+	assertExitCode(t, 0, "", "subs", "G7", "--key", "C")
+	assertExitCode(t, 0, "", "subs", "Am7")
+	assertExitCode(t, 1, "Error occurred: unknown root \"Hb\" at position 0 of chord \"Hb\"\n", "subs", "Hb")
+}
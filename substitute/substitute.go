@@ -0,0 +1,250 @@
+// A chord substitution replaces a chord of a progression with another that shares its tones or its function, e.g. the tritone substitution of a dominant seventh chord.
+//
+// Substitutes are suggested of each kind: the tritone substitution and the backdoor dominant of a dominant seventh chord,
+// the relative and parallel chords of a major or minor chord, and a diminished passing chord leading into any chord with a third.
+//
+// https://en.wikipedia.org/wiki/Chord_substitution
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package substitute
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Kind of substitution
+type Kind int
+
+// Kinds of substitution
+const (
+	Tritone  Kind = iota // a dominant seventh chord a tritone away, sharing its third and seventh, e.g. Db7 for G7
+	Backdoor             // the dominant seventh chord a whole step below the tonic that a dominant resolves to, e.g. Bb7 for G7 resolving to C
+	Relative             // a chord a third away, sharing two of its tones, e.g. Am or Em for C
+	Parallel             // the chord of the same root in the parallel mode, e.g. Cm for C
+	Passing              // a diminished seventh chord a semitone below, leading into it, e.g. Bdim7 before C
+)
+
+// Substitution of a chord, by its kind, the name of the substitute, e.g. "Db7", and an explanation of why it works
+type Substitution struct {
+	Kind        Kind
+	Name        string
+	Chord       chord.Chord
+	Explanation string
+}
+
+// For a chord in a key, or if the key is Nil, in no particular key, the substitutions of each kind that apply to it, in the order of the kinds.
+// A relative chord is only suggested if each of its tones is in the key, and any chord a dominant seventh chord resolves to is a fifth below it.
+func For(c chord.Chord, k key.Key) []Substitution {
+	third, fifth, seventh := semitonesOf(c, chord.I3), semitonesOf(c, chord.I5), semitonesOf(c, chord.I7)
+	var subs []Substitution
+	original := NameOf(c)
+	switch {
+	case third == 4 && seventh == 10:
+		target := step(c.Root, 5)
+		subs = append(subs, substitution(Tritone, step(c.Root, 6), note.Flat, "7",
+			" shares the tritone of %s, %s, and resolves down a semitone to %s, as %s resolves down a fifth",
+			original, sharedOf(c, "7", step(c.Root, 6), c.AdjSymbol), target.String(c.AdjSymbol), original))
+		subs = append(subs, substitution(Backdoor, step(target, 10), note.Flat, "7",
+			", the bVII7 of %s, resolves up a whole step to %s in place of %s, sharing %s",
+			target.String(c.AdjSymbol), target.String(c.AdjSymbol), original, sharedOf(c, "7", step(target, 10), c.AdjSymbol)))
+	case (third == 4 || third == 3) && fifth == 7:
+		subs = append(subs, relativesOf(c, k, third, seventh, original)...)
+		parallel := 7 - third // the other third, 3 for 4 or 4 for 3
+		mode := "minor"
+		if parallel == 4 {
+			mode = "major"
+		}
+		suffix := suffixOf(parallel, 7, seventhOf(parallel, seventh))
+		subs = append(subs, substitution(Parallel, c.Root, c.AdjSymbol, suffix,
+			", borrowed from the parallel %s of %s, shares its root and fifth", mode, original))
+	}
+	if third > 0 {
+		subs = append(subs, substitution(Passing, step(c.Root, 11), note.Sharp, "dim7",
+			", a semitone below %s, passes into it, each of its tones leading by step to a tone of %s", original, original))
+	}
+	adj := adjSymbolOf(k)
+	for n := range subs {
+		if subs[n].Kind == Relative && k.Mode != key.Nil {
+			subs[n] = respelled(subs[n], adj)
+		}
+	}
+	return subs
+}
+
+// NameOf a chord, by its root and the suffix of its triad or seventh, e.g. "G7" or "Bdim", or its root alone for any other chord
+func NameOf(c chord.Chord) string {
+	return c.Root.String(c.AdjSymbol) + suffixOf(semitonesOf(c, chord.I3), semitonesOf(c, chord.I5), semitonesOf(c, chord.I7))
+}
+
+// String of the Kind, e.g. "tritone"
+func (of Kind) String() string {
+	switch of {
+	case Tritone:
+		return "tritone"
+	case Backdoor:
+		return "backdoor dominant"
+	case Relative:
+		return "relative"
+	case Parallel:
+		return "parallel"
+	case Passing:
+		return "passing diminished"
+	}
+	return ""
+}
+
+// String of the Substitution, e.g. "tritone: Db7 shares the tritone of G7, B and F, and resolves down a semitone to C, as G7 resolves down a fifth"
+func (s Substitution) String() string {
+	return s.Kind.String() + ": " + s.Explanation
+}
+
+//
+// Private
+//
+
+// relativesOf a major or minor chord with a perfect fifth, in a key, the chords a third below and above it, of the opposite third, that share two of its tones,
+// each only if its tones are in the key, or if the key is Nil, only the relative minor of a major chord, or relative major of a minor chord
+func relativesOf(c chord.Chord, k key.Key, third, seventh int, original string) []Substitution {
+	var subs []Substitution
+	relative, mediant := 9, 4 // a minor third below, and a major third above, a major chord
+	if third == 3 {
+		relative, mediant = 3, 8 // a minor third above, and a major third below, a minor chord
+	}
+	other := 7 - third
+	suffix := suffixOf(other, 7, seventhOf(other, seventh))
+	mode := "minor"
+	if other == 4 {
+		mode = "major"
+	}
+	for n, semitones := range []int{relative, mediant} {
+		root := step(c.Root, semitones)
+		var s Substitution
+		if n == 0 {
+			s = substitution(Relative, root, c.AdjSymbol, suffix, ", the relative %s of %s, shares %s", mode, original, sharedOf(c, suffix, root, c.AdjSymbol))
+		} else {
+			direction := "above"
+			if third == 3 {
+				direction = "below"
+			}
+			s = substitution(Relative, root, c.AdjSymbol, suffix, ", a third %s %s, shares %s", direction, original, sharedOf(c, suffix, root, c.AdjSymbol))
+		}
+		if k.Mode == key.Nil && n > 0 || k.Mode != key.Nil && !inKey(s.Chord, k) {
+			continue
+		}
+		subs = append(subs, s)
+	}
+	return subs
+}
+
+// substitution of a kind, by the root and suffix of the substitute, spelled with some symbol, and an explanation following its name, e.g. ", the relative minor of C"
+func substitution(kind Kind, root note.Class, adj note.AdjSymbol, suffix string, format string, args ...interface{}) Substitution {
+	name := root.String(adj) + suffix
+	return Substitution{Kind: kind, Name: name, Chord: chord.Of(name), Explanation: name + fmt.Sprintf(format, args...)}
+}
+
+// respelled substitution, its name and explanation with the root of the substitute spelled with another symbol
+func respelled(s Substitution, adj note.AdjSymbol) Substitution {
+	name := s.Chord.Root.String(adj) + strings.TrimPrefix(s.Name, s.Chord.Root.String(s.Chord.AdjSymbol))
+	s.Explanation = name + strings.TrimPrefix(s.Explanation, s.Name)
+	s.Name, s.Chord = name, chord.Of(name)
+	return s
+}
+
+// sharedOf a chord and a substitute of some root and suffix, the tones they have in common, in order of the chord, e.g. "B and F"
+func sharedOf(c chord.Chord, suffix string, root note.Class, adj note.AdjSymbol) string {
+	sub := chord.Of(root.String(adj) + suffix)
+	var names []string
+	for _, i := range []chord.Interval{chord.I1, chord.I3, chord.I5, chord.I7} {
+		class, ok := c.ClassAt(i)
+		if ok && class != note.Nil && sub.Contains(class) {
+			names = append(names, class.String(adj))
+		}
+	}
+	switch len(names) {
+	case 0:
+		return "none of its tones"
+	case 1:
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+// semitonesOf the tone of a chord at an interval above its root, or -1 if it has none
+func semitonesOf(c chord.Chord, i chord.Interval) int {
+	class, ok := c.ClassAt(i)
+	if !ok || class == note.Nil {
+		return -1
+	}
+	return (c.Root.Diff(class) + 12) % 12
+}
+
+// seventhOf a chord of a third, the seventh of the same kind as another chord's, e.g. a minor seventh for a major seventh, or none for none
+func seventhOf(third, seventh int) int {
+	switch {
+	case seventh < 0:
+		return -1
+	case third == 3:
+		return 10
+	}
+	return 11
+}
+
+// suffixOf a chord name, by the semitones up from its root to its third, fifth and seventh, or -1 for any it doesn't have, e.g. "m7"
+func suffixOf(third, fifth, seventh int) string {
+	switch {
+	case third == 4 && seventh == 10:
+		return "7"
+	case third == 4 && seventh == 11:
+		return "maj7"
+	case third == 3 && seventh == 10:
+		return "m7"
+	case third == 3 && fifth == 6:
+		return "dim"
+	case third == 3:
+		return "m"
+	}
+	return ""
+}
+
+// inKey if every tone of a chord is in the major or natural minor scale of a key
+func inKey(c chord.Chord, k key.Key) bool {
+	mode := " major"
+	if k.Mode == key.Minor {
+		mode = " minor"
+	}
+	s := scale.Of(k.Root.String(k.AdjSymbol) + mode)
+	for _, t := range c.Tones {
+		if t.Class != note.Nil && !s.Contains(t.Class) {
+			return false
+		}
+	}
+	return true
+}
+
+// adjSymbolOf a key, flats if its signature has flats, else sharps
+func adjSymbolOf(k key.Key) note.AdjSymbol {
+	if k.Fifths() < 0 {
+		return note.Flat
+	}
+	return note.Sharp
+}
+
+// step from a pitch class up some semitones
+func step(class note.Class, semitones int) note.Class {
+	stepped, _ := class.Step(semitones)
+	return stepped
+}
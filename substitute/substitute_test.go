@@ -0,0 +1,109 @@
+// A chord substitution replaces a chord of a progression with another that shares its tones or its function, e.g. the tritone substitution of a dominant seventh chord.
+package substitute
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestFor_Dominant(t *testing.T) {
+	subs := For(chord.Of("G7"), key.Of("C"))
+	assert.Equal(t, []string{"Db7", "Bb7", "F#dim7"}, namesOf(subs))
+	assert.Equal(t, []Kind{Tritone, Backdoor, Passing}, kindsOf(subs))
+	assert.Equal(t, "Db7 shares the tritone of G7, B and F, and resolves down a semitone to C, as G7 resolves down a fifth", subs[0].Explanation)
+	assert.Equal(t, "Bb7, the bVII7 of C, resolves up a whole step to C in place of G7, sharing D and F", subs[1].Explanation)
+	assert.Equal(t, chord.Of("Db7"), subs[0].Chord)
+}
+
+func TestFor_Major(t *testing.T) {
+	subs := For(chord.Of("C"), key.Of("C"))
+	assert.Equal(t, []string{"Am", "Em", "Cm", "Bdim7"}, namesOf(subs))
+	assert.Equal(t, []Kind{Relative, Relative, Parallel, Passing}, kindsOf(subs))
+	assert.Equal(t, "Am, the relative minor of C, shares C and E", subs[0].Explanation)
+	assert.Equal(t, "Em, a third above C, shares E and G", subs[1].Explanation)
+	assert.Equal(t, "Cm, borrowed from the parallel minor of C, shares its root and fifth", subs[2].Explanation)
+}
+
+func TestFor_Minor(t *testing.T) {
+	subs := For(chord.Of("Am7"), key.Of("C"))
+	assert.Equal(t, []string{"Cmaj7", "Fmaj7", "Amaj7", "G#dim7"}, namesOf(subs))
+	assert.Equal(t, "Fmaj7, a third below Am7, shares A, C and E", subs[1].Explanation)
+}
+
+func TestFor_RelativeInKey(t *testing.T) {
+	assert.Equal(t, []string{"F", "D", "C#dim7"}, namesOf(For(chord.Of("Dm"), key.Of("C")))) // not Bb, which isn't in C major
+	assert.Equal(t, []string{"Dm", "Am", "Fm", "Edim7"}, namesOf(For(chord.Of("F"), key.Of("F"))))
+	assert.Equal(t, []string{"Eb", "C", "Bdim7"}, namesOf(For(chord.Of("Cm"), key.Of("G minor")))) // not Ab, which isn't in G minor
+	assert.Equal(t, []string{"Dm", "C#dim7"}, namesOf(For(chord.Of("D"), key.Of("G minor"))))
+}
+
+func TestFor_NoKey(t *testing.T) {
+	assert.Equal(t, []string{"Am7", "Cm7", "Bdim7"}, namesOf(For(chord.Of("Cmaj7"), key.Key{})))
+}
+
+func TestFor_Other(t *testing.T) {
+	assert.Equal(t, []string{"A#dim7"}, namesOf(For(chord.Of("Bdim"), key.Of("C"))))
+	assert.Equal(t, 0, len(For(chord.Of("Csus4"), key.Of("C"))))
+	assert.Equal(t, 0, len(For(chord.Chord{}, key.Of("C"))))
+}
+
+func TestNameOf(t *testing.T) {
+	assert.Equal(t, "G7", NameOf(chord.Of("G7")))
+	assert.Equal(t, "Bbmaj7", NameOf(chord.Of("Bbmaj7")))
+	assert.Equal(t, "Ebm", NameOf(chord.Of("Ebm")))
+	assert.Equal(t, "Bdim", NameOf(chord.Of("Bdim")))
+	assert.Equal(t, "C", NameOf(chord.Of("Csus4")))
+}
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "tritone", Tritone.String())
+	assert.Equal(t, "backdoor dominant", Backdoor.String())
+	assert.Equal(t, "relative", Relative.String())
+	assert.Equal(t, "parallel", Parallel.String())
+	assert.Equal(t, "passing diminished", Passing.String())
+	assert.Equal(t, "", Kind(5).String())
+}
+
+func TestSubstitution_String(t *testing.T) {
+	assert.Equal(t, "relative: Am, the relative minor of C, shares C and E", For(chord.Of("C"), key.Of("C"))[0].String())
+}
+
+//
+// Private
+//
+
+func TestSuffixOf(t *testing.T) {
+	assert.Equal(t, "", suffixOf(4, 7, -1))
+	assert.Equal(t, "m", suffixOf(3, 7, -1))
+	assert.Equal(t, "dim", suffixOf(3, 6, -1))
+	assert.Equal(t, "7", suffixOf(4, 7, 10))
+	assert.Equal(t, "maj7", suffixOf(4, 7, 11))
+	assert.Equal(t, "m7", suffixOf(3, 7, 10))
+}
+
+func TestSharedOf(t *testing.T) {
+	assert.Equal(t, "B and F", sharedOf(chord.Of("G7"), "7", note.Cs, note.Flat))
+	assert.Equal(t, "C, E and G", sharedOf(chord.Of("Cmaj7"), "m7", note.A, note.Sharp))
+	assert.Equal(t, "none of its tones", sharedOf(chord.Of("C"), "", note.Fs, note.Sharp))
+}
+
+func namesOf(subs []Substitution) []string {
+	var names []string
+	for _, s := range subs {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func kindsOf(subs []Substitution) []Kind {
+	var kinds []Kind
+	for _, s := range subs {
+		kinds = append(kinds, s.Kind)
+	}
+	return kinds
+}
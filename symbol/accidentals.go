@@ -0,0 +1,88 @@
+// Accidentals are written in ASCII by default, e.g. "Bb7", for the terminals and legacy systems that can't show Unicode, or as the glyphs of Unicode, e.g. "B♭7"
+package symbol
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Accidentals of the notes written, in ASCII or Unicode, or if empty, in ASCII
+type Accidentals string
+
+// Accidentals written
+const (
+	ASCII   Accidentals = "ascii"   // # and b, e.g. C# and Bb
+	Unicode Accidentals = "unicode" // ♯ and ♭, e.g. C♯ and B♭
+)
+
+// AccidentalsNames of the ways to write accidentals, e.g. for the usage of a flag
+var AccidentalsNames = []string{string(ASCII), string(Unicode)}
+
+// ErrUnknownAccidentals when parsing a way to write accidentals that doesn't exist, e.g. "emoji"
+var ErrUnknownAccidentals = errors.New("unknown accidentals")
+
+// ParseAccidentals of a name, e.g. "unicode", or of an empty name, in ASCII
+func ParseAccidentals(name string) (Accidentals, error) {
+	a := Accidentals(strings.ToLower(strings.TrimSpace(name)))
+	switch a {
+	case "", ASCII:
+		return ASCII, nil
+	case Unicode:
+		return Unicode, nil
+	}
+	return "", fmt.Errorf("%w %q, expected one of %s", ErrUnknownAccidentals, name, strings.Join(AccidentalsNames, ", "))
+}
+
+// Of some text written in ASCII, e.g. rendered as YAML, JSON or a table, its accidentals written this way, leaving the rest of it as it is,
+// e.g. "C♯m7♭5" of "C#m7b5" in Unicode: every # or b after a note letter beginning a word, e.g. "Bb", "C#" or "Ebb",
+// or before a number, not after a letter, e.g. "b5", "bb7" or "#11", but not e.g. the b of "bass" or the # of "sus#4"
+func (a Accidentals) Of(text string) string {
+	if a != Unicode || !strings.ContainsAny(text, "#b") {
+		return text
+	}
+	var b strings.Builder
+	b.Grow(len(text) + 2*strings.Count(text, "b") + 2*strings.Count(text, "#"))
+	for i := 0; i < len(text); {
+		j := i
+		for j < len(text) && (text[j] == '#' || text[j] == 'b') {
+			j++
+		}
+		if j == i {
+			b.WriteByte(text[i])
+			i++
+			continue
+		}
+		if isNoteLetterAt(text, i-1) || !isLetterAt(text, i-1) && j < len(text) && '0' <= text[j] && text[j] <= '9' {
+			for _, c := range text[i:j] {
+				if c == '#' {
+					b.WriteString("♯")
+				} else {
+					b.WriteString("♭")
+				}
+			}
+		} else {
+			b.WriteString(text[i:j])
+		}
+		i = j
+	}
+	return b.String()
+}
+
+//
+// Private
+//
+
+// isNoteLetterAt a position of some text, a letter from A to G beginning a word
+func isNoteLetterAt(text string, i int) bool {
+	return i >= 0 && 'A' <= text[i] && text[i] <= 'G' && !isLetterAt(text, i-1)
+}
+
+// isLetterAt a position of some text, a letter of ASCII in either case
+func isLetterAt(text string, i int) bool {
+	if i < 0 || i >= len(text) {
+		return false
+	}
+	c := text[i]
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
@@ -0,0 +1,50 @@
+// Accidentals are written in ASCII by default, e.g. "Bb7", for the terminals and legacy systems that can't show Unicode, or as the glyphs of Unicode, e.g. "B♭7"
+package symbol
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParseAccidentals(t *testing.T) {
+	for name, accidentals := range map[string]Accidentals{
+		"":          ASCII,
+		"ascii":     ASCII,
+		"unicode":   Unicode,
+		" Unicode ": Unicode,
+	} {
+		a, err := ParseAccidentals(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, accidentals, a, name)
+	}
+	_, err := ParseAccidentals("emoji")
+	assert.True(t, errors.Is(err, ErrUnknownAccidentals))
+	assert.Equal(t, `unknown accidentals "emoji", expected one of ascii, unicode`, err.Error())
+}
+
+func TestAccidentals_Of(t *testing.T) {
+	for text, unicode := range map[string]string{
+		"":                         "",
+		"C":                        "C",
+		"Bb7":                      "B♭7",
+		"C#m7b5":                   "C♯m7♭5",
+		"Ebb F##":                  "E♭♭ F♯♯",
+		"bb7 #11 b9":               "♭♭7 ♯11 ♭9",
+		`{"3":"Eb","b7":"Bb"}`:     `{"3":"E♭","♭7":"B♭"}`,
+		"root: Db\nbass: F#\n":     "root: D♭\nbass: F♯\n",
+		"Csus#4 bass Cdim7b9":      "Csus#4 bass Cdim7♭9",
+		"| Gb  | m3  |\n| A#  |\n": "| G♭  | m3  |\n| A♯  |\n",
+	} {
+		assert.Equal(t, unicode, Unicode.Of(text), text)
+		assert.Equal(t, text, ASCII.Of(text), text)
+		assert.Equal(t, text, Accidentals("").Of(text), text)
+	}
+}
+
+func TestAccidentals_Of_Normalized(t *testing.T) {
+	for _, text := range []string{"Bb7", "C#m7b5", "F##4", "Ebb major"} {
+		assert.Equal(t, text, Normalize(Unicode.Of(text)), text)
+	}
+}
@@ -0,0 +1,93 @@
+// The symbols of music in Unicode are normalized to the ASCII understood by every parser of chords, scales, keys, notes and pitches,
+// so each needn't handle them all again, e.g. "Bb7" of "B♭⁷", "F##4" of "F𝄪₄" or "Cm7-5" of "Cm7–5".
+//
+// https://en.wikipedia.org/wiki/Musical_Symbols_(Unicode_block)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package symbol
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Normalize the symbols of some text to ASCII, each of the flat, sharp, double flat, double sharp and natural, superscript and subscript digits, and dashes, leaving the rest of it as it is, e.g. "Bb7" of "B♭⁷",
+// without allocating if the text is already ASCII, e.g. in a real-time MIDI processor
+func Normalize(text string) string {
+	if isASCII(text) {
+		return text
+	}
+	var b strings.Builder
+	b.Grow(len(text))
+	for i := 0; i < len(text); {
+		r, width := utf8.DecodeRuneInString(text[i:])
+		if replacement, ok := replacements[r]; ok {
+			b.WriteString(replacement)
+		} else {
+			b.WriteString(text[i : i+width]) // as it is, even if it isn't valid UTF-8
+		}
+		i += width
+	}
+	return b.String()
+}
+
+// Position in some text of the byte at a position in its normalization, e.g. to report a parse error where it was in the text as written,
+// the position of the symbol it was normalized from, or else the length of the text if it's after the end of the normalization
+func Position(text string, normalized int) int {
+	if isASCII(text) {
+		return normalized
+	}
+	at := 0
+	for i := 0; i < len(text); {
+		r, width := utf8.DecodeRuneInString(text[i:])
+		size := width
+		if replacement, ok := replacements[r]; ok {
+			size = len(replacement)
+		}
+		if at+size > normalized {
+			return i
+		}
+		at += size
+		i += width
+	}
+	return len(text)
+}
+
+// Unnormalized span of some text, from a position in its normalization to another, the position and text of it as written,
+// e.g. to report a parse error of the text where it was, as it was, e.g. 6 and "♯" of 6 to 7 of "B♭maj7♯11"
+func Unnormalized(text string, from, to int) (int, string) {
+	i, j := Position(text, from), Position(text, to)
+	return i, text[i:j]
+}
+
+//
+// Private
+//
+
+// replacements of each symbol normalized, by the ASCII it's normalized to:
+// the flat, sharp, double flat and double sharp, and the natural, which is dropped, as every parser reads a letter without an accidental as natural,
+// the superscript and subscript digits, signs and minus, e.g. of a chord "C⁷" or a pitch "C₄", and the typographic dashes and minus sign
+var replacements = map[rune]string{
+	'♭': "b", '♯': "#", '＃': "#", '𝄫': "bb", '𝄪': "##", '♮': "",
+	'⁰': "0", '¹': "1", '²': "2", '³': "3", '⁴': "4", '⁵': "5", '⁶': "6", '⁷': "7", '⁸': "8", '⁹': "9", '⁺': "+", '⁻': "-",
+	'₀': "0", '₁': "1", '₂': "2", '₃': "3", '₄': "4", '₅': "5", '₆': "6", '₇': "7", '₈': "8", '₉': "9", '₊': "+", '₋': "-",
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-", '﹣': "-", '－': "-",
+}
+
+// isASCII if every byte of some text is, so there's nothing to normalize
+func isASCII(text string) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+// The symbols of music in Unicode are normalized to the ASCII understood by every parser of chords, scales, keys, notes and pitches,
+// so each needn't handle them all again, e.g. "Bb7" of "B♭⁷", "F##4" of "F𝄪₄" or "Cm7-5" of "Cm7–5".
+package symbol
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	for text, normalized := range map[string]string{
+		"":           "",
+		"Cm7":        "Cm7",
+		"B♭⁷":        "Bb7",
+		"F𝄪₄":        "F##4",
+		"E𝄫":         "Ebb",
+		"C♮4":        "C4",
+		"C＃m":        "C#m",
+		"Cm7–5":      "Cm7-5",
+		"Cm⁷♭⁵":      "Cm7b5",
+		"C⁺":         "C+",
+		"A₋₁":        "A-1",
+		"C°7 Cø Δ":   "C°7 Cø Δ",
+		"E𝄳4":        "E𝄳4",
+		"C\xffm":     "C\xffm",
+		"D♭ major":   "Db major",
+		"G—A‐B‑C‒D―": "G-A-B-C-D-",
+		"F−G﹣A－":     "F-G-A-",
+	} {
+		assert.Equal(t, normalized, Normalize(text), text)
+	}
+}
+
+func TestNormalize_EverySymbol(t *testing.T) {
+	for r, replacement := range replacements {
+		text := "C" + string(r) + "7"
+		normalized := Normalize(text)
+		assert.Equal(t, "C"+replacement+"7", normalized, text)
+		for i := 0; i < len(normalized); i++ {
+			assert.True(t, normalized[i] < utf8.RuneSelf, text)
+		}
+		if len(replacement) > 0 {
+			position, unnormalized := Unnormalized(text, 1, 1+len(replacement))
+			assert.Equal(t, 1, position, text)
+			assert.Equal(t, string(r), unnormalized, text)
+		}
+		assert.Equal(t, len(text)-1, Position(text, len(normalized)-1), text)
+	}
+}
+
+func TestNormalize_AllocationBudget(t *testing.T) {
+	assert.Equal(t, 0.0, testing.AllocsPerRun(100, func() { Normalize("Bbmaj7#11") }))
+}
+
+func TestPosition(t *testing.T) {
+	assert.Equal(t, 3, Position("Cm7b5", 3))
+	assert.Equal(t, 1, Position("B♭m7", 1))
+	assert.Equal(t, 4, Position("B♭m7", 2))
+	assert.Equal(t, 5, Position("B♭m7", 3))
+	assert.Equal(t, 1, Position("F𝄪₄", 2)) // the second # of the double sharp
+	assert.Equal(t, 5, Position("F𝄪₄", 3))
+	assert.Equal(t, 8, Position("F𝄪₄", 4))
+	assert.Equal(t, 8, Position("F𝄪₄", 99))
+}
+
+func TestUnnormalized(t *testing.T) {
+	position, text := Unnormalized("B♭maj7♯11", 6, 7)
+	assert.Equal(t, 8, position)
+	assert.Equal(t, "♯", text)
+	position, text = Unnormalized("B♭maj7♯11", 6, 9)
+	assert.Equal(t, 8, position)
+	assert.Equal(t, "♯11", text)
+	position, text = Unnormalized("Cm7 jams", 4, 8)
+	assert.Equal(t, 4, position)
+	assert.Equal(t, "jams", text)
+}
@@ -0,0 +1,109 @@
+// A tone set is a set of pitch classes, regardless of their octave or spelling, e.g. the tones of a chord or scale.
+//
+// Because a pitch class is the same whether it's spelled A# or Bb, membership and set operations are enharmonically aware.
+//
+// https://en.wikipedia.org/wiki/Set_theory_(music)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package toneset
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Set of pitch classes
+type Set map[note.Class]bool
+
+// Of some pitch classes, e.g. Of(note.C, note.E, note.G), ignoring the Nil class
+func Of(classes ...note.Class) Set {
+	s := make(Set)
+	for _, class := range classes {
+		if class != note.Nil {
+			s[class] = true
+		}
+	}
+	return s
+}
+
+// Contains a pitch class
+func (s Set) Contains(class note.Class) bool {
+	return s[class]
+}
+
+// ContainsAll of the pitch classes of another set
+func (s Set) ContainsAll(other Set) bool {
+	for class := range other {
+		if !s[class] {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal if both sets have the same pitch classes
+func (s Set) Equal(other Set) bool {
+	return len(s) == len(other) && s.ContainsAll(other)
+}
+
+// Intersection of this set and another, the pitch classes in both
+func (s Set) Intersection(other Set) Set {
+	r := make(Set)
+	for class := range s {
+		if other[class] {
+			r[class] = true
+		}
+	}
+	return r
+}
+
+// Union of this set and another, the pitch classes in either
+func (s Set) Union(other Set) Set {
+	r := make(Set)
+	for class := range s {
+		r[class] = true
+	}
+	for class := range other {
+		r[class] = true
+	}
+	return r
+}
+
+// Difference of this set and another, the pitch classes in this set that aren't in the other
+func (s Set) Difference(other Set) Set {
+	r := make(Set)
+	for class := range s {
+		if !other[class] {
+			r[class] = true
+		}
+	}
+	return r
+}
+
+// Similarity of this set and another, the share of the pitch classes in either that are in both, from 0 of none to 1 of the same set
+func (s Set) Similarity(other Set) float64 {
+	union := len(s.Union(other))
+	if union == 0 {
+		return 1
+	}
+	return float64(len(s.Intersection(other))) / float64(union)
+}
+
+// Classes in the set, in ascending order from C
+func (s Set) Classes() []note.Class {
+	classes := make([]note.Class, 0, len(s))
+	for class := range s {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i] < classes[j] })
+	return classes
+}
@@ -0,0 +1,65 @@
+// A tone set is a set of pitch classes, regardless of their octave or spelling, e.g. the tones of a chord or scale.
+package toneset
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestOf(t *testing.T) {
+	assert.Equal(t, Set{note.C: true, note.E: true}, Of(note.C, note.E, note.C, note.Nil))
+	assert.Equal(t, Set{}, Of())
+}
+
+func TestContains(t *testing.T) {
+	s := Of(note.C, note.Ds, note.G)
+	assert.True(t, s.Contains(note.Ds))
+	eb, _ := note.ClassNamed("Eb")
+	dSharp, _ := note.ClassNamed("D#")
+	assert.True(t, s.Contains(eb))
+	assert.True(t, s.Contains(dSharp))
+	assert.False(t, s.Contains(note.E))
+	assert.False(t, s.Contains(note.Nil))
+}
+
+func TestContainsAll(t *testing.T) {
+	s := Of(note.C, note.D, note.E, note.F, note.G, note.A, note.B)
+	assert.True(t, s.ContainsAll(Of(note.C, note.E, note.G)))
+	assert.True(t, s.ContainsAll(Of()))
+	assert.False(t, s.ContainsAll(Of(note.C, note.Ds, note.G)))
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, Of(note.C, note.E, note.G).Equal(Of(note.G, note.E, note.C)))
+	assert.True(t, Of().Equal(Of()))
+	assert.False(t, Of(note.C, note.E, note.G).Equal(Of(note.C, note.E)))
+	assert.False(t, Of(note.C, note.E).Equal(Of(note.C, note.E, note.G)))
+}
+
+func TestIntersection(t *testing.T) {
+	assert.Equal(t, Of(note.G), Of(note.C, note.E, note.G).Intersection(Of(note.G, note.B, note.D)))
+}
+
+func TestUnion(t *testing.T) {
+	assert.Equal(t, Of(note.C, note.D, note.E, note.G, note.B), Of(note.C, note.E, note.G).Union(Of(note.G, note.B, note.D)))
+}
+
+func TestDifference(t *testing.T) {
+	assert.Equal(t, Of(note.C, note.E), Of(note.C, note.E, note.G).Difference(Of(note.G, note.B, note.D)))
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 0.2, Of(note.C, note.E, note.G).Similarity(Of(note.G, note.B, note.D)))
+	assert.Equal(t, 0.75, Of(note.C, note.E, note.G, note.As).Similarity(Of(note.C, note.E, note.G)))
+	assert.Equal(t, 1.0, Of(note.C).Similarity(Of(note.C)))
+	assert.Equal(t, 0.0, Of(note.C).Similarity(Of(note.D)))
+	assert.Equal(t, 1.0, Of().Similarity(Of()))
+}
+
+func TestClasses(t *testing.T) {
+	assert.Equal(t, []note.Class{note.C, note.E, note.G, note.B}, Of(note.B, note.G, note.E, note.C).Classes())
+	assert.Equal(t, []note.Class{}, Of().Classes())
+}
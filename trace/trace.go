@@ -0,0 +1,113 @@
+// Tracing hooks are how an integrator debugs the decisions of an analysis in production, without forking,
+// e.g. which form of chord matched a name as it was parsed, or the score computed of each candidate of a detection.
+// No hook is set by default, and until one is, tracing costs only the check of whether it's enabled, allocating nothing.
+//
+// https://en.wikipedia.org/wiki/Tracing_(software)
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package trace
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-music-theory/music-theory/candidate"
+)
+
+// Event of a decision of an analysis, e.g. that the form Major Seventh of chord matched "Cmaj7", or that C Major scored 12 of a progression
+type Event struct {
+	Scope string  // of the package and the operation, e.g. "chord.parse" or "progression.key"
+	Input string  // analyzed, e.g. "Cmaj7" or "Dm7 G7 C"
+	Rule  string  // that matched, or the candidate that was scored, e.g. "Major Seventh" or "C Major"
+	Score float64 // computed of a candidate, or 0 of a rule that matched
+}
+
+// String of the event, its scope, input and rule, and any score, e.g. "progression.key Dm7 G7 C: C Major 12"
+func (e Event) String() string {
+	if e.Score == 0 {
+		return fmt.Sprintf("%s %s: %s", e.Scope, e.Input, e.Rule)
+	}
+	return fmt.Sprintf("%s %s: %s %g", e.Scope, e.Input, e.Rule, e.Score)
+}
+
+// Hook of the events traced, called synchronously by whatever goroutine is analyzing, so it must be safe for concurrent use
+type Hook interface {
+	Trace(e Event)
+}
+
+// HookFunc of each event traced, as a Hook
+type HookFunc func(e Event)
+
+// Trace an event, calling the func
+func (f HookFunc) Trace(e Event) {
+	f(e)
+}
+
+// Logger hook, printing a line of each event traced to a logger, e.g. trace.Set(trace.Logger(log.New(os.Stderr, "trace: ", 0)))
+func Logger(l *log.Logger) Hook {
+	return HookFunc(func(e Event) { l.Println(e.String()) })
+}
+
+// Set the hook of every event traced, or of nil to trace nothing, returning the hook it replaces, or nil if there was none,
+// e.g. once at startup, or around a test
+func Set(h Hook) Hook {
+	mutex.Lock()
+	defer mutex.Unlock()
+	previous := current
+	current = h
+	if h == nil {
+		atomic.StoreInt32(&enabled, 0)
+	} else {
+		atomic.StoreInt32(&enabled, 1)
+	}
+	return previous
+}
+
+// Enabled tracing, whether a hook is set, e.g. to check before building an event, so no event is built unless it's traced
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// Emit an event to the hook, if one is set
+func Emit(e Event) {
+	if !Enabled() {
+		return
+	}
+	mutex.RLock()
+	h := current
+	mutex.RUnlock()
+	if h != nil {
+		h.Trace(e)
+	}
+}
+
+// Ranked candidates of a detection, an event of each, of the rule of its value and its score, if a hook is set,
+// e.g. trace.Ranked("progression.key", "Dm7 G7 C", keys, key.Key.Canonical)
+func Ranked[T any](scope, input string, candidates []candidate.Candidate[T], rule func(T) string) {
+	if !Enabled() {
+		return
+	}
+	for _, c := range candidates {
+		Emit(Event{Scope: scope, Input: input, Rule: rule(c.Value), Score: c.Score})
+	}
+}
+
+//
+// Private
+//
+
+var (
+	mutex   sync.RWMutex
+	current Hook
+	enabled int32
+)
@@ -0,0 +1,68 @@
+// Tracing hooks are how an integrator debugs the decisions of an analysis in production, without forking,
+package trace
+
+import (
+	"bytes"
+	"log"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/candidate"
+)
+
+func TestEvent_String(t *testing.T) {
+	assert.Equal(t, "chord.parse Cmaj7: Major Seventh", Event{Scope: "chord.parse", Input: "Cmaj7", Rule: "Major Seventh"}.String())
+	assert.Equal(t, "progression.key Dm7 G7 C: C Major 12", Event{Scope: "progression.key", Input: "Dm7 G7 C", Rule: "C Major", Score: 12}.String())
+}
+
+func TestSet(t *testing.T) {
+	assert.False(t, Enabled())
+	var events []Event
+	assert.Nil(t, Set(recorderOf(&events)))
+	assert.True(t, Enabled())
+	Emit(Event{Scope: "test", Input: "in", Rule: "rule"})
+	assert.Equal(t, []Event{{Scope: "test", Input: "in", Rule: "rule"}}, events)
+
+	assert.NotNil(t, Set(nil))
+	assert.False(t, Enabled())
+	Emit(Event{Scope: "test", Input: "in", Rule: "ignored"})
+	assert.Equal(t, 1, len(events))
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	previous := Set(Logger(log.New(&buf, "trace: ", 0)))
+	defer Set(previous)
+	Emit(Event{Scope: "key.parse", Input: "Eb minor", Rule: "Minor"})
+	assert.Equal(t, "trace: key.parse Eb minor: Minor\n", buf.String())
+}
+
+func TestRanked(t *testing.T) {
+	candidates := candidate.Rank([]candidate.Candidate[string]{candidate.Of("b", 1), candidate.Of("a", 3)})
+	var events []Event
+	Ranked("test", "in", candidates, func(s string) string { return s }) // no hook, no events
+	previous := Set(recorderOf(&events))
+	defer Set(previous)
+	Ranked("test", "in", candidates, func(s string) string { return s })
+	assert.Equal(t, []Event{
+		{Scope: "test", Input: "in", Rule: "a", Score: 3},
+		{Scope: "test", Input: "in", Rule: "b", Score: 1},
+	}, events)
+}
+
+func TestEmit_NoHook(t *testing.T) {
+	assert.Equal(t, float64(0), testing.AllocsPerRun(100, func() {
+		if Enabled() {
+			Emit(Event{Scope: "test"})
+		}
+	}))
+}
+
+//
+// Private
+//
+
+func recorderOf(events *[]Event) Hook {
+	return HookFunc(func(e Event) { *events = append(*events, e) })
+}
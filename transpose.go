@@ -0,0 +1,27 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"io"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/pipeline"
+)
+
+// transposeSong from a file at a path some semitones, first detecting its key from its chords or melody if asked, and simplifying its chords to a level, if named,
+// rendered in a format, e.g. YAML of the song, or MusicXML of its melody or progression
+func transposeSong(w io.Writer, path string, semitones int, detectKey bool, simplify string, format string) error {
+	level, err := chord.SimplifyLevelOf(simplify)
+	if err != nil {
+		return err
+	}
+	s, err := readSongFile(path)
+	if err != nil {
+		return err
+	}
+	p := pipeline.New().From(s)
+	if detectKey {
+		p.DetectKey()
+	}
+	return p.Simplify(level).Transpose(semitones).Render(w, format)
+}
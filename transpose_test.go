@@ -0,0 +1,61 @@
+// Package main implements a command-line utility for music
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/render"
+)
+
+func TestTransposeSong(t *testing.T) {
+	dir, err := ioutil.TempDir("", "transpose")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := writeSongFile(t, dir)
+	var buf bytes.Buffer
+	assert.Nil(t, transposeSong(&buf, path, -2, false, "", render.YAML))
+	assert.Contains(t, buf.String(), "key: F major")
+	assert.Contains(t, buf.String(), "Gm7 C7")
+	buf.Reset()
+	assert.Nil(t, transposeSong(&buf, path, 0, true, "", render.YAML))
+	assert.Contains(t, buf.String(), "key: E minor", "detected from its chords")
+	assert.True(t, errors.Is(transposeSong(&buf, path, 2, false, "", "tab"), render.ErrUnknownFormat))
+	assert.NotNil(t, transposeSong(&buf, filepath.Join(dir, "missing.yaml"), 2, false, "", render.YAML))
+}
+
+func TestTransposeSong_Simplify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "transpose")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := writeSongFile(t, dir)
+	var buf bytes.Buffer
+	assert.Nil(t, transposeSong(&buf, path, -2, false, "triads", render.YAML))
+	assert.Contains(t, buf.String(), "key: F major")
+	assert.Contains(t, buf.String(), "bars: [Gm C, F]")
+	assert.Contains(t, buf.String(), "bars: [Bb]")
+	buf.Reset()
+	assert.Nil(t, transposeSong(&buf, path, 0, false, "sevenths", render.LeadSheet))
+	assert.Contains(t, buf.String(), "| Am7 D7 | GM7   |")
+	assert.Contains(t, buf.String(), "| ii7 V7 | Imaj7 |")
+	assert.True(t, errors.Is(transposeSong(&buf, path, 0, false, "beginner", render.YAML), chord.ErrUnknownSimplifyLevel))
+}
+
+func TestTransposeExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "transpose")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := writeSongFile(t, dir)
+	assertExitCode(t, 0, "", "transpose")
+	assertExitCode(t, 0, "", "transpose", "--semitones", "-2", path)
+	assertExitCode(t, 0, "", "transpose", "-s", "3", "--detect-key", "--format", "musicxml", path)
+	assertExitCode(t, 0, "", "transpose", "--simplify", "triads", "--format", "leadsheet", path)
+	assertExitCode(t, 1, "Error occurred: unknown format \"tab\"\n", "transpose", "--format", "tab", path)
+}
@@ -0,0 +1,143 @@
+// +build js,wasm
+
+// Wasm exposes the music theory models to JavaScript, so that browser-based apps can use the exact same logic as the command-line utility.
+//
+// Build it with `make wasm`, then load `music-theory.js` alongside `wasm_exec.js` from the Go distribution:
+//
+//     const theory = await MusicTheory.load("music-theory.wasm");
+//     theory.chord("Cm7");        // {root: "C", tones: {1: "C", 3: "Eb", 5: "G", 7: "Bb"}}
+//     theory.scale("C minor");
+//     theory.key("Db");
+//     theory.pitch("A4", 432);    // {note: "A4", tuning: 432, pitch: "432.00Hz"}
+//     theory.pitch("C4", "baroque", 3); // {note: "C4", tuning: 415, pitch: "246.760Hz"}
+//
+// Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+//
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"syscall/js"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/pitch"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func main() {
+	js.Global().Set("musicTheory", exports())
+	// keep running, so that the exported functions remain callable
+	select {}
+}
+
+//
+// Private
+//
+
+// exports of functions each returning a JSON string, to be parsed by the JavaScript wrapper
+func exports() js.Value {
+	return js.ValueOf(map[string]interface{}{
+		"chord": js.FuncOf(chordOf),
+		"scale": js.FuncOf(scaleOf),
+		"key":   js.FuncOf(keyOf),
+		"pitch": js.FuncOf(pitchOf),
+	})
+}
+
+func chordOf(this js.Value, args []js.Value) interface{} {
+	v, err := chord.Parse(stringArg(args, 0))
+	if err != nil {
+		return marshalJSON(specError{Error: err.Error()})
+	}
+	return v.ToJSON()
+}
+
+func scaleOf(this js.Value, args []js.Value) interface{} {
+	v, err := scale.Parse(stringArg(args, 0))
+	if err != nil {
+		return marshalJSON(specError{Error: err.Error()})
+	}
+	return v.ToJSON()
+}
+
+func keyOf(this js.Value, args []js.Value) interface{} {
+	v, err := key.Parse(stringArg(args, 0))
+	if err != nil {
+		return marshalJSON(specError{Error: err.Error()})
+	}
+	return v.ToJSON()
+}
+
+func pitchOf(this js.Value, args []js.Value) interface{} {
+	name := stringArg(args, 0)
+	tuning, err := tuningArg(args, 1)
+	if err != nil {
+		return marshalJSON(specError{Error: err.Error()})
+	}
+	precision, err := intArg(args, 2, pitch.DefaultPrecision, 0, pitch.MaxPrecision)
+	if err != nil {
+		return marshalJSON(specError{Error: err.Error()})
+	}
+	p, err := pitch.OfNoteWithPrecision(name, tuning, precision)
+	if err != nil {
+		return marshalJSON(specError{Error: err.Error()})
+	}
+	return marshalJSON(specPitch{Note: name, Tuning: tuning, Pitch: p})
+}
+
+// stringArg at an index, or an empty string if it's missing
+func stringArg(args []js.Value, i int) string {
+	if len(args) <= i || args[i].Type() != js.TypeString {
+		return ""
+	}
+	return args[i].String()
+}
+
+// tuningArg at an index, either a number of Hz or the name of a preset, or standard tuning if it's missing
+func tuningArg(args []js.Value, i int) (float64, error) {
+	if len(args) <= i {
+		return pitch.Standard, nil
+	}
+	switch args[i].Type() {
+	case js.TypeNumber:
+		return args[i].Float(), nil
+	case js.TypeString:
+		return pitch.TuningOf(args[i].String())
+	}
+	return pitch.Standard, nil
+}
+
+// intArg at an index, from a minimum to a maximum, or a default value if it's missing
+func intArg(args []js.Value, i int, def, min, max int) (int, error) {
+	if len(args) <= i || args[i].Type() != js.TypeNumber {
+		return def, nil
+	}
+	if v := args[i].Float(); !(v >= float64(min) && v <= float64(max)) {
+		return 0, fmt.Errorf("out of range %v, expected %d to %d", v, min, max)
+	}
+	return args[i].Int(), nil
+}
+
+func marshalJSON(v interface{}) string {
+	out, _ := json.Marshal(v)
+	return string(out[:])
+}
+
+type specPitch struct {
+	Note   string  `json:"note"`
+	Tuning float64 `json:"tuning"`
+	Pitch  string  `json:"pitch"`
+}
+
+type specError struct {
+	Error string `json:"error"`
+}
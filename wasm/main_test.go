@@ -0,0 +1,44 @@
+// +build js,wasm
+
+// Wasm exposes the music theory models to JavaScript, so that browser-based apps can use the exact same logic as the command-line utility.
+package main
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestChord(t *testing.T) {
+	assert.Equal(t, `{"root":"C","tones":{"1":"C","3":"Eb","5":"G","7":"Bb"}}`, call("chord", "Cm7"))
+}
+
+func TestScale(t *testing.T) {
+	assert.Equal(t, `{"root":"C","tones":{"1":"C","2":"D#","3":"E","4":"G","5":"G#","6":"B"}}`, call("scale", "C aug"))
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, `{"root":"Db","mode":"Major","relative":{"root":"Bb","mode":"Minor"}}`, call("key", "Db"))
+}
+
+func TestPitch(t *testing.T) {
+	assert.Equal(t, `{"note":"A4","tuning":440,"pitch":"440.00Hz"}`, call("pitch", "A4"))
+	assert.Equal(t, `{"note":"A5","tuning":432,"pitch":"864.00Hz"}`, call("pitch", "A5", 432))
+	assert.Equal(t, `{"note":"C4","tuning":415,"pitch":"246.760Hz"}`, call("pitch", "C4", "baroque", 3))
+	assert.Equal(t, `{"error":"unknown tuning \"loud\""}`, call("pitch", "A4", "loud"))
+	assert.Equal(t, `{"error":"out of range 5e+07, expected 0 to 10"}`, call("pitch", "A4", 440, 50000000))
+	assert.Equal(t, `{"error":"out of range -1, expected 0 to 10"}`, call("pitch", "A4", 440, -1))
+}
+
+func TestMissingArgs(t *testing.T) {
+	assert.Equal(t, `{"error":"unknown root \"\" at position 0 of chord \"\""}`, call("chord"))
+	assert.Equal(t, `{"error":"unknown mode \"jams\" at position 2 of scale \"C jams\""}`, call("scale", "C jams"))
+}
+
+//
+// Private
+//
+
+func call(name string, args ...interface{}) string {
+	return exports().Call(name, args...).String()
+}
@@ -0,0 +1,41 @@
+// Thin JavaScript wrapper of the music-theory WebAssembly build.
+//
+// Requires wasm_exec.js from the Go distribution to be loaded first, e.g.
+//
+//     <script src="wasm_exec.js"></script>
+//     <script src="music-theory.js"></script>
+//     <script>
+//       MusicTheory.load("music-theory.wasm").then(theory => {
+//         console.log(theory.chord("Cm7"));
+//       });
+//     </script>
+(function (root) {
+  "use strict";
+
+  function parse(json) {
+    return JSON.parse(json);
+  }
+
+  // load the WebAssembly from a URL, resolving to the music theory functions
+  function load(url) {
+    const go = new Go();
+    const fetched = fetch(url);
+    const instantiated = WebAssembly.instantiateStreaming
+      ? WebAssembly.instantiateStreaming(fetched, go.importObject)
+      : fetched
+          .then(response => response.arrayBuffer())
+          .then(bytes => WebAssembly.instantiate(bytes, go.importObject));
+    return instantiated.then(result => {
+      go.run(result.instance);
+      const exported = root.musicTheory;
+      return {
+        chord: name => parse(exported.chord(name)),
+        scale: name => parse(exported.scale(name)),
+        key: name => parse(exported.key(name)),
+        pitch: (name, tuning, precision) => parse(exported.pitch(name, tuning === undefined ? 440 : tuning, precision === undefined ? 2 : precision)),
+      };
+    });
+  }
+
+  root.MusicTheory = { load: load };
+})(typeof globalThis !== "undefined" ? globalThis : this);